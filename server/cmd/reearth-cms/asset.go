@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/log"
+)
+
+// extractAssetMetadata extracts the metadata of the files of an asset, which is run as a task after an archive is decompressed.
+func extractAssetMetadata(args []string) {
+	metadataCmd := flag.NewFlagSet("metadata", flag.ExitOnError)
+	aIdStr := metadataCmd.String("assetId", "", "")
+
+	if err := metadataCmd.Parse(args); err != nil {
+		return
+	}
+
+	aId := id.AssetIDFromRef(aIdStr)
+	if aId == nil {
+		log.Fatal("invalid asset id")
+	}
+
+	ctx := context.Background()
+	uc, _, _ := initContainer(ctx)
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{},
+		Machine:    true,
+	}
+
+	a, err := uc.Asset.ExtractMetadata(ctx, *aId, op)
+	if err != nil {
+		log.Fatalf("failed to extract metadata of asset %s: %v", aId, err)
+	}
+	if a.Metadata() == nil {
+		log.Infof("no metadata was extracted from asset %s", aId)
+		return
+	}
+	log.Infof("extracted metadata of asset %s", aId)
+}
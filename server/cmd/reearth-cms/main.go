@@ -30,6 +30,24 @@ func main() {
 	if len(os.Args) == 1 {
 		app.Start(debug, version)
 	}
+	if len(os.Args) >= 3 && os.Args[1] == "item" && os.Args[2] == "reindex" {
+		reindex(os.Args[3:])
+	}
+	if len(os.Args) >= 3 && os.Args[1] == "asset" && os.Args[2] == "metadata" {
+		extractAssetMetadata(os.Args[3:])
+	}
+	if len(os.Args) >= 3 && os.Args[1] == "project" && os.Args[2] == "export" {
+		exportProject(os.Args[3:])
+	}
+	if len(os.Args) >= 3 && os.Args[1] == "project" && os.Args[2] == "import" {
+		importProject(os.Args[3:])
+	}
+	if len(os.Args) >= 3 && os.Args[1] == "schema" && os.Args[2] == "plan" {
+		syncSchema(os.Args[3:], false)
+	}
+	if len(os.Args) >= 3 && os.Args[1] == "schema" && os.Args[2] == "apply" {
+		syncSchema(os.Args[3:], true)
+	}
 	if len(os.Args) >= 3 && os.Args[1] == "item" && os.Args[2] == "import" {
 		importCmd := flag.NewFlagSet("import", flag.ExitOnError)
 		uIdStr := importCmd.String("userId", "", "")
@@ -72,32 +90,7 @@ func main() {
 		}
 
 		ctx := context.Background()
-
-		// Load config
-		conf, err := app.ReadConfig(debug)
-		if err != nil {
-			log.Fatal(err)
-		}
-		log.Infof("config: %s", conf.Print())
-
-		// Init repositories
-		repos, gateways, acRepos, acGateways := app.InitReposAndGateways(ctx, conf)
-
-		uc := interactor.New(repos, gateways, acRepos, acGateways, interactor.ContainerConfig{
-			SignupSecret:    conf.SignupSecret,
-			AuthSrvUIDomain: conf.Host_Web,
-		})
-
-		// get op from user id
-		var op *usecase.Operator
-		if uIdStr != nil && *uIdStr != "" {
-			op, err = generateUserOperator(ctx, *uIdStr, repos, acRepos)
-		} else if iIdStr != nil && *iIdStr != "" {
-			op, err = generateIntegrationOperator(ctx, *iIdStr, repos, acRepos)
-		}
-		if err != nil || op == nil {
-			log.Fatalf("failed to generate operator: %v", err)
-		}
+		uc, op := initUsecase(ctx, *uIdStr, *iIdStr)
 
 		sp, err := uc.Schema.FindByModel(ctx, *mId, op)
 		if err != nil {
@@ -128,6 +121,41 @@ func main() {
 	}
 }
 
+func initUsecase(ctx context.Context, uIdStr, iIdStr string) (interfaces.Container, *usecase.Operator) {
+	uc, repos, acRepos := initContainer(ctx)
+
+	// get op from user id
+	var op *usecase.Operator
+	var err error
+	if uIdStr != "" {
+		op, err = generateUserOperator(ctx, uIdStr, repos, acRepos)
+	} else if iIdStr != "" {
+		op, err = generateIntegrationOperator(ctx, iIdStr, repos, acRepos)
+	}
+	if err != nil || op == nil {
+		log.Fatalf("failed to generate operator: %v", err)
+	}
+	return uc, op
+}
+
+func initContainer(ctx context.Context) (interfaces.Container, *repo.Container, *accountrepo.Container) {
+	// Load config
+	conf, err := app.ReadConfig(debug)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Infof("config: %s", conf.Print())
+
+	// Init repositories
+	repos, gateways, acRepos, acGateways := app.InitReposAndGateways(ctx, conf)
+
+	uc := interactor.New(repos, gateways, acRepos, acGateways, interactor.ContainerConfig{
+		SignupSecret:    conf.SignupSecret,
+		AuthSrvUIDomain: conf.Host_Web,
+	})
+	return uc, repos, acRepos
+}
+
 func generateUserOperator(ctx context.Context, uIdStr string, repo *repo.Container, accRepo *accountrepo.Container) (*usecase.Operator, error) {
 	uId, err := user.IDFrom(uIdStr)
 	if err != nil {
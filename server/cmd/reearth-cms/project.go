@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/log"
+	"github.com/samber/lo"
+)
+
+// exportProject writes a project with its models, items and assets to a zip file.
+func exportProject(args []string) {
+	exportCmd := flag.NewFlagSet("export", flag.ExitOnError)
+	uIdStr := exportCmd.String("userId", "", "")
+	iIdStr := exportCmd.String("integrationId", "", "")
+	pIdStr := exportCmd.String("projectId", "", "")
+	output := exportCmd.String("output", "", "")
+
+	if err := exportCmd.Parse(args); err != nil {
+		return
+	}
+
+	pId := id.ProjectIDFromRef(pIdStr)
+	if pId == nil {
+		log.Fatal("invalid project id")
+	}
+	if *output == "" {
+		log.Fatal("output is required")
+	}
+
+	ctx := context.Background()
+	uc, op := initUsecase(ctx, *uIdStr, *iIdStr)
+
+	res, err := uc.Project.Export(ctx, *pId, op)
+	if err != nil {
+		log.Fatalf("failed to export: %v", err)
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		log.Fatalf("failed to create output: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	if _, err := io.Copy(f, res.PipeReader); err != nil {
+		log.Fatalf("failed to export: %v", err)
+	}
+	log.Infof("exported project %s to %s", pId, *output)
+}
+
+// importProject creates a project in a workspace from a zip file written by exportProject.
+func importProject(args []string) {
+	importCmd := flag.NewFlagSet("import", flag.ExitOnError)
+	uIdStr := importCmd.String("userId", "", "")
+	iIdStr := importCmd.String("integrationId", "", "")
+	wIdStr := importCmd.String("workspaceId", "", "")
+	alias := importCmd.String("alias", "", "")
+	input := importCmd.String("file", "", "")
+
+	if err := importCmd.Parse(args); err != nil {
+		return
+	}
+
+	wId, err := accountdomain.WorkspaceIDFrom(*wIdStr)
+	if err != nil {
+		log.Fatal("invalid workspace id")
+	}
+	if *input == "" {
+		log.Fatal("file is required")
+	}
+
+	f, err := os.Open(*input)
+	if err != nil {
+		log.Fatalf("failed to open file: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+	fi, err := f.Stat()
+	if err != nil {
+		log.Fatalf("failed to open file: %v", err)
+	}
+
+	ctx := context.Background()
+	uc, op := initUsecase(ctx, *uIdStr, *iIdStr)
+
+	p, err := uc.Project.Import(ctx, interfaces.ImportProjectParam{
+		WorkspaceID: wId,
+		Alias:       lo.EmptyableToPtr(*alias),
+		Reader:      f,
+		Size:        fi.Size(),
+	}, op)
+	if err != nil {
+		log.Fatalf("failed to import: %v", err)
+	}
+	log.Infof("imported project %s (%s)", p.ID(), p.Alias())
+}
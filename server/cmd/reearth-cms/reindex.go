@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+// reindex rebuilds the search index of the items of a model, or of all models of a project.
+func reindex(args []string) {
+	reindexCmd := flag.NewFlagSet("reindex", flag.ExitOnError)
+	uIdStr := reindexCmd.String("userId", "", "")
+	iIdStr := reindexCmd.String("integrationId", "", "")
+	mIdStr := reindexCmd.String("modelId", "", "")
+	pIdStr := reindexCmd.String("projectId", "", "")
+
+	if err := reindexCmd.Parse(args); err != nil {
+		return
+	}
+
+	mId := id.ModelIDFromRef(mIdStr)
+	pId := id.ProjectIDFromRef(pIdStr)
+	if mId == nil && pId == nil {
+		log.Fatal("model id or project id is required")
+	}
+
+	ctx := context.Background()
+	uc, op := initUsecase(ctx, *uIdStr, *iIdStr)
+
+	var models id.ModelIDList
+	if mId != nil {
+		models = append(models, *mId)
+	} else {
+		var cur *usecasex.Cursor
+		for {
+			ml, pi, err := uc.Model.FindByProject(ctx, *pId, usecasex.CursorPagination{
+				After: cur,
+				First: lo.ToPtr(int64(100)),
+			}.Wrap(), op)
+			if err != nil {
+				log.Fatalf("failed to find models: %v", err)
+			}
+			models = append(models, lo.Map(ml, func(m *model.Model, _ int) id.ModelID { return m.ID() })...)
+			if pi == nil || !pi.HasNextPage {
+				break
+			}
+			cur = pi.EndCursor
+		}
+	}
+
+	for _, m := range models {
+		n, err := uc.Item.Reindex(ctx, m, op)
+		if err != nil {
+			log.Fatalf("failed to reindex model %s: %v", m, err)
+		}
+		log.Infof("reindexed %d items of model %s", n, m)
+	}
+}
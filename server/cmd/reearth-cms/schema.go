@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schemaspec"
+	"github.com/reearth/reearthx/log"
+)
+
+// syncSchema prints the changes to make the models and the groups of a project match a spec file, and applies them when apply is true.
+func syncSchema(args []string, apply bool) {
+	syncCmd := flag.NewFlagSet("schema", flag.ExitOnError)
+	uIdStr := syncCmd.String("userId", "", "")
+	iIdStr := syncCmd.String("integrationId", "", "")
+	pIdStr := syncCmd.String("projectId", "", "")
+	input := syncCmd.String("file", "", "")
+	allowDestructive := syncCmd.Bool("allowDestructive", false, "")
+
+	if err := syncCmd.Parse(args); err != nil {
+		return
+	}
+
+	pId := id.ProjectIDFromRef(pIdStr)
+	if pId == nil {
+		log.Fatal("invalid project id")
+	}
+	if *input == "" {
+		log.Fatal("file is required")
+	}
+
+	f, err := os.Open(*input)
+	if err != nil {
+		log.Fatalf("failed to open file: %v", err)
+	}
+	defer func() { _ = f.Close() }()
+	spec, err := schemaspec.Parse(f)
+	if err != nil {
+		log.Fatalf("failed to read spec: %v", err)
+	}
+
+	ctx := context.Background()
+	uc, op := initUsecase(ctx, *uIdStr, *iIdStr)
+
+	param := interfaces.SchemaSpecParam{
+		ProjectID:        *pId,
+		Spec:             spec,
+		AllowDestructive: *allowDestructive,
+	}
+	var plan schemaspec.Plan
+	if apply {
+		plan, err = uc.Schema.ApplySpec(ctx, param, op)
+	} else {
+		plan, err = uc.Schema.PlanSpec(ctx, param, op)
+	}
+	if err != nil {
+		log.Fatalf("failed to sync schema: %v", err)
+	}
+
+	if len(plan) == 0 {
+		log.Infof("no changes")
+		return
+	}
+	for _, c := range plan {
+		log.Infof("%s", c)
+	}
+	if !apply && plan.HasDestructiveChanges() {
+		log.Warnf("%d destructive changes: apply with -allowDestructive to apply them", len(plan.Destructive()))
+	}
+}
@@ -0,0 +1,115 @@
+package e2e
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/app"
+	"github.com/reearth/reearth-cms/server/pkg/imaging"
+	"github.com/stretchr/testify/assert"
+)
+
+// GET /assets/{uuid1}/{uuid2}/{filename}?w=&h=&fit=&format=&s=
+func TestAssetTransform(t *testing.T) {
+	transformSecret := []byte("transform_secret")
+	e := StartServer(t, &app.Config{
+		Host:                  "https://api.example.com",
+		AssetBaseURL:          "https://assets.example.com",
+		Asset_Public:          true,
+		Asset_TransformSecret: string(transformSecret),
+	}, true, baseSeeder)
+
+	img := image.NewRGBA(image.Rect(0, 0, 400, 200))
+	img.Set(0, 0, color.White)
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, img))
+
+	a := e.POST("/api/projects/{projectId}/assets", pid).
+		WithHeader("authorization", "Bearer "+secret).
+		WithMultipart().
+		WithFile("file", "image.png", bytes.NewReader(buf.Bytes())).
+		WithForm(map[string]any{"skipDecompression": true}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object()
+	aid := a.Value("id").String().Raw()
+	aUrl := a.Value("url").String().Raw()
+	p := strings.TrimPrefix(aUrl, "https://assets.example.com")
+
+	// the original file
+	e.GET(p).
+		Expect().
+		Status(http.StatusOK).
+		Body().IsEqual(buf.String())
+
+	o := imaging.Options{Width: 100, Height: 100, Fit: imaging.FitCover, Format: imaging.FormatJPEG}
+
+	// unsigned or tampered parameters are rejected
+	e.GET(p).
+		WithQuery("w", 100).
+		Expect().
+		Status(http.StatusBadRequest)
+	e.GET(p).
+		WithQueryString(strings.Replace(o.SignedQuery(transformSecret, p), "w=100", "w=200", 1)).
+		Expect().
+		Status(http.StatusBadRequest)
+	e.GET(p).
+		WithQuery("w", 0).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	for i := 0; i < 2; i++ {
+		// the second request is served from the cached derivative
+		res := e.GET(p).
+			WithQueryString(o.SignedQuery(transformSecret, p)).
+			Expect().
+			Status(http.StatusOK)
+		res.Header("Content-Type").IsEqual("image/jpeg")
+
+		cfg, name, err := image.DecodeConfig(strings.NewReader(res.Body().Raw()))
+		assert.NoError(t, err)
+		assert.Equal(t, "jpeg", name)
+		assert.Equal(t, 100, cfg.Width)
+		assert.Equal(t, 100, cfg.Height)
+	}
+
+	// the format of the source file is kept when it is not specified
+	res := e.GET(p).
+		WithQueryString(imaging.Options{Width: 40}.SignedQuery(transformSecret, p)).
+		Expect().
+		Status(http.StatusOK)
+	res.Header("Content-Type").IsEqual("image/png")
+	cfg, err := png.DecodeConfig(strings.NewReader(res.Body().Raw()))
+	assert.NoError(t, err)
+	assert.Equal(t, 40, cfg.Width)
+	assert.Equal(t, 20, cfg.Height)
+
+	// the signed URL is issued by the integration API
+	e.GET("/api/assets/{assetId}/transform", aid).
+		WithHeader("authorization", "Bearer "+secret).
+		WithQuery("w", 100).
+		WithQuery("h", 100).
+		WithQuery("fit", "cover").
+		WithQuery("format", "jpeg").
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		Object().
+		HasValue("url", "https://api.example.com"+p+"?"+o.SignedQuery(transformSecret, p))
+
+	// the cached derivatives are not served after the asset is deleted
+	e.DELETE("/api/assets/{assetId}", aid).
+		WithHeader("authorization", "Bearer "+secret).
+		Expect().
+		Status(http.StatusOK)
+	e.GET(p).
+		WithQueryString(o.SignedQuery(transformSecret, p)).
+		Expect().
+		Status(http.StatusNotFound)
+}
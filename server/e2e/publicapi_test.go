@@ -82,6 +82,7 @@ func TestPublicAPI(t *testing.T) {
 
 	// ok
 	e.GET("/api/p/{project}/{model}", publicAPIProjectAlias, publicAPIModelKey).
+		WithQuery("expand", publicAPIField2Key+","+publicAPIField4Key).
 		Expect().
 		Status(http.StatusOK).
 		JSON().
@@ -179,6 +180,18 @@ func TestPublicAPI(t *testing.T) {
 		})
 
 	e.GET("/api/p/{project}/{model}/{item}", publicAPIProjectAlias, publicAPIModelKey, publicAPIItem1ID).
+		Expect().
+		Status(http.StatusOK).
+		JSON().
+		IsEqual(map[string]any{
+			"id":               publicAPIItem1ID.String(),
+			publicAPIField1Key: "aaa",
+			// assets are not expanded by default
+			publicAPIField2Key: publicAPIAsset1ID.String(),
+		})
+
+	e.GET("/api/p/{project}/{model}/{item}", publicAPIProjectAlias, publicAPIModelKey, publicAPIItem1ID).
+		WithQuery("expand", publicAPIField2Key).
 		Expect().
 		Status(http.StatusOK).
 		JSON().
@@ -440,6 +453,7 @@ func TestPublicAPI(t *testing.T) {
 
 	// valid token
 	e.GET("/api/p/{project}/{model}", publicAPIProjectAlias, publicAPIModelKey).
+		WithQuery("expand", publicAPIField2Key+","+publicAPIField4Key).
 		WithHeader("Origin", "https://example.com").
 		WithHeader("Authorization", token).
 		WithHeader("Content-Type", "application/json").
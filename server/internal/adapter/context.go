@@ -8,6 +8,7 @@ import (
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
 	"github.com/reearth/reearthx/account/accountdomain/user"
 	"github.com/reearth/reearthx/appx"
 )
@@ -20,6 +21,7 @@ const (
 	ContextAuthInfo ContextKey = "authinfo"
 	contextUsecases ContextKey = "usecases"
 	contextGateways ContextKey = "gateways"
+	contextPreview  ContextKey = "preview"
 )
 
 func AttachUser(ctx context.Context, u *user.User) context.Context {
@@ -74,6 +76,20 @@ func Gateways(ctx context.Context) *gateway.Container {
 	return nil
 }
 
+// AttachPreviewToken attaches the preview token verified by the public API to preview draft content.
+func AttachPreviewToken(ctx context.Context, t *preview.Token) context.Context {
+	return context.WithValue(ctx, contextPreview, t)
+}
+
+func PreviewToken(ctx context.Context) *preview.Token {
+	if v := ctx.Value(contextPreview); v != nil {
+		if t, ok := v.(*preview.Token); ok {
+			return t
+		}
+	}
+	return nil
+}
+
 func Lang(ctx context.Context, lang *language.Tag) string {
 	if lang != nil && !lang.IsRoot() {
 		return lang.String()
@@ -42,9 +42,41 @@ func ToAsset(a *asset.Asset) *Asset {
 		Size:                    int64(a.Size()),
 		Public:                  ai.Public,
 		ContentType:             detectContentTypeByFilename(a.FileName()),
+		Metadata:                ToAssetMetadata(a.Metadata()),
 	}
 }
 
+func ToAssetMetadata(m *asset.Metadata) *AssetMetadata {
+	if m.IsEmpty() {
+		return nil
+	}
+	res := &AssetMetadata{
+		Width:        m.Width,
+		Height:       m.Height,
+		CapturedAt:   m.CapturedAt,
+		FeatureCount: m.FeatureCount,
+		Headers:      m.Headers,
+		RowCount:     m.RowCount,
+	}
+	if l := m.Location; l != nil {
+		res.Location = &AssetLocation{Lat: l.Lat, Lng: l.Lng}
+	}
+	if b := m.BBox; b != nil {
+		res.Bbox = &AssetBBox{MinLng: b.MinLng, MinLat: b.MinLat, MaxLng: b.MaxLng, MaxLat: b.MaxLat}
+	}
+	if g := m.GLTF; g != nil {
+		res.Gltf = &AssetGLTFStats{
+			Scenes:     g.Scenes,
+			Nodes:      g.Nodes,
+			Meshes:     g.Meshes,
+			Materials:  g.Materials,
+			Textures:   g.Textures,
+			Animations: g.Animations,
+		}
+	}
+	return res
+}
+
 func FromPreviewType(p *PreviewType) *asset.PreviewType {
 	if p == nil {
 		return nil
@@ -166,6 +198,25 @@ func (s *AssetSort) Into() *usecasex.Sort {
 	}
 }
 
+func (i *AssetMetadataFilterInput) Into() *asset.MetadataFilter {
+	if i == nil {
+		return nil
+	}
+	f := &asset.MetadataFilter{
+		MinWidth:       i.MinWidth,
+		MaxWidth:       i.MaxWidth,
+		MinHeight:      i.MinHeight,
+		MaxHeight:      i.MaxHeight,
+		CapturedAfter:  i.CapturedAfter,
+		CapturedBefore: i.CapturedBefore,
+		Header:         i.Header,
+	}
+	if b := i.Bbox; b != nil {
+		f.BBox = &asset.BBox{MinLng: b.MinLng, MinLat: b.MinLat, MaxLng: b.MaxLng, MaxLat: b.MaxLat}
+	}
+	return f
+}
+
 func detectContentTypeByFilename(filename string) *string {
 	ext := strings.ToLower(filepath.Ext(filename))
 
@@ -408,3 +408,36 @@ func TestConvertAsset_detectContentTypeByFilename(t *testing.T) {
 		})
 	}
 }
+
+func TestToAssetMetadata(t *testing.T) {
+	assert.Nil(t, ToAssetMetadata(nil))
+	assert.Nil(t, ToAssetMetadata(&asset.Metadata{}))
+
+	assert.Equal(t, &AssetMetadata{
+		Width:    lo.ToPtr(10),
+		Height:   lo.ToPtr(20),
+		Location: &AssetLocation{Lat: 35, Lng: 139},
+		Bbox:     &AssetBBox{MinLng: 1, MinLat: 2, MaxLng: 3, MaxLat: 4},
+		Gltf:     &AssetGLTFStats{Scenes: 1, Meshes: 2},
+	}, ToAssetMetadata(&asset.Metadata{
+		Width:    lo.ToPtr(10),
+		Height:   lo.ToPtr(20),
+		Location: &asset.Location{Lat: 35, Lng: 139},
+		BBox:     &asset.BBox{MinLng: 1, MinLat: 2, MaxLng: 3, MaxLat: 4},
+		GLTF:     &asset.GLTFStats{Scenes: 1, Meshes: 2},
+	}))
+}
+
+func TestAssetMetadataFilterInput_Into(t *testing.T) {
+	assert.Nil(t, (*AssetMetadataFilterInput)(nil).Into())
+
+	assert.Equal(t, &asset.MetadataFilter{
+		MinWidth: lo.ToPtr(10),
+		BBox:     &asset.BBox{MinLng: 1, MinLat: 2, MaxLng: 3, MaxLat: 4},
+		Header:   lo.ToPtr("name"),
+	}, (&AssetMetadataFilterInput{
+		MinWidth: lo.ToPtr(10),
+		Bbox:     &AssetBBoxInput{MinLng: 1, MinLat: 2, MaxLng: 3, MaxLat: 4},
+		Header:   lo.ToPtr("name"),
+	}).Into())
+}
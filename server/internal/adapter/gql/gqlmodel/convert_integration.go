@@ -63,6 +63,15 @@ func ToWebhook(w *integration.Webhook) *Webhook {
 			OnAssetUpload:     lo.ToPtr(w.Trigger()[event.AssetCreate]),
 			OnAssetDecompress: lo.ToPtr(w.Trigger()[event.AssetDecompress]),
 			OnAssetDelete:     lo.ToPtr(w.Trigger()[event.AssetDelete]),
+			OnRequestCreate:   lo.ToPtr(w.Trigger()[event.RequestCreate]),
+			OnRequestSubmit:   lo.ToPtr(w.Trigger()[event.RequestSubmit]),
+			OnRequestReview:   lo.ToPtr(w.Trigger()[event.RequestReview]),
+			OnRequestApprove:  lo.ToPtr(w.Trigger()[event.RequestApprove]),
+			OnRequestClose:    lo.ToPtr(w.Trigger()[event.RequestClose]),
+			OnRequestComment:  lo.ToPtr(w.Trigger()[event.RequestComment]),
+			OnCommentCreate:   lo.ToPtr(w.Trigger()[event.CommentCreate]),
+			OnCommentUpdate:   lo.ToPtr(w.Trigger()[event.CommentUpdate]),
+			OnCommentDelete:   lo.ToPtr(w.Trigger()[event.CommentDelete]),
 		},
 		Secret:    w.Secret(),
 		CreatedAt: w.CreatedAt(),
@@ -143,6 +143,15 @@ func TestToWebhook(t *testing.T) {
 					OnAssetUpload:     lo.ToPtr(false),
 					OnAssetDecompress: lo.ToPtr(false),
 					OnAssetDelete:     lo.ToPtr(false),
+					OnRequestCreate:   lo.ToPtr(false),
+					OnRequestSubmit:   lo.ToPtr(false),
+					OnRequestReview:   lo.ToPtr(false),
+					OnRequestApprove:  lo.ToPtr(false),
+					OnRequestClose:    lo.ToPtr(false),
+					OnRequestComment:  lo.ToPtr(false),
+					OnCommentCreate:   lo.ToPtr(false),
+					OnCommentUpdate:   lo.ToPtr(false),
+					OnCommentDelete:   lo.ToPtr(false),
 				},
 				CreatedAt: wId.Timestamp(),
 				UpdatedAt: now,
@@ -161,6 +170,15 @@ func TestToWebhook(t *testing.T) {
 					event.AssetCreate:     true,
 					event.AssetDecompress: true,
 					event.AssetDelete:     true,
+					event.RequestCreate:   true,
+					event.RequestSubmit:   true,
+					event.RequestReview:   true,
+					event.RequestApprove:  true,
+					event.RequestClose:    true,
+					event.RequestComment:  true,
+					event.CommentCreate:   true,
+					event.CommentUpdate:   true,
+					event.CommentDelete:   true,
 				}).
 				MustBuild(),
 			want: &Webhook{
@@ -177,6 +195,15 @@ func TestToWebhook(t *testing.T) {
 					OnAssetUpload:     lo.ToPtr(true),
 					OnAssetDecompress: lo.ToPtr(true),
 					OnAssetDelete:     lo.ToPtr(true),
+					OnRequestCreate:   lo.ToPtr(true),
+					OnRequestSubmit:   lo.ToPtr(true),
+					OnRequestReview:   lo.ToPtr(true),
+					OnRequestApprove:  lo.ToPtr(true),
+					OnRequestClose:    lo.ToPtr(true),
+					OnRequestComment:  lo.ToPtr(true),
+					OnCommentCreate:   lo.ToPtr(true),
+					OnCommentUpdate:   lo.ToPtr(true),
+					OnCommentDelete:   lo.ToPtr(true),
 				},
 				CreatedAt: wId.Timestamp(),
 				UpdatedAt: now,
@@ -228,6 +255,15 @@ func TestToWebhooks(t *testing.T) {
 						event.AssetCreate:     true,
 						event.AssetDecompress: true,
 						event.AssetDelete:     true,
+						event.RequestCreate:   true,
+						event.RequestSubmit:   true,
+						event.RequestReview:   true,
+						event.RequestApprove:  true,
+						event.RequestClose:    true,
+						event.RequestComment:  true,
+						event.CommentCreate:   true,
+						event.CommentUpdate:   true,
+						event.CommentDelete:   true,
 					}).
 					MustBuild(),
 			},
@@ -246,6 +282,15 @@ func TestToWebhooks(t *testing.T) {
 						OnAssetUpload:     lo.ToPtr(false),
 						OnAssetDecompress: lo.ToPtr(false),
 						OnAssetDelete:     lo.ToPtr(false),
+						OnRequestCreate:   lo.ToPtr(false),
+						OnRequestSubmit:   lo.ToPtr(false),
+						OnRequestReview:   lo.ToPtr(false),
+						OnRequestApprove:  lo.ToPtr(false),
+						OnRequestClose:    lo.ToPtr(false),
+						OnRequestComment:  lo.ToPtr(false),
+						OnCommentCreate:   lo.ToPtr(false),
+						OnCommentUpdate:   lo.ToPtr(false),
+						OnCommentDelete:   lo.ToPtr(false),
 					},
 					CreatedAt: wId.Timestamp(),
 					UpdatedAt: now,
@@ -264,6 +309,15 @@ func TestToWebhooks(t *testing.T) {
 						OnAssetUpload:     lo.ToPtr(true),
 						OnAssetDecompress: lo.ToPtr(true),
 						OnAssetDelete:     lo.ToPtr(true),
+						OnRequestCreate:   lo.ToPtr(true),
+						OnRequestSubmit:   lo.ToPtr(true),
+						OnRequestReview:   lo.ToPtr(true),
+						OnRequestApprove:  lo.ToPtr(true),
+						OnRequestClose:    lo.ToPtr(true),
+						OnRequestComment:  lo.ToPtr(true),
+						OnCommentCreate:   lo.ToPtr(true),
+						OnCommentUpdate:   lo.ToPtr(true),
+						OnCommentDelete:   lo.ToPtr(true),
 					},
 					CreatedAt: wId.Timestamp(),
 					UpdatedAt: now,
@@ -1,10 +1,14 @@
 package gqlmodel
 
 import (
+	"slices"
+
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/samber/lo"
 )
@@ -61,12 +65,89 @@ func toItemFields(fields item.Fields, s *schema.Schema, isGroupSchema bool) []*I
 				SchemaFieldID: IDFrom(sf.ID()),
 				Type:          ToValueType(sf.Type()),
 				Value:         v,
+				Locales:       toItemFieldLocales(field, sf),
 			})
 		}
 	}
 	return res
 }
 
+func toItemFieldLocales(f *item.Field, sf *schema.Field) []*ItemFieldLocaleValue {
+	if f == nil || !sf.Localized() {
+		return nil
+	}
+	locales := f.Locales()
+	keys := lo.Keys(locales)
+	slices.Sort(keys)
+	return lo.Map(keys, func(l locale.Locale, _ int) *ItemFieldLocaleValue {
+		return &ItemFieldLocaleValue{
+			Locale: l.String(),
+			Value:  ToValue(locales[l], sf.Multiple()),
+		}
+	})
+}
+
+func ToItemDiff(d item.Diff, sp *schema.Package) *ItemDiff {
+	res := &ItemDiff{
+		Fields:         toItemFieldChanges(d.Fields, sp),
+		MetadataFields: toItemFieldChanges(d.MetadataFields, sp),
+	}
+	if d.MetadataItem != nil {
+		res.MetadataChanged = true
+		res.PreviousMetadataID = IDFromRef(d.MetadataItem.Previous)
+		res.CurrentMetadataID = IDFromRef(d.MetadataItem.Current)
+	}
+	return res
+}
+
+func toItemFieldChanges(changes item.FieldChanges, sp *schema.Package) []*ItemFieldChange {
+	return lo.Map(changes, func(c item.FieldChange, _ int) *ItemFieldChange {
+		// values of the fields which were deleted from the schema are returned as lists
+		multiple := true
+		if sf := sp.Field(c.ID); sf != nil {
+			multiple = sf.Multiple()
+		}
+
+		res := &ItemFieldChange{
+			SchemaFieldID: IDFrom(c.ID),
+			ItemGroupID:   IDFromRef(c.Group),
+			Type:          ToItemFieldChangeType(c.Type),
+		}
+		if c.PreviousValue != nil {
+			res.PreviousValue = ToValue(c.PreviousValue, multiple)
+		}
+		if c.CurrentValue != nil {
+			res.CurrentValue = ToValue(c.CurrentValue, multiple)
+		}
+		if multiple {
+			res.AddedValues = lo.Map(c.AddedValues(), func(v *value.Value, _ int) any { return v.Interface() })
+			res.RemovedValues = lo.Map(c.RemovedValues(), func(v *value.Value, _ int) any { return v.Interface() })
+		}
+		return res
+	})
+}
+
+func ToRestoreItemPayload(res interfaces.RestoreItemResult, sp *schema.Package) *RestoreItemPayload {
+	return &RestoreItemPayload{
+		Item:                ToItem(res.Item, sp.Schema(), sp.GroupSchemas()),
+		Changes:             toItemFieldChanges(res.Changes, sp),
+		RemovedFieldIds:     lo.Map(res.RemovedFields, func(f id.FieldID, _ int) ID { return IDFrom(f) }),
+		TypeChangedFieldIds: lo.Map(res.TypeChangedFields, func(f id.FieldID, _ int) ID { return IDFrom(f) }),
+	}
+}
+
+func ToItemFieldChangeType(t item.FieldChangeType) ItemFieldChangeType {
+	switch t {
+	case item.FieldChangeTypeAdd:
+		return ItemFieldChangeTypeAdd
+	case item.FieldChangeTypeUpdate:
+		return ItemFieldChangeTypeUpdate
+	case item.FieldChangeTypeDelete:
+		return ItemFieldChangeTypeDelete
+	}
+	return ""
+}
+
 func ToVersionedItem(v *version.Value[*item.Item], s *schema.Schema, gsList schema.List) *VersionedItem {
 	if v == nil {
 		return nil
@@ -100,7 +181,8 @@ func ToItemParam(field *ItemFieldInput) *interfaces.ItemFieldParam {
 		Group: ToIDRef[id.ItemGroup](field.ItemGroupID),
 		Field: &fid,
 		// Type:  FromValueType(field.Type),
-		Value: field.Value,
+		Value:  field.Value,
+		Locale: locale.Locale(lo.FromPtr(field.Locale)),
 	}
 }
 
@@ -0,0 +1,53 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/samber/lo"
+)
+
+// ToItemTemplate converts the template with the schema and the metadata schema of its model. The fields which are not in the schemas are omitted.
+func ToItemTemplate(t *template.Template, s, ms *schema.Schema, gsList schema.List) *ItemTemplate {
+	if t == nil {
+		return nil
+	}
+	return &ItemTemplate{
+		ID:             IDFrom[id.ItemTemplate](t.ID()),
+		ModelID:        IDFrom[id.Model](t.Model()),
+		ProjectID:      IDFrom[id.Project](t.Project()),
+		Name:           t.Name(),
+		Description:    t.Description(),
+		Fields:         toItemTemplateFields(t.Fields(), s, gsList),
+		MetadataFields: toItemTemplateFields(t.MetadataFields(), ms, gsList),
+		UserID:         IDFromRef(t.User()),
+		IntegrationID:  IDFromRef(t.Integration()),
+		CreatedAt:      t.CreatedAt(),
+		UpdatedAt:      t.UpdatedAt(),
+	}
+}
+
+func toItemTemplateFields(fields item.Fields, s *schema.Schema, gsList schema.List) []*ItemField {
+	return lo.FilterMap(fields, func(f *item.Field, _ int) (*ItemField, bool) {
+		var sf *schema.Field
+		if s == nil {
+			return nil, false
+		}
+		if f.ItemGroup() == nil {
+			sf = s.Field(f.FieldID())
+		} else {
+			sf = gsList.Fields().Find(f.FieldID())
+		}
+		if sf == nil {
+			return nil, false
+		}
+		return &ItemField{
+			ItemGroupID:   IDFromRef(f.ItemGroup()),
+			SchemaFieldID: IDFrom(sf.ID()),
+			Type:          ToValueType(sf.Type()),
+			Value:         ToValue(f.Value(), sf.Multiple()),
+			Locales:       toItemFieldLocales(f, sf),
+		}, true
+	})
+}
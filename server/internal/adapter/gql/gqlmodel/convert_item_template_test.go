@@ -0,0 +1,48 @@
+package gqlmodel
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToItemTemplate(t *testing.T) {
+	wid, pid := accountdomain.NewWorkspaceID(), id.NewProjectID()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	gsf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).Multiple(true).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(pid).Fields(schema.FieldList{sf}).MustBuild()
+	gs := schema.New().NewID().Workspace(wid).Project(pid).Fields(schema.FieldList{gsf}).MustBuild()
+	g := id.NewItemGroupID()
+	uid := accountdomain.NewUserID()
+
+	tm := template.New().NewID().Project(pid).Model(id.NewModelID()).Name("t").User(&uid).Fields(item.Fields{
+		item.NewField(sf.ID(), value.TypeText.Value("a").AsMultiple(), nil),
+		item.NewField(gsf.ID(), value.NewMultiple(value.TypeText, []any{"b", "c"}), g.Ref()),
+		item.NewField(id.NewFieldID(), value.TypeText.Value("removed").AsMultiple(), nil),
+	}).MetadataFields(item.Fields{
+		item.NewField(id.NewFieldID(), value.TypeBool.Value(true).AsMultiple(), nil),
+	}).MustBuild()
+
+	assert.Equal(t, &ItemTemplate{
+		ID:          IDFrom(tm.ID()),
+		ModelID:     IDFrom(tm.Model()),
+		ProjectID:   IDFrom(pid),
+		Name:        "t",
+		Description: "",
+		Fields: []*ItemField{
+			{SchemaFieldID: IDFrom(sf.ID()), Type: SchemaFieldTypeText, Value: "a"},
+			{SchemaFieldID: IDFrom(gsf.ID()), ItemGroupID: IDFromRef(g.Ref()), Type: SchemaFieldTypeText, Value: []any{"b", "c"}},
+		},
+		MetadataFields: []*ItemField{},
+		UserID:         IDFromRef(&uid),
+		CreatedAt:      tm.CreatedAt(),
+		UpdatedAt:      tm.UpdatedAt(),
+	}, ToItemTemplate(tm, s, nil, schema.List{gs}))
+	assert.Nil(t, ToItemTemplate(nil, nil, nil, nil))
+}
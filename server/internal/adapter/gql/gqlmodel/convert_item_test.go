@@ -203,3 +203,49 @@ func TestToItemQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestToItemDiff(t *testing.T) {
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).Multiple(true).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{sf, sf2}).MustBuild()
+	sp := schema.NewPackage(s, nil, nil, nil)
+	mid := id.NewItemID()
+
+	got := ToItemDiff(item.Diff{
+		Fields: item.FieldChanges{
+			{
+				ID:            sf.ID(),
+				Type:          item.FieldChangeTypeUpdate,
+				PreviousValue: value.NewMultiple(value.TypeText, []any{"a", "b"}),
+				CurrentValue:  value.NewMultiple(value.TypeText, []any{"b", "c"}),
+			},
+			{
+				ID:           sf2.ID(),
+				Type:         item.FieldChangeTypeAdd,
+				CurrentValue: value.TypeText.Value("x").AsMultiple(),
+			},
+		},
+		MetadataItem: &item.MetadataItemChange{Current: mid.Ref()},
+	}, sp)
+
+	assert.Equal(t, &ItemDiff{
+		Fields: []*ItemFieldChange{
+			{
+				SchemaFieldID: IDFrom(sf.ID()),
+				Type:          ItemFieldChangeTypeUpdate,
+				PreviousValue: []any{"a", "b"},
+				CurrentValue:  []any{"b", "c"},
+				AddedValues:   []any{"c"},
+				RemovedValues: []any{"a"},
+			},
+			{
+				SchemaFieldID: IDFrom(sf2.ID()),
+				Type:          ItemFieldChangeTypeAdd,
+				CurrentValue:  "x",
+			},
+		},
+		MetadataChanged:   true,
+		CurrentMetadataID: IDFromRef(mid.Ref()),
+		MetadataFields:    []*ItemFieldChange{},
+	}, got)
+}
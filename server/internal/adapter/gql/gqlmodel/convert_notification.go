@@ -0,0 +1,86 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/samber/lo"
+)
+
+func ToNotification(n *notification.Notification) *Notification {
+	if n == nil {
+		return nil
+	}
+
+	return &Notification{
+		ID:          IDFrom(n.ID()),
+		Type:        ToNotificationType(n.Type()),
+		WorkspaceID: IDFrom(n.Workspace()),
+		ProjectID:   IDFrom(n.Project()),
+		RequestID:   IDFrom(n.Request()),
+		ActorID:     IDFromRef(n.Actor().User()),
+		CommentID:   IDFromRef(n.Comment()),
+		Read:        n.IsRead(),
+		ReadAt:      n.ReadAt(),
+		CreatedAt:   n.CreatedAt(),
+	}
+}
+
+func ToNotificationType(t notification.Type) NotificationType {
+	switch t {
+	case notification.TypeReviewRequested:
+		return NotificationTypeReviewRequested
+	case notification.TypeRequestApproved:
+		return NotificationTypeRequestApproved
+	case notification.TypeChangesRequested:
+		return NotificationTypeChangesRequested
+	case notification.TypeRequestClosed:
+		return NotificationTypeRequestClosed
+	case notification.TypeRequestComment:
+		return NotificationTypeRequestComment
+	default:
+		return ""
+	}
+}
+
+func FromNotificationType(t NotificationType) notification.Type {
+	switch t {
+	case NotificationTypeReviewRequested:
+		return notification.TypeReviewRequested
+	case NotificationTypeRequestApproved:
+		return notification.TypeRequestApproved
+	case NotificationTypeChangesRequested:
+		return notification.TypeChangesRequested
+	case NotificationTypeRequestClosed:
+		return notification.TypeRequestClosed
+	case NotificationTypeRequestComment:
+		return notification.TypeRequestComment
+	default:
+		return ""
+	}
+}
+
+func ToNotificationPreference(p *notification.Preference) *NotificationPreference {
+	if p == nil {
+		return nil
+	}
+	return &NotificationPreference{
+		Settings: lo.Map(notification.Types, func(t notification.Type, _ int) *NotificationSetting {
+			s := p.Setting(t)
+			return &NotificationSetting{
+				Type:  ToNotificationType(t),
+				Email: s.Email,
+				InApp: s.InApp,
+			}
+		}),
+	}
+}
+
+func FromNotificationSettings(settings []*NotificationSettingInput) map[notification.Type]notification.Setting {
+	res := make(map[notification.Type]notification.Setting, len(settings))
+	for _, s := range settings {
+		if s == nil {
+			continue
+		}
+		res[FromNotificationType(s.Type)] = notification.Setting{Email: s.Email, InApp: s.InApp}
+	}
+	return res
+}
@@ -0,0 +1,54 @@
+package gqlmodel
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToNotification(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	cid := id.NewCommentID()
+	n := notification.New().NewID().User(accountdomain.NewUserID()).Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).Request(id.NewRequestID()).Type(notification.TypeRequestComment).
+		Actor(operator.OperatorFromUser(uid)).Comment(&cid).MustBuild()
+
+	assert.Nil(t, ToNotification(nil))
+	assert.Equal(t, &Notification{
+		ID:          IDFrom(n.ID()),
+		Type:        NotificationTypeRequestComment,
+		WorkspaceID: IDFrom(n.Workspace()),
+		ProjectID:   IDFrom(n.Project()),
+		RequestID:   IDFrom(n.Request()),
+		ActorID:     lo.ToPtr(IDFrom(uid)),
+		CommentID:   lo.ToPtr(IDFrom(cid)),
+		Read:        false,
+		CreatedAt:   n.CreatedAt(),
+	}, ToNotification(n))
+}
+
+func TestNotificationType(t *testing.T) {
+	for _, ty := range notification.Types {
+		assert.Equal(t, ty, FromNotificationType(ToNotificationType(ty)))
+	}
+	assert.Equal(t, NotificationType(""), ToNotificationType("xxx"))
+}
+
+func TestToNotificationPreference(t *testing.T) {
+	p := notification.NewPreference(accountdomain.NewUserID())
+	assert.NoError(t, p.SetSetting(notification.TypeRequestClosed, notification.Setting{InApp: true}))
+
+	res := ToNotificationPreference(p)
+	assert.Equal(t, len(notification.Types), len(res.Settings))
+	s, _ := lo.Find(res.Settings, func(s *NotificationSetting) bool { return s.Type == NotificationTypeRequestClosed })
+	assert.Equal(t, &NotificationSetting{Type: NotificationTypeRequestClosed, Email: false, InApp: true}, s)
+
+	assert.Equal(t, map[notification.Type]notification.Setting{
+		notification.TypeRequestClosed: {Email: true, InApp: false},
+	}, FromNotificationSettings([]*NotificationSettingInput{{Type: NotificationTypeRequestClosed, Email: true}, nil}))
+}
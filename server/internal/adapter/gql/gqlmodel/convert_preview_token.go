@@ -0,0 +1,21 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+)
+
+func ToPreviewToken(t *preview.Token) *PreviewToken {
+	if t == nil {
+		return nil
+	}
+	return &PreviewToken{
+		ID:            IDFrom(t.ID()),
+		ProjectID:     IDFrom(t.Project()),
+		RequestID:     IDFromRef(t.Request()),
+		UserID:        IDFromRef(t.User()),
+		IntegrationID: IDFromRef(t.Integration()),
+		CreatedAt:     t.CreatedAt(),
+		ExpiresAt:     t.ExpiresAt(),
+		RevokedAt:     t.RevokedAt(),
+	}
+}
@@ -0,0 +1,31 @@
+package gqlmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToPreviewToken(t *testing.T) {
+	now := time.Now()
+	rid := id.NewRequestID()
+	uid := accountdomain.NewUserID()
+	tk := preview.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Request(&rid).User(&uid).ExpiresAt(now).MustBuild()
+	tk.Revoke(now)
+
+	assert.Equal(t, &PreviewToken{
+		ID:        IDFrom(tk.ID()),
+		ProjectID: IDFrom(tk.Project()),
+		RequestID: IDFromRef(&rid),
+		UserID:    IDFromRef(&uid),
+		CreatedAt: tk.CreatedAt(),
+		ExpiresAt: now,
+		RevokedAt: &now,
+	}, ToPreviewToken(tk))
+	assert.Nil(t, ToPreviewToken(nil))
+}
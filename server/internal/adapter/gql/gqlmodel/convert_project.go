@@ -1,7 +1,13 @@
 package gqlmodel
 
 import (
+	"slices"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/samber/lo"
 )
@@ -12,16 +18,103 @@ func ToProject(p *project.Project) *Project {
 	}
 
 	return &Project{
-		ID:           IDFrom(p.ID()),
-		WorkspaceID:  IDFrom(p.Workspace()),
-		CreatedAt:    p.CreatedAt(),
-		Alias:        p.Alias(),
-		Name:         p.Name(),
-		Description:  p.Description(),
-		UpdatedAt:    p.UpdatedAt(),
-		Publication:  ToProjectPublication(p.Publication()),
-		RequestRoles: lo.Map(p.RequestRoles(), func(r workspace.Role, _ int) Role { return ToRole(r) }),
+		ID:             IDFrom(p.ID()),
+		WorkspaceID:    IDFrom(p.Workspace()),
+		CreatedAt:      p.CreatedAt(),
+		Alias:          p.Alias(),
+		Name:           p.Name(),
+		Description:    p.Description(),
+		UpdatedAt:      p.UpdatedAt(),
+		Publication:    ToProjectPublication(p.Publication()),
+		RequestRoles:   lo.Map(p.RequestRoles(), func(r workspace.Role, _ int) Role { return ToRole(r) }),
+		Locales:        ToProjectLocales(p.Locales()),
+		ApprovalPolicy: ToProjectApprovalPolicy(p.ApprovalPolicy()),
+	}
+}
+
+func ToProjectApprovalPolicy(p *request.ApprovalPolicy) *ProjectApprovalPolicy {
+	if p == nil {
+		return nil
+	}
+	return &ProjectApprovalPolicy{
+		MinApprovals:        p.MinApprovals(),
+		RequiredReviewerIds: lo.Map(p.RequiredReviewers(), func(u accountdomain.UserID, _ int) ID { return IDFrom(u) }),
+		RequiredRoles:       lo.Map(p.RequiredRoles(), func(r workspace.Role, _ int) Role { return ToRole(r) }),
+		ResetOnChange:       p.ResetOnChange(),
+	}
+}
+
+func FromProjectApprovalPolicyInput(i *UpdateProjectApprovalPolicyInput) (*interfaces.UpdateProjectApprovalPolicyParam, error) {
+	if i == nil {
+		return nil, nil
+	}
+	reviewers, err := ToIDs[accountdomain.User](i.RequiredReviewerIds)
+	if err != nil {
+		return nil, err
+	}
+	return &interfaces.UpdateProjectApprovalPolicyParam{
+		MinApprovals:      i.MinApprovals,
+		RequiredReviewers: reviewers,
+		RequiredRoles:     lo.Map(i.RequiredRoles, func(r Role, _ int) workspace.Role { return FromRole(r) }),
+		ResetOnChange:     lo.FromPtr(i.ResetOnChange),
+	}, nil
+}
+
+func ToProjectLocales(s *locale.Settings) *ProjectLocales {
+	if s == nil {
+		return nil
+	}
+
+	fallbacks := s.Fallbacks()
+	keys := lo.Keys(fallbacks)
+	slices.Sort(keys)
+	return &ProjectLocales{
+		Default: s.Default().String(),
+		Locales: s.Locales().Strings(),
+		Fallbacks: lo.Map(keys, func(l locale.Locale, _ int) *ProjectLocaleFallback {
+			return &ProjectLocaleFallback{Locale: l.String(), Fallback: fallbacks[l].String()}
+		}),
+	}
+}
+
+func FromProjectLocalesInput(i *UpdateProjectLocalesInput) (*interfaces.UpdateProjectLocalesParam, error) {
+	if i == nil {
+		return nil, nil
 	}
+	if lo.FromPtr(i.Default) == "" {
+		return &interfaces.UpdateProjectLocalesParam{}, nil
+	}
+
+	def, err := locale.Parse(*i.Default)
+	if err != nil {
+		return nil, err
+	}
+	locales := make(locale.List, 0, len(i.Locales))
+	for _, l := range i.Locales {
+		pl, err := locale.Parse(l)
+		if err != nil {
+			return nil, err
+		}
+		locales = append(locales, pl)
+	}
+	fallbacks := make(map[locale.Locale]locale.Locale, len(i.Fallbacks))
+	for _, f := range i.Fallbacks {
+		from, err := locale.Parse(f.Locale)
+		if err != nil {
+			return nil, err
+		}
+		to, err := locale.Parse(f.Fallback)
+		if err != nil {
+			return nil, err
+		}
+		fallbacks[from] = to
+	}
+
+	return &interfaces.UpdateProjectLocalesParam{
+		Default:   def,
+		Locales:   locales,
+		Fallbacks: fallbacks,
+	}, nil
 }
 
 func ToProjectPublication(p *project.Publication) *ProjectPublication {
@@ -38,6 +131,7 @@ func ToProjectPublication(p *project.Publication) *ProjectPublication {
 		Scope:       ToProjectPublicationScope(p.Scope()),
 		AssetPublic: p.AssetPublic(),
 		Token:       token,
+		CacheMaxAge: p.CacheMaxAge(),
 	}
 }
 
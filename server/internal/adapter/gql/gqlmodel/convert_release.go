@@ -0,0 +1,51 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/samber/lo"
+)
+
+func ToRelease(r *release.Release) *Release {
+	if r == nil {
+		return nil
+	}
+	return &Release{
+		ID:          IDFrom(r.ID()),
+		WorkspaceID: IDFrom(r.Workspace()),
+		ProjectID:   IDFrom(r.Project()),
+		Name:        r.Name(),
+		Description: r.Description(),
+		State:       ToReleaseState(r.State()),
+		Items:       lo.Map(r.Items(), func(i *release.Item, _ int) *ReleaseItem { return ToReleaseItem(i) }),
+		CreatedAt:   r.CreatedAt(),
+		UpdatedAt:   r.UpdatedAt(),
+		PublishedAt: r.PublishedAt(),
+	}
+}
+
+func ToReleaseItem(i *release.Item) *ReleaseItem {
+	if i == nil {
+		return nil
+	}
+	var previous *string
+	if p := i.Previous(); p != nil {
+		previous = lo.ToPtr(p.String())
+	}
+	return &ReleaseItem{
+		ItemID:          IDFrom(i.Item()),
+		Version:         i.Version().String(),
+		PreviousVersion: previous,
+	}
+}
+
+func ToReleaseState(s release.State) ReleaseState {
+	switch s {
+	case release.StateDraft:
+		return ReleaseStateDraft
+	case release.StatePublished:
+		return ReleaseStatePublished
+	case release.StateRolledBack:
+		return ReleaseStateRolledBack
+	}
+	return ""
+}
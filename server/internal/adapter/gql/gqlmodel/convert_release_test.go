@@ -0,0 +1,57 @@
+package gqlmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToRelease(t *testing.T) {
+	now := time.Now()
+	iid := id.NewItemID()
+	v, prev := version.New(), version.New()
+	it, err := release.NewItemWithPrevious(iid, v, &prev)
+	assert.NoError(t, err)
+	r := release.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Name("spring").Description("desc").State(release.StatePublished).Items(release.ItemList{it}).
+		PublishedAt(&now).UpdatedAt(now).MustBuild()
+
+	assert.Equal(t, &Release{
+		ID:          IDFrom(r.ID()),
+		WorkspaceID: IDFrom(r.Workspace()),
+		ProjectID:   IDFrom(r.Project()),
+		Name:        "spring",
+		Description: "desc",
+		State:       ReleaseStatePublished,
+		Items: []*ReleaseItem{
+			{ItemID: IDFrom(iid), Version: v.String(), PreviousVersion: lo.ToPtr(prev.String())},
+		},
+		CreatedAt:   r.CreatedAt(),
+		UpdatedAt:   now,
+		PublishedAt: &now,
+	}, ToRelease(r))
+	assert.Nil(t, ToRelease(nil))
+}
+
+func TestToReleaseItem(t *testing.T) {
+	iid := id.NewItemID()
+	v := version.New()
+	it, err := release.NewItem(iid, v)
+	assert.NoError(t, err)
+
+	assert.Equal(t, &ReleaseItem{ItemID: IDFrom(iid), Version: v.String()}, ToReleaseItem(it))
+	assert.Nil(t, ToReleaseItem(nil))
+}
+
+func TestToReleaseState(t *testing.T) {
+	assert.Equal(t, ReleaseStateDraft, ToReleaseState(release.StateDraft))
+	assert.Equal(t, ReleaseStatePublished, ToReleaseState(release.StatePublished))
+	assert.Equal(t, ReleaseStateRolledBack, ToReleaseState(release.StateRolledBack))
+	assert.Equal(t, ReleaseState(""), ToReleaseState("unknown"))
+}
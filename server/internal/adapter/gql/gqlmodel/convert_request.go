@@ -40,6 +40,7 @@ func ToRequest(req *request.Request) *Request {
 		ProjectID:   IDFrom(req.Project()),
 		ThreadID:    IDFromRef(req.Thread()),
 		ReviewersID: lo.Map(req.Reviewers(), func(t accountdomain.UserID, _ int) ID { return IDFrom(t) }),
+		Reviews:     lo.Map(req.Reviews(), func(rv *request.Review, _ int) *RequestReview { return ToRequestReview(rv) }),
 		State:       ToRequestState(req.State()),
 		CreatedAt:   req.CreatedAt(),
 		UpdatedAt:   req.UpdatedAt(),
@@ -47,6 +48,29 @@ func ToRequest(req *request.Request) *Request {
 		ClosedAt:    req.ClosedAt(),
 	}
 }
+
+func ToRequestReview(rv *request.Review) *RequestReview {
+	if rv == nil {
+		return nil
+	}
+	return &RequestReview{
+		ReviewerID: IDFrom(rv.Reviewer()),
+		Decision:   ToRequestReviewDecision(rv.Decision()),
+		CreatedAt:  rv.CreatedAt(),
+	}
+}
+
+func ToRequestReviewDecision(d request.Decision) RequestReviewDecision {
+	switch d {
+	case request.DecisionApproved:
+		return RequestReviewDecisionApproved
+	case request.DecisionChangesRequested:
+		return RequestReviewDecisionChangesRequested
+	default:
+		return ""
+	}
+}
+
 func ToRequestState(s request.State) RequestState {
 	switch s {
 	case request.StateApproved:
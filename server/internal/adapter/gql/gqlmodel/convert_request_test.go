@@ -15,6 +15,7 @@ import (
 func TestToRequest(t *testing.T) {
 	ver := version.New().String()
 	itm, _ := request.NewItem(id.NewItemID(), lo.ToPtr(ver))
+	rv := request.NewReview(accountdomain.NewUserID(), request.DecisionApproved, util.Now())
 	req := request.New().
 		NewID().
 		Project(id.NewProjectID()).
@@ -25,6 +26,7 @@ func TestToRequest(t *testing.T) {
 		State(request.StateClosed).
 		Thread(id.NewThreadID().Ref()).
 		Reviewers(accountdomain.UserIDList{accountdomain.NewUserID()}).
+		Reviews([]*request.Review{rv}).
 		CreatedBy(accountdomain.NewUserID()).
 		ClosedAt(lo.ToPtr(util.Now())).
 		ApprovedAt(lo.ToPtr(util.Now())).
@@ -48,6 +50,11 @@ func TestToRequest(t *testing.T) {
 		UpdatedAt:   req.UpdatedAt(),
 		ApprovedAt:  req.ApprovedAt(),
 		ClosedAt:    req.ClosedAt(),
+		Reviews: []*RequestReview{{
+			ReviewerID: IDFrom(rv.Reviewer()),
+			Decision:   RequestReviewDecisionApproved,
+			CreatedAt:  rv.CreatedAt(),
+		}},
 	}, ToRequest(req))
 }
 
@@ -58,3 +65,9 @@ func TestToRequestState(t *testing.T) {
 	assert.Equal(t, RequestStateDraft, ToRequestState(request.StateDraft))
 	assert.Equal(t, RequestState(""), ToRequestState("xxx"))
 }
+
+func TestToRequestReviewDecision(t *testing.T) {
+	assert.Equal(t, RequestReviewDecisionApproved, ToRequestReviewDecision(request.DecisionApproved))
+	assert.Equal(t, RequestReviewDecisionChangesRequested, ToRequestReviewDecision(request.DecisionChangesRequested))
+	assert.Equal(t, RequestReviewDecision(""), ToRequestReviewDecision("xxx"))
+}
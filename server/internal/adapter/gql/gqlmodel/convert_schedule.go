@@ -0,0 +1,24 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+)
+
+func ToItemSchedule(s *schedule.Schedule) *ItemSchedule {
+	if s == nil {
+		return nil
+	}
+	return &ItemSchedule{
+		ID:            IDFrom[id.Schedule](s.ID()),
+		ItemID:        IDFrom[id.Item](s.Item()),
+		ModelID:       IDFrom[id.Model](s.Model()),
+		ProjectID:     IDFrom[id.Project](s.Project()),
+		PublishAt:     s.PublishAt(),
+		UnpublishAt:   s.UnpublishAt(),
+		UserID:        IDFromRef(s.User()),
+		IntegrationID: IDFromRef(s.Integration()),
+		CreatedAt:     s.CreatedAt(),
+		UpdatedAt:     s.UpdatedAt(),
+	}
+}
@@ -0,0 +1,31 @@
+package gqlmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToItemSchedule(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	uid := accountdomain.NewUserID()
+	s := schedule.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Model(id.NewModelID()).Item(id.NewItemID()).
+		PublishAt(lo.ToPtr(now)).User(&uid).UpdatedAt(now).MustBuild()
+
+	assert.Equal(t, &ItemSchedule{
+		ID:        IDFrom(s.ID()),
+		ItemID:    IDFrom(s.Item()),
+		ModelID:   IDFrom(s.Model()),
+		ProjectID: IDFrom(s.Project()),
+		PublishAt: lo.ToPtr(now),
+		UserID:    IDFromRef(&uid),
+		CreatedAt: s.CreatedAt(),
+		UpdatedAt: now,
+	}, ToItemSchedule(s))
+	assert.Nil(t, ToItemSchedule(nil))
+}
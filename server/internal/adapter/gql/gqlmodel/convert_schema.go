@@ -23,7 +23,8 @@ func ToSchema(s *schema.Schema) *Schema {
 		Fields: lo.Map(s.Fields(), func(sf *schema.Field, _ int) *SchemaField {
 			return ToSchemaField(sf, s.TitleField())
 		}),
-		TitleFieldID: IDFromRef(s.TitleField()),
+		TitleFieldID:    IDFromRef(s.TitleField()),
+		ValidationRules: ToValidationRules(s.ValidationRules()),
 	}
 }
 
@@ -44,8 +45,12 @@ func ToSchemaField(sf *schema.Field, titleField *id.FieldID) *SchemaField {
 		Unique:       sf.Unique(),
 		Required:     sf.Required(),
 		IsTitle:      lo.FromPtr(titleField) == sf.ID(),
+		Localized:    sf.Localized(),
 		CreatedAt:    sf.CreatedAt(),
 		UpdatedAt:    sf.UpdatedAt(),
+
+		ValidationRules: ToValidationRules(sf.ValidationRules()),
+		Expression:      lo.EmptyableToPtr(sf.Expression().String()),
 	}
 }
 
@@ -679,3 +684,129 @@ func intPtr(v *int64) *int {
 	}
 	return lo.ToPtr((int)(*v))
 }
+
+func ToValidationRules(rules []*schema.ValidationRule) []*ValidationRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	return lo.Map(rules, func(r *schema.ValidationRule, _ int) *ValidationRule {
+		res := &ValidationRule{
+			Type:    ToValidationRuleType(r.Type()),
+			Message: lo.EmptyableToPtr(r.Message()),
+		}
+		switch r.Type() {
+		case schema.ValidationRuleTypePattern:
+			res.Pattern = lo.ToPtr(r.Pattern())
+		case schema.ValidationRuleTypeMinCount, schema.ValidationRuleTypeMaxCount:
+			res.Count = lo.ToPtr(r.Count())
+		case schema.ValidationRuleTypeCompare:
+			res.FieldID = lo.ToPtr(IDFrom(r.Field()))
+			res.Operator = lo.ToPtr(ToCompareOperator(r.Operator()))
+			res.OtherFieldID = lo.ToPtr(IDFrom(r.OtherField()))
+		}
+		return res
+	})
+}
+
+func FromValidationRules(rules []*ValidationRuleInput) ([]*schema.ValidationRule, error) {
+	if rules == nil {
+		return nil, nil
+	}
+	res := make([]*schema.ValidationRule, 0, len(rules))
+	for _, r := range rules {
+		var fid, oid schema.FieldID
+		var err error
+		if r.FieldID != nil {
+			if fid, err = ToID[id.Field](*r.FieldID); err != nil {
+				return nil, err
+			}
+		}
+		if r.OtherFieldID != nil {
+			if oid, err = ToID[id.Field](*r.OtherFieldID); err != nil {
+				return nil, err
+			}
+		}
+		var op schema.CompareOperator
+		if r.Operator != nil {
+			op = FromCompareOperator(*r.Operator)
+		}
+		vr, err := schema.NewValidationRule(FromValidationRuleType(r.Type), lo.FromPtr(r.Pattern), lo.FromPtr(r.Count), fid, op, oid, lo.FromPtr(r.Message))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, vr)
+	}
+	return res, nil
+}
+
+func ToValidationRuleType(t schema.ValidationRuleType) ValidationRuleType {
+	switch t {
+	case schema.ValidationRuleTypePattern:
+		return ValidationRuleTypePattern
+	case schema.ValidationRuleTypeMinCount:
+		return ValidationRuleTypeMinCount
+	case schema.ValidationRuleTypeMaxCount:
+		return ValidationRuleTypeMaxCount
+	case schema.ValidationRuleTypeCompare:
+		return ValidationRuleTypeCompare
+
+	default:
+		return ""
+	}
+}
+
+func FromValidationRuleType(t ValidationRuleType) schema.ValidationRuleType {
+	switch t {
+	case ValidationRuleTypePattern:
+		return schema.ValidationRuleTypePattern
+	case ValidationRuleTypeMinCount:
+		return schema.ValidationRuleTypeMinCount
+	case ValidationRuleTypeMaxCount:
+		return schema.ValidationRuleTypeMaxCount
+	case ValidationRuleTypeCompare:
+		return schema.ValidationRuleTypeCompare
+
+	default:
+		return ""
+	}
+}
+
+func ToCompareOperator(o schema.CompareOperator) CompareOperator {
+	switch o {
+	case schema.CompareOperatorLessThan:
+		return CompareOperatorLt
+	case schema.CompareOperatorLessThanOrEqual:
+		return CompareOperatorLte
+	case schema.CompareOperatorGreaterThan:
+		return CompareOperatorGt
+	case schema.CompareOperatorGreaterThanOrEqual:
+		return CompareOperatorGte
+	case schema.CompareOperatorEqual:
+		return CompareOperatorEq
+	case schema.CompareOperatorNotEqual:
+		return CompareOperatorNe
+
+	default:
+		return ""
+	}
+}
+
+func FromCompareOperator(o CompareOperator) schema.CompareOperator {
+	switch o {
+	case CompareOperatorLt:
+		return schema.CompareOperatorLessThan
+	case CompareOperatorLte:
+		return schema.CompareOperatorLessThanOrEqual
+	case CompareOperatorGt:
+		return schema.CompareOperatorGreaterThan
+	case CompareOperatorGte:
+		return schema.CompareOperatorGreaterThanOrEqual
+	case CompareOperatorEq:
+		return schema.CompareOperatorEqual
+	case CompareOperatorNe:
+		return schema.CompareOperatorNotEqual
+
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,36 @@
+package gqlmodel
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+)
+
+func ToTrashEntry(e *trash.Entry) *TrashEntry {
+	if e == nil {
+		return nil
+	}
+	return &TrashEntry{
+		ID:            IDFrom[id.Trash](e.ID()),
+		ProjectID:     IDFrom[id.Project](e.Project()),
+		Type:          ToTrashEntryType(e.Type()),
+		ItemID:        IDFromRef(e.Item()),
+		AssetID:       IDFromRef(e.Asset()),
+		ModelID:       IDFromRef(e.Model()),
+		Name:          e.Name(),
+		UserID:        IDFromRef(e.User()),
+		IntegrationID: IDFromRef(e.Integration()),
+		DeletedAt:     e.DeletedAt(),
+	}
+}
+
+func ToTrashEntryType(t trash.Type) TrashEntryType {
+	switch t {
+	case trash.TypeItem:
+		return TrashEntryTypeItem
+	case trash.TypeAsset:
+		return TrashEntryTypeAsset
+	case trash.TypeModel:
+		return TrashEntryTypeModel
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+package gqlmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToTrashEntry(t *testing.T) {
+	now := time.Now()
+	uid := accountdomain.NewUserID()
+	iid := id.NewItemID()
+	mid := id.NewModelID()
+	e := trash.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Item(iid, mid).Name("title").User(&uid).DeletedAt(now).MustBuild()
+
+	assert.Equal(t, &TrashEntry{
+		ID:        IDFrom(e.ID()),
+		ProjectID: IDFrom(e.Project()),
+		Type:      TrashEntryTypeItem,
+		ItemID:    IDFromRef(&iid),
+		ModelID:   IDFromRef(&mid),
+		Name:      "title",
+		UserID:    IDFromRef(&uid),
+		DeletedAt: now,
+	}, ToTrashEntry(e))
+	assert.Nil(t, ToTrashEntry(nil))
+}
+
+func TestToTrashEntryType(t *testing.T) {
+	assert.Equal(t, TrashEntryTypeItem, ToTrashEntryType(trash.TypeItem))
+	assert.Equal(t, TrashEntryTypeAsset, ToTrashEntryType(trash.TypeAsset))
+	assert.Equal(t, TrashEntryTypeModel, ToTrashEntryType(trash.TypeModel))
+	assert.Equal(t, TrashEntryType(""), ToTrashEntryType("unknown"))
+}
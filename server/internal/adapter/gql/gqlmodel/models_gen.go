@@ -51,6 +51,11 @@ type AddIntegrationToWorkspaceInput struct {
 	Role          Role `json:"role"`
 }
 
+type AddReleaseItemsInput struct {
+	ReleaseID ID                  `json:"releaseId"`
+	Items     []*ReleaseItemInput `json:"items"`
+}
+
 type AddUsersToWorkspaceInput struct {
 	WorkspaceID ID             `json:"workspaceId"`
 	Users       []*MemberInput `json:"users"`
@@ -94,11 +99,26 @@ type Asset struct {
 	ArchiveExtractionStatus *ArchiveExtractionStatus `json:"archiveExtractionStatus,omitempty"`
 	Public                  bool                     `json:"public"`
 	ContentType             *string                  `json:"contentType,omitempty"`
+	Metadata                *AssetMetadata           `json:"metadata,omitempty"`
 }
 
 func (Asset) IsNode()        {}
 func (this Asset) GetID() ID { return this.ID }
 
+type AssetBBox struct {
+	MinLng float64 `json:"minLng"`
+	MinLat float64 `json:"minLat"`
+	MaxLng float64 `json:"maxLng"`
+	MaxLat float64 `json:"maxLat"`
+}
+
+type AssetBBoxInput struct {
+	MinLng float64 `json:"minLng"`
+	MinLat float64 `json:"minLat"`
+	MaxLng float64 `json:"maxLng"`
+	MaxLat float64 `json:"maxLat"`
+}
+
 type AssetConnection struct {
 	Edges      []*AssetEdge `json:"edges"`
 	Nodes      []*Asset     `json:"nodes"`
@@ -120,15 +140,53 @@ type AssetFile struct {
 	FilePaths       []string `json:"filePaths,omitempty"`
 }
 
+type AssetGLTFStats struct {
+	Scenes     int `json:"scenes"`
+	Nodes      int `json:"nodes"`
+	Meshes     int `json:"meshes"`
+	Materials  int `json:"materials"`
+	Textures   int `json:"textures"`
+	Animations int `json:"animations"`
+}
+
 type AssetItem struct {
 	ItemID  ID `json:"itemId"`
 	ModelID ID `json:"modelId"`
 }
 
+type AssetLocation struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+type AssetMetadata struct {
+	Width        *int            `json:"width,omitempty"`
+	Height       *int            `json:"height,omitempty"`
+	CapturedAt   *time.Time      `json:"capturedAt,omitempty"`
+	Location     *AssetLocation  `json:"location,omitempty"`
+	Bbox         *AssetBBox      `json:"bbox,omitempty"`
+	FeatureCount *int            `json:"featureCount,omitempty"`
+	Gltf         *AssetGLTFStats `json:"gltf,omitempty"`
+	Headers      []string        `json:"headers,omitempty"`
+	RowCount     *int            `json:"rowCount,omitempty"`
+}
+
+type AssetMetadataFilterInput struct {
+	MinWidth       *int            `json:"minWidth,omitempty"`
+	MaxWidth       *int            `json:"maxWidth,omitempty"`
+	MinHeight      *int            `json:"minHeight,omitempty"`
+	MaxHeight      *int            `json:"maxHeight,omitempty"`
+	CapturedAfter  *time.Time      `json:"capturedAfter,omitempty"`
+	CapturedBefore *time.Time      `json:"capturedBefore,omitempty"`
+	Bbox           *AssetBBoxInput `json:"bbox,omitempty"`
+	Header         *string         `json:"header,omitempty"`
+}
+
 type AssetQueryInput struct {
-	Project      ID                 `json:"project"`
-	Keyword      *string            `json:"keyword,omitempty"`
-	ContentTypes []ContentTypesEnum `json:"contentTypes,omitempty"`
+	Project      ID                        `json:"project"`
+	Keyword      *string                   `json:"keyword,omitempty"`
+	ContentTypes []ContentTypesEnum        `json:"contentTypes,omitempty"`
+	Metadata     *AssetMetadataFilterInput `json:"metadata,omitempty"`
 }
 
 type AssetSort struct {
@@ -164,6 +222,14 @@ type BoolFieldConditionInput struct {
 	Value    bool                `json:"value"`
 }
 
+type CancelItemSchedulesInput struct {
+	ItemIds []ID `json:"itemIds"`
+}
+
+type CancelItemSchedulesPayload struct {
+	ItemIds []ID `json:"itemIds"`
+}
+
 type CesiumResourceProps struct {
 	Name                 string `json:"name"`
 	URL                  string `json:"url"`
@@ -218,6 +284,25 @@ type ConditionInput struct {
 	Time     *TimeFieldConditionInput     `json:"time,omitempty"`
 }
 
+type ConvertFieldInput struct {
+	ModelID      ID                            `json:"modelId"`
+	FieldID      ID                            `json:"fieldId"`
+	Metadata     *bool                         `json:"metadata,omitempty"`
+	Type         SchemaFieldType               `json:"type"`
+	Multiple     *bool                         `json:"multiple,omitempty"`
+	TypeProperty *SchemaFieldTypePropertyInput `json:"typeProperty"`
+	AllVersions  *bool                         `json:"allVersions,omitempty"`
+	DryRun       *bool                         `json:"dryRun,omitempty"`
+}
+
+type ConvertFieldPayload struct {
+	Field     *SchemaField `json:"field"`
+	Items     int          `json:"items"`
+	Converted int          `json:"converted"`
+	Failed    int          `json:"failed"`
+	Lost      int          `json:"lost"`
+}
+
 type CorrespondingFieldInput struct {
 	FieldID     *ID    `json:"fieldId,omitempty"`
 	Title       string `json:"title"`
@@ -257,18 +342,21 @@ type CreateAssetUploadPayload struct {
 }
 
 type CreateFieldInput struct {
-	ModelID      *ID                           `json:"modelId,omitempty"`
-	GroupID      *ID                           `json:"groupId,omitempty"`
-	Type         SchemaFieldType               `json:"type"`
-	Title        string                        `json:"title"`
-	Metadata     *bool                         `json:"metadata,omitempty"`
-	Description  *string                       `json:"description,omitempty"`
-	Key          string                        `json:"key"`
-	Multiple     bool                          `json:"multiple"`
-	Unique       bool                          `json:"unique"`
-	Required     bool                          `json:"required"`
-	IsTitle      bool                          `json:"isTitle"`
-	TypeProperty *SchemaFieldTypePropertyInput `json:"typeProperty"`
+	ModelID         *ID                           `json:"modelId,omitempty"`
+	GroupID         *ID                           `json:"groupId,omitempty"`
+	Type            SchemaFieldType               `json:"type"`
+	Title           string                        `json:"title"`
+	Metadata        *bool                         `json:"metadata,omitempty"`
+	Description     *string                       `json:"description,omitempty"`
+	Key             string                        `json:"key"`
+	Multiple        bool                          `json:"multiple"`
+	Unique          bool                          `json:"unique"`
+	Required        bool                          `json:"required"`
+	IsTitle         bool                          `json:"isTitle"`
+	Localized       *bool                         `json:"localized,omitempty"`
+	TypeProperty    *SchemaFieldTypePropertyInput `json:"typeProperty"`
+	ValidationRules []*ValidationRuleInput        `json:"validationRules,omitempty"`
+	Expression      *string                       `json:"expression,omitempty"`
 }
 
 type CreateGroupInput struct {
@@ -285,6 +373,12 @@ type CreateIntegrationInput struct {
 	Type        IntegrationType `json:"type"`
 }
 
+type CreateItemFromTemplateInput struct {
+	TemplateID     ID                `json:"templateId"`
+	Fields         []*ItemFieldInput `json:"fields,omitempty"`
+	MetadataFields []*ItemFieldInput `json:"metadataFields,omitempty"`
+}
+
 type CreateItemInput struct {
 	SchemaID   ID                `json:"schemaId"`
 	ModelID    ID                `json:"modelId"`
@@ -293,6 +387,15 @@ type CreateItemInput struct {
 	Fields     []*ItemFieldInput `json:"fields"`
 }
 
+type CreateItemTemplateInput struct {
+	ModelID        ID                `json:"modelId"`
+	Name           string            `json:"name"`
+	Description    *string           `json:"description,omitempty"`
+	Fields         []*ItemFieldInput `json:"fields,omitempty"`
+	MetadataFields []*ItemFieldInput `json:"metadataFields,omitempty"`
+	SourceItemID   *ID               `json:"sourceItemId,omitempty"`
+}
+
 type CreateModelInput struct {
 	ProjectID   ID      `json:"projectId"`
 	Name        *string `json:"name,omitempty"`
@@ -308,6 +411,12 @@ type CreateProjectInput struct {
 	RequestRoles []Role  `json:"requestRoles,omitempty"`
 }
 
+type CreateReleaseInput struct {
+	ProjectID   ID      `json:"projectId"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+}
+
 type CreateRequestInput struct {
 	ProjectID   ID                  `json:"projectId"`
 	Title       string              `json:"title"`
@@ -427,6 +536,14 @@ type DeleteItemPayload struct {
 	ItemID ID `json:"itemId"`
 }
 
+type DeleteItemTemplateInput struct {
+	TemplateID ID `json:"templateId"`
+}
+
+type DeleteItemTemplatePayload struct {
+	TemplateID ID `json:"templateId"`
+}
+
 type DeleteMeInput struct {
 	UserID ID `json:"userId"`
 }
@@ -451,6 +568,14 @@ type DeleteProjectPayload struct {
 	ProjectID ID `json:"projectId"`
 }
 
+type DeleteReleaseInput struct {
+	ReleaseID ID `json:"releaseId"`
+}
+
+type DeleteReleasePayload struct {
+	ReleaseID ID `json:"releaseId"`
+}
+
 type DeleteRequestInput struct {
 	ProjectID  ID   `json:"projectId"`
 	RequestsID []ID `json:"requestsId"`
@@ -485,6 +610,10 @@ type DeleteWorkspacePayload struct {
 	WorkspaceID ID `json:"workspaceId"`
 }
 
+type DuplicateItemInput struct {
+	ItemID ID `json:"itemId"`
+}
+
 type FieldPayload struct {
 	Field *SchemaField `json:"field"`
 }
@@ -570,6 +699,17 @@ type IntegrationPayload struct {
 	Integration *Integration `json:"integration"`
 }
 
+type IssuePreviewTokenInput struct {
+	ProjectID ID   `json:"projectId"`
+	RequestID *ID  `json:"requestId,omitempty"`
+	TTL       *int `json:"ttl,omitempty"`
+}
+
+type IssuePreviewTokenPayload struct {
+	PreviewToken *PreviewToken `json:"previewToken"`
+	Token        string        `json:"token"`
+}
+
 type Item struct {
 	ID                     ID           `json:"id"`
 	SchemaID               ID           `json:"schemaId"`
@@ -612,16 +752,35 @@ type ItemConnection struct {
 	TotalCount int         `json:"totalCount"`
 }
 
+type ItemDiff struct {
+	Fields             []*ItemFieldChange `json:"fields"`
+	MetadataChanged    bool               `json:"metadataChanged"`
+	PreviousMetadataID *ID                `json:"previousMetadataId,omitempty"`
+	CurrentMetadataID  *ID                `json:"currentMetadataId,omitempty"`
+	MetadataFields     []*ItemFieldChange `json:"metadataFields"`
+}
+
 type ItemEdge struct {
 	Cursor usecasex.Cursor `json:"cursor"`
 	Node   *Item           `json:"node,omitempty"`
 }
 
 type ItemField struct {
-	SchemaFieldID ID              `json:"schemaFieldId"`
-	ItemGroupID   *ID             `json:"itemGroupId,omitempty"`
-	Type          SchemaFieldType `json:"type"`
-	Value         any             `json:"value,omitempty"`
+	SchemaFieldID ID                      `json:"schemaFieldId"`
+	ItemGroupID   *ID                     `json:"itemGroupId,omitempty"`
+	Type          SchemaFieldType         `json:"type"`
+	Value         any                     `json:"value,omitempty"`
+	Locales       []*ItemFieldLocaleValue `json:"locales,omitempty"`
+}
+
+type ItemFieldChange struct {
+	SchemaFieldID ID                  `json:"schemaFieldId"`
+	ItemGroupID   *ID                 `json:"itemGroupId,omitempty"`
+	Type          ItemFieldChangeType `json:"type"`
+	PreviousValue any                 `json:"previousValue,omitempty"`
+	CurrentValue  any                 `json:"currentValue,omitempty"`
+	AddedValues   []any               `json:"addedValues,omitempty"`
+	RemovedValues []any               `json:"removedValues,omitempty"`
 }
 
 type ItemFieldInput struct {
@@ -629,6 +788,12 @@ type ItemFieldInput struct {
 	ItemGroupID   *ID             `json:"itemGroupId,omitempty"`
 	Type          SchemaFieldType `json:"type"`
 	Value         any             `json:"value"`
+	Locale        *string         `json:"locale,omitempty"`
+}
+
+type ItemFieldLocaleValue struct {
+	Locale string `json:"locale"`
+	Value  any    `json:"value,omitempty"`
 }
 
 type ItemPayload struct {
@@ -642,6 +807,21 @@ type ItemQueryInput struct {
 	Q       *string `json:"q,omitempty"`
 }
 
+type ItemSchedule struct {
+	ID            ID         `json:"id"`
+	ItemID        ID         `json:"itemId"`
+	ModelID       ID         `json:"modelId"`
+	ProjectID     ID         `json:"projectId"`
+	PublishAt     *time.Time `json:"publishAt,omitempty"`
+	UnpublishAt   *time.Time `json:"unpublishAt,omitempty"`
+	UserID        *ID        `json:"userId,omitempty"`
+	IntegrationID *ID        `json:"integrationId,omitempty"`
+	CreatedBy     Operator   `json:"createdBy,omitempty"`
+	Item          *Item      `json:"item,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
 type ItemSort struct {
 	Field     *FieldSelector `json:"field"`
 	Direction *SortDirection `json:"direction,omitempty"`
@@ -652,11 +832,43 @@ type ItemSortInput struct {
 	Direction *SortDirection      `json:"direction,omitempty"`
 }
 
+type ItemTemplate struct {
+	ID             ID           `json:"id"`
+	ModelID        ID           `json:"modelId"`
+	ProjectID      ID           `json:"projectId"`
+	Name           string       `json:"name"`
+	Description    string       `json:"description"`
+	Fields         []*ItemField `json:"fields"`
+	MetadataFields []*ItemField `json:"metadataFields"`
+	UserID         *ID          `json:"userId,omitempty"`
+	IntegrationID  *ID          `json:"integrationId,omitempty"`
+	CreatedBy      Operator     `json:"createdBy,omitempty"`
+	Model          *Model       `json:"model,omitempty"`
+	CreatedAt      time.Time    `json:"createdAt"`
+	UpdatedAt      time.Time    `json:"updatedAt"`
+}
+
+type ItemTemplatePayload struct {
+	ItemTemplate *ItemTemplate `json:"itemTemplate"`
+}
+
 type KeyAvailability struct {
 	Key       string `json:"key"`
 	Available bool   `json:"available"`
 }
 
+type MarkAllNotificationsReadPayload struct {
+	UnreadCount int `json:"unreadCount"`
+}
+
+type MarkNotificationsReadInput struct {
+	NotificationIds []ID `json:"notificationIds"`
+}
+
+type MarkNotificationsReadPayload struct {
+	Notifications []*Notification `json:"notifications"`
+}
+
 type Me struct {
 	ID            ID             `json:"id"`
 	Name          string         `json:"name"`
@@ -733,6 +945,53 @@ type MultipleFieldConditionInput struct {
 type Mutation struct {
 }
 
+type Notification struct {
+	ID          ID               `json:"id"`
+	Type        NotificationType `json:"type"`
+	WorkspaceID ID               `json:"workspaceId"`
+	ProjectID   ID               `json:"projectId"`
+	RequestID   ID               `json:"requestId"`
+	ActorID     *ID              `json:"actorId,omitempty"`
+	CommentID   *ID              `json:"commentId,omitempty"`
+	Read        bool             `json:"read"`
+	ReadAt      *time.Time       `json:"readAt,omitempty"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	Request     *Request         `json:"request,omitempty"`
+	Actor       *User            `json:"actor,omitempty"`
+}
+
+type NotificationConnection struct {
+	Edges      []*NotificationEdge `json:"edges"`
+	Nodes      []*Notification     `json:"nodes"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+type NotificationEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *Notification   `json:"node,omitempty"`
+}
+
+type NotificationPreference struct {
+	Settings []*NotificationSetting `json:"settings"`
+}
+
+type NotificationPreferencePayload struct {
+	NotificationPreference *NotificationPreference `json:"notificationPreference"`
+}
+
+type NotificationSetting struct {
+	Type  NotificationType `json:"type"`
+	Email bool             `json:"email"`
+	InApp bool             `json:"inApp"`
+}
+
+type NotificationSettingInput struct {
+	Type  NotificationType `json:"type"`
+	Email bool             `json:"email"`
+	InApp bool             `json:"inApp"`
+}
+
 type NullableFieldCondition struct {
 	FieldID  *FieldSelector   `json:"fieldId"`
 	Operator NullableOperator `json:"operator"`
@@ -793,17 +1052,43 @@ type Pagination struct {
 	Before *usecasex.Cursor `json:"before,omitempty"`
 }
 
+type PreviewToken struct {
+	ID            ID         `json:"id"`
+	ProjectID     ID         `json:"projectId"`
+	RequestID     *ID        `json:"requestId,omitempty"`
+	UserID        *ID        `json:"userId,omitempty"`
+	IntegrationID *ID        `json:"integrationId,omitempty"`
+	CreatedBy     Operator   `json:"createdBy,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	ExpiresAt     time.Time  `json:"expiresAt"`
+	RevokedAt     *time.Time `json:"revokedAt,omitempty"`
+}
+
+type PreviewTokenConnection struct {
+	Edges      []*PreviewTokenEdge `json:"edges"`
+	Nodes      []*PreviewToken     `json:"nodes"`
+	PageInfo   *PageInfo           `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
+}
+
+type PreviewTokenEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *PreviewToken   `json:"node,omitempty"`
+}
+
 type Project struct {
-	ID           ID                  `json:"id"`
-	Name         string              `json:"name"`
-	Description  string              `json:"description"`
-	Alias        string              `json:"alias"`
-	WorkspaceID  ID                  `json:"workspaceId"`
-	Workspace    *Workspace          `json:"workspace,omitempty"`
-	CreatedAt    time.Time           `json:"createdAt"`
-	UpdatedAt    time.Time           `json:"updatedAt"`
-	Publication  *ProjectPublication `json:"publication,omitempty"`
-	RequestRoles []Role              `json:"requestRoles,omitempty"`
+	ID             ID                     `json:"id"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description"`
+	Alias          string                 `json:"alias"`
+	WorkspaceID    ID                     `json:"workspaceId"`
+	Workspace      *Workspace             `json:"workspace,omitempty"`
+	CreatedAt      time.Time              `json:"createdAt"`
+	UpdatedAt      time.Time              `json:"updatedAt"`
+	Publication    *ProjectPublication    `json:"publication,omitempty"`
+	RequestRoles   []Role                 `json:"requestRoles,omitempty"`
+	Locales        *ProjectLocales        `json:"locales,omitempty"`
+	ApprovalPolicy *ProjectApprovalPolicy `json:"approvalPolicy,omitempty"`
 }
 
 func (Project) IsNode()        {}
@@ -814,6 +1099,13 @@ type ProjectAliasAvailability struct {
 	Available bool   `json:"available"`
 }
 
+type ProjectApprovalPolicy struct {
+	MinApprovals        int    `json:"minApprovals"`
+	RequiredReviewerIds []ID   `json:"requiredReviewerIds"`
+	RequiredRoles       []Role `json:"requiredRoles"`
+	ResetOnChange       bool   `json:"resetOnChange"`
+}
+
 type ProjectConnection struct {
 	Edges      []*ProjectEdge `json:"edges"`
 	Nodes      []*Project     `json:"nodes"`
@@ -826,6 +1118,22 @@ type ProjectEdge struct {
 	Node   *Project        `json:"node,omitempty"`
 }
 
+type ProjectLocaleFallback struct {
+	Locale   string `json:"locale"`
+	Fallback string `json:"fallback"`
+}
+
+type ProjectLocaleFallbackInput struct {
+	Locale   string `json:"locale"`
+	Fallback string `json:"fallback"`
+}
+
+type ProjectLocales struct {
+	Default   string                   `json:"default"`
+	Locales   []string                 `json:"locales"`
+	Fallbacks []*ProjectLocaleFallback `json:"fallbacks"`
+}
+
 type ProjectPayload struct {
 	Project *Project `json:"project"`
 }
@@ -834,6 +1142,7 @@ type ProjectPublication struct {
 	Scope       ProjectPublicationScope `json:"scope"`
 	AssetPublic bool                    `json:"assetPublic"`
 	Token       *string                 `json:"token,omitempty"`
+	CacheMaxAge int                     `json:"cacheMaxAge"`
 }
 
 type PublishItemInput struct {
@@ -862,6 +1171,10 @@ type PublishModelsPayload struct {
 	Models []*PublishModelPayload `json:"models"`
 }
 
+type PublishReleaseInput struct {
+	ReleaseID ID `json:"releaseId"`
+}
+
 type Query struct {
 }
 
@@ -873,6 +1186,48 @@ type RegeneratePublicAPITokenInput struct {
 	ProjectID ID `json:"projectId"`
 }
 
+type Release struct {
+	ID          ID             `json:"id"`
+	WorkspaceID ID             `json:"workspaceId"`
+	ProjectID   ID             `json:"projectId"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	State       ReleaseState   `json:"state"`
+	Items       []*ReleaseItem `json:"items"`
+	CreatedAt   time.Time      `json:"createdAt"`
+	UpdatedAt   time.Time      `json:"updatedAt"`
+	PublishedAt *time.Time     `json:"publishedAt,omitempty"`
+	Project     *Project       `json:"project,omitempty"`
+}
+
+type ReleaseConnection struct {
+	Edges      []*ReleaseEdge `json:"edges"`
+	Nodes      []*Release     `json:"nodes"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+type ReleaseEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *Release        `json:"node,omitempty"`
+}
+
+type ReleaseItem struct {
+	ItemID          ID             `json:"itemId"`
+	Version         string         `json:"version"`
+	PreviousVersion *string        `json:"previousVersion,omitempty"`
+	Item            *VersionedItem `json:"item,omitempty"`
+}
+
+type ReleaseItemInput struct {
+	ItemID  ID      `json:"itemId"`
+	Version *string `json:"version,omitempty"`
+}
+
+type ReleasePayload struct {
+	Release *Release `json:"release"`
+}
+
 type RemoveIntegrationFromWorkspaceInput struct {
 	WorkspaceID   ID `json:"workspaceId"`
 	IntegrationID ID `json:"integrationId"`
@@ -904,31 +1259,41 @@ type RemoveMyAuthInput struct {
 	Auth string `json:"auth"`
 }
 
+type RemoveReleaseItemsInput struct {
+	ReleaseID ID   `json:"releaseId"`
+	ItemIds   []ID `json:"itemIds"`
+}
+
 type Request struct {
-	ID          ID             `json:"id"`
-	Items       []*RequestItem `json:"items"`
-	Title       string         `json:"title"`
-	Description *string        `json:"description,omitempty"`
-	CreatedByID ID             `json:"createdById"`
-	WorkspaceID ID             `json:"workspaceId"`
-	ProjectID   ID             `json:"projectId"`
-	ThreadID    *ID            `json:"threadId,omitempty"`
-	ReviewersID []ID           `json:"reviewersId"`
-	State       RequestState   `json:"state"`
-	CreatedAt   time.Time      `json:"createdAt"`
-	UpdatedAt   time.Time      `json:"updatedAt"`
-	ApprovedAt  *time.Time     `json:"approvedAt,omitempty"`
-	ClosedAt    *time.Time     `json:"closedAt,omitempty"`
-	Thread      *Thread        `json:"thread,omitempty"`
-	CreatedBy   *User          `json:"createdBy,omitempty"`
-	Workspace   *Workspace     `json:"workspace,omitempty"`
-	Project     *Project       `json:"project,omitempty"`
-	Reviewers   []*User        `json:"reviewers"`
+	ID          ID               `json:"id"`
+	Items       []*RequestItem   `json:"items"`
+	Title       string           `json:"title"`
+	Description *string          `json:"description,omitempty"`
+	CreatedByID ID               `json:"createdById"`
+	WorkspaceID ID               `json:"workspaceId"`
+	ProjectID   ID               `json:"projectId"`
+	ThreadID    *ID              `json:"threadId,omitempty"`
+	ReviewersID []ID             `json:"reviewersId"`
+	Reviews     []*RequestReview `json:"reviews"`
+	State       RequestState     `json:"state"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	UpdatedAt   time.Time        `json:"updatedAt"`
+	ApprovedAt  *time.Time       `json:"approvedAt,omitempty"`
+	ClosedAt    *time.Time       `json:"closedAt,omitempty"`
+	Thread      *Thread          `json:"thread,omitempty"`
+	CreatedBy   *User            `json:"createdBy,omitempty"`
+	Workspace   *Workspace       `json:"workspace,omitempty"`
+	Project     *Project         `json:"project,omitempty"`
+	Reviewers   []*User          `json:"reviewers"`
 }
 
 func (Request) IsNode()        {}
 func (this Request) GetID() ID { return this.ID }
 
+type RequestChangesInput struct {
+	RequestID ID `json:"requestId"`
+}
+
 type RequestConnection struct {
 	Edges      []*RequestEdge `json:"edges"`
 	Nodes      []*Request     `json:"nodes"`
@@ -957,6 +1322,13 @@ type RequestPayload struct {
 	Request *Request `json:"request"`
 }
 
+type RequestReview struct {
+	ReviewerID ID                    `json:"reviewerId"`
+	Reviewer   *User                 `json:"reviewer,omitempty"`
+	Decision   RequestReviewDecision `json:"decision"`
+	CreatedAt  time.Time             `json:"createdAt"`
+}
+
 type ResourceInput struct {
 	Tile    *TileResourceInput    `json:"tile,omitempty"`
 	Terrain *TerrainResourceInput `json:"terrain,omitempty"`
@@ -974,36 +1346,82 @@ type ResourcesListInput struct {
 	Enabled          *bool            `json:"enabled,omitempty"`
 }
 
+type RestoreItemInput struct {
+	ItemID  ID     `json:"itemId"`
+	Version string `json:"version"`
+}
+
+type RestoreItemPayload struct {
+	Item                *Item              `json:"item"`
+	Changes             []*ItemFieldChange `json:"changes"`
+	RemovedFieldIds     []ID               `json:"removedFieldIds"`
+	TypeChangedFieldIds []ID               `json:"typeChangedFieldIds"`
+}
+
+type RestoreTrashEntryInput struct {
+	TrashEntryID ID `json:"trashEntryId"`
+}
+
+type RestoreTrashEntryPayload struct {
+	TrashEntry *TrashEntry `json:"trashEntry"`
+}
+
+type RevokePreviewTokenInput struct {
+	PreviewTokenID ID `json:"previewTokenId"`
+}
+
+type RevokePreviewTokenPayload struct {
+	PreviewToken *PreviewToken `json:"previewToken"`
+}
+
+type RollbackReleaseInput struct {
+	ReleaseID ID `json:"releaseId"`
+}
+
+type ScheduleItemsInput struct {
+	ItemIds     []ID       `json:"itemIds"`
+	PublishAt   *time.Time `json:"publishAt,omitempty"`
+	UnpublishAt *time.Time `json:"unpublishAt,omitempty"`
+}
+
+type ScheduleItemsPayload struct {
+	Schedules []*ItemSchedule `json:"schedules"`
+}
+
 type Schema struct {
-	ID           ID             `json:"id"`
-	ProjectID    ID             `json:"projectId"`
-	Fields       []*SchemaField `json:"fields"`
-	TitleFieldID *ID            `json:"titleFieldId,omitempty"`
-	TitleField   *SchemaField   `json:"titleField,omitempty"`
-	Project      *Project       `json:"project"`
+	ID              ID                `json:"id"`
+	ProjectID       ID                `json:"projectId"`
+	Fields          []*SchemaField    `json:"fields"`
+	TitleFieldID    *ID               `json:"titleFieldId,omitempty"`
+	TitleField      *SchemaField      `json:"titleField,omitempty"`
+	ValidationRules []*ValidationRule `json:"validationRules"`
+	Project         *Project          `json:"project"`
 }
 
 func (Schema) IsNode()        {}
 func (this Schema) GetID() ID { return this.ID }
 
 type SchemaField struct {
-	ID           ID                      `json:"id"`
-	ModelID      *ID                     `json:"modelId,omitempty"`
-	GroupID      *ID                     `json:"groupId,omitempty"`
-	Model        *Model                  `json:"model,omitempty"`
-	Group        *Group                  `json:"group,omitempty"`
-	Type         SchemaFieldType         `json:"type"`
-	TypeProperty SchemaFieldTypeProperty `json:"typeProperty,omitempty"`
-	Key          string                  `json:"key"`
-	Title        string                  `json:"title"`
-	Order        *int                    `json:"order,omitempty"`
-	Description  *string                 `json:"description,omitempty"`
-	Multiple     bool                    `json:"multiple"`
-	Unique       bool                    `json:"unique"`
-	Required     bool                    `json:"required"`
-	IsTitle      bool                    `json:"isTitle"`
-	CreatedAt    time.Time               `json:"createdAt"`
-	UpdatedAt    time.Time               `json:"updatedAt"`
+	ID              ID                      `json:"id"`
+	ModelID         *ID                     `json:"modelId,omitempty"`
+	GroupID         *ID                     `json:"groupId,omitempty"`
+	Model           *Model                  `json:"model,omitempty"`
+	Group           *Group                  `json:"group,omitempty"`
+	Type            SchemaFieldType         `json:"type"`
+	TypeProperty    SchemaFieldTypeProperty `json:"typeProperty,omitempty"`
+	Key             string                  `json:"key"`
+	Title           string                  `json:"title"`
+	Order           *int                    `json:"order,omitempty"`
+	Description     *string                 `json:"description,omitempty"`
+	Multiple        bool                    `json:"multiple"`
+	Unique          bool                    `json:"unique"`
+	Required        bool                    `json:"required"`
+	IsTitle         bool                    `json:"isTitle"`
+	Localized       bool                    `json:"localized"`
+	ValidationRules []*ValidationRule       `json:"validationRules"`
+	Expression      *string                 `json:"expression,omitempty"`
+	CreatedAt       time.Time               `json:"createdAt"`
+	UpdatedAt       time.Time               `json:"updatedAt"`
 }
 
 type SchemaFieldAsset struct {
@@ -1242,6 +1660,10 @@ type SchemaMarkdownTextInput struct {
 	MaxLength    *int `json:"maxLength,omitempty"`
 }
 
+type SchemaPayload struct {
+	Schema *Schema `json:"schema"`
+}
+
 type SearchAssetsInput struct {
 	Query      *AssetQueryInput `json:"query"`
 	Sort       *AssetSort       `json:"sort,omitempty"`
@@ -1323,6 +1745,32 @@ type TimeFieldConditionInput struct {
 	Value    time.Time           `json:"value"`
 }
 
+type TrashEntry struct {
+	ID            ID             `json:"id"`
+	ProjectID     ID             `json:"projectId"`
+	Type          TrashEntryType `json:"type"`
+	ItemID        *ID            `json:"itemId,omitempty"`
+	AssetID       *ID            `json:"assetId,omitempty"`
+	ModelID       *ID            `json:"modelId,omitempty"`
+	Name          string         `json:"name"`
+	UserID        *ID            `json:"userId,omitempty"`
+	IntegrationID *ID            `json:"integrationId,omitempty"`
+	DeletedBy     Operator       `json:"deletedBy,omitempty"`
+	DeletedAt     time.Time      `json:"deletedAt"`
+}
+
+type TrashEntryConnection struct {
+	Edges      []*TrashEntryEdge `json:"edges"`
+	Nodes      []*TrashEntry     `json:"nodes"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+type TrashEntryEdge struct {
+	Cursor usecasex.Cursor `json:"cursor"`
+	Node   *TrashEntry     `json:"node,omitempty"`
+}
+
 type UnpublishItemInput struct {
 	ItemIds []ID `json:"itemIds"`
 }
@@ -1347,19 +1795,22 @@ type UpdateCommentInput struct {
 }
 
 type UpdateFieldInput struct {
-	ModelID      *ID                           `json:"modelId,omitempty"`
-	GroupID      *ID                           `json:"groupId,omitempty"`
-	FieldID      ID                            `json:"fieldId"`
-	Title        *string                       `json:"title,omitempty"`
-	Description  *string                       `json:"description,omitempty"`
-	Order        *int                          `json:"order,omitempty"`
-	Metadata     *bool                         `json:"metadata,omitempty"`
-	Key          *string                       `json:"key,omitempty"`
-	Required     *bool                         `json:"required,omitempty"`
-	Unique       *bool                         `json:"unique,omitempty"`
-	Multiple     *bool                         `json:"multiple,omitempty"`
-	IsTitle      *bool                         `json:"isTitle,omitempty"`
-	TypeProperty *SchemaFieldTypePropertyInput `json:"typeProperty,omitempty"`
+	ModelID         *ID                           `json:"modelId,omitempty"`
+	GroupID         *ID                           `json:"groupId,omitempty"`
+	FieldID         ID                            `json:"fieldId"`
+	Title           *string                       `json:"title,omitempty"`
+	Description     *string                       `json:"description,omitempty"`
+	Order           *int                          `json:"order,omitempty"`
+	Metadata        *bool                         `json:"metadata,omitempty"`
+	Key             *string                       `json:"key,omitempty"`
+	Required        *bool                         `json:"required,omitempty"`
+	Unique          *bool                         `json:"unique,omitempty"`
+	Multiple        *bool                         `json:"multiple,omitempty"`
+	IsTitle         *bool                         `json:"isTitle,omitempty"`
+	Localized       *bool                         `json:"localized,omitempty"`
+	TypeProperty    *SchemaFieldTypePropertyInput `json:"typeProperty,omitempty"`
+	ValidationRules []*ValidationRuleInput        `json:"validationRules,omitempty"`
+	Expression      *string                       `json:"expression,omitempty"`
 }
 
 type UpdateGroupInput struct {
@@ -1394,6 +1845,14 @@ type UpdateItemInput struct {
 	Version    *string           `json:"version,omitempty"`
 }
 
+type UpdateItemTemplateInput struct {
+	TemplateID     ID                `json:"templateId"`
+	Name           *string           `json:"name,omitempty"`
+	Description    *string           `json:"description,omitempty"`
+	Fields         []*ItemFieldInput `json:"fields,omitempty"`
+	MetadataFields []*ItemFieldInput `json:"metadataFields,omitempty"`
+}
+
 type UpdateMeInput struct {
 	Name                 *string       `json:"name,omitempty"`
 	Email                *string       `json:"email,omitempty"`
@@ -1423,18 +1882,44 @@ type UpdateModelsOrderInput struct {
 	ModelIds []ID `json:"modelIds"`
 }
 
+type UpdateNotificationPreferenceInput struct {
+	Settings []*NotificationSettingInput `json:"settings"`
+}
+
+type UpdateProjectApprovalPolicyInput struct {
+	MinApprovals        int    `json:"minApprovals"`
+	RequiredReviewerIds []ID   `json:"requiredReviewerIds,omitempty"`
+	RequiredRoles       []Role `json:"requiredRoles,omitempty"`
+	ResetOnChange       *bool  `json:"resetOnChange,omitempty"`
+}
+
 type UpdateProjectInput struct {
-	ProjectID    ID                             `json:"projectId"`
-	Name         *string                        `json:"name,omitempty"`
-	Description  *string                        `json:"description,omitempty"`
-	Alias        *string                        `json:"alias,omitempty"`
-	Publication  *UpdateProjectPublicationInput `json:"publication,omitempty"`
-	RequestRoles []Role                         `json:"requestRoles,omitempty"`
+	ProjectID      ID                                `json:"projectId"`
+	Name           *string                           `json:"name,omitempty"`
+	Description    *string                           `json:"description,omitempty"`
+	Alias          *string                           `json:"alias,omitempty"`
+	Publication    *UpdateProjectPublicationInput    `json:"publication,omitempty"`
+	RequestRoles   []Role                            `json:"requestRoles,omitempty"`
+	Locales        *UpdateProjectLocalesInput        `json:"locales,omitempty"`
+	ApprovalPolicy *UpdateProjectApprovalPolicyInput `json:"approvalPolicy,omitempty"`
+}
+
+type UpdateProjectLocalesInput struct {
+	Default   *string                       `json:"default,omitempty"`
+	Locales   []string                      `json:"locales,omitempty"`
+	Fallbacks []*ProjectLocaleFallbackInput `json:"fallbacks,omitempty"`
 }
 
 type UpdateProjectPublicationInput struct {
 	Scope       *ProjectPublicationScope `json:"scope,omitempty"`
 	AssetPublic *bool                    `json:"assetPublic,omitempty"`
+	CacheMaxAge *int                     `json:"cacheMaxAge,omitempty"`
+}
+
+type UpdateReleaseInput struct {
+	ReleaseID   ID      `json:"releaseId"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 type UpdateRequestInput struct {
@@ -1452,6 +1937,13 @@ type UpdateUserOfWorkspaceInput struct {
 	Role        Role `json:"role"`
 }
 
+type UpdateValidationRulesInput struct {
+	ModelID  *ID                    `json:"modelId,omitempty"`
+	GroupID  *ID                    `json:"groupId,omitempty"`
+	Metadata *bool                  `json:"metadata,omitempty"`
+	Rules    []*ValidationRuleInput `json:"rules"`
+}
+
 type UpdateViewInput struct {
 	ViewID  ID                      `json:"viewId"`
 	Name    *string                 `json:"name,omitempty"`
@@ -1517,6 +2009,26 @@ func (User) IsOperator() {}
 func (User) IsNode()        {}
 func (this User) GetID() ID { return this.ID }
 
+type ValidationRule struct {
+	Type         ValidationRuleType `json:"type"`
+	Pattern      *string            `json:"pattern,omitempty"`
+	Count        *int               `json:"count,omitempty"`
+	FieldID      *ID                `json:"fieldId,omitempty"`
+	Operator     *CompareOperator   `json:"operator,omitempty"`
+	OtherFieldID *ID                `json:"otherFieldId,omitempty"`
+	Message      *string            `json:"message,omitempty"`
+}
+
+type ValidationRuleInput struct {
+	Type         ValidationRuleType `json:"type"`
+	Pattern      *string            `json:"pattern,omitempty"`
+	Count        *int               `json:"count,omitempty"`
+	FieldID      *ID                `json:"fieldId,omitempty"`
+	Operator     *CompareOperator   `json:"operator,omitempty"`
+	OtherFieldID *ID                `json:"otherFieldId,omitempty"`
+	Message      *string            `json:"message,omitempty"`
+}
+
 type VersionedItem struct {
 	Version string   `json:"version"`
 	Parents []string `json:"parents,omitempty"`
@@ -1570,6 +2082,15 @@ type WebhookTrigger struct {
 	OnAssetUpload     *bool `json:"onAssetUpload,omitempty"`
 	OnAssetDecompress *bool `json:"onAssetDecompress,omitempty"`
 	OnAssetDelete     *bool `json:"onAssetDelete,omitempty"`
+	OnRequestCreate   *bool `json:"onRequestCreate,omitempty"`
+	OnRequestSubmit   *bool `json:"onRequestSubmit,omitempty"`
+	OnRequestReview   *bool `json:"onRequestReview,omitempty"`
+	OnRequestApprove  *bool `json:"onRequestApprove,omitempty"`
+	OnRequestClose    *bool `json:"onRequestClose,omitempty"`
+	OnRequestComment  *bool `json:"onRequestComment,omitempty"`
+	OnCommentCreate   *bool `json:"onCommentCreate,omitempty"`
+	OnCommentUpdate   *bool `json:"onCommentUpdate,omitempty"`
+	OnCommentDelete   *bool `json:"onCommentDelete,omitempty"`
 }
 
 type WebhookTriggerInput struct {
@@ -1581,6 +2102,15 @@ type WebhookTriggerInput struct {
 	OnAssetUpload     *bool `json:"onAssetUpload,omitempty"`
 	OnAssetDecompress *bool `json:"onAssetDecompress,omitempty"`
 	OnAssetDelete     *bool `json:"onAssetDelete,omitempty"`
+	OnRequestCreate   *bool `json:"onRequestCreate,omitempty"`
+	OnRequestSubmit   *bool `json:"onRequestSubmit,omitempty"`
+	OnRequestReview   *bool `json:"onRequestReview,omitempty"`
+	OnRequestApprove  *bool `json:"onRequestApprove,omitempty"`
+	OnRequestClose    *bool `json:"onRequestClose,omitempty"`
+	OnRequestComment  *bool `json:"onRequestComment,omitempty"`
+	OnCommentCreate   *bool `json:"onCommentCreate,omitempty"`
+	OnCommentUpdate   *bool `json:"onCommentUpdate,omitempty"`
+	OnCommentDelete   *bool `json:"onCommentDelete,omitempty"`
 }
 
 type Workspace struct {
@@ -1850,6 +2380,69 @@ func (e BoolOperator) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type CompareOperator string
+
+const (
+	CompareOperatorLt  CompareOperator = "LT"
+	CompareOperatorLte CompareOperator = "LTE"
+	CompareOperatorGt  CompareOperator = "GT"
+	CompareOperatorGte CompareOperator = "GTE"
+	CompareOperatorEq  CompareOperator = "EQ"
+	CompareOperatorNe  CompareOperator = "NE"
+)
+
+var AllCompareOperator = []CompareOperator{
+	CompareOperatorLt,
+	CompareOperatorLte,
+	CompareOperatorGt,
+	CompareOperatorGte,
+	CompareOperatorEq,
+	CompareOperatorNe,
+}
+
+func (e CompareOperator) IsValid() bool {
+	switch e {
+	case CompareOperatorLt, CompareOperatorLte, CompareOperatorGt, CompareOperatorGte, CompareOperatorEq, CompareOperatorNe:
+		return true
+	}
+	return false
+}
+
+func (e CompareOperator) String() string {
+	return string(e)
+}
+
+func (e *CompareOperator) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CompareOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CompareOperator", str)
+	}
+	return nil
+}
+
+func (e CompareOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CompareOperator) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CompareOperator) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ContentTypesEnum string
 
 const (
@@ -2161,6 +2754,63 @@ func (e IntegrationType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ItemFieldChangeType string
+
+const (
+	ItemFieldChangeTypeAdd    ItemFieldChangeType = "ADD"
+	ItemFieldChangeTypeUpdate ItemFieldChangeType = "UPDATE"
+	ItemFieldChangeTypeDelete ItemFieldChangeType = "DELETE"
+)
+
+var AllItemFieldChangeType = []ItemFieldChangeType{
+	ItemFieldChangeTypeAdd,
+	ItemFieldChangeTypeUpdate,
+	ItemFieldChangeTypeDelete,
+}
+
+func (e ItemFieldChangeType) IsValid() bool {
+	switch e {
+	case ItemFieldChangeTypeAdd, ItemFieldChangeTypeUpdate, ItemFieldChangeTypeDelete:
+		return true
+	}
+	return false
+}
+
+func (e ItemFieldChangeType) String() string {
+	return string(e)
+}
+
+func (e *ItemFieldChangeType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ItemFieldChangeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ItemFieldChangeType", str)
+	}
+	return nil
+}
+
+func (e ItemFieldChangeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ItemFieldChangeType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ItemFieldChangeType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type ItemStatus string
 
 const (
@@ -2356,6 +3006,67 @@ func (e NodeType) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type NotificationType string
+
+const (
+	NotificationTypeReviewRequested  NotificationType = "REVIEW_REQUESTED"
+	NotificationTypeRequestApproved  NotificationType = "REQUEST_APPROVED"
+	NotificationTypeChangesRequested NotificationType = "CHANGES_REQUESTED"
+	NotificationTypeRequestClosed    NotificationType = "REQUEST_CLOSED"
+	NotificationTypeRequestComment   NotificationType = "REQUEST_COMMENT"
+)
+
+var AllNotificationType = []NotificationType{
+	NotificationTypeReviewRequested,
+	NotificationTypeRequestApproved,
+	NotificationTypeChangesRequested,
+	NotificationTypeRequestClosed,
+	NotificationTypeRequestComment,
+}
+
+func (e NotificationType) IsValid() bool {
+	switch e {
+	case NotificationTypeReviewRequested, NotificationTypeRequestApproved, NotificationTypeChangesRequested, NotificationTypeRequestClosed, NotificationTypeRequestComment:
+		return true
+	}
+	return false
+}
+
+func (e NotificationType) String() string {
+	return string(e)
+}
+
+func (e *NotificationType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationType", str)
+	}
+	return nil
+}
+
+func (e NotificationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type NullableOperator string
 
 const (
@@ -2649,6 +3360,118 @@ func (e ProjectPublicationScope) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type ReleaseState string
+
+const (
+	ReleaseStateDraft      ReleaseState = "DRAFT"
+	ReleaseStatePublished  ReleaseState = "PUBLISHED"
+	ReleaseStateRolledBack ReleaseState = "ROLLED_BACK"
+)
+
+var AllReleaseState = []ReleaseState{
+	ReleaseStateDraft,
+	ReleaseStatePublished,
+	ReleaseStateRolledBack,
+}
+
+func (e ReleaseState) IsValid() bool {
+	switch e {
+	case ReleaseStateDraft, ReleaseStatePublished, ReleaseStateRolledBack:
+		return true
+	}
+	return false
+}
+
+func (e ReleaseState) String() string {
+	return string(e)
+}
+
+func (e *ReleaseState) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReleaseState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReleaseState", str)
+	}
+	return nil
+}
+
+func (e ReleaseState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ReleaseState) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ReleaseState) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RequestReviewDecision string
+
+const (
+	RequestReviewDecisionApproved         RequestReviewDecision = "APPROVED"
+	RequestReviewDecisionChangesRequested RequestReviewDecision = "CHANGES_REQUESTED"
+)
+
+var AllRequestReviewDecision = []RequestReviewDecision{
+	RequestReviewDecisionApproved,
+	RequestReviewDecisionChangesRequested,
+}
+
+func (e RequestReviewDecision) IsValid() bool {
+	switch e {
+	case RequestReviewDecisionApproved, RequestReviewDecisionChangesRequested:
+		return true
+	}
+	return false
+}
+
+func (e RequestReviewDecision) String() string {
+	return string(e)
+}
+
+func (e *RequestReviewDecision) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RequestReviewDecision(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RequestReviewDecision", str)
+	}
+	return nil
+}
+
+func (e RequestReviewDecision) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RequestReviewDecision) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RequestReviewDecision) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type RequestState string
 
 const (
@@ -3345,3 +4168,119 @@ func (e TimeOperator) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type TrashEntryType string
+
+const (
+	TrashEntryTypeItem  TrashEntryType = "ITEM"
+	TrashEntryTypeAsset TrashEntryType = "ASSET"
+	TrashEntryTypeModel TrashEntryType = "MODEL"
+)
+
+var AllTrashEntryType = []TrashEntryType{
+	TrashEntryTypeItem,
+	TrashEntryTypeAsset,
+	TrashEntryTypeModel,
+}
+
+func (e TrashEntryType) IsValid() bool {
+	switch e {
+	case TrashEntryTypeItem, TrashEntryTypeAsset, TrashEntryTypeModel:
+		return true
+	}
+	return false
+}
+
+func (e TrashEntryType) String() string {
+	return string(e)
+}
+
+func (e *TrashEntryType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrashEntryType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrashEntryType", str)
+	}
+	return nil
+}
+
+func (e TrashEntryType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TrashEntryType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TrashEntryType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ValidationRuleType string
+
+const (
+	ValidationRuleTypePattern  ValidationRuleType = "PATTERN"
+	ValidationRuleTypeMinCount ValidationRuleType = "MIN_COUNT"
+	ValidationRuleTypeMaxCount ValidationRuleType = "MAX_COUNT"
+	ValidationRuleTypeCompare  ValidationRuleType = "COMPARE"
+)
+
+var AllValidationRuleType = []ValidationRuleType{
+	ValidationRuleTypePattern,
+	ValidationRuleTypeMinCount,
+	ValidationRuleTypeMaxCount,
+	ValidationRuleTypeCompare,
+}
+
+func (e ValidationRuleType) IsValid() bool {
+	switch e {
+	case ValidationRuleTypePattern, ValidationRuleTypeMinCount, ValidationRuleTypeMaxCount, ValidationRuleTypeCompare:
+		return true
+	}
+	return false
+}
+
+func (e ValidationRuleType) String() string {
+	return string(e)
+}
+
+func (e *ValidationRuleType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ValidationRuleType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ValidationRuleType", str)
+	}
+	return nil
+}
+
+func (e ValidationRuleType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ValidationRuleType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ValidationRuleType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -20,6 +20,10 @@ type Loaders struct {
 	Workspace         *WorkspaceLoader
 	Item              *ItemLoader
 	View              *ViewLoader
+	ItemTemplate      *ItemTemplateLoader
+	Trash             *TrashLoader
+	Release           *ReleaseLoader
+	PreviewToken      *PreviewTokenLoader
 	ItemStatus        *ItemStatusLoader
 	AssetItem         *AssetItemLoader
 	User              *UserLoader
@@ -31,6 +35,7 @@ type Loaders struct {
 	Integration       *IntegrationLoader
 	Group             *GroupLoader
 	WorkspaceSettings *WorkspaceSettingsLoader
+	Notification      *NotificationLoader
 }
 
 func NewLoaders(usecases *interfaces.Container) *Loaders {
@@ -50,10 +55,15 @@ func NewLoaders(usecases *interfaces.Container) *Loaders {
 		Integration:       NewIntegrationLoader(usecases.Integration),
 		Item:              NewItemLoader(usecases.Item, usecases.Schema, usecases.Model),
 		View:              NewViewLoader(usecases.View),
+		ItemTemplate:      NewItemTemplateLoader(usecases.ItemTemplate, usecases.Schema, usecases.Model),
+		Trash:             NewTrashLoader(usecases.Trash),
+		Release:           NewReleaseLoader(usecases.Release),
+		PreviewToken:      NewPreviewTokenLoader(usecases.PreviewToken),
 		ItemStatus:        NewItemStatusLoader(usecases.Item),
 		Thread:            NewThreadLoader(usecases.Thread),
 		Group:             NewGroupLoader(usecases.Group),
 		WorkspaceSettings: NewWorkspaceSettingsLoader(usecases.WorkspaceSettings),
+		Notification:      NewNotificationLoader(usecases.Notification),
 	}
 }
 
@@ -72,6 +72,7 @@ func (c *AssetLoader) Search(ctx context.Context, query gqlmodel.AssetQueryInput
 		Sort:         sort.Into(),
 		Pagination:   pagination.Into(),
 		ContentTypes: ct,
+		Metadata:     query.Metadata.Into(),
 	}
 
 	assets, pi, err := c.usecase.Search(ctx, pID, filter, getOperator(ctx))
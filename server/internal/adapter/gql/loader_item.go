@@ -116,6 +116,30 @@ func (c *ItemLoader) FindVersionedItems(ctx context.Context, itemID gqlmodel.ID)
 	return vis, nil
 }
 
+func (c *ItemLoader) Diff(ctx context.Context, itemID gqlmodel.ID, from, to string) (*gqlmodel.ItemDiff, error) {
+	op := getOperator(ctx)
+	iId, err := gqlmodel.ToID[id.Item](itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := c.usecase.Diff(ctx, iId, version.ParseVersionOrRef(from), version.ParseVersionOrRef(to), op)
+	if err != nil {
+		return nil, err
+	}
+
+	itm, err := c.usecase.FindByID(ctx, iId, op)
+	if err != nil {
+		return nil, err
+	}
+	sp, err := c.schemaUsecase.FindByModel(ctx, itm.Value().Model(), op)
+	if err != nil {
+		return nil, err
+	}
+
+	return gqlmodel.ToItemDiff(d, sp), nil
+}
+
 func (c *ItemLoader) Search(ctx context.Context, query gqlmodel.SearchItemInput) (*gqlmodel.ItemConnection, error) {
 	_, span := trace.StartSpan(ctx, "loader/item/search")
 	t := time.Now()
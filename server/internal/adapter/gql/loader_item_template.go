@@ -0,0 +1,59 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/samber/lo"
+)
+
+type ItemTemplateLoader struct {
+	usecase       interfaces.ItemTemplate
+	schemaUsecase interfaces.Schema
+	modelUsecase  interfaces.Model
+}
+
+func NewItemTemplateLoader(usecase interfaces.ItemTemplate, schemaUsecase interfaces.Schema, modelUsecase interfaces.Model) *ItemTemplateLoader {
+	return &ItemTemplateLoader{usecase: usecase, schemaUsecase: schemaUsecase, modelUsecase: modelUsecase}
+}
+
+func (c *ItemTemplateLoader) FindByModel(ctx context.Context, modelID gqlmodel.ID) ([]*gqlmodel.ItemTemplate, error) {
+	mID, err := gqlmodel.ToID[id.Model](modelID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.usecase.FindByModel(ctx, mID, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return c.convert(ctx, mID, res...)
+}
+
+// convert converts the templates of the model with the schemas of the model.
+func (c *ItemTemplateLoader) convert(ctx context.Context, mID id.ModelID, templates ...*template.Template) ([]*gqlmodel.ItemTemplate, error) {
+	op := getOperator(ctx)
+	m, err := c.modelUsecase.FindByID(ctx, mID, op)
+	if err != nil {
+		return nil, err
+	}
+
+	sIDs := id.SchemaIDList{m.Schema()}
+	if m.Metadata() != nil {
+		sIDs = append(sIDs, *m.Metadata())
+	}
+	ss, gs, err := c.schemaUsecase.GetSchemasAndGroupSchemasByIDs(ctx, sIDs, op)
+	if err != nil {
+		return nil, err
+	}
+	s := schema.List(ss).Schema(m.Schema().Ref())
+	ms := schema.List(ss).Schema(m.Metadata())
+
+	return lo.Map(templates, func(t *template.Template, _ int) *gqlmodel.ItemTemplate {
+		return gqlmodel.ToItemTemplate(t, s, ms, gs)
+	}), nil
+}
@@ -0,0 +1,67 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+const notificationDefaultPageSize = 20
+
+type NotificationLoader struct {
+	usecase interfaces.Notification
+}
+
+func NewNotificationLoader(usecase interfaces.Notification) *NotificationLoader {
+	return &NotificationLoader{usecase: usecase}
+}
+
+func (c *NotificationLoader) FindByUser(ctx context.Context, unreadOnly *bool, p *gqlmodel.Pagination) (*gqlmodel.NotificationConnection, error) {
+	pagination := p.Into()
+	if pagination == nil {
+		pagination = usecasex.CursorPagination{First: lo.ToPtr(int64(notificationDefaultPageSize))}.Wrap()
+	}
+
+	f := interfaces.NotificationFilter{UnreadOnly: lo.FromPtr(unreadOnly)}
+	notifications, pi, err := c.usecase.FindByUser(ctx, f, pagination, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.NotificationEdge, 0, len(notifications))
+	nodes := make([]*gqlmodel.Notification, 0, len(notifications))
+	for _, n := range notifications {
+		notification := gqlmodel.ToNotification(n)
+		edges = append(edges, &gqlmodel.NotificationEdge{
+			Node:   notification,
+			Cursor: usecasex.Cursor(notification.ID),
+		})
+		nodes = append(nodes, notification)
+	}
+
+	return &gqlmodel.NotificationConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: int(pi.TotalCount),
+	}, nil
+}
+
+func (c *NotificationLoader) CountUnread(ctx context.Context) (int, error) {
+	res, err := c.usecase.CountUnread(ctx, getOperator(ctx))
+	if err != nil {
+		return 0, err
+	}
+	return int(res), nil
+}
+
+func (c *NotificationLoader) FindPreference(ctx context.Context) (*gqlmodel.NotificationPreference, error) {
+	res, err := c.usecase.FindPreference(ctx, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return gqlmodel.ToNotificationPreference(res), nil
+}
@@ -0,0 +1,48 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type PreviewTokenLoader struct {
+	usecase interfaces.PreviewToken
+}
+
+func NewPreviewTokenLoader(usecase interfaces.PreviewToken) *PreviewTokenLoader {
+	return &PreviewTokenLoader{usecase: usecase}
+}
+
+func (c *PreviewTokenLoader) FindByProject(ctx context.Context, projectID gqlmodel.ID, p *gqlmodel.Pagination) (*gqlmodel.PreviewTokenConnection, error) {
+	pID, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, pi, err := c.usecase.FindByProject(ctx, pID, p.Into(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.PreviewTokenEdge, 0, len(res))
+	nodes := make([]*gqlmodel.PreviewToken, 0, len(res))
+	for _, t := range res {
+		pt := gqlmodel.ToPreviewToken(t)
+		edges = append(edges, &gqlmodel.PreviewTokenEdge{
+			Node:   pt,
+			Cursor: usecasex.Cursor(pt.ID),
+		})
+		nodes = append(nodes, pt)
+	}
+
+	return &gqlmodel.PreviewTokenConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: int(pi.TotalCount),
+	}, nil
+}
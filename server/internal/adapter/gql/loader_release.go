@@ -0,0 +1,48 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type ReleaseLoader struct {
+	usecase interfaces.Release
+}
+
+func NewReleaseLoader(usecase interfaces.Release) *ReleaseLoader {
+	return &ReleaseLoader{usecase: usecase}
+}
+
+func (c *ReleaseLoader) FindByProject(ctx context.Context, projectID gqlmodel.ID, p *gqlmodel.Pagination) (*gqlmodel.ReleaseConnection, error) {
+	pID, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, pi, err := c.usecase.FindByProject(ctx, pID, p.Into(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.ReleaseEdge, 0, len(res))
+	nodes := make([]*gqlmodel.Release, 0, len(res))
+	for _, r := range res {
+		rel := gqlmodel.ToRelease(r)
+		edges = append(edges, &gqlmodel.ReleaseEdge{
+			Node:   rel,
+			Cursor: usecasex.Cursor(rel.ID),
+		})
+		nodes = append(nodes, rel)
+	}
+
+	return &gqlmodel.ReleaseConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: int(pi.TotalCount),
+	}, nil
+}
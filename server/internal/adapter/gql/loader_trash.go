@@ -0,0 +1,48 @@
+package gql
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type TrashLoader struct {
+	usecase interfaces.Trash
+}
+
+func NewTrashLoader(usecase interfaces.Trash) *TrashLoader {
+	return &TrashLoader{usecase: usecase}
+}
+
+func (c *TrashLoader) FindByProject(ctx context.Context, projectID gqlmodel.ID, p *gqlmodel.Pagination) (*gqlmodel.TrashEntryConnection, error) {
+	pID, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, pi, err := c.usecase.FindByProject(ctx, pID, p.Into(), getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*gqlmodel.TrashEntryEdge, 0, len(res))
+	nodes := make([]*gqlmodel.TrashEntry, 0, len(res))
+	for _, r := range res {
+		e := gqlmodel.ToTrashEntry(r)
+		edges = append(edges, &gqlmodel.TrashEntryEdge{
+			Node:   e,
+			Cursor: usecasex.Cursor(e.ID),
+		})
+		nodes = append(nodes, e)
+	}
+
+	return &gqlmodel.TrashEntryConnection{
+		Edges:      edges,
+		Nodes:      nodes,
+		PageInfo:   gqlmodel.ToPageInfo(pi),
+		TotalCount: int(pi.TotalCount),
+	}, nil
+}
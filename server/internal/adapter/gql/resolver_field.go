@@ -36,6 +36,11 @@ func (r *mutationResolver) CreateField(ctx context.Context, input gqlmodel.Creat
 		return nil, err
 	}
 
+	rules, err := gqlmodel.FromValidationRules(input.ValidationRules)
+	if err != nil {
+		return nil, err
+	}
+
 	f, err := usecases(ctx).Schema.CreateField(ctx, interfaces.CreateFieldParam{
 		ModelID:      mid,
 		SchemaID:     s.ID(),
@@ -47,8 +52,12 @@ func (r *mutationResolver) CreateField(ctx context.Context, input gqlmodel.Creat
 		Unique:       input.Unique,
 		Required:     input.Required,
 		IsTitle:      input.IsTitle,
+		Localized:    lo.FromPtr(input.Localized),
 		DefaultValue: dv,
 		TypeProperty: tp,
+
+		ValidationRules: rules,
+		Expression:      lo.FromPtr(input.Expression),
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -88,6 +97,10 @@ func (r *mutationResolver) CreateFields(ctx context.Context, input []*gqlmodel.C
 		if err != nil {
 			return interfaces.CreateFieldParam{}, err
 		}
+		rules, err := gqlmodel.FromValidationRules(ipt.ValidationRules)
+		if err != nil {
+			return interfaces.CreateFieldParam{}, err
+		}
 		return interfaces.CreateFieldParam{
 			ModelID:      mid,
 			SchemaID:     s.ID(),
@@ -98,8 +111,12 @@ func (r *mutationResolver) CreateFields(ctx context.Context, input []*gqlmodel.C
 			Unique:       ipt.Unique,
 			IsTitle:      ipt.IsTitle,
 			Required:     ipt.Required,
+			Localized:    lo.FromPtr(ipt.Localized),
 			DefaultValue: dv,
 			TypeProperty: tp,
+
+			ValidationRules: rules,
+			Expression:      lo.FromPtr(ipt.Expression),
 		}, nil
 	})
 	if err != nil {
@@ -145,6 +162,11 @@ func (r *mutationResolver) UpdateField(ctx context.Context, input gqlmodel.Updat
 		return nil, err
 	}
 
+	rules, err := gqlmodel.FromValidationRules(input.ValidationRules)
+	if err != nil {
+		return nil, err
+	}
+
 	f, err := usecases(ctx).Schema.UpdateField(ctx, interfaces.UpdateFieldParam{
 		ModelID:      mid,
 		SchemaID:     s.ID(),
@@ -157,8 +179,12 @@ func (r *mutationResolver) UpdateField(ctx context.Context, input gqlmodel.Updat
 		Unique:       input.Unique,
 		Required:     input.Required,
 		IsTitle:      input.IsTitle,
+		Localized:    input.Localized,
 		DefaultValue: dv,
 		TypeProperty: tp,
+
+		ValidationRules: rules,
+		Expression:      input.Expression,
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -195,6 +221,10 @@ func (r *mutationResolver) UpdateFields(ctx context.Context, input []*gqlmodel.U
 		if err != nil {
 			return interfaces.UpdateFieldParam{}, err
 		}
+		rules, err := gqlmodel.FromValidationRules(ipt.ValidationRules)
+		if err != nil {
+			return interfaces.UpdateFieldParam{}, err
+		}
 		return interfaces.UpdateFieldParam{
 			SchemaID:     s.ID(),
 			FieldID:      fid,
@@ -206,8 +236,12 @@ func (r *mutationResolver) UpdateFields(ctx context.Context, input []*gqlmodel.U
 			Unique:       ipt.Unique,
 			IsTitle:      ipt.IsTitle,
 			Required:     ipt.Required,
+			Localized:    ipt.Localized,
 			DefaultValue: dv,
 			TypeProperty: tp,
+
+			ValidationRules: rules,
+			Expression:      ipt.Expression,
 		}, nil
 	})
 	if err != nil {
@@ -255,6 +289,58 @@ func (r *mutationResolver) DeleteField(ctx context.Context, input gqlmodel.Delet
 	}, nil
 }
 
+// ConvertField is the resolver for the convertField field.
+func (r *mutationResolver) ConvertField(ctx context.Context, input gqlmodel.ConvertFieldInput) (*gqlmodel.ConvertFieldPayload, error) {
+	mid, err := gqlmodel.ToID[id.Model](input.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	fid, err := gqlmodel.ToID[id.Field](input.FieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := usecases(ctx).Model.FindOrCreateSchema(ctx, interfaces.FindOrCreateSchemaParam{
+		ModelID:  &mid,
+		Metadata: input.Metadata,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	dbField := s.Field(fid)
+	if dbField == nil {
+		return nil, interfaces.ErrFieldNotFound
+	}
+
+	tp, dv, err := gqlmodel.FromSchemaTypeProperty(input.TypeProperty, input.Type, lo.FromPtrOr(input.Multiple, dbField.Multiple()))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Schema.ConvertField(ctx, interfaces.ConvertFieldParam{
+		ModelID:      mid,
+		SchemaID:     s.ID(),
+		FieldID:      fid,
+		TypeProperty: tp,
+		Multiple:     input.Multiple,
+		DefaultValue: dv,
+		AllVersions:  lo.FromPtr(input.AllVersions),
+		DryRun:       lo.FromPtr(input.DryRun),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ConvertFieldPayload{
+		Field:     gqlmodel.ToSchemaField(res.Field, s.TitleField()),
+		Items:     res.Items,
+		Converted: res.Converted,
+		Failed:    res.Failed,
+		Lost:      res.Lost,
+	}, nil
+}
+
 // GuessSchemaFields is the resolver for the guessSchemaFields field.
 func (r *queryResolver) GuessSchemaFields(ctx context.Context, input gqlmodel.GuessSchemaFieldsInput) (*gqlmodel.GuessSchemaFieldResult, error) {
 	assetID, err := gqlmodel.ToID[id.Asset](input.AssetID)
@@ -34,6 +34,15 @@ func (r *mutationResolver) CreateWebhook(ctx context.Context, input gqlmodel.Cre
 			event.AssetCreate:     lo.FromPtrOr(input.Trigger.OnAssetUpload, false),
 			event.AssetDecompress: lo.FromPtrOr(input.Trigger.OnAssetDecompress, false),
 			event.AssetDelete:     lo.FromPtrOr(input.Trigger.OnAssetDelete, false),
+			event.RequestCreate:   lo.FromPtrOr(input.Trigger.OnRequestCreate, false),
+			event.RequestSubmit:   lo.FromPtrOr(input.Trigger.OnRequestSubmit, false),
+			event.RequestReview:   lo.FromPtrOr(input.Trigger.OnRequestReview, false),
+			event.RequestApprove:  lo.FromPtrOr(input.Trigger.OnRequestApprove, false),
+			event.RequestClose:    lo.FromPtrOr(input.Trigger.OnRequestClose, false),
+			event.RequestComment:  lo.FromPtrOr(input.Trigger.OnRequestComment, false),
+			event.CommentCreate:   lo.FromPtrOr(input.Trigger.OnCommentCreate, false),
+			event.CommentUpdate:   lo.FromPtrOr(input.Trigger.OnCommentUpdate, false),
+			event.CommentDelete:   lo.FromPtrOr(input.Trigger.OnCommentDelete, false),
 		},
 		Secret: input.Secret,
 	}, getOperator(ctx))
@@ -66,6 +75,15 @@ func (r *mutationResolver) UpdateWebhook(ctx context.Context, input gqlmodel.Upd
 			event.AssetCreate:     lo.FromPtrOr(input.Trigger.OnAssetUpload, false),
 			event.AssetDecompress: lo.FromPtrOr(input.Trigger.OnAssetDecompress, false),
 			event.AssetDelete:     lo.FromPtrOr(input.Trigger.OnAssetDelete, false),
+			event.RequestCreate:   lo.FromPtrOr(input.Trigger.OnRequestCreate, false),
+			event.RequestSubmit:   lo.FromPtrOr(input.Trigger.OnRequestSubmit, false),
+			event.RequestReview:   lo.FromPtrOr(input.Trigger.OnRequestReview, false),
+			event.RequestApprove:  lo.FromPtrOr(input.Trigger.OnRequestApprove, false),
+			event.RequestClose:    lo.FromPtrOr(input.Trigger.OnRequestClose, false),
+			event.RequestComment:  lo.FromPtrOr(input.Trigger.OnRequestComment, false),
+			event.CommentCreate:   lo.FromPtrOr(input.Trigger.OnCommentCreate, false),
+			event.CommentUpdate:   lo.FromPtrOr(input.Trigger.OnCommentUpdate, false),
+			event.CommentDelete:   lo.FromPtrOr(input.Trigger.OnCommentDelete, false),
 		},
 		Secret: input.Secret,
 	}, getOperator(ctx))
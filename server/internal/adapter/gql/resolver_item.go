@@ -228,6 +228,27 @@ func (r *mutationResolver) DeleteItem(ctx context.Context, input gqlmodel.Delete
 	return &gqlmodel.DeleteItemPayload{ItemID: input.ItemID}, nil
 }
 
+// RestoreItem is the resolver for the restoreItem field.
+func (r *mutationResolver) RestoreItem(ctx context.Context, input gqlmodel.RestoreItemInput) (*gqlmodel.RestoreItemPayload, error) {
+	op := getOperator(ctx)
+	iid, err := gqlmodel.ToID[id.Item](input.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Item.Restore(ctx, iid, version.ParseVersionOrRef(input.Version), op)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, err := usecases(ctx).Schema.FindByModel(ctx, res.Item.Value().Model(), op)
+	if err != nil {
+		return nil, err
+	}
+
+	return gqlmodel.ToRestoreItemPayload(res, sp), nil
+}
+
 // PublishItem is the resolver for the publishItem field.
 func (r *mutationResolver) PublishItem(ctx context.Context, input gqlmodel.PublishItemInput) (*gqlmodel.PublishItemPayload, error) {
 	op := getOperator(ctx)
@@ -275,6 +296,11 @@ func (r *queryResolver) VersionsByItem(ctx context.Context, itemID gqlmodel.ID)
 	return loaders(ctx).Item.FindVersionedItems(ctx, itemID)
 }
 
+// ItemDiff is the resolver for the itemDiff field.
+func (r *queryResolver) ItemDiff(ctx context.Context, itemID gqlmodel.ID, from string, to string) (*gqlmodel.ItemDiff, error) {
+	return loaders(ctx).Item.Diff(ctx, itemID, from, to)
+}
+
 // SearchItem is the resolver for the searchItem field.
 func (r *queryResolver) SearchItem(ctx context.Context, input gqlmodel.SearchItemInput) (*gqlmodel.ItemConnection, error) {
 	return loaders(ctx).Item.Search(ctx, input)
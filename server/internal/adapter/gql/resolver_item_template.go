@@ -0,0 +1,167 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *itemTemplateResolver) CreatedBy(ctx context.Context, obj *gqlmodel.ItemTemplate) (gqlmodel.Operator, error) {
+	if obj.UserID != nil {
+		return dataloaders(ctx).User.Load(*obj.UserID)
+	}
+	if obj.IntegrationID != nil {
+		return dataloaders(ctx).Integration.Load(*obj.IntegrationID)
+	}
+	return nil, nil
+}
+
+// Model is the resolver for the model field.
+func (r *itemTemplateResolver) Model(ctx context.Context, obj *gqlmodel.ItemTemplate) (*gqlmodel.Model, error) {
+	return dataloaders(ctx).Model.Load(obj.ModelID)
+}
+
+// CreateItemTemplate is the resolver for the createItemTemplate field.
+func (r *mutationResolver) CreateItemTemplate(ctx context.Context, input gqlmodel.CreateItemTemplateInput) (*gqlmodel.ItemTemplatePayload, error) {
+	mid, err := gqlmodel.ToID[id.Model](input.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	var sourceItem *id.ItemID
+	if input.SourceItemID != nil {
+		iid, err := gqlmodel.ToID[id.Item](*input.SourceItemID)
+		if err != nil {
+			return nil, err
+		}
+		sourceItem = &iid
+	}
+
+	res, err := usecases(ctx).ItemTemplate.Create(ctx, interfaces.CreateItemTemplateParam{
+		ModelID:        mid,
+		Name:           input.Name,
+		Description:    lo.FromPtr(input.Description),
+		Fields:         util.DerefSlice(util.Map(input.Fields, gqlmodel.ToItemParam)),
+		MetadataFields: util.DerefSlice(util.Map(input.MetadataFields, gqlmodel.ToItemParam)),
+		SourceItem:     sourceItem,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := loaders(ctx).ItemTemplate.convert(ctx, res.Model(), res)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ItemTemplatePayload{ItemTemplate: t[0]}, nil
+}
+
+// UpdateItemTemplate is the resolver for the updateItemTemplate field.
+func (r *mutationResolver) UpdateItemTemplate(ctx context.Context, input gqlmodel.UpdateItemTemplateInput) (*gqlmodel.ItemTemplatePayload, error) {
+	tid, err := gqlmodel.ToID[id.ItemTemplate](input.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	param := interfaces.UpdateItemTemplateParam{
+		ID:          tid,
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	if input.Fields != nil {
+		param.Fields = util.DerefSlice(util.Map(input.Fields, gqlmodel.ToItemParam))
+	}
+	if input.MetadataFields != nil {
+		param.MetadataFields = util.DerefSlice(util.Map(input.MetadataFields, gqlmodel.ToItemParam))
+	}
+
+	res, err := usecases(ctx).ItemTemplate.Update(ctx, param, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := loaders(ctx).ItemTemplate.convert(ctx, res.Model(), res)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ItemTemplatePayload{ItemTemplate: t[0]}, nil
+}
+
+// DeleteItemTemplate is the resolver for the deleteItemTemplate field.
+func (r *mutationResolver) DeleteItemTemplate(ctx context.Context, input gqlmodel.DeleteItemTemplateInput) (*gqlmodel.DeleteItemTemplatePayload, error) {
+	tid, err := gqlmodel.ToID[id.ItemTemplate](input.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecases(ctx).ItemTemplate.Delete(ctx, tid, getOperator(ctx)); err != nil {
+		return nil, err
+	}
+	return &gqlmodel.DeleteItemTemplatePayload{TemplateID: input.TemplateID}, nil
+}
+
+// CreateItemFromTemplate is the resolver for the createItemFromTemplate field.
+func (r *mutationResolver) CreateItemFromTemplate(ctx context.Context, input gqlmodel.CreateItemFromTemplateInput) (*gqlmodel.ItemPayload, error) {
+	tid, err := gqlmodel.ToID[id.ItemTemplate](input.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+
+	op := getOperator(ctx)
+	res, err := usecases(ctx).Item.CreateFromTemplate(ctx, interfaces.CreateItemFromTemplateParam{
+		TemplateID:     tid,
+		Fields:         util.DerefSlice(util.Map(input.Fields, gqlmodel.ToItemParam)),
+		MetadataFields: util.DerefSlice(util.Map(input.MetadataFields, gqlmodel.ToItemParam)),
+	}, op)
+	if err != nil {
+		return nil, err
+	}
+	ss, gs, err := usecases(ctx).Schema.GetSchemasAndGroupSchemasByIDs(ctx, id.SchemaIDList{res.Value().Schema()}, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ItemPayload{
+		Item: gqlmodel.ToItem(res, ss[0], gs),
+	}, nil
+}
+
+// DuplicateItem is the resolver for the duplicateItem field.
+func (r *mutationResolver) DuplicateItem(ctx context.Context, input gqlmodel.DuplicateItemInput) (*gqlmodel.ItemPayload, error) {
+	iid, err := gqlmodel.ToID[id.Item](input.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	op := getOperator(ctx)
+	res, err := usecases(ctx).Item.Duplicate(ctx, iid, op)
+	if err != nil {
+		return nil, err
+	}
+	ss, gs, err := usecases(ctx).Schema.GetSchemasAndGroupSchemasByIDs(ctx, id.SchemaIDList{res.Value().Schema()}, op)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ItemPayload{
+		Item: gqlmodel.ToItem(res, ss[0], gs),
+	}, nil
+}
+
+// ItemTemplates is the resolver for the itemTemplates field.
+func (r *queryResolver) ItemTemplates(ctx context.Context, modelID gqlmodel.ID) ([]*gqlmodel.ItemTemplate, error) {
+	return loaders(ctx).ItemTemplate.FindByModel(ctx, modelID)
+}
+
+// ItemTemplate returns ItemTemplateResolver implementation.
+func (r *Resolver) ItemTemplate() ItemTemplateResolver { return &itemTemplateResolver{r} }
+
+type itemTemplateResolver struct{ *Resolver }
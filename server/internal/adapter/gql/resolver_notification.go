@@ -0,0 +1,93 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/samber/lo"
+)
+
+// MarkNotificationsRead is the resolver for the markNotificationsRead field.
+func (r *mutationResolver) MarkNotificationsRead(ctx context.Context, input gqlmodel.MarkNotificationsReadInput) (*gqlmodel.MarkNotificationsReadPayload, error) {
+	nids, err := gqlmodel.ToIDs[id.Notification](input.NotificationIds)
+	if err != nil {
+		return nil, err
+	}
+	res, err := usecases(ctx).Notification.MarkRead(ctx, nids, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.MarkNotificationsReadPayload{
+		Notifications: lo.Map(res, func(n *notification.Notification, _ int) *gqlmodel.Notification { return gqlmodel.ToNotification(n) }),
+	}, nil
+}
+
+// MarkAllNotificationsRead is the resolver for the markAllNotificationsRead field.
+func (r *mutationResolver) MarkAllNotificationsRead(ctx context.Context) (*gqlmodel.MarkAllNotificationsReadPayload, error) {
+	if err := usecases(ctx).Notification.MarkAllRead(ctx, getOperator(ctx)); err != nil {
+		return nil, err
+	}
+	c, err := loaders(ctx).Notification.CountUnread(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.MarkAllNotificationsReadPayload{
+		UnreadCount: c,
+	}, nil
+}
+
+// UpdateNotificationPreference is the resolver for the updateNotificationPreference field.
+func (r *mutationResolver) UpdateNotificationPreference(ctx context.Context, input gqlmodel.UpdateNotificationPreferenceInput) (*gqlmodel.NotificationPreferencePayload, error) {
+	res, err := usecases(ctx).Notification.UpdatePreference(ctx, interfaces.UpdateNotificationPreferenceParam{
+		Settings: gqlmodel.FromNotificationSettings(input.Settings),
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.NotificationPreferencePayload{
+		NotificationPreference: gqlmodel.ToNotificationPreference(res),
+	}, nil
+}
+
+// Request is the resolver for the request field.
+func (r *notificationResolver) Request(ctx context.Context, obj *gqlmodel.Notification) (*gqlmodel.Request, error) {
+	return dataloaders(ctx).Request.Load(obj.RequestID)
+}
+
+// Actor is the resolver for the actor field.
+func (r *notificationResolver) Actor(ctx context.Context, obj *gqlmodel.Notification) (*gqlmodel.User, error) {
+	if obj.ActorID == nil {
+		return nil, nil
+	}
+	return dataloaders(ctx).User.Load(*obj.ActorID)
+}
+
+// Notifications is the resolver for the notifications field.
+func (r *queryResolver) Notifications(ctx context.Context, unreadOnly *bool, pagination *gqlmodel.Pagination) (*gqlmodel.NotificationConnection, error) {
+	return loaders(ctx).Notification.FindByUser(ctx, unreadOnly, pagination)
+}
+
+// UnreadNotificationCount is the resolver for the unreadNotificationCount field.
+func (r *queryResolver) UnreadNotificationCount(ctx context.Context) (int, error) {
+	return loaders(ctx).Notification.CountUnread(ctx)
+}
+
+// NotificationPreference is the resolver for the notificationPreference field.
+func (r *queryResolver) NotificationPreference(ctx context.Context) (*gqlmodel.NotificationPreference, error) {
+	return loaders(ctx).Notification.FindPreference(ctx)
+}
+
+// Notification returns NotificationResolver implementation.
+func (r *Resolver) Notification() NotificationResolver { return &notificationResolver{r} }
+
+type notificationResolver struct{ *Resolver }
@@ -0,0 +1,75 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+)
+
+// IssuePreviewToken is the resolver for the issuePreviewToken field.
+func (r *mutationResolver) IssuePreviewToken(ctx context.Context, input gqlmodel.IssuePreviewTokenInput) (*gqlmodel.IssuePreviewTokenPayload, error) {
+	pid, err := gqlmodel.ToID[id.Project](input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	param := interfaces.IssuePreviewTokenParam{
+		ProjectID: pid,
+		RequestID: gqlmodel.ToIDRef[id.Request](input.RequestID),
+	}
+	if input.TTL != nil {
+		ttl := time.Duration(*input.TTL) * time.Second
+		param.TTL = &ttl
+	}
+
+	res, token, err := usecases(ctx).PreviewToken.Issue(ctx, param, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.IssuePreviewTokenPayload{
+		PreviewToken: gqlmodel.ToPreviewToken(res),
+		Token:        token,
+	}, nil
+}
+
+// RevokePreviewToken is the resolver for the revokePreviewToken field.
+func (r *mutationResolver) RevokePreviewToken(ctx context.Context, input gqlmodel.RevokePreviewTokenInput) (*gqlmodel.RevokePreviewTokenPayload, error) {
+	tid, err := gqlmodel.ToID[id.PreviewToken](input.PreviewTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).PreviewToken.Revoke(ctx, tid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.RevokePreviewTokenPayload{PreviewToken: gqlmodel.ToPreviewToken(res)}, nil
+}
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *previewTokenResolver) CreatedBy(ctx context.Context, obj *gqlmodel.PreviewToken) (gqlmodel.Operator, error) {
+	if obj.UserID != nil {
+		return dataloaders(ctx).User.Load(*obj.UserID)
+	}
+	if obj.IntegrationID != nil {
+		return dataloaders(ctx).Integration.Load(*obj.IntegrationID)
+	}
+	return nil, nil
+}
+
+// PreviewTokens is the resolver for the previewTokens field.
+func (r *queryResolver) PreviewTokens(ctx context.Context, projectID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.PreviewTokenConnection, error) {
+	return loaders(ctx).PreviewToken.FindByProject(ctx, projectID, pagination)
+}
+
+// PreviewToken returns PreviewTokenResolver implementation.
+func (r *Resolver) PreviewToken() PreviewTokenResolver { return &previewTokenResolver{r} }
+
+type previewTokenResolver struct{ *Resolver }
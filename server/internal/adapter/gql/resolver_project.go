@@ -53,16 +53,29 @@ func (r *mutationResolver) UpdateProject(ctx context.Context, input gqlmodel.Upd
 		pub = &interfaces.UpdateProjectPublicationParam{
 			Scope:       scope,
 			AssetPublic: input.Publication.AssetPublic,
+			CacheMaxAge: input.Publication.CacheMaxAge,
 		}
 	}
 
+	locales, err := gqlmodel.FromProjectLocalesInput(input.Locales)
+	if err != nil {
+		return nil, err
+	}
+
+	approvalPolicy, err := gqlmodel.FromProjectApprovalPolicyInput(input.ApprovalPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := usecases(ctx).Project.Update(ctx, interfaces.UpdateProjectParam{
-		ID:           pid,
-		Name:         input.Name,
-		Description:  input.Description,
-		Alias:        input.Alias,
-		Publication:  pub,
-		RequestRoles: lo.Map(input.RequestRoles, func(r gqlmodel.Role, _ int) workspace.Role { return gqlmodel.FromRole(r) }),
+		ID:             pid,
+		Name:           input.Name,
+		Description:    input.Description,
+		Alias:          input.Alias,
+		Publication:    pub,
+		RequestRoles:   lo.Map(input.RequestRoles, func(r gqlmodel.Role, _ int) workspace.Role { return gqlmodel.FromRole(r) }),
+		Locales:        locales,
+		ApprovalPolicy: approvalPolicy,
 	}, getOperator(ctx))
 	if err != nil {
 		return nil, err
@@ -0,0 +1,168 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+// CreateRelease is the resolver for the createRelease field.
+func (r *mutationResolver) CreateRelease(ctx context.Context, input gqlmodel.CreateReleaseInput) (*gqlmodel.ReleasePayload, error) {
+	pid, err := gqlmodel.ToID[id.Project](input.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Release.Create(ctx, interfaces.CreateReleaseParam{
+		ProjectID:   pid,
+		Name:        input.Name,
+		Description: input.Description,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ReleasePayload{Release: gqlmodel.ToRelease(res)}, nil
+}
+
+// UpdateRelease is the resolver for the updateRelease field.
+func (r *mutationResolver) UpdateRelease(ctx context.Context, input gqlmodel.UpdateReleaseInput) (*gqlmodel.ReleasePayload, error) {
+	rid, err := gqlmodel.ToID[id.Release](input.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Release.Update(ctx, interfaces.UpdateReleaseParam{
+		ReleaseID:   rid,
+		Name:        input.Name,
+		Description: input.Description,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ReleasePayload{Release: gqlmodel.ToRelease(res)}, nil
+}
+
+// DeleteRelease is the resolver for the deleteRelease field.
+func (r *mutationResolver) DeleteRelease(ctx context.Context, input gqlmodel.DeleteReleaseInput) (*gqlmodel.DeleteReleasePayload, error) {
+	rid, err := gqlmodel.ToID[id.Release](input.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecases(ctx).Release.Delete(ctx, rid, getOperator(ctx)); err != nil {
+		return nil, err
+	}
+	return &gqlmodel.DeleteReleasePayload{ReleaseID: input.ReleaseID}, nil
+}
+
+// AddReleaseItems is the resolver for the addReleaseItems field.
+func (r *mutationResolver) AddReleaseItems(ctx context.Context, input gqlmodel.AddReleaseItemsInput) (*gqlmodel.ReleasePayload, error) {
+	rid, err := gqlmodel.ToID[id.Release](input.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := util.TryMap(input.Items, func(i *gqlmodel.ReleaseItemInput) (interfaces.ReleaseItemParam, error) {
+		iid, err := gqlmodel.ToID[id.Item](i.ItemID)
+		if err != nil {
+			return interfaces.ReleaseItemParam{}, err
+		}
+		var v *version.Version
+		if i.Version != nil {
+			u, err := uuid.Parse(*i.Version)
+			if err != nil {
+				return interfaces.ReleaseItemParam{}, err
+			}
+			v = lo.ToPtr(version.Version(u))
+		}
+		return interfaces.ReleaseItemParam{Item: iid, Version: v}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Release.AddItems(ctx, rid, items, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ReleasePayload{Release: gqlmodel.ToRelease(res)}, nil
+}
+
+// RemoveReleaseItems is the resolver for the removeReleaseItems field.
+func (r *mutationResolver) RemoveReleaseItems(ctx context.Context, input gqlmodel.RemoveReleaseItemsInput) (*gqlmodel.ReleasePayload, error) {
+	rid, err := gqlmodel.ToID[id.Release](input.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+	iids, err := gqlmodel.ToIDs[id.Item](input.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Release.RemoveItems(ctx, rid, iids, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ReleasePayload{Release: gqlmodel.ToRelease(res)}, nil
+}
+
+// PublishRelease is the resolver for the publishRelease field.
+func (r *mutationResolver) PublishRelease(ctx context.Context, input gqlmodel.PublishReleaseInput) (*gqlmodel.ReleasePayload, error) {
+	rid, err := gqlmodel.ToID[id.Release](input.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Release.Publish(ctx, rid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ReleasePayload{Release: gqlmodel.ToRelease(res)}, nil
+}
+
+// RollbackRelease is the resolver for the rollbackRelease field.
+func (r *mutationResolver) RollbackRelease(ctx context.Context, input gqlmodel.RollbackReleaseInput) (*gqlmodel.ReleasePayload, error) {
+	rid, err := gqlmodel.ToID[id.Release](input.ReleaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Release.Rollback(ctx, rid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return &gqlmodel.ReleasePayload{Release: gqlmodel.ToRelease(res)}, nil
+}
+
+// Releases is the resolver for the releases field.
+func (r *queryResolver) Releases(ctx context.Context, projectID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.ReleaseConnection, error) {
+	return loaders(ctx).Release.FindByProject(ctx, projectID, pagination)
+}
+
+// Project is the resolver for the project field.
+func (r *releaseResolver) Project(ctx context.Context, obj *gqlmodel.Release) (*gqlmodel.Project, error) {
+	return dataloaders(ctx).Project.Load(obj.ProjectID)
+}
+
+// Item is the resolver for the item field.
+func (r *releaseItemResolver) Item(ctx context.Context, obj *gqlmodel.ReleaseItem) (*gqlmodel.VersionedItem, error) {
+	return loaders(ctx).Item.FindVersionedItem(ctx, obj.ItemID, &obj.Version)
+}
+
+// Release returns ReleaseResolver implementation.
+func (r *Resolver) Release() ReleaseResolver { return &releaseResolver{r} }
+
+// ReleaseItem returns ReleaseItemResolver implementation.
+func (r *Resolver) ReleaseItem() ReleaseItemResolver { return &releaseItemResolver{r} }
+
+type releaseResolver struct{ *Resolver }
+type releaseItemResolver struct{ *Resolver }
@@ -114,6 +114,22 @@ func (r *mutationResolver) ApproveRequest(ctx context.Context, input gqlmodel.Ap
 	}, nil
 }
 
+// RequestChanges is the resolver for the requestChanges field.
+func (r *mutationResolver) RequestChanges(ctx context.Context, input gqlmodel.RequestChangesInput) (*gqlmodel.RequestPayload, error) {
+	rid, err := gqlmodel.ToID[id.Request](input.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := usecases(ctx).Request.RequestChanges(ctx, rid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RequestPayload{
+		Request: gqlmodel.ToRequest(res),
+	}, nil
+}
+
 // DeleteRequest is the resolver for the deleteRequest field.
 func (r *mutationResolver) DeleteRequest(ctx context.Context, input gqlmodel.DeleteRequestInput) (*gqlmodel.DeleteRequestPayload, error) {
 	rids, err := gqlmodel.ToIDs[id.Request](input.RequestsID)
@@ -177,11 +193,20 @@ func (r *requestItemResolver) Item(ctx context.Context, obj *gqlmodel.RequestIte
 	return loaders(ctx).Item.FindVersionedItem(ctx, obj.ItemID, obj.Version)
 }
 
+// Reviewer is the resolver for the reviewer field.
+func (r *requestReviewResolver) Reviewer(ctx context.Context, obj *gqlmodel.RequestReview) (*gqlmodel.User, error) {
+	return dataloaders(ctx).User.Load(obj.ReviewerID)
+}
+
 // Request returns RequestResolver implementation.
 func (r *Resolver) Request() RequestResolver { return &requestResolver{r} }
 
 // RequestItem returns RequestItemResolver implementation.
 func (r *Resolver) RequestItem() RequestItemResolver { return &requestItemResolver{r} }
 
+// RequestReview returns RequestReviewResolver implementation.
+func (r *Resolver) RequestReview() RequestReviewResolver { return &requestReviewResolver{r} }
+
 type requestResolver struct{ *Resolver }
 type requestItemResolver struct{ *Resolver }
+type requestReviewResolver struct{ *Resolver }
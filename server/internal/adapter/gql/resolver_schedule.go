@@ -0,0 +1,100 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/samber/lo"
+)
+
+// CreatedBy is the resolver for the createdBy field.
+func (r *itemScheduleResolver) CreatedBy(ctx context.Context, obj *gqlmodel.ItemSchedule) (gqlmodel.Operator, error) {
+	if obj.UserID != nil {
+		return dataloaders(ctx).User.Load(*obj.UserID)
+	}
+	if obj.IntegrationID != nil {
+		return dataloaders(ctx).Integration.Load(*obj.IntegrationID)
+	}
+	return nil, nil
+}
+
+// Item is the resolver for the item field.
+func (r *itemScheduleResolver) Item(ctx context.Context, obj *gqlmodel.ItemSchedule) (*gqlmodel.Item, error) {
+	return dataloaders(ctx).Item.Load(obj.ItemID)
+}
+
+// ScheduleItems is the resolver for the scheduleItems field.
+func (r *mutationResolver) ScheduleItems(ctx context.Context, input gqlmodel.ScheduleItemsInput) (*gqlmodel.ScheduleItemsPayload, error) {
+	iids, err := gqlmodel.ToIDs[id.Item](input.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Schedule.ScheduleItems(ctx, interfaces.ScheduleItemsParam{
+		Items:       iids,
+		PublishAt:   input.PublishAt,
+		UnpublishAt: input.UnpublishAt,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.ScheduleItemsPayload{
+		Schedules: lo.Map(res, func(s *schedule.Schedule, _ int) *gqlmodel.ItemSchedule { return gqlmodel.ToItemSchedule(s) }),
+	}, nil
+}
+
+// CancelItemSchedules is the resolver for the cancelItemSchedules field.
+func (r *mutationResolver) CancelItemSchedules(ctx context.Context, input gqlmodel.CancelItemSchedulesInput) (*gqlmodel.CancelItemSchedulesPayload, error) {
+	iids, err := gqlmodel.ToIDs[id.Item](input.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := usecases(ctx).Schedule.CancelItems(ctx, iids, getOperator(ctx)); err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.CancelItemSchedulesPayload{ItemIds: input.ItemIds}, nil
+}
+
+// ItemSchedules is the resolver for the itemSchedules field.
+func (r *queryResolver) ItemSchedules(ctx context.Context, projectID gqlmodel.ID, itemIds []gqlmodel.ID) ([]*gqlmodel.ItemSchedule, error) {
+	pid, err := gqlmodel.ToID[id.Project](projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res schedule.List
+	if itemIds != nil {
+		iids, err := gqlmodel.ToIDs[id.Item](itemIds)
+		if err != nil {
+			return nil, err
+		}
+		res, err = usecases(ctx).Schedule.FindByItems(ctx, iids, getOperator(ctx))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		res, err = usecases(ctx).Schedule.FindByProject(ctx, pid, getOperator(ctx))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.FilterMap(res, func(s *schedule.Schedule, _ int) (*gqlmodel.ItemSchedule, bool) {
+		return gqlmodel.ToItemSchedule(s), s.Project() == pid
+	}), nil
+}
+
+// ItemSchedule returns ItemScheduleResolver implementation.
+func (r *Resolver) ItemSchedule() ItemScheduleResolver { return &itemScheduleResolver{r} }
+
+type itemScheduleResolver struct{ *Resolver }
@@ -8,9 +8,41 @@ import (
 	"context"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/samber/lo"
 )
 
+// UpdateValidationRules is the resolver for the updateValidationRules field.
+func (r *mutationResolver) UpdateValidationRules(ctx context.Context, input gqlmodel.UpdateValidationRulesInput) (*gqlmodel.SchemaPayload, error) {
+	param := interfaces.FindOrCreateSchemaParam{
+		ModelID:  gqlmodel.ToIDRef[id.Model](input.ModelID),
+		GroupID:  gqlmodel.ToIDRef[id.Group](input.GroupID),
+		Metadata: input.Metadata,
+	}
+	s, err := usecases(ctx).Model.FindOrCreateSchema(ctx, param, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := gqlmodel.FromValidationRules(input.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err = usecases(ctx).Schema.UpdateValidationRules(ctx, interfaces.UpdateValidationRulesParam{
+		SchemaID: s.ID(),
+		Rules:    rules,
+	}, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.SchemaPayload{
+		Schema: gqlmodel.ToSchema(s),
+	}, nil
+}
+
 // TitleField is the resolver for the titleField field.
 func (r *schemaResolver) TitleField(ctx context.Context, obj *gqlmodel.Schema) (*gqlmodel.SchemaField, error) {
 	if obj.TitleFieldID == nil {
@@ -0,0 +1,50 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.73
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter/gql/gqlmodel"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+)
+
+// RestoreTrashEntry is the resolver for the restoreTrashEntry field.
+func (r *mutationResolver) RestoreTrashEntry(ctx context.Context, input gqlmodel.RestoreTrashEntryInput) (*gqlmodel.RestoreTrashEntryPayload, error) {
+	tid, err := gqlmodel.ToID[id.Trash](input.TrashEntryID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := usecases(ctx).Trash.Restore(ctx, tid, getOperator(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlmodel.RestoreTrashEntryPayload{
+		TrashEntry: gqlmodel.ToTrashEntry(res),
+	}, nil
+}
+
+// TrashEntries is the resolver for the trashEntries field.
+func (r *queryResolver) TrashEntries(ctx context.Context, projectID gqlmodel.ID, pagination *gqlmodel.Pagination) (*gqlmodel.TrashEntryConnection, error) {
+	return loaders(ctx).Trash.FindByProject(ctx, projectID, pagination)
+}
+
+// DeletedBy is the resolver for the deletedBy field.
+func (r *trashEntryResolver) DeletedBy(ctx context.Context, obj *gqlmodel.TrashEntry) (gqlmodel.Operator, error) {
+	if obj.UserID != nil {
+		return dataloaders(ctx).User.Load(*obj.UserID)
+	}
+	if obj.IntegrationID != nil {
+		return dataloaders(ctx).Integration.Load(*obj.IntegrationID)
+	}
+	return nil, nil
+}
+
+// TrashEntry returns TrashEntryResolver implementation.
+func (r *Resolver) TrashEntry() TrashEntryResolver { return &trashEntryResolver{r} }
+
+type trashEntryResolver struct{ *Resolver }
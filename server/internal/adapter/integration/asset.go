@@ -3,12 +3,15 @@ package integration
 import (
 	"context"
 	"errors"
+	"net/url"
+	"strconv"
 
 	"github.com/oapi-codegen/runtime"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/imaging"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
@@ -32,11 +35,17 @@ func (s *Server) AssetFilter(ctx context.Context, request AssetFilterRequestObje
 		}
 	}
 
+	mf, err := fromAssetMetadataFilter(request.Params)
+	if err != nil {
+		return AssetFilter400Response{}, err
+	}
+
 	p := fromPagination(request.Params.Page, request.Params.PerPage)
 	f := interfaces.AssetFilter{
 		Keyword:    request.Params.Keyword,
 		Sort:       sort,
 		Pagination: p,
+		Metadata:   mf,
 	}
 
 	assets, pi, err := uc.Asset.Search(ctx, request.ProjectId, f, op)
@@ -265,6 +274,41 @@ func (s *Server) AssetPublish(ctx context.Context, request AssetPublishRequestOb
 	return AssetPublish200JSONResponse(*aa), nil
 }
 
+func (s *Server) AssetTransformURL(ctx context.Context, request AssetTransformURLRequestObject) (AssetTransformURLResponseObject, error) {
+	uc := adapter.Usecases(ctx)
+	op := adapter.Operator(ctx)
+
+	q := url.Values{}
+	if request.Params.W != nil {
+		q.Set("w", strconv.Itoa(*request.Params.W))
+	}
+	if request.Params.H != nil {
+		q.Set("h", strconv.Itoa(*request.Params.H))
+	}
+	if request.Params.Fit != nil {
+		q.Set("fit", string(*request.Params.Fit))
+	}
+	if request.Params.Format != nil {
+		q.Set("format", string(*request.Params.Format))
+	}
+	o, err := imaging.ParseQuery(q)
+	if err != nil {
+		return AssetTransformURL400Response{}, err
+	}
+	if o == nil {
+		return AssetTransformURL400Response{}, imaging.ErrInvalidSize
+	}
+
+	u, err := uc.Asset.TransformURL(ctx, request.AssetId, *o, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return AssetTransformURL404Response{}, err
+		}
+		return AssetTransformURL400Response{}, err
+	}
+	return AssetTransformURL200JSONResponse{Url: &u}, nil
+}
+
 func (s *Server) AssetUnpublish(ctx context.Context, request AssetUnpublishRequestObject) (AssetUnpublishResponseObject, error) {
 	uc := adapter.Usecases(ctx)
 	op := adapter.Operator(ctx)
@@ -1,16 +1,21 @@
 package integration
 
 import (
+	"time"
+
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/group"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
@@ -61,15 +66,36 @@ func fromItemFieldParam(f integrationapi.Field, _ *schema.Field) interfaces.Item
 		k = id.NewKey(*f.Key).Ref()
 	}
 
+	var l locale.Locale
+	if f.Locale != nil {
+		// an invalid locale is kept as it is so that it is rejected as a locale not enabled in the project
+		l = locale.Locale(*f.Locale)
+		if pl, err := locale.Parse(*f.Locale); err == nil {
+			l = pl
+		}
+	}
+
 	return interfaces.ItemFieldParam{
 		Field: f.Id,
 		Key:   k,
 		// Type:  sf.Type(),
-		Value: v,
-		Group: f.Group,
+		Value:  v,
+		Group:  f.Group,
+		Locale: l,
 	}
 }
 
+func localeFromParam(s *string) (*locale.Locale, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	l, err := locale.Parse(*s)
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
 func convertFields(fields *[]integrationapi.Field, sp *schema.Package, appendDefault, isMeta bool) (res []interfaces.ItemFieldParam) {
 	res = []interfaces.ItemFieldParam{}
 	if fields == nil {
@@ -197,7 +223,7 @@ func fromQuery(sp schema.Package, req ItemFilterRequestObject) *item.Query {
 		c = fromCondition(sp, *req.Body.Filter)
 	}
 
-	return item.NewQuery(sp.Schema().Project(), req.ModelId, sp.Schema().ID().Ref(), lo.FromPtr(req.Params.Keyword), nil).
+	return item.NewQuery(sp.Schema().Project(), req.ModelId, sp.Schema().ID().Ref(), lo.FromPtr(req.Params.Keyword), atRef(req.Params.At)).
 		WithSort(s).
 		WithFilter(c)
 }
@@ -320,3 +346,34 @@ func fromProjectPublicationScope(p integrationapi.ProjectPublicationScope) *proj
 		return nil
 	}
 }
+
+// atRef returns the snapshot of the public ref at the time, which reads the items as they were public at the time.
+func atRef(at *time.Time) *version.Ref {
+	if at == nil {
+		return nil
+	}
+	return version.Public.At(*at).Ref()
+}
+
+func fromAssetMetadataFilter(p integrationapi.AssetFilterParams) (*asset.MetadataFilter, error) {
+	f := &asset.MetadataFilter{
+		MinWidth:       p.MinWidth,
+		MaxWidth:       p.MaxWidth,
+		MinHeight:      p.MinHeight,
+		MaxHeight:      p.MaxHeight,
+		CapturedAfter:  p.CapturedAfter,
+		CapturedBefore: p.CapturedBefore,
+		Header:         p.Header,
+	}
+	if p.Bbox != nil {
+		b, err := asset.ParseBBox(*p.Bbox)
+		if err != nil {
+			return nil, err
+		}
+		f.BBox = b
+	}
+	if f.IsEmpty() {
+		return nil, nil
+	}
+	return f, nil
+}
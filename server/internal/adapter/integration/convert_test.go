@@ -3,6 +3,7 @@ package integration
 import (
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/group"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/model"
@@ -254,3 +255,24 @@ func Test_fromRequestRole(t *testing.T) {
 		assert.Nil(t, actual)
 	})
 }
+
+func TestFromAssetMetadataFilter(t *testing.T) {
+	got, err := fromAssetMetadataFilter(integrationapi.AssetFilterParams{})
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = fromAssetMetadataFilter(integrationapi.AssetFilterParams{
+		MinWidth: lo.ToPtr(100),
+		Bbox:     lo.ToPtr("130,30,135,35"),
+		Header:   lo.ToPtr("name"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &asset.MetadataFilter{
+		MinWidth: lo.ToPtr(100),
+		BBox:     &asset.BBox{MinLng: 130, MinLat: 30, MaxLng: 135, MaxLat: 35},
+		Header:   lo.ToPtr("name"),
+	}, got)
+
+	_, err = fromAssetMetadataFilter(integrationapi.AssetFilterParams{Bbox: lo.ToPtr("130,30")})
+	assert.Equal(t, asset.ErrInvalidBBox, err)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 )
@@ -81,7 +82,12 @@ func (s *Server) ItemsAsGeoJSON(ctx context.Context, request ItemsAsGeoJSONReque
 		return ItemsAsGeoJSON400Response{}, err
 	}
 
-	featureCollections, err := uc.Item.ItemsAsGeoJSON(ctx, schemaPackage, request.Params.Page, request.Params.PerPage, op)
+	l, err := localeFromParam(request.Params.Locale)
+	if err != nil {
+		return ItemsAsGeoJSON400Response{}, err
+	}
+
+	featureCollections, err := uc.Item.ItemsAsGeoJSON(ctx, schemaPackage, request.Params.Page, request.Params.PerPage, l, op)
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return ItemsAsGeoJSON404Response{}, err
@@ -107,7 +113,12 @@ func (s *Server) ItemsAsCSV(ctx context.Context, request ItemsAsCSVRequestObject
 		return ItemsAsCSV400Response{}, err
 	}
 
-	pr, err := uc.Item.ItemsAsCSV(ctx, schemaPackage, request.Params.Page, request.Params.PerPage, op)
+	l, err := localeFromParam(request.Params.Locale)
+	if err != nil {
+		return ItemsAsCSV400Response{}, err
+	}
+
+	pr, err := uc.Item.ItemsAsCSV(ctx, schemaPackage, request.Params.Page, request.Params.PerPage, l, op)
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return ItemsAsCSV404Response{}, err
@@ -146,8 +157,14 @@ func (s *Server) ItemFilterWithProject(ctx context.Context, request ItemFilterWi
 	}
 
 	p := fromPagination(request.Params.Page, request.Params.PerPage)
-	// TODO: support sort
-	items, pi, err := adapter.Usecases(ctx).Item.FindBySchema(ctx, sp.Schema().ID(), nil, p, op)
+	var items item.VersionedList
+	var pi *usecasex.PageInfo
+	if ref := atRef(request.Params.At); ref != nil {
+		items, pi, err = uc.Item.FindPublicByModel(ctx, m.ID(), ref, p, op)
+	} else {
+		// TODO: support sort
+		items, pi, err = uc.Item.FindBySchema(ctx, sp.Schema().ID(), nil, p, op)
+	}
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return ItemFilterWithProject404Response{}, err
@@ -214,7 +231,12 @@ func (s *Server) ItemsWithProjectAsGeoJSON(ctx context.Context, request ItemsWit
 		return ItemsWithProjectAsGeoJSON400Response{}, err
 	}
 
-	featureCollections, err := uc.Item.ItemsAsGeoJSON(ctx, schemaPackage, request.Params.Page, request.Params.PerPage, op)
+	l, err := localeFromParam(request.Params.Locale)
+	if err != nil {
+		return ItemsWithProjectAsGeoJSON400Response{}, err
+	}
+
+	featureCollections, err := uc.Item.ItemsAsGeoJSON(ctx, schemaPackage, request.Params.Page, request.Params.PerPage, l, op)
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return ItemsWithProjectAsGeoJSON404Response{}, err
@@ -256,7 +278,12 @@ func (s *Server) ItemsWithProjectAsCSV(ctx context.Context, request ItemsWithPro
 		return ItemsWithProjectAsCSV400Response{}, err
 	}
 
-	pr, err := uc.Item.ItemsAsCSV(ctx, schemaPackage, request.Params.Page, request.Params.PerPage, op)
+	l, err := localeFromParam(request.Params.Locale)
+	if err != nil {
+		return ItemsWithProjectAsCSV400Response{}, err
+	}
+
+	pr, err := uc.Item.ItemsAsCSV(ctx, schemaPackage, request.Params.Page, request.Params.PerPage, l, op)
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return ItemsWithProjectAsCSV404Response{}, err
@@ -389,6 +416,44 @@ func (s *Server) ItemUpdate(ctx context.Context, request ItemUpdateRequestObject
 	return ItemUpdate200JSONResponse(integrationapi.NewVersionedItem(i, sp.Schema(), assetContext(ctx, assets, request.Body.Asset), getReferencedItems(ctx, i), sp.MetaSchema(), metaItem, sp.GroupSchemas())), nil
 }
 
+func (s *Server) ItemRestore(ctx context.Context, request ItemRestoreRequestObject) (ItemRestoreResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	ver := version.ParseVersionOrRef(request.Body.Version)
+	if ver.IsZero() {
+		return ItemRestore400Response{}, rerror.ErrInvalidParams
+	}
+
+	res, err := uc.Item.Restore(ctx, request.ItemId, ver, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemRestore404Response{}, err
+		}
+		return ItemRestore400Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, res.Item.Value().Model(), op)
+	if err != nil {
+		return ItemRestore500Response{}, err
+	}
+
+	var mi item.Versioned
+	if mid := res.Item.Value().MetadataItem(); mid != nil {
+		mi, err = uc.Item.FindByID(ctx, *mid, op)
+		if err != nil {
+			return ItemRestore500Response{}, err
+		}
+	}
+
+	return ItemRestore200JSONResponse{
+		Item:              lo.ToPtr(integrationapi.NewVersionedItem(res.Item, sp.Schema(), nil, getReferencedItems(ctx, res.Item), sp.MetaSchema(), mi, sp.GroupSchemas())),
+		Changes:           lo.ToPtr(integrationapi.ToItemFieldChanges(res.Changes, sp)),
+		RemovedFields:     lo.ToPtr([]id.FieldID(res.RemovedFields)),
+		TypeChangedFields: lo.ToPtr([]id.FieldID(res.TypeChangedFields)),
+	}, nil
+}
+
 func (s *Server) ItemDelete(ctx context.Context, request ItemDeleteRequestObject) (ItemDeleteResponseObject, error) {
 	op := adapter.Operator(ctx)
 	uc := adapter.Usecases(ctx)
@@ -409,7 +474,13 @@ func (s *Server) ItemGet(ctx context.Context, request ItemGetRequestObject) (Ite
 	op := adapter.Operator(ctx)
 	uc := adapter.Usecases(ctx)
 
-	i, err := uc.Item.FindByID(ctx, request.ItemId, op)
+	var i item.Versioned
+	var err error
+	if ref := atRef(request.Params.At); ref != nil {
+		i, err = uc.Item.FindPublicByID(ctx, request.ItemId, ref, op)
+	} else {
+		i, err = uc.Item.FindByID(ctx, request.ItemId, op)
+	}
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
 			return ItemGet404Response{}, err
@@ -446,6 +517,36 @@ func (s *Server) ItemGet(ctx context.Context, request ItemGetRequestObject) (Ite
 	return ItemGet200JSONResponse(integrationapi.NewVersionedItem(i, schm, assetContext(ctx, assets, request.Params.Asset), getReferencedItems(ctx, i), ms, mi, sp.GroupSchemas())), nil
 }
 
+func (s *Server) ItemDiff(ctx context.Context, request ItemDiffRequestObject) (ItemDiffResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	from, to := version.ParseVersionOrRef(request.Params.From), version.ParseVersionOrRef(request.Params.To)
+	if from.IsZero() || to.IsZero() {
+		return ItemDiff400Response{}, rerror.ErrInvalidParams
+	}
+
+	d, err := uc.Item.Diff(ctx, request.ItemId, from, to, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemDiff404Response{}, err
+		}
+		return ItemDiff500Response{}, err
+	}
+
+	i, err := uc.Item.FindByID(ctx, request.ItemId, op)
+	if err != nil {
+		return ItemDiff500Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, i.Value().Model(), op)
+	if err != nil {
+		return ItemDiff500Response{}, err
+	}
+
+	return ItemDiff200JSONResponse(integrationapi.NewItemDiff(d, sp)), nil
+}
+
 func createItem(ctx context.Context, uc *interfaces.Container, m *model.Model, fields, metaFields *[]integrationapi.Field, op *usecase.Operator) (*integrationapi.VersionedItem, error) {
 	sp, err := uc.Schema.FindByModel(ctx, m.ID(), op)
 	if err != nil {
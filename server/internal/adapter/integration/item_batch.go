@@ -0,0 +1,232 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) ItemBatchCreate(ctx context.Context, request ItemBatchCreateRequestObject) (ItemBatchCreateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if request.Body == nil {
+		return ItemBatchCreate400JSONResponse{}, interfaces.ErrEmptyBatch
+	}
+	if len(request.Body.Items) > interfaces.MaxBatchItems {
+		return ItemBatchCreate400JSONResponse{}, interfaces.ErrTooManyItemsInBatch
+	}
+
+	m, err := uc.Model.FindByID(ctx, request.ModelId, op)
+	if err != nil {
+		return ItemBatchCreate400JSONResponse{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, m.ID(), op)
+	if err != nil {
+		return ItemBatchCreate400JSONResponse{}, err
+	}
+
+	params := make([]interfaces.BatchCreateItemParam, 0, len(request.Body.Items))
+	for _, it := range request.Body.Items {
+		p := interfaces.BatchCreateItemParam{
+			CreateItemParam: interfaces.CreateItemParam{
+				SchemaID: sp.Schema().ID(),
+				ModelID:  m.ID(),
+				Fields:   convertFields(it.Fields, sp, true, false),
+			},
+		}
+		if m.Metadata() != nil {
+			p.Metadata = &interfaces.CreateItemParam{
+				SchemaID: sp.MetaSchema().ID(),
+				ModelID:  m.ID(),
+				Fields:   convertFields(it.MetadataFields, sp, true, true),
+			}
+		}
+		params = append(params, p)
+	}
+
+	res, err := uc.Item.BatchCreate(ctx, params, lo.FromPtr(request.Body.Atomic), op)
+	if res == nil {
+		return ItemBatchCreate400JSONResponse{}, err
+	}
+
+	schemas := batchSchemas{m.ID(): sp}
+	body := schemas.result(ctx, res, op)
+	if err != nil {
+		return ItemBatchCreate400JSONResponse(body), nil
+	}
+	return ItemBatchCreate200JSONResponse(body), nil
+}
+
+func (s *Server) ItemBatchUpdate(ctx context.Context, request ItemBatchUpdateRequestObject) (ItemBatchUpdateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if request.Body == nil {
+		return ItemBatchUpdate400JSONResponse{}, interfaces.ErrEmptyBatch
+	}
+	if len(request.Body.Items) > interfaces.MaxBatchItems {
+		return ItemBatchUpdate400JSONResponse{}, interfaces.ErrTooManyItemsInBatch
+	}
+
+	itemIDs := make(id.ItemIDList, 0, len(request.Body.Items))
+	for _, it := range request.Body.Items {
+		itemIDs = append(itemIDs, it.Id)
+	}
+	items, err := uc.Item.FindByIDs(ctx, itemIDs, op)
+	if err != nil {
+		return ItemBatchUpdate400JSONResponse{}, err
+	}
+
+	schemas := batchSchemas{}
+	// items which do not exist are reported without being passed to the usecase
+	var missing []int
+	var indexes []int
+	params := make([]interfaces.BatchUpdateItemParam, 0, len(request.Body.Items))
+	for k, it := range request.Body.Items {
+		i := items.Item(it.Id)
+		if i == nil {
+			missing = append(missing, k)
+			continue
+		}
+		sp := schemas.find(ctx, i.Value().Model(), op)
+		if sp == nil {
+			missing = append(missing, k)
+			continue
+		}
+
+		p := interfaces.BatchUpdateItemParam{
+			UpdateItemParam: interfaces.UpdateItemParam{ItemID: it.Id},
+		}
+		if it.Fields != nil {
+			p.Fields = convertFields(it.Fields, sp, false, false)
+		}
+		if sp.MetaSchema() != nil && it.MetadataFields != nil {
+			p.MetadataFields = convertFields(it.MetadataFields, sp, false, true)
+		}
+		params = append(params, p)
+		indexes = append(indexes, k)
+	}
+
+	atomic := lo.FromPtr(request.Body.Atomic)
+	res := make([]interfaces.BatchItemResult, len(request.Body.Items))
+	for _, k := range missing {
+		res[k] = interfaces.BatchItemResult{ID: request.Body.Items[k].Id.Ref(), Err: rerror.ErrNotFound}
+	}
+
+	if atomic && len(missing) > 0 {
+		for _, k := range indexes {
+			res[k] = interfaces.BatchItemResult{ID: request.Body.Items[k].Id.Ref(), Err: interfaces.ErrBatchAborted}
+		}
+		return ItemBatchUpdate400JSONResponse(schemas.result(ctx, res, op)), nil
+	}
+
+	if len(params) > 0 {
+		updated, err := uc.Item.BatchUpdate(ctx, params, atomic, op)
+		if updated == nil {
+			return ItemBatchUpdate400JSONResponse{}, err
+		}
+		for j, k := range indexes {
+			res[k] = updated[j]
+		}
+		if err != nil {
+			return ItemBatchUpdate400JSONResponse(schemas.result(ctx, res, op)), nil
+		}
+	}
+	return ItemBatchUpdate200JSONResponse(schemas.result(ctx, res, op)), nil
+}
+
+func (s *Server) ItemBatchDelete(ctx context.Context, request ItemBatchDeleteRequestObject) (ItemBatchDeleteResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if request.Body == nil {
+		return ItemBatchDelete400JSONResponse{}, interfaces.ErrEmptyBatch
+	}
+	if len(request.Body.ItemIds) > interfaces.MaxBatchItems {
+		return ItemBatchDelete400JSONResponse{}, interfaces.ErrTooManyItemsInBatch
+	}
+
+	res, err := uc.Item.BatchDelete(ctx, request.Body.ItemIds, lo.FromPtr(request.Body.Atomic), op)
+	if res == nil {
+		return ItemBatchDelete400JSONResponse{}, err
+	}
+
+	body := batchSchemas{}.result(ctx, res, op)
+	if err != nil {
+		return ItemBatchDelete400JSONResponse(body), nil
+	}
+	return ItemBatchDelete200JSONResponse(body), nil
+}
+
+func (s *Server) ItemBatchPublish(ctx context.Context, request ItemBatchPublishRequestObject) (ItemBatchPublishResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if request.Body == nil {
+		return ItemBatchPublish400JSONResponse{}, interfaces.ErrEmptyBatch
+	}
+	if len(request.Body.ItemIds) > interfaces.MaxBatchItems {
+		return ItemBatchPublish400JSONResponse{}, interfaces.ErrTooManyItemsInBatch
+	}
+
+	res, err := uc.Item.BatchPublish(ctx, request.Body.ItemIds, lo.FromPtr(request.Body.Atomic), op)
+	if res == nil {
+		return ItemBatchPublish400JSONResponse{}, err
+	}
+
+	body := batchSchemas{}.result(ctx, res, op)
+	if err != nil {
+		return ItemBatchPublish400JSONResponse(body), nil
+	}
+	return ItemBatchPublish200JSONResponse(body), nil
+}
+
+// batchSchemas caches schema packages by model while building the result of a batch.
+type batchSchemas map[id.ModelID]*schema.Package
+
+func (s batchSchemas) find(ctx context.Context, mid id.ModelID, op *usecase.Operator) *schema.Package {
+	if sp, ok := s[mid]; ok {
+		return sp
+	}
+	sp, err := adapter.Usecases(ctx).Schema.FindByModel(ctx, mid, op)
+	if err != nil {
+		sp = nil
+	}
+	s[mid] = sp
+	return sp
+}
+
+func (s batchSchemas) result(ctx context.Context, res []interfaces.BatchItemResult, op *usecase.Operator) integrationapi.ItemBatchResult {
+	entries := make([]integrationapi.ItemBatchResultEntry, 0, len(res))
+	failed := 0
+	for k, r := range res {
+		e := integrationapi.ItemBatchResultEntry{
+			Index: lo.ToPtr(k),
+			Id:    r.ID,
+		}
+		if r.Err != nil {
+			failed++
+			e.Error = lo.ToPtr(r.Err.Error())
+		} else if r.Item != nil {
+			if sp := s.find(ctx, r.Item.Value().Model(), op); sp != nil {
+				e.Item = lo.ToPtr(integrationapi.NewVersionedItem(r.Item, sp.Schema(), nil, getReferencedItems(ctx, r.Item), sp.MetaSchema(), r.Metadata, sp.GroupSchemas()))
+			}
+		}
+		entries = append(entries, e)
+	}
+
+	return integrationapi.ItemBatchResult{
+		Results:   &entries,
+		Succeeded: lo.ToPtr(len(res) - failed),
+		Failed:    lo.ToPtr(failed),
+	}
+}
@@ -0,0 +1,214 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) ItemTemplateList(ctx context.Context, request ItemTemplateListRequestObject) (ItemTemplateListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	sp, err := uc.Schema.FindByModel(ctx, request.ModelId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTemplateList404Response{}, err
+		}
+		return ItemTemplateList400Response{}, err
+	}
+
+	res, err := uc.ItemTemplate.FindByModel(ctx, request.ModelId, op)
+	if err != nil {
+		return ItemTemplateList500Response{}, err
+	}
+
+	return ItemTemplateList200JSONResponse{
+		Templates: lo.ToPtr(integrationapi.NewItemTemplates(res, sp)),
+	}, nil
+}
+
+func (s *Server) ItemTemplateCreate(ctx context.Context, request ItemTemplateCreateRequestObject) (ItemTemplateCreateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	sp, err := uc.Schema.FindByModel(ctx, request.ModelId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTemplateCreate404Response{}, err
+		}
+		return ItemTemplateCreate400Response{}, err
+	}
+
+	t, err := uc.ItemTemplate.Create(ctx, interfaces.CreateItemTemplateParam{
+		ModelID:        request.ModelId,
+		Name:           request.Body.Name,
+		Description:    lo.FromPtr(request.Body.Description),
+		Fields:         convertFields(request.Body.Fields, sp, false, false),
+		MetadataFields: convertFields(request.Body.MetadataFields, sp, false, true),
+		SourceItem:     request.Body.SourceItemId,
+	}, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTemplateCreate404Response{}, err
+		}
+		return ItemTemplateCreate400Response{}, err
+	}
+
+	return ItemTemplateCreate200JSONResponse(*integrationapi.NewItemTemplate(t, sp)), nil
+}
+
+func (s *Server) ItemTemplateGet(ctx context.Context, request ItemTemplateGetRequestObject) (ItemTemplateGetResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	t, err := uc.ItemTemplate.FindByID(ctx, request.TemplateId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTemplateGet404Response{}, err
+		}
+		return ItemTemplateGet400Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, t.Model(), op)
+	if err != nil {
+		return ItemTemplateGet500Response{}, err
+	}
+
+	return ItemTemplateGet200JSONResponse(*integrationapi.NewItemTemplate(t, sp)), nil
+}
+
+func (s *Server) ItemTemplateUpdate(ctx context.Context, request ItemTemplateUpdateRequestObject) (ItemTemplateUpdateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	t, err := uc.ItemTemplate.FindByID(ctx, request.TemplateId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTemplateUpdate404Response{}, err
+		}
+		return ItemTemplateUpdate400Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, t.Model(), op)
+	if err != nil {
+		return ItemTemplateUpdate500Response{}, err
+	}
+
+	param := interfaces.UpdateItemTemplateParam{
+		ID:          request.TemplateId,
+		Name:        request.Body.Name,
+		Description: request.Body.Description,
+	}
+	if request.Body.Fields != nil {
+		param.Fields = convertFields(request.Body.Fields, sp, false, false)
+	}
+	if request.Body.MetadataFields != nil {
+		param.MetadataFields = convertFields(request.Body.MetadataFields, sp, false, true)
+	}
+
+	t, err = uc.ItemTemplate.Update(ctx, param, op)
+	if err != nil {
+		return ItemTemplateUpdate400Response{}, err
+	}
+
+	return ItemTemplateUpdate200JSONResponse(*integrationapi.NewItemTemplate(t, sp)), nil
+}
+
+func (s *Server) ItemTemplateDelete(ctx context.Context, request ItemTemplateDeleteRequestObject) (ItemTemplateDeleteResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if err := uc.ItemTemplate.Delete(ctx, request.TemplateId, op); err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemTemplateDelete404Response{}, err
+		}
+		return ItemTemplateDelete400Response{}, err
+	}
+
+	return ItemTemplateDelete200JSONResponse{
+		Id: &request.TemplateId,
+	}, nil
+}
+
+func (s *Server) ItemCreateFromTemplate(ctx context.Context, request ItemCreateFromTemplateRequestObject) (ItemCreateFromTemplateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	t, err := uc.ItemTemplate.FindByID(ctx, request.TemplateId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemCreateFromTemplate404Response{}, err
+		}
+		return ItemCreateFromTemplate400Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, t.Model(), op)
+	if err != nil {
+		return ItemCreateFromTemplate500Response{}, err
+	}
+
+	param := interfaces.CreateItemFromTemplateParam{
+		TemplateID: request.TemplateId,
+	}
+	if request.Body != nil {
+		param.Fields = convertFields(request.Body.Fields, sp, false, false)
+		param.MetadataFields = convertFields(request.Body.MetadataFields, sp, false, true)
+	}
+
+	i, err := uc.Item.CreateFromTemplate(ctx, param, op)
+	if err != nil {
+		return ItemCreateFromTemplate400Response{}, err
+	}
+
+	res, err := copiedItem(ctx, uc, i, sp, op)
+	if err != nil {
+		return ItemCreateFromTemplate500Response{}, err
+	}
+	return ItemCreateFromTemplate200JSONResponse(res), nil
+}
+
+func (s *Server) ItemDuplicate(ctx context.Context, request ItemDuplicateRequestObject) (ItemDuplicateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	i, err := uc.Item.Duplicate(ctx, request.ItemId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ItemDuplicate404Response{}, err
+		}
+		return ItemDuplicate400Response{}, err
+	}
+
+	sp, err := uc.Schema.FindByModel(ctx, i.Value().Model(), op)
+	if err != nil {
+		return ItemDuplicate500Response{}, err
+	}
+
+	res, err := copiedItem(ctx, uc, i, sp, op)
+	if err != nil {
+		return ItemDuplicate500Response{}, err
+	}
+	return ItemDuplicate200JSONResponse(res), nil
+}
+
+// copiedItem converts an item created from a template or a duplicated item with its metadata item.
+func copiedItem(ctx context.Context, uc *interfaces.Container, i item.Versioned, sp *schema.Package, op *usecase.Operator) (integrationapi.VersionedItem, error) {
+	var metaItem item.Versioned
+	if mid := i.Value().MetadataItem(); mid != nil {
+		mi, err := uc.Item.FindByID(ctx, *mid, op)
+		if err != nil {
+			return integrationapi.VersionedItem{}, err
+		}
+		metaItem = mi
+	}
+	return integrationapi.NewVersionedItem(i, sp.Schema(), nil, getReferencedItems(ctx, i), sp.MetaSchema(), metaItem, sp.GroupSchemas()), nil
+}
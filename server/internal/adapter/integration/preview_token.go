@@ -0,0 +1,90 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) PreviewTokenList(ctx context.Context, request PreviewTokenListRequestObject) (PreviewTokenListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	prj, err := uc.Project.FindByIDOrAlias(ctx, request.ProjectIdOrAlias, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return PreviewTokenList404Response{}, err
+		}
+		return PreviewTokenList400Response{}, err
+	}
+
+	p := fromPagination(request.Params.Page, request.Params.PerPage)
+	res, pi, err := uc.PreviewToken.FindByProject(ctx, prj.ID(), p, op)
+	if err != nil {
+		return PreviewTokenList500Response{}, err
+	}
+
+	return PreviewTokenList200JSONResponse{
+		PreviewTokens: lo.ToPtr(integrationapi.NewPreviewTokens(res)),
+		Page:          lo.ToPtr(Page(*p.Offset)),
+		PerPage:       lo.ToPtr(int(p.Offset.Limit)),
+		TotalCount:    lo.ToPtr(int(pi.TotalCount)),
+	}, nil
+}
+
+func (s *Server) PreviewTokenIssue(ctx context.Context, request PreviewTokenIssueRequestObject) (PreviewTokenIssueResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	prj, err := uc.Project.FindByIDOrAlias(ctx, request.ProjectIdOrAlias, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return PreviewTokenIssue404Response{}, err
+		}
+		return PreviewTokenIssue400Response{}, err
+	}
+
+	param := interfaces.IssuePreviewTokenParam{
+		ProjectID: prj.ID(),
+	}
+	if request.Body != nil {
+		param.RequestID = request.Body.RequestId
+		if request.Body.Ttl != nil {
+			param.TTL = lo.ToPtr(time.Duration(*request.Body.Ttl) * time.Second)
+		}
+	}
+
+	t, token, err := uc.PreviewToken.Issue(ctx, param, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return PreviewTokenIssue404Response{}, err
+		}
+		return PreviewTokenIssue400Response{}, err
+	}
+
+	return PreviewTokenIssue200JSONResponse{
+		PreviewToken: *integrationapi.NewPreviewToken(t),
+		Token:        token,
+	}, nil
+}
+
+func (s *Server) PreviewTokenRevoke(ctx context.Context, request PreviewTokenRevokeRequestObject) (PreviewTokenRevokeResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	t, err := uc.PreviewToken.Revoke(ctx, request.PreviewTokenId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return PreviewTokenRevoke404Response{}, err
+		}
+		return PreviewTokenRevoke400Response{}, err
+	}
+
+	return PreviewTokenRevoke200JSONResponse(*integrationapi.NewPreviewToken(t)), nil
+}
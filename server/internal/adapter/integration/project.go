@@ -3,16 +3,28 @@ package integration
 import (
 	"context"
 	"errors"
+	"io"
+	"mime/multipart"
+	"os"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
 )
 
+// maxProjectArchiveSize is the maximum size of a project archive to import.
+const maxProjectArchiveSize int64 = 10 << 30
+
+const maxProjectImportAliasSize = 1 << 10
+
+var ErrProjectArchiveTooLarge = rerror.NewE(i18n.T("project archive is too large"))
+
 func (s *Server) ProjectFilter(ctx context.Context, request ProjectFilterRequestObject) (ProjectFilterResponseObject, error) {
 	uc := adapter.Usecases(ctx)
 	op := adapter.Operator(ctx)
@@ -142,6 +154,7 @@ func (s *Server) ProjectUpdate(ctx context.Context, request ProjectUpdateRequest
 		pub = &interfaces.UpdateProjectPublicationParam{
 			Scope:       scope,
 			AssetPublic: request.Body.Publication.AssetPublic,
+			CacheMaxAge: request.Body.Publication.CacheMaxAge,
 		}
 	}
 
@@ -188,3 +201,127 @@ func (s *Server) ProjectDelete(ctx context.Context, request ProjectDeleteRequest
 		Id: &id,
 	}, nil
 }
+
+func (s *Server) ProjectExport(ctx context.Context, request ProjectExportRequestObject) (ProjectExportResponseObject, error) {
+	uc := adapter.Usecases(ctx)
+	op := adapter.Operator(ctx)
+
+	if request.WorkspaceId.IsEmpty() {
+		return ProjectExport400Response{}, rerror.ErrInvalidParams
+	}
+
+	if !op.IsReadableWorkspace(request.WorkspaceId) {
+		return ProjectExport404Response{}, rerror.ErrNotFound
+	}
+
+	res, err := uc.Project.Export(ctx, request.ProjectId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) || errors.Is(err, interfaces.ErrOperationDenied) {
+			return ProjectExport404Response{}, err
+		}
+		return ProjectExport500Response{}, rerror.ErrInternalBy(err)
+	}
+
+	return ProjectExport200ApplicationzipResponse{
+		Body: res.PipeReader,
+	}, nil
+}
+
+func (s *Server) ProjectImport(ctx context.Context, request ProjectImportRequestObject) (ProjectImportResponseObject, error) {
+	uc := adapter.Usecases(ctx)
+	op := adapter.Operator(ctx)
+
+	if request.WorkspaceId.IsEmpty() || request.Body == nil {
+		return ProjectImport400Response{}, rerror.ErrInvalidParams
+	}
+
+	if !op.IsWritableWorkspace(request.WorkspaceId) {
+		return ProjectImport404Response{}, rerror.ErrNotFound
+	}
+
+	// zip archives need random access, so the file is streamed to a temporary file instead of being read in memory
+	f, alias, err := readProjectArchive(request.Body)
+	if err != nil {
+		return ProjectImport400Response{}, err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+	st, err := f.Stat()
+	if err != nil {
+		return ProjectImport500Response{}, rerror.ErrInternalBy(err)
+	}
+	if st.Size() == 0 {
+		return ProjectImport400Response{}, ErrFileIsMissing
+	}
+
+	p, err := uc.Project.Import(ctx, interfaces.ImportProjectParam{
+		WorkspaceID: request.WorkspaceId,
+		Alias:       alias,
+		Reader:      f,
+		Size:        st.Size(),
+	}, op)
+	if err != nil {
+		if errors.Is(err, gateway.ErrInvalidProjectArchive) || errors.Is(err, interfaces.ErrProjectAliasAlreadyUsed) || errors.Is(err, project.ErrInvalidAlias) {
+			return ProjectImport400Response{}, err
+		}
+		if errors.Is(err, interfaces.ErrOperationDenied) {
+			return ProjectImport404Response{}, err
+		}
+		return ProjectImport500Response{}, rerror.ErrInternalBy(err)
+	}
+
+	return ProjectImport201JSONResponse(integrationapi.NewProject(p)), nil
+}
+
+// readProjectArchive reads the multipart body of a project import and writes the archive to a temporary file.
+// The temporary file has to be removed by the caller.
+func readProjectArchive(mr *multipart.Reader) (f *os.File, alias *string, err error) {
+	defer func() {
+		if err != nil && f != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+			f = nil
+		}
+	}()
+
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return f, nil, err
+		}
+
+		switch part.FormName() {
+		case "alias":
+			b, err := io.ReadAll(io.LimitReader(part, maxProjectImportAliasSize))
+			if err != nil {
+				return f, nil, err
+			}
+			alias = lo.ToPtr(string(b))
+		case "file":
+			if f != nil {
+				return f, nil, rerror.ErrInvalidParams
+			}
+			if f, err = os.CreateTemp("", "reearth-cms-project-*.zip"); err != nil {
+				return f, nil, err
+			}
+			n, err := io.Copy(f, io.LimitReader(part, maxProjectArchiveSize+1))
+			if err != nil {
+				return f, nil, err
+			}
+			if n > maxProjectArchiveSize {
+				return f, nil, ErrProjectArchiveTooLarge
+			}
+		}
+		_ = part.Close()
+	}
+
+	if f == nil {
+		return nil, nil, ErrFileIsMissing
+	}
+	return f, alias, nil
+}
@@ -0,0 +1,103 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) ScheduleList(ctx context.Context, request ScheduleListRequestObject) (ScheduleListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	prj, err := uc.Project.FindByIDOrAlias(ctx, request.ProjectIdOrAlias, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleList404Response{}, err
+		}
+		return ScheduleList400Response{}, err
+	}
+
+	res, err := uc.Schedule.FindByProject(ctx, prj.ID(), op)
+	if err != nil {
+		return ScheduleList500Response{}, err
+	}
+
+	return ScheduleList200JSONResponse{
+		Schedules: lo.ToPtr(integrationapi.NewItemSchedules(res)),
+	}, nil
+}
+
+func (s *Server) ScheduleCreate(ctx context.Context, request ScheduleCreateRequestObject) (ScheduleCreateResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	prj, err := uc.Project.FindByIDOrAlias(ctx, request.ProjectIdOrAlias, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return ScheduleCreate404Response{}, err
+		}
+		return ScheduleCreate400Response{}, err
+	}
+
+	items, err := uc.Item.FindByIDs(ctx, request.Body.ItemIds, op)
+	if err != nil {
+		return ScheduleCreate400Response{}, err
+	}
+	if len(items) != len(request.Body.ItemIds) || lo.ContainsBy(items, func(i item.Versioned) bool {
+		return i.Value().Project() != prj.ID()
+	}) {
+		return ScheduleCreate404Response{}, interfaces.ErrItemMissing
+	}
+
+	res, err := uc.Schedule.ScheduleItems(ctx, interfaces.ScheduleItemsParam{
+		Items:       request.Body.ItemIds,
+		PublishAt:   request.Body.PublishAt,
+		UnpublishAt: request.Body.UnpublishAt,
+	}, op)
+	if err != nil {
+		return ScheduleCreate400Response{}, err
+	}
+
+	return ScheduleCreate200JSONResponse{
+		Schedules: lo.ToPtr(integrationapi.NewItemSchedules(res)),
+	}, nil
+}
+
+func (s *Server) ItemScheduleGet(ctx context.Context, request ItemScheduleGetRequestObject) (ItemScheduleGetResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	res, err := uc.Schedule.FindByItems(ctx, id.ItemIDList{request.ItemId}, op)
+	if err != nil {
+		return ItemScheduleGet500Response{}, err
+	}
+	if len(res) == 0 {
+		return ItemScheduleGet404Response{}, rerror.ErrNotFound
+	}
+
+	return ItemScheduleGet200JSONResponse(*integrationapi.NewItemSchedule(res[0])), nil
+}
+
+func (s *Server) ItemScheduleDelete(ctx context.Context, request ItemScheduleDeleteRequestObject) (ItemScheduleDeleteResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if err := uc.Schedule.CancelItems(ctx, id.ItemIDList{request.ItemId}, op); err != nil {
+		if errors.Is(err, rerror.ErrNotFound) || errors.Is(err, interfaces.ErrItemMissing) {
+			return ItemScheduleDelete404Response{}, err
+		}
+		return ItemScheduleDelete400Response{}, err
+	}
+
+	return ItemScheduleDelete200JSONResponse{
+		Id: request.ItemId.Ref(),
+	}, nil
+}
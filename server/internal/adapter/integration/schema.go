@@ -98,9 +98,11 @@ func (s *Server) FieldCreate(ctx context.Context, request FieldCreateRequestObje
 		Multiple:     *request.Body.Multiple,
 		Unique:       false,
 		Required:     *request.Body.Required,
+		Localized:    lo.FromPtr(request.Body.Localized),
 		IsTitle:      false,
 		TypeProperty: tp,
 		DefaultValue: dv,
+		Expression:   lo.FromPtr(request.Body.Expression),
 	}
 	f, err := uc.Schema.CreateField(ctx, param, op)
 	if err != nil {
@@ -159,9 +161,11 @@ func (s *Server) FieldCreateWithProject(ctx context.Context, request FieldCreate
 		Multiple:     *request.Body.Multiple,
 		Unique:       false,
 		Required:     *request.Body.Required,
+		Localized:    lo.FromPtr(request.Body.Localized),
 		IsTitle:      false,
 		TypeProperty: tp,
 		DefaultValue: dv,
+		Expression:   lo.FromPtr(request.Body.Expression),
 	}
 	f, err := uc.Schema.CreateField(ctx, param, op)
 	if err != nil {
@@ -210,9 +214,11 @@ func (s *Server) FieldUpdate(ctx context.Context, request FieldUpdateRequestObje
 		Multiple:     request.Body.Multiple,
 		Unique:       lo.ToPtr(false),
 		Required:     request.Body.Required,
+		Localized:    request.Body.Localized,
 		IsTitle:      lo.ToPtr(false),
 		TypeProperty: nil,
 		DefaultValue: nil,
+		Expression:   request.Body.Expression,
 	}
 	f, err = uc.Schema.UpdateField(ctx, param, op)
 	if err != nil {
@@ -277,9 +283,11 @@ func (s *Server) FieldUpdateWithProject(ctx context.Context, request FieldUpdate
 		Multiple:     request.Body.Multiple,
 		Unique:       lo.ToPtr(false),
 		Required:     request.Body.Required,
+		Localized:    request.Body.Localized,
 		IsTitle:      lo.ToPtr(false),
 		TypeProperty: tp,
 		DefaultValue: dv,
+		Expression:   request.Body.Expression,
 	}
 	f, err = uc.Schema.UpdateField(ctx, param, op)
 	if err != nil {
@@ -0,0 +1,73 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) SchemaSpecPlan(ctx context.Context, request SchemaSpecPlanRequestObject) (SchemaSpecPlanResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if request.Body == nil {
+		return SchemaSpecPlan400Response{}, rerror.ErrInvalidParams
+	}
+
+	p, err := uc.Project.FindByIDOrAlias(ctx, request.ProjectIdOrAlias, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return SchemaSpecPlan404Response{}, err
+		}
+		return SchemaSpecPlan500Response{}, err
+	}
+
+	plan, err := uc.Schema.PlanSpec(ctx, interfaces.SchemaSpecParam{
+		ProjectID: p.ID(),
+		Spec:      request.Body.Into(),
+	}, op)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrOperationDenied) {
+			return SchemaSpecPlan404Response{}, err
+		}
+		return SchemaSpecPlan400Response{}, err
+	}
+
+	return SchemaSpecPlan200JSONResponse(integrationapi.NewSchemaPlan(plan)), nil
+}
+
+func (s *Server) SchemaSpecApply(ctx context.Context, request SchemaSpecApplyRequestObject) (SchemaSpecApplyResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	if request.Body == nil {
+		return SchemaSpecApply400Response{}, rerror.ErrInvalidParams
+	}
+
+	p, err := uc.Project.FindByIDOrAlias(ctx, request.ProjectIdOrAlias, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return SchemaSpecApply404Response{}, err
+		}
+		return SchemaSpecApply500Response{}, err
+	}
+
+	plan, err := uc.Schema.ApplySpec(ctx, interfaces.SchemaSpecParam{
+		ProjectID:        p.ID(),
+		Spec:             request.Body.Into(),
+		AllowDestructive: lo.FromPtr(request.Params.AllowDestructive),
+	}, op)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrOperationDenied) {
+			return SchemaSpecApply404Response{}, err
+		}
+		return SchemaSpecApply400Response{}, err
+	}
+
+	return SchemaSpecApply200JSONResponse(integrationapi.NewSchemaPlan(plan)), nil
+}
@@ -51,6 +51,9 @@ type ServerInterface interface {
 	// publish asset
 	// (POST /assets/{assetId}/publish)
 	AssetPublish(ctx echo.Context, assetId AssetIdParam) error
+	// Returns a signed URL of the image asset transformed with the parameters.
+	// (GET /assets/{assetId}/transform)
+	AssetTransformURL(ctx echo.Context, assetId AssetIdParam, params AssetTransformURLParams) error
 	// publish asset
 	// (POST /assets/{assetId}/unpublish)
 	AssetUnpublish(ctx echo.Context, assetId AssetIdParam) error
@@ -66,6 +69,15 @@ type ServerInterface interface {
 	// Update a group's details.
 	// (PATCH /groups/{groupId})
 	GroupUpdate(ctx echo.Context, groupId GroupIdParam) error
+	// delete items in batch
+	// (DELETE /items/batch)
+	ItemBatchDelete(ctx echo.Context) error
+	// update items in batch
+	// (PATCH /items/batch)
+	ItemBatchUpdate(ctx echo.Context) error
+	// publish items in batch
+	// (POST /items/batch/publish)
+	ItemBatchPublish(ctx echo.Context) error
 	// delete an item
 	// (DELETE /items/{itemId})
 	ItemDelete(ctx echo.Context, itemId ItemIdParam) error
@@ -87,6 +99,21 @@ type ServerInterface interface {
 	// Update Item Comment
 	// (PATCH /items/{itemId}/comments/{commentId})
 	ItemCommentUpdate(ctx echo.Context, itemId ItemIdParam, commentId CommentIdParam) error
+	// Returns the difference between two versions of an item.
+	// (GET /items/{itemId}/diff)
+	ItemDiff(ctx echo.Context, itemId ItemIdParam, params ItemDiffParams) error
+	// Duplicate an item.
+	// (POST /items/{itemId}/duplicate)
+	ItemDuplicate(ctx echo.Context, itemId ItemIdParam) error
+	// Restore an item to a previous version.
+	// (POST /items/{itemId}/restore)
+	ItemRestore(ctx echo.Context, itemId ItemIdParam) error
+	// Cancel the pending schedule of an item.
+	// (DELETE /items/{itemId}/schedule)
+	ItemScheduleDelete(ctx echo.Context, itemId ItemIdParam) error
+	// Returns the pending schedule of an item.
+	// (GET /items/{itemId}/schedule)
+	ItemScheduleGet(ctx echo.Context, itemId ItemIdParam) error
 	// delete a model
 	// (DELETE /models/{modelId})
 	ModelDelete(ctx echo.Context, modelId ModelIdParam) error
@@ -114,12 +141,24 @@ type ServerInterface interface {
 	// Returns a GeoJSON that has a list of items as features.
 	// (GET /models/{modelId}/items.geojson)
 	ItemsAsGeoJSON(ctx echo.Context, modelId ModelIdParam, params ItemsAsGeoJSONParams) error
+	// create items in batch
+	// (POST /models/{modelId}/items/batch)
+	ItemBatchCreate(ctx echo.Context, modelId ModelIdParam) error
 	// Returns a metadata schema as json by model ID
 	// (GET /models/{modelId}/metadata_schema.json)
 	MetadataSchemaByModelAsJSON(ctx echo.Context, modelId ModelIdParam) error
 	// Returns a schema as json by model ID
 	// (GET /models/{modelId}/schema.json)
 	SchemaByModelAsJSON(ctx echo.Context, modelId ModelIdParam) error
+	// Returns a list of item templates of the model.
+	// (GET /models/{modelId}/templates)
+	ItemTemplateList(ctx echo.Context, modelId ModelIdParam) error
+	// Create an item template.
+	// (POST /models/{modelId}/templates)
+	ItemTemplateCreate(ctx echo.Context, modelId ModelIdParam) error
+	// Revoke a preview token.
+	// (DELETE /preview_tokens/{previewTokenId})
+	PreviewTokenRevoke(ctx echo.Context, previewTokenId PreviewTokenIdParam) error
 	// Returns a list of groups in a project.
 	// (GET /projects/{projectIdOrAlias}/groups)
 	GroupFilter(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params GroupFilterParams) error
@@ -177,12 +216,33 @@ type ServerInterface interface {
 	// Returns a schema as json by project and model ID
 	// (GET /projects/{projectIdOrAlias}/models/{modelIdOrKey}/schema.json)
 	SchemaByModelWithProjectAsJSON(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error
+	// Returns the preview tokens of the project.
+	// (GET /projects/{projectIdOrAlias}/preview_tokens)
+	PreviewTokenList(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params PreviewTokenListParams) error
+	// Issue a preview token.
+	// (POST /projects/{projectIdOrAlias}/preview_tokens)
+	PreviewTokenIssue(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam) error
+	// Returns a list of pending item schedules.
+	// (GET /projects/{projectIdOrAlias}/schedules)
+	ScheduleList(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam) error
+	// Schedule publishing and unpublishing of items.
+	// (POST /projects/{projectIdOrAlias}/schedules)
+	ScheduleCreate(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam) error
 	// Returns a schema.
 	// (GET /projects/{projectIdOrAlias}/schemata)
 	SchemaFilter(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params SchemaFilterParams) error
+	// Applies a spec to the schemas.
+	// (POST /projects/{projectIdOrAlias}/schemata/apply)
+	SchemaSpecApply(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params SchemaSpecApplyParams) error
+	// Plans the changes to the schemas from a spec.
+	// (POST /projects/{projectIdOrAlias}/schemata/plan)
+	SchemaSpecPlan(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam) error
 	// Returns a schema as json by project and schema ID
 	// (GET /projects/{projectIdOrAlias}/schemata/{schemaId}/schema.json)
 	SchemaByIDWithProjectAsJSON(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, schemaId SchemaIdParam) error
+	// Returns the entries in the trash of the project.
+	// (GET /projects/{projectIdOrAlias}/trash)
+	TrashList(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params TrashListParams) error
 	// Returns a list of assets.
 	// (GET /projects/{projectId}/assets)
 	AssetFilter(ctx echo.Context, projectId ProjectIdParam, params AssetFilterParams) error
@@ -204,12 +264,30 @@ type ServerInterface interface {
 	// Returns a schema as json by schema ID
 	// (GET /schemata/{schemaId}/schema.json)
 	SchemaByIDAsJSON(ctx echo.Context, schemaId SchemaIdParam) error
+	// Delete an item template.
+	// (DELETE /templates/{templateId})
+	ItemTemplateDelete(ctx echo.Context, templateId TemplateIdParam) error
+	// Returns an item template.
+	// (GET /templates/{templateId})
+	ItemTemplateGet(ctx echo.Context, templateId TemplateIdParam) error
+	// Update an item template.
+	// (PATCH /templates/{templateId})
+	ItemTemplateUpdate(ctx echo.Context, templateId TemplateIdParam) error
+	// Create an item from an item template.
+	// (POST /templates/{templateId}/items)
+	ItemCreateFromTemplate(ctx echo.Context, templateId TemplateIdParam) error
+	// Restore an entry in the trash.
+	// (POST /trash/{trashId}/restore)
+	TrashRestore(ctx echo.Context, trashId TrashIdParam) error
 	// Returns a list of projects.
 	// (GET /{workspaceId}/projects)
 	ProjectFilter(ctx echo.Context, workspaceId WorkspaceIdParam, params ProjectFilterParams) error
 	// Create a project
 	// (POST /{workspaceId}/projects)
 	ProjectCreate(ctx echo.Context, workspaceId WorkspaceIdParam, params ProjectCreateParams) error
+	// Import a project
+	// (POST /{workspaceId}/projects/import)
+	ProjectImport(ctx echo.Context, workspaceId WorkspaceIdParam) error
 	// Delete a project
 	// (DELETE /{workspaceId}/projects/{projectId})
 	ProjectDelete(ctx echo.Context, workspaceId WorkspaceIdParam, projectId ProjectIdParam) error
@@ -219,6 +297,9 @@ type ServerInterface interface {
 	// Update a project.
 	// (PATCH /{workspaceId}/projects/{projectId})
 	ProjectUpdate(ctx echo.Context, workspaceId WorkspaceIdParam, projectId ProjectIdParam) error
+	// Export a project.
+	// (GET /{workspaceId}/projects/{projectId}/export)
+	ProjectExport(ctx echo.Context, workspaceId WorkspaceIdParam, projectId ProjectIdParam) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -375,6 +456,54 @@ func (w *ServerInterfaceWrapper) AssetPublish(ctx echo.Context) error {
 	return err
 }
 
+// AssetTransformURL converts echo context to params.
+func (w *ServerInterfaceWrapper) AssetTransformURL(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "assetId" -------------
+	var assetId AssetIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assetId", ctx.Param("assetId"), &assetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter assetId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AssetTransformURLParams
+	// ------------- Optional query parameter "w" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "w", ctx.QueryParams(), &params.W)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter w: %s", err))
+	}
+
+	// ------------- Optional query parameter "h" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "h", ctx.QueryParams(), &params.H)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter h: %s", err))
+	}
+
+	// ------------- Optional query parameter "fit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fit", ctx.QueryParams(), &params.Fit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fit: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AssetTransformURL(ctx, assetId, params)
+	return err
+}
+
 // AssetUnpublish converts echo context to params.
 func (w *ServerInterfaceWrapper) AssetUnpublish(ctx echo.Context) error {
 	var err error
@@ -479,6 +608,39 @@ func (w *ServerInterfaceWrapper) GroupUpdate(ctx echo.Context) error {
 	return err
 }
 
+// ItemBatchDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemBatchDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemBatchDelete(ctx)
+	return err
+}
+
+// ItemBatchUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemBatchUpdate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemBatchUpdate(ctx)
+	return err
+}
+
+// ItemBatchPublish converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemBatchPublish(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemBatchPublish(ctx)
+	return err
+}
+
 // ItemDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) ItemDelete(ctx echo.Context) error {
 	var err error
@@ -526,6 +688,13 @@ func (w *ServerInterfaceWrapper) ItemGet(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
 	}
 
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", ctx.QueryParams(), &params.At)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter at: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ItemGet(ctx, itemId, params)
 	return err
@@ -637,6 +806,112 @@ func (w *ServerInterfaceWrapper) ItemCommentUpdate(ctx echo.Context) error {
 	return err
 }
 
+// ItemDiff converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemDiff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ItemDiffParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemDiff(ctx, itemId, params)
+	return err
+}
+
+// ItemDuplicate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemDuplicate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemDuplicate(ctx, itemId)
+	return err
+}
+
+// ItemRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemRestore(ctx, itemId)
+	return err
+}
+
+// ItemScheduleDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemScheduleDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemScheduleDelete(ctx, itemId)
+	return err
+}
+
+// ItemScheduleGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemScheduleGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemScheduleGet(ctx, itemId)
+	return err
+}
+
 // ModelDelete converts echo context to params.
 func (w *ServerInterfaceWrapper) ModelDelete(ctx echo.Context) error {
 	var err error
@@ -791,6 +1066,13 @@ func (w *ServerInterfaceWrapper) ItemFilter(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyword: %s", err))
 	}
 
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", ctx.QueryParams(), &params.At)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter at: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ItemFilter(ctx, modelId, params)
 	return err
@@ -843,6 +1125,13 @@ func (w *ServerInterfaceWrapper) ItemsAsCSV(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
 	}
 
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", ctx.QueryParams(), &params.Locale)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter locale: %s", err))
+	}
+
 	// ------------- Optional query parameter "ref" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "ref", ctx.QueryParams(), &params.Ref)
@@ -884,6 +1173,13 @@ func (w *ServerInterfaceWrapper) ItemsAsGeoJSON(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
 	}
 
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", ctx.QueryParams(), &params.Locale)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter locale: %s", err))
+	}
+
 	// ------------- Optional query parameter "ref" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "ref", ctx.QueryParams(), &params.Ref)
@@ -896,6 +1192,24 @@ func (w *ServerInterfaceWrapper) ItemsAsGeoJSON(ctx echo.Context) error {
 	return err
 }
 
+// ItemBatchCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemBatchCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "modelId" -------------
+	var modelId ModelIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "modelId", ctx.Param("modelId"), &modelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemBatchCreate(ctx, modelId)
+	return err
+}
+
 // MetadataSchemaByModelAsJSON converts echo context to params.
 func (w *ServerInterfaceWrapper) MetadataSchemaByModelAsJSON(ctx echo.Context) error {
 	var err error
@@ -932,6 +1246,60 @@ func (w *ServerInterfaceWrapper) SchemaByModelAsJSON(ctx echo.Context) error {
 	return err
 }
 
+// ItemTemplateList converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemTemplateList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "modelId" -------------
+	var modelId ModelIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "modelId", ctx.Param("modelId"), &modelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemTemplateList(ctx, modelId)
+	return err
+}
+
+// ItemTemplateCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemTemplateCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "modelId" -------------
+	var modelId ModelIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "modelId", ctx.Param("modelId"), &modelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter modelId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemTemplateCreate(ctx, modelId)
+	return err
+}
+
+// PreviewTokenRevoke converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewTokenRevoke(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "previewTokenId" -------------
+	var previewTokenId PreviewTokenIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "previewTokenId", ctx.Param("previewTokenId"), &previewTokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter previewTokenId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewTokenRevoke(ctx, previewTokenId)
+	return err
+}
+
 // GroupFilter converts echo context to params.
 func (w *ServerInterfaceWrapper) GroupFilter(ctx echo.Context) error {
 	var err error
@@ -1411,6 +1779,13 @@ func (w *ServerInterfaceWrapper) ItemFilterWithProject(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset: %s", err))
 	}
 
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", ctx.QueryParams(), &params.At)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter at: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ItemFilterWithProject(ctx, projectIdOrAlias, modelIdOrKey, params)
 	return err
@@ -1479,6 +1854,13 @@ func (w *ServerInterfaceWrapper) ItemsWithProjectAsCSV(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
 	}
 
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", ctx.QueryParams(), &params.Locale)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter locale: %s", err))
+	}
+
 	// ------------- Optional query parameter "ref" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "ref", ctx.QueryParams(), &params.Ref)
@@ -1528,6 +1910,13 @@ func (w *ServerInterfaceWrapper) ItemsWithProjectAsGeoJSON(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
 	}
 
+	// ------------- Optional query parameter "locale" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "locale", ctx.QueryParams(), &params.Locale)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter locale: %s", err))
+	}
+
 	// ------------- Optional query parameter "ref" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "ref", ctx.QueryParams(), &params.Ref)
@@ -1592,8 +1981,8 @@ func (w *ServerInterfaceWrapper) SchemaByModelWithProjectAsJSON(ctx echo.Context
 	return err
 }
 
-// SchemaFilter converts echo context to params.
-func (w *ServerInterfaceWrapper) SchemaFilter(ctx echo.Context) error {
+// PreviewTokenList converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewTokenList(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectIdOrAlias" -------------
 	var projectIdOrAlias ProjectIdOrAliasParam
@@ -1606,21 +1995,7 @@ func (w *ServerInterfaceWrapper) SchemaFilter(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params SchemaFilterParams
-	// ------------- Optional query parameter "sort" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
-	}
-
-	// ------------- Optional query parameter "dir" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "dir", ctx.QueryParams(), &params.Dir)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dir: %s", err))
-	}
-
+	var params PreviewTokenListParams
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
@@ -1635,20 +2010,13 @@ func (w *ServerInterfaceWrapper) SchemaFilter(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
 	}
 
-	// ------------- Optional query parameter "keyword" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "keyword", ctx.QueryParams(), &params.Keyword)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyword: %s", err))
-	}
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.SchemaFilter(ctx, projectIdOrAlias, params)
+	err = w.Handler.PreviewTokenList(ctx, projectIdOrAlias, params)
 	return err
 }
 
-// SchemaByIDWithProjectAsJSON converts echo context to params.
-func (w *ServerInterfaceWrapper) SchemaByIDWithProjectAsJSON(ctx echo.Context) error {
+// PreviewTokenIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewTokenIssue(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "projectIdOrAlias" -------------
 	var projectIdOrAlias ProjectIdOrAliasParam
@@ -1658,11 +2026,165 @@ func (w *ServerInterfaceWrapper) SchemaByIDWithProjectAsJSON(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
 	}
 
-	// ------------- Path parameter "schemaId" -------------
-	var schemaId SchemaIdParam
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", ctx.Param("schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PreviewTokenIssue(ctx, projectIdOrAlias)
+	return err
+}
+
+// ScheduleList converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleList(ctx, projectIdOrAlias)
+	return err
+}
+
+// ScheduleCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ScheduleCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ScheduleCreate(ctx, projectIdOrAlias)
+	return err
+}
+
+// SchemaFilter converts echo context to params.
+func (w *ServerInterfaceWrapper) SchemaFilter(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SchemaFilterParams
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "dir" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dir", ctx.QueryParams(), &params.Dir)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dir: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+	}
+
+	// ------------- Optional query parameter "keyword" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "keyword", ctx.QueryParams(), &params.Keyword)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyword: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SchemaFilter(ctx, projectIdOrAlias, params)
+	return err
+}
+
+// SchemaSpecApply converts echo context to params.
+func (w *ServerInterfaceWrapper) SchemaSpecApply(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SchemaSpecApplyParams
+	// ------------- Optional query parameter "allowDestructive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "allowDestructive", ctx.QueryParams(), &params.AllowDestructive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter allowDestructive: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SchemaSpecApply(ctx, projectIdOrAlias, params)
+	return err
+}
+
+// SchemaSpecPlan converts echo context to params.
+func (w *ServerInterfaceWrapper) SchemaSpecPlan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SchemaSpecPlan(ctx, projectIdOrAlias)
+	return err
+}
+
+// SchemaByIDWithProjectAsJSON converts echo context to params.
+func (w *ServerInterfaceWrapper) SchemaByIDWithProjectAsJSON(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	// ------------- Path parameter "schemaId" -------------
+	var schemaId SchemaIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "schemaId", ctx.Param("schemaId"), &schemaId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter schemaId: %s", err))
 	}
 
@@ -1673,6 +2195,40 @@ func (w *ServerInterfaceWrapper) SchemaByIDWithProjectAsJSON(ctx echo.Context) e
 	return err
 }
 
+// TrashList converts echo context to params.
+func (w *ServerInterfaceWrapper) TrashList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "projectIdOrAlias" -------------
+	var projectIdOrAlias ProjectIdOrAliasParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectIdOrAlias", ctx.Param("projectIdOrAlias"), &projectIdOrAlias, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectIdOrAlias: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TrashListParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TrashList(ctx, projectIdOrAlias, params)
+	return err
+}
+
 // AssetFilter converts echo context to params.
 func (w *ServerInterfaceWrapper) AssetFilter(ctx echo.Context) error {
 	var err error
@@ -1723,6 +2279,62 @@ func (w *ServerInterfaceWrapper) AssetFilter(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyword: %s", err))
 	}
 
+	// ------------- Optional query parameter "minWidth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minWidth", ctx.QueryParams(), &params.MinWidth)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minWidth: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxWidth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxWidth", ctx.QueryParams(), &params.MaxWidth)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxWidth: %s", err))
+	}
+
+	// ------------- Optional query parameter "minHeight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minHeight", ctx.QueryParams(), &params.MinHeight)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minHeight: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxHeight" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxHeight", ctx.QueryParams(), &params.MaxHeight)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxHeight: %s", err))
+	}
+
+	// ------------- Optional query parameter "capturedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "capturedAfter", ctx.QueryParams(), &params.CapturedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter capturedAfter: %s", err))
+	}
+
+	// ------------- Optional query parameter "capturedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "capturedBefore", ctx.QueryParams(), &params.CapturedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter capturedBefore: %s", err))
+	}
+
+	// ------------- Optional query parameter "bbox" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bbox", ctx.QueryParams(), &params.Bbox)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bbox: %s", err))
+	}
+
+	// ------------- Optional query parameter "header" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "header", ctx.QueryParams(), &params.Header)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter header: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.AssetFilter(ctx, projectId, params)
 	return err
@@ -1852,83 +2464,191 @@ func (w *ServerInterfaceWrapper) SchemaByIDAsJSON(ctx echo.Context) error {
 	return err
 }
 
-// ProjectFilter converts echo context to params.
-func (w *ServerInterfaceWrapper) ProjectFilter(ctx echo.Context) error {
+// ItemTemplateDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemTemplateDelete(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workspaceId" -------------
-	var workspaceId WorkspaceIdParam
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ProjectFilterParams
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemTemplateDelete(ctx, templateId)
+	return err
+}
 
-	// ------------- Optional query parameter "perPage" -------------
+// ItemTemplateGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemTemplateGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateIdParam
 
-	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProjectFilter(ctx, workspaceId, params)
+	err = w.Handler.ItemTemplateGet(ctx, templateId)
 	return err
 }
 
-// ProjectCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) ProjectCreate(ctx echo.Context) error {
+// ItemTemplateUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemTemplateUpdate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workspaceId" -------------
-	var workspaceId WorkspaceIdParam
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ProjectCreateParams
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ItemTemplateUpdate(ctx, templateId)
+	return err
+}
 
-	// ------------- Optional query parameter "perPage" -------------
+// ItemCreateFromTemplate converts echo context to params.
+func (w *ServerInterfaceWrapper) ItemCreateFromTemplate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateIdParam
 
-	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", ctx.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter templateId: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ProjectCreate(ctx, workspaceId, params)
+	err = w.Handler.ItemCreateFromTemplate(ctx, templateId)
 	return err
 }
 
-// ProjectDelete converts echo context to params.
-func (w *ServerInterfaceWrapper) ProjectDelete(ctx echo.Context) error {
+// TrashRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) TrashRestore(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "workspaceId" -------------
-	var workspaceId WorkspaceIdParam
+	// ------------- Path parameter "trashId" -------------
+	var trashId TrashIdParam
 
-	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "trashId", ctx.Param("trashId"), &trashId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter trashId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TrashRestore(ctx, trashId)
+	return err
+}
+
+// ProjectFilter converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectFilter(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProjectFilterParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectFilter(ctx, workspaceId, params)
+	return err
+}
+
+// ProjectCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectCreate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProjectCreateParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", ctx.QueryParams(), &params.PerPage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter perPage: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectCreate(ctx, workspaceId, params)
+	return err
+}
+
+// ProjectImport converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectImport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectImport(ctx, workspaceId)
+	return err
+}
+
+// ProjectDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectDelete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
 	}
 
 	// ------------- Path parameter "projectId" -------------
@@ -1998,6 +2718,32 @@ func (w *ServerInterfaceWrapper) ProjectUpdate(ctx echo.Context) error {
 	return err
 }
 
+// ProjectExport converts echo context to params.
+func (w *ServerInterfaceWrapper) ProjectExport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", ctx.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workspaceId: %s", err))
+	}
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId ProjectIdParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", ctx.Param("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter projectId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ProjectExport(ctx, workspaceId, projectId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -2034,11 +2780,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/assets/:assetId/comments/:commentId", wrapper.AssetCommentDelete)
 	router.PATCH(baseURL+"/assets/:assetId/comments/:commentId", wrapper.AssetCommentUpdate)
 	router.POST(baseURL+"/assets/:assetId/publish", wrapper.AssetPublish)
+	router.GET(baseURL+"/assets/:assetId/transform", wrapper.AssetTransformURL)
 	router.POST(baseURL+"/assets/:assetId/unpublish", wrapper.AssetUnpublish)
 	router.GET(baseURL+"/assets/:uuid1/:uuid2/:filename", wrapper.AssetContentGet)
 	router.DELETE(baseURL+"/groups/:groupId", wrapper.GroupDelete)
 	router.GET(baseURL+"/groups/:groupId", wrapper.GroupGet)
 	router.PATCH(baseURL+"/groups/:groupId", wrapper.GroupUpdate)
+	router.DELETE(baseURL+"/items/batch", wrapper.ItemBatchDelete)
+	router.PATCH(baseURL+"/items/batch", wrapper.ItemBatchUpdate)
+	router.POST(baseURL+"/items/batch/publish", wrapper.ItemBatchPublish)
 	router.DELETE(baseURL+"/items/:itemId", wrapper.ItemDelete)
 	router.GET(baseURL+"/items/:itemId", wrapper.ItemGet)
 	router.PATCH(baseURL+"/items/:itemId", wrapper.ItemUpdate)
@@ -2046,6 +2796,11 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/items/:itemId/comments", wrapper.ItemCommentCreate)
 	router.DELETE(baseURL+"/items/:itemId/comments/:commentId", wrapper.ItemCommentDelete)
 	router.PATCH(baseURL+"/items/:itemId/comments/:commentId", wrapper.ItemCommentUpdate)
+	router.GET(baseURL+"/items/:itemId/diff", wrapper.ItemDiff)
+	router.POST(baseURL+"/items/:itemId/duplicate", wrapper.ItemDuplicate)
+	router.POST(baseURL+"/items/:itemId/restore", wrapper.ItemRestore)
+	router.DELETE(baseURL+"/items/:itemId/schedule", wrapper.ItemScheduleDelete)
+	router.GET(baseURL+"/items/:itemId/schedule", wrapper.ItemScheduleGet)
 	router.DELETE(baseURL+"/models/:modelId", wrapper.ModelDelete)
 	router.GET(baseURL+"/models/:modelId", wrapper.ModelGet)
 	router.PATCH(baseURL+"/models/:modelId", wrapper.ModelUpdate)
@@ -2055,8 +2810,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/models/:modelId/items", wrapper.ItemCreate)
 	router.GET(baseURL+"/models/:modelId/items.csv", wrapper.ItemsAsCSV)
 	router.GET(baseURL+"/models/:modelId/items.geojson", wrapper.ItemsAsGeoJSON)
+	router.POST(baseURL+"/models/:modelId/items/batch", wrapper.ItemBatchCreate)
 	router.GET(baseURL+"/models/:modelId/metadata_schema.json", wrapper.MetadataSchemaByModelAsJSON)
 	router.GET(baseURL+"/models/:modelId/schema.json", wrapper.SchemaByModelAsJSON)
+	router.GET(baseURL+"/models/:modelId/templates", wrapper.ItemTemplateList)
+	router.POST(baseURL+"/models/:modelId/templates", wrapper.ItemTemplateCreate)
+	router.DELETE(baseURL+"/preview_tokens/:previewTokenId", wrapper.PreviewTokenRevoke)
 	router.GET(baseURL+"/projects/:projectIdOrAlias/groups", wrapper.GroupFilter)
 	router.POST(baseURL+"/projects/:projectIdOrAlias/groups", wrapper.GroupCreate)
 	router.DELETE(baseURL+"/projects/:projectIdOrAlias/groups/:groupIdOrKey", wrapper.GroupDeleteWithProject)
@@ -2076,8 +2835,15 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/projects/:projectIdOrAlias/models/:modelIdOrKey/items.geojson", wrapper.ItemsWithProjectAsGeoJSON)
 	router.GET(baseURL+"/projects/:projectIdOrAlias/models/:modelIdOrKey/metadata_schema.json", wrapper.MetadataSchemaByModelWithProjectAsJSON)
 	router.GET(baseURL+"/projects/:projectIdOrAlias/models/:modelIdOrKey/schema.json", wrapper.SchemaByModelWithProjectAsJSON)
+	router.GET(baseURL+"/projects/:projectIdOrAlias/preview_tokens", wrapper.PreviewTokenList)
+	router.POST(baseURL+"/projects/:projectIdOrAlias/preview_tokens", wrapper.PreviewTokenIssue)
+	router.GET(baseURL+"/projects/:projectIdOrAlias/schedules", wrapper.ScheduleList)
+	router.POST(baseURL+"/projects/:projectIdOrAlias/schedules", wrapper.ScheduleCreate)
 	router.GET(baseURL+"/projects/:projectIdOrAlias/schemata", wrapper.SchemaFilter)
+	router.POST(baseURL+"/projects/:projectIdOrAlias/schemata/apply", wrapper.SchemaSpecApply)
+	router.POST(baseURL+"/projects/:projectIdOrAlias/schemata/plan", wrapper.SchemaSpecPlan)
 	router.GET(baseURL+"/projects/:projectIdOrAlias/schemata/:schemaId/schema.json", wrapper.SchemaByIDWithProjectAsJSON)
+	router.GET(baseURL+"/projects/:projectIdOrAlias/trash", wrapper.TrashList)
 	router.GET(baseURL+"/projects/:projectId/assets", wrapper.AssetFilter)
 	router.POST(baseURL+"/projects/:projectId/assets", wrapper.AssetCreate)
 	router.POST(baseURL+"/projects/:projectId/assets/uploads", wrapper.AssetUploadCreate)
@@ -2085,11 +2851,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/schemata/:schemaId/fields/:fieldIdOrKey", wrapper.FieldDelete)
 	router.PATCH(baseURL+"/schemata/:schemaId/fields/:fieldIdOrKey", wrapper.FieldUpdate)
 	router.GET(baseURL+"/schemata/:schemaId/schema.json", wrapper.SchemaByIDAsJSON)
+	router.DELETE(baseURL+"/templates/:templateId", wrapper.ItemTemplateDelete)
+	router.GET(baseURL+"/templates/:templateId", wrapper.ItemTemplateGet)
+	router.PATCH(baseURL+"/templates/:templateId", wrapper.ItemTemplateUpdate)
+	router.POST(baseURL+"/templates/:templateId/items", wrapper.ItemCreateFromTemplate)
+	router.POST(baseURL+"/trash/:trashId/restore", wrapper.TrashRestore)
 	router.GET(baseURL+"/:workspaceId/projects", wrapper.ProjectFilter)
 	router.POST(baseURL+"/:workspaceId/projects", wrapper.ProjectCreate)
+	router.POST(baseURL+"/:workspaceId/projects/import", wrapper.ProjectImport)
 	router.DELETE(baseURL+"/:workspaceId/projects/:projectId", wrapper.ProjectDelete)
 	router.GET(baseURL+"/:workspaceId/projects/:projectId", wrapper.ProjectGet)
 	router.PATCH(baseURL+"/:workspaceId/projects/:projectId", wrapper.ProjectUpdate)
+	router.GET(baseURL+"/:workspaceId/projects/:projectId/export", wrapper.ProjectExport)
 
 }
 
@@ -2431,6 +3204,49 @@ func (response AssetPublish404Response) VisitAssetPublishResponse(w http.Respons
 	return nil
 }
 
+type AssetTransformURLRequestObject struct {
+	AssetId AssetIdParam `json:"assetId"`
+	Params  AssetTransformURLParams
+}
+
+type AssetTransformURLResponseObject interface {
+	VisitAssetTransformURLResponse(w http.ResponseWriter) error
+}
+
+type AssetTransformURL200JSONResponse struct {
+	Url *string `json:"url,omitempty"`
+}
+
+func (response AssetTransformURL200JSONResponse) VisitAssetTransformURLResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AssetTransformURL400Response struct {
+}
+
+func (response AssetTransformURL400Response) VisitAssetTransformURLResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AssetTransformURL401Response = UnauthorizedErrorResponse
+
+func (response AssetTransformURL401Response) VisitAssetTransformURLResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AssetTransformURL404Response struct {
+}
+
+func (response AssetTransformURL404Response) VisitAssetTransformURLResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
 type AssetUnpublishRequestObject struct {
 	AssetId AssetIdParam `json:"assetId"`
 }
@@ -2670,6 +3486,129 @@ func (response GroupUpdate500Response) VisitGroupUpdateResponse(w http.ResponseW
 	return nil
 }
 
+type ItemBatchDeleteRequestObject struct {
+	Body *ItemBatchDeleteJSONRequestBody
+}
+
+type ItemBatchDeleteResponseObject interface {
+	VisitItemBatchDeleteResponse(w http.ResponseWriter) error
+}
+
+type ItemBatchDelete200JSONResponse ItemBatchResult
+
+func (response ItemBatchDelete200JSONResponse) VisitItemBatchDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBatchDelete400JSONResponse ItemBatchResult
+
+func (response ItemBatchDelete400JSONResponse) VisitItemBatchDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBatchDelete401Response = UnauthorizedErrorResponse
+
+func (response ItemBatchDelete401Response) VisitItemBatchDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemBatchDelete500Response struct {
+}
+
+func (response ItemBatchDelete500Response) VisitItemBatchDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemBatchUpdateRequestObject struct {
+	Body *ItemBatchUpdateJSONRequestBody
+}
+
+type ItemBatchUpdateResponseObject interface {
+	VisitItemBatchUpdateResponse(w http.ResponseWriter) error
+}
+
+type ItemBatchUpdate200JSONResponse ItemBatchResult
+
+func (response ItemBatchUpdate200JSONResponse) VisitItemBatchUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBatchUpdate400JSONResponse ItemBatchResult
+
+func (response ItemBatchUpdate400JSONResponse) VisitItemBatchUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBatchUpdate401Response = UnauthorizedErrorResponse
+
+func (response ItemBatchUpdate401Response) VisitItemBatchUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemBatchUpdate500Response struct {
+}
+
+func (response ItemBatchUpdate500Response) VisitItemBatchUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemBatchPublishRequestObject struct {
+	Body *ItemBatchPublishJSONRequestBody
+}
+
+type ItemBatchPublishResponseObject interface {
+	VisitItemBatchPublishResponse(w http.ResponseWriter) error
+}
+
+type ItemBatchPublish200JSONResponse ItemBatchResult
+
+func (response ItemBatchPublish200JSONResponse) VisitItemBatchPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBatchPublish400JSONResponse ItemBatchResult
+
+func (response ItemBatchPublish400JSONResponse) VisitItemBatchPublishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBatchPublish401Response = UnauthorizedErrorResponse
+
+func (response ItemBatchPublish401Response) VisitItemBatchPublishResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemBatchPublish500Response struct {
+}
+
+func (response ItemBatchPublish500Response) VisitItemBatchPublishResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
 type ItemDeleteRequestObject struct {
 	ItemId ItemIdParam `json:"itemId"`
 }
@@ -2978,45 +3917,289 @@ func (response ItemCommentUpdate404Response) VisitItemCommentUpdateResponse(w ht
 	return nil
 }
 
-type ModelDeleteRequestObject struct {
-	ModelId ModelIdParam `json:"modelId"`
+type ItemDiffRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+	Params ItemDiffParams
 }
 
-type ModelDeleteResponseObject interface {
-	VisitModelDeleteResponse(w http.ResponseWriter) error
+type ItemDiffResponseObject interface {
+	VisitItemDiffResponse(w http.ResponseWriter) error
 }
 
-type ModelDelete200JSONResponse struct {
-	Id *id.ModelID `json:"id,omitempty"`
-}
+type ItemDiff200JSONResponse ItemDiff
 
-func (response ModelDelete200JSONResponse) VisitModelDeleteResponse(w http.ResponseWriter) error {
+func (response ItemDiff200JSONResponse) VisitItemDiffResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModelDelete400Response struct {
+type ItemDiff400Response struct {
 }
 
-func (response ModelDelete400Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+func (response ItemDiff400Response) VisitItemDiffResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelDelete401Response = UnauthorizedErrorResponse
+type ItemDiff401Response = UnauthorizedErrorResponse
 
-func (response ModelDelete401Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+func (response ItemDiff401Response) VisitItemDiffResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelGetRequestObject struct {
-	ModelId ModelIdParam `json:"modelId"`
+type ItemDiff404Response struct {
 }
 
-type ModelGetResponseObject interface {
+func (response ItemDiff404Response) VisitItemDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemDiff500Response struct {
+}
+
+func (response ItemDiff500Response) VisitItemDiffResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemDuplicateRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+}
+
+type ItemDuplicateResponseObject interface {
+	VisitItemDuplicateResponse(w http.ResponseWriter) error
+}
+
+type ItemDuplicate200JSONResponse VersionedItem
+
+func (response ItemDuplicate200JSONResponse) VisitItemDuplicateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemDuplicate400Response struct {
+}
+
+func (response ItemDuplicate400Response) VisitItemDuplicateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemDuplicate401Response = UnauthorizedErrorResponse
+
+func (response ItemDuplicate401Response) VisitItemDuplicateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemDuplicate404Response struct {
+}
+
+func (response ItemDuplicate404Response) VisitItemDuplicateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemDuplicate500Response struct {
+}
+
+func (response ItemDuplicate500Response) VisitItemDuplicateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemRestoreRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+	Body   *ItemRestoreJSONRequestBody
+}
+
+type ItemRestoreResponseObject interface {
+	VisitItemRestoreResponse(w http.ResponseWriter) error
+}
+
+type ItemRestore200JSONResponse ItemRestoreResult
+
+func (response ItemRestore200JSONResponse) VisitItemRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemRestore400Response struct {
+}
+
+func (response ItemRestore400Response) VisitItemRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemRestore401Response = UnauthorizedErrorResponse
+
+func (response ItemRestore401Response) VisitItemRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemRestore404Response struct {
+}
+
+func (response ItemRestore404Response) VisitItemRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemRestore500Response struct {
+}
+
+func (response ItemRestore500Response) VisitItemRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemScheduleDeleteRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+}
+
+type ItemScheduleDeleteResponseObject interface {
+	VisitItemScheduleDeleteResponse(w http.ResponseWriter) error
+}
+
+type ItemScheduleDelete200JSONResponse struct {
+	Id *id.ItemID `json:"id,omitempty"`
+}
+
+func (response ItemScheduleDelete200JSONResponse) VisitItemScheduleDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemScheduleDelete400Response struct {
+}
+
+func (response ItemScheduleDelete400Response) VisitItemScheduleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemScheduleDelete401Response = UnauthorizedErrorResponse
+
+func (response ItemScheduleDelete401Response) VisitItemScheduleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemScheduleDelete404Response struct {
+}
+
+func (response ItemScheduleDelete404Response) VisitItemScheduleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemScheduleDelete500Response struct {
+}
+
+func (response ItemScheduleDelete500Response) VisitItemScheduleDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemScheduleGetRequestObject struct {
+	ItemId ItemIdParam `json:"itemId"`
+}
+
+type ItemScheduleGetResponseObject interface {
+	VisitItemScheduleGetResponse(w http.ResponseWriter) error
+}
+
+type ItemScheduleGet200JSONResponse ItemSchedule
+
+func (response ItemScheduleGet200JSONResponse) VisitItemScheduleGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemScheduleGet400Response struct {
+}
+
+func (response ItemScheduleGet400Response) VisitItemScheduleGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemScheduleGet401Response = UnauthorizedErrorResponse
+
+func (response ItemScheduleGet401Response) VisitItemScheduleGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemScheduleGet404Response struct {
+}
+
+func (response ItemScheduleGet404Response) VisitItemScheduleGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemScheduleGet500Response struct {
+}
+
+func (response ItemScheduleGet500Response) VisitItemScheduleGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ModelDeleteRequestObject struct {
+	ModelId ModelIdParam `json:"modelId"`
+}
+
+type ModelDeleteResponseObject interface {
+	VisitModelDeleteResponse(w http.ResponseWriter) error
+}
+
+type ModelDelete200JSONResponse struct {
+	Id *id.ModelID `json:"id,omitempty"`
+}
+
+func (response ModelDelete200JSONResponse) VisitModelDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModelDelete400Response struct {
+}
+
+func (response ModelDelete400Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ModelDelete401Response = UnauthorizedErrorResponse
+
+func (response ModelDelete401Response) VisitModelDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ModelGetRequestObject struct {
+	ModelId ModelIdParam `json:"modelId"`
+}
+
+type ModelGetResponseObject interface {
 	VisitModelGetResponse(w http.ResponseWriter) error
 }
 
@@ -3386,6 +4569,48 @@ func (response ItemsAsGeoJSON500Response) VisitItemsAsGeoJSONResponse(w http.Res
 	return nil
 }
 
+type ItemBatchCreateRequestObject struct {
+	ModelId ModelIdParam `json:"modelId"`
+	Body    *ItemBatchCreateJSONRequestBody
+}
+
+type ItemBatchCreateResponseObject interface {
+	VisitItemBatchCreateResponse(w http.ResponseWriter) error
+}
+
+type ItemBatchCreate200JSONResponse ItemBatchResult
+
+func (response ItemBatchCreate200JSONResponse) VisitItemBatchCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBatchCreate400JSONResponse ItemBatchResult
+
+func (response ItemBatchCreate400JSONResponse) VisitItemBatchCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemBatchCreate401Response = UnauthorizedErrorResponse
+
+func (response ItemBatchCreate401Response) VisitItemBatchCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemBatchCreate500Response struct {
+}
+
+func (response ItemBatchCreate500Response) VisitItemBatchCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
 type MetadataSchemaByModelAsJSONRequestObject struct {
 	ModelId ModelIdParam `json:"modelId"`
 }
@@ -3482,90 +4707,237 @@ func (response SchemaByModelAsJSON500Response) VisitSchemaByModelAsJSONResponse(
 	return nil
 }
 
-type GroupFilterRequestObject struct {
-	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	Params           GroupFilterParams
+type ItemTemplateListRequestObject struct {
+	ModelId ModelIdParam `json:"modelId"`
 }
 
-type GroupFilterResponseObject interface {
-	VisitGroupFilterResponse(w http.ResponseWriter) error
+type ItemTemplateListResponseObject interface {
+	VisitItemTemplateListResponse(w http.ResponseWriter) error
 }
 
-type GroupFilter200JSONResponse struct {
-	Groups     *[]Group `json:"groups,omitempty"`
-	Page       *int     `json:"page,omitempty"`
-	PerPage    *int     `json:"perPage,omitempty"`
-	TotalCount *int     `json:"totalCount,omitempty"`
+type ItemTemplateList200JSONResponse struct {
+	Templates *[]ItemTemplate `json:"templates,omitempty"`
 }
 
-func (response GroupFilter200JSONResponse) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ItemTemplateList200JSONResponse) VisitItemTemplateListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GroupFilter400Response struct {
+type ItemTemplateList400Response struct {
 }
 
-func (response GroupFilter400Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ItemTemplateList400Response) VisitItemTemplateListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type GroupFilter401Response = UnauthorizedErrorResponse
+type ItemTemplateList401Response = UnauthorizedErrorResponse
 
-func (response GroupFilter401Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ItemTemplateList401Response) VisitItemTemplateListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type GroupFilter404Response struct {
+type ItemTemplateList404Response struct {
 }
 
-func (response GroupFilter404Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ItemTemplateList404Response) VisitItemTemplateListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type GroupFilter500Response struct {
+type ItemTemplateList500Response struct {
 }
 
-func (response GroupFilter500Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+func (response ItemTemplateList500Response) VisitItemTemplateListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type GroupCreateRequestObject struct {
-	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	Params           GroupCreateParams
-	Body             *GroupCreateJSONRequestBody
+type ItemTemplateCreateRequestObject struct {
+	ModelId ModelIdParam `json:"modelId"`
+	Body    *ItemTemplateCreateJSONRequestBody
 }
 
-type GroupCreateResponseObject interface {
-	VisitGroupCreateResponse(w http.ResponseWriter) error
+type ItemTemplateCreateResponseObject interface {
+	VisitItemTemplateCreateResponse(w http.ResponseWriter) error
 }
 
-type GroupCreate201JSONResponse Group
+type ItemTemplateCreate200JSONResponse ItemTemplate
 
-func (response GroupCreate201JSONResponse) VisitGroupCreateResponse(w http.ResponseWriter) error {
+func (response ItemTemplateCreate200JSONResponse) VisitItemTemplateCreateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GroupCreate400Response struct {
+type ItemTemplateCreate400Response struct {
 }
 
-func (response GroupCreate400Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
+func (response ItemTemplateCreate400Response) VisitItemTemplateCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type GroupCreate401Response = UnauthorizedErrorResponse
+type ItemTemplateCreate401Response = UnauthorizedErrorResponse
 
-func (response GroupCreate401Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
+func (response ItemTemplateCreate401Response) VisitItemTemplateCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemTemplateCreate404Response struct {
+}
+
+func (response ItemTemplateCreate404Response) VisitItemTemplateCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemTemplateCreate500Response struct {
+}
+
+func (response ItemTemplateCreate500Response) VisitItemTemplateCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PreviewTokenRevokeRequestObject struct {
+	PreviewTokenId PreviewTokenIdParam `json:"previewTokenId"`
+}
+
+type PreviewTokenRevokeResponseObject interface {
+	VisitPreviewTokenRevokeResponse(w http.ResponseWriter) error
+}
+
+type PreviewTokenRevoke200JSONResponse PreviewToken
+
+func (response PreviewTokenRevoke200JSONResponse) VisitPreviewTokenRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewTokenRevoke400Response struct {
+}
+
+func (response PreviewTokenRevoke400Response) VisitPreviewTokenRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PreviewTokenRevoke401Response = UnauthorizedErrorResponse
+
+func (response PreviewTokenRevoke401Response) VisitPreviewTokenRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PreviewTokenRevoke404Response struct {
+}
+
+func (response PreviewTokenRevoke404Response) VisitPreviewTokenRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PreviewTokenRevoke500Response struct {
+}
+
+func (response PreviewTokenRevoke500Response) VisitPreviewTokenRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GroupFilterRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	Params           GroupFilterParams
+}
+
+type GroupFilterResponseObject interface {
+	VisitGroupFilterResponse(w http.ResponseWriter) error
+}
+
+type GroupFilter200JSONResponse struct {
+	Groups     *[]Group `json:"groups,omitempty"`
+	Page       *int     `json:"page,omitempty"`
+	PerPage    *int     `json:"perPage,omitempty"`
+	TotalCount *int     `json:"totalCount,omitempty"`
+}
+
+func (response GroupFilter200JSONResponse) VisitGroupFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GroupFilter400Response struct {
+}
+
+func (response GroupFilter400Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GroupFilter401Response = UnauthorizedErrorResponse
+
+func (response GroupFilter401Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GroupFilter404Response struct {
+}
+
+func (response GroupFilter404Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GroupFilter500Response struct {
+}
+
+func (response GroupFilter500Response) VisitGroupFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type GroupCreateRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	Params           GroupCreateParams
+	Body             *GroupCreateJSONRequestBody
+}
+
+type GroupCreateResponseObject interface {
+	VisitGroupCreateResponse(w http.ResponseWriter) error
+}
+
+type GroupCreate201JSONResponse Group
+
+func (response GroupCreate201JSONResponse) VisitGroupCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GroupCreate400Response struct {
+}
+
+func (response GroupCreate400Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type GroupCreate401Response = UnauthorizedErrorResponse
+
+func (response GroupCreate401Response) VisitGroupCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
@@ -3926,563 +5298,923 @@ type ModelUpdateWithProjectResponseObject interface {
 	VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error
 }
 
-type ModelUpdateWithProject200JSONResponse Model
+type ModelUpdateWithProject200JSONResponse Model
+
+func (response ModelUpdateWithProject200JSONResponse) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ModelUpdateWithProject400Response struct {
+}
+
+func (response ModelUpdateWithProject400Response) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ModelUpdateWithProject401Response = UnauthorizedErrorResponse
+
+func (response ModelUpdateWithProject401Response) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ModelUpdateWithProject404Response struct {
+}
+
+func (response ModelUpdateWithProject404Response) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ModelUpdateWithProject500Response struct {
+}
+
+func (response ModelUpdateWithProject500Response) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type FieldCreateWithProjectRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	Body             *FieldCreateWithProjectJSONRequestBody
+}
+
+type FieldCreateWithProjectResponseObject interface {
+	VisitFieldCreateWithProjectResponse(w http.ResponseWriter) error
+}
+
+type FieldCreateWithProject200JSONResponse SchemaField
+
+func (response FieldCreateWithProject200JSONResponse) VisitFieldCreateWithProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FieldCreateWithProject400Response struct {
+}
+
+func (response FieldCreateWithProject400Response) VisitFieldCreateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type FieldCreateWithProject401Response = UnauthorizedErrorResponse
+
+func (response FieldCreateWithProject401Response) VisitFieldCreateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type FieldDeleteWithProjectRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	FieldIdOrKey     FieldIdOrKeyParam     `json:"fieldIdOrKey"`
+}
+
+type FieldDeleteWithProjectResponseObject interface {
+	VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error
+}
+
+type FieldDeleteWithProject200JSONResponse struct {
+	Id *id.FieldID `json:"id,omitempty"`
+}
+
+func (response FieldDeleteWithProject200JSONResponse) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FieldDeleteWithProject400Response struct {
+}
+
+func (response FieldDeleteWithProject400Response) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type FieldDeleteWithProject401Response = UnauthorizedErrorResponse
+
+func (response FieldDeleteWithProject401Response) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type FieldDeleteWithProject404Response struct {
+}
+
+func (response FieldDeleteWithProject404Response) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type FieldDeleteWithProject500Response struct {
+}
+
+func (response FieldDeleteWithProject500Response) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type FieldUpdateWithProjectRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	FieldIdOrKey     FieldIdOrKeyParam     `json:"fieldIdOrKey"`
+	Body             *FieldUpdateWithProjectJSONRequestBody
+}
+
+type FieldUpdateWithProjectResponseObject interface {
+	VisitFieldUpdateWithProjectResponse(w http.ResponseWriter) error
+}
+
+type FieldUpdateWithProject200JSONResponse SchemaField
+
+func (response FieldUpdateWithProject200JSONResponse) VisitFieldUpdateWithProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FieldUpdateWithProject400Response struct {
+}
+
+func (response FieldUpdateWithProject400Response) VisitFieldUpdateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type FieldUpdateWithProject401Response = UnauthorizedErrorResponse
+
+func (response FieldUpdateWithProject401Response) VisitFieldUpdateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemFilterWithProjectRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	Params           ItemFilterWithProjectParams
+}
+
+type ItemFilterWithProjectResponseObject interface {
+	VisitItemFilterWithProjectResponse(w http.ResponseWriter) error
+}
+
+type ItemFilterWithProject200JSONResponse struct {
+	Items      *[]VersionedItem `json:"items,omitempty"`
+	Page       *int             `json:"page,omitempty"`
+	PerPage    *int             `json:"perPage,omitempty"`
+	TotalCount *int             `json:"totalCount,omitempty"`
+}
+
+func (response ItemFilterWithProject200JSONResponse) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemFilterWithProject400Response struct {
+}
+
+func (response ItemFilterWithProject400Response) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemFilterWithProject401Response = UnauthorizedErrorResponse
+
+func (response ItemFilterWithProject401Response) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemFilterWithProject404Response struct {
+}
+
+func (response ItemFilterWithProject404Response) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemFilterWithProject500Response struct {
+}
+
+func (response ItemFilterWithProject500Response) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemCreateWithProjectRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	Body             *ItemCreateWithProjectJSONRequestBody
+}
+
+type ItemCreateWithProjectResponseObject interface {
+	VisitItemCreateWithProjectResponse(w http.ResponseWriter) error
+}
+
+type ItemCreateWithProject200JSONResponse VersionedItem
+
+func (response ItemCreateWithProject200JSONResponse) VisitItemCreateWithProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemCreateWithProject400Response struct {
+}
+
+func (response ItemCreateWithProject400Response) VisitItemCreateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemCreateWithProject401Response = UnauthorizedErrorResponse
+
+func (response ItemCreateWithProject401Response) VisitItemCreateWithProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemsWithProjectAsCSVRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	Params           ItemsWithProjectAsCSVParams
+}
+
+type ItemsWithProjectAsCSVResponseObject interface {
+	VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error
+}
+
+type ItemsWithProjectAsCSV200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ItemsWithProjectAsCSV200TextcsvResponse) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ItemsWithProjectAsCSV400Response struct {
+}
+
+func (response ItemsWithProjectAsCSV400Response) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemsWithProjectAsCSV401Response = UnauthorizedErrorResponse
+
+func (response ItemsWithProjectAsCSV401Response) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemsWithProjectAsCSV404Response struct {
+}
+
+func (response ItemsWithProjectAsCSV404Response) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemsWithProjectAsCSV500Response struct {
+}
+
+func (response ItemsWithProjectAsCSV500Response) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemsWithProjectAsGeoJSONRequestObject struct {
+	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
+	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	Params           ItemsWithProjectAsGeoJSONParams
+}
+
+type ItemsWithProjectAsGeoJSONResponseObject interface {
+	VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error
+}
+
+type ItemsWithProjectAsGeoJSON200JSONResponse GeoJSON
 
-func (response ModelUpdateWithProject200JSONResponse) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+func (response ItemsWithProjectAsGeoJSON200JSONResponse) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ModelUpdateWithProject400Response struct {
+type ItemsWithProjectAsGeoJSON400Response struct {
 }
 
-func (response ModelUpdateWithProject400Response) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+func (response ItemsWithProjectAsGeoJSON400Response) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ModelUpdateWithProject401Response = UnauthorizedErrorResponse
+type ItemsWithProjectAsGeoJSON401Response = UnauthorizedErrorResponse
 
-func (response ModelUpdateWithProject401Response) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+func (response ItemsWithProjectAsGeoJSON401Response) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ModelUpdateWithProject404Response struct {
+type ItemsWithProjectAsGeoJSON404Response struct {
 }
 
-func (response ModelUpdateWithProject404Response) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+func (response ItemsWithProjectAsGeoJSON404Response) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ModelUpdateWithProject500Response struct {
+type ItemsWithProjectAsGeoJSON500Response struct {
 }
 
-func (response ModelUpdateWithProject500Response) VisitModelUpdateWithProjectResponse(w http.ResponseWriter) error {
+func (response ItemsWithProjectAsGeoJSON500Response) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type FieldCreateWithProjectRequestObject struct {
+type MetadataSchemaByModelWithProjectAsJSONRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
 	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
-	Body             *FieldCreateWithProjectJSONRequestBody
 }
 
-type FieldCreateWithProjectResponseObject interface {
-	VisitFieldCreateWithProjectResponse(w http.ResponseWriter) error
+type MetadataSchemaByModelWithProjectAsJSONResponseObject interface {
+	VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error
 }
 
-type FieldCreateWithProject200JSONResponse SchemaField
+type MetadataSchemaByModelWithProjectAsJSON200JSONResponse SchemaJSON
 
-func (response FieldCreateWithProject200JSONResponse) VisitFieldCreateWithProjectResponse(w http.ResponseWriter) error {
+func (response MetadataSchemaByModelWithProjectAsJSON200JSONResponse) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FieldCreateWithProject400Response struct {
+type MetadataSchemaByModelWithProjectAsJSON400Response struct {
 }
 
-func (response FieldCreateWithProject400Response) VisitFieldCreateWithProjectResponse(w http.ResponseWriter) error {
+func (response MetadataSchemaByModelWithProjectAsJSON400Response) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type FieldCreateWithProject401Response = UnauthorizedErrorResponse
+type MetadataSchemaByModelWithProjectAsJSON401Response = UnauthorizedErrorResponse
 
-func (response FieldCreateWithProject401Response) VisitFieldCreateWithProjectResponse(w http.ResponseWriter) error {
+func (response MetadataSchemaByModelWithProjectAsJSON401Response) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type FieldDeleteWithProjectRequestObject struct {
+type MetadataSchemaByModelWithProjectAsJSON404Response struct {
+}
+
+func (response MetadataSchemaByModelWithProjectAsJSON404Response) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type MetadataSchemaByModelWithProjectAsJSON500Response struct {
+}
+
+func (response MetadataSchemaByModelWithProjectAsJSON500Response) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type SchemaByModelWithProjectAsJSONRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
 	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
-	FieldIdOrKey     FieldIdOrKeyParam     `json:"fieldIdOrKey"`
 }
 
-type FieldDeleteWithProjectResponseObject interface {
-	VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error
+type SchemaByModelWithProjectAsJSONResponseObject interface {
+	VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error
 }
 
-type FieldDeleteWithProject200JSONResponse struct {
-	Id *id.FieldID `json:"id,omitempty"`
-}
+type SchemaByModelWithProjectAsJSON200JSONResponse SchemaJSON
 
-func (response FieldDeleteWithProject200JSONResponse) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+func (response SchemaByModelWithProjectAsJSON200JSONResponse) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FieldDeleteWithProject400Response struct {
+type SchemaByModelWithProjectAsJSON400Response struct {
 }
 
-func (response FieldDeleteWithProject400Response) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+func (response SchemaByModelWithProjectAsJSON400Response) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type FieldDeleteWithProject401Response = UnauthorizedErrorResponse
+type SchemaByModelWithProjectAsJSON401Response = UnauthorizedErrorResponse
 
-func (response FieldDeleteWithProject401Response) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+func (response SchemaByModelWithProjectAsJSON401Response) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type FieldDeleteWithProject404Response struct {
+type SchemaByModelWithProjectAsJSON404Response struct {
 }
 
-func (response FieldDeleteWithProject404Response) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+func (response SchemaByModelWithProjectAsJSON404Response) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type FieldDeleteWithProject500Response struct {
+type SchemaByModelWithProjectAsJSON500Response struct {
 }
 
-func (response FieldDeleteWithProject500Response) VisitFieldDeleteWithProjectResponse(w http.ResponseWriter) error {
+func (response SchemaByModelWithProjectAsJSON500Response) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type FieldUpdateWithProjectRequestObject struct {
+type PreviewTokenListRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
-	FieldIdOrKey     FieldIdOrKeyParam     `json:"fieldIdOrKey"`
-	Body             *FieldUpdateWithProjectJSONRequestBody
+	Params           PreviewTokenListParams
 }
 
-type FieldUpdateWithProjectResponseObject interface {
-	VisitFieldUpdateWithProjectResponse(w http.ResponseWriter) error
+type PreviewTokenListResponseObject interface {
+	VisitPreviewTokenListResponse(w http.ResponseWriter) error
 }
 
-type FieldUpdateWithProject200JSONResponse SchemaField
+type PreviewTokenList200JSONResponse struct {
+	Page          *int            `json:"page,omitempty"`
+	PerPage       *int            `json:"perPage,omitempty"`
+	PreviewTokens *[]PreviewToken `json:"previewTokens,omitempty"`
+	TotalCount    *int            `json:"totalCount,omitempty"`
+}
 
-func (response FieldUpdateWithProject200JSONResponse) VisitFieldUpdateWithProjectResponse(w http.ResponseWriter) error {
+func (response PreviewTokenList200JSONResponse) VisitPreviewTokenListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type FieldUpdateWithProject400Response struct {
+type PreviewTokenList400Response struct {
 }
 
-func (response FieldUpdateWithProject400Response) VisitFieldUpdateWithProjectResponse(w http.ResponseWriter) error {
+func (response PreviewTokenList400Response) VisitPreviewTokenListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type FieldUpdateWithProject401Response = UnauthorizedErrorResponse
+type PreviewTokenList401Response = UnauthorizedErrorResponse
 
-func (response FieldUpdateWithProject401Response) VisitFieldUpdateWithProjectResponse(w http.ResponseWriter) error {
+func (response PreviewTokenList401Response) VisitPreviewTokenListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemFilterWithProjectRequestObject struct {
+type PreviewTokenList404Response struct {
+}
+
+func (response PreviewTokenList404Response) VisitPreviewTokenListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type PreviewTokenList500Response struct {
+}
+
+func (response PreviewTokenList500Response) VisitPreviewTokenListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PreviewTokenIssueRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
-	Params           ItemFilterWithProjectParams
+	Body             *PreviewTokenIssueJSONRequestBody
 }
 
-type ItemFilterWithProjectResponseObject interface {
-	VisitItemFilterWithProjectResponse(w http.ResponseWriter) error
+type PreviewTokenIssueResponseObject interface {
+	VisitPreviewTokenIssueResponse(w http.ResponseWriter) error
 }
 
-type ItemFilterWithProject200JSONResponse struct {
-	Items      *[]VersionedItem `json:"items,omitempty"`
-	Page       *int             `json:"page,omitempty"`
-	PerPage    *int             `json:"perPage,omitempty"`
-	TotalCount *int             `json:"totalCount,omitempty"`
+type PreviewTokenIssue200JSONResponse struct {
+	PreviewToken PreviewToken `json:"previewToken"`
+	Token        string       `json:"token"`
 }
 
-func (response ItemFilterWithProject200JSONResponse) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+func (response PreviewTokenIssue200JSONResponse) VisitPreviewTokenIssueResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ItemFilterWithProject400Response struct {
+type PreviewTokenIssue400Response struct {
 }
 
-func (response ItemFilterWithProject400Response) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+func (response PreviewTokenIssue400Response) VisitPreviewTokenIssueResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemFilterWithProject401Response = UnauthorizedErrorResponse
+type PreviewTokenIssue401Response = UnauthorizedErrorResponse
 
-func (response ItemFilterWithProject401Response) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+func (response PreviewTokenIssue401Response) VisitPreviewTokenIssueResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemFilterWithProject404Response struct {
+type PreviewTokenIssue404Response struct {
 }
 
-func (response ItemFilterWithProject404Response) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+func (response PreviewTokenIssue404Response) VisitPreviewTokenIssueResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemFilterWithProject500Response struct {
+type PreviewTokenIssue500Response struct {
 }
 
-func (response ItemFilterWithProject500Response) VisitItemFilterWithProjectResponse(w http.ResponseWriter) error {
+func (response PreviewTokenIssue500Response) VisitPreviewTokenIssueResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type ItemCreateWithProjectRequestObject struct {
+type ScheduleListRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
-	Body             *ItemCreateWithProjectJSONRequestBody
 }
 
-type ItemCreateWithProjectResponseObject interface {
-	VisitItemCreateWithProjectResponse(w http.ResponseWriter) error
+type ScheduleListResponseObject interface {
+	VisitScheduleListResponse(w http.ResponseWriter) error
 }
 
-type ItemCreateWithProject200JSONResponse VersionedItem
+type ScheduleList200JSONResponse struct {
+	Schedules *[]ItemSchedule `json:"schedules,omitempty"`
+}
 
-func (response ItemCreateWithProject200JSONResponse) VisitItemCreateWithProjectResponse(w http.ResponseWriter) error {
+func (response ScheduleList200JSONResponse) VisitScheduleListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ItemCreateWithProject400Response struct {
+type ScheduleList400Response struct {
 }
 
-func (response ItemCreateWithProject400Response) VisitItemCreateWithProjectResponse(w http.ResponseWriter) error {
+func (response ScheduleList400Response) VisitScheduleListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemCreateWithProject401Response = UnauthorizedErrorResponse
+type ScheduleList401Response = UnauthorizedErrorResponse
 
-func (response ItemCreateWithProject401Response) VisitItemCreateWithProjectResponse(w http.ResponseWriter) error {
+func (response ScheduleList401Response) VisitScheduleListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemsWithProjectAsCSVRequestObject struct {
+type ScheduleList404Response struct {
+}
+
+func (response ScheduleList404Response) VisitScheduleListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ScheduleList500Response struct {
+}
+
+func (response ScheduleList500Response) VisitScheduleListResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ScheduleCreateRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
-	Params           ItemsWithProjectAsCSVParams
+	Body             *ScheduleCreateJSONRequestBody
 }
 
-type ItemsWithProjectAsCSVResponseObject interface {
-	VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error
+type ScheduleCreateResponseObject interface {
+	VisitScheduleCreateResponse(w http.ResponseWriter) error
 }
 
-type ItemsWithProjectAsCSV200TextcsvResponse struct {
-	Body          io.Reader
-	ContentLength int64
+type ScheduleCreate200JSONResponse struct {
+	Schedules *[]ItemSchedule `json:"schedules,omitempty"`
 }
 
-func (response ItemsWithProjectAsCSV200TextcsvResponse) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/csv")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
-	}
+func (response ScheduleCreate200JSONResponse) VisitScheduleCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
-	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ItemsWithProjectAsCSV400Response struct {
+type ScheduleCreate400Response struct {
 }
 
-func (response ItemsWithProjectAsCSV400Response) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+func (response ScheduleCreate400Response) VisitScheduleCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemsWithProjectAsCSV401Response = UnauthorizedErrorResponse
+type ScheduleCreate401Response = UnauthorizedErrorResponse
 
-func (response ItemsWithProjectAsCSV401Response) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+func (response ScheduleCreate401Response) VisitScheduleCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemsWithProjectAsCSV404Response struct {
+type ScheduleCreate404Response struct {
 }
 
-func (response ItemsWithProjectAsCSV404Response) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+func (response ScheduleCreate404Response) VisitScheduleCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemsWithProjectAsCSV500Response struct {
+type ScheduleCreate500Response struct {
 }
 
-func (response ItemsWithProjectAsCSV500Response) VisitItemsWithProjectAsCSVResponse(w http.ResponseWriter) error {
+func (response ScheduleCreate500Response) VisitScheduleCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type ItemsWithProjectAsGeoJSONRequestObject struct {
+type SchemaFilterRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
-	Params           ItemsWithProjectAsGeoJSONParams
+	Params           SchemaFilterParams
 }
 
-type ItemsWithProjectAsGeoJSONResponseObject interface {
-	VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error
+type SchemaFilterResponseObject interface {
+	VisitSchemaFilterResponse(w http.ResponseWriter) error
 }
 
-type ItemsWithProjectAsGeoJSON200JSONResponse GeoJSON
+type SchemaFilter200JSONResponse struct {
+	Models     *[]Model `json:"models,omitempty"`
+	Page       *int     `json:"page,omitempty"`
+	PerPage    *int     `json:"perPage,omitempty"`
+	TotalCount *int     `json:"totalCount,omitempty"`
+}
 
-func (response ItemsWithProjectAsGeoJSON200JSONResponse) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response SchemaFilter200JSONResponse) VisitSchemaFilterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ItemsWithProjectAsGeoJSON400Response struct {
+type SchemaFilter400Response struct {
 }
 
-func (response ItemsWithProjectAsGeoJSON400Response) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response SchemaFilter400Response) VisitSchemaFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ItemsWithProjectAsGeoJSON401Response = UnauthorizedErrorResponse
+type SchemaFilter401Response = UnauthorizedErrorResponse
 
-func (response ItemsWithProjectAsGeoJSON401Response) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response SchemaFilter401Response) VisitSchemaFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ItemsWithProjectAsGeoJSON404Response struct {
+type SchemaFilter404Response struct {
 }
 
-func (response ItemsWithProjectAsGeoJSON404Response) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response SchemaFilter404Response) VisitSchemaFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ItemsWithProjectAsGeoJSON500Response struct {
+type SchemaFilter500Response struct {
 }
 
-func (response ItemsWithProjectAsGeoJSON500Response) VisitItemsWithProjectAsGeoJSONResponse(w http.ResponseWriter) error {
+func (response SchemaFilter500Response) VisitSchemaFilterResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type MetadataSchemaByModelWithProjectAsJSONRequestObject struct {
+type SchemaSpecApplyRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	Params           SchemaSpecApplyParams
+	Body             *SchemaSpecApplyJSONRequestBody
 }
 
-type MetadataSchemaByModelWithProjectAsJSONResponseObject interface {
-	VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error
+type SchemaSpecApplyResponseObject interface {
+	VisitSchemaSpecApplyResponse(w http.ResponseWriter) error
 }
 
-type MetadataSchemaByModelWithProjectAsJSON200JSONResponse SchemaJSON
+type SchemaSpecApply200JSONResponse SchemaPlan
 
-func (response MetadataSchemaByModelWithProjectAsJSON200JSONResponse) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecApply200JSONResponse) VisitSchemaSpecApplyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MetadataSchemaByModelWithProjectAsJSON400Response struct {
+type SchemaSpecApply400Response struct {
 }
 
-func (response MetadataSchemaByModelWithProjectAsJSON400Response) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecApply400Response) VisitSchemaSpecApplyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type MetadataSchemaByModelWithProjectAsJSON401Response = UnauthorizedErrorResponse
+type SchemaSpecApply401Response = UnauthorizedErrorResponse
 
-func (response MetadataSchemaByModelWithProjectAsJSON401Response) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecApply401Response) VisitSchemaSpecApplyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type MetadataSchemaByModelWithProjectAsJSON404Response struct {
+type SchemaSpecApply404Response struct {
 }
 
-func (response MetadataSchemaByModelWithProjectAsJSON404Response) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecApply404Response) VisitSchemaSpecApplyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type MetadataSchemaByModelWithProjectAsJSON500Response struct {
+type SchemaSpecApply500Response struct {
 }
 
-func (response MetadataSchemaByModelWithProjectAsJSON500Response) VisitMetadataSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecApply500Response) VisitSchemaSpecApplyResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type SchemaByModelWithProjectAsJSONRequestObject struct {
+type SchemaSpecPlanRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	ModelIdOrKey     ModelIdOrKeyParam     `json:"modelIdOrKey"`
+	Body             *SchemaSpecPlanJSONRequestBody
 }
 
-type SchemaByModelWithProjectAsJSONResponseObject interface {
-	VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error
+type SchemaSpecPlanResponseObject interface {
+	VisitSchemaSpecPlanResponse(w http.ResponseWriter) error
 }
 
-type SchemaByModelWithProjectAsJSON200JSONResponse SchemaJSON
+type SchemaSpecPlan200JSONResponse SchemaPlan
 
-func (response SchemaByModelWithProjectAsJSON200JSONResponse) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecPlan200JSONResponse) VisitSchemaSpecPlanResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SchemaByModelWithProjectAsJSON400Response struct {
+type SchemaSpecPlan400Response struct {
 }
 
-func (response SchemaByModelWithProjectAsJSON400Response) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecPlan400Response) VisitSchemaSpecPlanResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type SchemaByModelWithProjectAsJSON401Response = UnauthorizedErrorResponse
+type SchemaSpecPlan401Response = UnauthorizedErrorResponse
 
-func (response SchemaByModelWithProjectAsJSON401Response) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecPlan401Response) VisitSchemaSpecPlanResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type SchemaByModelWithProjectAsJSON404Response struct {
+type SchemaSpecPlan404Response struct {
 }
 
-func (response SchemaByModelWithProjectAsJSON404Response) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecPlan404Response) VisitSchemaSpecPlanResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type SchemaByModelWithProjectAsJSON500Response struct {
+type SchemaSpecPlan500Response struct {
 }
 
-func (response SchemaByModelWithProjectAsJSON500Response) VisitSchemaByModelWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response SchemaSpecPlan500Response) VisitSchemaSpecPlanResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type SchemaFilterRequestObject struct {
+type SchemaByIDWithProjectAsJSONRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	Params           SchemaFilterParams
-}
-
-type SchemaFilterResponseObject interface {
-	VisitSchemaFilterResponse(w http.ResponseWriter) error
+	SchemaId         SchemaIdParam         `json:"schemaId"`
 }
 
-type SchemaFilter200JSONResponse struct {
-	Models     *[]Model `json:"models,omitempty"`
-	Page       *int     `json:"page,omitempty"`
-	PerPage    *int     `json:"perPage,omitempty"`
-	TotalCount *int     `json:"totalCount,omitempty"`
+type SchemaByIDWithProjectAsJSONResponseObject interface {
+	VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error
 }
 
-func (response SchemaFilter200JSONResponse) VisitSchemaFilterResponse(w http.ResponseWriter) error {
+type SchemaByIDWithProjectAsJSON200JSONResponse SchemaJSON
+
+func (response SchemaByIDWithProjectAsJSON200JSONResponse) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SchemaFilter400Response struct {
+type SchemaByIDWithProjectAsJSON400Response struct {
 }
 
-func (response SchemaFilter400Response) VisitSchemaFilterResponse(w http.ResponseWriter) error {
+func (response SchemaByIDWithProjectAsJSON400Response) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type SchemaFilter401Response = UnauthorizedErrorResponse
+type SchemaByIDWithProjectAsJSON401Response = UnauthorizedErrorResponse
 
-func (response SchemaFilter401Response) VisitSchemaFilterResponse(w http.ResponseWriter) error {
+func (response SchemaByIDWithProjectAsJSON401Response) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type SchemaFilter404Response struct {
+type SchemaByIDWithProjectAsJSON404Response struct {
 }
 
-func (response SchemaFilter404Response) VisitSchemaFilterResponse(w http.ResponseWriter) error {
+func (response SchemaByIDWithProjectAsJSON404Response) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type SchemaFilter500Response struct {
+type SchemaByIDWithProjectAsJSON500Response struct {
 }
 
-func (response SchemaFilter500Response) VisitSchemaFilterResponse(w http.ResponseWriter) error {
+func (response SchemaByIDWithProjectAsJSON500Response) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type SchemaByIDWithProjectAsJSONRequestObject struct {
+type TrashListRequestObject struct {
 	ProjectIdOrAlias ProjectIdOrAliasParam `json:"projectIdOrAlias"`
-	SchemaId         SchemaIdParam         `json:"schemaId"`
+	Params           TrashListParams
 }
 
-type SchemaByIDWithProjectAsJSONResponseObject interface {
-	VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error
+type TrashListResponseObject interface {
+	VisitTrashListResponse(w http.ResponseWriter) error
 }
 
-type SchemaByIDWithProjectAsJSON200JSONResponse SchemaJSON
+type TrashList200JSONResponse struct {
+	Entries    *[]TrashEntry `json:"entries,omitempty"`
+	Page       *int          `json:"page,omitempty"`
+	PerPage    *int          `json:"perPage,omitempty"`
+	TotalCount *int          `json:"totalCount,omitempty"`
+}
 
-func (response SchemaByIDWithProjectAsJSON200JSONResponse) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response TrashList200JSONResponse) VisitTrashListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SchemaByIDWithProjectAsJSON400Response struct {
+type TrashList400Response struct {
 }
 
-func (response SchemaByIDWithProjectAsJSON400Response) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response TrashList400Response) VisitTrashListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type SchemaByIDWithProjectAsJSON401Response = UnauthorizedErrorResponse
+type TrashList401Response = UnauthorizedErrorResponse
 
-func (response SchemaByIDWithProjectAsJSON401Response) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response TrashList401Response) VisitTrashListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type SchemaByIDWithProjectAsJSON404Response struct {
+type TrashList404Response struct {
 }
 
-func (response SchemaByIDWithProjectAsJSON404Response) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response TrashList404Response) VisitTrashListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type SchemaByIDWithProjectAsJSON500Response struct {
+type TrashList500Response struct {
 }
 
-func (response SchemaByIDWithProjectAsJSON500Response) VisitSchemaByIDWithProjectAsJSONResponse(w http.ResponseWriter) error {
+func (response TrashList500Response) VisitTrashListResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
@@ -4773,6 +6505,250 @@ func (response SchemaByIDAsJSON500Response) VisitSchemaByIDAsJSONResponse(w http
 	return nil
 }
 
+type ItemTemplateDeleteRequestObject struct {
+	TemplateId TemplateIdParam `json:"templateId"`
+}
+
+type ItemTemplateDeleteResponseObject interface {
+	VisitItemTemplateDeleteResponse(w http.ResponseWriter) error
+}
+
+type ItemTemplateDelete200JSONResponse struct {
+	Id *id.ItemTemplateID `json:"id,omitempty"`
+}
+
+func (response ItemTemplateDelete200JSONResponse) VisitItemTemplateDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemTemplateDelete400Response struct {
+}
+
+func (response ItemTemplateDelete400Response) VisitItemTemplateDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemTemplateDelete401Response = UnauthorizedErrorResponse
+
+func (response ItemTemplateDelete401Response) VisitItemTemplateDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemTemplateDelete404Response struct {
+}
+
+func (response ItemTemplateDelete404Response) VisitItemTemplateDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemTemplateDelete500Response struct {
+}
+
+func (response ItemTemplateDelete500Response) VisitItemTemplateDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemTemplateGetRequestObject struct {
+	TemplateId TemplateIdParam `json:"templateId"`
+}
+
+type ItemTemplateGetResponseObject interface {
+	VisitItemTemplateGetResponse(w http.ResponseWriter) error
+}
+
+type ItemTemplateGet200JSONResponse ItemTemplate
+
+func (response ItemTemplateGet200JSONResponse) VisitItemTemplateGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemTemplateGet400Response struct {
+}
+
+func (response ItemTemplateGet400Response) VisitItemTemplateGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemTemplateGet401Response = UnauthorizedErrorResponse
+
+func (response ItemTemplateGet401Response) VisitItemTemplateGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemTemplateGet404Response struct {
+}
+
+func (response ItemTemplateGet404Response) VisitItemTemplateGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemTemplateGet500Response struct {
+}
+
+func (response ItemTemplateGet500Response) VisitItemTemplateGetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemTemplateUpdateRequestObject struct {
+	TemplateId TemplateIdParam `json:"templateId"`
+	Body       *ItemTemplateUpdateJSONRequestBody
+}
+
+type ItemTemplateUpdateResponseObject interface {
+	VisitItemTemplateUpdateResponse(w http.ResponseWriter) error
+}
+
+type ItemTemplateUpdate200JSONResponse ItemTemplate
+
+func (response ItemTemplateUpdate200JSONResponse) VisitItemTemplateUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemTemplateUpdate400Response struct {
+}
+
+func (response ItemTemplateUpdate400Response) VisitItemTemplateUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemTemplateUpdate401Response = UnauthorizedErrorResponse
+
+func (response ItemTemplateUpdate401Response) VisitItemTemplateUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemTemplateUpdate404Response struct {
+}
+
+func (response ItemTemplateUpdate404Response) VisitItemTemplateUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemTemplateUpdate500Response struct {
+}
+
+func (response ItemTemplateUpdate500Response) VisitItemTemplateUpdateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ItemCreateFromTemplateRequestObject struct {
+	TemplateId TemplateIdParam `json:"templateId"`
+	Body       *ItemCreateFromTemplateJSONRequestBody
+}
+
+type ItemCreateFromTemplateResponseObject interface {
+	VisitItemCreateFromTemplateResponse(w http.ResponseWriter) error
+}
+
+type ItemCreateFromTemplate200JSONResponse VersionedItem
+
+func (response ItemCreateFromTemplate200JSONResponse) VisitItemCreateFromTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ItemCreateFromTemplate400Response struct {
+}
+
+func (response ItemCreateFromTemplate400Response) VisitItemCreateFromTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ItemCreateFromTemplate401Response = UnauthorizedErrorResponse
+
+func (response ItemCreateFromTemplate401Response) VisitItemCreateFromTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ItemCreateFromTemplate404Response struct {
+}
+
+func (response ItemCreateFromTemplate404Response) VisitItemCreateFromTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ItemCreateFromTemplate500Response struct {
+}
+
+func (response ItemCreateFromTemplate500Response) VisitItemCreateFromTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type TrashRestoreRequestObject struct {
+	TrashId TrashIdParam `json:"trashId"`
+}
+
+type TrashRestoreResponseObject interface {
+	VisitTrashRestoreResponse(w http.ResponseWriter) error
+}
+
+type TrashRestore200JSONResponse TrashEntry
+
+func (response TrashRestore200JSONResponse) VisitTrashRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrashRestore400Response struct {
+}
+
+func (response TrashRestore400Response) VisitTrashRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type TrashRestore401Response = UnauthorizedErrorResponse
+
+func (response TrashRestore401Response) VisitTrashRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type TrashRestore404Response struct {
+}
+
+func (response TrashRestore404Response) VisitTrashRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type TrashRestore500Response struct {
+}
+
+func (response TrashRestore500Response) VisitTrashRestoreResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
 type ProjectFilterRequestObject struct {
 	WorkspaceId WorkspaceIdParam `json:"workspaceId"`
 	Params      ProjectFilterParams
@@ -4796,82 +6772,131 @@ func (response ProjectFilter200JSONResponse) VisitProjectFilterResponse(w http.R
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProjectFilter400Response struct {
+type ProjectFilter400Response struct {
+}
+
+func (response ProjectFilter400Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ProjectFilter401Response = UnauthorizedErrorResponse
+
+func (response ProjectFilter401Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ProjectFilter404Response = NotFoundErrorResponse
+
+func (response ProjectFilter404Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ProjectFilter500Response struct {
+}
+
+func (response ProjectFilter500Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ProjectCreateRequestObject struct {
+	WorkspaceId WorkspaceIdParam `json:"workspaceId"`
+	Params      ProjectCreateParams
+	Body        *ProjectCreateJSONRequestBody
+}
+
+type ProjectCreateResponseObject interface {
+	VisitProjectCreateResponse(w http.ResponseWriter) error
+}
+
+type ProjectCreate201JSONResponse Project
+
+func (response ProjectCreate201JSONResponse) VisitProjectCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProjectCreate400Response struct {
 }
 
-func (response ProjectFilter400Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+func (response ProjectCreate400Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ProjectFilter401Response = UnauthorizedErrorResponse
+type ProjectCreate401Response = UnauthorizedErrorResponse
 
-func (response ProjectFilter401Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+func (response ProjectCreate401Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ProjectFilter404Response = NotFoundErrorResponse
+type ProjectCreate404Response struct {
+}
 
-func (response ProjectFilter404Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+func (response ProjectCreate404Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ProjectFilter500Response struct {
+type ProjectCreate500Response struct {
 }
 
-func (response ProjectFilter500Response) VisitProjectFilterResponse(w http.ResponseWriter) error {
+func (response ProjectCreate500Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type ProjectCreateRequestObject struct {
+type ProjectImportRequestObject struct {
 	WorkspaceId WorkspaceIdParam `json:"workspaceId"`
-	Params      ProjectCreateParams
-	Body        *ProjectCreateJSONRequestBody
+	Body        *multipart.Reader
 }
 
-type ProjectCreateResponseObject interface {
-	VisitProjectCreateResponse(w http.ResponseWriter) error
+type ProjectImportResponseObject interface {
+	VisitProjectImportResponse(w http.ResponseWriter) error
 }
 
-type ProjectCreate201JSONResponse Project
+type ProjectImport201JSONResponse Project
 
-func (response ProjectCreate201JSONResponse) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response ProjectImport201JSONResponse) VisitProjectImportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ProjectCreate400Response struct {
+type ProjectImport400Response struct {
 }
 
-func (response ProjectCreate400Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response ProjectImport400Response) VisitProjectImportResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ProjectCreate401Response = UnauthorizedErrorResponse
+type ProjectImport401Response = UnauthorizedErrorResponse
 
-func (response ProjectCreate401Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response ProjectImport401Response) VisitProjectImportResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ProjectCreate404Response struct {
+type ProjectImport404Response struct {
 }
 
-func (response ProjectCreate404Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response ProjectImport404Response) VisitProjectImportResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type ProjectCreate500Response struct {
+type ProjectImport500Response struct {
 }
 
-func (response ProjectCreate500Response) VisitProjectCreateResponse(w http.ResponseWriter) error {
+func (response ProjectImport500Response) VisitProjectImportResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
@@ -5026,6 +7051,65 @@ func (response ProjectUpdate500Response) VisitProjectUpdateResponse(w http.Respo
 	return nil
 }
 
+type ProjectExportRequestObject struct {
+	WorkspaceId WorkspaceIdParam `json:"workspaceId"`
+	ProjectId   ProjectIdParam   `json:"projectId"`
+}
+
+type ProjectExportResponseObject interface {
+	VisitProjectExportResponse(w http.ResponseWriter) error
+}
+
+type ProjectExport200ApplicationzipResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ProjectExport200ApplicationzipResponse) VisitProjectExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/zip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ProjectExport400Response struct {
+}
+
+func (response ProjectExport400Response) VisitProjectExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ProjectExport401Response = UnauthorizedErrorResponse
+
+func (response ProjectExport401Response) VisitProjectExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ProjectExport404Response struct {
+}
+
+func (response ProjectExport404Response) VisitProjectExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ProjectExport500Response struct {
+}
+
+func (response ProjectExport500Response) VisitProjectExportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// delete assets in batch
@@ -5052,6 +7136,9 @@ type StrictServerInterface interface {
 	// publish asset
 	// (POST /assets/{assetId}/publish)
 	AssetPublish(ctx context.Context, request AssetPublishRequestObject) (AssetPublishResponseObject, error)
+	// Returns a signed URL of the image asset transformed with the parameters.
+	// (GET /assets/{assetId}/transform)
+	AssetTransformURL(ctx context.Context, request AssetTransformURLRequestObject) (AssetTransformURLResponseObject, error)
 	// publish asset
 	// (POST /assets/{assetId}/unpublish)
 	AssetUnpublish(ctx context.Context, request AssetUnpublishRequestObject) (AssetUnpublishResponseObject, error)
@@ -5067,6 +7154,15 @@ type StrictServerInterface interface {
 	// Update a group's details.
 	// (PATCH /groups/{groupId})
 	GroupUpdate(ctx context.Context, request GroupUpdateRequestObject) (GroupUpdateResponseObject, error)
+	// delete items in batch
+	// (DELETE /items/batch)
+	ItemBatchDelete(ctx context.Context, request ItemBatchDeleteRequestObject) (ItemBatchDeleteResponseObject, error)
+	// update items in batch
+	// (PATCH /items/batch)
+	ItemBatchUpdate(ctx context.Context, request ItemBatchUpdateRequestObject) (ItemBatchUpdateResponseObject, error)
+	// publish items in batch
+	// (POST /items/batch/publish)
+	ItemBatchPublish(ctx context.Context, request ItemBatchPublishRequestObject) (ItemBatchPublishResponseObject, error)
 	// delete an item
 	// (DELETE /items/{itemId})
 	ItemDelete(ctx context.Context, request ItemDeleteRequestObject) (ItemDeleteResponseObject, error)
@@ -5088,6 +7184,21 @@ type StrictServerInterface interface {
 	// Update Item Comment
 	// (PATCH /items/{itemId}/comments/{commentId})
 	ItemCommentUpdate(ctx context.Context, request ItemCommentUpdateRequestObject) (ItemCommentUpdateResponseObject, error)
+	// Returns the difference between two versions of an item.
+	// (GET /items/{itemId}/diff)
+	ItemDiff(ctx context.Context, request ItemDiffRequestObject) (ItemDiffResponseObject, error)
+	// Duplicate an item.
+	// (POST /items/{itemId}/duplicate)
+	ItemDuplicate(ctx context.Context, request ItemDuplicateRequestObject) (ItemDuplicateResponseObject, error)
+	// Restore an item to a previous version.
+	// (POST /items/{itemId}/restore)
+	ItemRestore(ctx context.Context, request ItemRestoreRequestObject) (ItemRestoreResponseObject, error)
+	// Cancel the pending schedule of an item.
+	// (DELETE /items/{itemId}/schedule)
+	ItemScheduleDelete(ctx context.Context, request ItemScheduleDeleteRequestObject) (ItemScheduleDeleteResponseObject, error)
+	// Returns the pending schedule of an item.
+	// (GET /items/{itemId}/schedule)
+	ItemScheduleGet(ctx context.Context, request ItemScheduleGetRequestObject) (ItemScheduleGetResponseObject, error)
 	// delete a model
 	// (DELETE /models/{modelId})
 	ModelDelete(ctx context.Context, request ModelDeleteRequestObject) (ModelDeleteResponseObject, error)
@@ -5115,12 +7226,24 @@ type StrictServerInterface interface {
 	// Returns a GeoJSON that has a list of items as features.
 	// (GET /models/{modelId}/items.geojson)
 	ItemsAsGeoJSON(ctx context.Context, request ItemsAsGeoJSONRequestObject) (ItemsAsGeoJSONResponseObject, error)
+	// create items in batch
+	// (POST /models/{modelId}/items/batch)
+	ItemBatchCreate(ctx context.Context, request ItemBatchCreateRequestObject) (ItemBatchCreateResponseObject, error)
 	// Returns a metadata schema as json by model ID
 	// (GET /models/{modelId}/metadata_schema.json)
 	MetadataSchemaByModelAsJSON(ctx context.Context, request MetadataSchemaByModelAsJSONRequestObject) (MetadataSchemaByModelAsJSONResponseObject, error)
 	// Returns a schema as json by model ID
 	// (GET /models/{modelId}/schema.json)
 	SchemaByModelAsJSON(ctx context.Context, request SchemaByModelAsJSONRequestObject) (SchemaByModelAsJSONResponseObject, error)
+	// Returns a list of item templates of the model.
+	// (GET /models/{modelId}/templates)
+	ItemTemplateList(ctx context.Context, request ItemTemplateListRequestObject) (ItemTemplateListResponseObject, error)
+	// Create an item template.
+	// (POST /models/{modelId}/templates)
+	ItemTemplateCreate(ctx context.Context, request ItemTemplateCreateRequestObject) (ItemTemplateCreateResponseObject, error)
+	// Revoke a preview token.
+	// (DELETE /preview_tokens/{previewTokenId})
+	PreviewTokenRevoke(ctx context.Context, request PreviewTokenRevokeRequestObject) (PreviewTokenRevokeResponseObject, error)
 	// Returns a list of groups in a project.
 	// (GET /projects/{projectIdOrAlias}/groups)
 	GroupFilter(ctx context.Context, request GroupFilterRequestObject) (GroupFilterResponseObject, error)
@@ -5178,12 +7301,33 @@ type StrictServerInterface interface {
 	// Returns a schema as json by project and model ID
 	// (GET /projects/{projectIdOrAlias}/models/{modelIdOrKey}/schema.json)
 	SchemaByModelWithProjectAsJSON(ctx context.Context, request SchemaByModelWithProjectAsJSONRequestObject) (SchemaByModelWithProjectAsJSONResponseObject, error)
+	// Returns the preview tokens of the project.
+	// (GET /projects/{projectIdOrAlias}/preview_tokens)
+	PreviewTokenList(ctx context.Context, request PreviewTokenListRequestObject) (PreviewTokenListResponseObject, error)
+	// Issue a preview token.
+	// (POST /projects/{projectIdOrAlias}/preview_tokens)
+	PreviewTokenIssue(ctx context.Context, request PreviewTokenIssueRequestObject) (PreviewTokenIssueResponseObject, error)
+	// Returns a list of pending item schedules.
+	// (GET /projects/{projectIdOrAlias}/schedules)
+	ScheduleList(ctx context.Context, request ScheduleListRequestObject) (ScheduleListResponseObject, error)
+	// Schedule publishing and unpublishing of items.
+	// (POST /projects/{projectIdOrAlias}/schedules)
+	ScheduleCreate(ctx context.Context, request ScheduleCreateRequestObject) (ScheduleCreateResponseObject, error)
 	// Returns a schema.
 	// (GET /projects/{projectIdOrAlias}/schemata)
 	SchemaFilter(ctx context.Context, request SchemaFilterRequestObject) (SchemaFilterResponseObject, error)
+	// Applies a spec to the schemas.
+	// (POST /projects/{projectIdOrAlias}/schemata/apply)
+	SchemaSpecApply(ctx context.Context, request SchemaSpecApplyRequestObject) (SchemaSpecApplyResponseObject, error)
+	// Plans the changes to the schemas from a spec.
+	// (POST /projects/{projectIdOrAlias}/schemata/plan)
+	SchemaSpecPlan(ctx context.Context, request SchemaSpecPlanRequestObject) (SchemaSpecPlanResponseObject, error)
 	// Returns a schema as json by project and schema ID
 	// (GET /projects/{projectIdOrAlias}/schemata/{schemaId}/schema.json)
 	SchemaByIDWithProjectAsJSON(ctx context.Context, request SchemaByIDWithProjectAsJSONRequestObject) (SchemaByIDWithProjectAsJSONResponseObject, error)
+	// Returns the entries in the trash of the project.
+	// (GET /projects/{projectIdOrAlias}/trash)
+	TrashList(ctx context.Context, request TrashListRequestObject) (TrashListResponseObject, error)
 	// Returns a list of assets.
 	// (GET /projects/{projectId}/assets)
 	AssetFilter(ctx context.Context, request AssetFilterRequestObject) (AssetFilterResponseObject, error)
@@ -5205,12 +7349,30 @@ type StrictServerInterface interface {
 	// Returns a schema as json by schema ID
 	// (GET /schemata/{schemaId}/schema.json)
 	SchemaByIDAsJSON(ctx context.Context, request SchemaByIDAsJSONRequestObject) (SchemaByIDAsJSONResponseObject, error)
+	// Delete an item template.
+	// (DELETE /templates/{templateId})
+	ItemTemplateDelete(ctx context.Context, request ItemTemplateDeleteRequestObject) (ItemTemplateDeleteResponseObject, error)
+	// Returns an item template.
+	// (GET /templates/{templateId})
+	ItemTemplateGet(ctx context.Context, request ItemTemplateGetRequestObject) (ItemTemplateGetResponseObject, error)
+	// Update an item template.
+	// (PATCH /templates/{templateId})
+	ItemTemplateUpdate(ctx context.Context, request ItemTemplateUpdateRequestObject) (ItemTemplateUpdateResponseObject, error)
+	// Create an item from an item template.
+	// (POST /templates/{templateId}/items)
+	ItemCreateFromTemplate(ctx context.Context, request ItemCreateFromTemplateRequestObject) (ItemCreateFromTemplateResponseObject, error)
+	// Restore an entry in the trash.
+	// (POST /trash/{trashId}/restore)
+	TrashRestore(ctx context.Context, request TrashRestoreRequestObject) (TrashRestoreResponseObject, error)
 	// Returns a list of projects.
 	// (GET /{workspaceId}/projects)
 	ProjectFilter(ctx context.Context, request ProjectFilterRequestObject) (ProjectFilterResponseObject, error)
 	// Create a project
 	// (POST /{workspaceId}/projects)
 	ProjectCreate(ctx context.Context, request ProjectCreateRequestObject) (ProjectCreateResponseObject, error)
+	// Import a project
+	// (POST /{workspaceId}/projects/import)
+	ProjectImport(ctx context.Context, request ProjectImportRequestObject) (ProjectImportResponseObject, error)
 	// Delete a project
 	// (DELETE /{workspaceId}/projects/{projectId})
 	ProjectDelete(ctx context.Context, request ProjectDeleteRequestObject) (ProjectDeleteResponseObject, error)
@@ -5220,6 +7382,9 @@ type StrictServerInterface interface {
 	// Update a project.
 	// (PATCH /{workspaceId}/projects/{projectId})
 	ProjectUpdate(ctx context.Context, request ProjectUpdateRequestObject) (ProjectUpdateResponseObject, error)
+	// Export a project.
+	// (GET /{workspaceId}/projects/{projectId}/export)
+	ProjectExport(ctx context.Context, request ProjectExportRequestObject) (ProjectExportResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -5452,6 +7617,32 @@ func (sh *strictHandler) AssetPublish(ctx echo.Context, assetId AssetIdParam) er
 	return nil
 }
 
+// AssetTransformURL operation middleware
+func (sh *strictHandler) AssetTransformURL(ctx echo.Context, assetId AssetIdParam, params AssetTransformURLParams) error {
+	var request AssetTransformURLRequestObject
+
+	request.AssetId = assetId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AssetTransformURL(ctx.Request().Context(), request.(AssetTransformURLRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AssetTransformURL")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AssetTransformURLResponseObject); ok {
+		return validResponse.VisitAssetTransformURLResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // AssetUnpublish operation middleware
 func (sh *strictHandler) AssetUnpublish(ctx echo.Context, assetId AssetIdParam) error {
 	var request AssetUnpublishRequestObject
@@ -5585,6 +7776,93 @@ func (sh *strictHandler) GroupUpdate(ctx echo.Context, groupId GroupIdParam) err
 	return nil
 }
 
+// ItemBatchDelete operation middleware
+func (sh *strictHandler) ItemBatchDelete(ctx echo.Context) error {
+	var request ItemBatchDeleteRequestObject
+
+	var body ItemBatchDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemBatchDelete(ctx.Request().Context(), request.(ItemBatchDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemBatchDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemBatchDeleteResponseObject); ok {
+		return validResponse.VisitItemBatchDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemBatchUpdate operation middleware
+func (sh *strictHandler) ItemBatchUpdate(ctx echo.Context) error {
+	var request ItemBatchUpdateRequestObject
+
+	var body ItemBatchUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemBatchUpdate(ctx.Request().Context(), request.(ItemBatchUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemBatchUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemBatchUpdateResponseObject); ok {
+		return validResponse.VisitItemBatchUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemBatchPublish operation middleware
+func (sh *strictHandler) ItemBatchPublish(ctx echo.Context) error {
+	var request ItemBatchPublishRequestObject
+
+	var body ItemBatchPublishJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemBatchPublish(ctx.Request().Context(), request.(ItemBatchPublishRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemBatchPublish")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemBatchPublishResponseObject); ok {
+		return validResponse.VisitItemBatchPublishResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ItemDelete operation middleware
 func (sh *strictHandler) ItemDelete(ctx echo.Context, itemId ItemIdParam) error {
 	var request ItemDeleteRequestObject
@@ -5763,18 +8041,150 @@ func (sh *strictHandler) ItemCommentUpdate(ctx echo.Context, itemId ItemIdParam,
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemCommentUpdate(ctx.Request().Context(), request.(ItemCommentUpdateRequestObject))
+		return sh.ssi.ItemCommentUpdate(ctx.Request().Context(), request.(ItemCommentUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemCommentUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemCommentUpdateResponseObject); ok {
+		return validResponse.VisitItemCommentUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemDiff operation middleware
+func (sh *strictHandler) ItemDiff(ctx echo.Context, itemId ItemIdParam, params ItemDiffParams) error {
+	var request ItemDiffRequestObject
+
+	request.ItemId = itemId
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemDiff(ctx.Request().Context(), request.(ItemDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemDiff")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemDiffResponseObject); ok {
+		return validResponse.VisitItemDiffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemDuplicate operation middleware
+func (sh *strictHandler) ItemDuplicate(ctx echo.Context, itemId ItemIdParam) error {
+	var request ItemDuplicateRequestObject
+
+	request.ItemId = itemId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemDuplicate(ctx.Request().Context(), request.(ItemDuplicateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemDuplicate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemDuplicateResponseObject); ok {
+		return validResponse.VisitItemDuplicateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemRestore operation middleware
+func (sh *strictHandler) ItemRestore(ctx echo.Context, itemId ItemIdParam) error {
+	var request ItemRestoreRequestObject
+
+	request.ItemId = itemId
+
+	var body ItemRestoreJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemRestore(ctx.Request().Context(), request.(ItemRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemRestoreResponseObject); ok {
+		return validResponse.VisitItemRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemScheduleDelete operation middleware
+func (sh *strictHandler) ItemScheduleDelete(ctx echo.Context, itemId ItemIdParam) error {
+	var request ItemScheduleDeleteRequestObject
+
+	request.ItemId = itemId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemScheduleDelete(ctx.Request().Context(), request.(ItemScheduleDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemScheduleDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemScheduleDeleteResponseObject); ok {
+		return validResponse.VisitItemScheduleDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemScheduleGet operation middleware
+func (sh *strictHandler) ItemScheduleGet(ctx echo.Context, itemId ItemIdParam) error {
+	var request ItemScheduleGetRequestObject
+
+	request.ItemId = itemId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemScheduleGet(ctx.Request().Context(), request.(ItemScheduleGetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemCommentUpdate")
+		handler = middleware(handler, "ItemScheduleGet")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ItemCommentUpdateResponseObject); ok {
-		return validResponse.VisitItemCommentUpdateResponse(ctx.Response())
+	} else if validResponse, ok := response.(ItemScheduleGetResponseObject); ok {
+		return validResponse.VisitItemScheduleGetResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -6047,6 +8457,37 @@ func (sh *strictHandler) ItemsAsGeoJSON(ctx echo.Context, modelId ModelIdParam,
 	return nil
 }
 
+// ItemBatchCreate operation middleware
+func (sh *strictHandler) ItemBatchCreate(ctx echo.Context, modelId ModelIdParam) error {
+	var request ItemBatchCreateRequestObject
+
+	request.ModelId = modelId
+
+	var body ItemBatchCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemBatchCreate(ctx.Request().Context(), request.(ItemBatchCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemBatchCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemBatchCreateResponseObject); ok {
+		return validResponse.VisitItemBatchCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // MetadataSchemaByModelAsJSON operation middleware
 func (sh *strictHandler) MetadataSchemaByModelAsJSON(ctx echo.Context, modelId ModelIdParam) error {
 	var request MetadataSchemaByModelAsJSONRequestObject
@@ -6097,6 +8538,87 @@ func (sh *strictHandler) SchemaByModelAsJSON(ctx echo.Context, modelId ModelIdPa
 	return nil
 }
 
+// ItemTemplateList operation middleware
+func (sh *strictHandler) ItemTemplateList(ctx echo.Context, modelId ModelIdParam) error {
+	var request ItemTemplateListRequestObject
+
+	request.ModelId = modelId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemTemplateList(ctx.Request().Context(), request.(ItemTemplateListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemTemplateList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemTemplateListResponseObject); ok {
+		return validResponse.VisitItemTemplateListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemTemplateCreate operation middleware
+func (sh *strictHandler) ItemTemplateCreate(ctx echo.Context, modelId ModelIdParam) error {
+	var request ItemTemplateCreateRequestObject
+
+	request.ModelId = modelId
+
+	var body ItemTemplateCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemTemplateCreate(ctx.Request().Context(), request.(ItemTemplateCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemTemplateCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemTemplateCreateResponseObject); ok {
+		return validResponse.VisitItemTemplateCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PreviewTokenRevoke operation middleware
+func (sh *strictHandler) PreviewTokenRevoke(ctx echo.Context, previewTokenId PreviewTokenIdParam) error {
+	var request PreviewTokenRevokeRequestObject
+
+	request.PreviewTokenId = previewTokenId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewTokenRevoke(ctx.Request().Context(), request.(PreviewTokenRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewTokenRevoke")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PreviewTokenRevokeResponseObject); ok {
+		return validResponse.VisitPreviewTokenRevokeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GroupFilter operation middleware
 func (sh *strictHandler) GroupFilter(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params GroupFilterParams) error {
 	var request GroupFilterRequestObject
@@ -6446,218 +8968,394 @@ func (sh *strictHandler) FieldUpdateWithProject(ctx echo.Context, projectIdOrAli
 
 	request.ProjectIdOrAlias = projectIdOrAlias
 	request.ModelIdOrKey = modelIdOrKey
-	request.FieldIdOrKey = fieldIdOrKey
-
-	var body FieldUpdateWithProjectJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.FieldIdOrKey = fieldIdOrKey
+
+	var body FieldUpdateWithProjectJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.FieldUpdateWithProject(ctx.Request().Context(), request.(FieldUpdateWithProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FieldUpdateWithProject")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(FieldUpdateWithProjectResponseObject); ok {
+		return validResponse.VisitFieldUpdateWithProjectResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemFilterWithProject operation middleware
+func (sh *strictHandler) ItemFilterWithProject(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemFilterWithProjectParams) error {
+	var request ItemFilterWithProjectRequestObject
+
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemFilterWithProject(ctx.Request().Context(), request.(ItemFilterWithProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemFilterWithProject")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemFilterWithProjectResponseObject); ok {
+		return validResponse.VisitItemFilterWithProjectResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemCreateWithProject operation middleware
+func (sh *strictHandler) ItemCreateWithProject(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
+	var request ItemCreateWithProjectRequestObject
+
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+
+	var body ItemCreateWithProjectJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemCreateWithProject(ctx.Request().Context(), request.(ItemCreateWithProjectRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemCreateWithProject")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemCreateWithProjectResponseObject); ok {
+		return validResponse.VisitItemCreateWithProjectResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemsWithProjectAsCSV operation middleware
+func (sh *strictHandler) ItemsWithProjectAsCSV(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemsWithProjectAsCSVParams) error {
+	var request ItemsWithProjectAsCSVRequestObject
+
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemsWithProjectAsCSV(ctx.Request().Context(), request.(ItemsWithProjectAsCSVRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemsWithProjectAsCSV")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemsWithProjectAsCSVResponseObject); ok {
+		return validResponse.VisitItemsWithProjectAsCSVResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemsWithProjectAsGeoJSON operation middleware
+func (sh *strictHandler) ItemsWithProjectAsGeoJSON(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemsWithProjectAsGeoJSONParams) error {
+	var request ItemsWithProjectAsGeoJSONRequestObject
+
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemsWithProjectAsGeoJSON(ctx.Request().Context(), request.(ItemsWithProjectAsGeoJSONRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemsWithProjectAsGeoJSON")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemsWithProjectAsGeoJSONResponseObject); ok {
+		return validResponse.VisitItemsWithProjectAsGeoJSONResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// MetadataSchemaByModelWithProjectAsJSON operation middleware
+func (sh *strictHandler) MetadataSchemaByModelWithProjectAsJSON(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
+	var request MetadataSchemaByModelWithProjectAsJSONRequestObject
+
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MetadataSchemaByModelWithProjectAsJSON(ctx.Request().Context(), request.(MetadataSchemaByModelWithProjectAsJSONRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MetadataSchemaByModelWithProjectAsJSON")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(MetadataSchemaByModelWithProjectAsJSONResponseObject); ok {
+		return validResponse.VisitMetadataSchemaByModelWithProjectAsJSONResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SchemaByModelWithProjectAsJSON operation middleware
+func (sh *strictHandler) SchemaByModelWithProjectAsJSON(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
+	var request SchemaByModelWithProjectAsJSONRequestObject
+
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.ModelIdOrKey = modelIdOrKey
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FieldUpdateWithProject(ctx.Request().Context(), request.(FieldUpdateWithProjectRequestObject))
+		return sh.ssi.SchemaByModelWithProjectAsJSON(ctx.Request().Context(), request.(SchemaByModelWithProjectAsJSONRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FieldUpdateWithProject")
+		handler = middleware(handler, "SchemaByModelWithProjectAsJSON")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(FieldUpdateWithProjectResponseObject); ok {
-		return validResponse.VisitFieldUpdateWithProjectResponse(ctx.Response())
+	} else if validResponse, ok := response.(SchemaByModelWithProjectAsJSONResponseObject); ok {
+		return validResponse.VisitSchemaByModelWithProjectAsJSONResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ItemFilterWithProject operation middleware
-func (sh *strictHandler) ItemFilterWithProject(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemFilterWithProjectParams) error {
-	var request ItemFilterWithProjectRequestObject
+// PreviewTokenList operation middleware
+func (sh *strictHandler) PreviewTokenList(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params PreviewTokenListParams) error {
+	var request PreviewTokenListRequestObject
 
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.ModelIdOrKey = modelIdOrKey
 	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemFilterWithProject(ctx.Request().Context(), request.(ItemFilterWithProjectRequestObject))
+		return sh.ssi.PreviewTokenList(ctx.Request().Context(), request.(PreviewTokenListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemFilterWithProject")
+		handler = middleware(handler, "PreviewTokenList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ItemFilterWithProjectResponseObject); ok {
-		return validResponse.VisitItemFilterWithProjectResponse(ctx.Response())
+	} else if validResponse, ok := response.(PreviewTokenListResponseObject); ok {
+		return validResponse.VisitPreviewTokenListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ItemCreateWithProject operation middleware
-func (sh *strictHandler) ItemCreateWithProject(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
-	var request ItemCreateWithProjectRequestObject
+// PreviewTokenIssue operation middleware
+func (sh *strictHandler) PreviewTokenIssue(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam) error {
+	var request PreviewTokenIssueRequestObject
 
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.ModelIdOrKey = modelIdOrKey
 
-	var body ItemCreateWithProjectJSONRequestBody
+	var body PreviewTokenIssueJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemCreateWithProject(ctx.Request().Context(), request.(ItemCreateWithProjectRequestObject))
+		return sh.ssi.PreviewTokenIssue(ctx.Request().Context(), request.(PreviewTokenIssueRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemCreateWithProject")
+		handler = middleware(handler, "PreviewTokenIssue")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ItemCreateWithProjectResponseObject); ok {
-		return validResponse.VisitItemCreateWithProjectResponse(ctx.Response())
+	} else if validResponse, ok := response.(PreviewTokenIssueResponseObject); ok {
+		return validResponse.VisitPreviewTokenIssueResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ItemsWithProjectAsCSV operation middleware
-func (sh *strictHandler) ItemsWithProjectAsCSV(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemsWithProjectAsCSVParams) error {
-	var request ItemsWithProjectAsCSVRequestObject
+// ScheduleList operation middleware
+func (sh *strictHandler) ScheduleList(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam) error {
+	var request ScheduleListRequestObject
 
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.ModelIdOrKey = modelIdOrKey
-	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemsWithProjectAsCSV(ctx.Request().Context(), request.(ItemsWithProjectAsCSVRequestObject))
+		return sh.ssi.ScheduleList(ctx.Request().Context(), request.(ScheduleListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemsWithProjectAsCSV")
+		handler = middleware(handler, "ScheduleList")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ItemsWithProjectAsCSVResponseObject); ok {
-		return validResponse.VisitItemsWithProjectAsCSVResponse(ctx.Response())
+	} else if validResponse, ok := response.(ScheduleListResponseObject); ok {
+		return validResponse.VisitScheduleListResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ItemsWithProjectAsGeoJSON operation middleware
-func (sh *strictHandler) ItemsWithProjectAsGeoJSON(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam, params ItemsWithProjectAsGeoJSONParams) error {
-	var request ItemsWithProjectAsGeoJSONRequestObject
+// ScheduleCreate operation middleware
+func (sh *strictHandler) ScheduleCreate(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam) error {
+	var request ScheduleCreateRequestObject
 
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.ModelIdOrKey = modelIdOrKey
-	request.Params = params
+
+	var body ScheduleCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ItemsWithProjectAsGeoJSON(ctx.Request().Context(), request.(ItemsWithProjectAsGeoJSONRequestObject))
+		return sh.ssi.ScheduleCreate(ctx.Request().Context(), request.(ScheduleCreateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ItemsWithProjectAsGeoJSON")
+		handler = middleware(handler, "ScheduleCreate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ItemsWithProjectAsGeoJSONResponseObject); ok {
-		return validResponse.VisitItemsWithProjectAsGeoJSONResponse(ctx.Response())
+	} else if validResponse, ok := response.(ScheduleCreateResponseObject); ok {
+		return validResponse.VisitScheduleCreateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// MetadataSchemaByModelWithProjectAsJSON operation middleware
-func (sh *strictHandler) MetadataSchemaByModelWithProjectAsJSON(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
-	var request MetadataSchemaByModelWithProjectAsJSONRequestObject
+// SchemaFilter operation middleware
+func (sh *strictHandler) SchemaFilter(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params SchemaFilterParams) error {
+	var request SchemaFilterRequestObject
 
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.ModelIdOrKey = modelIdOrKey
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.MetadataSchemaByModelWithProjectAsJSON(ctx.Request().Context(), request.(MetadataSchemaByModelWithProjectAsJSONRequestObject))
+		return sh.ssi.SchemaFilter(ctx.Request().Context(), request.(SchemaFilterRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MetadataSchemaByModelWithProjectAsJSON")
+		handler = middleware(handler, "SchemaFilter")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(MetadataSchemaByModelWithProjectAsJSONResponseObject); ok {
-		return validResponse.VisitMetadataSchemaByModelWithProjectAsJSONResponse(ctx.Response())
+	} else if validResponse, ok := response.(SchemaFilterResponseObject); ok {
+		return validResponse.VisitSchemaFilterResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SchemaByModelWithProjectAsJSON operation middleware
-func (sh *strictHandler) SchemaByModelWithProjectAsJSON(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, modelIdOrKey ModelIdOrKeyParam) error {
-	var request SchemaByModelWithProjectAsJSONRequestObject
+// SchemaSpecApply operation middleware
+func (sh *strictHandler) SchemaSpecApply(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params SchemaSpecApplyParams) error {
+	var request SchemaSpecApplyRequestObject
 
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.ModelIdOrKey = modelIdOrKey
+	request.Params = params
+
+	var body SchemaSpecApplyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SchemaByModelWithProjectAsJSON(ctx.Request().Context(), request.(SchemaByModelWithProjectAsJSONRequestObject))
+		return sh.ssi.SchemaSpecApply(ctx.Request().Context(), request.(SchemaSpecApplyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SchemaByModelWithProjectAsJSON")
+		handler = middleware(handler, "SchemaSpecApply")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SchemaByModelWithProjectAsJSONResponseObject); ok {
-		return validResponse.VisitSchemaByModelWithProjectAsJSONResponse(ctx.Response())
+	} else if validResponse, ok := response.(SchemaSpecApplyResponseObject); ok {
+		return validResponse.VisitSchemaSpecApplyResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// SchemaFilter operation middleware
-func (sh *strictHandler) SchemaFilter(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params SchemaFilterParams) error {
-	var request SchemaFilterRequestObject
+// SchemaSpecPlan operation middleware
+func (sh *strictHandler) SchemaSpecPlan(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam) error {
+	var request SchemaSpecPlanRequestObject
 
 	request.ProjectIdOrAlias = projectIdOrAlias
-	request.Params = params
+
+	var body SchemaSpecPlanJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SchemaFilter(ctx.Request().Context(), request.(SchemaFilterRequestObject))
+		return sh.ssi.SchemaSpecPlan(ctx.Request().Context(), request.(SchemaSpecPlanRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SchemaFilter")
+		handler = middleware(handler, "SchemaSpecPlan")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(SchemaFilterResponseObject); ok {
-		return validResponse.VisitSchemaFilterResponse(ctx.Response())
+	} else if validResponse, ok := response.(SchemaSpecPlanResponseObject); ok {
+		return validResponse.VisitSchemaSpecPlanResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -6690,6 +9388,32 @@ func (sh *strictHandler) SchemaByIDWithProjectAsJSON(ctx echo.Context, projectId
 	return nil
 }
 
+// TrashList operation middleware
+func (sh *strictHandler) TrashList(ctx echo.Context, projectIdOrAlias ProjectIdOrAliasParam, params TrashListParams) error {
+	var request TrashListRequestObject
+
+	request.ProjectIdOrAlias = projectIdOrAlias
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TrashList(ctx.Request().Context(), request.(TrashListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TrashList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TrashListResponseObject); ok {
+		return validResponse.VisitTrashListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // AssetFilter operation middleware
 func (sh *strictHandler) AssetFilter(ctx echo.Context, projectId ProjectIdParam, params AssetFilterParams) error {
 	var request AssetFilterRequestObject
@@ -6900,6 +9624,143 @@ func (sh *strictHandler) SchemaByIDAsJSON(ctx echo.Context, schemaId SchemaIdPar
 	return nil
 }
 
+// ItemTemplateDelete operation middleware
+func (sh *strictHandler) ItemTemplateDelete(ctx echo.Context, templateId TemplateIdParam) error {
+	var request ItemTemplateDeleteRequestObject
+
+	request.TemplateId = templateId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemTemplateDelete(ctx.Request().Context(), request.(ItemTemplateDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemTemplateDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemTemplateDeleteResponseObject); ok {
+		return validResponse.VisitItemTemplateDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemTemplateGet operation middleware
+func (sh *strictHandler) ItemTemplateGet(ctx echo.Context, templateId TemplateIdParam) error {
+	var request ItemTemplateGetRequestObject
+
+	request.TemplateId = templateId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemTemplateGet(ctx.Request().Context(), request.(ItemTemplateGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemTemplateGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemTemplateGetResponseObject); ok {
+		return validResponse.VisitItemTemplateGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemTemplateUpdate operation middleware
+func (sh *strictHandler) ItemTemplateUpdate(ctx echo.Context, templateId TemplateIdParam) error {
+	var request ItemTemplateUpdateRequestObject
+
+	request.TemplateId = templateId
+
+	var body ItemTemplateUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemTemplateUpdate(ctx.Request().Context(), request.(ItemTemplateUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemTemplateUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemTemplateUpdateResponseObject); ok {
+		return validResponse.VisitItemTemplateUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ItemCreateFromTemplate operation middleware
+func (sh *strictHandler) ItemCreateFromTemplate(ctx echo.Context, templateId TemplateIdParam) error {
+	var request ItemCreateFromTemplateRequestObject
+
+	request.TemplateId = templateId
+
+	var body ItemCreateFromTemplateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ItemCreateFromTemplate(ctx.Request().Context(), request.(ItemCreateFromTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ItemCreateFromTemplate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ItemCreateFromTemplateResponseObject); ok {
+		return validResponse.VisitItemCreateFromTemplateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TrashRestore operation middleware
+func (sh *strictHandler) TrashRestore(ctx echo.Context, trashId TrashIdParam) error {
+	var request TrashRestoreRequestObject
+
+	request.TrashId = trashId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TrashRestore(ctx.Request().Context(), request.(TrashRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TrashRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TrashRestoreResponseObject); ok {
+		return validResponse.VisitTrashRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ProjectFilter operation middleware
 func (sh *strictHandler) ProjectFilter(ctx echo.Context, workspaceId WorkspaceIdParam, params ProjectFilterParams) error {
 	var request ProjectFilterRequestObject
@@ -6958,6 +9819,37 @@ func (sh *strictHandler) ProjectCreate(ctx echo.Context, workspaceId WorkspaceId
 	return nil
 }
 
+// ProjectImport operation middleware
+func (sh *strictHandler) ProjectImport(ctx echo.Context, workspaceId WorkspaceIdParam) error {
+	var request ProjectImportRequestObject
+
+	request.WorkspaceId = workspaceId
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ProjectImport(ctx.Request().Context(), request.(ProjectImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ProjectImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ProjectImportResponseObject); ok {
+		return validResponse.VisitProjectImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ProjectDelete operation middleware
 func (sh *strictHandler) ProjectDelete(ctx echo.Context, workspaceId WorkspaceIdParam, projectId ProjectIdParam) error {
 	var request ProjectDeleteRequestObject
@@ -7042,98 +9934,196 @@ func (sh *strictHandler) ProjectUpdate(ctx echo.Context, workspaceId WorkspaceId
 	return nil
 }
 
+// ProjectExport operation middleware
+func (sh *strictHandler) ProjectExport(ctx echo.Context, workspaceId WorkspaceIdParam, projectId ProjectIdParam) error {
+	var request ProjectExportRequestObject
+
+	request.WorkspaceId = workspaceId
+	request.ProjectId = projectId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ProjectExport(ctx.Request().Context(), request.(ProjectExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ProjectExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ProjectExportResponseObject); ok {
+		return validResponse.VisitProjectExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9W2/bOLN/RdD5gPPixt3L95K3NEkL7zZNkKRbHCyKgrHGNr/IopekkuYL/N8PeJMo",
-	"iZIoW74k8Uvr2BQ15NyHM8PncEzmC5JAwll4/BwuEEVz4EDlX4gx4KPoSnwp/o6AjSlecEyS8DgcnQVk",
-	"EvAZBAxiGHOIAvlAOAix+H2B+CwchAmaQ3hs5goHIYV/UkwhCo85TWEQsvEM5kjMz58WYijjFCfTcBD+",
-	"fDcl7/SXODo6kVOchcvlQE1XA9jNAsZ4goEFjzPgM6AKriBCHAWIQgDzO4giiAKcSPgpsDTmzAD+Twr0",
-	"qQR5aMP5LwqT8Dj8n2G+eUP1KxvK0efyBWIRAtYxmc8h6bSR+hH3VmbzrbOZp3oStZ0TDHE0ii7pn/DU",
-	"ACUN7uHJACufMVs4JxHELNCvd4Jtv2NlyNWoo49yrjM1l1jAlJJ00XEB8hmzgAUl/4FxzY7bs68Mupzk",
-	"yAF0K1l0BnQdwvgkp1BkgTnMu5CtGO8GTM20DlwjMYMC6x6eHgmtg0v/GmQTuZhaDwrrARAvklTdkark",
-	"M17IsmdfeWfkJAWq0tO2Yq0zoOtg70JOodC3QFOoge4rgyjgRFOUggxNoQaJ+qcciAgmKI15ePzLIJzj",
-	"BM/Tufxs4Eg4TIEqIIBe9QaHmssNyr/fD8I5+qlhef++HTKFCkEYJzFGrJHwkBhhMNqIxPK0K2NTTyRp",
-	"Ts1UgNpfWviB2whnicqu9EOKzihM/NCLAgoTsZsPQGtQLDS+E71hjDgwsQhIBE7/zr9YpHcxHoffBw7J",
-	"omby2S05sKBm3RtmZlyHS2/UHGr7GKH8DNOWLYxgghOQwBEaAQ0iTGEsBpkVUGALkjAIYsz4IHjEcRzc",
-	"QYCnCaFCZ0yshzELEsKDBQUGCYeoBhsRpjXYEEBauEDyL/mlGw2E8q4LdC2rBk4xfQ2gYwqIQ3RiU479",
-	"XbqI9Gcn4I+E3rMFGkMXhsseclOQNac306HxmKQJj8gc4eToWzaDICHJgmqTpDvxhfCPJE2ic0oJrQJ8",
-	"Kzf1nxSYgJUCIykdQ/CIFE1MxKPhchB+TVDKZ4Ti/0LdVCfjMTAWcHIPiaCpOWYMJ1PB4jh5QDGOLCaU",
-	"sH0ExFMK0geiZAGUYwX0FMgcOH1qs/s/mXHCbIo62DOD0gv1CHKnZaP9mCRAiOZocXSpPl6ghZhC/f6c",
-	"UZJZjpN2im9YDszoUxLHinWr2zBRQ+RnYcuxtv0wEOTvQ5SipwZgrdf7gf0JyB83l19eDLAZHRWhHRNC",
-	"I5wIrSH+JAlcTsLjv5shviI4EfM2j7pIY479hn7GCdxo+H1m7TD+isRPU5L4QqsHfxcOkto03AGVNh+2",
-	"4VLtzCC0tmkQWgvTvxS+MfBlT5k/zYs7U4Y1ve8iDUqFKTlSD/xaXW4ZeN/ZC6h1z6oA6AxuzVxqC/1n",
-	"M+RUma8K1oTQOZKGAUnvYqH49DNJOr8TBrc0zvUe/tayoS5I19uA/HW/V39UgaeKvEB0PMMPcP6TUyTp",
-	"7IYjnjKbsBeQRMb3/bGgZEqBCYM/IonYggnCMUQO8hyEY5JwSPit5pTq75mJUthcxOEdx3Nrf/NHJjiG",
-	"tg2SY3w1ZxYHNJaLA84FhQcMj7cljsdz7cWJ/3+wBzH7FIj698dv0Y9bHAPTf84fhDyQJveP34RJNGYP",
-	"wjJL7hPymDi3L/da2pdhOSuZr5A/dUdIDEhROeEovsH/tVeak29uKHpjJKWxO96R23x/C1QMCl6YeGpQ",
-	"Y6M6nJ1cwJVCohY6UCymFPalpMqYQQ1RqmholRWkIdi+2yhRDCWHl2kiZdrr4zClHLkFd8YZfXGFF6UX",
-	"g7SVjR2TJMJuew0lkbd0yqdxSKg7xBRdlkwsFcxt52uIoxvpfxBJrWIOxJXNbhAA/6QoFkyXEH6uPrsQ",
-	"8IDiVCDOuRWCXfYKygofl9jLgGa9zDzs4qG50JSLGDa7RpyM4zQCdpI8qYWOCl9kP0u2tX+O4+bNMHRY",
-	"IbD1diVJ4xjdbXpXYL7gej/O5Uc/u06L6I2CNpWCh97OkDBBY2BMf7R+uKSSXG+JNSL/zoeGjbJZD1kK",
-	"8vUlEsus2c3tqxD2CCea3U/zvxhHlLNvWMZNIInMx4TwG/snQSvmV58trlHCHbdYKpuNbswdTAgVCg1N",
-	"uFSb6otLepmYL/VnMrmdYfYN4D7744IkcnPUX/8HiDbvjY8mXWfDXFwrJ3CEgShJF55RnewEzVPL68PM",
-	"UJ1sOQ0M47k24U+uUho3bcqyiOkmevGHvOxbS/NR2kWYJGeIg/XnV2VxzUmEJ3hsj7C/0qOY8m4MZgbh",
-	"HDiSL/aUw8b/KEVdZjiOKPi7ncZFKYujNo+p3kVBfOb8gblNfdfaMrIsLq4QDX12uWQQR/7uq/pf7blj",
-	"B7yI3GKKOiJvcOZW9Kr8Ujb0KOtUputRScVvsg5jbBdKrnBQQI/ajgzUDDdOI1Ce/lRJubv30UYgXijN",
-	"znTrURojxi8kT0PkD53g8AhxdNMRfcXnOqJxE/TX5NVvhTZXCA+4xIw5qa26mfL8tyeXuBeiLOx/PUYl",
-	"YpB5VRMG9NqvrCc0xwPj1yTuEJvWU13nz7rE6QoBHfvkri0U0nBg5xBkTolVPChEOpug7fSyQk5XRSSU",
-	"KEsm+DXyDylF879++Dw6DQfh59HF6Pb8LByEV9ejv05uz51WpjwcdFvgdQDbiLNefH1+cnZ+HQ7Cb9ej",
-	"W/nh4mT05fZk9EX+cflN/O8CIRcBawv0HWh0W8qsLCE55jF8NDa3r7npwpG9pMqO9mWH22GYKknm3OMM",
-	"43az4evX6D70/JdrjctB+K/aBLd2iVtiyUg55Si+Kr65ldAExNYzS6frymNo9H2aHT/5awHi740bWFxC",
-	"R/NZs6RLR/lzn0SjOn/6DMm04Axk+WBW7pjfYZbJLfMa3cumu/Y5p2NLTnL4KXSD+O+EgrB1KR7PbtW3",
-	"c0TvI/Io1Mt4BuP7O/IzHGS515FyD2WUdxCqhBYTs5deol6TTPoCConMcVEnFspHGoQc6dMeeUx7afIr",
-	"zBfnERbesDMgAZRhkkAkvPtdiOvJWoI6zzTB7EKbyG4RZQzojz2BZxJH3e4vNVUH1dPaNJXWRw1Z5i/I",
-	"sB2NOh3IFjHqnrg4WysoK5htGgqPlS9dsSsG45Ri/iQVsSLFO0AU6EmqhIlcrUSx/Dqfdsb5QiVo4WRC",
-	"qvlT13COKJ+9O724CUbyXExaacHJ1SjMpEbLqGxx4S9H74/e64BighY4PA5/O3p/9FuoYiAScFU3oaVv",
-	"DFwKDhXCwyQRJBTKY98PiI9nZ2pEZoV/INGTyqTJjujQYmFsy+F/mNrjOnNLlaacNeG76Qy6cqBRxtSy",
-	"nExXToz79f37NcDH0SYhLxKGwpJKeVwOwt8V4KXEQ5VhZ3L5gqymKFBBSfncL3Ucmm3MsJrnJ5/8vfrG",
-	"L3l6oMUWMofKZoi/vy+/D0KWzueIPslcUUFGgV4TToI7QVxKUzChsE4UTX4Xs2oCHT7rQqZlK6laVNoj",
-	"rjvWSb0OjDpR5kKUUOe8Bh+f5CNrIaO18Ovl7/AUeNP22gWCNSmK+ZBhoYBQZvRV2Giokzt0sm0d8nQm",
-	"xGeVa90jR9mv9zybVMkoq8tPU933WuRoRjLZwiq0k/+yNhENwgVhLWRyKi3y3gyE+tSfbWh7L2KsktrL",
-	"pyvlV3UhrUYBM3zOCmfblbcmpJ3p8MbMryqyjV5Mirv1umy0bYiXQev4UjW3FEjSbmwkpK+L6M1LJLUH",
-	"wcmrI1KzMAvf3cWUPJJis3IXhn7145V+ycEOXhPlGlv1prITx2myFSx/zV5zwPM28ZymOPplqf7/dTl8",
-	"nuAYEjSHZZtvI9HR2T8lYw78HeMUVP1njrcsmniHEyQLUstaooI1PoNAjQ7060z5qEb0y/FczQK8PNiS",
-	"ivqal802NLORiO5QaL0UdsXKb/p1vTd91FTo8TZDsJ1eKN16ecjChs+6AUmjgS1TwXZmWdv9TVrtajk4",
-	"YKmsJ56kcfwUqFVFR3vEEQrKQpH0v92QcaAJigMG9AFoAGrWLvLwTNvjqifNkcViEohCDK58psBTmrAg",
-	"Ao5wLHtWWLM4KGTD8Tp1LFiLcw3mG0Xztay6fRCIZqqb1rgR491smULfo4ID5XIYmJRaQiwNgnt4GgSE",
-	"Bta4dkLq2fdqyw7omN66a4etlg9uZxDoQ02zvW+SF7R3p2nsf1kuGhysIHShDCMPn1XLq0ZNOOIw35ki",
-	"tBpqdYgvYX1g/jrCSolpWWYQqdIIWpWYfrAqcGT9h1Rc3URi1qvIIwJl9T4Uq9qYZCglSVSp4mSfyWGj",
-	"yrFMBFXy6YZ+u9Feq0Jspr6etV3WhKBL08v+Epz6zUjata49cFSziq1nqKpebT84Fs++knNj8YZXeWys",
-	"MG71uy0gfp1znYpIdcZLLRo5HBq/pkNjf8JqEC2+R8YWFb28E+PCPr0Wy34rUqXPw2KLhA5nxfZZ8esi",
-	"T70uge3g1E82qdbqw2edTN8oiGRJ7s5EkN3k2VsA6Z62O8DsKgGDrAOvQZlcs0/EQD1Z9dnkBBuOdest",
-	"dvgbwR83l18CaYkGZBKkDKgMtbI369TneHKguJuyKHRi93DrG0nkEMVeh8IVVILEX4S4qVJEhRhdqmE4",
-	"Joun7rklVTp1+kqnZPF0ocVfP0TYA5HtB1Fl8aadiczmJ4uttzcoRgWNmJ71KFE3guiz3qwNea0KdZI0",
-	"ni8I5X0QdcprDKaRekV/IdMPaHw/pVJlOesszWVInaq18qrjrPsbkD+Y7MggQXKVrprqVhk//bO2qp0j",
-	"DjflDA+72QKniMP0qdgskAHNmz3ID/Kb721NuvK7oPSarBc4u3SpsntE+VA88M4UsNYhwHR8ak2DesOb",
-	"uumiRHW7wylJC56nVdeuVto8RAiPht9ry4ubWhUl8Pix12YZ+oy8Fk4fZ6iiUJTUC3Tzs50olk2pCCVt",
-	"1W1saRIBLV5S0VE7GAS2OF8xZmpfpT56xHwWTHDMQZCLVFTyagWcTN2Hah/l2M6nuvn1Ih6xosJ9Kx7j",
-	"82uUfAbb1x15jF/1PLp9dOHyLnV+3YfSVdjs0Fh0E8ZkSQb225JAXkB1/Nx4fVR2r1X7QNlVPBNa2dj3",
-	"g3UE2CGQUCdz+sgS8PTTZCi538Osw3H+asf5e8UVqx+j1RzIu7Xx0Zg9eGjk05u/Aj5DPJihqoJGLDCX",
-	"+LgVMjthpzd/dVbIW9KZ7VlZHH7yod6oteo2TvQ9lwFO5JbqKd6s0O1CVsVjl/w6QkFZa0vnBgaZAjGC",
-	"poVJ9BVX6zGKuSfrxTLL6hLaLN3JOGZz87Zlb5NluhJZURXk97BthmWMyfBDX/3syTnmsSwYyQLxZHD3",
-	"pC++HZ1Vz1kK/YM/qGD3CdPMszEitdsBtoQG3vCpnBc+s6BBsYP0INwggXajyw7keCDD/SNDL+rbANVp",
-	"44QNn8v3OC917WWHIJh6ILiDmCRTYTtyomJwqtQrv5q5pqAqi4b1GCzJF+HlEeqqpRcXJSmhoHj7+j4V",
-	"WOkuzVsrN3STKE4CZBPj2qWH7svVdx1tXSNOXBsFklvUcxio/6QR+wRLV56LSVY7sPplO0WRut/vTosi",
-	"N3Z+r+M+QQKPaoHtPOinn7LeAJf0T3gqZTEW16ESGJkpuZTHNRIKXwWlJviG+ewqc+oPfQZebp+BnAKa",
-	"dYF/44FiVbuZv4sR9Al4jwR26FSwfqeCTqSyJbPBlnm99zuoCMZxC82qF5TJ9pBOemiK0FdTBD/2a7MY",
-	"VIylg0erHqjJnN6Eu5pD6OWuZknHh0P9V3Kon1Pc2mUCu/BJa91GuYq9dxsPtQb9H/U3Z961i+ssJO7r",
-	"4LUUvOyDE9epok3YBioajKO3JiCrGO29QG5L3tahVm4ntXIrK0Fb6PRZaXfwkl6DItyHrjYtRXydNesw",
-	"z8bcLY85DUiZ2qkMyE2w0F7ezLldtivUo+xzceJKVqi55t/wik4VXo9Xhs/yfx/b1Cg3PgMFS4CjqqKQ",
-	"UO2DhdpwJ66LNMyK3qx9KjfgyEVfu7RY2h+y6be1mYxc0+bMmIMMfp0yODUGS88yeKtFekWCP9TrbbZ/",
-	"7KHi7RAO8K14yyosdh8d0J5LcStOs5qnUW133c05NocSuzdWYlcltzpuWUPrbqcYz+KHQ13eoS5vv+ry",
-	"etUd67DiVsv+Cix5qAA8VABurALQYtDVKwH3gEn7LzTUb5aua7eiwwL3Hgq/9rv+sAbNfdci7gGLrFvq",
-	"6MUQB0Z4YRWQLfT/ouherU61F/Qi76Ma+j0knfYUV9P0ph5gb53vjiqMxdE2eerlRshLfed8BcHwWX3q",
-	"o9LfFpT61wb9Nzo7KL+XpfxsnO5c+xmybSH4pb69vMPxnL7gvdP5nOxj/Oa6aDp6Xe7orC27xP1F2QKa",
-	"0l7HfWd1jHRUuae+j4My38MuWeitrs0XHEwlkDL5yZS5yx9rOHozd6adJ2MiL5J0JZmwe7w4A7F6Cozp",
-	"TNoIJiiNeXg8QTGDQZikcYzuYjC31zvyTcg9uJNwUxr75dp27kvuszw95lanw1Qblvv3NvfaqfLObPuO",
-	"BS2Y6nj/5bN9fsyXcVojx7fo6WG6iAnS2b9Ozh4xlsr3fb3+LHkaBZLYA04C9bD4sYmrv8pRGW+vLYG2",
-	"Jxz0mM+QTPnM3TS+jcHGKWWE7vpakp0sPYGf3PlDD8LSzd+aIF/DvW4VxmrkcJd7uWpaf9nS98jMP6SC",
-	"HlJBe0jHr6fixoT72lT6/c+ff4m4jAq5732kvpckzuay1w9y6iCnekhZ30Qw1yeAe4ja7mnUdhORWlfA",
-	"9fmR0Hu2QGMQJGfcug6x1uyRMpHpc4FNHDP2HBK0V+0VqzSWsiNaudmjxgzSw0WODaRo+zZXZse6s4vF",
-	"GTtteKOX0LNfhGKMmNPiaOsBUBNkyAC7JjF05qXr/Nm+cvz7a7aa8buHUWIHpi058Zb0Wha5z/MuHexY",
-	"r33soGKTX6Yn25lnpt/fpfuOOYx9RMz0Ty00VX2zVc+NpNLamKe2qaSeZMO313eVD29ULjjxtStF7TiG",
-	"bOnI00ZkPQcENqCgF+mdeb0nSV9ZT+yfht8dB5sOq/vHyeZahO02EGpm6OVy+f8BAAD//9YZlvzI5QAA",
+	"H4sIAAAAAAAC/+x923LjtrLor6B4dtV50djJytov8+aMZ2Z7rbm4bE9Sp7KnUjDZkrBMEQoA2lZc/vdT",
+	"QAMkKII3ifJlpBdbEkGg0egbGo3uhyjmiyXPIFMyevsQLamgC1AgzDcqJaiz5Fz/qL8nIGPBlorxLHob",
+	"nZ0SPiVqDkRCCrGChJgXoknE9PMlVfNoEmV0AdFb11c0iQT8lTMBSfRWiRwmkYznsKC6f7Va6qZSCZbN",
+	"okl0/2bG39gfWXJ0Yro4jR4fJ9hdA2CXS4jZlIEkd3NQcxAIF0moooQKILC4hiSBhLDMwC9A5qmSDvC/",
+	"chCrNcgjH87/EjCN3kb/57hE3jE+lcem9XszgJ6EgbUJ0G8SEqI4EUATAwhTsJCESv1lRe5AAFnm1ymL",
+	"CVWmgWILaAKzCuOUiwVV0dsooQre2PeqCNawxXyxgGzQIttXwstc9LfNQr+zneBSTxmkyVnyVfwbVi1Q",
+	"CnIDKwesecct74InkEpihw+C7Y+xMeTY6uiD6esU+9ITmAmeLwdOwLzjJrAU/D8QN2Dc731j0E0nRwGg",
+	"O8liMKDbEMZH0wWSheaVIWSr24cBw562getM94Bg3cDqjosmuOxTUnQU4mTbKGoGQA+U8pim0DDOJ/MQ",
+	"ZYvk6S0YXNzSNAepMWNeZn9Dgpwij8hvxTPdMoEpzVOF7cD9apfXyFABKheZxuuUMEWYJHzBlILkqGFW",
+	"2FXHpAyrDmQV804vCvR733i5TScVVrHddpLiYEC3IcnPpgukySWdQYcKQjZByOisScfYRyUQlk6itz9P",
+	"ogXL2CJfmM8OjkzBDAQCAeJ8NDiwrzAo//3TJFrQewvLTz91QybglsHdFb+BrMcy2uZE6fbh9av2uM0y",
+	"nvs92dVEytF0fJIyKlv5hOoWa/zbBHO1242hth0ZFsGeKlD3l9j9wG2Fs4ZNfAkRKWDajxopETDV2LwF",
+	"0UCR2iIMUmOUUgVSTwIyTYJ/lD+gaRd9D9ll2FMfbJmGFVMnjDDX4zbUeIl9IPokF+qUiQ4UJjBlGaof",
+	"LhIQJGECYt3IzUCAXPJMAkmZVBNyx9KUXANhs4wL1C/ly0ySjCvNghIyBUnDaiRMNKyGBtJbC2q+mR/D",
+	"y8CFGjrB0LQa4NTdNwAaC6AKkhOfcvzf8mViPwcBV7BYakLrQUHa/CGufZh2yt62tZKuXE9IQ0pQOe8B",
+	"o2lHIFMGeyEIsaNtwLsyXSBcd1zcyCWNYYi8Kl4Kg+j12Vtm0TjmeaYSvqAsO/q96EFDaSQY0pjZrX/h",
+	"6gPPs+S9EFzUAb4yNPlXDlLDqs3CXMRA7iiy1FS/Gj1Oom8ZzdWcC20dNnR1EscgJSpAzZILJiXLZlpC",
+	"suyWpizxZJiB7QNQlQswLgbBlyAUQ6BnwBegV7VjW/3RtdOWfzLAJJ+sDWhb8GurWvzXDP9CsqDLo6/4",
+	"8TNd6i7w+UPBiG46YdarjPA4ca3f8TRFyVdHwxSbmM/GCdCFDwdBOR4Vgq5agPWG7wf2R+D/uvz65dUA",
+	"W9BRFdqYc5GwTCtd/ZVn8HUavf2jHeJzzjLdb3urz3mqWL+mn1gGlxb+Pr0OaH/O09WMZ32htY2/6z0+",
+	"Io0NWEqfD7vWEjEziTw0TSJvYvZJ5RcHX/GW++oGHkwZXvd9J+mWVG8czvCFf9Snuw58394rSxvuFQEY",
+	"DG5DX4jC/r05cqr1Vwer9DLy/Dr1XIxZvrjW2yuzFbM4/KUDoSFIt0NAOdw/6w/Rr1uTF1TEc3YL7++V",
+	"oIbOLhVVufQJewlZ4tw3fy4FnwmQer+U8EyjYEpZCkmAPCdRzDMFmbqynFJ/Xlh4PV24k2jKUuhCkGnT",
+	"V3MWbvZJtABFE6r6Ob0/u8aPzuQJTNDtjNdEBVvYzb7+/6e81WDNgOPfP39J/rxiKUj7dXGrBYnZ6vz5",
+	"i7alYnmrLeLsJuN3WRDv5W6xz5a72CQWe7TyrWvOU6DIHlzR9JL97c+0pPvSQO+9lLlIw26x0lj8Q6/h",
+	"pLL71W9NGvYGgU1mKRnXjiq85aCp7lIbpoacUwlBrJoOPqZqqllEBlgpYwuqWci3vArPixYNCgSjadNj",
+	"kHNoeJbxpOmRjCFreqbgvjBdAp4gH8+2GzdUAY4PttfhxJ9tI7Y/8ZiGLcCUqp4CNcW16my5Np/UHBDp",
+	"lxuh++yxe9Xid08IoFiEhEwFX9izltJDjAdtd3O9LzBO4XyZcppAckT0/sPJk/KYJkWfM82IlbtmPwFi",
+	"BgmhU6W3/KafBLTY0XJW9/U1S1flOY8kUy5wm7hagt+38VRLUEfR+jbg+prf16e5YNmnbDYh+j9VE7Kg",
+	"95+yGaFZYj4aDA7RfesqJ6ZLTS3D5LuziHPUvXWinqVq2ktCl6z6OInmQBN7zltMqTb4+gTmwGbzBjBS",
+	"j7Y7QSkYQVMpv2uZ3R1L1LyBX2tk7M4m66LI7Gm75T/N0DYwzde1VC6t/0/BTCgatkELJT+Wgu+ltKtH",
+	"pgG8ZAkLCx6aJb0NrbKbAG1cU4macm23iEer3SYKpMmlcaVws+66D6rQ/eAWAP7KUexmXL3Hz6EFMOdc",
+	"0duHICq0An9RUNYsizXB7UDzBnMvhyT5Qhv9yxR2O0eWxWmegDzJVjjRs8oPxWNjSPiP07QdGY4OawS2",
+	"HVayPE3p9a6xAoulsvh4bz7226JahbFT0GZG8IirOdW76RSktB+9B1+FIdcr7rUof+tDww22x8DFQsi3",
+	"l0iy2JjvDq9a2FOWWXZ/V36Tigolf2fGBQxZ4j5mXF36jzStuKd9UNywLRiIYqNsdoqYa5hyoRWaMeOi",
+	"if3hq/iauR/tZz69mjP5O8BN8eUzzwxy8Nv/AyracdMz2mljhIW41nQQ8GgLni97OqiLeJaeWt6GFkUY",
+	"ZxI0MGyYRVNEiDWOzTyN4b0eCoKGevE8EAzCpDapg3EfDdCgnZEgo9L03MfX42QN0DIQpQZbYefbbgk3",
+	"0X1qTrMAoB5AHt1bk66NvM3kje3XZUtUGaGNnfov7LoX1ez3jdnIeHaKR3Tu6zc0SBc8YVMW+y38n2wr",
+	"iX4sR7jo3TED91RTztO05l+fszQR0N/B6JxRtc1Rh2+s2adEKxuE8oEM+2ZCcyu4tjq5Cmk+hJxvev/Z",
+	"e+r4H3EewEAvGeDJjCYZ0OJ929AN1i/21bbywheGxhTUHF1e1ILv8zIzrEoOREcBarE2Ie2nF+tXquL5",
+	"hYn8DXAuunCDG1IXLdx30dcGe581HJ/IPI4BkvCwj92zwI5rUwF3hrvZxrI8R2VZAvdhlJjYyi6pCkIy",
+	"nkGie2ye0CmbTgNCJhcCssJBdTYM8oFcqhsZHn03p9ksKKucIwtbJGH/sGv0YfThjRed53IjfDRh3h+z",
+	"7iVIEkhQNbfu0iZuqX5zenPy3BaRQ5YHkYAFv+05H6ePq1bK1wzNpySZEHS3Gy9hAimosO0ZxPkFSMUF",
+	"NImh2KzGqKSzAasW+AoQ8lC7popXyz4j9tuEaK1lkjxov+zII+dGdMJTwUwYc6wfs3rtT6Mi3H2Q2HNR",
+	"xAOChbc9KZPzQedd2SYvbXCuJqGP67cSa6WN5iajpLgwUMZp+8ZJV5helTJdRNwolDmyvTrdylJdj/cb",
+	"gQs2VKmN89iIRcY3r18ATYdJ2drZ/SkaA5CfgpTZwFVrdJhQqT6b3TLacf2gc4R4OXBjVH1v4AZpF6TX",
+	"FuDwJLu+Dag/pOT9Kxej0B/cL5kAObpdsH6jYwSpuPHi23jgXi9e2Lb2xVt+87wCq0njluv2PUgmeKek",
+	"vsExN1VGOrIdRXZVVqqZ8Q3/9jp4t3M/994oaeCCpwM2Grari/LdkHbdQK35QfKDSKUaGx+gl6DLqBqT",
+	"T+29p/7qLoDSOmWZq+otYjam8Rw+0/uTGTRFNPG1yNpvv346exdNok9nn8+u3p9Gk+j84uy3k6v3wWMS",
+	"5YRiLzlaW1lv4Iv3J6fvL6JJ9PvF2ZX58Pnk7MvVydkX8+Xr7/p/CIRSlWwtmJ/B5+prq42FrWIqhQ/u",
+	"0KjvBje0RjilRleNUoJd5woGxvYkIJXIY8VuIUyn0zDszR4e3x5sGP5h7XJTwXHmiswyxds01q3S75jC",
+	"X/C6N/TeBJFtJZj7nsKxvxs9g16MRv1pKbqCUafDTrCaMRS+3PFfLLzE/9V4bbtb3dVciaGDwB5MrCH2",
+	"3nkMnmurFFrprf1U2DytQPy9FYHVKQw8PLLiLkSH/SWbWUaMs/8E2SwcK+fdiO4XtO9uTPcLcxwD6c14",
+	"Pk9ptr1vtCI0u8Xf2uU5kQOGtUq+KA7GLQgkphlJucRLoPYYU/rXK2WJNT/Kq2G+l0uIG+IKhk5Xd2Vc",
+	"6o3Oj016NLvqfr7X8qUGgdzFJFWBXb/SWD4nd3MWz4mGXCs/L86hCFXGeAEbNCxgCkLgJd5rE07MTF4J",
+	"GTI63D1FLVQH6tVCOdaBryV84VP7wRo5AUg2VTn0vv+FnVZBwrK+HbVqucYdDV8WVwbqGLMPNaLs5fwC",
+	"U/1XxKw8ZDH0J/mL4pVuBU1nmzDVFZ21XarrHbCSZ+yvvAHn5m6u2aZc5Ck0oHhJlQKRmfMss8MiIk/L",
+	"1DAuemTg9H6rDB11hXfiWX6HYiil29MEbpRiLLBQg4Mxei2GhsWk2zFLQGPBpfRuPjzJOrQvwOewx/cV",
+	"LEB1Q9YqnU3TgvR/1KW88OXi+i1qgckGEpbN+uEMQ/aKbBi268SmQUJlbX6W5JrGN1oNqzmTzVgu9pKt",
+	"w66P1BkIiq3aMaOFcwAnaUNkTQPJrQ1sWrWPu7bEARAa781MByySJc1E20EVMg0ffUhJZ026ux4InJp7",
+	"Z2ZHP1Pmj/4If0WTKAvfKzRm2odN4L9jat5rElbJ9fJMuLbG9sGbSsZKch/tYGH3RK99jsle0hC/5RJn",
+	"Drq4iw6T8YMaihwpryiiYfwzs1qILqYWdIlC1wXK05yBWM9F4eZ2BBCit9Jg9Gah4F7ZG60nAmg0iQSL",
+	"51f464KKm4TfaSaI5xDfXPN7b8bWYWeuVk0iNMzdRTkTe2w3Bb7p7S4u4/bImM1Ruc/66vKzuB/eJ0zL",
+	"leAtgEq40jO4mLcPk7AcIf0buKMFFA6PfjACUrikwPUbr3nOghq6caOVnA1K6FALQAt0PHAXvsFZlIWi",
+	"x8wfQxdGJMS5YGplDg/srWOgAsRJjntrM1uzxObnstu5UktM8MSyKa/rwAt4T4Wav3n3+ZJ4YpWcnJ9F",
+	"hTeuo1Uxuejno5+OfrLKO6NLFr2Nfjn66eiXCCPrDeB4adcatsYX//bBKnsr/yOjfUw48qkLgrRHi7/y",
+	"ZIXGSnEvli6X7sDs+D8Scdx0RIQK8HRQfKCnCrt8VFXBqkQO64m1/vHTT1uAz5JdQl4lDFwlzDj3OIn+",
+	"iYCvJS7DDF0uFxgpUn6jswzf+7mJQwvEHNfzhJk3/1kf8UuZXsxjC5ODyWeIP74/fp9EMl8sqLaDLKER",
+	"OyeWkWtNXJFzsPyBFCej77pXS6DHD9ZceuwkVY9KR1zrgWnMf4wVDS5ZaKG0OlcN6/HRvLLVYnRmHXj9",
+	"GJ6BakOvn7+/IcVZ2eS4kt/fZASrsdGxzahgk/U1LZ5NP/AJU12OyFH+8D0vBGMGiM3lp0tw/6PI0YJk",
+	"ionVaKd8sjURTaIllx1k8s4d9Y9jIDTn23gKbd+LGOuk9vrpCvdVQ0irVcAcPxS1I7qVtyWkZ9PhrelW",
+	"6ovt9GJWxdaPZaM9hXiZdLZfK2hiBJKxG1sJ6VsZc7S3EglxQE5+OCJ1E/PWe7iYsjeV1oskjasfz+0g",
+	"Bzt4yyW3q9VsKgfXWAmaySkXi3Zj98o1+3bxKarJsyr8v7NEzb0s5vgiJKTIeBlIC38XqpLiXQVfH+R/",
+	"TFa2oaPMh47C7zCgSfdJzEGdUhg+o382edpM0IBNEhcedcqqGe/X8uiY4xQsszBlwVxRdcA+GOdc4/Qx",
+	"o8m00sgmPy+mcgNLm7bQZjOZELiP9Y9qbktvTZmQikz1WmNyko9nH/SrkMU8MbXPyPmXj03Vb6wDMTTz",
+	"/yxBWxhLY2fcwfUyNOnvo9o4jYlGOy0Zgzw2yyAh3y4+NZPcq5cgF6a+kSQ0MF0kG9SS/tTN6SOWrnEi",
+	"4eip9unFXdrdaqhvxTAHHfWUOirPWfLzI/7/x+Pxw5SloEXLY5dfxizHYN8ajxWoN1IJwNoXgbqC1yyj",
+	"YhU4CwnKDGztgmMr6WJfk9fNTaAXV6+Z19/KkiEtdTLNQg8oYxLQhwNG+sd2I32wVNhjNEewgwY0og4D",
+	"n48fbP3AVueAiQZ8Nq+AX56wU5OaxsQk/ZFymqfpymYPSY5eEEcglJUCMf8dhkyByGhKJIhbEASzDg2S",
+	"h6fWl4AR0L7i/Iih7975wfp5KOrqBBRlqc0dV/QSoJAdnzVgSEPjmlsw93SZL0zFkVu90BIL9catKz7M",
+	"lqmULa04f0LODoy/1GJpQm5gNSFcEK9dNyGN7DfqCpodGOL63M6mRj7QGzIbkOHQu5e8YD1Tlsb+ryxF",
+	"Q4AVtC40R2DH1yVNh9XgmcsMN3YohuILvMVcRYXZORtlTjKOBeWYtNejEpJnKUhJaJp6Ra5ttrvAxSgX",
+	"GTgobMKLESzqzZjan60h0W6g78/MKOv5CAMsY7MOVm+VlUzzVGB0sKORoDRDEsAkioRlBOnGxGNvx7C7",
+	"YkR7joGkGQg1QYpCdRQ8TCg4bmSN8IQcV+W3QCbbpw+IHDfgsR5BG8xgNFB+HKTH3ksPmwCyU3qsafDK",
+	"mVLQ5VZIFf9g6KDID4r8wIodTtUBvPiAxPPYZVA/m1upOXFvW6QJs6HzP0aAiZ1PyCJrdQnZF+vbd5Ns",
+	"2LiBhjkYiqLxPWJRMPNT79aq9FrsTECtZ+GuEdDJS6acnXql1uklZPsPoRQUKz09Ue2EOvamwlU+7TyE",
+	"K6tDjngranSr/llV/oGj2n1bzQxVV8Hd0eb63R8k2NyYVj9irLm1GuNaqJ1Z+G2CQWsitXHXdIg0/wEj",
+	"zfsTVoto6Rtn7lHR6wszr+DpR9kEPIlUGTPC3COhQ4C5H2D+Y5GnnZdebfJuI9mU2KJHrVtZP+Ogn/1E",
+	"mqBbtVZeu/DdCL4glFgrlShOaIZp8OxP4R2HKcPUEdF0UvTKBaFEwJTIPJ4TKklKlV4yDZhNMN8QFiv4",
+	"YrsQpBGAUHxYUNKO/YcG9Q2H5ppQMKMCuQZ1B4CFDy0K5L7u2lUDau54gRqPJ8bf3IdYOkcagOHxuE1W",
+	"bhV9aNpKQkkGdyQRdKrM7CaG4JmSpTjAn4voZMsXt2WhTYsXLP3pEOakSrFF8TzWprB+xhWJ+ZJBgmNm",
+	"wIxg0c+K5JvmNcxJWBFXd/zNHV2V2brswwZpVCDzOTfyJokbJpPZyx19sQoDN/UCi5w9DSMU2mBNBZbJ",
+	"wjy6J642XKEMCTqlbHq6OxDgojLLbpBgtL65m5usv6slSGzrTsoce9ipJ2GyttXfRrMKvYQ1vZVkoRnb",
+	"U5e5rl/CAVu1aF4DozrM76k320y+4ABt9dVpvR/3Sq9yXtte2dW7exXHZDHNYkgL/BRz3DM6eYdoMFeW",
+	"wKT2LFDRYC+5VW7J5uITw44DrSuVHZtd73u7vr59vNECb2sUY4r54webaa7V4WZSNj6b9CgSRg5xtNkM",
+	"s89AVZucoZf5cO1af8YCAN2H6Phm3YgxHeyYxy2KA8xN/nX59QsxJy5mkyFBmFj+vd0Ge+sUWOJhvGw5",
+	"tvfxdSuJHK5JbEPhCJUm8VchbuoUUSPGkGo4jvlyNXyPWKfT4JngO75cfbbibxwiHIHIXgZRFXEVzyYy",
+	"29/8wtUHLUF3HjuoacRt7tGHBgvrJ8CUxn5K+V4kzRZLLtQYRJ2rBoPpDIcYLzToVxrfzIRRWcEkxBvl",
+	"Iy9LXbmsFzPg/5KmBqMB6XtLzR3jkvl3A7stckUVXK576/0qtkpQBbNVJWN3JkEov9hcvjS/dOZwd9Of",
+	"eCk93ADfQ2mAsQoOFepYv/DGZXduWoApw6125z37PUbqrjP2zjIuIHnXXFsBZ9reRAuPluebpbKHuw+j",
+	"Vr+0lzAb4eyzGaopFJR6RCqq8meyxXelIlDaEnOqkmcJCHQIb6gd3AJ2bL5SJhGvRh+ZY5wpSxVocjGK",
+	"iovEfAl7mT+YtoMDnSUX/WOXdeNTJnq3X9IZ9G8M4nxI+92FaN/A6o6LZMOQ7jH0My58dxBGhjU2d2N3",
+	"ronLcVP7L21tmaIE5c+TgPi0NNHdUHFF00K+FW1/mmwj6w4+hybxNMbZes8tnYmuGje+8xDhvlmE+4vi",
+	"is0jSxsOxMKK+yiWtz2U97vL3zCH4JzWdTmVZApU5QIaAh/kiXx3+dtg3b1T9WoKjW6ijbsDpxTcq2OL",
+	"161Sjp0QfEZYZlbAdrG3MnoIFVYDF22JqWgSaULcWpi38NMMuJNLHTz1EbgROFvxle1kb3hrc/nvMBXk",
+	"M7cWZQKF/eSwoTRZVTSTyCF5hxxW5g3amT1mLp2PbJT9YClQdm0bHjKYHNImbGoH98qaUBMujqL/RDwe",
+	"9dTiRXiyO3KRRL9Jrle2RPDZaf002b6Drulf8UjvRFpFvjP6xP/NSvCgAXuvZ+EaraxkNIl2qP2G0eUA",
+	"cjyQ4csjw17U9xRUp2CxNFctWi+zX9lW499mr4zfy8ZgHjyb3Wk/qRi9pATh4B2t4MOZMbUQGXOJ8KpA",
+	"24jO06ZLEw4me5nB3o5YCngzZRXj2V19MfcgmDoiF9Y+dPd6YpqRayApTBWBxVKtjsjv2kbHYhsmPDvR",
+	"JvqM3UI2WbsiVN4sCt1eMhcq8K4RYZlUQJMidAUvFFXN6vCu3+F15A1KVzjay9w5tBRG9xdsWOC9v7Uw",
+	"3b+EnUUp0rrvdBXssHdXAao5FhwaWmWTVnvmVgnc/an4DWTy+MF+v9JfawHf6ybXLb+xObjta8R0QyRH",
+	"PwpTWl5knKQ8m4EgNI5hqZfqeoVvmctL5OT8rM7w5x4gONIuTTN/2s1XkjQUSXWu+6cZNRLcdSSHBZ/M",
+	"/IXbRANWKdA30awv21Cp+XSWfBUnKaPy0VaZGBCNgS+Qa9C0ybKZq4Nlk9qbdTaDNKSOL8IyRjT5ykn0",
+	"0gw2P/urO4NfWwKWWRFi0f1yOOocQXqywgphEmWZYbeCGLcusrDOPS8j7GeLgKVGn7ZB0RObi8MDy2tW",
+	"F3ayme3189OUf3BG13OWf9i1LWUupJsJdvNgP/1UVEH6Kv4Nq1brCm/SSVdcwsQNGij6Kijs4Hem5ufF",
+	"GfChotLrrahUUkC7LuhfYqlav8f1P8QI+ghqRAI71GTavibTIFJ5IrPBl3mjV3aqCca4g2ZxgHWyPdxr",
+	"PJR/Gqv8Uz/267IY8EBiwI4WX2i4wruL7WoJYa/tanH79RAy/oMcipQUt/V99efYkzZuG80sXvy28XDp",
+	"ffxA8vYrYN3iujg/7rvB68i88BI2cYNSq1y5U1HCkr1LsFdb0dEztTzRbuuQtOVZkrZsrAR9oTNmypfD",
+	"LulHUIQvoYxIRzaZwZr1uIzKeF4eCxqQJsQDDchdsBDcLwVIOZSDzO0UTRfhnBCY6SKF8NOSo0JP8ZeO",
+	"e4iajq90w2dn2kpahZecY2cjGxahLTnNBhxtx2nHD+Z/H8vWT1uIOXtZIIOugeol2LcGkL727Ukxo721",
+	"bg0CjkL09Zz2TvdLPv121v4wc9qdEXSQ4AcJ3liIeHQJ/qSZaqrsckha82Lqih7Svhy8Fn3TvhR5A57f",
+	"idFy/4HQzJRtCouj3e2/Dnlm9izPTJ3cmrhlCwX9NBlpPH44JKc5JKd51clpRlU123Duk+a+qXDwIQ3O",
+	"IQ3OS0mD4/Hz5ulwXgBPj58Qw46MN10HJceoMPshQcHLzpPRsMxj58x4ASyybUqOXgxxYIRXlqmjg/5f",
+	"Fd1XL0b3qu5cuRIrCZMyh0RvD/z7jRPCsjjNTS0vuF+aBAwaX+52Mc9AYiVXyWYZJK63siSlHjBUktK/",
+	"eGvTkTyZTTiub3JkZ+KyciO5r5eleiW87rzZrYuySkt7XfiuylU210ggsn77i+dB8dLogzzT/G0E4ZwL",
+	"9SZlt45b8egEa9BW8xwgTmzFa1Pz2bLI2rzMhReepatKwjz3xa22SaFov3i5WeriQz9ygsN2m8XQLkLM",
+	"9EZzmRZQ9jiQv7BtTc0MpdI66j+xKSi2AIcQO8mMSIh5lsgjcgpTahIOKq5FKpnzXBg5G9NMi1G4jwES",
+	"IuEWMpLQldnNtImR3SfirwicocLJvdR+u7jymnvre8+wXqvP9jr9hWGKIdkvuswMWdTr7GVh2CKgRqDI",
+	"uSHoPHPfir7K7Xo1t8LEfTfnuPrTiog8Owpa3rqn8bOaVebbO6vZpVcAduusZiUIe3/45+ipipex6skO",
+	"VajFWJ7GU5xcg6N3NJWPuSiJXv+kTHuj+ojWCvKIvL9nUvnlcquZZ401LWCZ0jhkSTtARr4ChPV1q5Tf",
+	"MzdX4MAd53+iKqcYCVXwRuMgVHCsQFr/lwLphvUMnr6o10FuPJ/ccFh0bOiigQp60j+EQgl84dFHES5s",
+	"cb1e7qSjBn/R4abrSFtQ69/BF+S++7mOao4sRZ/Sh/V6Q+OqVde+9xUEx5pBV2Ne8Fgj9zTld2hqYNEB",
+	"aXfsMc1IyiXgIxQYVfNhQmQezwmVmKBGi79pkbeU6c7/ysEEE+ClpYjqsU5BKpHHit1qRSsbq3M2G0if",
+	"qcu3iALJSGFj+2CmsKoPgSyosg4IuYQYcxgpQTNJY93hEfnClZHdXt0FrMqgO0lpZmZPmckbU8BeYMsO",
+	"vipck2aWTfbUgl4uIT4xa7q5QdXttNejPE8w8nlKG3NHmr4hcbjbN2F6YqYvbZKWItUioq9BsvaWE5pS",
+	"RxMTzRGHfLHMXWIaxwKKkwW9gU1Z8ojgJbiJa61fdkmRBRCWQKYwDxNmTGWC3MBKTopReFaILceFXgon",
+	"81uRQ6uZKw3l7h9TeqvokeO+8abGUI2qPXxgwnDk3G1Z9QE/jVHUwT9rtE9bjpDPTg/nx6/r/Nhf02c/",
+	"QHZk29eGVYLKeS+PrrUnzZUQWR6cS7LgeJyE5xu6P2REVDVSEQExZMpLkkiunDG2zIW25ZYgFjTDRnSq",
+	"bLVrAZq+Gc/0c8YDiuFKjza+6xcyJdgAB46Z9PtMidWr30rj+jkE7PFhrkWBM1IQL81HuoYSX0s2qQah",
+	"8Ij3vYbcv7PSYNAFvBP9zt7Viq9VdF/bMCMfkzuWKENnbEH19iu8S1+w7HfdMLQ799i/viu/HzIIvd9o",
+	"EDuTObDZXPWZyv+YlhvOpe8w9H6jYc5MpySmS5ULPMLhwtNR9jAiNKR752SKlB64ytF6ntEPlGuYcgF9",
+	"YfnVtB4BmBPL+KaUz7UWzJrxr/k90cgUUosWExqCLVKOqpcwLVGxJI9uzKfkfzUJfMpmE/2PqsmC3ptv",
+	"9P4TVf8bNUzo+prfh5ayGeJ3l78V4spsQ+f01nnO0nyRNQw0B5qsLd/6UM953dXM6NVZHXYdtBZ5mTbG",
+	"dofVOD3fPLDsMsJd1b73TU1KeAOIDdrUQCK524T45mGDeh75PNm+9j6LuZYT4VpQN2x5Cnr2Xr6JBIOj",
+	"ordTmkqYRFmepvQ6BXTKhKrrNsUXTaJcpP2ycrn0FFSoYy0d3yT2tG+b6dk2VzZ5RaB2F2bD6Lzm1xNT",
+	"NV/9DqLCegimJt5//Wxf3rQtOK2V4zuM7uN8mXJq84Q1R3Hq8b5dfDI8TW00oeIEX9YP27j6m2lV8PbW",
+	"EujphINt8wmymZqHLLduBotzIbnYNmHfuLEiTzT1DO5V8MEIwjLM35YgXz+Xf6szViuHhxzImyYAXPfl",
+	"9cjhd0j7dEj79OyJ+5p5oDU1X2PSvZefae81rmVSyZI3RpK8NXm1uzx3Byl3kHLPntxuF0fFfY6HD2fC",
+	"L/RMeBfnwKHj3KK2+/GD+1irf9xckvzZVKoPxJAaDfbQeL+LZruUtkOKZhcVHJqJ4SPstDhDV030k2y/",
+	"V7WQJsOWdZgIKWVE74p2NXjMNV2D6iJO0D2rXFDCoFwbF8gFqaYNNE3x3m8wX5Sb5chGXlcZh5eZI/HF",
+	"lpHoYmq/5t5ec7crKDGEuZv1e5kaeQT2bzk5kvboCO/860ExhpcpWSYUwp+LKK+wZECpgZcdXahwlqzL",
+	"BH4L4k4wBW09tWRN/SB4SY77lzb1WdOk+uW1mU23vE8cXh4BGQ7H8OPB7C6onB8/mH+azwVIxQVswOnY",
+	"Qxebf+a33r1lDMVAn71N/4P5kCwLQqbEilzT+KZkd4yFw1PlRdGbadkQonlh57RDYvVjMMOUahGbIKT7",
+	"Z2ua2Wv6xDX1AxtDkYyaNh/uuLiRSxobDeROLwfEBxav1PO3mAe7uAI7ek6mctY90zFx5xp56kxM/Pkv",
+	"wLa/+YWrD5rSi7eeMIOExU41B4rF2PBtlccZz1oB1k5h5OM/mjIqg7ulrt1Uw66lAOyCD0mNYBftonx3",
+	"rGzyP4+meQp+7+H/9uOvPDmxj0aTl4M3wI7N2ueYLZZcqOH2UZ1je2yF3EUfqy2LPtxds7/ZklARz9kt",
+	"ELjXgOEVSDuV9+anI/L+FsSKnJ2upTnCbGfOd8LU3G6+eBZMeYYBODj9Nk4fGL7WzOy9g9LWsq+Y976/",
+	"Mr5EutpfxkTC2oIxvaC2ttMI29mzHUQ4PhpwBuHY9c4lUoCEyDyOQcppnqarva2w10oqnSWkvXtUQRLZ",
+	"8fHEUAGxp3IhuF7PZUEHwuA7akd3EdnIpw07sJwxKSt1L/Yg6XPvjZdnej8fB7tziZfHyZYYydOWum5n",
+	"6H6q/hjN3h5OId9WXss2Ymxfc8Rg04zYFXb5RibklsGdnPgFHzHLiHVSkzmTiosVHlaUF3CCLI9W+TDV",
+	"8jdbjlCPqRkF+6ZVcA1eplJ5fHz8/wEAAP//bVPxz7BwAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
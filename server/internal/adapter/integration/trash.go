@@ -0,0 +1,52 @@
+package integration
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+func (s *Server) TrashList(ctx context.Context, request TrashListRequestObject) (TrashListResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	prj, err := uc.Project.FindByIDOrAlias(ctx, request.ProjectIdOrAlias, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return TrashList404Response{}, err
+		}
+		return TrashList400Response{}, err
+	}
+
+	p := fromPagination(request.Params.Page, request.Params.PerPage)
+	res, pi, err := uc.Trash.FindByProject(ctx, prj.ID(), p, op)
+	if err != nil {
+		return TrashList500Response{}, err
+	}
+
+	return TrashList200JSONResponse{
+		Entries:    lo.ToPtr(integrationapi.NewTrashEntries(res)),
+		Page:       lo.ToPtr(Page(*p.Offset)),
+		PerPage:    lo.ToPtr(int(p.Offset.Limit)),
+		TotalCount: lo.ToPtr(int(pi.TotalCount)),
+	}, nil
+}
+
+func (s *Server) TrashRestore(ctx context.Context, request TrashRestoreRequestObject) (TrashRestoreResponseObject, error) {
+	op := adapter.Operator(ctx)
+	uc := adapter.Usecases(ctx)
+
+	e, err := uc.Trash.Restore(ctx, request.TrashId, op)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return TrashRestore404Response{}, err
+		}
+		return TrashRestore400Response{}, err
+	}
+
+	return TrashRestore200JSONResponse(*integrationapi.NewTrashEntry(e)), nil
+}
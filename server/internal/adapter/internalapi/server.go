@@ -148,7 +148,7 @@ func (s server) GetModelGeoJSONExportURL(ctx context.Context, req *pb.ExportRequ
 	for {
 		// Get the next page of features
 		page++
-		res, err := uc.Item.ItemsAsGeoJSON(ctx, sp, &page, lo.ToPtr(100), op)
+		res, err := uc.Item.ItemsAsGeoJSON(ctx, sp, &page, lo.ToPtr(100), nil, op)
 		if err != nil {
 			return nil, err
 		}
@@ -2,12 +2,14 @@ package publicapi
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
 )
@@ -22,6 +24,7 @@ var controllerCK = contextKey("controller")
 
 const defaultLimit = 50
 const maxLimit = 100
+const defaultMaxExpandDepth = 3
 
 func AttachController(ctx context.Context, c *Controller) context.Context {
 	return context.WithValue(ctx, controllerCK, c)
@@ -31,13 +34,28 @@ func GetController(ctx context.Context) *Controller {
 	return ctx.Value(controllerCK).(*Controller)
 }
 
-func Echo(e *echo.Group) {
+type Config struct {
+	// MaxExpandDepth is the max depth of references which can be expanded by the expand query param.
+	MaxExpandDepth int
+}
+
+func (c Config) maxExpandDepth() int {
+	if c.MaxExpandDepth <= 0 {
+		return defaultMaxExpandDepth
+	}
+	return c.MaxExpandDepth
+}
+
+func Echo(e *echo.Group, conf Config) {
 	e.Use(middleware.CORS())
-	e.GET("/:project/:model", PublicApiItemOrAssetList())
-	e.GET("/:project/:model/:item", PublicApiItemOrAsset())
+	// "graphql" is reserved and cannot be used as a model key
+	e.GET("/:project/graphql", PublicApiGraphQL(conf))
+	e.POST("/:project/graphql", PublicApiGraphQL(conf))
+	e.GET("/:project/:model", PublicApiItemOrAssetList(conf))
+	e.GET("/:project/:model/:item", PublicApiItemOrAsset(conf))
 }
 
-func PublicApiItemOrAsset() echo.HandlerFunc {
+func PublicApiItemOrAsset(conf Config) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
 		ctrl := GetController(c.Request().Context())
@@ -50,7 +68,15 @@ func PublicApiItemOrAsset() echo.HandlerFunc {
 		} else if i == "schema.json" {
 			res, err = ctrl.GetSchemaJSON(ctx, p, m)
 		} else {
-			res, err = ctrl.GetItem(ctx, p, m, i)
+			ip := itemParamFromEchoContext(c)
+			if err := ip.validate(conf.maxExpandDepth()); err != nil {
+				return err
+			}
+			var cache Cache
+			res, cache, err = ctrl.GetItem(ctx, p, m, i, ip)
+			if err == nil && cache.Write(c) {
+				return c.NoContent(http.StatusNotModified)
+			}
 		}
 
 		if err != nil {
@@ -61,7 +87,7 @@ func PublicApiItemOrAsset() echo.HandlerFunc {
 	}
 }
 
-func PublicApiItemOrAssetList() echo.HandlerFunc {
+func PublicApiItemOrAssetList(conf Config) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		ctx := c.Request().Context()
 		ctrl := GetController(ctx)
@@ -70,8 +96,14 @@ func PublicApiItemOrAssetList() echo.HandlerFunc {
 		pKey := c.Param("project")
 		p, err := listParamFromEchoContext(c)
 		if err != nil {
+			if errors.Is(err, ErrInvalidFilter) || errors.Is(err, ErrInvalidSort) {
+				return err
+			}
 			return c.JSON(http.StatusBadRequest, "invalid offset or limit")
 		}
+		if err := p.validate(conf.maxExpandDepth()); err != nil {
+			return err
+		}
 
 		if mKey == "assets" {
 			res, err := ctrl.GetAssets(ctx, pKey, p)
@@ -89,25 +121,32 @@ func PublicApiItemOrAssetList() echo.HandlerFunc {
 			resType = "json"
 		}
 
-		res, _, err := ctrl.GetItems(ctx, pKey, mKey, p)
-		if err != nil {
-			return err
+		if resType == "json" {
+			res, cache, err := ctrl.GetItems(ctx, pKey, mKey, p)
+			if err != nil {
+				return err
+			}
+			if cache.Write(c) {
+				return c.NoContent(http.StatusNotModified)
+			}
+			return c.JSON(http.StatusOK, res)
 		}
 
-		vi, s, err := ctrl.GetVersionedItems(ctx, pKey, mKey, p)
+		vi, s, cache, err := ctrl.GetVersionedItems(ctx, pKey, mKey, p)
 		if err != nil {
 			return err
 		}
+		if cache.Write(c) {
+			return c.NoContent(http.StatusNotModified)
+		}
 
 		switch resType {
 		case "csv":
 			return toCSV(c, vi, s)
 		case "geojson":
 			return toGeoJSON(c, vi, s)
-		case "json":
-			return c.JSON(http.StatusOK, res)
 		default:
-			return c.JSON(http.StatusOK, res)
+			return c.JSON(http.StatusOK, vi)
 		}
 	}
 }
@@ -160,9 +199,46 @@ func listParamFromEchoContext(c echo.Context) (ListParam, error) {
 		}.Wrap()
 	}
 
+	if err != nil {
+		return ListParam{}, err
+	}
+
+	filters, err := filterParamsFromQuery(c.QueryParams())
+	if err != nil {
+		return ListParam{}, err
+	}
+
+	sort, err := sortParamFromQuery(c.QueryParams())
+	if err != nil {
+		return ListParam{}, err
+	}
+
 	return ListParam{
+		ItemParam:  itemParamFromEchoContext(c),
 		Pagination: p,
-	}, err
+		Keyword:    c.QueryParam("keyword"),
+		Sort:       sort,
+		Filters:    filters,
+	}, nil
+}
+
+func itemParamFromEchoContext(c echo.Context) ItemParam {
+	var e Expand
+	if c.QueryParams().Has("expand") {
+		e = ExpandFrom(c.QueryParam("expand"))
+	}
+	var l locale.Locale
+	if q := c.QueryParam("locale"); q != "" {
+		l, _ = locale.Parse(q)
+	}
+	return ItemParam{
+		Fields:   fieldsFromQuery(c.QueryParam("fields")),
+		Expand:   e,
+		CacheKey: c.Request().URL.RequestURI(),
+		Locale:   l,
+		Release:  c.QueryParam("release"),
+		At:       c.QueryParam("at"),
+	}
 }
 
 func intParams(c echo.Context, params ...string) (int64, bool) {
@@ -17,6 +17,7 @@ func TestListParamFromEchoContext(t *testing.T) {
 		httptest.NewRequest("GET", "/?start_cursor=xxx&page_size=100", nil), nil))
 	assert.NoError(t, err)
 	assert.Equal(t, ListParam{
+		ItemParam: ItemParam{CacheKey: "/?start_cursor=xxx&page_size=100"},
 		Pagination: &usecasex.Pagination{
 			Cursor: &usecasex.CursorPagination{
 				First: lo.ToPtr(int64(100)),
@@ -29,6 +30,7 @@ func TestListParamFromEchoContext(t *testing.T) {
 		httptest.NewRequest("GET", "/?offset=101&limit=101", nil), nil))
 	assert.NoError(t, err)
 	assert.Equal(t, ListParam{
+		ItemParam: ItemParam{CacheKey: "/?offset=101&limit=101"},
 		Pagination: &usecasex.Pagination{
 			Offset: &usecasex.OffsetPagination{
 				Limit:  100,
@@ -41,6 +43,7 @@ func TestListParamFromEchoContext(t *testing.T) {
 		httptest.NewRequest("GET", "/?page=3&perPage=100", nil), nil))
 	assert.NoError(t, err)
 	assert.Equal(t, ListParam{
+		ItemParam: ItemParam{CacheKey: "/?page=3&perPage=100"},
 		Pagination: &usecasex.Pagination{
 			Offset: &usecasex.OffsetPagination{
 				Limit:  100,
@@ -49,3 +52,56 @@ func TestListParamFromEchoContext(t *testing.T) {
 		},
 	}, p)
 }
+
+func TestListParamFromEchoContext_Query(t *testing.T) {
+	e := echo.New()
+
+	p, err := listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?keyword=foo&sort=-name&filter[count][gt]=3&filter[name]=bar&filter[tags][in]=a,b", nil), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", p.Keyword)
+	assert.Equal(t, &SortParam{Key: "name", Desc: true}, p.Sort)
+	assert.Equal(t, []FilterParam{
+		{Key: "count", Op: FilterOperatorGt, Value: "3"},
+		{Key: "name", Op: FilterOperatorEq, Value: "bar"},
+		{Key: "tags", Op: FilterOperatorIn, Value: "a,b"},
+	}, p.Filters)
+
+	p, err = listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?sort=name&dir=desc", nil), nil))
+	assert.NoError(t, err)
+	assert.Equal(t, &SortParam{Key: "name", Desc: true}, p.Sort)
+	assert.Nil(t, p.Filters)
+
+	_, err = listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?filter[name][like]=bar", nil), nil))
+	assert.Same(t, ErrInvalidFilter, err)
+
+	_, err = listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?filter[name=bar", nil), nil))
+	assert.Same(t, ErrInvalidFilter, err)
+
+	_, err = listParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?sort=name&dir=up", nil), nil))
+	assert.Same(t, ErrInvalidSort, err)
+}
+
+func TestItemParamFromEchoContext(t *testing.T) {
+	e := echo.New()
+
+	p := itemParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?fields=title,%20location,&expand=author,author.company", nil), nil))
+	assert.Equal(t, ItemParam{
+		Fields:   []string{"title", "location"},
+		Expand:   Expand{"author": Expand{"company": Expand{}}},
+		CacheKey: "/?fields=title,%20location,&expand=author,author.company",
+	}, p)
+
+	p = itemParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/?expand=", nil), nil))
+	assert.Equal(t, ItemParam{Expand: Expand{}, CacheKey: "/?expand="}, p)
+
+	p = itemParamFromEchoContext(e.NewContext(
+		httptest.NewRequest("GET", "/", nil), nil))
+	assert.Equal(t, ItemParam{CacheKey: "/"}, p)
+}
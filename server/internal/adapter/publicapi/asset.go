@@ -10,6 +10,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 func (c *Controller) GetAsset(ctx context.Context, prj, i string) (Asset, error) {
@@ -51,7 +52,7 @@ func (c *Controller) GetAssets(ctx context.Context, pKey string, p ListParam) (L
 
 	al, pi, err := c.usecases.Asset.Search(ctx, prj.ID(), interfaces.AssetFilter{
 		Sort:       nil,
-		Keyword:    nil,
+		Keyword:    lo.EmptyableToPtr(p.Keyword),
 		Pagination: p.Pagination,
 	}, nil)
 
@@ -0,0 +1,89 @@
+package publicapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// Cache holds the validators and the freshness of a public api response.
+type Cache struct {
+	ETag         string
+	LastModified time.Time
+	MaxAge       int
+	Private      bool
+}
+
+func NewCache(pub *project.Publication, lastModified time.Time, keys ...string) Cache {
+	h := sha256.New()
+	for _, k := range keys {
+		_, _ = h.Write([]byte(k))
+		_, _ = h.Write([]byte{0})
+	}
+
+	c := Cache{
+		ETag:         fmt.Sprintf(`"%s"`, hex.EncodeToString(h.Sum(nil))[:32]),
+		LastModified: lastModified.UTC().Truncate(time.Second),
+	}
+	if pub != nil {
+		c.MaxAge = pub.CacheMaxAge()
+		c.Private = pub.Scope() != project.PublicationScopePublic
+	}
+	return c
+}
+
+func (c Cache) CacheControl() string {
+	if c.MaxAge <= 0 {
+		return "no-cache"
+	}
+	if c.Private {
+		return fmt.Sprintf("private, max-age=%d", c.MaxAge)
+	}
+	return fmt.Sprintf("public, max-age=%d", c.MaxAge)
+}
+
+// Write sets cache headers to the response and returns true if the client already has the fresh response.
+// As described in RFC 9110, If-Modified-Since is ignored when If-None-Match is present.
+func (c Cache) Write(ctx echo.Context) bool {
+	h := ctx.Response().Header()
+	h.Set(echo.HeaderCacheControl, c.CacheControl())
+	if c.ETag != "" {
+		h.Set(headerETag, c.ETag)
+	}
+	if !c.LastModified.IsZero() {
+		h.Set(echo.HeaderLastModified, c.LastModified.Format(http.TimeFormat))
+	}
+
+	req := ctx.Request()
+	if inm := req.Header.Get(headerIfNoneMatch); inm != "" {
+		return c.ETag != "" && matchETag(inm, c.ETag)
+	}
+	if ims := req.Header.Get(echo.HeaderIfModifiedSince); ims != "" && !c.LastModified.IsZero() {
+		t, err := http.ParseTime(ims)
+		return err == nil && !c.LastModified.After(t)
+	}
+	return false
+}
+
+// matchETag compares ETags with the weak comparison, which is used for If-None-Match.
+func matchETag(header, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, t := range strings.Split(header, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || strings.TrimPrefix(t, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
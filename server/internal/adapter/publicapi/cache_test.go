@@ -0,0 +1,152 @@
+package publicapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCache(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	pub := project.NewPublication(project.PublicationScopePublic, true)
+	pub.SetCacheMaxAge(60)
+
+	c := NewCache(pub, now, "a", "b")
+	assert.Equal(t, 60, c.MaxAge)
+	assert.False(t, c.Private)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), c.LastModified)
+	assert.Len(t, c.ETag, 34)
+	assert.Equal(t, c.ETag, NewCache(pub, now, "a", "b").ETag)
+	assert.NotEqual(t, c.ETag, NewCache(pub, now, "ab").ETag)
+
+	c = NewCache(project.NewPublication(project.PublicationScopeLimited, true), now)
+	assert.Equal(t, 0, c.MaxAge)
+	assert.True(t, c.Private)
+
+	c = NewCache(nil, now)
+	assert.Equal(t, 0, c.MaxAge)
+	assert.False(t, c.Private)
+}
+
+func TestCache_CacheControl(t *testing.T) {
+	assert.Equal(t, "no-cache", Cache{}.CacheControl())
+	assert.Equal(t, "public, max-age=60", Cache{MaxAge: 60}.CacheControl())
+	assert.Equal(t, "private, max-age=60", Cache{MaxAge: 60, Private: true}.CacheControl())
+}
+
+func TestCache_Write(t *testing.T) {
+	lm := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := Cache{ETag: `"xxx"`, LastModified: lm, MaxAge: 60}
+
+	tests := []struct {
+		name   string
+		header map[string]string
+		want   bool
+	}{
+		{
+			name: "no conditions",
+			want: false,
+		},
+		{
+			name:   "etag matched",
+			header: map[string]string{headerIfNoneMatch: `"yyy", "xxx"`},
+			want:   true,
+		},
+		{
+			name:   "weak etag matched",
+			header: map[string]string{headerIfNoneMatch: `W/"xxx"`},
+			want:   true,
+		},
+		{
+			name:   "etag not matched",
+			header: map[string]string{headerIfNoneMatch: `"yyy"`},
+			want:   false,
+		},
+		{
+			name:   "not modified since",
+			header: map[string]string{echo.HeaderIfModifiedSince: lm.Format(http.TimeFormat)},
+			want:   true,
+		},
+		{
+			name:   "modified since",
+			header: map[string]string{echo.HeaderIfModifiedSince: lm.Add(-time.Second).Format(http.TimeFormat)},
+			want:   false,
+		},
+		{
+			name: "if-modified-since is ignored when if-none-match is present",
+			header: map[string]string{
+				headerIfNoneMatch:          `"yyy"`,
+				echo.HeaderIfModifiedSince: lm.Format(http.TimeFormat),
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			rec := httptest.NewRecorder()
+
+			assert.Equal(t, tt.want, c.Write(echo.New().NewContext(req, rec)))
+			assert.Equal(t, "public, max-age=60", rec.Header().Get(echo.HeaderCacheControl))
+			assert.Equal(t, `"xxx"`, rec.Header().Get(headerETag))
+			assert.Equal(t, lm.Format(http.TimeFormat), rec.Header().Get(echo.HeaderLastModified))
+		})
+	}
+}
+
+func TestExpansion_Cache(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := NewCache(nil, now, "a")
+
+	var x *expansion
+	assert.Equal(t, c, x.cache(c))
+	assert.Equal(t, c, (&expansion{}).cache(c))
+
+	iid := id.NewItemID()
+	it := item.New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Thread(id.NewThreadID().Ref()).Timestamp(now.Add(time.Hour)).MustBuild()
+	v1 := version.NewValue(version.New(), nil, nil, now, it)
+	v2 := version.NewValue(version.New(), nil, nil, now, it)
+
+	// referenced items
+	x = &expansion{}
+	x.addItem(iid, v1)
+	c1 := x.cache(c)
+	assert.NotEqual(t, c.ETag, c1.ETag)
+	assert.Equal(t, now.Add(time.Hour), c1.LastModified)
+
+	x = &expansion{}
+	x.addItem(iid, v2)
+	assert.NotEqual(t, c1.ETag, x.cache(c).ETag)
+
+	x = &expansion{}
+	x.addItem(iid, nil)
+	assert.NotEqual(t, c1.ETag, x.cache(c).ETag)
+	assert.Equal(t, now, x.cache(c).LastModified)
+
+	// assets
+	a := asset.New().NewID().Project(id.NewProjectID()).CreatedByUser(accountdomain.NewUserID()).Thread(id.NewThreadID().Ref()).Size(1).NewUUID().MustBuild()
+	x = &expansion{}
+	x.addAssets([]id.AssetID{a.ID()}, asset.List{a})
+	c2 := x.cache(c)
+	assert.NotEqual(t, c.ETag, c2.ETag)
+	assert.True(t, c2.LastModified.IsZero())
+
+	a.UpdatePublic(!a.Public())
+	x = &expansion{}
+	x.addAssets([]id.AssetID{a.ID()}, asset.List{a})
+	assert.NotEqual(t, c2.ETag, x.cache(c).ETag)
+}
@@ -4,25 +4,30 @@ import (
 	"context"
 	"errors"
 
+	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
 )
 
 var ErrInvalidProject = rerror.NewE(i18n.T("invalid project"))
 
 type Controller struct {
-	project  repo.Project
-	usecases *interfaces.Container
+	project        repo.Project
+	usecases       *interfaces.Container
+	graphqlSchemas *lru.Cache[id.ProjectID, cachedGraphQLSchema]
 }
 
 func NewController(project repo.Project, usecases *interfaces.Container) *Controller {
 	return &Controller{
-		project:  project,
-		usecases: usecases,
+		project:        project,
+		usecases:       usecases,
+		graphqlSchemas: lo.Must(lru.New[id.ProjectID, cachedGraphQLSchema](graphqlSchemaCacheSize)),
 	}
 }
 
@@ -0,0 +1,321 @@
+package publicapi
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrInvalidExpand = rerror.NewE(i18n.T("invalid expand"))
+	ErrInvalidTime   = rerror.NewE(i18n.T("invalid time"))
+)
+
+// Expand is a tree of field keys whose referenced items or assets should be inlined.
+// e.g. "author,author.company,photo" is parsed as {"author": {"company": {}}, "photo": {}}.
+type Expand map[string]Expand
+
+func ExpandFrom(s string) Expand {
+	res := Expand{}
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		e := res
+		for _, k := range strings.Split(p, ".") {
+			if k == "" {
+				continue
+			}
+			if e[k] == nil {
+				e[k] = Expand{}
+			}
+			e = e[k]
+		}
+	}
+	return res
+}
+
+func (e Expand) Has(key string) bool {
+	_, ok := e[key]
+	return ok
+}
+
+func (e Expand) Child(key string) Expand {
+	if c := e[key]; c != nil {
+		return c
+	}
+	return Expand{}
+}
+
+func (e Expand) Depth() int {
+	d := 0
+	for _, c := range e {
+		d = max(d, c.Depth()+1)
+	}
+	return d
+}
+
+type ItemParam struct {
+	// Fields is a list of field keys to be returned. All fields are returned if it is empty.
+	Fields []string
+	// Expand is a tree of fields to be expanded. Nothing is expanded if it is nil, and unexpanded references and assets are returned as their IDs.
+	Expand Expand
+	// CacheKey identifies the representation of the response. The request URI is usually used.
+	CacheKey string
+	// Locale is the locale to resolve the values of localized fields. The default locale of the project is used if it is empty.
+	Locale locale.Locale
+	// Release is the name of the release to preview. The versions of items staged in the release are returned instead of the public versions.
+	Release string
+	// At is the time in RFC3339 to read the items as they were public at the time. It takes precedence over previews.
+	At string
+	// locales is the locale settings of the project, which are set after the project is found.
+	locales *locale.Settings
+	// ref is the ref which items are read from, which is set after the preview token or the release is found.
+	ref *version.Ref
+}
+
+// versionRef returns the ref which items are read from.
+func (p ItemParam) versionRef() *version.Ref {
+	if p.ref != nil {
+		return p.ref
+	}
+	return version.Public.Ref()
+}
+
+func fieldsFromQuery(s string) []string {
+	if s == "" {
+		return nil
+	}
+	res := lo.Filter(lo.Map(strings.Split(s, ","), func(f string, _ int) string {
+		return strings.TrimSpace(f)
+	}), func(f string, _ int) bool {
+		return f != ""
+	})
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
+
+func (p ItemParam) validate(maxDepth int) error {
+	if p.Expand != nil && p.Expand.Depth() > maxDepth {
+		return ErrInvalidExpand
+	}
+	if _, err := p.at(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (p ItemParam) at() (*time.Time, error) {
+	if p.At == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, p.At)
+	if err != nil {
+		return nil, ErrInvalidTime
+	}
+	return &t, nil
+}
+
+func (p ItemParam) hasField(key string) bool {
+	return len(p.Fields) == 0 || slices.Contains(p.Fields, key)
+}
+
+// expandsField returns whether the field should be expanded.
+func expandsField(f *schema.Field, e Expand) bool {
+	return e.Has(f.Key().String())
+}
+
+func childExpand(f *schema.Field, e Expand) Expand {
+	if e == nil {
+		return nil
+	}
+	return e.Child(f.Key().String())
+}
+
+// expansion records the referenced items and the assets which are inlined into items.
+// They change the response without changing the items, so they are used for the cache validators of the response.
+type expansion struct {
+	keys         []string
+	lastModified time.Time
+	// assets is true when assets are inlined. Assets have no modification time, so only the ETag can validate the response.
+	assets bool
+}
+
+func (x *expansion) addItem(iid id.ItemID, v item.Versioned) {
+	if v == nil {
+		// the item may be published later
+		x.keys = append(x.keys, iid.String())
+		return
+	}
+	x.keys = append(x.keys, iid.String()+":"+v.Version().String())
+	if t := v.Value().Timestamp(); t.After(x.lastModified) {
+		x.lastModified = t
+	}
+}
+
+func (x *expansion) addAssets(ids []id.AssetID, assets asset.List) {
+	for _, aid := range ids {
+		x.assets = true
+		a, ok := lo.Find(assets, func(a *asset.Asset) bool { return a != nil && a.ID() == aid })
+		if !ok {
+			x.keys = append(x.keys, aid.String())
+			continue
+		}
+		x.keys = append(x.keys, aid.String()+":"+a.AccessInfo().Url+":"+strconv.FormatBool(a.Public()))
+	}
+}
+
+// cache adds the expansion to the validators of the cache.
+func (x *expansion) cache(c Cache) Cache {
+	if x == nil || (len(x.keys) == 0 && !x.assets) {
+		return c
+	}
+	c.ETag = NewCache(nil, time.Time{}, append([]string{c.ETag}, x.keys...)...).ETag
+	if x.assets {
+		c.LastModified = time.Time{}
+	} else if x.lastModified.After(c.LastModified) {
+		c.LastModified = x.lastModified.UTC().Truncate(time.Second)
+	}
+	return c
+}
+
+// newItems converts items into public items loading referenced items and assets according to the param.
+func (c *Controller) newItems(ctx context.Context, items item.List, sp *schema.Package, p ItemParam, assetPublic bool) ([]Item, *expansion, error) {
+	x := &expansion{}
+	var assets asset.List
+	if assetPublic {
+		assetIDs := lo.FlatMap(items, func(i *item.Item, _ int) []id.AssetID {
+			return expandedAssetIDs(i, sp, p)
+		})
+		if len(assetIDs) > 0 {
+			var err error
+			assets, err = c.usecases.Asset.FindByIDs(ctx, assetIDs, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			x.addAssets(assetIDs, assets)
+		}
+	}
+
+	return lo.Map(items, func(i *item.Item, _ int) Item {
+		return c.newItem(ctx, i, sp, assets, p, assetPublic, x)
+	}), x, nil
+}
+
+func (c *Controller) newItem(ctx context.Context, i *item.Item, sp *schema.Package, assets asset.List, p ItemParam, assetPublic bool, x *expansion) Item {
+	i = i.Localize(p.locales, p.Locale)
+	res := newItem(i, sp, assets, c.getReferencedItems(ctx, i, sp.Schema(), p, assetPublic, x), assetPublic)
+	if len(p.Fields) > 0 {
+		res.Fields = lo.PickByKeys(res.Fields, p.Fields)
+	}
+	return res
+}
+
+// expandedAssetIDs returns the IDs of the assets in the expanded asset fields of the item.
+// Asset fields in a group are expanded with the path of the group field and the asset field such as "group.photo".
+func expandedAssetIDs(i *item.Item, sp *schema.Package, p ItemParam) []id.AssetID {
+	var res []id.AssetID
+	for _, f := range sp.Schema().FieldsByType(value.TypeAsset) {
+		if !p.hasField(f.Key().String()) || !expandsField(f, p.Expand) {
+			continue
+		}
+		res = append(res, fieldAssetIDs(i, f.ID())...)
+	}
+
+	for _, f := range sp.Schema().FieldsByType(value.TypeGroup) {
+		if !p.hasField(f.Key().String()) || !expandsField(f, p.Expand) {
+			continue
+		}
+		var gid id.GroupID
+		f.TypeProperty().Match(schema.TypePropertyMatch{
+			Group: func(g *schema.FieldGroup) { gid = g.Group() },
+		})
+		gs := sp.GroupSchema(gid)
+		if gs == nil {
+			continue
+		}
+		ge := childExpand(f, p.Expand)
+		for _, gf := range gs.FieldsByType(value.TypeAsset) {
+			if expandsField(gf, ge) {
+				res = append(res, fieldAssetIDs(i, gf.ID())...)
+			}
+		}
+	}
+	return res
+}
+
+func fieldAssetIDs(i *item.Item, fid id.FieldID) []id.AssetID {
+	return lo.FlatMap(i.Fields(), func(f *item.Field, _ int) []id.AssetID {
+		if f.FieldID() != fid {
+			return nil
+		}
+		return lo.FilterMap(f.Value().Values(), func(v *value.Value, _ int) (id.AssetID, bool) {
+			return v.ValueAsset()
+		})
+	})
+}
+
+func (c *Controller) getReferencedItems(ctx context.Context, i *item.Item, s *schema.Schema, p ItemParam, assetPublic bool, x *expansion) []Item {
+	if i == nil || s == nil {
+		return nil
+	}
+
+	var res []Item
+	for _, f := range s.FieldsByType(value.TypeReference) {
+		if !p.hasField(f.Key().String()) || !expandsField(f, p.Expand) {
+			continue
+		}
+
+		itf := i.Field(f.ID())
+		if itf == nil {
+			continue
+		}
+
+		cp := ItemParam{Expand: childExpand(f, p.Expand), Locale: p.Locale, locales: p.locales, ref: p.ref}
+		for _, v := range itf.Value().Values() {
+			iid, ok := v.ValueReference()
+			if !ok {
+				continue
+			}
+			ri, err := c.usecases.Item.FindPublicByID(ctx, iid, p.ref, adapter.Operator(ctx))
+			if err != nil || ri == nil {
+				x.addItem(iid, nil)
+				continue
+			}
+			x.addItem(iid, ri)
+			rsp, err := c.usecases.Schema.FindByModel(ctx, ri.Value().Model(), nil)
+			if err != nil {
+				continue
+			}
+			var assets asset.List
+			if assetPublic {
+				if aids := expandedAssetIDs(ri.Value(), rsp, cp); len(aids) > 0 {
+					assets, err = c.usecases.Asset.FindByIDs(ctx, aids, nil)
+					if err != nil {
+						continue
+					}
+					x.addAssets(aids, assets)
+				}
+			}
+			res = append(res, c.newItem(ctx, ri.Value(), rsp, assets, cp, assetPublic, x))
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,95 @@
+package publicapi
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandFrom(t *testing.T) {
+	assert.Equal(t, Expand{}, ExpandFrom(""))
+	assert.Equal(t, Expand{
+		"author": Expand{
+			"company": Expand{},
+		},
+		"photo": Expand{},
+	}, ExpandFrom("author, author.company,photo,,"))
+	assert.Equal(t, Expand{
+		"a": Expand{
+			"b": Expand{
+				"c": Expand{},
+			},
+		},
+	}, ExpandFrom("a.b.c"))
+}
+
+func TestExpand_Depth(t *testing.T) {
+	assert.Equal(t, 0, Expand(nil).Depth())
+	assert.Equal(t, 0, Expand{}.Depth())
+	assert.Equal(t, 1, ExpandFrom("a,b").Depth())
+	assert.Equal(t, 3, ExpandFrom("a,b.c.d,e.f").Depth())
+}
+
+func TestExpand_Child(t *testing.T) {
+	e := ExpandFrom("a.b")
+	assert.True(t, e.Has("a"))
+	assert.False(t, e.Has("b"))
+	assert.Equal(t, Expand{"b": Expand{}}, e.Child("a"))
+	assert.Equal(t, Expand{}, e.Child("b"))
+}
+
+func TestItemParam_Validate(t *testing.T) {
+	assert.NoError(t, ItemParam{}.validate(1))
+	assert.NoError(t, ItemParam{Expand: ExpandFrom("a.b")}.validate(2))
+	assert.Same(t, ErrInvalidExpand, ItemParam{Expand: ExpandFrom("a.b.c")}.validate(2))
+	assert.NoError(t, ItemParam{At: "2024-04-01T12:00:00+09:00"}.validate(1))
+	assert.Same(t, ErrInvalidTime, ItemParam{At: "2024-04-01"}.validate(1))
+}
+
+func TestExpandedAssetIDs(t *testing.T) {
+	a1, a2, a3 := id.NewAssetID(), id.NewAssetID(), id.NewAssetID()
+	gs := schema.New().
+		NewID().
+		Project(id.NewProjectID()).
+		Workspace(accountdomain.NewWorkspaceID()).
+		Fields([]*schema.Field{
+			schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(id.NewKey("photo")).MustBuild(),
+		}).
+		MustBuild()
+	gid := id.NewGroupID()
+	s := schema.New().
+		NewID().
+		Project(id.NewProjectID()).
+		Workspace(accountdomain.NewWorkspaceID()).
+		Fields([]*schema.Field{
+			schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(id.NewKey("image")).MustBuild(),
+			schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(id.NewKey("file")).MustBuild(),
+			schema.NewField(schema.NewGroup(gid).TypeProperty()).NewID().Key(id.NewKey("group")).MustBuild(),
+		}).
+		MustBuild()
+	ig := id.NewItemGroupID()
+	it := item.New().
+		NewID().
+		Schema(s.ID()).
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		Thread(id.NewThreadID().Ref()).
+		Fields([]*item.Field{
+			item.NewField(s.Fields()[0].ID(), value.New(value.TypeAsset, a1).AsMultiple(), nil),
+			item.NewField(s.Fields()[1].ID(), value.New(value.TypeAsset, a2).AsMultiple(), nil),
+			item.NewField(s.Fields()[2].ID(), value.New(value.TypeGroup, ig).AsMultiple(), nil),
+			item.NewField(gs.Fields()[0].ID(), value.New(value.TypeAsset, a3).AsMultiple(), ig.Ref()),
+		}).
+		MustBuild()
+	sp := schema.NewPackage(s, nil, map[id.GroupID]*schema.Schema{gid: gs}, nil)
+
+	assert.Empty(t, expandedAssetIDs(it, sp, ItemParam{}))
+	assert.Equal(t, []id.AssetID{a1}, expandedAssetIDs(it, sp, ItemParam{Expand: ExpandFrom("image")}))
+	assert.Equal(t, []id.AssetID{a1, a3}, expandedAssetIDs(it, sp, ItemParam{Expand: ExpandFrom("image,file,group.photo"), Fields: []string{"image", "group"}}))
+	assert.Empty(t, expandedAssetIDs(it, sp, ItemParam{Expand: ExpandFrom("group")}))
+}
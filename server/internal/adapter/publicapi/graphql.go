@@ -0,0 +1,552 @@
+package publicapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/labstack/echo/v4"
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+var ErrInvalidGraphQLRequest = rerror.NewE(i18n.T("invalid graphql request"))
+
+const (
+	// graphqlSchemaCacheSize is the number of projects whose GraphQL schemas are cached.
+	graphqlSchemaCacheSize = 256
+	// maxGraphQLRequestSize is the max size of the body of a GraphQL request.
+	maxGraphQLRequestSize = 1 << 20
+)
+
+var graphqlResolverCK = contextKey("graphql_resolver")
+
+// PublicApiGraphQL serves a read-only GraphQL api whose schema is generated from public models of the project.
+func PublicApiGraphQL(conf Config) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		ctrl := GetController(ctx)
+
+		req, err := graphqlRequestFromEchoContext(c)
+		if err != nil {
+			return err
+		}
+
+		s, r, err := ctrl.GraphQL(ctx, c.Param("project"), conf)
+		if err != nil {
+			return err
+		}
+
+		// values of localized fields are resolved for the locale given by the query parameter
+		if l, err := locale.Parse(c.QueryParam("locale")); err == nil {
+			r.locale = l
+		}
+
+		res := graphql.Do(graphql.Params{
+			Schema:         s.schema,
+			RequestString:  req.Query,
+			VariableValues: req.Variables,
+			OperationName:  req.OperationName,
+			Context:        context.WithValue(ctx, graphqlResolverCK, r),
+		})
+		return c.JSON(http.StatusOK, res)
+	}
+}
+
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func graphqlRequestFromEchoContext(c echo.Context) (graphqlRequest, error) {
+	var req graphqlRequest
+	if c.Request().Method == http.MethodGet {
+		req.Query = c.QueryParam("query")
+		req.OperationName = c.QueryParam("operationName")
+		if v := c.QueryParam("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				return req, ErrInvalidGraphQLRequest
+			}
+		}
+	} else {
+		if err := json.NewDecoder(io.LimitReader(c.Request().Body, maxGraphQLRequestSize)).Decode(&req); err != nil {
+			return req, ErrInvalidGraphQLRequest
+		}
+	}
+	if req.Query == "" {
+		return req, ErrInvalidGraphQLRequest
+	}
+	return req, nil
+}
+
+// GraphQL returns the GraphQL schema of the project, and a resolver which resolves a request with public items of the project.
+// The schema is generated again only when the public models or their fields are changed.
+func (c *Controller) GraphQL(ctx context.Context, prj string, conf Config) (*graphqlSchema, *graphqlResolver, error) {
+	pr, err := c.checkProject(ctx, prj)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s, err := c.findGraphQLSchema(ctx, pr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// draft items are resolved with a preview token of the project
+	ref := version.Public.Ref()
+	if t := adapter.PreviewToken(ctx); t != nil && t.Project() == pr.ID() {
+		ref = t.Ref().Ref()
+	}
+
+	return s, &graphqlResolver{
+		ctrl:     c,
+		project:  pr,
+		ref:      ref,
+		maxDepth: conf.maxExpandDepth(),
+		items:    map[id.ItemID]*item.Item{},
+		assets:   map[id.AssetID]*asset.Asset{},
+	}, nil
+}
+
+type cachedGraphQLSchema struct {
+	version string
+	schema  *graphqlSchema
+}
+
+func (c *Controller) findGraphQLSchema(ctx context.Context, pr *project.Project) (*graphqlSchema, error) {
+	models, _, err := c.usecases.Model.FindByProject(ctx, pr.ID(), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	models = models.Ordered()
+
+	packages := map[id.ModelID]*schema.Package{}
+	for _, m := range models {
+		if !m.Public() {
+			continue
+		}
+		sp, err := c.usecases.Schema.FindByModel(ctx, m.ID(), nil)
+		if err != nil {
+			return nil, err
+		}
+		packages[m.ID()] = sp
+	}
+	if len(packages) == 0 {
+		return nil, rerror.ErrNotFound
+	}
+
+	v := graphqlSchemaVersion(models, packages)
+	if cs, ok := c.graphqlSchemas.Get(pr.ID()); ok && cs.version == v {
+		return cs.schema, nil
+	}
+
+	s, err := newGraphQLSchema(models, packages)
+	if err != nil {
+		return nil, err
+	}
+	c.graphqlSchemas.Add(pr.ID(), cachedGraphQLSchema{version: v, schema: s})
+	return s, nil
+}
+
+// graphqlResolver resolves a GraphQL request. Loaded items and assets are cached while resolving the request.
+type graphqlResolver struct {
+	ctrl    *Controller
+	project *project.Project
+	locale  locale.Locale
+	// ref is the ref which items are read from
+	ref *version.Ref
+	// maxDepth is the max depth of nested references
+	maxDepth int
+	items    map[id.ItemID]*item.Item
+	assets   map[id.AssetID]*asset.Asset
+}
+
+func graphqlResolverFrom(ctx context.Context) *graphqlResolver {
+	return ctx.Value(graphqlResolverCK).(*graphqlResolver)
+}
+
+// graphqlItem is the source of an object of a model or a group. fields are the fields of the group when the object is a group.
+type graphqlItem struct {
+	item   *item.Item
+	fields item.Fields
+	depth  int
+}
+
+type graphqlList struct {
+	items      []graphqlItem
+	totalCount int64
+	hasMore    bool
+}
+
+type graphqlAsset struct {
+	id    id.AssetID
+	asset *asset.Asset
+}
+
+func (o *graphqlObject) resolveItem(p graphql.ResolveParams) (any, error) {
+	r := graphqlResolverFrom(p.Context)
+	iid, err := id.ItemIDFrom(graphqlString(p.Args["id"]))
+	if err != nil {
+		return nil, nil
+	}
+
+	it, err := r.findItem(p.Context, iid)
+	if err != nil || it == nil || it.Model() != o.model.ID() {
+		return nil, err
+	}
+
+	if err := r.loadAssets(p.Context, it.AssetIDs()); err != nil {
+		return nil, err
+	}
+	return graphqlItem{item: it, fields: it.Fields()}, nil
+}
+
+func (o *graphqlObject) resolveItemList(p graphql.ResolveParams) (any, error) {
+	r := graphqlResolverFrom(p.Context)
+
+	limit, _ := graphqlInt(p.Args["limit"])
+	if limit <= 0 {
+		limit = defaultLimit
+	} else if limit > maxLimit {
+		limit = maxLimit
+	}
+	offset, _ := graphqlInt(p.Args["offset"])
+	offset = max(offset, 0)
+	pagination := usecasex.OffsetPagination{Offset: offset, Limit: limit}.Wrap()
+
+	cond, err := o.condition(p.Args["filter"])
+	if err != nil {
+		return nil, err
+	}
+	so, err := o.sort(p.Args["sort"])
+	if err != nil {
+		return nil, err
+	}
+	keyword := graphqlString(p.Args["keyword"])
+
+	var items item.VersionedList
+	var pi *usecasex.PageInfo
+	if keyword == "" && cond == nil && so == nil {
+		items, pi, err = r.ctrl.usecases.Item.FindPublicByModel(p.Context, o.model.ID(), r.ref, pagination, adapter.Operator(p.Context))
+	} else {
+		s := o.schema.Schema()
+		q := item.NewQuery(s.Project(), o.model.ID(), s.ID().Ref(), keyword, r.ref).
+			WithSort(so).
+			WithFilter(cond)
+		items, pi, err = r.ctrl.usecases.Item.Search(p.Context, *o.schema, q, pagination, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pi == nil {
+		pi = &usecasex.PageInfo{TotalCount: int64(len(items))}
+	}
+
+	itms := items.Unwrap().Localize(r.project.Locales(), r.locale)
+	if err := r.loadAssets(p.Context, lo.FlatMap(itms, func(i *item.Item, _ int) []id.AssetID {
+		return i.AssetIDs()
+	})); err != nil {
+		return nil, err
+	}
+
+	return graphqlList{
+		items: lo.Map(itms, func(i *item.Item, _ int) graphqlItem {
+			r.items[i.ID()] = i
+			return graphqlItem{item: i, fields: i.Fields()}
+		}),
+		totalCount: pi.TotalCount,
+		hasMore:    offset+int64(len(itms)) < pi.TotalCount,
+	}, nil
+}
+
+func (f *graphqlField) resolve(p graphql.ResolveParams) (any, error) {
+	r := graphqlResolverFrom(p.Context)
+	src := p.Source.(graphqlItem)
+
+	itf := src.fields.Field(f.field.ID())
+	if itf == nil || itf.Value() == nil {
+		return nil, nil
+	}
+
+	// assets are hidden in the same way as the REST api when they are not public
+	if f.field.Type() == value.TypeAsset && !r.project.Publication().AssetPublic() {
+		return nil, nil
+	}
+
+	var res []any
+	for _, v := range itf.Value().Values() {
+		var rv any
+		switch f.field.Type() {
+		case value.TypeAsset:
+			aid, ok := v.ValueAsset()
+			if !ok {
+				continue
+			}
+			rv = graphqlAsset{id: aid, asset: r.assets[aid]}
+		case value.TypeReference:
+			iid, ok := v.ValueReference()
+			if !ok {
+				continue
+			}
+			if f.object == nil {
+				rv = iid.String()
+				break
+			}
+			if src.depth >= r.maxDepth {
+				return nil, ErrInvalidExpand
+			}
+			ri, err := r.findItem(p.Context, iid)
+			if err != nil {
+				return nil, err
+			}
+			if ri == nil || ri.Model() != f.object.model.ID() {
+				continue
+			}
+			if err := r.loadAssets(p.Context, ri.AssetIDs()); err != nil {
+				return nil, err
+			}
+			rv = graphqlItem{item: ri, fields: ri.Fields(), depth: src.depth + 1}
+		case value.TypeGroup:
+			igid, ok := v.ValueGroup()
+			if !ok {
+				continue
+			}
+			rv = graphqlItem{item: src.item, fields: src.fields.FieldsByGroup(igid), depth: src.depth}
+		case value.TypeGeometryObject, value.TypeGeometryEditor:
+			if s, ok := v.Interface().(string); ok && json.Valid([]byte(s)) {
+				rv = json.RawMessage(s)
+			} else {
+				rv = v.Interface()
+			}
+		default:
+			rv = v.Interface()
+		}
+		res = append(res, rv)
+	}
+
+	if f.field.Multiple() {
+		if res == nil {
+			return []any{}, nil
+		}
+		return res, nil
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+	return res[0], nil
+}
+
+func (r *graphqlResolver) findItem(ctx context.Context, iid id.ItemID) (*item.Item, error) {
+	if it, ok := r.items[iid]; ok {
+		return it, nil
+	}
+
+	it, err := r.ctrl.usecases.Item.FindPublicByID(ctx, iid, r.ref, adapter.Operator(ctx))
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			r.items[iid] = nil
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	itv := it.Value().Localize(r.project.Locales(), r.locale)
+	r.items[iid] = itv
+	return itv, nil
+}
+
+// loadAssets loads assets which are not cached yet. Assets are not loaded if they are not public.
+func (r *graphqlResolver) loadAssets(ctx context.Context, ids []id.AssetID) error {
+	if !r.project.Publication().AssetPublic() {
+		return nil
+	}
+
+	ids = lo.Uniq(lo.Filter(ids, func(aid id.AssetID, _ int) bool {
+		_, ok := r.assets[aid]
+		return !ok
+	}))
+	if len(ids) == 0 {
+		return nil
+	}
+
+	assets, err := r.ctrl.usecases.Asset.FindByIDs(ctx, ids, nil)
+	if err != nil {
+		return err
+	}
+	for _, aid := range ids {
+		r.assets[aid] = nil
+	}
+	for _, a := range assets {
+		if a != nil {
+			r.assets[a.ID()] = a
+		}
+	}
+	return nil
+}
+
+// condition converts a filter input of the object into a view condition.
+func (o *graphqlObject) condition(v any) (*view.Condition, error) {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) == 0 {
+		return nil, nil
+	}
+
+	var conds []view.Condition
+	for _, k := range sortedKeys(m) {
+		if m[k] == nil {
+			continue
+		}
+
+		if k == "and" || k == "or" {
+			l, ok := m[k].([]any)
+			if !ok {
+				return nil, ErrInvalidFilter
+			}
+			var children []view.Condition
+			for _, c := range l {
+				cond, err := o.condition(c)
+				if err != nil {
+					return nil, err
+				}
+				if cond != nil {
+					children = append(children, *cond)
+				}
+			}
+			if len(children) == 0 {
+				continue
+			}
+			if k == "and" {
+				conds = append(conds, view.Condition{
+					ConditionType: view.ConditionTypeAnd,
+					AndCondition:  &view.AndCondition{Conditions: children},
+				})
+			} else {
+				conds = append(conds, view.Condition{
+					ConditionType: view.ConditionTypeOr,
+					OrCondition:   &view.OrCondition{Conditions: children},
+				})
+			}
+			continue
+		}
+
+		gf := o.Field(k)
+		ops, ok := m[k].(map[string]any)
+		if gf == nil || !ok {
+			return nil, ErrInvalidFilter
+		}
+		for _, op := range sortedKeys(ops) {
+			if ops[op] == nil {
+				continue
+			}
+			p := FilterParam{Key: gf.field.Key().String(), Op: FilterOperator(op)}
+			if l, ok := ops[op].([]any); ok {
+				p.Values = lo.Map(l, func(v any, _ int) string { return graphqlString(v) })
+			} else {
+				p.Value = graphqlString(ops[op])
+			}
+			c, err := toCondition(gf.field, p)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, *c)
+		}
+	}
+
+	if len(conds) == 0 {
+		return nil, nil
+	}
+	if len(conds) == 1 {
+		return &conds[0], nil
+	}
+	return &view.Condition{
+		ConditionType: view.ConditionTypeAnd,
+		AndCondition:  &view.AndCondition{Conditions: conds},
+	}, nil
+}
+
+// sort converts a sort input of the object into a view sort.
+func (o *graphqlObject) sort(v any) (*view.Sort, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+
+	p := &SortParam{Desc: graphqlString(m["direction"]) == "DESC"}
+	switch f := graphqlString(m["field"]); f {
+	case gqlFieldCreatedAt:
+		p.Key = sortKeyCreatedAt
+	case gqlFieldUpdatedAt:
+		p.Key = sortKeyUpdatedAt
+	default:
+		gf := o.Field(f)
+		if gf == nil {
+			return nil, ErrInvalidSort
+		}
+		p.Key = gf.field.Key().String()
+	}
+	return toSort(o.schema.Schema(), p)
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := lo.Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
+func graphqlString(v any) string {
+	switch vv := v.(type) {
+	case string:
+		return vv
+	case bool:
+		return strconv.FormatBool(vv)
+	case int:
+		return strconv.Itoa(vv)
+	case int64:
+		return strconv.FormatInt(vv, 10)
+	case float64:
+		return strconv.FormatFloat(vv, 'f', -1, 64)
+	case json.Number:
+		return vv.String()
+	case time.Time:
+		return vv.Format(time.RFC3339)
+	case *time.Time:
+		if vv != nil {
+			return vv.Format(time.RFC3339)
+		}
+	}
+	return ""
+}
+
+func graphqlInt(v any) (int64, bool) {
+	switch vv := v.(type) {
+	case int:
+		return int64(vv), true
+	case int64:
+		return vv, true
+	case float64:
+		return int64(vv), true
+	case json.Number:
+		i, err := vv.Int64()
+		return i, err == nil
+	}
+	return 0, false
+}
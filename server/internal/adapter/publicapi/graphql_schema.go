@@ -0,0 +1,552 @@
+package publicapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/rerror"
+)
+
+const (
+	gqlTypeQuery          = "Query"
+	gqlTypeAsset          = "Asset"
+	gqlTypeDateTime       = "DateTime"
+	gqlTypeJSON           = "JSON"
+	gqlTypeSortDirection  = "SortDirection"
+	gqlTypeStringFilter   = "StringFilter"
+	gqlTypeNumberFilter   = "NumberFilter"
+	gqlTypeBooleanFilter  = "BooleanFilter"
+	gqlTypeDateTimeFilter = "DateTimeFilter"
+
+	gqlFieldID        = "id"
+	gqlFieldCreatedAt = "createdAt"
+	gqlFieldUpdatedAt = "updatedAt"
+	gqlFieldTypename  = "__typename"
+)
+
+var gqlNameRegexp = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// Types which do not depend on models are shared by schemas of all projects.
+var (
+	gqlJSON = graphql.NewScalar(graphql.ScalarConfig{
+		Name:        gqlTypeJSON,
+		Description: "A JSON value such as a GeoJSON geometry.",
+		Serialize:   func(v any) any { return v },
+		ParseValue:  func(v any) any { return v },
+		ParseLiteral: func(v ast.Value) any {
+			return v.GetValue()
+		},
+	})
+
+	gqlAsset = graphql.NewObject(graphql.ObjectConfig{
+		Name: gqlTypeAsset,
+		Fields: graphql.Fields{
+			gqlFieldID: &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(graphqlAsset).id.String(), nil
+				},
+			},
+			"url": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if a := p.Source.(graphqlAsset).asset; a != nil {
+						return a.AccessInfo().Url, nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+
+	gqlSortDirection = graphql.NewEnum(graphql.EnumConfig{
+		Name: gqlTypeSortDirection,
+		Values: graphql.EnumValueConfigMap{
+			"ASC":  &graphql.EnumValueConfig{Value: "ASC"},
+			"DESC": &graphql.EnumValueConfig{Value: "DESC"},
+		},
+	})
+
+	gqlStringFilter   = newGraphQLFilter(gqlTypeStringFilter, graphql.String, "eq", "ne", "contains")
+	gqlNumberFilter   = newGraphQLFilter(gqlTypeNumberFilter, graphql.Float, "eq", "ne", "lt", "lte", "gt", "gte")
+	gqlBooleanFilter  = newGraphQLFilter(gqlTypeBooleanFilter, graphql.Boolean, "eq", "ne")
+	gqlDateTimeFilter = newGraphQLFilter(gqlTypeDateTimeFilter, graphql.DateTime, "eq", "ne", "lt", "lte", "gt", "gte")
+)
+
+func newGraphQLFilter(name string, t graphql.Input, ops ...string) *graphql.InputObject {
+	fields := graphql.InputObjectConfigFieldMap{
+		"null": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+	}
+	for _, op := range ops {
+		fields[op] = &graphql.InputObjectFieldConfig{Type: t}
+	}
+	// booleans cannot be compared with multiple values
+	if t != graphql.Boolean && t != graphql.DateTime {
+		fields["in"] = &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(t))}
+	}
+	return graphql.NewInputObject(graphql.InputObjectConfig{Name: name, Fields: fields})
+}
+
+// graphqlSchema is a GraphQL schema generated from public models of a project.
+type graphqlSchema struct {
+	schema  graphql.Schema
+	objects []*graphqlObject
+}
+
+// graphqlObject is a GraphQL object type converted from a model or a group.
+type graphqlObject struct {
+	name        string
+	description string
+	// model is nil when the object is converted from a group
+	model  *model.Model
+	schema *schema.Package
+	fields []*graphqlField
+	object *graphql.Object
+}
+
+type graphqlField struct {
+	name  string
+	field *schema.Field
+	// object is a type of referenced items or a group. It is nil if the field is a scalar or the referenced model is not public.
+	object *graphqlObject
+}
+
+func (o *graphqlObject) Field(name string) *graphqlField {
+	for _, f := range o.fields {
+		if f.name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (o *graphqlObject) listName() string      { return o.name + "List" }
+func (o *graphqlObject) filterName() string    { return o.name + "Filter" }
+func (o *graphqlObject) sortName() string      { return o.name + "Sort" }
+func (o *graphqlObject) sortFieldName() string { return o.name + "SortField" }
+
+// newGraphQLSchema generates a GraphQL schema from models and their schemas.
+// Models or fields whose keys cannot be converted into unique GraphQL names are omitted.
+func newGraphQLSchema(models model.List, packages map[id.ModelID]*schema.Package) (*graphqlSchema, error) {
+	used := map[string]struct{}{}
+	for _, n := range []string{
+		gqlTypeQuery, gqlTypeAsset, gqlTypeDateTime, gqlTypeJSON, gqlTypeSortDirection,
+		gqlTypeStringFilter, gqlTypeNumberFilter, gqlTypeBooleanFilter, gqlTypeDateTimeFilter,
+		"String", "Int", "Float", "Boolean", "ID",
+	} {
+		used[n] = struct{}{}
+	}
+	reserve := func(names ...string) bool {
+		for _, n := range names {
+			if _, ok := used[n]; ok || !gqlNameRegexp.MatchString(n) || strings.HasPrefix(n, "__") {
+				return false
+			}
+		}
+		for _, n := range names {
+			used[n] = struct{}{}
+		}
+		return true
+	}
+
+	res := &graphqlSchema{}
+	queries := map[string]struct{}{}
+	byModel := map[id.ModelID]*graphqlObject{}
+	for _, m := range models {
+		if m == nil || !m.Public() {
+			continue
+		}
+		sp := packages[m.ID()]
+		if sp == nil || sp.Schema() == nil {
+			continue
+		}
+
+		o := &graphqlObject{
+			name:        graphqlTypeName(m.Key().String()),
+			description: m.Description(),
+			model:       m,
+			schema:      sp,
+		}
+		qn := lowerFirst(o.name)
+		if _, ok := queries[qn]; ok {
+			continue
+		}
+		if _, ok := queries[qn+"List"]; ok {
+			continue
+		}
+		if !reserve(o.name, o.listName(), o.filterName(), o.sortName(), o.sortFieldName()) {
+			continue
+		}
+
+		res.objects = append(res.objects, o)
+		queries[qn] = struct{}{}
+		queries[qn+"List"] = struct{}{}
+		byModel[m.ID()] = o
+	}
+	if len(res.objects) == 0 {
+		return nil, rerror.ErrNotFound
+	}
+
+	var groups []*graphqlObject
+	for _, o := range res.objects {
+		o.fields = graphqlFields(o, o.schema.Schema().Fields(), byModel, func(f *schema.Field, name string) *graphqlObject {
+			gs := o.schema.GroupSchema(fieldGroup(f))
+			g := &graphqlObject{
+				name:        o.name + upperFirst(name),
+				description: f.Description(),
+				schema:      o.schema,
+			}
+			if gs == nil {
+				return nil
+			}
+			// groups cannot be nested
+			g.fields = graphqlFields(g, gs.Fields(), byModel, nil)
+			if len(g.fields) == 0 || !reserve(g.name) {
+				return nil
+			}
+			groups = append(groups, g)
+			return g
+		})
+	}
+	res.objects = append(res.objects, groups...)
+
+	s, err := res.build()
+	if err != nil {
+		return nil, err
+	}
+	res.schema = s
+	return res, nil
+}
+
+func graphqlFields(o *graphqlObject, fields schema.FieldList, models map[id.ModelID]*graphqlObject, group func(*schema.Field, string) *graphqlObject) []*graphqlField {
+	used := map[string]struct{}{gqlFieldTypename: {}}
+	if o.model != nil {
+		used[gqlFieldID] = struct{}{}
+		used[gqlFieldCreatedAt] = struct{}{}
+		used[gqlFieldUpdatedAt] = struct{}{}
+	}
+
+	var res []*graphqlField
+	for _, f := range fields {
+		name := graphqlFieldName(f.Key().String())
+		if _, ok := used[name]; ok || !gqlNameRegexp.MatchString(name) || strings.HasPrefix(name, "__") {
+			continue
+		}
+
+		gf := &graphqlField{name: name, field: f}
+		switch f.Type() {
+		case value.TypeReference:
+			f.TypeProperty().Match(schema.TypePropertyMatch{
+				Reference: func(r *schema.FieldReference) { gf.object = models[r.Model()] },
+			})
+		case value.TypeGroup:
+			if group == nil {
+				continue
+			}
+			if gf.object = group(f, name); gf.object == nil {
+				continue
+			}
+		}
+
+		used[name] = struct{}{}
+		res = append(res, gf)
+	}
+	return res
+}
+
+func fieldGroup(f *schema.Field) id.GroupID {
+	var gid id.GroupID
+	f.TypeProperty().Match(schema.TypePropertyMatch{
+		Group: func(g *schema.FieldGroup) { gid = g.Group() },
+	})
+	return gid
+}
+
+// build builds the types of the objects. Fields are given as thunks because objects can reference each other.
+func (s *graphqlSchema) build() (graphql.Schema, error) {
+	for _, o := range s.objects {
+		o.object = graphql.NewObject(graphql.ObjectConfig{
+			Name:        o.name,
+			Description: o.description,
+			Fields:      graphql.FieldsThunk(o.outputFields),
+		})
+	}
+
+	query := graphql.Fields{}
+	for _, o := range s.objects {
+		if o.model == nil {
+			continue
+		}
+
+		list := graphql.NewObject(graphql.ObjectConfig{
+			Name: o.listName(),
+			Fields: graphql.Fields{
+				"results": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(o.object))),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return p.Source.(graphqlList).items, nil
+					},
+				},
+				"totalCount": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.Int),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return p.Source.(graphqlList).totalCount, nil
+					},
+				},
+				"hasMore": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.Boolean),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return p.Source.(graphqlList).hasMore, nil
+					},
+				},
+			},
+		})
+
+		n := lowerFirst(o.name)
+		query[n] = &graphql.Field{
+			Type: o.object,
+			Args: graphql.FieldConfigArgument{
+				"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+			},
+			Resolve: o.resolveItem,
+		}
+		query[n+"List"] = &graphql.Field{
+			Type: list,
+			Args: graphql.FieldConfigArgument{
+				"keyword": &graphql.ArgumentConfig{Type: graphql.String},
+				"filter":  &graphql.ArgumentConfig{Type: o.filterInput()},
+				"sort":    &graphql.ArgumentConfig{Type: o.sortInput()},
+				"limit":   &graphql.ArgumentConfig{Type: graphql.Int},
+				"offset":  &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: o.resolveItemList,
+		}
+	}
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   gqlTypeQuery,
+			Fields: query,
+		}),
+	})
+}
+
+func (o *graphqlObject) outputFields() graphql.Fields {
+	res := graphql.Fields{}
+	if o.model != nil {
+		res[gqlFieldID] = &graphql.Field{
+			Type: graphql.NewNonNull(graphql.ID),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return p.Source.(graphqlItem).item.ID().String(), nil
+			},
+		}
+		res[gqlFieldCreatedAt] = &graphql.Field{
+			Type: graphql.NewNonNull(graphql.DateTime),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return p.Source.(graphqlItem).item.ID().Timestamp(), nil
+			},
+		}
+		res[gqlFieldUpdatedAt] = &graphql.Field{
+			Type: graphql.NewNonNull(graphql.DateTime),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return p.Source.(graphqlItem).item.Timestamp(), nil
+			},
+		}
+	}
+	for _, f := range o.fields {
+		res[f.name] = &graphql.Field{
+			Type:        f.outputType(),
+			Description: f.field.Description(),
+			Resolve:     f.resolve,
+		}
+	}
+	return res
+}
+
+func (o *graphqlObject) filterInput() *graphql.InputObject {
+	var filter *graphql.InputObject
+	filter = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: o.filterName(),
+		Fields: graphql.InputObjectConfigFieldMapThunk(func() graphql.InputObjectConfigFieldMap {
+			res := graphql.InputObjectConfigFieldMap{
+				"and": &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(filter))},
+				"or":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(filter))},
+			}
+			for _, f := range o.fields {
+				if t := f.filterType(); t != nil && f.name != "and" && f.name != "or" {
+					res[f.name] = &graphql.InputObjectFieldConfig{Type: t}
+				}
+			}
+			return res
+		}),
+	})
+	return filter
+}
+
+func (o *graphqlObject) sortInput() *graphql.InputObject {
+	values := graphql.EnumValueConfigMap{
+		gqlFieldCreatedAt: &graphql.EnumValueConfig{Value: gqlFieldCreatedAt},
+		gqlFieldUpdatedAt: &graphql.EnumValueConfig{Value: gqlFieldUpdatedAt},
+	}
+	for _, f := range o.fields {
+		// true, false and null cannot be enum values
+		if f.filterType() != nil && f.name != "true" && f.name != "false" && f.name != "null" {
+			values[f.name] = &graphql.EnumValueConfig{Value: f.name}
+		}
+	}
+
+	return graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: o.sortName(),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"field": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.NewEnum(graphql.EnumConfig{
+					Name:   o.sortFieldName(),
+					Values: values,
+				})),
+			},
+			"direction": &graphql.InputObjectFieldConfig{Type: gqlSortDirection},
+		},
+	})
+}
+
+func (f *graphqlField) outputType() graphql.Output {
+	var t graphql.Output
+	switch f.field.Type() {
+	case value.TypeInteger:
+		t = graphql.Int
+	case value.TypeNumber:
+		t = graphql.Float
+	case value.TypeBool, value.TypeCheckbox:
+		t = graphql.Boolean
+	case value.TypeDateTime:
+		t = graphql.DateTime
+	case value.TypeAsset:
+		t = gqlAsset
+	case value.TypeGeometryObject, value.TypeGeometryEditor:
+		t = gqlJSON
+	case value.TypeReference, value.TypeGroup:
+		if f.object != nil {
+			t = f.object.object
+		} else {
+			t = graphql.ID
+		}
+	default:
+		t = graphql.String
+	}
+
+	if f.field.Multiple() {
+		return graphql.NewList(graphql.NewNonNull(t))
+	}
+	return t
+}
+
+func (f *graphqlField) filterType() *graphql.InputObject {
+	t := f.field.Type()
+	switch {
+	case !isFilterableType(t):
+		return nil
+	case isStringType(t):
+		return gqlStringFilter
+	case t == value.TypeInteger || t == value.TypeNumber:
+		return gqlNumberFilter
+	case t == value.TypeBool || t == value.TypeCheckbox:
+		return gqlBooleanFilter
+	case t == value.TypeDateTime:
+		return gqlDateTimeFilter
+	}
+	return nil
+}
+
+// graphqlSchemaVersion returns a hash of the models and the fields which the GraphQL schema is generated from.
+// The schema is generated again only when the hash changes.
+func graphqlSchemaVersion(models model.List, packages map[id.ModelID]*schema.Package) string {
+	h := sha256.New()
+	writeFields := func(fields schema.FieldList) {
+		for _, f := range fields {
+			var ref string
+			f.TypeProperty().Match(schema.TypePropertyMatch{
+				Reference: func(r *schema.FieldReference) { ref = r.Model().String() },
+				Group:     func(g *schema.FieldGroup) { ref = g.Group().String() },
+			})
+			_, _ = fmt.Fprintf(h, "%s\x00%s\x00%s\x00%t\x00%q\x00%s\x00", f.ID(), f.Key(), f.Type(), f.Multiple(), f.Description(), ref)
+		}
+	}
+
+	for _, m := range models {
+		if m == nil || !m.Public() {
+			continue
+		}
+		sp := packages[m.ID()]
+		if sp == nil || sp.Schema() == nil {
+			continue
+		}
+		_, _ = fmt.Fprintf(h, "%s\x00%s\x00%q\x00", m.ID(), m.Key(), m.Description())
+		writeFields(sp.Schema().Fields())
+		for _, f := range sp.Schema().FieldsByType(value.TypeGroup) {
+			if gs := sp.GroupSchema(fieldGroup(f)); gs != nil {
+				writeFields(gs.Fields())
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// graphqlTypeName converts a model key into a GraphQL type name. e.g. "blog-post" -> "BlogPost"
+func graphqlTypeName(key string) string {
+	words := splitGraphQLName(key)
+	for i, w := range words {
+		words[i] = upperFirst(w)
+	}
+	return graphqlName(strings.Join(words, ""))
+}
+
+// graphqlFieldName converts a field key into a GraphQL field name.
+// A valid GraphQL name is used as it is, otherwise it is converted into camel case. e.g. "first-name" -> "firstName"
+func graphqlFieldName(key string) string {
+	if gqlNameRegexp.MatchString(key) {
+		return key
+	}
+	words := splitGraphQLName(key)
+	for i, w := range words {
+		if i > 0 {
+			words[i] = upperFirst(w)
+		}
+	}
+	return graphqlName(strings.Join(words, ""))
+}
+
+func splitGraphQLName(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r))
+	})
+}
+
+func graphqlName(s string) string {
+	if s != "" && unicode.IsDigit(rune(s[0])) {
+		return "_" + s
+	}
+	return s
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,300 @@
+package publicapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/labstack/echo/v4"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/group"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLTypeName(t *testing.T) {
+	assert.Equal(t, "Post", graphqlTypeName("post"))
+	assert.Equal(t, "BlogPost", graphqlTypeName("blog-post"))
+	assert.Equal(t, "BlogPost", graphqlTypeName("blog_post"))
+	assert.Equal(t, "_1post", graphqlTypeName("1post"))
+	assert.Equal(t, "", graphqlTypeName("-"))
+}
+
+func TestGraphQLFieldName(t *testing.T) {
+	assert.Equal(t, "title", graphqlFieldName("title"))
+	assert.Equal(t, "first_name", graphqlFieldName("first_name"))
+	assert.Equal(t, "firstName", graphqlFieldName("first-name"))
+	assert.Equal(t, "_1st", graphqlFieldName("1st"))
+}
+
+func TestNewGraphQLSchema(t *testing.T) {
+	pid := id.NewProjectID()
+	wid := accountdomain.NewWorkspaceID()
+
+	am := model.New().NewID().Project(pid).Schema(id.NewSchemaID()).Key(id.NewKey("author")).Public(true).MustBuild()
+	as := schema.New().ID(am.Schema()).Project(pid).Workspace(wid).Fields(schema.FieldList{
+		schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild(),
+	}).MustBuild()
+
+	gs := schema.New().NewID().Project(pid).Workspace(wid).Fields(schema.FieldList{
+		schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("heading")).MustBuild(),
+	}).MustBuild()
+	gid := id.NewGroupID()
+
+	pm := model.New().NewID().Project(pid).Schema(id.NewSchemaID()).Key(id.NewKey("blog-post")).Description("posts").Public(true).MustBuild()
+	ps := schema.New().ID(pm.Schema()).Project(pid).Workspace(wid).Fields(schema.FieldList{
+		schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).Description(`"title"`).MustBuild(),
+		schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("view-count")).MustBuild(),
+		schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("createdAt")).MustBuild(),
+		schema.NewField(schema.NewBool().TypeProperty()).NewID().Key(id.NewKey("tags")).Multiple(true).MustBuild(),
+		schema.NewField(schema.NewReference(am.ID(), am.Schema(), nil, nil).TypeProperty()).NewID().Key(id.NewKey("author")).MustBuild(),
+		schema.NewField(schema.NewReference(id.NewModelID(), id.NewSchemaID(), nil, nil).TypeProperty()).NewID().Key(id.NewKey("private")).MustBuild(),
+		schema.NewField(schema.NewGroup(gid).TypeProperty()).NewID().Key(id.NewKey("sections")).Multiple(true).MustBuild(),
+	}).MustBuild()
+
+	// not public
+	nm := model.New().NewID().Project(pid).Schema(id.NewSchemaID()).Key(id.NewKey("draft")).MustBuild()
+	// the name conflicts with a type of the blog-post model
+	cm := model.New().NewID().Project(pid).Schema(id.NewSchemaID()).Key(id.NewKey("blog-post-list")).Public(true).MustBuild()
+
+	s, err := newGraphQLSchema(model.List{pm, am, nm, cm}, map[id.ModelID]*schema.Package{
+		pm.ID(): schema.NewPackage(ps, nil, map[id.GroupID]*schema.Schema{gid: gs}, nil),
+		am.ID(): schema.NewPackage(as, nil, nil, nil),
+		nm.ID(): schema.NewPackage(as, nil, nil, nil),
+		cm.ID(): schema.NewPackage(as, nil, nil, nil),
+	})
+	require.NoError(t, err)
+
+	q := s.schema.QueryType().Fields()
+	assert.NotNil(t, q["blogPost"])
+	assert.Equal(t, "BlogPostList", q["blogPostList"].Type.String())
+	assert.NotNil(t, q["author"])
+	assert.Nil(t, q["draft"])
+	assert.Nil(t, q["blogPostListList"])
+
+	post := s.schema.Type("BlogPost").(*graphql.Object)
+	assert.Equal(t, "posts", post.Description())
+	assert.ElementsMatch(t, []string{"id", "createdAt", "updatedAt", "title", "viewCount", "tags", "author", "private", "sections"}, lo.Keys(post.Fields()))
+	assert.Equal(t, `"title"`, post.Fields()["title"].Description)
+	assert.Equal(t, "Int", post.Fields()["viewCount"].Type.String())
+	assert.Equal(t, "[Boolean!]", post.Fields()["tags"].Type.String())
+	assert.Equal(t, "Author", post.Fields()["author"].Type.String())
+	assert.Equal(t, "ID", post.Fields()["private"].Type.String())
+	assert.Equal(t, "[BlogPostSections!]", post.Fields()["sections"].Type.String())
+	assert.Equal(t, []string{"heading"}, lo.Keys(s.schema.Type("BlogPostSections").(*graphql.Object).Fields()))
+
+	filter := s.schema.Type("BlogPostFilter").(*graphql.InputObject).Fields()
+	assert.ElementsMatch(t, []string{"and", "or", "title", "viewCount", "tags", "author", "private"}, lo.Keys(filter))
+	assert.Equal(t, "NumberFilter", filter["viewCount"].Type.String())
+
+	// no models can be converted
+	_, err = newGraphQLSchema(model.List{nm}, map[id.ModelID]*schema.Package{
+		nm.ID(): schema.NewPackage(as, nil, nil, nil),
+	})
+	assert.Same(t, rerror.ErrNotFound, err)
+}
+
+func TestGraphQLSchemaVersion(t *testing.T) {
+	pid := id.NewProjectID()
+	wid := accountdomain.NewWorkspaceID()
+	m := model.New().NewID().Project(pid).Schema(id.NewSchemaID()).Key(id.NewKey("post")).Public(true).MustBuild()
+	f := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild()
+	s := schema.New().ID(m.Schema()).Project(pid).Workspace(wid).Fields(schema.FieldList{f}).MustBuild()
+	packages := map[id.ModelID]*schema.Package{m.ID(): schema.NewPackage(s, nil, nil, nil)}
+
+	v := graphqlSchemaVersion(model.List{m}, packages)
+	assert.Equal(t, v, graphqlSchemaVersion(model.List{m}, packages))
+
+	s.Field(f.ID()).SetDescription("title of the post")
+	v2 := graphqlSchemaVersion(model.List{m}, packages)
+	assert.NotEqual(t, v, v2)
+
+	m.SetPublic(false)
+	assert.NotEqual(t, v2, graphqlSchemaVersion(model.List{m}, packages))
+}
+
+func TestPublicApiGraphQL(t *testing.T) {
+	ctx := context.Background()
+	r := memory.New()
+	wid := accountdomain.NewWorkspaceID()
+
+	pr := project.New().NewID().Workspace(wid).Alias("project-alias").Publication(project.NewPublication(project.PublicationScopePublic, false)).MustBuild()
+	pid := pr.ID()
+
+	am := model.New().NewID().Project(pid).Schema(id.NewSchemaID()).Key(id.NewKey("author")).Public(true).MustBuild()
+	as := schema.New().ID(am.Schema()).Project(pid).Workspace(wid).Fields(schema.FieldList{
+		schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild(),
+	}).MustBuild()
+
+	gs := schema.New().NewID().Project(pid).Workspace(wid).Fields(schema.FieldList{
+		schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("heading")).MustBuild(),
+	}).MustBuild()
+	g := group.New().NewID().Name("section").Project(pid).Key(id.NewKey("section")).Schema(gs.ID()).MustBuild()
+
+	pm := model.New().NewID().Project(pid).Schema(id.NewSchemaID()).Key(id.NewKey("post")).Public(true).MustBuild()
+	ps := schema.New().ID(pm.Schema()).Project(pid).Workspace(wid).Fields(schema.FieldList{
+		schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild(),
+		schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("count")).MustBuild(),
+		schema.NewField(schema.NewReference(am.ID(), am.Schema(), nil, nil).TypeProperty()).NewID().Key(id.NewKey("author")).MustBuild(),
+		schema.NewField(schema.NewGroup(g.ID()).TypeProperty()).NewID().Key(id.NewKey("sections")).Multiple(true).MustBuild(),
+		schema.NewField(schema.NewAsset().TypeProperty()).NewID().Key(id.NewKey("image")).MustBuild(),
+	}).MustBuild()
+
+	a := item.New().NewID().Project(pid).Model(am.ID()).Schema(as.ID()).Thread(id.NewThreadID().Ref()).Fields([]*item.Field{
+		item.NewField(as.Fields()[0].ID(), value.New(value.TypeText, "alice").AsMultiple(), nil),
+	}).MustBuild()
+	ig := id.NewItemGroupID()
+	p1 := item.New().NewID().Project(pid).Model(pm.ID()).Schema(ps.ID()).Thread(id.NewThreadID().Ref()).Fields([]*item.Field{
+		item.NewField(ps.Fields()[0].ID(), value.New(value.TypeText, "hello").AsMultiple(), nil),
+		item.NewField(ps.Fields()[1].ID(), value.New(value.TypeInteger, 10).AsMultiple(), nil),
+		item.NewField(ps.Fields()[2].ID(), value.New(value.TypeReference, a.ID()).AsMultiple(), nil),
+		item.NewField(ps.Fields()[3].ID(), value.New(value.TypeGroup, ig).AsMultiple(), nil),
+		item.NewField(gs.Fields()[0].ID(), value.New(value.TypeText, "intro").AsMultiple(), ig.Ref()),
+		item.NewField(ps.Fields()[4].ID(), value.New(value.TypeAsset, id.NewAssetID()).AsMultiple(), nil),
+	}).MustBuild()
+	// not published
+	p2 := item.New().NewID().Project(pid).Model(pm.ID()).Schema(ps.ID()).Thread(id.NewThreadID().Ref()).Fields([]*item.Field{
+		item.NewField(ps.Fields()[0].ID(), value.New(value.TypeText, "draft").AsMultiple(), nil),
+	}).MustBuild()
+
+	require.NoError(t, r.Project.Save(ctx, pr))
+	require.NoError(t, r.Model.Save(ctx, am))
+	require.NoError(t, r.Model.Save(ctx, pm))
+	require.NoError(t, r.Schema.Save(ctx, as))
+	require.NoError(t, r.Schema.Save(ctx, ps))
+	require.NoError(t, r.Schema.Save(ctx, gs))
+	require.NoError(t, r.Group.Save(ctx, g))
+	for _, it := range []*item.Item{a, p1, p2} {
+		require.NoError(t, r.Item.Save(ctx, it))
+	}
+	for _, it := range []*item.Item{a, p1} {
+		require.NoError(t, r.Item.UpdateRef(ctx, it.ID(), version.Public, version.Latest.OrVersion().Ref()))
+	}
+
+	gw := &gateway.Container{}
+	ctrl := NewController(r.Project, &interfaces.Container{
+		Item:   interactor.NewItem(r, gw),
+		Model:  interactor.NewModel(r, gw),
+		Schema: interactor.NewSchema(r, gw),
+		Asset:  interactor.NewAsset(r, gw),
+	})
+
+	query := func(t *testing.T, q string) map[string]any {
+		t.Helper()
+		body, _ := json.Marshal(map[string]any{"query": q})
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(string(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req = req.WithContext(AttachController(req.Context(), ctrl))
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("project")
+		c.SetParamValues("project-alias")
+
+		require.NoError(t, PublicApiGraphQL(Config{})(c))
+		var res map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		return res
+	}
+
+	res := query(t, `{
+		postList(sort: {field: count, direction: DESC}) {
+			totalCount
+			hasMore
+			results { id title count author { __typename name } sections { heading } }
+		}
+	}`)
+	assert.Nil(t, res["errors"])
+	assert.Equal(t, map[string]any{
+		"postList": map[string]any{
+			"totalCount": float64(1),
+			"hasMore":    false,
+			"results": []any{
+				map[string]any{
+					"id":       p1.ID().String(),
+					"title":    "hello",
+					"count":    float64(10),
+					"author":   map[string]any{"__typename": "Author", "name": "alice"},
+					"sections": []any{map[string]any{"heading": "intro"}},
+				},
+			},
+		},
+	}, res["data"])
+
+	// assets of the project are not public
+	res = query(t, `{ post(id: "`+p1.ID().String()+`") { image { id url } } }`)
+	assert.Nil(t, res["errors"])
+	assert.Equal(t, map[string]any{"post": map[string]any{"image": nil}}, res["data"])
+
+	res = query(t, `{ post(id: "`+p1.ID().String()+`") { t: title } draft: post(id: "`+p2.ID().String()+`") { title } }`)
+	assert.Equal(t, map[string]any{
+		"post":  map[string]any{"t": "hello"},
+		"draft": nil,
+	}, res["data"])
+
+	res = query(t, `{ postList(filter: {or: [{title: {eq: "hello"}}, {count: {gt: 100}}]}) { totalCount } }`)
+	assert.Equal(t, map[string]any{"postList": map[string]any{"totalCount": float64(1)}}, res["data"])
+
+	res = query(t, `{ __type(name: "Post") { name fields { name } } }`)
+	assert.Equal(t, map[string]any{
+		"__type": map[string]any{
+			"name": "Post",
+			// fields are sorted by their names
+			"fields": []any{
+				map[string]any{"name": "author"},
+				map[string]any{"name": "count"},
+				map[string]any{"name": "createdAt"},
+				map[string]any{"name": "id"},
+				map[string]any{"name": "image"},
+				map[string]any{"name": "sections"},
+				map[string]any{"name": "title"},
+				map[string]any{"name": "updatedAt"},
+			},
+		},
+	}, res["data"])
+
+	// the schema is generated once
+	assert.Equal(t, 1, ctrl.graphqlSchemas.Len())
+	cs, _ := ctrl.graphqlSchemas.Get(pid)
+	s, err := ctrl.findGraphQLSchema(ctx, pr)
+	require.NoError(t, err)
+	assert.Same(t, cs.schema, s)
+
+	res = query(t, `mutation { post(id: "x") { title } }`)
+	assert.NotNil(t, res["errors"])
+}
+
+func TestGraphQLRequestFromEchoContext(t *testing.T) {
+	newContext := func(req *http.Request) echo.Context {
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	req, err := graphqlRequestFromEchoContext(newContext(httptest.NewRequest(http.MethodGet, `/?query={a}&variables={"x":1}`, nil)))
+	require.NoError(t, err)
+	assert.Equal(t, graphqlRequest{Query: "{a}", Variables: map[string]any{"x": float64(1)}}, req)
+
+	req, err = graphqlRequestFromEchoContext(newContext(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"query":"{a}","operationName":"A"}`))))
+	require.NoError(t, err)
+	assert.Equal(t, graphqlRequest{Query: "{a}", OperationName: "A"}, req)
+
+	_, err = graphqlRequestFromEchoContext(newContext(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{`))))
+	assert.Same(t, ErrInvalidGraphQLRequest, err)
+	_, err = graphqlRequestFromEchoContext(newContext(httptest.NewRequest(http.MethodGet, "/", nil)))
+	assert.Same(t, ErrInvalidGraphQLRequest, err)
+}
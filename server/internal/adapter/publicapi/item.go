@@ -3,180 +3,218 @@ package publicapi
 import (
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/adapter"
-	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
-	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/rerror"
-	"github.com/reearth/reearthx/util"
-	"github.com/samber/lo"
+	"github.com/reearth/reearthx/usecasex"
 )
 
-func (c *Controller) GetItem(ctx context.Context, prj, mkey, i string) (Item, error) {
+func (c *Controller) GetItem(ctx context.Context, prj, mkey, i string, p ItemParam) (Item, Cache, error) {
 	pr, err := c.checkProject(ctx, prj)
 	if err != nil {
-		return Item{}, err
+		return Item{}, Cache{}, err
 	}
 
 	if mkey == "" {
-		return Item{}, rerror.ErrNotFound
+		return Item{}, Cache{}, rerror.ErrNotFound
 	}
 
 	iid, err := id.ItemIDFrom(i)
 	if err != nil {
-		return Item{}, rerror.ErrNotFound
+		return Item{}, Cache{}, rerror.ErrNotFound
 	}
 
-	it, err := c.usecases.Item.FindPublicByID(ctx, iid, nil)
+	if err := c.findRef(ctx, pr, &p); err != nil {
+		return Item{}, Cache{}, err
+	}
+
+	it, err := c.usecases.Item.FindPublicByID(ctx, iid, p.ref, adapter.Operator(ctx))
 	if err != nil {
 		if errors.Is(err, rerror.ErrNotFound) {
-			return Item{}, rerror.ErrNotFound
+			return Item{}, Cache{}, rerror.ErrNotFound
 		}
-		return Item{}, err
+		return Item{}, Cache{}, err
 	}
 
 	itv := it.Value()
 	m, err := c.usecases.Model.FindByID(ctx, itv.Model(), nil)
 	if err != nil {
-		return Item{}, err
+		return Item{}, Cache{}, err
 	}
 
 	if m.Key().String() != mkey || !m.Public() {
-		return Item{}, rerror.ErrNotFound
+		return Item{}, Cache{}, rerror.ErrNotFound
 	}
 
 	sp, err := c.usecases.Schema.FindByModel(ctx, m.ID(), nil)
 	if err != nil {
-		return Item{}, err
+		return Item{}, Cache{}, err
 	}
 
-	var assets asset.List
-	if pr.Publication().AssetPublic() {
-		assets, err = c.usecases.Asset.FindByIDs(ctx, itv.AssetIDs(), nil)
-		if err != nil {
-			return Item{}, err
-		}
+	p.locales = pr.Locales()
+	res, x, err := c.newItems(ctx, item.List{itv}, sp, p, pr.Publication().AssetPublic())
+	if err != nil {
+		return Item{}, Cache{}, err
 	}
 
-	return NewItem(itv, sp, assets, getReferencedItems(ctx, itv, pr.Publication().AssetPublic())), nil
+	return res[0], p.cache(x.cache(NewCache(pr.Publication(), itv.Timestamp(), p.CacheKey, it.Version().String()))), nil
 }
 
-func (c *Controller) GetItems(ctx context.Context, prj, model string, p ListParam) (ListResult[Item], *schema.Schema, error) {
-	pr, err := c.checkProject(ctx, prj)
+func (c *Controller) GetItems(ctx context.Context, prj, model string, p ListParam) (ListResult[Item], Cache, error) {
+	r, err := c.findPublicItems(ctx, prj, model, p)
 	if err != nil {
-		return ListResult[Item]{}, nil, err
+		return ListResult[Item]{}, Cache{}, err
 	}
 
-	m, err := c.usecases.Model.FindByKey(ctx, pr.ID(), model, nil)
+	p.locales = r.project.Locales()
+	itms, x, err := c.newItems(ctx, r.items.Unwrap(), r.schema, p.ItemParam, r.project.Publication().AssetPublic())
 	if err != nil {
-		return ListResult[Item]{}, nil, err
-	}
-	if !m.Public() {
-		return ListResult[Item]{}, nil, rerror.ErrNotFound
+		return ListResult[Item]{}, Cache{}, err
 	}
 
-	sp, err := c.usecases.Schema.FindByModel(ctx, m.ID(), nil)
-	if err != nil {
-		return ListResult[Item]{}, nil, err
-	}
+	return NewListResult(itms, r.pageInfo, p.Pagination), x.cache(r.cache(p)), nil
+}
 
-	items, pi, err := c.usecases.Item.FindPublicByModel(ctx, m.ID(), p.Pagination, nil)
+func (c *Controller) GetVersionedItems(ctx context.Context, prj, model string, p ListParam) (item.VersionedList, *schema.Schema, Cache, error) {
+	r, err := c.findPublicItems(ctx, prj, model, p)
 	if err != nil {
-		return ListResult[Item]{}, nil, err
+		return item.VersionedList{}, nil, Cache{}, err
 	}
 
-	var assets asset.List
-	if pr.Publication().AssetPublic() {
-		assetIDs := lo.FlatMap(items.Unwrap(), func(i *item.Item, _ int) []id.AssetID {
-			return i.AssetIDs()
-		})
-		assets, err = c.usecases.Asset.FindByIDs(ctx, assetIDs, nil)
-		if err != nil {
-			return ListResult[Item]{}, nil, err
-		}
-	}
+	return r.items.Localize(r.project.Locales(), p.Locale), r.schema.Schema(), r.cache(p), nil
+}
 
-	itms, err := util.TryMap(items.Unwrap(), func(i *item.Item) (Item, error) {
+type publicItems struct {
+	project      *project.Project
+	schema       *schema.Package
+	items        item.VersionedList
+	pageInfo     *usecasex.PageInfo
+	lastModified time.Time
+}
 
-		if err != nil {
-			return Item{}, err
-		}
-		return NewItem(i, sp, assets, getReferencedItems(ctx, i, pr.Publication().AssetPublic())), nil
-	})
-	if err != nil {
-		return ListResult[Item]{}, nil, err
+// cache returns the cache of the item list.
+// Versions of the listed items are also used for the ETag because publishing or unpublishing items does not change the last modification time of the model.
+func (r publicItems) cache(p ListParam) Cache {
+	keys := []string{p.CacheKey, r.lastModified.String(), strconv.FormatInt(r.pageInfo.TotalCount, 10)}
+	for _, i := range r.items {
+		keys = append(keys, i.Version().String())
 	}
-
-	res := NewListResult(itms, pi, p.Pagination)
-	return res, sp.Schema(), nil
+	return p.cache(NewCache(r.project.Publication(), r.lastModified, keys...))
 }
 
-func (c *Controller) GetVersionedItems(ctx context.Context, prj, model string, p ListParam) (item.VersionedList, *schema.Schema, error) {
+func (c *Controller) findPublicItems(ctx context.Context, prj, model string, p ListParam) (publicItems, error) {
 	pr, err := c.checkProject(ctx, prj)
 	if err != nil {
-		return item.VersionedList{}, nil, err
+		return publicItems{}, err
+	}
+
+	if err := c.findRef(ctx, pr, &p.ItemParam); err != nil {
+		return publicItems{}, err
 	}
 
 	m, err := c.usecases.Model.FindByKey(ctx, pr.ID(), model, nil)
 	if err != nil {
-		return item.VersionedList{}, nil, err
+		return publicItems{}, err
 	}
 	if !m.Public() {
-		return item.VersionedList{}, nil, rerror.ErrNotFound
+		return publicItems{}, rerror.ErrNotFound
 	}
 
 	sp, err := c.usecases.Schema.FindByModel(ctx, m.ID(), nil)
 	if err != nil {
-		return item.VersionedList{}, nil, err
+		return publicItems{}, err
 	}
 
-	items, _, err := c.usecases.Item.FindPublicByModel(ctx, m.ID(), p.Pagination, nil)
+	items, pi, err := c.findItems(ctx, m.ID(), sp, p)
 	if err != nil {
-		return item.VersionedList{}, nil, err
+		return publicItems{}, err
+	}
+	if pi == nil {
+		pi = &usecasex.PageInfo{TotalCount: int64(len(items))}
 	}
 
-	return items, sp.Schema(), nil
+	lastModified, err := c.usecases.Item.LastModifiedByModel(ctx, m.ID(), nil)
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return publicItems{}, err
+	}
+
+	return publicItems{
+		project:      pr,
+		schema:       sp,
+		items:        items,
+		pageInfo:     pi,
+		lastModified: lastModified,
+	}, nil
 }
 
-func getReferencedItems(ctx context.Context, i *item.Item, prp bool) []Item {
-	op := adapter.Operator(ctx)
-	uc := adapter.Usecases(ctx)
+func (c *Controller) findItems(ctx context.Context, mid id.ModelID, sp *schema.Package, p ListParam) (item.VersionedList, *usecasex.PageInfo, error) {
+	if !p.HasQuery() {
+		return c.usecases.Item.FindPublicByModel(ctx, mid, p.ref, p.Pagination, adapter.Operator(ctx))
+	}
 
-	if i == nil {
-		return nil
+	q, err := p.Query(mid, sp.Schema())
+	if err != nil {
+		return nil, nil, err
 	}
+	return c.usecases.Item.Search(ctx, *sp, q, p.Pagination, nil)
+}
 
-	var vi []Item
-	for _, f := range i.Fields() {
-		if f.Type() != value.TypeReference {
-			continue
-		}
-		for _, v := range f.Value().Values() {
-			iid, ok := v.Value().(id.ItemID)
-			if !ok {
-				continue
-			}
-			ii, err := uc.Item.FindByID(ctx, iid, op)
-			if err != nil || ii == nil {
-				continue
-			}
-			sp, err := uc.Schema.FindByModel(ctx, ii.Value().Model(), op)
-			if err != nil {
-				continue
-			}
-			var assets asset.List
-			if prp {
-				assets, err = uc.Asset.FindByIDs(ctx, ii.Value().AssetIDs(), nil)
-				if err != nil {
-					continue
-				}
-			}
-			vi = append(vi, NewItem(ii.Value(), sp, assets, nil))
+// findRef sets the ref which items are read from to the param: the snapshot of the public ref at the time, the ref of the preview token, or the ref of the release to preview.
+// Releases contain unpublished versions, so they can be previewed only by operators who can read the project.
+func (c *Controller) findRef(ctx context.Context, pr *project.Project, p *ItemParam) error {
+	if at, err := p.at(); err != nil {
+		return err
+	} else if at != nil {
+		p.ref = version.Public.At(*at).Ref()
+		return nil
+	}
+	if t := adapter.PreviewToken(ctx); t != nil {
+		if t.Project() != pr.ID() {
+			return rerror.ErrNotFound
 		}
+		p.ref = t.Ref().Ref()
+	}
+	if p.Release == "" {
+		return nil
 	}
+	if !canPreview(ctx, pr.ID()) {
+		return rerror.ErrNotFound
+	}
+
+	r, err := c.usecases.Release.FindByName(ctx, pr.ID(), p.Release, nil)
+	if err != nil {
+		return err
+	}
+	p.ref = r.Ref().Ref()
+	return nil
+}
 
-	return vi
+// canPreview reports whether the request can preview the unpublished content of the project.
+// The public API token does not allow it as it is shared with everyone who reads the published content,
+// so a preview token of the whole project, or the credentials of a user or an integration, are required.
+func canPreview(ctx context.Context, pid id.ProjectID) bool {
+	if t := adapter.PreviewToken(ctx); t != nil {
+		return t.Project() == pid && t.Request() == nil
+	}
+	op := adapter.Operator(ctx)
+	if op == nil || (op.AcOperator == nil || op.AcOperator.User == nil) && op.Integration == nil {
+		return false
+	}
+	return op.IsReadableProject(pid)
+}
+
+// cache disables the shared cache of previews, which change whenever items are updated or staged, and of snapshots.
+func (p ItemParam) cache(c Cache) Cache {
+	if p.ref != nil {
+		c.MaxAge = 0
+		c.Private = true
+	}
+	return c
 }
@@ -0,0 +1,80 @@
+package publicapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestController_GetItems_Release(t *testing.T) {
+	ctx := context.Background()
+	r := memory.New()
+	wid := accountdomain.NewWorkspaceID()
+
+	pr := project.New().NewID().Workspace(wid).Alias("project-alias").Publication(project.NewPublication(project.PublicationScopePublic, false)).MustBuild()
+	m := model.New().NewID().Project(pr.ID()).Schema(id.NewSchemaID()).Key(id.NewKey("post")).Public(true).MustBuild()
+	s := schema.New().ID(m.Schema()).Project(pr.ID()).Workspace(wid).Fields(schema.FieldList{
+		schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild(),
+	}).MustBuild()
+	it := item.New().NewID().Project(pr.ID()).Model(m.ID()).Schema(s.ID()).Thread(id.NewThreadID().Ref()).Fields([]*item.Field{
+		item.NewField(s.Fields()[0].ID(), value.New(value.TypeText, "staged").AsMultiple(), nil),
+	}).MustBuild()
+	rel := release.New().NewID().Workspace(wid).Project(pr.ID()).Name("v1").MustBuild()
+
+	require.NoError(t, r.Project.Save(ctx, pr))
+	require.NoError(t, r.Model.Save(ctx, m))
+	require.NoError(t, r.Schema.Save(ctx, s))
+	require.NoError(t, r.Item.Save(ctx, it))
+	require.NoError(t, r.Item.UpdateRef(ctx, it.ID(), rel.Ref(), version.Latest.OrVersion().Ref()))
+	require.NoError(t, r.Release.Save(ctx, rel))
+
+	gw := &gateway.Container{}
+	ctrl := NewController(r.Project, &interfaces.Container{
+		Item:    interactor.NewItem(r, gw),
+		Model:   interactor.NewModel(r, gw),
+		Schema:  interactor.NewSchema(r, gw),
+		Asset:   interactor.NewAsset(r, gw),
+		Release: interactor.NewRelease(r, gw),
+	})
+	param := ListParam{ItemParam: ItemParam{Release: "v1"}, Pagination: usecasex.OffsetPagination{Limit: 50}.Wrap()}
+
+	// the operator of the public API token can read the project but can not preview releases
+	publicCtx := adapter.AttachOperator(ctx, &usecase.Operator{
+		AcOperator:       &accountusecase.Operator{ReadableWorkspaces: id.WorkspaceIDList{wid}},
+		ReadableProjects: id.ProjectIDList{pr.ID()},
+	})
+	_, _, err := ctrl.GetItems(publicCtx, "project-alias", "post", param)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	// a preview token scoped to a request can not preview releases
+	rt := preview.New().NewID().Workspace(wid).Project(pr.ID()).Request(id.NewRequestID().Ref()).ExpiresAt(time.Now().Add(time.Hour)).MustBuild()
+	_, _, err = ctrl.GetItems(adapter.AttachPreviewToken(publicCtx, rt), "project-alias", "post", param)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	pt := preview.New().NewID().Workspace(wid).Project(pr.ID()).ExpiresAt(time.Now().Add(time.Hour)).MustBuild()
+	res, _, err := ctrl.GetItems(adapter.AttachPreviewToken(publicCtx, pt), "project-alias", "post", param)
+	require.NoError(t, err)
+	assert.Len(t, res.Results, 1)
+}
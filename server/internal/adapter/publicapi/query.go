@@ -0,0 +1,389 @@
+package publicapi
+
+import (
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrInvalidFilter = rerror.NewE(i18n.T("invalid filter"))
+	ErrInvalidSort   = rerror.NewE(i18n.T("invalid sort"))
+)
+
+type FilterOperator string
+
+const (
+	FilterOperatorEq       FilterOperator = "eq"
+	FilterOperatorNe       FilterOperator = "ne"
+	FilterOperatorLt       FilterOperator = "lt"
+	FilterOperatorLte      FilterOperator = "lte"
+	FilterOperatorGt       FilterOperator = "gt"
+	FilterOperatorGte      FilterOperator = "gte"
+	FilterOperatorContains FilterOperator = "contains"
+	FilterOperatorIn       FilterOperator = "in"
+	FilterOperatorNull     FilterOperator = "null"
+)
+
+var filterOperators = []FilterOperator{
+	FilterOperatorEq,
+	FilterOperatorNe,
+	FilterOperatorLt,
+	FilterOperatorLte,
+	FilterOperatorGt,
+	FilterOperatorGte,
+	FilterOperatorContains,
+	FilterOperatorIn,
+	FilterOperatorNull,
+}
+
+const (
+	sortKeyCreatedAt = "createdAt"
+	sortKeyUpdatedAt = "updatedAt"
+)
+
+type FilterParam struct {
+	Key   string
+	Op    FilterOperator
+	Value string
+	// Values is used instead of Value for the in operator when it is set.
+	Values []string
+}
+
+type SortParam struct {
+	Key  string
+	Desc bool
+}
+
+// filterParamsFromQuery parses filters in the form of "filter[key][op]=value".
+// "filter[key]=value" is a shorthand of "filter[key][eq]=value".
+func filterParamsFromQuery(q url.Values) ([]FilterParam, error) {
+	var res []FilterParam
+	for k, vs := range q {
+		rest, ok := strings.CutPrefix(k, "filter[")
+		if !ok {
+			continue
+		}
+
+		key, rest, ok := strings.Cut(rest, "]")
+		if !ok || key == "" {
+			return nil, ErrInvalidFilter
+		}
+
+		op := FilterOperatorEq
+		if rest != "" {
+			o, ok := strings.CutPrefix(rest, "[")
+			if !ok {
+				return nil, ErrInvalidFilter
+			}
+			o, ok = strings.CutSuffix(o, "]")
+			if !ok {
+				return nil, ErrInvalidFilter
+			}
+			op = FilterOperator(strings.ToLower(o))
+		}
+		if !slices.Contains(filterOperators, op) {
+			return nil, ErrInvalidFilter
+		}
+
+		for _, v := range vs {
+			res = append(res, FilterParam{Key: key, Op: op, Value: v})
+		}
+	}
+
+	// query values are stored in a map, so the order should be fixed
+	slices.SortStableFunc(res, func(a, b FilterParam) int {
+		if c := strings.Compare(a.Key, b.Key); c != 0 {
+			return c
+		}
+		return strings.Compare(string(a.Op), string(b.Op))
+	})
+	return res, nil
+}
+
+func sortParamFromQuery(q url.Values) (*SortParam, error) {
+	key := q.Get("sort")
+	if key == "" {
+		return nil, nil
+	}
+
+	desc := false
+	if k, ok := strings.CutPrefix(key, "-"); ok {
+		key, desc = k, true
+	}
+
+	switch strings.ToLower(q.Get("dir")) {
+	case "":
+	case "asc":
+		desc = false
+	case "desc":
+		desc = true
+	default:
+		return nil, ErrInvalidSort
+	}
+
+	return &SortParam{Key: key, Desc: desc}, nil
+}
+
+func (p ListParam) HasQuery() bool {
+	return p.Keyword != "" || p.Sort != nil || len(p.Filters) > 0
+}
+
+// Query builds an item query for public items of the model.
+// Only fields of the model schema can be used to filter or sort items. Meta fields are not exposed to the public.
+func (p ListParam) Query(mid id.ModelID, s *schema.Schema) (*item.Query, error) {
+	so, err := toSort(s, p.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := toFilter(s, p.Filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.NewQuery(s.Project(), mid, s.ID().Ref(), p.Keyword, p.versionRef()).
+		WithSort(so).
+		WithFilter(c), nil
+}
+
+func toSort(s *schema.Schema, p *SortParam) (*view.Sort, error) {
+	if p == nil {
+		return nil, nil
+	}
+
+	d := view.DirectionAsc
+	if p.Desc {
+		d = view.DirectionDesc
+	}
+
+	switch p.Key {
+	case sortKeyCreatedAt:
+		return &view.Sort{Field: view.FieldSelector{Type: view.FieldTypeCreationDate}, Direction: d}, nil
+	case sortKeyUpdatedAt:
+		return &view.Sort{Field: view.FieldSelector{Type: view.FieldTypeModificationDate}, Direction: d}, nil
+	}
+
+	f := s.FieldByIDOrKey(nil, id.NewKeyFromPtr(&p.Key))
+	if f == nil || !isFilterableType(f.Type()) {
+		return nil, ErrInvalidSort
+	}
+
+	return &view.Sort{
+		Field: view.FieldSelector{
+			Type: view.FieldTypeField,
+			ID:   f.ID().Ref(),
+		},
+		Direction: d,
+	}, nil
+}
+
+func toFilter(s *schema.Schema, params []FilterParam) (*view.Condition, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	conds := make([]view.Condition, 0, len(params))
+	for _, p := range params {
+		f := s.FieldByIDOrKey(nil, id.NewKeyFromPtr(&p.Key))
+		if f == nil || !isFilterableType(f.Type()) {
+			return nil, ErrInvalidFilter
+		}
+
+		c, err := toCondition(f, p)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, *c)
+	}
+
+	if len(conds) == 1 {
+		return &conds[0], nil
+	}
+	return &view.Condition{
+		ConditionType: view.ConditionTypeAnd,
+		AndCondition:  &view.AndCondition{Conditions: conds},
+	}, nil
+}
+
+func toCondition(f *schema.Field, p FilterParam) (*view.Condition, error) {
+	fs := view.FieldSelector{
+		Type: view.FieldTypeField,
+		ID:   f.ID().Ref(),
+	}
+	t := f.Type()
+
+	switch p.Op {
+	case FilterOperatorEq, FilterOperatorNe:
+		v, err := filterValue(t, p.Value)
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := v.(bool); ok {
+			return &view.Condition{
+				ConditionType: view.ConditionTypeBool,
+				BoolCondition: &view.BoolCondition{
+					Field: fs,
+					Op:    lo.Ternary(p.Op == FilterOperatorEq, view.BoolOperatorEquals, view.BoolOperatorNotEquals),
+					Value: b,
+				},
+			}, nil
+		}
+		if t == value.TypeDateTime {
+			// the datetime value is parsed by the repository
+			v = p.Value
+		}
+		return &view.Condition{
+			ConditionType: view.ConditionTypeBasic,
+			BasicCondition: &view.BasicCondition{
+				Field: fs,
+				Op:    lo.Ternary(p.Op == FilterOperatorEq, view.BasicOperatorEquals, view.BasicOperatorNotEquals),
+				Value: v,
+			},
+		}, nil
+
+	case FilterOperatorLt, FilterOperatorLte, FilterOperatorGt, FilterOperatorGte:
+		v, err := filterValue(t, p.Value)
+		if err != nil {
+			return nil, err
+		}
+		switch vv := v.(type) {
+		case float64:
+			return &view.Condition{
+				ConditionType: view.ConditionTypeNumber,
+				NumberCondition: &view.NumberCondition{
+					Field: fs,
+					Op:    numberOperators[p.Op],
+					Value: vv,
+				},
+			}, nil
+		case time.Time:
+			return &view.Condition{
+				ConditionType: view.ConditionTypeTime,
+				TimeCondition: &view.TimeCondition{
+					Field: fs,
+					Op:    timeOperators[p.Op],
+					Value: vv,
+				},
+			}, nil
+		}
+		return nil, ErrInvalidFilter
+
+	case FilterOperatorContains:
+		if !isStringType(t) {
+			return nil, ErrInvalidFilter
+		}
+		return &view.Condition{
+			ConditionType: view.ConditionTypeString,
+			StringCondition: &view.StringCondition{
+				Field: fs,
+				Op:    view.StringOperatorContains,
+				Value: p.Value,
+			},
+		}, nil
+
+	case FilterOperatorIn:
+		vs := p.Values
+		if vs == nil {
+			vs = strings.Split(p.Value, ",")
+		}
+		values := make([]any, 0, len(vs))
+		for _, s := range vs {
+			v, err := filterValue(t, s)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return &view.Condition{
+			ConditionType: view.ConditionTypeMultiple,
+			MultipleCondition: &view.MultipleCondition{
+				Field: fs,
+				Op:    view.MultipleOperatorIncludesAny,
+				Value: values,
+			},
+		}, nil
+
+	case FilterOperatorNull:
+		b, err := strconv.ParseBool(p.Value)
+		if err != nil {
+			return nil, ErrInvalidFilter
+		}
+		return &view.Condition{
+			ConditionType: view.ConditionTypeNullable,
+			NullableCondition: &view.NullableCondition{
+				Field: fs,
+				Op:    lo.Ternary(b, view.NullableOperatorEmpty, view.NullableOperatorNotEmpty),
+			},
+		}, nil
+	}
+
+	return nil, ErrInvalidFilter
+}
+
+var numberOperators = map[FilterOperator]view.NumberOperator{
+	FilterOperatorLt:  view.NumberOperatorLessThan,
+	FilterOperatorLte: view.NumberOperatorLessThanOrEqualTo,
+	FilterOperatorGt:  view.NumberOperatorGreaterThan,
+	FilterOperatorGte: view.NumberOperatorGreaterThanOrEqualTo,
+}
+
+var timeOperators = map[FilterOperator]view.TimeOperator{
+	FilterOperatorLt:  view.TimeOperatorBefore,
+	FilterOperatorLte: view.TimeOperatorBeforeOrOn,
+	FilterOperatorGt:  view.TimeOperatorAfter,
+	FilterOperatorGte: view.TimeOperatorAfterOrOn,
+}
+
+// filterValue converts a query string into a value that can be compared with stored values of the field type.
+func filterValue(t value.Type, s string) (any, error) {
+	switch t {
+	case value.TypeInteger, value.TypeNumber:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, ErrInvalidFilter
+		}
+		return f, nil
+	case value.TypeBool, value.TypeCheckbox:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, ErrInvalidFilter
+		}
+		return b, nil
+	case value.TypeDateTime:
+		tt, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, ErrInvalidFilter
+		}
+		return tt, nil
+	}
+	return s, nil
+}
+
+func isStringType(t value.Type) bool {
+	switch t {
+	case value.TypeText, value.TypeTextArea, value.TypeRichText, value.TypeMarkdown,
+		value.TypeSelect, value.TypeURL, value.TypeReference, value.TypeAsset:
+		return true
+	}
+	return false
+}
+
+func isFilterableType(t value.Type) bool {
+	switch t {
+	case value.TypeInteger, value.TypeNumber, value.TypeBool, value.TypeCheckbox, value.TypeDateTime:
+		return true
+	}
+	return isStringType(t)
+}
@@ -0,0 +1,137 @@
+package publicapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListParam_Query(t *testing.T) {
+	fText := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("name")).MustBuild()
+	fNumber := schema.NewField(lo.Must(schema.NewNumber(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("count")).MustBuild()
+	fBool := schema.NewField(schema.NewBool().TypeProperty()).NewID().Key(id.NewKey("flag")).MustBuild()
+	fDate := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Key(id.NewKey("date")).MustBuild()
+	fGroup := schema.NewField(schema.NewGroup(id.NewGroupID()).TypeProperty()).NewID().Key(id.NewKey("group")).MustBuild()
+	s := schema.New().
+		NewID().
+		Project(id.NewProjectID()).
+		Workspace(accountdomain.NewWorkspaceID()).
+		Fields([]*schema.Field{fText, fNumber, fBool, fDate, fGroup}).
+		MustBuild()
+	mid := id.NewModelID()
+
+	// no filters
+	q, err := ListParam{Keyword: "foo"}.Query(mid, s)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", q.Keyword())
+	assert.Equal(t, mid, q.Model())
+	assert.Equal(t, s.Project(), q.Project())
+	assert.Equal(t, version.Public.Ref(), q.Ref())
+	assert.Nil(t, q.Filter())
+	assert.Nil(t, q.Sort())
+
+	// sort
+	q, err = ListParam{Sort: &SortParam{Key: "count", Desc: true}}.Query(mid, s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Sort{
+		Field:     view.FieldSelector{Type: view.FieldTypeField, ID: fNumber.ID().Ref()},
+		Direction: view.DirectionDesc,
+	}, q.Sort())
+
+	q, err = ListParam{Sort: &SortParam{Key: "createdAt"}}.Query(mid, s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Sort{
+		Field:     view.FieldSelector{Type: view.FieldTypeCreationDate},
+		Direction: view.DirectionAsc,
+	}, q.Sort())
+
+	_, err = ListParam{Sort: &SortParam{Key: "group"}}.Query(mid, s)
+	assert.Same(t, ErrInvalidSort, err)
+
+	// single filter
+	q, err = ListParam{Filters: []FilterParam{{Key: "name", Op: FilterOperatorContains, Value: "x"}}}.Query(mid, s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Condition{
+		ConditionType: view.ConditionTypeString,
+		StringCondition: &view.StringCondition{
+			Field: view.FieldSelector{Type: view.FieldTypeField, ID: fText.ID().Ref()},
+			Op:    view.StringOperatorContains,
+			Value: "x",
+		},
+	}, q.Filter())
+
+	// multiple filters
+	q, err = ListParam{Filters: []FilterParam{
+		{Key: "count", Op: FilterOperatorGte, Value: "1.5"},
+		{Key: "date", Op: FilterOperatorLt, Value: "2024-01-02T00:00:00Z"},
+		{Key: "flag", Op: FilterOperatorNe, Value: "true"},
+		{Key: "name", Op: FilterOperatorIn, Value: "a,b"},
+		{Key: "name", Op: FilterOperatorNull, Value: "false"},
+	}}.Query(mid, s)
+	assert.NoError(t, err)
+	assert.Equal(t, &view.Condition{
+		ConditionType: view.ConditionTypeAnd,
+		AndCondition: &view.AndCondition{
+			Conditions: []view.Condition{
+				{
+					ConditionType: view.ConditionTypeNumber,
+					NumberCondition: &view.NumberCondition{
+						Field: view.FieldSelector{Type: view.FieldTypeField, ID: fNumber.ID().Ref()},
+						Op:    view.NumberOperatorGreaterThanOrEqualTo,
+						Value: 1.5,
+					},
+				},
+				{
+					ConditionType: view.ConditionTypeTime,
+					TimeCondition: &view.TimeCondition{
+						Field: view.FieldSelector{Type: view.FieldTypeField, ID: fDate.ID().Ref()},
+						Op:    view.TimeOperatorBefore,
+						Value: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+					},
+				},
+				{
+					ConditionType: view.ConditionTypeBool,
+					BoolCondition: &view.BoolCondition{
+						Field: view.FieldSelector{Type: view.FieldTypeField, ID: fBool.ID().Ref()},
+						Op:    view.BoolOperatorNotEquals,
+						Value: true,
+					},
+				},
+				{
+					ConditionType: view.ConditionTypeMultiple,
+					MultipleCondition: &view.MultipleCondition{
+						Field: view.FieldSelector{Type: view.FieldTypeField, ID: fText.ID().Ref()},
+						Op:    view.MultipleOperatorIncludesAny,
+						Value: []any{"a", "b"},
+					},
+				},
+				{
+					ConditionType: view.ConditionTypeNullable,
+					NullableCondition: &view.NullableCondition{
+						Field: view.FieldSelector{Type: view.FieldTypeField, ID: fText.ID().Ref()},
+						Op:    view.NullableOperatorNotEmpty,
+					},
+				},
+			},
+		},
+	}, q.Filter())
+
+	// invalid filters
+	_, err = ListParam{Filters: []FilterParam{{Key: "unknown", Op: FilterOperatorEq, Value: "x"}}}.Query(mid, s)
+	assert.Same(t, ErrInvalidFilter, err)
+	_, err = ListParam{Filters: []FilterParam{{Key: "group", Op: FilterOperatorEq, Value: "x"}}}.Query(mid, s)
+	assert.Same(t, ErrInvalidFilter, err)
+	_, err = ListParam{Filters: []FilterParam{{Key: "count", Op: FilterOperatorEq, Value: "x"}}}.Query(mid, s)
+	assert.Same(t, ErrInvalidFilter, err)
+	_, err = ListParam{Filters: []FilterParam{{Key: "name", Op: FilterOperatorGt, Value: "x"}}}.Query(mid, s)
+	assert.Same(t, ErrInvalidFilter, err)
+	_, err = ListParam{Filters: []FilterParam{{Key: "count", Op: FilterOperatorContains, Value: "1"}}}.Query(mid, s)
+	assert.Same(t, ErrInvalidFilter, err)
+}
@@ -52,7 +52,11 @@ func NewListResult[T any](results []T, pi *usecasex.PageInfo, p *usecasex.Pagina
 }
 
 type ListParam struct {
+	ItemParam
 	Pagination *usecasex.Pagination
+	Keyword    string
+	Sort       *SortParam
+	Filters    []FilterParam
 }
 
 type Item struct {
@@ -68,13 +72,18 @@ func (i Item) MarshalJSON() ([]byte, error) {
 }
 
 func NewItem(i *item.Item, sp *schema.Package, assets asset.List, refItems []Item) Item {
+	return newItem(i, sp, assets, refItems, false)
+}
+
+// newItem converts an item into a public item. When assetIDs is true, assets which are not in the list are returned as their IDs like unexpanded references.
+func newItem(i *item.Item, sp *schema.Package, assets asset.List, refItems []Item, assetIDs bool) Item {
 	gsf := schema.FieldList{}
 	for _, groupSchema := range sp.GroupSchemas() {
 		gsf = append(gsf, groupSchema.Fields().Clone()...)
 	}
 	itm := Item{
 		ID:     i.ID().String(),
-		Fields: NewItemFields(i.Fields(), sp.Schema().Fields(), gsf, refItems, assets),
+		Fields: newItemFields(i.Fields(), sp.Schema().Fields(), gsf, refItems, assets, assetIDs),
 	}
 
 	return itm
@@ -96,6 +105,10 @@ func (i ItemFields) DropEmptyFields() ItemFields {
 }
 
 func NewItemFields(fields item.Fields, sfields schema.FieldList, groupFields schema.FieldList, refItems []Item, assets asset.List) ItemFields {
+	return newItemFields(fields, sfields, groupFields, refItems, assets, false)
+}
+
+func newItemFields(fields item.Fields, sfields schema.FieldList, groupFields schema.FieldList, refItems []Item, assets asset.List, assetIDs bool) ItemFields {
 	return ItemFields(lo.SliceToMap(fields, func(f *item.Field) (k string, val any) {
 		sf := sfields.Find(f.FieldID())
 		if sf == nil {
@@ -111,6 +124,7 @@ func NewItemFields(fields item.Fields, sfields schema.FieldList, groupFields sch
 
 		if sf.Type() == value.TypeAsset {
 			var itemAssets []ItemAsset
+			var ids []string
 			for _, v := range f.Value().Values() {
 				aid, ok := v.ValueAsset()
 				if !ok {
@@ -118,13 +132,25 @@ func NewItemFields(fields item.Fields, sfields schema.FieldList, groupFields sch
 				}
 				if as, ok := lo.Find(assets, func(a *asset.Asset) bool { return a != nil && a.ID() == aid }); ok {
 					itemAssets = append(itemAssets, NewItemAsset(as))
+				} else if assetIDs {
+					// the asset is not expanded
+					ids = append(ids, aid.String())
 				}
 			}
 
-			if sf.Multiple() {
+			switch {
+			case len(ids) == 0 && sf.Multiple():
 				val = itemAssets
-			} else if len(itemAssets) > 0 {
+			case len(ids) == 0 && len(itemAssets) > 0:
 				val = itemAssets[0]
+			case len(ids) == 0:
+			case len(itemAssets) == 0 && sf.Multiple():
+				val = ids
+			case len(itemAssets) == 0:
+				val = ids[0]
+			default:
+				// some of the expanded assets are not found
+				val = append(lo.ToAnySlice(itemAssets), lo.ToAnySlice(ids)...)
 			}
 		} else if sf.Type() == value.TypeReference {
 			rf, _ := f.Value().ValuesReference()
@@ -134,6 +160,9 @@ func NewItemFields(fields item.Fields, sfields schema.FieldList, groupFields sch
 				})
 				if ok {
 					val = v
+				} else {
+					// the referenced item is not expanded
+					val = rf[0].String()
 				}
 			}
 		} else if sf.Type() == value.TypeGroup {
@@ -144,7 +173,7 @@ func NewItemFields(fields item.Fields, sfields schema.FieldList, groupFields sch
 					continue
 				}
 				gf := fields.FieldsByGroup(itgID)
-				igf := NewItemFields(gf, groupFields, nil, nil, assets)
+				igf := newItemFields(gf, groupFields, nil, nil, assets, assetIDs)
 				res = append(res, igf)
 			}
 			if sf.Multiple() {
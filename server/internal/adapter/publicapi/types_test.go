@@ -157,6 +157,56 @@ func TestNewItem_Multiple(t *testing.T) {
 			"aaaaa": []any{"aaaa"},
 		}),
 	}, NewItem(it, schema.NewPackage(s, nil, nil, nil), nil, nil))
+
+	// not expanded
+	assert.Equal(t, Item{
+		ID: it.ID().String(),
+		Fields: ItemFields(map[string]any{
+			"aaaaa": []any{"aaaa"},
+			"bbbbb": []string{as.ID().String()},
+		}),
+	}, newItem(it, schema.NewPackage(s, nil, nil, nil), nil, nil, true))
+}
+
+func TestNewItem_Reference(t *testing.T) {
+	s := schema.New().
+		NewID().
+		Project(id.NewProjectID()).
+		Workspace(accountdomain.NewWorkspaceID()).
+		Fields([]*schema.Field{
+			schema.NewField(schema.NewReference(id.NewModelID(), id.NewSchemaID(), nil, nil).TypeProperty()).NewID().Key(id.NewKey("ref")).MustBuild(),
+		}).
+		MustBuild()
+	rid := id.NewItemID()
+	it := item.New().
+		NewID().
+		Schema(s.ID()).
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		Thread(id.NewThreadID().Ref()).
+		Fields([]*item.Field{
+			item.NewField(s.Fields()[0].ID(), value.New(value.TypeReference, rid).AsMultiple(), nil),
+		}).
+		MustBuild()
+	ri := Item{
+		ID:     rid.String(),
+		Fields: ItemFields{"name": "aaa"},
+	}
+
+	assert.Equal(t, Item{
+		ID: it.ID().String(),
+		Fields: ItemFields{
+			"ref": ri,
+		},
+	}, NewItem(it, schema.NewPackage(s, nil, nil, nil), nil, []Item{ri}))
+
+	// not expanded
+	assert.Equal(t, Item{
+		ID: it.ID().String(),
+		Fields: ItemFields{
+			"ref": rid.String(),
+		},
+	}, NewItem(it, schema.NewPackage(s, nil, nil, nil), nil, nil))
 }
 
 func TestItem_MarshalJSON(t *testing.T) {
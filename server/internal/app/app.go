@@ -11,6 +11,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/adapter/integration"
 	"github.com/reearth/reearth-cms/server/internal/adapter/publicapi"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/appx"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
@@ -56,8 +57,12 @@ func initEcho(appCtx *ApplicationContext) *echo.Echo {
 	}
 
 	usecaseMiddleware := UsecaseMiddleware(appCtx.Repos, appCtx.Gateways, appCtx.AcRepos, appCtx.AcGateways, interactor.ContainerConfig{
-		SignupSecret:    appCtx.Config.SignupSecret,
-		AuthSrvUIDomain: appCtx.Config.Host_Web,
+		SignupSecret:         appCtx.Config.SignupSecret,
+		AuthSrvUIDomain:      appCtx.Config.Host_Web,
+		PreviewSecret:        appCtx.Config.PublicAPI.PreviewSecret,
+		WebHost:              appCtx.Config.WebHost(),
+		AssetHost:            appCtx.Config.Host,
+		AssetTransformSecret: appCtx.Config.Asset_TransformSecret,
 	})
 
 	// apis
@@ -77,7 +82,9 @@ func initEcho(appCtx *ApplicationContext) *echo.Echo {
 	)
 	api.POST("/signup", Signup(), usecaseMiddleware)
 
-	publicapi.Echo(api.Group("/p", publicAPIAuthMiddleware(appCtx), usecaseMiddleware))
+	publicapi.Echo(api.Group("/p", publicAPIAuthMiddleware(appCtx), usecaseMiddleware), publicapi.Config{
+		MaxExpandDepth: appCtx.Config.PublicAPI.MaxExpandDepth,
+	})
 	integration.RegisterHandlers(api.Group(
 		"",
 		authMiddleware(appCtx),
@@ -134,6 +141,13 @@ func errorMessage(err error, log func(string, ...interface{})) (int, string) {
 		return code, msg
 	}
 
+	var vErrs schema.ValidationErrors
+	if errors.As(err, &vErrs) {
+		code = http.StatusBadRequest
+		msg = vErrs.Error()
+		return code, msg
+	}
+
 	var rErr *rerror.E
 	if errors.As(err, &rErr) {
 		code = http.StatusBadRequest
@@ -160,14 +174,35 @@ func errorHandler(next func(error, echo.Context)) func(error, echo.Context) {
 		code, msg := errorMessage(err, func(f string, args ...interface{}) {
 			c.Echo().Logger.Errorf(f, args...)
 		})
-		if err := c.JSON(code, map[string]string{
+		res := map[string]any{
 			"error": msg,
-		}); err != nil {
+		}
+		var vErrs schema.ValidationErrors
+		if errors.As(err, &vErrs) {
+			res["details"] = validationErrorDetails(vErrs)
+		}
+		if err := c.JSON(code, res); err != nil {
 			next(err, c)
 		}
 	}
 }
 
+// validationErrorDetails lists the violations of the validation rules with the keys of the fields so that clients can show them on the fields.
+func validationErrorDetails(errs schema.ValidationErrors) []map[string]string {
+	return lo.Map(errs, func(e *schema.ValidationError, _ int) map[string]string {
+		d := map[string]string{
+			"fieldId": e.Field.String(),
+			"key":     e.Key,
+			"rule":    string(e.Rule),
+			"message": e.Reason(),
+		}
+		if e.Locale != "" {
+			d["locale"] = e.Locale
+		}
+		return d
+	})
+}
+
 func private(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		c.Response().Header().Set(echo.HeaderCacheControl, "private, no-store, no-cache, must-revalidate")
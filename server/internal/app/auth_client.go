@@ -9,8 +9,10 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integration"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/user"
@@ -132,7 +134,34 @@ func publicAPIAuthMiddleware(appCtx *ApplicationContext) echo.MiddlewareFunc {
 		return func(c echo.Context) error {
 			req := c.Request()
 			ctx := req.Context()
-			if token := getToken(req); token != "" {
+			if token := getPreviewToken(req); token != "" {
+				uc := interactor.NewPreviewToken(appCtx.Repos, appCtx.Gateways, appCtx.Config.PublicAPI.PreviewSecret)
+				t, err := uc.Verify(ctx, token)
+				if err != nil {
+					if errors.Is(err, preview.ErrInvalidToken) || errors.Is(err, preview.ErrExpired) || errors.Is(err, preview.ErrRevoked) {
+						return c.JSON(http.StatusUnauthorized, map[string]string{"error": err.Error()})
+					}
+					return err
+				}
+
+				p, err := appCtx.Repos.Project.FindByID(ctx, t.Project())
+				if err != nil {
+					return err
+				}
+
+				// the preview token does not override the publication scope: private projects can not be previewed with the public API
+				if p.Publication() == nil || p.Publication().Scope() == project.PublicationScopePrivate {
+					return c.JSON(http.StatusUnauthorized, map[string]string{"error": "invalid project"})
+				}
+
+				// the preview token grants read access to its project like the publication token, and to the draft content at its ref
+				defaultLang := req.Header.Get("Accept-Language")
+				op := generatePublicApiOperator(p, defaultLang)
+				op.PreviewRef = t.Ref().Ref()
+				ctx = adapter.AttachOperator(ctx, op)
+				ctx = adapter.AttachPreviewToken(ctx, t)
+				c.SetRequest(req.WithContext(ctx))
+			} else if token := getToken(req); token != "" {
 				p, err := appCtx.Repos.Project.FindByPublicAPIToken(ctx, token)
 				if err != nil {
 					if errors.Is(err, rerror.ErrNotFound) {
@@ -164,6 +193,14 @@ func getToken(req *http.Request) string {
 	return ""
 }
 
+func getPreviewToken(req *http.Request) string {
+	token := strings.TrimPrefix(req.Header.Get("authorization"), "Bearer ")
+	if preview.IsToken(token) {
+		return token
+	}
+	return ""
+}
+
 func generateUserOperator(ctx context.Context, appCtx *ApplicationContext, u *user.User, defaultLang string) (*usecase.Operator, error) {
 	if u == nil {
 		return nil, nil
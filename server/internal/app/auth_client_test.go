@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicAPIAuthMiddleware_PreviewToken(t *testing.T) {
+	ctx := context.Background()
+	repos := memory.New()
+	wid := accountdomain.NewWorkspaceID()
+	appCtx := &ApplicationContext{
+		Config:   &Config{PublicAPI: PublicAPIConfig{PreviewSecret: "secret"}},
+		Repos:    repos,
+		Gateways: &gateway.Container{},
+	}
+
+	token := func(scope project.PublicationScope) string {
+		p := project.New().NewID().Workspace(wid).Publication(project.NewPublication(scope, false)).MustBuild()
+		require.NoError(t, repos.Project.Save(ctx, p))
+		pt := preview.New().NewID().Workspace(wid).Project(p.ID()).ExpiresAt(time.Now().Add(time.Hour)).MustBuild()
+		require.NoError(t, repos.PreviewToken.Save(ctx, pt))
+		return pt.Sign([]byte("secret"))
+	}
+	serve := func(token string) (*httptest.ResponseRecorder, bool) {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		called := false
+		err := publicAPIAuthMiddleware(appCtx)(func(c echo.Context) error {
+			called = true
+			op := adapter.Operator(c.Request().Context())
+			assert.Equal(t, version.Latest.Ref(), op.PreviewRef)
+			return c.NoContent(http.StatusOK)
+		})(echo.New().NewContext(r, w))
+		require.NoError(t, err)
+		return w, called
+	}
+
+	// the draft content of a limited project can be previewed
+	w, called := serve(token(project.PublicationScopeLimited))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, called)
+
+	// the preview token does not override the private publication scope
+	w, called = serve(token(project.PublicationScopePrivate))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.False(t, called)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/k0kubun/pp/v3"
@@ -45,6 +46,10 @@ type Config struct {
 	// asset
 	Asset_Public bool   `default:"true" pp:",omitempty"`
 	AssetBaseURL string `pp:",omitempty"`
+	// Asset_TransformSecret is the secret to sign the parameters of image transformations.
+	Asset_TransformSecret string `pp:",omitempty"`
+	// search index of items: "mongo" or "local" (items are searched without an index when empty)
+	Search string `pp:",omitempty"`
 	// auth
 	Auth          AuthConfigs    `pp:",omitempty"`
 	Auth0         Auth0Config    `pp:",omitempty"`
@@ -66,9 +71,15 @@ type Config struct {
 	// internal api
 	InternalApi InternalApiConfig `pp:",omitempty"`
 
+	// public api
+	PublicAPI PublicAPIConfig `pp:",omitempty"`
+
 	// server
 	Server ServerConfig `pp:",omitempty"`
 
+	// scheduled publishing
+	Scheduler SchedulerConfig `pp:",omitempty"`
+
 	// Health Check Configuration
 	HealthCheck HealthCheckConfig `pp:",omitempty"`
 }
@@ -83,12 +94,23 @@ type ServerConfig struct {
 	Active bool `default:"true" pp:",omitempty"`
 }
 
+type SchedulerConfig struct {
+	Active   bool          `default:"true" pp:",omitempty"`
+	Interval time.Duration `default:"1m" pp:",omitempty"`
+}
+
 type InternalApiConfig struct {
 	Active bool   `default:"false" pp:",omitempty"`
 	Port   string `default:"50051" pp:",omitempty"`
 	Token  string `default:"" pp:",omitempty"`
 }
 
+type PublicAPIConfig struct {
+	MaxExpandDepth int `default:"3" pp:",omitempty"`
+	// PreviewSecret is the secret to sign preview tokens for draft content.
+	PreviewSecret string `pp:",omitempty"`
+}
+
 type AuthConfig struct {
 	ISS      string   `pp:",omitempty"`
 	AUD      []string `pp:",omitempty"`
@@ -369,6 +391,8 @@ func (c *Config) secrets() []string {
 		c.DB,
 		c.Auth0.ClientSecret,
 		c.InternalApi.Token,
+		c.PublicAPI.PreviewSecret,
+		c.Asset_TransformSecret,
 		c.HealthCheck.Username,
 		c.HealthCheck.Password,
 	}
@@ -386,6 +410,17 @@ func prepareUrl(url string) string {
 	return url
 }
 
+// WebHost returns the URL of the web app. The web app is served by this server when Host_Web is not set.
+func (c *Config) WebHost() string {
+	if c.Host_Web != "" {
+		return c.Host_Web
+	}
+	if c.Web_Disabled {
+		return ""
+	}
+	return c.Host
+}
+
 func (c *Config) WebConfig() map[string]any {
 	config := make(map[string]any)
 
@@ -1,6 +1,8 @@
 package app
 
 import (
+	"bytes"
+	"errors"
 	"io"
 	"mime"
 	"net/http"
@@ -9,6 +11,10 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/imaging"
+	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 )
 
@@ -49,18 +55,32 @@ func handleAssetByUUID(appCtx *ApplicationContext) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		filename := ctx.Param("filename")
 		uuid := ctx.Param("uuid1") + ctx.Param("uuid2")
-		if !appCtx.Config.Asset_Public {
-			a, err := appCtx.Repos.Asset.FindByUUID(ctx.Request().Context(), uuid)
+		o, err := imaging.ParseQuery(ctx.QueryParams())
+		if err != nil {
+			return err
+		}
+
+		// the asset is always looked up for transformations so that derivatives of deleted assets are not served from the cache
+		var a *asset.Asset
+		if !appCtx.Config.Asset_Public || o != nil {
+			a, err = appCtx.Repos.Asset.FindByUUID(ctx.Request().Context(), uuid)
 			if err != nil {
 				return err
 			}
-			if a != nil && !a.Public() {
-				op := adapter.Operator(ctx.Request().Context())
-				if op == nil || !op.IsReadableProject(a.Project()) {
-					return rerror.ErrNotFound
-				}
+		}
+		if !appCtx.Config.Asset_Public && a != nil && !a.Public() {
+			op := adapter.Operator(ctx.Request().Context())
+			if op == nil || !op.IsReadableProject(a.Project()) {
+				return rerror.ErrNotFound
 			}
 		}
+
+		if o != nil {
+			if err := o.Verify([]byte(appCtx.Config.Asset_TransformSecret), imaging.AssetPath(uuid, filename), ctx.QueryParams()); err != nil {
+				return err
+			}
+			return handleAssetTransform(ctx, appCtx, a, filename, *o)
+		}
 		r, h, err := appCtx.Gateways.File.ReadAsset(
 			ctx.Request().Context(), uuid, filename, assetHeaders(ctx.Request().Header),
 		)
@@ -71,6 +91,58 @@ func handleAssetByUUID(appCtx *ApplicationContext) echo.HandlerFunc {
 	}
 }
 
+// handleAssetTransform serves the derivative of the image of the asset transformed with the options.
+// Derivatives are cached in the file storage, and a failure of caching does not fail the request.
+func handleAssetTransform(ctx echo.Context, appCtx *ApplicationContext, a *asset.Asset, filename string, o imaging.Options) error {
+	if a == nil {
+		return rerror.ErrNotFound
+	}
+	if pt := a.PreviewType(); pt == nil || *pt != asset.PreviewTypeImage {
+		return imaging.ErrUnsupportedFormat
+	}
+
+	c := ctx.Request().Context()
+	uuid := a.UUID()
+	p := o.DerivativePath(uuid, filename)
+	if p == "" {
+		return rerror.ErrNotFound
+	}
+
+	if r, h, err := appCtx.Gateways.File.Read(c, p, nil); err == nil {
+		defer func() { _ = r.Close() }()
+		f, _ := o.OutputFormat(filename)
+		if h == nil {
+			h = map[string]string{}
+		}
+		h["Content-Type"] = f.ContentType()
+		return streamFile(ctx, p, r, h)
+	} else if !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+
+	r, _, err := appCtx.Gateways.File.ReadAsset(c, uuid, filename, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = r.Close() }()
+
+	b, f, err := imaging.Transform(r, filename, o)
+	if err != nil {
+		return err
+	}
+
+	if _, err := appCtx.Gateways.File.Upload(c, &file.File{
+		Content:     io.NopCloser(bytes.NewReader(b)),
+		Name:        path.Base(p),
+		Size:        int64(len(b)),
+		ContentType: f.ContentType(),
+	}, p); err != nil {
+		log.Errorfc(c, "asset: failed to cache the derivative %s: %v", p, err)
+	}
+
+	return ctx.Blob(http.StatusOK, f.ContentType(), b)
+}
+
 func handleAssetByFileName(appCtx *ApplicationContext) echo.HandlerFunc {
 	return func(ctx echo.Context) error {
 		filename := ctx.Param("filename")
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -13,6 +14,7 @@ import (
 	"github.com/ravilushqa/otelgqlgen"
 	"github.com/reearth/reearth-cms/server/internal/adapter"
 	"github.com/reearth/reearth-cms/server/internal/adapter/gql"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -88,10 +90,23 @@ func gqlErrorPresenter(dev bool) graphql.ErrorPresenterFunc {
 				err = gqlErr.Unwrap()
 			}
 			if err, ok := err.(rerror.Localizable); ok {
-				return graphql.DefaultErrorPresenter(ctx, err.LocalizeError(l))
+				return withValidationErrors(graphql.DefaultErrorPresenter(ctx, err.LocalizeError(l)))
 			}
 		}
 
-		return graphql.DefaultErrorPresenter(ctx, e)
+		return withValidationErrors(graphql.DefaultErrorPresenter(ctx, e))
 	}
 }
+
+// withValidationErrors adds the violations of the validation rules to the extensions of the error.
+func withValidationErrors(e *gqlerror.Error) *gqlerror.Error {
+	var errs schema.ValidationErrors
+	if !errors.As(e, &errs) {
+		return e
+	}
+	if e.Extensions == nil {
+		e.Extensions = map[string]any{}
+	}
+	e.Extensions["validationErrors"] = validationErrorDetails(errs)
+	return e
+}
@@ -50,6 +50,7 @@ type WebServer struct {
 	appServer      *echo.Echo
 	internalPort   string
 	internalServer *grpc.Server
+	scheduler      *Scheduler
 }
 
 type ApplicationContext struct {
@@ -90,6 +91,10 @@ func NewServer(ctx context.Context, appCtx *ApplicationContext) *WebServer {
 		w.internalPort = ":" + appCtx.Config.InternalApi.Port
 		w.internalServer = initGrpc(appCtx)
 	}
+
+	if appCtx.Config.Scheduler.Active {
+		w.scheduler = NewScheduler(appCtx)
+	}
 	return w
 }
 
@@ -125,6 +130,11 @@ func (w *WebServer) Run(ctx context.Context) {
 		log.Info("server: grpc server is not configured")
 	}
 
+	if w.scheduler != nil {
+		go w.scheduler.Run(ctx)
+		log.Infof("server: started scheduler every %s", w.scheduler.interval)
+	}
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
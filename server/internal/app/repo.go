@@ -4,10 +4,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/archive"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/auth0"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/aws"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/gcp"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mailer"
 	mongorepo "github.com/reearth/reearth-cms/server/internal/infrastructure/mongo"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
@@ -115,6 +117,44 @@ func InitReposAndGateways(ctx context.Context, conf *Config) (*repo.Container, *
 	}
 	gateways.File = fileRepo
 
+	// Project archive
+	gateways.ProjectArchiver = archive.NewProjectArchiver()
+
+	// Search
+	switch conf.Search {
+	case "mongo":
+		itemSearch := mongorepo.NewItemSearch(mongox.NewClient(conf.DB_CMS, client))
+		if err := itemSearch.Init(); err != nil {
+			log.Fatalf("search: failed to init mongo index: %+v", err)
+		}
+		gateways.ItemSearch = itemSearch
+		log.Infof("search: mongo is used")
+	case "local":
+		itemSearch, err := fs.NewItemSearch(afero.NewBasePathFs(afero.NewOsFs(), "data"))
+		if err != nil {
+			log.Fatalf("search: failed to load local index: %+v", err)
+		}
+		gateways.ItemSearch = itemSearch
+		log.Infof("search: local index is used")
+	case "":
+		log.Infof("search: not used")
+	default:
+		log.Fatalf("search: unknown search index: %s", conf.Search)
+	}
+
+	// Mailer
+	switch conf.Mailer {
+	case "sendgrid":
+		gateways.Mailer = mailer.NewSendGrid(conf.SendGrid.Name, conf.SendGrid.Email, conf.SendGrid.API)
+		log.Infof("mailer: sendgrid is used")
+	case "smtp":
+		gateways.Mailer = mailer.NewSMTP(conf.SMTP.Host, conf.SMTP.Port, conf.SMTP.SMTPUsername, conf.SMTP.Email, conf.SMTP.Password)
+		log.Infof("mailer: smtp is used")
+	default:
+		gateways.Mailer = mailer.NewLogger()
+		log.Infof("mailer: logger is used")
+	}
+
 	// Auth0
 	auth := auth0.New(conf.Auth0.Domain, conf.Auth0.ClientID, conf.Auth0.ClientSecret)
 	gateways.Authenticator = auth
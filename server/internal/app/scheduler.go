@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/util"
+)
+
+// Scheduler periodically runs the due publish and unpublish schedules of items.
+// Multiple server instances can run it at the same time since the schedules are processed under a lock.
+type Scheduler struct {
+	interval time.Duration
+	usecase  interfaces.Schedule
+}
+
+func NewScheduler(appCtx *ApplicationContext) *Scheduler {
+	interval := appCtx.Config.Scheduler.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &Scheduler{
+		interval: interval,
+		usecase:  interactor.NewSchedule(appCtx.Repos, appCtx.Gateways),
+	}
+}
+
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.tick(ctx)
+		}
+	}
+}
+
+func (s *Scheduler) tick(ctx context.Context) {
+	n, err := s.usecase.RunDue(ctx, util.Now())
+	if err != nil {
+		log.Errorfc(ctx, "scheduler: failed to run schedules: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Infofc(ctx, "scheduler: %d schedules processed", n)
+	}
+}
@@ -0,0 +1,642 @@
+package archive
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// formatVersion is the version of the archive format. It must be increased when the format is changed incompatibly.
+const formatVersion = 1
+
+const (
+	manifestFile = "manifest.json"
+	projectFile  = "project.json"
+	modelsFile   = "models.jsonl"
+	schemasFile  = "schemas.jsonl"
+	groupsFile   = "groups.jsonl"
+	viewsFile    = "views.jsonl"
+	itemsFile    = "items.jsonl"
+	assetsFile   = "assets.jsonl"
+	threadsFile  = "threads.jsonl"
+	assetsDir    = "assets"
+)
+
+const (
+	maxManifestSize = 1 << 20
+	// maxDocumentsSize is the maximum size of a file of documents in an archive
+	maxDocumentsSize = 4 << 30
+	// maxDocumentSize is the maximum size of a line, which is larger than the limit of MongoDB as Extended JSON is verbose
+	maxDocumentSize = 64 << 20
+)
+
+type manifest struct {
+	Version    int       `json:"version"`
+	Project    string    `json:"project"`
+	ExportedAt time.Time `json:"exportedAt"`
+}
+
+type itemVersionDocument struct {
+	Version string
+	Refs    []string
+	Time    time.Time
+	Item    *mongodoc.ItemDocument
+}
+
+type assetDocument struct {
+	Asset *mongodoc.AssetDocument
+	File  *mongodoc.AssetFileDocument
+}
+
+// ProjectArchiver writes projects as zip archives. The entities are stored as the same documents as the database
+// in MongoDB Extended JSON, one document per line, so that the values of the items keep their types.
+type ProjectArchiver struct{}
+
+func NewProjectArchiver() *ProjectArchiver {
+	return &ProjectArchiver{}
+}
+
+func (a *ProjectArchiver) Write(ctx context.Context, w io.Writer, pa *gateway.ProjectArchive) error {
+	if pa == nil || pa.Project == nil {
+		return gateway.ErrInvalidProjectArchive
+	}
+
+	zw := zip.NewWriter(w)
+
+	m, err := json.Marshal(manifest{
+		Version:    formatVersion,
+		Project:    pa.Project.ID().String(),
+		ExportedAt: util.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := writeFile(zw, manifestFile, m); err != nil {
+		return err
+	}
+
+	if err := writeEntities(zw, projectFile, []*project.Project{pa.Project}, mongodoc.NewProject); err != nil {
+		return err
+	}
+	if err := writeEntities(zw, modelsFile, pa.Models, mongodoc.NewModel); err != nil {
+		return err
+	}
+	if err := writeEntities(zw, schemasFile, pa.Schemas, mongodoc.NewSchema); err != nil {
+		return err
+	}
+	if err := writeEntities(zw, groupsFile, pa.Groups, mongodoc.NewGroup); err != nil {
+		return err
+	}
+	if err := writeEntities(zw, viewsFile, pa.Views, mongodoc.NewView); err != nil {
+		return err
+	}
+	if err := writeEntities(zw, itemsFile, pa.Items, func(v item.Versioned) (itemVersionDocument, string) {
+		if v.Value() == nil {
+			return itemVersionDocument{}, ""
+		}
+		d, iid := mongodoc.NewItem(v.Value())
+		return itemVersionDocument{
+			Version: v.Version().String(),
+			Refs:    lo.Map(archivedRefs(v.Refs().Values()), func(r version.Ref, _ int) string { return r.String() }),
+			Time:    v.Time(),
+			Item:    d,
+		}, iid
+	}); err != nil {
+		return err
+	}
+	if err := writeEntities(zw, threadsFile, pa.Threads, mongodoc.NewThread); err != nil {
+		return err
+	}
+	if err := writeEntities(zw, assetsFile, pa.Assets, func(a *asset.Asset) (assetDocument, string) {
+		d, aid := mongodoc.NewAsset(a)
+		return assetDocument{Asset: d, File: mongodoc.NewFile(pa.AssetFiles[a.ID()])}, aid
+	}); err != nil {
+		return err
+	}
+
+	if pa.AssetContent != nil {
+		for _, a := range pa.Assets {
+			if err := writeAssetContent(ctx, zw, pa, a); err != nil {
+				return err
+			}
+		}
+	}
+
+	return zw.Close()
+}
+
+func (a *ProjectArchiver) Read(ctx context.Context, r io.ReaderAt, size int64, wid accountdomain.WorkspaceID) (*gateway.ProjectArchive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, gateway.ErrInvalidProjectArchive
+	}
+	files := lo.SliceToMap(zr.File, func(f *zip.File) (string, *zip.File) { return f.Name, f })
+
+	mf, ok := files[manifestFile]
+	if !ok || mf.UncompressedSize64 > maxManifestSize {
+		return nil, gateway.ErrInvalidProjectArchive
+	}
+	mr, err := mf.Open()
+	if err != nil {
+		return nil, gateway.ErrInvalidProjectArchive
+	}
+	var m manifest
+	err = json.NewDecoder(io.LimitReader(mr, maxManifestSize)).Decode(&m)
+	_ = mr.Close()
+	if err != nil || m.Version != formatVersion {
+		return nil, gateway.ErrInvalidProjectArchive
+	}
+
+	docs, err := readArchiveDocuments(files)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := docs.collectIDs()
+	if err != nil {
+		return nil, err
+	}
+	docs.remap(ids, wid)
+
+	pa := &gateway.ProjectArchive{AssetFiles: map[id.AssetID]*asset.File{}}
+
+	if len(docs.projects) != 1 {
+		return nil, gateway.ErrInvalidProjectArchive
+	}
+	if pa.Project, err = docs.projects[0].Model(); err != nil {
+		return nil, err
+	}
+	if pa.Models, err = util.TryMap(docs.models, (*mongodoc.ModelDocument).Model); err != nil {
+		return nil, err
+	}
+	if pa.Schemas, err = util.TryMap(docs.schemas, (*mongodoc.SchemaDocument).Model); err != nil {
+		return nil, err
+	}
+	if pa.Groups, err = util.TryMap(docs.groups, (*mongodoc.GroupDocument).Model); err != nil {
+		return nil, err
+	}
+	if pa.Views, err = util.TryMap(docs.views, (*mongodoc.ViewDocument).Model); err != nil {
+		return nil, err
+	}
+	if pa.Threads, err = util.TryMap(docs.threads, (*mongodoc.ThreadDocument).Model); err != nil {
+		return nil, err
+	}
+	if pa.Items, err = util.TryMap(docs.items, func(d itemVersionDocument) (item.Versioned, error) {
+		it, err := d.Item.Model()
+		if err != nil {
+			return nil, err
+		}
+		ver, err := uuid.Parse(d.Version)
+		if err != nil {
+			return nil, err
+		}
+		refs := version.NewRefs(archivedRefs(lo.Map(d.Refs, func(r string, _ int) version.Ref { return version.Ref(r) }))...)
+		return version.NewValue(version.Version(ver), nil, refs, d.Time, it), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for _, d := range docs.assets {
+		a, err := d.Asset.Model()
+		if err != nil {
+			return nil, err
+		}
+		pa.Assets = append(pa.Assets, a)
+		if f := d.File.Model(); f != nil {
+			pa.AssetFiles[a.ID()] = f
+		}
+	}
+
+	// the paths of the contents contain the old IDs of the assets
+	contents := map[id.AssetID]*zip.File{}
+	for name, f := range files {
+		dir, _ := path.Split(name)
+		aid, err := id.AssetIDFrom(ids[path.Base(dir)])
+		if err != nil || path.Dir(path.Clean(dir)) != assetsDir {
+			continue
+		}
+		contents[aid] = f
+	}
+	pa.AssetContent = func(_ context.Context, a *asset.Asset) (io.ReadCloser, error) {
+		f, ok := contents[a.ID()]
+		if !ok {
+			return nil, rerror.ErrNotFound
+		}
+		return f.Open()
+	}
+
+	return pa, nil
+}
+
+// archiveDocuments holds the documents of all entities in an archive.
+type archiveDocuments struct {
+	projects []*mongodoc.ProjectDocument
+	models   []*mongodoc.ModelDocument
+	schemas  []*mongodoc.SchemaDocument
+	groups   []*mongodoc.GroupDocument
+	views    []*mongodoc.ViewDocument
+	items    []itemVersionDocument
+	threads  []*mongodoc.ThreadDocument
+	assets   []assetDocument
+}
+
+func readArchiveDocuments(files map[string]*zip.File) (d archiveDocuments, err error) {
+	if d.projects, err = readDocuments[*mongodoc.ProjectDocument](files[projectFile]); err != nil {
+		return
+	}
+	if d.models, err = readDocuments[*mongodoc.ModelDocument](files[modelsFile]); err != nil {
+		return
+	}
+	if d.schemas, err = readDocuments[*mongodoc.SchemaDocument](files[schemasFile]); err != nil {
+		return
+	}
+	if d.groups, err = readDocuments[*mongodoc.GroupDocument](files[groupsFile]); err != nil {
+		return
+	}
+	if d.views, err = readDocuments[*mongodoc.ViewDocument](files[viewsFile]); err != nil {
+		return
+	}
+	if d.items, err = readDocuments[itemVersionDocument](files[itemsFile]); err != nil {
+		return
+	}
+	if d.threads, err = readDocuments[*mongodoc.ThreadDocument](files[threadsFile]); err != nil {
+		return
+	}
+	d.assets, err = readDocuments[assetDocument](files[assetsFile])
+	return
+}
+
+// idMap maps the old IDs of the entities in an archive to the new ones.
+// IDs which are not in the map, such as users and integrations, are kept as they are.
+type idMap map[string]string
+
+func (m idMap) add(old string, newID func() string) {
+	if _, ok := m[old]; old != "" && !ok {
+		m[old] = newID()
+	}
+}
+
+func (m idMap) get(old string) string {
+	if n, ok := m[old]; ok {
+		return n
+	}
+	return old
+}
+
+func (m idMap) ref(old *string) *string {
+	if old == nil {
+		return nil
+	}
+	return lo.ToPtr(m.get(*old))
+}
+
+func (m idMap) list(old []string) []string {
+	return lo.Map(old, func(s string, _ int) string { return m.get(s) })
+}
+
+// collectIDs issues the new IDs of all entities in the archive, keyed by the old IDs.
+func (d archiveDocuments) collectIDs() (idMap, error) {
+	ids := idMap{}
+	for _, p := range d.projects {
+		if p == nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		ids.add(p.ID, func() string { return id.NewProjectID().String() })
+	}
+	for _, m := range d.models {
+		if m == nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		ids.add(m.ID, func() string { return id.NewModelID().String() })
+	}
+	for _, s := range d.schemas {
+		if s == nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		ids.add(s.ID, func() string { return id.NewSchemaID().String() })
+		for _, f := range s.Fields {
+			ids.add(f.ID, func() string { return id.NewFieldID().String() })
+		}
+	}
+	for _, g := range d.groups {
+		if g == nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		ids.add(g.ID, func() string { return id.NewGroupID().String() })
+	}
+	for _, v := range d.views {
+		if v == nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		ids.add(v.ID, func() string { return id.NewViewID().String() })
+	}
+	for _, v := range d.items {
+		if v.Item == nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		ids.add(v.Item.ID, func() string { return id.NewItemID().String() })
+		for _, f := range v.Item.Fields {
+			if f.ItemGroup != nil {
+				ids.add(*f.ItemGroup, func() string { return id.NewItemGroupID().String() })
+			}
+		}
+	}
+	for _, th := range d.threads {
+		if th == nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		ids.add(th.ID, func() string { return id.NewThreadID().String() })
+		for _, c := range th.Comments {
+			if c != nil {
+				ids.add(c.ID, func() string { return id.NewCommentID().String() })
+			}
+		}
+	}
+	for _, a := range d.assets {
+		if a.Asset == nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		ids.add(a.Asset.ID, func() string { return id.NewAssetID().String() })
+	}
+	return ids, nil
+}
+
+// remap replaces the IDs in the documents with the new IDs, and moves the project to the workspace.
+// Only the fields which hold IDs are replaced, so texts in the items are kept as they are even if they look like IDs.
+func (d archiveDocuments) remap(ids idMap, wid accountdomain.WorkspaceID) {
+	for _, p := range d.projects {
+		p.ID = ids.get(p.ID)
+		p.Workspace = wid.String()
+	}
+	for _, m := range d.models {
+		m.ID = ids.get(m.ID)
+		m.Project = ids.get(m.Project)
+		m.Schema = ids.get(m.Schema)
+		m.Metadata = ids.ref(m.Metadata)
+	}
+	for _, s := range d.schemas {
+		s.ID = ids.get(s.ID)
+		s.Workspace = wid.String()
+		s.Project = ids.get(s.Project)
+		s.TitleField = ids.ref(s.TitleField)
+		for i := range s.Fields {
+			remapField(&s.Fields[i], ids)
+		}
+		remapValidationRules(s.ValidationRules, ids)
+	}
+	for _, g := range d.groups {
+		g.ID = ids.get(g.ID)
+		g.Project = ids.get(g.Project)
+		g.Schema = ids.get(g.Schema)
+	}
+	for _, v := range d.views {
+		v.ID = ids.get(v.ID)
+		v.Project = ids.get(v.Project)
+		v.ModelId = ids.get(v.ModelId)
+		v.Schema = ids.get(v.Schema)
+		if v.Sort != nil {
+			remapFieldSelector(&v.Sort.Field, ids)
+		}
+		for i := range v.Columns {
+			remapFieldSelector(&v.Columns[i].Field, ids)
+		}
+		remapFilter(v.Filter, ids)
+	}
+	for _, v := range d.items {
+		it := v.Item
+		it.ID = ids.get(it.ID)
+		it.Project = ids.get(it.Project)
+		it.Schema = ids.get(it.Schema)
+		it.ModelID = ids.get(it.ModelID)
+		it.Thread = ids.ref(it.Thread)
+		it.MetadataItem = ids.ref(it.MetadataItem)
+		it.OriginalItem = ids.ref(it.OriginalItem)
+		it.Assets = ids.list(it.Assets)
+		for i := range it.Fields {
+			f := &it.Fields[i]
+			f.F = ids.get(f.F)
+			f.ItemGroup = ids.ref(f.ItemGroup)
+			remapValue(&f.V, ids)
+			for l, lv := range f.L {
+				remapValue(&lv, ids)
+				f.L[l] = lv
+			}
+		}
+	}
+	for _, th := range d.threads {
+		th.ID = ids.get(th.ID)
+		th.Workspace = wid.String()
+		for _, c := range th.Comments {
+			if c != nil {
+				c.ID = ids.get(c.ID)
+			}
+		}
+	}
+	for _, a := range d.assets {
+		a.Asset.ID = ids.get(a.Asset.ID)
+		a.Asset.Project = ids.get(a.Asset.Project)
+		a.Asset.Thread = ids.ref(a.Asset.Thread)
+	}
+}
+
+func remapField(f *mongodoc.FieldDocument, ids idMap) {
+	f.ID = ids.get(f.ID)
+	if f.DefaultValue != nil {
+		remapValue(f.DefaultValue, ids)
+	}
+	if r := f.TypeProperty.Reference; r != nil {
+		r.Model = ids.get(r.Model)
+		r.Schema = ids.get(r.Schema)
+		r.CorrespondingField = ids.ref(r.CorrespondingField)
+	}
+	if g := f.TypeProperty.Group; g != nil {
+		g.Group = ids.get(g.Group)
+	}
+	remapValidationRules(f.ValidationRules, ids)
+}
+
+func remapValidationRules(rules []mongodoc.ValidationRuleDocument, ids idMap) {
+	for i := range rules {
+		if rules[i].Field != "" {
+			rules[i].Field = ids.get(rules[i].Field)
+		}
+		if rules[i].OtherField != "" {
+			rules[i].OtherField = ids.get(rules[i].OtherField)
+		}
+	}
+}
+
+// remapValue replaces the IDs held by the values of the types referring to other entities.
+func remapValue(v *mongodoc.ValueDocument, ids idMap) {
+	switch value.Type(v.T) {
+	case value.TypeReference, value.TypeAsset, value.TypeGroup:
+		for i, e := range v.V {
+			if s, ok := e.(string); ok {
+				v.V[i] = ids.get(s)
+			}
+		}
+	}
+}
+
+func remapFieldSelector(s *mongodoc.FieldSelectorDocument, ids idMap) {
+	s.Field = ids.ref(s.Field)
+}
+
+func remapFilter(f *mongodoc.FilterDocument, ids idMap) {
+	if f == nil {
+		return
+	}
+	if f.AndCondition != nil {
+		for i := range f.AndCondition.Conditions {
+			remapFilter(&f.AndCondition.Conditions[i], ids)
+		}
+	}
+	if f.OrCondition != nil {
+		for i := range f.OrCondition.Conditions {
+			remapFilter(&f.OrCondition.Conditions[i], ids)
+		}
+	}
+	if c := f.BasicCondition; c != nil {
+		remapFieldSelector(&c.Field, ids)
+	}
+	if c := f.NullableCondition; c != nil {
+		remapFieldSelector(&c.Field, ids)
+	}
+	if c := f.MultipleCondition; c != nil {
+		remapFieldSelector(&c.Field, ids)
+	}
+	if c := f.BoolCondition; c != nil {
+		remapFieldSelector(&c.Field, ids)
+	}
+	if c := f.StringCondition; c != nil {
+		remapFieldSelector(&c.Field, ids)
+	}
+	if c := f.NumberCondition; c != nil {
+		remapFieldSelector(&c.Field, ids)
+	}
+	if c := f.TimeCondition; c != nil {
+		remapFieldSelector(&c.Field, ids)
+	}
+}
+
+func writeAssetContent(ctx context.Context, zw *zip.Writer, pa *gateway.ProjectArchive, a *asset.Asset) error {
+	r, err := pa.AssetContent(ctx, a)
+	if err != nil {
+		return fmt.Errorf("failed to read asset %s: %w", a.ID(), err)
+	}
+	defer func() { _ = r.Close() }()
+
+	w, err := zw.Create(path.Join(assetsDir, a.ID().String(), path.Base(a.FileName())))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
+
+func writeFile(zw *zip.Writer, name string, b []byte) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// writeEntities writes the entities as documents. A nil entity makes the archive invalid rather than writing a null document.
+func writeEntities[E comparable, D any](zw *zip.Writer, name string, entities []E, f func(E) (D, string)) error {
+	var zero E
+	docs, err := util.TryMap(entities, func(e E) (D, error) {
+		if e == zero {
+			return *new(D), gateway.ErrInvalidProjectArchive
+		}
+		d, eid := f(e)
+		if eid == "" {
+			return d, gateway.ErrInvalidProjectArchive
+		}
+		return d, nil
+	})
+	if err != nil {
+		return err
+	}
+	return writeDocuments(zw, name, docs)
+}
+
+func writeDocuments[T any](zw *zip.Writer, name string, docs []T) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	for _, d := range docs {
+		b, err := bson.MarshalExtJSON(d, true, false)
+		if err != nil {
+			return err
+		}
+		if _, err := bw.Write(b); err != nil {
+			return err
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// archivedRefs keeps the latest and public refs. The staging refs of releases and requests are dropped
+// as releases and requests are not archived and their IDs are not remapped.
+func archivedRefs(refs []version.Ref) []version.Ref {
+	return lo.Filter(refs, func(r version.Ref, _ int) bool { return r == version.Latest || r == version.Public })
+}
+
+// readDocuments streams the documents in the file, one per line.
+// The size of the file is limited so that a crafted archive can not exhaust the memory.
+func readDocuments[T any](f *zip.File) ([]T, error) {
+	if f == nil || f.UncompressedSize64 > maxDocumentsSize {
+		return nil, gateway.ErrInvalidProjectArchive
+	}
+	r, err := f.Open()
+	if err != nil {
+		return nil, gateway.ErrInvalidProjectArchive
+	}
+	defer func() { _ = r.Close() }()
+
+	var res []T
+	s := bufio.NewScanner(io.LimitReader(r, maxDocumentsSize))
+	s.Buffer(nil, maxDocumentSize)
+	for s.Scan() {
+		line := s.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var d T
+		if err := bson.UnmarshalExtJSON(line, true, &d); err != nil {
+			return nil, gateway.ErrInvalidProjectArchive
+		}
+		res = append(res, d)
+	}
+	if err := s.Err(); err != nil {
+		return nil, gateway.ErrInvalidProjectArchive
+	}
+	return res, nil
+}
@@ -0,0 +1,70 @@
+package archive
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectArchiver(t *testing.T) {
+	ctx := context.Background()
+	a := NewProjectArchiver()
+	wid, wid2 := accountdomain.NewWorkspaceID(), accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).Alias("project-a").MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.NewKey("model1")).Project(prj.ID()).MustBuild()
+	// a text which looks like an ID is not an ID
+	fid := id.NewFieldID()
+	it := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Fields(item.Fields{
+		item.NewField(fid, value.TypeText.Value(m.ID().String()).AsMultiple(), nil),
+	}).MustBuild()
+
+	assert.ErrorIs(t, a.Write(ctx, &bytes.Buffer{}, &gateway.ProjectArchive{
+		Project: prj,
+		Models:  model.List{nil},
+	}), gateway.ErrInvalidProjectArchive)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, a.Write(ctx, buf, &gateway.ProjectArchive{
+		Project: prj,
+		Models:  model.List{m},
+		Schemas: schema.List{s},
+		// the staging ref of a release is dropped as releases are not archived
+		Items: item.VersionedList{version.NewValue(version.New(), nil, version.NewRefs(version.Latest, release.Ref(id.NewReleaseID())), util.Now(), it)},
+	}))
+
+	got, err := a.Read(ctx, bytes.NewReader(buf.Bytes()), int64(buf.Len()), wid2)
+	require.NoError(t, err)
+	assert.NotEqual(t, prj.ID(), got.Project.ID())
+	assert.Equal(t, wid2, got.Project.Workspace())
+	assert.Equal(t, "project-a", got.Project.Alias())
+	require.Equal(t, 1, len(got.Models))
+	require.Equal(t, 1, len(got.Schemas))
+	assert.NotEqual(t, m.ID(), got.Models[0].ID())
+	assert.Equal(t, got.Project.ID(), got.Models[0].Project())
+	assert.Equal(t, got.Schemas[0].ID(), got.Models[0].Schema())
+	assert.Equal(t, wid2, got.Schemas[0].Workspace())
+	require.Equal(t, 1, len(got.Items))
+	gotItem := got.Items[0].Value()
+	assert.NotEqual(t, it.ID(), gotItem.ID())
+	assert.Equal(t, got.Models[0].ID(), gotItem.Model())
+	assert.Equal(t, m.ID().String(), gotItem.Field(fid).Value().First().Value())
+	assert.Equal(t, []version.Ref{version.Latest}, got.Items[0].Refs().Values())
+
+	_, err = a.Read(ctx, bytes.NewReader([]byte("invalid")), 7, wid2)
+	assert.ErrorIs(t, err, gateway.ErrInvalidProjectArchive)
+}
@@ -59,7 +59,7 @@ func (t *TaskRunner) Retry(ctx context.Context, id string) error {
 
 func (t *TaskRunner) runTaskReq(ctx context.Context, p task.Payload) error {
 	if p.DecompressAsset == nil {
-		return nil
+		return gateway.ErrUnsupportedTask
 	}
 
 	payload := p.DecompressAsset.Payload().DecompressAsset
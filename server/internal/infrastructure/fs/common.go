@@ -8,6 +8,7 @@ import (
 const (
 	fileSizeLimit int64 = 10 * 1024 * 1024 * 1024 // 10GB
 	assetDir            = "assets"
+	searchDir           = "search"
 	defaultBase         = "http://localhost:8080"
 )
 
@@ -0,0 +1,441 @@
+package fs
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/search"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/spf13/afero"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	itemSearchIndexFile   = "items.json"
+	itemSearchJournalFile = "items.log"
+	// itemSearchCompaction is the number of journal entries exceeding the number of documents which triggers the compaction of the journal.
+	itemSearchCompaction = 1000
+	// itemSearchMaxEntrySize is the maximum size of an entry of the journal.
+	itemSearchMaxEntrySize = 16 * 1024 * 1024
+)
+
+// itemSearchScope is the documents of a model at a ref. Every search is done in a scope.
+type itemSearchScope struct {
+	model id.ModelID
+	ref   version.Ref
+}
+
+type itemSearchDocument struct {
+	ID      string       `json:"id"`
+	Project string       `json:"project"`
+	Model   string       `json:"model"`
+	Schema  string       `json:"schema"`
+	Ref     string       `json:"ref"`
+	Terms   search.Terms `json:"terms"`
+}
+
+// itemSearchEntry is a change of the index recorded in the journal.
+type itemSearchEntry struct {
+	Index       *itemSearchDocument `json:"index,omitempty"`
+	Delete      []string            `json:"delete,omitempty"`
+	Ref         *string             `json:"ref,omitempty"`
+	DeleteModel string              `json:"deleteModel,omitempty"`
+}
+
+// itemSearchPostings is an inverted index of the documents in a scope.
+type itemSearchPostings struct {
+	docs  map[id.ItemID]gateway.ItemSearchDocument
+	terms map[string]map[id.ItemID]int
+	// sorted is the sorted terms to find the terms starting with a query token. It is nil when the terms are changed.
+	sorted []string
+}
+
+// itemSearch is an embedded search index which is kept in memory as an inverted index.
+// Changes are appended to a journal, which is compacted into a snapshot when it grows.
+type itemSearch struct {
+	fs        afero.Fs
+	lock      sync.RWMutex
+	scopes    map[itemSearchScope]*itemSearchPostings
+	count     int
+	journaled int
+}
+
+func NewItemSearch(fs afero.Fs) (gateway.ItemSearch, error) {
+	s := &itemSearch{
+		fs:     fs,
+		scopes: map[itemSearchScope]*itemSearchPostings{},
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *itemSearch) Index(_ context.Context, docs []gateway.ItemSearchDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	entries := make([]itemSearchEntry, 0, len(docs))
+	for _, d := range docs {
+		s.index(d)
+		entries = append(entries, itemSearchEntry{Index: newItemSearchDocument(d)})
+	}
+	return s.journal(entries...)
+}
+
+func (s *itemSearch) Delete(_ context.Context, ids id.ItemIDList, ref *version.Ref) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.delete(ids, ref)
+	e := itemSearchEntry{Delete: ids.Strings()}
+	if ref != nil {
+		e.Ref = (*string)(ref)
+	}
+	return s.journal(e)
+}
+
+func (s *itemSearch) DeleteByModel(_ context.Context, mid id.ModelID) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.deleteModel(mid)
+	return s.journal(itemSearchEntry{DeleteModel: mid.String()})
+}
+
+func (s *itemSearch) Search(_ context.Context, q gateway.ItemSearchQuery) ([]gateway.ItemSearchHit, int64, error) {
+	tokens := slices.Compact(slices.Clone(search.Tokenize(q.Keyword)))
+	if len(tokens) == 0 {
+		return nil, 0, nil
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	p := s.scopes[itemSearchScope{model: q.Model, ref: q.Ref}]
+	if p == nil {
+		return nil, 0, nil
+	}
+
+	// every token has to match, so the scores are intersected token by token
+	var scores map[id.ItemID]int
+	for _, tok := range tokens {
+		next := map[id.ItemID]int{}
+		for _, term := range p.prefixed(tok) {
+			w := 1
+			if term == tok {
+				w = 2
+			}
+			for iid, f := range p.terms[term] {
+				if scores != nil {
+					if _, ok := scores[iid]; !ok {
+						continue
+					}
+				}
+				next[iid] += f * w
+			}
+		}
+		for iid, sc := range scores {
+			if _, ok := next[iid]; ok {
+				next[iid] += sc
+			}
+		}
+		if scores = next; len(scores) == 0 {
+			return nil, 0, nil
+		}
+	}
+
+	res := make([]gateway.ItemSearchHit, 0, len(scores))
+	for iid, sc := range scores {
+		d := p.docs[iid]
+		if d.Project != q.Project || (q.Schema != nil && d.Schema != *q.Schema) {
+			continue
+		}
+		res = append(res, gateway.ItemSearchHit{ID: iid, Score: float64(sc) / float64(len(tokens))})
+	}
+
+	gateway.SortItemSearchHits(res)
+	total := int64(len(res))
+	if q.Offset >= total {
+		return nil, total, nil
+	}
+	res = res[q.Offset:]
+	if q.Limit > 0 && int64(len(res)) > q.Limit {
+		res = res[:q.Limit]
+	}
+	return res, total, nil
+}
+
+func (s *itemSearch) index(d gateway.ItemSearchDocument) {
+	k := itemSearchScope{model: d.Model, ref: d.Ref}
+	p := s.scopes[k]
+	if p == nil {
+		p = &itemSearchPostings{
+			docs:  map[id.ItemID]gateway.ItemSearchDocument{},
+			terms: map[string]map[id.ItemID]int{},
+		}
+		s.scopes[k] = p
+	}
+	if !p.remove(d.ID) {
+		s.count++
+	}
+	p.docs[d.ID] = d
+	for t, f := range d.Terms {
+		if p.terms[t] == nil {
+			p.terms[t] = map[id.ItemID]int{}
+			p.sorted = nil
+		}
+		p.terms[t][d.ID] = f
+	}
+}
+
+func (s *itemSearch) delete(ids id.ItemIDList, ref *version.Ref) {
+	for k, p := range s.scopes {
+		if ref != nil && k.ref != *ref {
+			continue
+		}
+		for _, iid := range ids {
+			if p.remove(iid) {
+				s.count--
+			}
+		}
+	}
+}
+
+func (s *itemSearch) deleteModel(mid id.ModelID) {
+	for k, p := range s.scopes {
+		if k.model == mid {
+			s.count -= len(p.docs)
+			delete(s.scopes, k)
+		}
+	}
+}
+
+// remove removes the document from the postings and returns whether it existed.
+func (p *itemSearchPostings) remove(iid id.ItemID) bool {
+	d, ok := p.docs[iid]
+	if !ok {
+		return false
+	}
+	delete(p.docs, iid)
+	for t := range d.Terms {
+		delete(p.terms[t], iid)
+		if len(p.terms[t]) == 0 {
+			delete(p.terms, t)
+			p.sorted = nil
+		}
+	}
+	return true
+}
+
+// prefixed returns the terms starting with the token. It has to be called with the write lock as it may sort the terms.
+func (p *itemSearchPostings) prefixed(tok string) []string {
+	if p.sorted == nil {
+		p.sorted = make([]string, 0, len(p.terms))
+		for t := range p.terms {
+			p.sorted = append(p.sorted, t)
+		}
+		sort.Strings(p.sorted)
+	}
+	var res []string
+	for i := sort.SearchStrings(p.sorted, tok); i < len(p.sorted) && strings.HasPrefix(p.sorted[i], tok); i++ {
+		res = append(res, p.sorted[i])
+	}
+	return res
+}
+
+func (s *itemSearch) load() error {
+	b, err := afero.ReadFile(s.fs, path.Join(searchDir, itemSearchIndexFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return rerror.ErrInternalBy(err)
+	}
+	if err == nil {
+		var docs []itemSearchDocument
+		if err := json.Unmarshal(b, &docs); err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		for _, d := range docs {
+			if err := s.apply(itemSearchEntry{Index: &d}); err != nil {
+				return err
+			}
+		}
+	}
+
+	f, err := s.fs.Open(path.Join(searchDir, itemSearchJournalFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return rerror.ErrInternalBy(err)
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, itemSearchMaxEntrySize)
+	for sc.Scan() {
+		var e itemSearchEntry
+		if err := json.Unmarshal(sc.Bytes(), &e); err != nil {
+			// the last entry may be broken by a crash while it was written
+			break
+		}
+		if err := s.apply(e); err != nil {
+			return err
+		}
+		s.journaled++
+	}
+	if s.journaled > 0 {
+		return s.compact()
+	}
+	return nil
+}
+
+// apply applies the entry of the journal to the index.
+func (s *itemSearch) apply(e itemSearchEntry) error {
+	switch {
+	case e.Index != nil:
+		d, err := e.Index.Into()
+		if err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		s.index(d)
+	case len(e.Delete) > 0:
+		ids, err := id.ItemIDListFrom(e.Delete)
+		if err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		s.delete(ids, (*version.Ref)(e.Ref))
+	case e.DeleteModel != "":
+		mid, err := id.ModelIDFrom(e.DeleteModel)
+		if err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		s.deleteModel(mid)
+	}
+	return nil
+}
+
+// journal appends the entries to the journal, and compacts the journal when it has grown enough.
+func (s *itemSearch) journal(entries ...itemSearchEntry) error {
+	if s.journaled+len(entries) > s.count+itemSearchCompaction {
+		return s.compact()
+	}
+
+	var b []byte
+	for _, e := range entries {
+		l, err := json.Marshal(e)
+		if err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		b = append(append(b, l...), '\n')
+	}
+	if err := s.fs.MkdirAll(searchDir, 0755); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	f, err := s.fs.OpenFile(path.Join(searchDir, itemSearchJournalFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return rerror.ErrInternalBy(err)
+	}
+	if err := f.Close(); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	s.journaled += len(entries)
+	return nil
+}
+
+// compact writes the whole index to a temporary file, renames it so a crash does not leave a broken index, and clears the journal.
+func (s *itemSearch) compact() error {
+	docs := make([]*itemSearchDocument, 0, s.count)
+	for _, p := range s.scopes {
+		for _, d := range p.docs {
+			docs = append(docs, newItemSearchDocument(d))
+		}
+	}
+	slices.SortFunc(docs, func(a, b *itemSearchDocument) int {
+		if a.ID != b.ID {
+			return strings.Compare(a.ID, b.ID)
+		}
+		return strings.Compare(a.Ref, b.Ref)
+	})
+
+	b, err := json.Marshal(docs)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	if err := s.fs.MkdirAll(searchDir, 0755); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	p := path.Join(searchDir, itemSearchIndexFile)
+	if err := afero.WriteFile(s.fs, p+".tmp", b, 0644); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	if err := s.fs.Rename(p+".tmp", p); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	if err := s.fs.Remove(path.Join(searchDir, itemSearchJournalFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return rerror.ErrInternalBy(err)
+	}
+	s.journaled = 0
+	return nil
+}
+
+func newItemSearchDocument(d gateway.ItemSearchDocument) *itemSearchDocument {
+	return &itemSearchDocument{
+		ID:      d.ID.String(),
+		Project: d.Project.String(),
+		Model:   d.Model.String(),
+		Schema:  d.Schema.String(),
+		Ref:     string(d.Ref),
+		Terms:   d.Terms,
+	}
+}
+
+func (d *itemSearchDocument) Into() (gateway.ItemSearchDocument, error) {
+	iid, err := id.ItemIDFrom(d.ID)
+	if err != nil {
+		return gateway.ItemSearchDocument{}, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return gateway.ItemSearchDocument{}, err
+	}
+	mid, err := id.ModelIDFrom(d.Model)
+	if err != nil {
+		return gateway.ItemSearchDocument{}, err
+	}
+	sid, err := id.SchemaIDFrom(d.Schema)
+	if err != nil {
+		return gateway.ItemSearchDocument{}, err
+	}
+	return gateway.ItemSearchDocument{
+		ID:      iid,
+		Project: pid,
+		Model:   mid,
+		Schema:  sid,
+		Ref:     version.Ref(d.Ref),
+		Terms:   d.Terms,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package fs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/search"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemSearch(t *testing.T) {
+	ctx := context.Background()
+	fs := afero.NewMemMapFs()
+	pid, mid, sid := id.NewProjectID(), id.NewModelID(), id.NewSchemaID()
+	i1, i2, i3 := id.NewItemID(), id.NewItemID(), id.NewItemID()
+
+	terms := func(text string, weight int) search.Terms {
+		t := search.NewTerms()
+		t.Add(text, weight)
+		return t
+	}
+	doc := func(iid id.ItemID, mid id.ModelID, ref version.Ref, t search.Terms) gateway.ItemSearchDocument {
+		return gateway.ItemSearchDocument{ID: iid, Project: pid, Model: mid, Schema: sid, Ref: ref, Terms: t}
+	}
+
+	s, err := NewItemSearch(fs)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Index(ctx, []gateway.ItemSearchDocument{
+		doc(i1, mid, version.Latest, terms("東京都の天気", 1)),
+		doc(i2, mid, version.Latest, terms("東京タワー", 3)),
+		doc(i2, mid, version.Public, terms("東京タワー", 3)),
+		doc(i3, id.NewModelID(), version.Latest, terms("東京", 1)),
+	}))
+
+	q := gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "東京"}
+	hits, total, err := s.Search(ctx, q)
+	assert.NoError(t, err)
+	assert.Equal(t, []gateway.ItemSearchHit{{ID: i2, Score: 4.5}, {ID: i1, Score: 1.5}}, hits)
+	assert.Equal(t, int64(2), total)
+
+	q.Limit = 1
+	hits, total, err = s.Search(ctx, q)
+	assert.NoError(t, err)
+	assert.Equal(t, []gateway.ItemSearchHit{{ID: i2, Score: 4.5}}, hits)
+	assert.Equal(t, int64(2), total)
+
+	q.Offset = 1
+	hits, total, err = s.Search(ctx, q)
+	assert.NoError(t, err)
+	assert.Equal(t, []gateway.ItemSearchHit{{ID: i1, Score: 1.5}}, hits)
+	assert.Equal(t, int64(2), total)
+
+	q.Offset = 2
+	hits, total, err = s.Search(ctx, q)
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+	assert.Equal(t, int64(2), total)
+
+	// every token has to match
+	hits, _, err = s.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "東京 天気"})
+	assert.NoError(t, err)
+	assert.Equal(t, []gateway.ItemSearchHit{{ID: i1, Score: 1.75}}, hits)
+
+	hits, _, err = s.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "天気"})
+	assert.NoError(t, err)
+	assert.Equal(t, []gateway.ItemSearchHit{{ID: i1, Score: 2}}, hits)
+
+	hits, _, err = s.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: " "})
+	assert.NoError(t, err)
+	assert.Nil(t, hits)
+
+	// the index is loaded from the journal
+	exists, _ := afero.Exists(fs, "search/items.log")
+	assert.True(t, exists)
+	s2, err := NewItemSearch(fs)
+	assert.NoError(t, err)
+	hits, _, err = s2.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Public, Keyword: "タワー"})
+	assert.NoError(t, err)
+	assert.Equal(t, []gateway.ItemSearchHit{{ID: i2, Score: 6}}, hits)
+
+	assert.NoError(t, s2.Delete(ctx, id.ItemIDList{i2}, version.Public.Ref()))
+	hits, _, err = s2.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Public, Keyword: "タワー"})
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+	hits, _, err = s2.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "タワー"})
+	assert.NoError(t, err)
+	assert.Len(t, hits, 1)
+
+	assert.NoError(t, s2.Delete(ctx, id.ItemIDList{i2}, nil))
+	assert.NoError(t, s2.DeleteByModel(ctx, mid))
+	hits, _, err = s2.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "東京"})
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+
+	// the journal was compacted into the snapshot when it was loaded, and the changes after that are loaded again
+	s3, err := NewItemSearch(fs)
+	assert.NoError(t, err)
+	hits, _, err = s3.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "東京"})
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+	assert.Equal(t, 1, s3.(*itemSearch).count)
+}
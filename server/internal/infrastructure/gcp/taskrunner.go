@@ -79,7 +79,10 @@ func (t *TaskRunner) runCloudBuild(ctx context.Context, p task.Payload) error {
 	if p.Import != nil {
 		return importItems(ctx, p, t.conf)
 	}
-	return nil
+	if p.ExtractAssetMetadata != nil {
+		return extractAssetMetadata(ctx, p, t.conf)
+	}
+	return gateway.ErrUnsupportedTask
 }
 
 func decompressAsset(ctx context.Context, p task.Payload, conf *TaskConfig) error {
@@ -223,16 +226,6 @@ func importItems(ctx context.Context, p task.Payload, conf *TaskConfig) error {
 		return rerror.Fmt("invalid import payload")
 	}
 
-	cb, err := cloudbuild.NewService(ctx)
-	if err != nil {
-		return rerror.ErrInternalBy(err)
-	}
-
-	project := conf.GCPProject
-	account := conf.BuildServiceAccount
-	region := conf.GCPRegion
-	singleDb := conf.DBName == conf.AccountDBName
-
 	args := []string{
 		"item",
 		"import",
@@ -251,6 +244,33 @@ func importItems(ctx context.Context, p task.Payload, conf *TaskConfig) error {
 		args = append(args, "-integrationId="+p.Import.IntegrationId)
 	}
 
+	return runCmsCommand(ctx, conf, "cms_import-items", args)
+}
+
+func extractAssetMetadata(ctx context.Context, p task.Payload, conf *TaskConfig) error {
+	if p.ExtractAssetMetadata.AssetID == "" {
+		return rerror.Fmt("invalid extract asset metadata payload")
+	}
+
+	return runCmsCommand(ctx, conf, "cms_extract-asset-metadata", []string{
+		"asset",
+		"metadata",
+		"-assetId=" + p.ExtractAssetMetadata.AssetID,
+	})
+}
+
+// runCmsCommand runs a command of the CMS image on Cloud Build with the access to the databases.
+func runCmsCommand(ctx context.Context, conf *TaskConfig, tag string, args []string) error {
+	cb, err := cloudbuild.NewService(ctx)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	project := conf.GCPProject
+	account := conf.BuildServiceAccount
+	region := conf.GCPRegion
+	singleDb := conf.DBName == conf.AccountDBName
+
 	availableSecrets := []*cloudbuild.SecretManagerSecret{
 		{
 			VersionName: fmt.Sprintf("projects/%s/secrets/%s/versions/latest", project, conf.DBSecretName),
@@ -269,7 +289,7 @@ func importItems(ctx context.Context, p task.Payload, conf *TaskConfig) error {
 	}
 
 	build := &cloudbuild.Build{
-		Tags:     []string{"cms_import-items"},
+		Tags:     []string{tag},
 		Timeout:  "86400s", // 1 day
 		QueueTtl: "86400s", // 1 day
 		Steps: []*cloudbuild.BuildStep{
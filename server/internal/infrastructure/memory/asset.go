@@ -14,21 +14,24 @@ import (
 )
 
 type Asset struct {
-	data *util.SyncMap[asset.ID, *asset.Asset]
-	err  error
-	f    repo.ProjectFilter
+	data  *util.SyncMap[asset.ID, *asset.Asset]
+	trash *util.SyncMap[asset.ID, *asset.Asset]
+	err   error
+	f     repo.ProjectFilter
 }
 
 func NewAsset() repo.Asset {
 	return &Asset{
-		data: &util.SyncMap[id.AssetID, *asset.Asset]{},
+		data:  &util.SyncMap[id.AssetID, *asset.Asset]{},
+		trash: &util.SyncMap[id.AssetID, *asset.Asset]{},
 	}
 }
 
 func (r *Asset) Filtered(f repo.ProjectFilter) repo.Asset {
 	return &Asset{
-		data: r.data,
-		f:    r.f.Merge(f),
+		data:  r.data,
+		trash: r.trash,
+		f:     r.f.Merge(f),
 	}
 }
 
@@ -86,7 +89,7 @@ func (r *Asset) Search(_ context.Context, id id.ProjectID, filter repo.AssetFilt
 		}
 		// Content type filter can't be performed as it's not stored in memory
 
-		return true
+		return filter.Metadata.Match(v.Metadata())
 	})).SortByID()
 
 	var startCursor, endCursor *usecasex.Cursor
@@ -140,3 +143,37 @@ func (r *Asset) BatchDelete(_ context.Context, ids id.AssetIDList) error {
 	}
 	return nil
 }
+
+func (r *Asset) Trash(_ context.Context, id id.AssetID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	a, ok := r.data.Load(id)
+	if !ok {
+		return rerror.ErrNotFound
+	}
+	if !r.f.CanWrite(a.Project()) {
+		return repo.ErrOperationDenied
+	}
+	r.data.Delete(id)
+	r.trash.Store(id, a)
+	return nil
+}
+
+func (r *Asset) Restore(_ context.Context, id id.AssetID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	a, ok := r.trash.Load(id)
+	if !ok {
+		return rerror.ErrNotFound
+	}
+	if !r.f.CanWrite(a.Project()) {
+		return repo.ErrOperationDenied
+	}
+	r.trash.Delete(id)
+	r.data.Store(id, a)
+	return nil
+}
@@ -3,6 +3,7 @@ package memory
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
@@ -396,6 +397,49 @@ func TestAssetRepo_Search(t *testing.T) {
 	}
 }
 
+func TestAssetRepo_SearchByMetadata(t *testing.T) {
+	pid := id.NewProjectID()
+	tim := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	newAsset := func(m *asset.Metadata) *asset.Asset {
+		return asset.New().NewID().Project(pid).CreatedAt(tim).NewUUID().CreatedByUser(accountdomain.NewUserID()).
+			Size(1000).Metadata(m).MustBuild()
+	}
+	image := newAsset(&asset.Metadata{Width: lo.ToPtr(800), Height: lo.ToPtr(600), CapturedAt: &tim, Location: &asset.Location{Lat: 35, Lng: 139}})
+	geo := newAsset(&asset.Metadata{BBox: &asset.BBox{MinLng: 130, MinLat: 30, MaxLng: 135, MaxLat: 35}, FeatureCount: lo.ToPtr(3)})
+	table := newAsset(&asset.Metadata{Headers: []string{"id", "name"}, RowCount: lo.ToPtr(2)})
+	other := newAsset(nil)
+
+	tests := []struct {
+		name   string
+		filter *asset.MetadataFilter
+		want   asset.List
+	}{
+		{name: "no filter", filter: nil, want: asset.List{image, geo, table, other}},
+		{name: "width", filter: &asset.MetadataFilter{MinWidth: lo.ToPtr(800), MaxWidth: lo.ToPtr(1000)}, want: asset.List{image}},
+		{name: "height", filter: &asset.MetadataFilter{MaxHeight: lo.ToPtr(599)}, want: nil},
+		{name: "captured at", filter: &asset.MetadataFilter{CapturedAfter: &tim, CapturedBefore: &tim}, want: asset.List{image}},
+		{name: "bbox", filter: &asset.MetadataFilter{BBox: &asset.BBox{MinLng: 134, MinLat: 34, MaxLng: 140, MaxLat: 36}}, want: asset.List{image, geo}},
+		{name: "header", filter: &asset.MetadataFilter{Header: lo.ToPtr("name")}, want: asset.List{table}},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewAsset()
+			ctx := context.Background()
+			for _, a := range (asset.List{image, geo, table, other}) {
+				assert.NoError(t, r.Save(ctx, a))
+			}
+
+			got, _, err := r.Search(ctx, pid, repo.AssetFilter{Metadata: tc.filter})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
 func TestAssetRepo_Delete(t *testing.T) {
 	pid1 := id.NewProjectID()
 	id1 := id.NewAssetID()
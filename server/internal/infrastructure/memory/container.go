@@ -20,6 +20,11 @@ func New() *repo.Container {
 		Model:             NewModel(),
 		Item:              NewItem(),
 		View:              NewView(),
+		Schedule:          NewSchedule(),
+		ItemTemplate:      NewItemTemplate(),
+		Trash:             NewTrash(),
+		Release:           NewRelease(),
+		PreviewToken:      NewPreviewToken(),
 		Schema:            NewSchema(),
 		Integration:       NewIntegration(),
 		Thread:            NewThread(),
@@ -27,6 +32,9 @@ func New() *repo.Container {
 		Group:             NewGroup(),
 		WorkspaceSettings: NewWorkspaceSettings(),
 		Transaction:       &usecasex.NopTransaction{},
+		// notifications
+		Notification:           NewNotification(),
+		NotificationPreference: NewNotificationPreference(),
 	}
 }
 
@@ -21,14 +21,16 @@ import (
 )
 
 type Item struct {
-	data *memorygit.VersionedSyncMap[item.ID, *item.Item]
-	f    repo.ProjectFilter
-	err  error
+	data  *memorygit.VersionedSyncMap[item.ID, *item.Item]
+	trash *memorygit.VersionedSyncMap[item.ID, *item.Item]
+	f     repo.ProjectFilter
+	err   error
 }
 
 func NewItem() repo.Item {
 	return &Item{
-		data: memorygit.NewVersionedSyncMap[item.ID, *item.Item](),
+		data:  memorygit.NewVersionedSyncMap[item.ID, *item.Item](),
+		trash: memorygit.NewVersionedSyncMap[item.ID, *item.Item](),
 	}
 }
 
@@ -39,7 +41,7 @@ func (r *Item) FindByAssets(_ context.Context, list id.AssetIDList, ref *version
 
 	var res item.VersionedList
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
-		itv := v.Get(ref.OrLatest().OrVersion())
+		itv := getRef(v, ref)
 		it := itv.Value()
 		if it.AssetIDs().Has(list...) {
 			res = append(res, itv)
@@ -51,8 +53,9 @@ func (r *Item) FindByAssets(_ context.Context, list id.AssetIDList, ref *version
 
 func (r *Item) Filtered(filter repo.ProjectFilter) repo.Item {
 	return &Item{
-		data: r.data,
-		f:    r.f.Merge(filter),
+		data:  r.data,
+		trash: r.trash,
+		f:     r.f.Merge(filter),
 	}
 }
 
@@ -61,6 +64,14 @@ func (r *Item) FindByID(_ context.Context, itemID id.ItemID, ref *version.Ref) (
 		return nil, r.err
 	}
 
+	if ref != nil && (ref.IsStaging() || ref.IsSnapshot()) {
+		itv := getRef(r.data.LoadAllVersions(itemID), ref)
+		if itv == nil {
+			return nil, rerror.ErrNotFound
+		}
+		return itv, nil
+	}
+
 	item, ok := r.data.Load(itemID, ref.OrLatest().OrVersion())
 	if !ok {
 		return nil, rerror.ErrNotFound
@@ -75,7 +86,7 @@ func (r *Item) FindBySchema(_ context.Context, schemaID id.SchemaID, ref *versio
 
 	var res item.VersionedList
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
-		itv := v.Get(ref.OrLatest().OrVersion())
+		itv := getRef(v, ref)
 		it := itv.Value()
 		if it.Schema() == schemaID && r.f.CanRead(it.Project()) {
 			res = append(res, itv)
@@ -92,7 +103,10 @@ func (r *Item) FindByModel(_ context.Context, modelID id.ModelID, ref *version.R
 
 	var res item.VersionedList
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
-		itv := v.Get(ref.OrLatest().OrVersion())
+		itv := getRef(v, ref)
+		if itv == nil {
+			return true
+		}
 		it := itv.Value()
 		if it.Model() == modelID {
 			res = append(res, itv)
@@ -108,6 +122,13 @@ func (r *Item) FindByIDs(_ context.Context, list id.ItemIDList, ref *version.Ref
 		return nil, r.err
 	}
 
+	if ref != nil && (ref.IsStaging() || ref.IsSnapshot()) {
+		return lo.FilterMap(list, func(iid id.ItemID, _ int) (item.Versioned, bool) {
+			itv := getRef(r.data.LoadAllVersions(iid), ref)
+			return itv, itv != nil
+		}), nil
+	}
+
 	return r.data.LoadAll(list, lo.ToPtr(ref.OrLatest().OrVersion())), nil
 }
 
@@ -200,6 +221,25 @@ func (r *Item) UpdateRef(_ context.Context, item id.ItemID, ref version.Ref, vr
 	return nil
 }
 
+func (r *Item) ReplaceVersion(_ context.Context, v item.Versioned) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if !r.f.CanWrite(v.Value().Project()) {
+		return repo.ErrOperationDenied
+	}
+	if _, ok := r.data.Load(v.Value().ID(), v.Version().OrRef()); !ok {
+		return rerror.ErrNotFound
+	}
+	if r.data.IsArchived(v.Value().ID()) {
+		return version.ErrArchived
+	}
+
+	r.data.Replace(v.Value().ID(), v.Version(), v.Value())
+	return nil
+}
+
 func (r *Item) Remove(_ context.Context, itemID id.ItemID) error {
 	if r.err != nil {
 		return r.err
@@ -249,6 +289,37 @@ func (r *Item) Archive(_ context.Context, itemID id.ItemID, projectID id.Project
 	return nil
 }
 
+func (r *Item) Trash(_ context.Context, itemID id.ItemID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return moveItem(r.data, r.trash, itemID, r.f)
+}
+
+func (r *Item) Restore(_ context.Context, itemID id.ItemID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return moveItem(r.trash, r.data, itemID, r.f)
+}
+
+func moveItem(from, to *memorygit.VersionedSyncMap[item.ID, *item.Item], itemID id.ItemID, f repo.ProjectFilter) error {
+	iv, _ := from.Load(itemID, version.Latest.OrVersion())
+	if iv == nil {
+		return rerror.ErrNotFound
+	}
+	if !f.CanWrite(iv.Value().Project()) {
+		return repo.ErrOperationDenied
+	}
+
+	if v, ok := from.LoadAndDelete(itemID); ok {
+		to.Store(itemID, v)
+	}
+	return nil
+}
+
 func SetItemError(r repo.Item, err error) {
 	r.(*Item).err = err
 }
@@ -271,20 +342,29 @@ func (r *Item) Search(_ context.Context, sp schema.Package, q *item.Query, pagin
 
 	var res item.VersionedList
 	qq := q.Keyword()
+	ids := q.IDs()
 
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
-		it := v.Get(version.Latest.OrVersion())
+		it := getRef(v, q.Ref())
+		if it == nil {
+			return true
+		}
 		itv := it.Value()
-		_, searchMatched := lo.Find(itv.Fields(), func(f *item.Field) bool {
-			return lo.SomeBy(f.Value().Values(), func(v *value.Value) bool {
-				if s, ok := v.ValueString(); ok {
-					if strings.Contains(s, qq) {
-						return true
+		var searchMatched bool
+		if ids != nil {
+			searchMatched = ids.Has(itv.ID())
+		} else {
+			_, searchMatched = lo.Find(itv.Fields(), func(f *item.Field) bool {
+				return lo.SomeBy(f.Value().Values(), func(v *value.Value) bool {
+					if s, ok := v.ValueString(); ok {
+						if strings.Contains(s, qq) {
+							return true
+						}
 					}
-				}
-				return false
+					return false
+				})
 			})
-		})
+		}
 		schemaMatched := q.Schema() == nil || itv.Schema() == *q.Schema()
 		modelMatched := itv.Model() == q.Model()
 		if searchMatched && schemaMatched && modelMatched && r.f.CanRead(itv.Project()) {
@@ -292,6 +372,13 @@ func (r *Item) Search(_ context.Context, sp schema.Package, q *item.Query, pagin
 		}
 		return true
 	})
+
+	// items found by the search index are ordered by relevance
+	if ids != nil {
+		slices.SortStableFunc(res, func(a, b item.Versioned) int {
+			return slices.Index(ids, a.Value().ID()) - slices.Index(ids, b.Value().ID())
+		})
+	}
 	return res, nil, nil
 }
 
@@ -302,12 +389,19 @@ func (r *Item) FindByModelAndValue(_ context.Context, modelID id.ModelID, fields
 
 	var res item.VersionedList
 	r.data.Range(func(k item.ID, v *version.Values[*item.Item]) bool {
-		itv := v.Get(ref.OrLatest().OrVersion())
+		itv := getRef(v, ref)
+		if itv == nil {
+			return true
+		}
 		it := itv.Value()
 		if it.Model() == modelID {
 			for _, f := range fields {
 				for _, ff := range it.Fields() {
-					if f.Field == ff.FieldID() && f.Value.Equal(ff.Value()) {
+					v := ff.Value()
+					if f.Locale != "" {
+						v = ff.LocaleValue(f.Locale)
+					}
+					if f.Field == ff.FieldID() && f.Value.Equal(v) {
 						res = append(res, itv)
 					}
 				}
@@ -393,3 +487,21 @@ func (r *Item) Copy(ctx context.Context, params repo.CopyParams) (*string, *stri
 
 	return lo.ToPtr(string(filter)), lo.ToPtr(string(changes)), nil
 }
+
+// getRef returns the version of the ref. Items which are not staged at a staging ref are read from the public ref to preview them.
+// Snapshot refs read the versions which the ref pointed at the time.
+func getRef(v *version.Values[*item.Item], ref *version.Ref) *version.Value[*item.Item] {
+	if ref == nil {
+		return v.Get(version.Latest.OrVersion())
+	}
+	if r, t, ok := ref.Snapshot(); ok {
+		return v.GetAt(r, t)
+	}
+	if ref.IsStaging() {
+		if itv := v.Get(ref.OrVersion()); itv != nil {
+			return itv
+		}
+		return v.Get(version.Public.OrVersion())
+	}
+	return v.Get(ref.OrLatest().OrVersion())
+}
@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+type ItemTemplate struct {
+	data *util.SyncMap[id.ItemTemplateID, *template.Template]
+	f    repo.ProjectFilter
+	err  error
+}
+
+func NewItemTemplate() repo.ItemTemplate {
+	return &ItemTemplate{
+		data: &util.SyncMap[id.ItemTemplateID, *template.Template]{},
+	}
+}
+
+func (r *ItemTemplate) Filtered(f repo.ProjectFilter) repo.ItemTemplate {
+	return &ItemTemplate{
+		data: r.data,
+		f:    r.f.Merge(f),
+		err:  r.err,
+	}
+}
+
+func (r *ItemTemplate) FindByID(_ context.Context, tid id.ItemTemplateID) (*template.Template, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	t := r.data.Find(func(k id.ItemTemplateID, t *template.Template) bool {
+		return k == tid && r.f.CanRead(t.Project())
+	})
+	if t == nil {
+		return nil, rerror.ErrNotFound
+	}
+	return t.Clone(), nil
+}
+
+func (r *ItemTemplate) FindByModel(_ context.Context, mid id.ModelID) (template.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res := r.data.FindAll(func(_ id.ItemTemplateID, t *template.Template) bool {
+		return t.Model() == mid && r.f.CanRead(t.Project())
+	})
+	return util.Map(template.List(res).SortByName(), func(t *template.Template) *template.Template {
+		return t.Clone()
+	}), nil
+}
+
+func (r *ItemTemplate) Save(_ context.Context, t *template.Template) error {
+	if r.err != nil {
+		return r.err
+	}
+	if !r.f.CanWrite(t.Project()) {
+		return repo.ErrOperationDenied
+	}
+
+	r.data.Store(t.ID(), t.Clone())
+	return nil
+}
+
+func (r *ItemTemplate) Remove(_ context.Context, tid id.ItemTemplateID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if t, ok := r.data.Load(tid); ok && r.f.CanWrite(t.Project()) {
+		r.data.Delete(tid)
+		return nil
+	}
+	return rerror.ErrNotFound
+}
+
+func SetItemTemplateError(r repo.ItemTemplate, err error) {
+	r.(*ItemTemplate).err = err
+}
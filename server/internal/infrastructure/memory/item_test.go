@@ -254,6 +254,32 @@ func TestItem_UpdateRef(t *testing.T) {
 	assert.Same(t, wantErr, r.UpdateRef(ctx, i.ID(), vx, v.Version().OrRef().Ref()))
 }
 
+func TestItem_ReplaceVersion(t *testing.T) {
+	ctx := context.Background()
+	sid, fid, pid := id.NewSchemaID(), id.NewFieldID(), id.NewProjectID()
+	newItem := func(iid id.ItemID, v string) *item.Item {
+		return item.New().ID(iid).Schema(sid).Model(id.NewModelID()).Project(pid).Thread(id.NewThreadID().Ref()).
+			Fields([]*item.Field{item.NewField(fid, value.TypeText.Value(v).AsMultiple(), nil)}).MustBuild()
+	}
+	iid := id.NewItemID()
+	r := NewItem()
+	_ = r.Save(ctx, newItem(iid, "a"))
+	_ = r.Save(ctx, newItem(iid, "b"))
+	versions, _ := r.FindAllVersionsByID(ctx, iid)
+
+	assert.NoError(t, r.ReplaceVersion(ctx, version.ValueFrom(versions[0], newItem(iid, "c"))))
+	got, _ := r.FindVersionByID(ctx, iid, versions[0].Version().OrRef())
+	assert.Equal(t, "c", got.Value().Field(fid).Value().First().Interface())
+	got, _ = r.FindByID(ctx, iid, nil)
+	assert.Equal(t, versions[1].Version(), got.Version())
+	assert.Equal(t, "b", got.Value().Field(fid).Value().First().Interface())
+
+	assert.Equal(t, rerror.ErrNotFound, r.ReplaceVersion(ctx, version.NewValue(version.New(), nil, nil, time.Time{}, newItem(iid, "d"))))
+
+	r = NewItem().Filtered(repo.ProjectFilter{Readable: []id.ProjectID{pid}, Writable: []id.ProjectID{}})
+	assert.Equal(t, repo.ErrOperationDenied, r.ReplaceVersion(ctx, version.ValueFrom(versions[0], newItem(iid, "c"))))
+}
+
 func TestItem_FindByAssets(t *testing.T) {
 	ctx := context.Background()
 	sid := id.NewSchemaID()
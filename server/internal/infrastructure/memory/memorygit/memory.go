@@ -97,6 +97,17 @@ func (m *VersionedSyncMap[K, V]) UpdateRef(key K, ref version.Ref, vr *version.V
 	})
 }
 
+func (m *VersionedSyncMap[K, V]) Replace(key K, ver version.Version, value V) (res bool) {
+	m.Range(func(k K, v *version.Values[V]) bool {
+		if k == key {
+			res = v.Replace(ver, value)
+			return false
+		}
+		return true
+	})
+	return
+}
+
 func (m *VersionedSyncMap[K, V]) IsArchived(key K) bool {
 	v, _ := m.m.Load(key)
 	return v.IsArchived()
@@ -111,6 +122,20 @@ func (m *VersionedSyncMap[K, V]) Delete(key K) {
 	m.m.Delete(key)
 }
 
+// LoadAndDelete removes all versions of the key and returns them.
+func (m *VersionedSyncMap[K, V]) LoadAndDelete(key K) (*version.Values[V], bool) {
+	v, ok := m.m.Load(key)
+	if ok {
+		m.m.Delete(key)
+	}
+	return v, ok
+}
+
+// Store sets all versions of the key.
+func (m *VersionedSyncMap[K, V]) Store(key K, v *version.Values[V]) {
+	m.m.Store(key, v)
+}
+
 func (m *VersionedSyncMap[K, V]) DeleteAll(key ...K) {
 	m.m.DeleteAll(key...)
 }
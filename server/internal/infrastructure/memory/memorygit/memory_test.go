@@ -278,6 +278,22 @@ func TestVersionedSyncMap_Store(t *testing.T) {
 	assert.Equal(t, "d", got3.Value())
 }
 
+func TestVersionedSyncMap_Replace(t *testing.T) {
+	vm := NewVersionedSyncMap[string, string]()
+	vm.SaveOne("a", "b", nil)
+	vm.SaveOne("a", "c", nil)
+	all := vm.LoadAllVersions("a").All()
+
+	assert.True(t, vm.Replace("a", all[0].Version(), "x"))
+	got, _ := vm.Load("a", all[0].Version().OrRef())
+	assert.Equal(t, "x", got.Value())
+	got, _ = vm.Load("a", version.Latest.OrVersion())
+	assert.Equal(t, "c", got.Value())
+
+	assert.False(t, vm.Replace("a", version.New(), "y"))
+	assert.False(t, vm.Replace("b", all[0].Version(), "y"))
+}
+
 func TestVersionedSyncMap_UpdateRef(t *testing.T) {
 	vx := version.New()
 
@@ -15,24 +15,27 @@ import (
 )
 
 type Model struct {
-	data *util.SyncMap[id.ModelID, *model.Model]
-	f    repo.ProjectFilter
-	now  *util.TimeNow
-	err  error
+	data  *util.SyncMap[id.ModelID, *model.Model]
+	trash *util.SyncMap[id.ModelID, *model.Model]
+	f     repo.ProjectFilter
+	now   *util.TimeNow
+	err   error
 }
 
 func NewModel() repo.Model {
 	return &Model{
-		data: &util.SyncMap[id.ModelID, *model.Model]{},
-		now:  &util.TimeNow{},
+		data:  &util.SyncMap[id.ModelID, *model.Model]{},
+		trash: &util.SyncMap[id.ModelID, *model.Model]{},
+		now:   &util.TimeNow{},
 	}
 }
 
 func (r *Model) Filtered(f repo.ProjectFilter) repo.Model {
 	return &Model{
-		data: r.data,
-		f:    r.f.Merge(f),
-		now:  &util.TimeNow{},
+		data:  r.data,
+		trash: r.trash,
+		f:     r.f.Merge(f),
+		now:   &util.TimeNow{},
 	}
 }
 
@@ -218,6 +221,40 @@ func (r *Model) Remove(_ context.Context, mId id.ModelID) error {
 	return rerror.ErrNotFound
 }
 
+func (r *Model) Trash(_ context.Context, mId id.ModelID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	m, ok := r.data.Load(mId)
+	if !ok {
+		return rerror.ErrNotFound
+	}
+	if !r.f.CanWrite(m.Project()) {
+		return repo.ErrOperationDenied
+	}
+	r.data.Delete(mId)
+	r.trash.Store(mId, m)
+	return nil
+}
+
+func (r *Model) Restore(_ context.Context, mId id.ModelID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	m, ok := r.trash.Load(mId)
+	if !ok {
+		return rerror.ErrNotFound
+	}
+	if !r.f.CanWrite(m.Project()) {
+		return repo.ErrOperationDenied
+	}
+	r.trash.Delete(mId)
+	r.data.Store(mId, m)
+	return nil
+}
+
 func MockModelNow(r repo.Model, t time.Time) func() {
 	return r.(*Model).now.Mock(t)
 }
@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type Notification struct {
+	data *util.SyncMap[id.NotificationID, *notification.Notification]
+	err  error
+}
+
+func NewNotification() repo.Notification {
+	return &Notification{
+		data: &util.SyncMap[id.NotificationID, *notification.Notification]{},
+	}
+}
+
+func (r *Notification) FindByID(_ context.Context, nid id.NotificationID) (*notification.Notification, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if n, ok := r.data.Load(nid); ok {
+		return n, nil
+	}
+	return nil, rerror.ErrNotFound
+}
+
+func (r *Notification) FindByIDs(_ context.Context, ids id.NotificationIDList) (notification.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res := notification.List(r.data.FindAll(func(k id.NotificationID, _ *notification.Notification) bool {
+		return ids.Has(k)
+	}))
+	return res.SortByNewest(), nil
+}
+
+func (r *Notification) FindByUser(_ context.Context, uid accountdomain.UserID, filter repo.NotificationFilter, _ *usecasex.Pagination) (notification.List, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	result := notification.List(r.data.FindAll(func(_ id.NotificationID, v *notification.Notification) bool {
+		return v.User() == uid && (!filter.UnreadOnly || !v.IsRead())
+	})).SortByNewest()
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = lo.ToPtr(usecasex.Cursor(result[0].ID().String()))
+		endCursor = lo.ToPtr(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		false,
+		false,
+	), nil
+}
+
+func (r *Notification) CountUnread(_ context.Context, uid accountdomain.UserID) (int64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	return int64(r.data.CountAll(func(_ id.NotificationID, v *notification.Notification) bool {
+		return v.User() == uid && !v.IsRead()
+	})), nil
+}
+
+func (r *Notification) Save(_ context.Context, n *notification.Notification) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(n.ID(), n)
+	return nil
+}
+
+func (r *Notification) SaveAll(_ context.Context, l notification.List) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.StoreAll(lo.SliceToMap(l, func(n *notification.Notification) (id.NotificationID, *notification.Notification) {
+		return n.ID(), n
+	}))
+	return nil
+}
+
+func (r *Notification) MarkAllRead(_ context.Context, uid accountdomain.UserID, t time.Time) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Range(func(_ id.NotificationID, v *notification.Notification) bool {
+		if v.User() == uid {
+			v.MarkRead(t)
+		}
+		return true
+	})
+	return nil
+}
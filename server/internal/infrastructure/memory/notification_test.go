@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotification(t *testing.T) {
+	ctx := context.Background()
+	uid := accountdomain.NewUserID()
+	newNotification := func(u accountdomain.UserID) *notification.Notification {
+		return notification.New().NewID().User(u).Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+			Request(id.NewRequestID()).Type(notification.TypeReviewRequested).MustBuild()
+	}
+	n1 := newNotification(uid)
+	n2 := newNotification(uid)
+	n3 := newNotification(accountdomain.NewUserID())
+	n2.MarkRead(time.Now())
+
+	r := NewNotification()
+	assert.NoError(t, r.Save(ctx, n1))
+	assert.NoError(t, r.SaveAll(ctx, notification.List{n2, n3}))
+
+	got, err := r.FindByID(ctx, n1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, n1, got)
+
+	_, err = r.FindByID(ctx, notification.NewID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	list, err := r.FindByIDs(ctx, id.NotificationIDList{n1.ID(), n3.ID()})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, notification.List{n1, n3}, list)
+
+	list, pi, err := r.FindByUser(ctx, uid, repo.NotificationFilter{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n1, n2}.SortByNewest(), list)
+	assert.Equal(t, int64(2), pi.TotalCount)
+
+	list, _, err = r.FindByUser(ctx, uid, repo.NotificationFilter{UnreadOnly: true}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n1}, list)
+
+	c, err := r.CountUnread(ctx, uid)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), c)
+}
+
+func TestNotificationPreference(t *testing.T) {
+	ctx := context.Background()
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	p := notification.NewPreference(u1)
+	assert.NoError(t, p.SetSetting(notification.TypeRequestComment, notification.Setting{InApp: true}))
+
+	r := NewNotificationPreference()
+	assert.NoError(t, r.Save(ctx, p))
+
+	got, err := r.FindByUsers(ctx, accountdomain.UserIDList{u1, u2})
+	assert.NoError(t, err)
+	assert.Equal(t, []*notification.Preference{p}, got)
+}
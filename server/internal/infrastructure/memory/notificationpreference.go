@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+)
+
+type NotificationPreference struct {
+	data *util.SyncMap[accountdomain.UserID, *notification.Preference]
+	err  error
+}
+
+func NewNotificationPreference() repo.NotificationPreference {
+	return &NotificationPreference{
+		data: &util.SyncMap[accountdomain.UserID, *notification.Preference]{},
+	}
+}
+
+func (r *NotificationPreference) FindByUsers(_ context.Context, ids accountdomain.UserIDList) ([]*notification.Preference, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res := make([]*notification.Preference, 0, len(ids))
+	for _, uid := range ids {
+		if p, ok := r.data.Load(uid); ok {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+func (r *NotificationPreference) Save(_ context.Context, p *notification.Preference) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.data.Store(p.User(), p)
+	return nil
+}
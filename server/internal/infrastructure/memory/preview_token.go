@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+type PreviewToken struct {
+	data *util.SyncMap[id.PreviewTokenID, *preview.Token]
+	f    repo.ProjectFilter
+	err  error
+}
+
+func NewPreviewToken() repo.PreviewToken {
+	return &PreviewToken{
+		data: &util.SyncMap[id.PreviewTokenID, *preview.Token]{},
+	}
+}
+
+func (r *PreviewToken) Filtered(f repo.ProjectFilter) repo.PreviewToken {
+	return &PreviewToken{
+		data: r.data,
+		f:    r.f.Merge(f),
+		err:  r.err,
+	}
+}
+
+func (r *PreviewToken) FindByID(_ context.Context, tid id.PreviewTokenID) (*preview.Token, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res := r.data.Find(func(k id.PreviewTokenID, v *preview.Token) bool {
+		return k == tid && r.f.CanRead(v.Project())
+	})
+	if res == nil {
+		return nil, rerror.ErrNotFound
+	}
+	return res, nil
+}
+
+func (r *PreviewToken) FindByProject(_ context.Context, pid id.ProjectID, _ *usecasex.Pagination) (preview.List, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	// TODO: implement pagination
+
+	if !r.f.CanRead(pid) {
+		return nil, usecasex.EmptyPageInfo(), nil
+	}
+
+	result := preview.List(r.data.FindAll(func(_ id.PreviewTokenID, v *preview.Token) bool {
+		return v.Project() == pid
+	}))
+	slices.SortStableFunc(result, func(a, b *preview.Token) int {
+		return b.ID().Compare(a.ID())
+	})
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = lo.ToPtr(usecasex.Cursor(result[0].ID().String()))
+		endCursor = lo.ToPtr(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		false,
+		false,
+	), nil
+}
+
+func (r *PreviewToken) Save(_ context.Context, v *preview.Token) error {
+	if r.err != nil {
+		return r.err
+	}
+	if !r.f.CanWrite(v.Project()) {
+		return repo.ErrOperationDenied
+	}
+
+	r.data.Store(v.ID(), v)
+	return nil
+}
+
+func SetPreviewTokenError(r repo.PreviewToken, err error) {
+	r.(*PreviewToken).err = err
+}
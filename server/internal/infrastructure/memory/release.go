@@ -0,0 +1,119 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type Release struct {
+	data *util.SyncMap[id.ReleaseID, *release.Release]
+	f    repo.ProjectFilter
+	err  error
+}
+
+func NewRelease() repo.Release {
+	return &Release{
+		data: &util.SyncMap[id.ReleaseID, *release.Release]{},
+	}
+}
+
+func (r *Release) Filtered(f repo.ProjectFilter) repo.Release {
+	return &Release{
+		data: r.data,
+		f:    r.f.Merge(f),
+		err:  r.err,
+	}
+}
+
+func (r *Release) FindByID(_ context.Context, rid id.ReleaseID) (*release.Release, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res := r.data.Find(func(k id.ReleaseID, v *release.Release) bool {
+		return k == rid && r.f.CanRead(v.Project())
+	})
+	if res == nil {
+		return nil, rerror.ErrNotFound
+	}
+	return res, nil
+}
+
+func (r *Release) FindByName(_ context.Context, pid id.ProjectID, name string) (*release.Release, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	res := r.data.Find(func(_ id.ReleaseID, v *release.Release) bool {
+		return v.Project() == pid && v.Name() == name && r.f.CanRead(v.Project())
+	})
+	if res == nil {
+		return nil, rerror.ErrNotFound
+	}
+	return res, nil
+}
+
+func (r *Release) FindByProject(_ context.Context, pid id.ProjectID, _ *usecasex.Pagination) (release.List, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	// TODO: implement pagination
+
+	if !r.f.CanRead(pid) {
+		return nil, usecasex.EmptyPageInfo(), nil
+	}
+
+	result := release.List(r.data.FindAll(func(_ id.ReleaseID, v *release.Release) bool {
+		return v.Project() == pid
+	})).SortByCreatedAt()
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = lo.ToPtr(usecasex.Cursor(result[0].ID().String()))
+		endCursor = lo.ToPtr(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		false,
+		false,
+	), nil
+}
+
+func (r *Release) Save(_ context.Context, v *release.Release) error {
+	if r.err != nil {
+		return r.err
+	}
+	if !r.f.CanWrite(v.Project()) {
+		return repo.ErrOperationDenied
+	}
+
+	r.data.Store(v.ID(), v)
+	return nil
+}
+
+func (r *Release) Remove(_ context.Context, rid id.ReleaseID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if v, ok := r.data.Load(rid); ok && r.f.CanWrite(v.Project()) {
+		r.data.Delete(rid)
+		return nil
+	}
+	return rerror.ErrNotFound
+}
+
+func SetReleaseError(r repo.Release, err error) {
+	r.(*Release).err = err
+}
@@ -121,11 +121,22 @@ func (r *Request) FindByItems(ctx context.Context, list id.ItemIDList, f *repo.R
 		return nil, r.err
 	}
 	res := r.data.FindAll(func(_ request.ID, value *request.Request) bool {
-		return value.Items().IDs().Has(list...) && r.f.CanRead(value.Project())
+		stateMatched := f == nil || len(f.State) == 0 || slices.Contains(f.State, value.State())
+		return value.Items().IDs().Has(list...) && stateMatched && r.f.CanRead(value.Project())
 	})
 	return res, nil
 }
 
+func (r *Request) FindByThread(ctx context.Context, tid id.ThreadID) (*request.Request, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return rerror.ErrIfNil(r.data.Find(func(_ request.ID, value *request.Request) bool {
+		return value.Thread() != nil && *value.Thread() == tid && r.f.CanRead(value.Project())
+	}), rerror.ErrNotFound)
+}
+
 func SetRequestError(r repo.Request, err error) {
 	r.(*Request).err = err
 }
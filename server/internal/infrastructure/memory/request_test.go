@@ -55,6 +55,32 @@ func TestRequest_FindByID(t *testing.T) {
 	assert.Same(t, rerror.ErrNotFound, err)
 }
 
+func TestRequest_FindByThread(t *testing.T) {
+	ctx := context.Background()
+	item, _ := request.NewItemWithVersion(id.NewItemID(), version.New().OrRef())
+	tid := id.NewThreadID()
+
+	req := request.New().
+		NewID().
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).
+		CreatedBy(accountdomain.NewUserID()).
+		Thread(tid.Ref()).
+		Items(request.ItemList{item}).
+		Title("foo").
+		MustBuild()
+	r := NewRequest()
+	_ = r.Save(ctx, req)
+
+	out, err := r.FindByThread(ctx, tid)
+	assert.NoError(t, err)
+	assert.Equal(t, req, out)
+
+	out2, err := r.FindByThread(ctx, id.NewThreadID())
+	assert.Nil(t, out2)
+	assert.Same(t, rerror.ErrNotFound, err)
+}
+
 func TestRequest_SaveAll(t *testing.T) {
 	ctx := context.Background()
 	pid := id.NewProjectID()
@@ -0,0 +1,113 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+)
+
+type Schedule struct {
+	data *util.SyncMap[id.ScheduleID, *schedule.Schedule]
+	f    repo.ProjectFilter
+	err  error
+}
+
+func NewSchedule() repo.Schedule {
+	return &Schedule{
+		data: &util.SyncMap[id.ScheduleID, *schedule.Schedule]{},
+	}
+}
+
+func (r *Schedule) Filtered(f repo.ProjectFilter) repo.Schedule {
+	return &Schedule{
+		data: r.data,
+		f:    r.f.Merge(f),
+		err:  r.err,
+	}
+}
+
+func (r *Schedule) FindByID(_ context.Context, sid id.ScheduleID) (*schedule.Schedule, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	s := r.data.Find(func(k id.ScheduleID, s *schedule.Schedule) bool {
+		return k == sid && r.f.CanRead(s.Project())
+	})
+	if s == nil {
+		return nil, rerror.ErrNotFound
+	}
+	return s.Clone(), nil
+}
+
+func (r *Schedule) FindByItems(_ context.Context, ids id.ItemIDList) (schedule.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.findAll(func(s *schedule.Schedule) bool {
+		return ids.Has(s.Item())
+	}), nil
+}
+
+func (r *Schedule) FindByProject(_ context.Context, pid id.ProjectID) (schedule.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.findAll(func(s *schedule.Schedule) bool {
+		return s.Project() == pid
+	}), nil
+}
+
+func (r *Schedule) FindDue(_ context.Context, now time.Time) (schedule.List, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return r.findAll(func(s *schedule.Schedule) bool {
+		return s.IsPublishDue(now) || s.IsUnpublishDue(now)
+	}), nil
+}
+
+func (r *Schedule) Save(_ context.Context, s *schedule.Schedule) error {
+	if r.err != nil {
+		return r.err
+	}
+	if !r.f.CanWrite(s.Project()) {
+		return repo.ErrOperationDenied
+	}
+
+	r.data.Store(s.ID(), s.Clone())
+	return nil
+}
+
+func (r *Schedule) Remove(_ context.Context, sid id.ScheduleID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if s, ok := r.data.Load(sid); ok && r.f.CanWrite(s.Project()) {
+		r.data.Delete(sid)
+		return nil
+	}
+	return rerror.ErrNotFound
+}
+
+func (r *Schedule) findAll(f func(*schedule.Schedule) bool) schedule.List {
+	res := r.data.FindAll(func(_ id.ScheduleID, s *schedule.Schedule) bool {
+		return f(s) && r.f.CanRead(s.Project())
+	})
+	return util.Map(schedule.List(res).SortByNextAt(), func(s *schedule.Schedule) *schedule.Schedule {
+		return s.Clone()
+	})
+}
+
+func SetScheduleError(r repo.Schedule, err error) {
+	r.(*Schedule).err = err
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type Trash struct {
+	data *util.SyncMap[id.TrashID, *trash.Entry]
+	f    repo.ProjectFilter
+	err  error
+}
+
+func NewTrash() repo.Trash {
+	return &Trash{
+		data: &util.SyncMap[id.TrashID, *trash.Entry]{},
+	}
+}
+
+func (r *Trash) Filtered(f repo.ProjectFilter) repo.Trash {
+	return &Trash{
+		data: r.data,
+		f:    r.f.Merge(f),
+		err:  r.err,
+	}
+}
+
+func (r *Trash) FindByID(_ context.Context, tid id.TrashID) (*trash.Entry, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	e := r.data.Find(func(k id.TrashID, e *trash.Entry) bool {
+		return k == tid && r.f.CanRead(e.Project())
+	})
+	if e == nil {
+		return nil, rerror.ErrNotFound
+	}
+	return e, nil
+}
+
+func (r *Trash) FindByProject(_ context.Context, pid id.ProjectID, _ *usecasex.Pagination) (trash.List, *usecasex.PageInfo, error) {
+	if r.err != nil {
+		return nil, nil, r.err
+	}
+
+	// TODO: implement pagination
+
+	if !r.f.CanRead(pid) {
+		return nil, usecasex.EmptyPageInfo(), nil
+	}
+
+	result := trash.List(r.data.FindAll(func(_ id.TrashID, e *trash.Entry) bool {
+		return e.Project() == pid
+	})).SortByDeletedAt()
+
+	var startCursor, endCursor *usecasex.Cursor
+	if len(result) > 0 {
+		startCursor = lo.ToPtr(usecasex.Cursor(result[0].ID().String()))
+		endCursor = lo.ToPtr(usecasex.Cursor(result[len(result)-1].ID().String()))
+	}
+
+	return result, usecasex.NewPageInfo(
+		int64(len(result)),
+		startCursor,
+		endCursor,
+		false,
+		false,
+	), nil
+}
+
+func (r *Trash) Save(_ context.Context, e *trash.Entry) error {
+	if r.err != nil {
+		return r.err
+	}
+	if !r.f.CanWrite(e.Project()) {
+		return repo.ErrOperationDenied
+	}
+
+	r.data.Store(e.ID(), e)
+	return nil
+}
+
+func (r *Trash) Remove(_ context.Context, tid id.TrashID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if e, ok := r.data.Load(tid); ok && r.f.CanWrite(e.Project()) {
+		r.data.Delete(tid)
+		return nil
+	}
+	return rerror.ErrNotFound
+}
+
+func SetTrashError(r repo.Trash, err error) {
+	r.(*Trash).err = err
+}
@@ -106,9 +106,57 @@ func (r *Asset) Search(ctx context.Context, pID id.ProjectID, filter repo.AssetF
 		}
 	}
 
+	for k, v := range metadataFilter(filter.Metadata) {
+		filters[k] = v
+	}
+
 	return r.paginate(ctx, filters, filter.Sort, filter.Pagination)
 }
 
+// metadataFilter returns the conditions of the filter on the fields of the metadata document.
+func metadataFilter(f *asset.MetadataFilter) bson.M {
+	if f.IsEmpty() {
+		return nil
+	}
+
+	res := bson.M{}
+	rangeFilter := func(key string, minV, maxV any, hasMin, hasMax bool) {
+		c := bson.M{}
+		if hasMin {
+			c["$gte"] = minV
+		}
+		if hasMax {
+			c["$lte"] = maxV
+		}
+		if len(c) > 0 {
+			res[key] = c
+		}
+	}
+	rangeFilter("metadata.width", f.MinWidth, f.MaxWidth, f.MinWidth != nil, f.MaxWidth != nil)
+	rangeFilter("metadata.height", f.MinHeight, f.MaxHeight, f.MinHeight != nil, f.MaxHeight != nil)
+	rangeFilter("metadata.capturedat", f.CapturedAfter, f.CapturedBefore, f.CapturedAfter != nil, f.CapturedBefore != nil)
+
+	if b := f.BBox; b != nil {
+		res["$or"] = []bson.M{
+			{
+				"metadata.bbox.minlng": bson.M{"$lte": b.MaxLng},
+				"metadata.bbox.maxlng": bson.M{"$gte": b.MinLng},
+				"metadata.bbox.minlat": bson.M{"$lte": b.MaxLat},
+				"metadata.bbox.maxlat": bson.M{"$gte": b.MinLat},
+			},
+			{
+				"metadata.location.lng": bson.M{"$gte": b.MinLng, "$lte": b.MaxLng},
+				"metadata.location.lat": bson.M{"$gte": b.MinLat, "$lte": b.MaxLat},
+			},
+		}
+	}
+
+	if f.Header != nil {
+		res["metadata.headers"] = *f.Header
+	}
+	return res
+}
+
 func (r *Asset) UpdateProject(ctx context.Context, from, to id.ProjectID) error {
 	if !r.f.CanWrite(from) || !r.f.CanWrite(to) {
 		return repo.ErrOperationDenied
@@ -153,6 +201,18 @@ func (r *Asset) BatchDelete(ctx context.Context, ids id.AssetIDList) error {
 	return r.client.RemoveAll(ctx, r.writeFilter(filter))
 }
 
+func (r *Asset) Trash(ctx context.Context, id id.AssetID) error {
+	return setTrashed(ctx, r.client, r.writeFilter(bson.M{
+		"id": id.String(),
+	}), true)
+}
+
+func (r *Asset) Restore(ctx context.Context, id id.AssetID) error {
+	return setTrashed(ctx, r.client, r.writeFilter(bson.M{
+		"id": id.String(),
+	}), false)
+}
+
 func (r *Asset) paginate(ctx context.Context, filter any, sort *usecasex.Sort, pagination *usecasex.Pagination) ([]*asset.Asset, *usecasex.PageInfo, error) {
 	c := mongodoc.NewAssetConsumer()
 	pageInfo, err := r.client.Paginate(ctx, r.readFilter(filter), sort, pagination, c, options.Find().SetProjection(bson.M{"file": 0}))
@@ -194,7 +254,7 @@ func filterAssets(ids []id.AssetID, rows []*asset.Asset) []*asset.Asset {
 }
 
 func (r *Asset) readFilter(filter interface{}) interface{} {
-	return applyProjectFilter(filter, r.f.Readable)
+	return applyProjectFilter(excludeTrashed(filter), r.f.Readable)
 }
 
 func (r *Asset) writeFilter(filter interface{}) interface{} {
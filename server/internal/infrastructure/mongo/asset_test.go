@@ -15,6 +15,7 @@ import (
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 func Test_AssetRepo_Filtered(t *testing.T) {
@@ -447,6 +448,53 @@ func TestAssetRepo_Search(t *testing.T) {
 	}
 }
 
+func TestAssetRepo_SearchByMetadata(t *testing.T) {
+	pid := id.NewProjectID()
+	tim := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	newAsset := func(m *asset.Metadata) *asset.Asset {
+		return asset.New().NewID().Project(pid).CreatedAt(tim).NewUUID().CreatedByUser(accountdomain.NewUserID()).
+			Size(1000).Metadata(m).MustBuild()
+	}
+	image := newAsset(&asset.Metadata{Width: lo.ToPtr(800), Height: lo.ToPtr(600), CapturedAt: &tim, Location: &asset.Location{Lat: 35, Lng: 139}})
+	geo := newAsset(&asset.Metadata{BBox: &asset.BBox{MinLng: 130, MinLat: 30, MaxLng: 135, MaxLat: 35}, FeatureCount: lo.ToPtr(3)})
+	table := newAsset(&asset.Metadata{Headers: []string{"id", "name"}, RowCount: lo.ToPtr(2)})
+	other := newAsset(nil)
+
+	tests := []struct {
+		name   string
+		filter *asset.MetadataFilter
+		want   asset.List
+	}{
+		{name: "no filter", filter: nil, want: asset.List{image, geo, table, other}},
+		{name: "width", filter: &asset.MetadataFilter{MinWidth: lo.ToPtr(800), MaxWidth: lo.ToPtr(1000)}, want: asset.List{image}},
+		{name: "height", filter: &asset.MetadataFilter{MaxHeight: lo.ToPtr(599)}, want: nil},
+		{name: "captured at", filter: &asset.MetadataFilter{CapturedAfter: &tim, CapturedBefore: &tim}, want: asset.List{image}},
+		{name: "bbox", filter: &asset.MetadataFilter{BBox: &asset.BBox{MinLng: 134, MinLat: 34, MaxLng: 140, MaxLat: 36}}, want: asset.List{image, geo}},
+		{name: "header", filter: &asset.MetadataFilter{Header: lo.ToPtr("name")}, want: asset.List{table}},
+	}
+
+	initDB := mongotest.Connect(t)
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := mongox.NewClientWithDatabase(initDB(t))
+
+			r := NewAsset(client)
+			ctx := context.Background()
+			for _, a := range (asset.List{image, geo, table, other}) {
+				assert.NoError(t, r.Save(ctx, a))
+			}
+
+			got, _, err := r.Search(ctx, pid, repo.AssetFilter{Metadata: tc.filter})
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tc.want, got)
+		})
+	}
+}
+
 func TestAssetRepo_Delete(t *testing.T) {
 	pid1 := id.NewProjectID()
 	uid1 := accountdomain.NewUserID()
@@ -654,3 +702,31 @@ func TestAssetRepo_Save(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataFilter(t *testing.T) {
+	assert.Nil(t, metadataFilter(nil))
+	assert.Nil(t, metadataFilter(&asset.MetadataFilter{}))
+
+	assert.Equal(t, bson.M{
+		"metadata.width":   bson.M{"$gte": lo.ToPtr(10)},
+		"metadata.height":  bson.M{"$lte": lo.ToPtr(20)},
+		"metadata.headers": "name",
+		"$or": []bson.M{
+			{
+				"metadata.bbox.minlng": bson.M{"$lte": 3.0},
+				"metadata.bbox.maxlng": bson.M{"$gte": 1.0},
+				"metadata.bbox.minlat": bson.M{"$lte": 4.0},
+				"metadata.bbox.maxlat": bson.M{"$gte": 2.0},
+			},
+			{
+				"metadata.location.lng": bson.M{"$gte": 1.0, "$lte": 3.0},
+				"metadata.location.lat": bson.M{"$gte": 2.0, "$lte": 4.0},
+			},
+		},
+	}, metadataFilter(&asset.MetadataFilter{
+		MinWidth:  lo.ToPtr(10),
+		MaxHeight: lo.ToPtr(20),
+		BBox:      &asset.BBox{MinLng: 1, MinLat: 2, MaxLng: 3, MaxLat: 4},
+		Header:    lo.ToPtr("name"),
+	}))
+}
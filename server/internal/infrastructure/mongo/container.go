@@ -41,6 +41,11 @@ func New(ctx context.Context, mc *mongo.Client, databaseName string, useTransact
 		Request:           NewRequest(client),
 		Item:              NewItem(client),
 		View:              NewView(client),
+		Schedule:          NewSchedule(client),
+		ItemTemplate:      NewItemTemplate(client),
+		Trash:             NewTrash(client),
+		Release:           NewRelease(client),
+		PreviewToken:      NewPreviewToken(client),
 		Model:             NewModel(client),
 		Schema:            NewSchema(client),
 		Thread:            NewThread(client),
@@ -48,6 +53,9 @@ func New(ctx context.Context, mc *mongo.Client, databaseName string, useTransact
 		Group:             NewGroup(client),
 		Event:             NewEvent(client),
 		WorkspaceSettings: NewWorkspaceSettings(client),
+		// notifications
+		Notification:           NewNotification(client),
+		NotificationPreference: NewNotificationPreference(client),
 	}
 
 	// init
@@ -73,6 +81,11 @@ func Init(r *repo.Container) error {
 		r.AssetUpload.(*AssetUpload).Init,
 		r.Model.(*Model).Init,
 		r.View.(*View).Init,
+		r.Schedule.(*Schedule).Init,
+		r.ItemTemplate.(*ItemTemplate).Init,
+		r.Trash.(*Trash).Init,
+		r.Release.(*Release).Init,
+		r.PreviewToken.(*PreviewToken).Init,
 		r.Request.(*Request).Init,
 		r.Project.(*ProjectRepo).Init,
 		r.Item.(*Item).Init,
@@ -81,6 +94,8 @@ func Init(r *repo.Container) error {
 		r.Integration.(*Integration).Init,
 		r.Event.(*Event).Init,
 		r.WorkspaceSettings.(*WorkspaceSettingsRepo).Init,
+		r.Notification.(*Notification).Init,
+		r.NotificationPreference.(*NotificationPreference).Init,
 	)
 }
 
@@ -112,6 +112,20 @@ func (r *Item) FindByModelAndValue(ctx context.Context, modelID id.ModelID, fiel
 			continue
 		}
 
+		if f.Locale != "" {
+			filters = append(filters, bson.M{
+				"modelid": modelID.String(),
+				"fields": bson.M{
+					"$elemMatch": bson.M{
+						"f":                             f.Field.String(),
+						"l." + f.Locale.String() + ".t": v.T,
+						"l." + f.Locale.String() + ".v": v.V,
+					},
+				},
+			})
+			continue
+		}
+
 		filters = append(
 			filters,
 			bson.M{
@@ -159,6 +173,9 @@ func (r *Item) FindVersionByID(ctx context.Context, itemID id.ItemID, ver versio
 	}), version.Eq(ver), c); err != nil {
 		return nil, err
 	}
+	if len(c.Result) == 0 {
+		return nil, rerror.ErrNotFound
+	}
 
 	return c.Result[0], nil
 }
@@ -188,9 +205,9 @@ func (r *Item) FindAllVersionsByIDs(ctx context.Context, ids id.ItemIDList) (ite
 }
 
 func (r *Item) LastModifiedByModel(ctx context.Context, modelID id.ModelID) (time.Time, error) {
-	return r.client.Timestamp(ctx, bson.M{
+	return r.client.Timestamp(ctx, excludeTrashed(bson.M{
 		"modelid": modelID.String(),
-	}, version.Eq(version.Latest.OrVersion()))
+	}), version.Eq(version.Latest.OrVersion()))
 }
 
 func (r *Item) IsArchived(ctx context.Context, id id.ItemID) (bool, error) {
@@ -223,6 +240,14 @@ func (r *Item) UpdateRef(ctx context.Context, item id.ItemID, ref version.Ref, v
 	return r.client.UpdateRef(ctx, item.String(), ref, vr)
 }
 
+func (r *Item) ReplaceVersion(ctx context.Context, v item.Versioned) error {
+	if !r.f.CanWrite(v.Value().Project()) {
+		return repo.ErrOperationDenied
+	}
+	doc, id := mongodoc.NewItem(v.Value())
+	return r.client.ReplaceOne(ctx, id, v.Version(), doc)
+}
+
 func (r *Item) Remove(ctx context.Context, id id.ItemID) error {
 	return r.client.RemoveOne(ctx, r.writeFilter(bson.M{"id": id.String()}))
 }
@@ -237,9 +262,17 @@ func (r *Item) Archive(ctx context.Context, id id.ItemID, pid id.ProjectID, b bo
 	}, b)
 }
 
+func (r *Item) Trash(ctx context.Context, id id.ItemID) error {
+	return setTrashed(ctx, r.client.Client(), r.writeFilter(bson.M{"id": id.String()}), true)
+}
+
+func (r *Item) Restore(ctx context.Context, id id.ItemID) error {
+	return setTrashed(ctx, r.client.Client(), r.writeFilter(bson.M{"id": id.String()}), false)
+}
+
 func (r *Item) paginate(ctx context.Context, filter bson.M, ref *version.Ref, sort *usecasex.Sort, pagination *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error) {
 	c := mongodoc.NewVersionedItemConsumer()
-	pageInfo, err := r.client.Paginate(ctx, r.readFilter(filter), version.Eq(ref.OrLatest().OrVersion()), sort, pagination, c)
+	pageInfo, err := r.client.Paginate(ctx, r.readFilter(filter), refQuery(ref), sort, pagination, c)
 	if err != nil {
 		return nil, nil, rerror.ErrInternalBy(err)
 	}
@@ -248,7 +281,7 @@ func (r *Item) paginate(ctx context.Context, filter bson.M, ref *version.Ref, so
 
 func (r *Item) paginateAggregation(ctx context.Context, pipeline []any, ref *version.Ref, sort *usecasex.Sort, pagination *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, error) {
 	c := mongodoc.NewVersionedItemConsumer()
-	pageInfo, err := r.client.PaginateAggregation(ctx, applyProjectFilterToPipeline(pipeline, r.f.Readable), version.Eq(ref.OrLatest().OrVersion()), sort, pagination, c)
+	pageInfo, err := r.client.PaginateAggregation(ctx, applyProjectFilterToPipeline(excludeTrashedFromPipeline(pipeline), r.f.Readable), refQuery(ref), sort, pagination, c)
 	if err != nil {
 		return nil, nil, rerror.ErrInternalBy(err)
 	}
@@ -257,7 +290,7 @@ func (r *Item) paginateAggregation(ctx context.Context, pipeline []any, ref *ver
 
 func (r *Item) find(ctx context.Context, filter any, ref *version.Ref) (item.VersionedList, error) {
 	c := mongodoc.NewVersionedItemConsumer()
-	if err := r.client.Find(ctx, r.readFilter(filter), version.Eq(ref.OrLatest().OrVersion()), c); err != nil {
+	if err := r.client.Find(ctx, r.readFilter(filter), refQuery(ref), c); err != nil {
 		return nil, err
 	}
 	return c.Result, nil
@@ -265,7 +298,7 @@ func (r *Item) find(ctx context.Context, filter any, ref *version.Ref) (item.Ver
 
 func (r *Item) aggregate(ctx context.Context, pipeline []any, ref *version.Ref) (item.VersionedList, error) {
 	c := mongodoc.NewVersionedItemConsumer()
-	if err := r.client.Aggregate(ctx, applyProjectFilterToPipeline(pipeline, r.f.Readable), version.Eq(ref.OrLatest().OrVersion()), c); err != nil {
+	if err := r.client.Aggregate(ctx, applyProjectFilterToPipeline(excludeTrashedFromPipeline(pipeline), r.f.Readable), refQuery(ref), c); err != nil {
 		return nil, err
 	}
 	return c.Result, nil
@@ -273,14 +306,14 @@ func (r *Item) aggregate(ctx context.Context, pipeline []any, ref *version.Ref)
 
 func (r *Item) findOne(ctx context.Context, filter any, ref *version.Ref) (item.Versioned, error) {
 	c := mongodoc.NewVersionedItemConsumer()
-	if err := r.client.FindOne(ctx, r.readFilter(filter), version.Eq(ref.OrLatest().OrVersion()), c); err != nil {
+	if err := r.client.FindOne(ctx, r.readFilter(filter), refQuery(ref), c); err != nil {
 		return nil, err
 	}
 	return c.Result[0], nil
 }
 
 func (r *Item) Copy(ctx context.Context, params repo.CopyParams) (*string, *string, error) {
-	filter, err := json.Marshal(bson.M{"schema": params.OldSchema.String(), "__r": bson.M{"$in": []string{"latest"}}})
+	filter, err := json.Marshal(bson.M{"schema": params.OldSchema.String(), "__r": bson.M{"$in": []string{"latest"}}, "trashed": bson.M{"$ne": true}})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -369,9 +402,24 @@ func filterItems(ids []id.ItemID, rows item.VersionedList) item.VersionedList {
 }
 
 func (r *Item) readFilter(filter any) any {
-	return applyProjectFilter(filter, r.f.Readable)
+	return applyProjectFilter(excludeTrashed(filter), r.f.Readable)
 }
 
 func (r *Item) writeFilter(filter any) any {
 	return applyProjectFilter(filter, r.f.Writable)
 }
+
+// refQuery returns the query of the ref. Items which are not staged at a staging ref are read from the public ref to preview them.
+// Snapshot refs read the versions which the ref pointed at the time.
+func refQuery(ref *version.Ref) version.Query {
+	if ref == nil {
+		return version.Eq(version.Latest.OrVersion())
+	}
+	if r, t, ok := ref.Snapshot(); ok {
+		return version.At(r, t)
+	}
+	if ref.IsStaging() {
+		return version.Overlay(*ref, version.Public)
+	}
+	return version.Eq(ref.OrLatest().OrVersion())
+}
@@ -64,8 +64,10 @@ func buildPipeline(query *item.Query, sp schema.Package) []any {
 	// create aliases for fields used in filter logic or sort
 	pipeline = append(pipeline, basicFieldsAliasStages(query, sp)...)
 
-	// apply text filter
-	if query.Keyword() != "" {
+	// apply text filter unless the items were already found by the search index
+	if query.IDs() != nil {
+		pipeline = append(pipeline, rankStage(query.IDs()))
+	} else if query.Keyword() != "" {
 		pipeline = append(pipeline, textFilterStage(query.Keyword(), sp))
 	}
 
@@ -85,6 +87,8 @@ func sort(query *item.Query) *usecasex.Sort {
 			Key:      fieldKey(query.Sort().Field),
 			Reverted: reverted,
 		}
+	} else if query.IDs() != nil {
+		s = &usecasex.Sort{Key: rankKey}
 	}
 	return s
 }
@@ -97,9 +101,19 @@ func basicFilterStage(query *item.Query) any {
 	if query.Schema() != nil {
 		filter["schema"] = query.Schema().String()
 	}
+	if query.IDs() != nil {
+		filter["id"] = bson.M{"$in": query.IDs().Strings()}
+	}
 	return bson.M{"$match": filter}
 }
 
+const rankKey = "__temp.rank"
+
+// rankStage sets the position of the item in the search result so items can be sorted by relevance.
+func rankStage(ids id.ItemIDList) any {
+	return bson.M{"$addFields": bson.M{rankKey: bson.M{"$indexOfArray": bson.A{ids.Strings(), "$id"}}}}
+}
+
 func lookupMetaItem() []any {
 	return []any{
 		bson.M{
@@ -461,7 +475,7 @@ func fieldKey(f view.FieldSelector) string {
 	case view.FieldTypeCreationUser:
 		return "__temp.createdBy"
 	case view.FieldTypeModificationDate:
-		return "__temp.updateAt"
+		return "__temp.updatedAt"
 	case view.FieldTypeModificationUser:
 		return "__temp.updatedBy"
 	case view.FieldTypeStatus:
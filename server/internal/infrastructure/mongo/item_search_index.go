@@ -0,0 +1,200 @@
+package mongo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/search"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	itemSearchTextIndex = "re_text"
+	// itemSearchMaxPrefix is the maximum length in runes of the prefixes of a term which are indexed.
+	itemSearchMaxPrefix = 16
+	// itemSearchMaxRepeat is the maximum number of times a term is repeated in the text to weight it.
+	itemSearchMaxRepeat = 10
+)
+
+// ItemSearch is a search index of items stored in a collection.
+// The terms are tokenized in the application as a MongoDB text index does not support Japanese, and are stored
+// with their prefixes in a text field indexed by a text index without a language, so the terms are neither stemmed nor dropped.
+// The text index finds and scores the documents, and the terms array makes every term of the keyword required.
+type ItemSearch struct {
+	client *mongox.Collection
+}
+
+type itemSearchDocument struct {
+	ID      string
+	Item    string
+	Project string
+	Model   string
+	Schema  string
+	Ref     string
+	Terms   []string
+	Text    string
+}
+
+type itemSearchHitDocument struct {
+	Item  string
+	Score float64
+}
+
+func NewItemSearch(client *mongox.Client) *ItemSearch {
+	return &ItemSearch{client: client.WithCollection("itemsearch")}
+}
+
+func (r *ItemSearch) Init() error {
+	// the text index has options which are not supported by createIndexes, and createIndexes would drop it as an unknown index
+	_, err := r.client.Client().Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "item", Value: 1}}},
+		{Keys: bson.D{{Key: "model", Value: 1}}},
+		{
+			// the equality conditions of a search precede the text so only the documents of the model at the ref are scanned
+			Keys:    bson.D{{Key: "project", Value: 1}, {Key: "model", Value: 1}, {Key: "ref", Value: 1}, {Key: "text", Value: "text"}},
+			Options: options.Index().SetName(itemSearchTextIndex).SetDefaultLanguage("none"),
+		},
+	})
+	return err
+}
+
+func (r *ItemSearch) Index(ctx context.Context, docs []gateway.ItemSearchDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(docs))
+	res := make([]any, 0, len(docs))
+	for _, d := range docs {
+		doc := newItemSearchDocument(d)
+		ids = append(ids, doc.ID)
+		res = append(res, doc)
+	}
+	if err := r.client.SaveAll(ctx, ids, res); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
+func (r *ItemSearch) Delete(ctx context.Context, ids id.ItemIDList, ref *version.Ref) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"item": bson.M{"$in": ids.Strings()}}
+	if ref != nil {
+		filter["ref"] = ref.String()
+	}
+	if err := r.client.RemoveAll(ctx, filter); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
+func (r *ItemSearch) DeleteByModel(ctx context.Context, mid id.ModelID) error {
+	if err := r.client.RemoveAll(ctx, bson.M{"model": mid.String()}); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
+func (r *ItemSearch) Search(ctx context.Context, q gateway.ItemSearchQuery) ([]gateway.ItemSearchHit, int64, error) {
+	tokens := lo.Uniq(search.Tokenize(q.Keyword))
+	if len(tokens) == 0 {
+		return nil, 0, nil
+	}
+
+	filter := bson.M{
+		"project": q.Project.String(),
+		"model":   q.Model.String(),
+		"ref":     q.Ref.String(),
+		"$text":   bson.M{"$search": strings.Join(tokens, " ")},
+		"terms":   bson.M{"$all": tokens},
+	}
+	if q.Schema != nil {
+		filter["schema"] = q.Schema.String()
+	}
+
+	total, err := r.client.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, rerror.ErrInternalBy(err)
+	}
+	if total == 0 || q.Offset >= total {
+		return nil, total, nil
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	opts := options.Find().
+		SetProjection(bson.M{"item": 1, "score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "item", Value: 1}}).
+		SetSkip(q.Offset)
+	if q.Limit > 0 {
+		opts.SetLimit(q.Limit)
+	}
+
+	var res []gateway.ItemSearchHit
+	c := mongox.FuncConsumer(func(raw bson.Raw) error {
+		if raw == nil {
+			return nil
+		}
+		var d itemSearchHitDocument
+		if err := bson.Unmarshal(raw, &d); err != nil {
+			return err
+		}
+		iid, err := id.ItemIDFrom(d.Item)
+		if err != nil {
+			return err
+		}
+		res = append(res, gateway.ItemSearchHit{ID: iid, Score: d.Score})
+		return nil
+	})
+	if err := r.client.Find(ctx, filter, c, opts); err != nil {
+		return nil, 0, rerror.ErrInternalBy(err)
+	}
+	return res, total, nil
+}
+
+func newItemSearchDocument(d gateway.ItemSearchDocument) itemSearchDocument {
+	// the terms and their prefixes are repeated in the text by their frequency to weight them, and exact terms count double
+	weights := map[string]int{}
+	for t, f := range d.Terms {
+		weights[t] += f * 2
+		runes := []rune(t)
+		for i := 1; i < len(runes) && i <= itemSearchMaxPrefix; i++ {
+			weights[string(runes[:i])] += f
+		}
+	}
+
+	terms := lo.Keys(weights)
+	slices.Sort(terms)
+	var text strings.Builder
+	for _, t := range terms {
+		for i := 0; i < min(weights[t], itemSearchMaxRepeat); i++ {
+			if text.Len() > 0 {
+				text.WriteByte(' ')
+			}
+			text.WriteString(t)
+		}
+	}
+
+	return itemSearchDocument{
+		ID:      d.ID.String() + ":" + d.Ref.String(),
+		Item:    d.ID.String(),
+		Project: d.Project.String(),
+		Model:   d.Model.String(),
+		Schema:  d.Schema.String(),
+		Ref:     d.Ref.String(),
+		Terms:   terms,
+		Text:    text.String(),
+	}
+}
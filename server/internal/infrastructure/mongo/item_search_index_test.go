@@ -0,0 +1,84 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/search"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItemSearch(t *testing.T) {
+	initDB := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(initDB(t))
+	r := NewItemSearch(client)
+	assert.NoError(t, r.Init())
+
+	ctx := context.Background()
+	pid, mid, sid := id.NewProjectID(), id.NewModelID(), id.NewSchemaID()
+	i1, i2 := id.NewItemID(), id.NewItemID()
+	doc := func(iid id.ItemID, ref version.Ref, text string, weight int) gateway.ItemSearchDocument {
+		terms := search.NewTerms()
+		terms.Add(text, weight)
+		return gateway.ItemSearchDocument{ID: iid, Project: pid, Model: mid, Schema: sid, Ref: ref, Terms: terms}
+	}
+
+	assert.NoError(t, r.Index(ctx, []gateway.ItemSearchDocument{
+		doc(i1, version.Latest, "東京都の天気", 1),
+		doc(i2, version.Latest, "東京タワー", 3),
+		doc(i2, version.Public, "東京タワー", 3),
+	}))
+
+	hitIDs := func(hits []gateway.ItemSearchHit) id.ItemIDList {
+		return lo.Map(hits, func(h gateway.ItemSearchHit, _ int) id.ItemID { return h.ID })
+	}
+
+	// the title weighted higher is ranked higher
+	hits, total, err := r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "東京"})
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i2, i1}, hitIDs(hits))
+	assert.Equal(t, int64(2), total)
+
+	// paginated in the store
+	hits, total, err = r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "東京", Offset: 1, Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i1}, hitIDs(hits))
+	assert.Equal(t, int64(2), total)
+
+	// every token has to match, and a token matches the terms starting with it
+	hits, _, err = r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "東京 天気"})
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i1}, hitIDs(hits))
+	hits, _, err = r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "京"})
+	assert.NoError(t, err)
+	assert.Len(t, hits, 2)
+
+	hits, _, err = r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Schema: &sid, Ref: version.Latest, Keyword: "天気", Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i1}, hitIDs(hits))
+
+	// re-indexing replaces the document
+	assert.NoError(t, r.Index(ctx, []gateway.ItemSearchDocument{doc(i1, version.Latest, "大阪", 1)}))
+	hits, _, err = r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "天気"})
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+
+	assert.NoError(t, r.Delete(ctx, id.ItemIDList{i2}, version.Public.Ref()))
+	hits, _, err = r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Public, Keyword: "タワー"})
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+	hits, _, err = r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "タワー"})
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i2}, hitIDs(hits))
+
+	assert.NoError(t, r.DeleteByModel(ctx, mid))
+	hits, _, err = r.Search(ctx, gateway.ItemSearchQuery{Project: pid, Model: mid, Ref: version.Latest, Keyword: "東京"})
+	assert.NoError(t, err)
+	assert.Empty(t, hits)
+}
@@ -0,0 +1,73 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearthx/mongox"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	itemTemplateIndexes       = []string{"project", "modelid"}
+	itemTemplateUniqueIndexes = []string{"id"}
+)
+
+type ItemTemplate struct {
+	client *mongox.Collection
+	f      repo.ProjectFilter
+}
+
+func NewItemTemplate(client *mongox.Client) repo.ItemTemplate {
+	return &ItemTemplate{client: client.WithCollection("item_template")}
+}
+
+func (r *ItemTemplate) Init() error {
+	return createIndexes(context.Background(), r.client, itemTemplateIndexes, itemTemplateUniqueIndexes)
+}
+
+func (r *ItemTemplate) Filtered(f repo.ProjectFilter) repo.ItemTemplate {
+	return &ItemTemplate{
+		client: r.client,
+		f:      r.f.Merge(f),
+	}
+}
+
+func (r *ItemTemplate) FindByID(ctx context.Context, tid id.ItemTemplateID) (*template.Template, error) {
+	c := mongodoc.NewItemTemplateConsumer()
+	if err := r.client.FindOne(ctx, r.readFilter(bson.M{"id": tid.String()}), c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *ItemTemplate) FindByModel(ctx context.Context, mid id.ModelID) (template.List, error) {
+	c := mongodoc.NewItemTemplateConsumer()
+	if err := r.client.Find(ctx, r.readFilter(bson.M{"modelid": mid.String()}), c); err != nil {
+		return nil, err
+	}
+	return template.List(c.Result).SortByName(), nil
+}
+
+func (r *ItemTemplate) Save(ctx context.Context, t *template.Template) error {
+	if !r.f.CanWrite(t.Project()) {
+		return repo.ErrOperationDenied
+	}
+	doc, tid := mongodoc.NewItemTemplate(t)
+	return r.client.SaveOne(ctx, tid, doc)
+}
+
+func (r *ItemTemplate) Remove(ctx context.Context, tid id.ItemTemplateID) error {
+	return r.client.RemoveOne(ctx, r.writeFilter(bson.M{"id": tid.String()}))
+}
+
+func (r *ItemTemplate) readFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Readable)
+}
+
+func (r *ItemTemplate) writeFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Writable)
+}
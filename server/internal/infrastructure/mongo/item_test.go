@@ -516,6 +516,33 @@ func TestItem_UpdateRef(t *testing.T) {
 	assert.Equal(t, version.NewRefs(vx, version.Latest), v2.Refs())
 }
 
+func TestItem_ReplaceVersion(t *testing.T) {
+	ctx := context.Background()
+	sid, fid, pid := id.NewSchemaID(), id.NewFieldID(), id.NewProjectID()
+	newItem := func(iid id.ItemID, v string) *item.Item {
+		return item.New().ID(iid).Schema(sid).Model(id.NewModelID()).Project(pid).Thread(id.NewThreadID().Ref()).
+			Fields([]*item.Field{item.NewField(fid, value.TypeText.Value(v).AsMultiple(), nil)}).MustBuild()
+	}
+	iid := id.NewItemID()
+	init := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(init(t))
+	r := NewItem(client)
+	_ = r.Save(ctx, newItem(iid, "a"))
+	_ = r.Save(ctx, newItem(iid, "b"))
+	v1, _ := r.FindByID(ctx, iid, nil)
+	_ = r.Save(ctx, newItem(iid, "c"))
+
+	assert.NoError(t, r.ReplaceVersion(ctx, version.ValueFrom(v1, newItem(iid, "x"))))
+	got, err := r.FindVersionByID(ctx, iid, v1.Version().OrRef())
+	assert.NoError(t, err)
+	assert.Equal(t, v1.Parents(), got.Parents())
+	assert.Equal(t, "x", got.Value().Field(fid).Value().First().Interface())
+	got, _ = r.FindByID(ctx, iid, nil)
+	assert.Equal(t, "c", got.Value().Field(fid).Value().First().Interface())
+
+	assert.Equal(t, rerror.ErrNotFound, r.ReplaceVersion(ctx, version.NewValue(version.New(), nil, nil, time.Time{}, newItem(iid, "d"))))
+}
+
 func TestItem_FindByAssets(t *testing.T) {
 	init := mongotest.Connect(t)
 	sid := id.NewSchemaID()
@@ -592,7 +619,7 @@ func TestItem_Copy(t *testing.T) {
 	filter, changes, err := r.Copy(ctx, params)
 	assert.NoError(t, err)
 
-	wantFilter, err := json.Marshal(bson.M{"schema": params.OldSchema.String(), "__r": bson.M{"$in": []string{"latest"}}})
+	wantFilter, err := json.Marshal(bson.M{"schema": params.OldSchema.String(), "__r": bson.M{"$in": []string{"latest"}}, "trashed": bson.M{"$ne": true}})
 	assert.NoError(t, err)
 	assert.Equal(t, filter, lo.ToPtr(string(wantFilter)))
 
@@ -135,9 +135,9 @@ func (r *Model) FindByIDOrKey(ctx context.Context, projectID id.ProjectID, q mod
 }
 
 func (r *Model) CountByProject(ctx context.Context, projectID id.ProjectID) (int, error) {
-	count, err := r.client.Count(ctx, bson.M{
+	count, err := r.client.Count(ctx, excludeTrashed(bson.M{
 		"project": projectID.String(),
-	})
+	}))
 	return int(count), err
 }
 
@@ -169,6 +169,14 @@ func (r *Model) Remove(ctx context.Context, modelID id.ModelID) error {
 	return r.client.RemoveOne(ctx, r.writeFilter(bson.M{"id": modelID.String()}))
 }
 
+func (r *Model) Trash(ctx context.Context, modelID id.ModelID) error {
+	return setTrashed(ctx, r.client, r.writeFilter(bson.M{"id": modelID.String()}), true)
+}
+
+func (r *Model) Restore(ctx context.Context, modelID id.ModelID) error {
+	return setTrashed(ctx, r.client, r.writeFilter(bson.M{"id": modelID.String()}), false)
+}
+
 func (r *Model) findOne(ctx context.Context, filter any) (*model.Model, error) {
 	c := mongodoc.NewModelConsumer()
 	if err := r.client.FindOne(ctx, r.readFilter(filter), c); err != nil {
@@ -229,7 +237,7 @@ func sortModels(ms *model.Sort) *usecasex.Sort {
 }
 
 func (r *Model) readFilter(filter interface{}) interface{} {
-	return applyProjectFilter(filter, r.f.Readable)
+	return applyProjectFilter(excludeTrashed(filter), r.f.Readable)
 }
 
 func (r *Model) writeFilter(filter interface{}) interface{} {
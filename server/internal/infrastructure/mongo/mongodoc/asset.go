@@ -25,6 +25,40 @@ type AssetDocument struct {
 	ArchiveExtractionStatus string
 	FlatFiles               bool
 	Public                  bool
+	Metadata                *AssetMetadataDocument `bson:",omitempty"`
+}
+
+type AssetMetadataDocument struct {
+	Width        *int                   `bson:",omitempty"`
+	Height       *int                   `bson:",omitempty"`
+	CapturedAt   *time.Time             `bson:",omitempty"`
+	Location     *AssetLocationDocument `bson:",omitempty"`
+	BBox         *AssetBBoxDocument     `bson:",omitempty"`
+	FeatureCount *int                   `bson:",omitempty"`
+	GLTF         *AssetGLTFDocument     `bson:",omitempty"`
+	Headers      []string               `bson:",omitempty"`
+	RowCount     *int                   `bson:",omitempty"`
+}
+
+type AssetLocationDocument struct {
+	Lat float64
+	Lng float64
+}
+
+type AssetBBoxDocument struct {
+	MinLng float64
+	MinLat float64
+	MaxLng float64
+	MaxLat float64
+}
+
+type AssetGLTFDocument struct {
+	Scenes     int
+	Nodes      int
+	Meshes     int
+	Materials  int
+	Textures   int
+	Animations int
 }
 
 type AssetAndFileDocument struct {
@@ -84,9 +118,72 @@ func NewAsset(a *asset.Asset) (*AssetDocument, string) {
 		ArchiveExtractionStatus: archiveExtractionStatus,
 		FlatFiles:               a.FlatFiles(),
 		Public:                  a.Public(),
+		Metadata:                NewAssetMetadata(a.Metadata()),
 	}, aid
 }
 
+func NewAssetMetadata(m *asset.Metadata) *AssetMetadataDocument {
+	if m.IsEmpty() {
+		return nil
+	}
+	d := &AssetMetadataDocument{
+		Width:        m.Width,
+		Height:       m.Height,
+		CapturedAt:   m.CapturedAt,
+		FeatureCount: m.FeatureCount,
+		Headers:      m.Headers,
+		RowCount:     m.RowCount,
+	}
+	if l := m.Location; l != nil {
+		d.Location = &AssetLocationDocument{Lat: l.Lat, Lng: l.Lng}
+	}
+	if b := m.BBox; b != nil {
+		d.BBox = &AssetBBoxDocument{MinLng: b.MinLng, MinLat: b.MinLat, MaxLng: b.MaxLng, MaxLat: b.MaxLat}
+	}
+	if g := m.GLTF; g != nil {
+		d.GLTF = &AssetGLTFDocument{
+			Scenes:     g.Scenes,
+			Nodes:      g.Nodes,
+			Meshes:     g.Meshes,
+			Materials:  g.Materials,
+			Textures:   g.Textures,
+			Animations: g.Animations,
+		}
+	}
+	return d
+}
+
+func (d *AssetMetadataDocument) Model() *asset.Metadata {
+	if d == nil {
+		return nil
+	}
+	m := &asset.Metadata{
+		Width:        d.Width,
+		Height:       d.Height,
+		CapturedAt:   d.CapturedAt,
+		FeatureCount: d.FeatureCount,
+		Headers:      d.Headers,
+		RowCount:     d.RowCount,
+	}
+	if l := d.Location; l != nil {
+		m.Location = &asset.Location{Lat: l.Lat, Lng: l.Lng}
+	}
+	if b := d.BBox; b != nil {
+		m.BBox = &asset.BBox{MinLng: b.MinLng, MinLat: b.MinLat, MaxLng: b.MaxLng, MaxLat: b.MaxLat}
+	}
+	if g := d.GLTF; g != nil {
+		m.GLTF = &asset.GLTFStats{
+			Scenes:     g.Scenes,
+			Nodes:      g.Nodes,
+			Meshes:     g.Meshes,
+			Materials:  g.Materials,
+			Textures:   g.Textures,
+			Animations: g.Animations,
+		}
+	}
+	return m
+}
+
 func (d *AssetDocument) Model() (*asset.Asset, error) {
 	aid, err := id.AssetIDFrom(d.ID)
 	if err != nil {
@@ -108,7 +205,8 @@ func (d *AssetDocument) Model() (*asset.Asset, error) {
 		Thread(id.ThreadIDFromRef(d.Thread)).
 		ArchiveExtractionStatus(asset.ArchiveExtractionStatusFromRef(lo.ToPtr(d.ArchiveExtractionStatus))).
 		FlatFiles(d.FlatFiles).
-		Public(d.Public)
+		Public(d.Public).
+		Metadata(d.Metadata.Model())
 
 	if d.User != nil {
 		uid, err := accountdomain.UserIDFrom(*d.User)
@@ -9,6 +9,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -155,3 +156,22 @@ func TestNewFile(t *testing.T) {
 		})
 	}
 }
+
+func TestAssetMetadataDocument_Model(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	m := &asset.Metadata{
+		Width:        lo.ToPtr(10),
+		Height:       lo.ToPtr(20),
+		CapturedAt:   &now,
+		Location:     &asset.Location{Lat: 35, Lng: 139},
+		BBox:         &asset.BBox{MinLng: 1, MinLat: 2, MaxLng: 3, MaxLat: 4},
+		FeatureCount: lo.ToPtr(5),
+		GLTF:         &asset.GLTFStats{Scenes: 1, Nodes: 2, Meshes: 3, Materials: 4, Textures: 5, Animations: 6},
+		Headers:      []string{"a", "b"},
+		RowCount:     lo.ToPtr(7),
+	}
+	assert.Equal(t, m, NewAssetMetadata(m).Model())
+	assert.Nil(t, NewAssetMetadata(nil))
+	assert.Nil(t, NewAssetMetadata(&asset.Metadata{}))
+	assert.Nil(t, (*AssetMetadataDocument)(nil).Model())
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongogit"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/mongox"
@@ -35,6 +36,8 @@ type ItemFieldDocument struct {
 	F         string        `bson:"f,omitempty"`
 	V         ValueDocument `bson:"v,omitempty"`
 	ItemGroup *string
+	// L holds the values of the locales other than the default locale
+	L map[string]ValueDocument `bson:"l,omitempty"`
 }
 
 type ItemConsumer = mongox.SliceFuncConsumer[*ItemDocument, *item.Item]
@@ -60,24 +63,13 @@ func NewVersionedItemConsumer() *VersionedItemConsumer {
 func NewItem(i *item.Item) (*ItemDocument, string) {
 	itmId := i.ID().String()
 	return &ItemDocument{
-		ID:           itmId,
-		Schema:       i.Schema().String(),
-		ModelID:      i.Model().String(),
-		Project:      i.Project().String(),
-		MetadataItem: i.MetadataItem().StringRef(),
-		OriginalItem: i.OriginalItem().StringRef(),
-		Fields: lo.FilterMap(i.Fields(), func(f *item.Field, _ int) (ItemFieldDocument, bool) {
-			v := NewMultipleValue(f.Value())
-			if v == nil {
-				return ItemFieldDocument{}, false
-			}
-
-			return ItemFieldDocument{
-				ItemGroup: f.ItemGroup().StringRef(),
-				F:         f.FieldID().String(),
-				V:         *v,
-			}, true
-		}),
+		ID:                   itmId,
+		Schema:               i.Schema().String(),
+		ModelID:              i.Model().String(),
+		Project:              i.Project().String(),
+		MetadataItem:         i.MetadataItem().StringRef(),
+		OriginalItem:         i.OriginalItem().StringRef(),
+		Fields:               newItemFields(i.Fields()),
 		Timestamp:            i.Timestamp(),
 		User:                 i.User().StringRef(),
 		UpdatedByUser:        i.UpdatedByUser().StringRef(),
@@ -110,14 +102,7 @@ func (d *ItemDocument) Model() (*item.Item, error) {
 		return nil, err
 	}
 
-	fields, err := util.TryMap(d.Fields, func(f ItemFieldDocument) (*item.Field, error) {
-		sf, err := item.FieldIDFrom(f.F)
-		if err != nil {
-			return nil, err
-		}
-		ig := id.ItemGroupIDFromRef(f.ItemGroup)
-		return item.NewField(sf, f.V.MultipleValue(), ig), nil
-	})
+	fields, err := itemFieldsFrom(d.Fields)
 	if err != nil {
 		return nil, err
 	}
@@ -160,3 +145,50 @@ func NewItems(items item.List) ([]*ItemDocument, []string) {
 	}
 	return res, ids
 }
+
+func newItemFields(fields item.Fields) []ItemFieldDocument {
+	return lo.FilterMap(fields, func(f *item.Field, _ int) (ItemFieldDocument, bool) {
+		v := NewMultipleValue(f.Value())
+		if v == nil {
+			return ItemFieldDocument{}, false
+		}
+
+		return ItemFieldDocument{
+			ItemGroup: f.ItemGroup().StringRef(),
+			F:         f.FieldID().String(),
+			V:         *v,
+			L:         newItemFieldLocales(f),
+		}, true
+	})
+}
+
+func itemFieldsFrom(docs []ItemFieldDocument) (item.Fields, error) {
+	return util.TryMap(docs, func(f ItemFieldDocument) (*item.Field, error) {
+		sf, err := item.FieldIDFrom(f.F)
+		if err != nil {
+			return nil, err
+		}
+		ig := id.ItemGroupIDFromRef(f.ItemGroup)
+		itf := item.NewField(sf, f.V.MultipleValue(), ig)
+		if itf != nil {
+			for l, v := range f.L {
+				itf.SetLocaleValue(locale.Locale(l), v.MultipleValue())
+			}
+		}
+		return itf, nil
+	})
+}
+
+func newItemFieldLocales(f *item.Field) map[string]ValueDocument {
+	locales := f.Locales()
+	if len(locales) == 0 {
+		return nil
+	}
+	res := make(map[string]ValueDocument, len(locales))
+	for l, v := range locales {
+		if d := NewMultipleValue(v); d != nil {
+			res[l.String()] = *d
+		}
+	}
+	return res
+}
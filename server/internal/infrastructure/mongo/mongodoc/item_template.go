@@ -0,0 +1,84 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+)
+
+type ItemTemplateDocument struct {
+	ID             string
+	Project        string
+	ModelID        string
+	Name           string
+	Description    string
+	Fields         []ItemFieldDocument
+	MetadataFields []ItemFieldDocument
+	User           *string
+	Integration    *string
+	UpdatedAt      time.Time
+}
+
+type ItemTemplateConsumer = mongox.SliceFuncConsumer[*ItemTemplateDocument, *template.Template]
+
+func NewItemTemplateConsumer() *ItemTemplateConsumer {
+	return NewConsumer[*ItemTemplateDocument, *template.Template]()
+}
+
+func NewItemTemplate(t *template.Template) (*ItemTemplateDocument, string) {
+	if t == nil {
+		return nil, ""
+	}
+	tid := t.ID().String()
+	return &ItemTemplateDocument{
+		ID:             tid,
+		Project:        t.Project().String(),
+		ModelID:        t.Model().String(),
+		Name:           t.Name(),
+		Description:    t.Description(),
+		Fields:         newItemFields(t.Fields()),
+		MetadataFields: newItemFields(t.MetadataFields()),
+		User:           t.User().StringRef(),
+		Integration:    t.Integration().StringRef(),
+		UpdatedAt:      t.UpdatedAt(),
+	}, tid
+}
+
+func (d *ItemTemplateDocument) Model() (*template.Template, error) {
+	tid, err := id.ItemTemplateIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+	mid, err := id.ModelIDFrom(d.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := itemFieldsFrom(d.Fields)
+	if err != nil {
+		return nil, err
+	}
+	metadataFields, err := itemFieldsFrom(d.MetadataFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return template.New().
+		ID(tid).
+		Project(pid).
+		Model(mid).
+		Name(d.Name).
+		Description(d.Description).
+		Fields(fields).
+		MetadataFields(metadataFields).
+		User(accountdomain.UserIDFromRef(d.User)).
+		Integration(id.IntegrationIDFromRef(d.Integration)).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
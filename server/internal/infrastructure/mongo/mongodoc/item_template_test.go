@@ -0,0 +1,51 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItemTemplate(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	uid := user.NewID()
+	fid, gid := id.NewFieldID(), id.NewFieldID()
+	g := id.NewItemGroupID()
+	f := item.NewField(fid, value.TypeText.Value("a").AsMultiple(), nil)
+	f.SetLocaleValue("ja", value.TypeText.Value("あ").AsMultiple())
+
+	tm := template.New().NewID().
+		Project(id.NewProjectID()).
+		Model(id.NewModelID()).
+		Name("template").
+		Description("desc").
+		Fields(item.Fields{
+			f,
+			item.NewField(gid, value.NewMultiple(value.TypeGroup, []any{g}), nil),
+			item.NewField(fid, value.TypeText.Value("b").AsMultiple(), g.Ref()),
+		}).
+		MetadataFields(item.Fields{item.NewField(fid, value.TypeBool.Value(true).AsMultiple(), nil)}).
+		User(&uid).
+		UpdatedAt(now).
+		MustBuild()
+
+	doc, tid := NewItemTemplate(tm)
+	assert.Equal(t, tm.ID().String(), tid)
+	assert.Equal(t, "template", doc.Name)
+	assert.Len(t, doc.Fields, 3)
+	assert.Len(t, doc.MetadataFields, 1)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, tm, got)
+
+	doc, tid = NewItemTemplate(nil)
+	assert.Nil(t, doc)
+	assert.Empty(t, tid)
+}
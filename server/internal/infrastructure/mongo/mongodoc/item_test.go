@@ -10,6 +10,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/account/accountdomain/user"
 	"github.com/stretchr/testify/assert"
 )
@@ -177,6 +178,21 @@ func TestNewItem(t *testing.T) {
 	}
 }
 
+func TestNewItem_Locales(t *testing.T) {
+	fId := schema.NewFieldID()
+	f := item.NewField(fId, value.TypeText.Value("hello").AsMultiple(), nil)
+	f.SetLocaleValue("ja", value.TypeText.Value("こんにちは").AsMultiple())
+	i := item.New().NewID().Project(project.NewID()).Schema(schema.NewID()).Model(model.NewID()).Thread(thread.NewID().Ref()).Fields(item.Fields{f}).MustBuild()
+
+	doc, _ := NewItem(i)
+	assert.Equal(t, map[string]ValueDocument{"ja": {T: "text", V: []any{"こんにちは"}}}, doc.Fields[0].L)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), got.Field(fId).Value())
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), got.Field(fId).LocaleValue("ja"))
+}
+
 func TestNewItemConsumer(t *testing.T) {
 	c := NewItemConsumer()
 	assert.NotNil(t, c)
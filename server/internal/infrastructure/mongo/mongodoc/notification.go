@@ -0,0 +1,162 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/samber/lo"
+)
+
+type NotificationDocument struct {
+	ID          string
+	User        string
+	Workspace   string
+	Project     string
+	Request     string
+	Type        string
+	Comment     *string
+	ReadAt      *time.Time
+	Read        bool
+	Actor       *string
+	Integration *string
+	Machine     bool
+}
+
+// NotificationPreferenceDocument is identified by the ID of the user.
+type NotificationPreferenceDocument struct {
+	ID       string
+	Settings []NotificationSettingDocument
+}
+
+type NotificationSettingDocument struct {
+	Type  string
+	Email bool
+	InApp bool
+}
+
+type NotificationConsumer = mongox.SliceFuncConsumer[*NotificationDocument, *notification.Notification]
+
+func NewNotificationConsumer() *NotificationConsumer {
+	return NewConsumer[*NotificationDocument, *notification.Notification]()
+}
+
+type NotificationPreferenceConsumer = mongox.SliceFuncConsumer[*NotificationPreferenceDocument, *notification.Preference]
+
+func NewNotificationPreferenceConsumer() *NotificationPreferenceConsumer {
+	return NewConsumer[*NotificationPreferenceDocument, *notification.Preference]()
+}
+
+func NewNotification(n *notification.Notification) (*NotificationDocument, string) {
+	nid := n.ID().String()
+	return &NotificationDocument{
+		ID:          nid,
+		User:        n.User().String(),
+		Workspace:   n.Workspace().String(),
+		Project:     n.Project().String(),
+		Request:     n.Request().String(),
+		Type:        n.Type().String(),
+		Comment:     n.Comment().StringRef(),
+		ReadAt:      n.ReadAt(),
+		Read:        n.IsRead(),
+		Actor:       n.Actor().User().StringRef(),
+		Integration: n.Actor().Integration().StringRef(),
+		Machine:     n.Actor().Machine(),
+	}, nid
+}
+
+func NewNotifications(l notification.List) ([]any, []string) {
+	res := make([]any, 0, len(l))
+	ids := make([]string, 0, len(l))
+	for _, n := range l {
+		if n == nil {
+			continue
+		}
+		d, nid := NewNotification(n)
+		res = append(res, d)
+		ids = append(ids, nid)
+	}
+	return res, ids
+}
+
+func (d *NotificationDocument) Model() (*notification.Notification, error) {
+	nid, err := notification.IDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := accountdomain.UserIDFrom(d.User)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := accountdomain.WorkspaceIDFrom(d.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+	rid, err := id.RequestIDFrom(d.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	var o operator.Operator
+	if d.Actor != nil {
+		if uid := accountdomain.UserIDFromRef(d.Actor); uid != nil {
+			o = operator.OperatorFromUser(*uid)
+		}
+	} else if d.Integration != nil {
+		if iid := id.IntegrationIDFromRef(d.Integration); iid != nil {
+			o = operator.OperatorFromIntegration(*iid)
+		}
+	} else if d.Machine {
+		o = operator.OperatorFromMachine()
+	}
+
+	return notification.New().
+		ID(nid).
+		User(uid).
+		Workspace(wid).
+		Project(pid).
+		Request(rid).
+		Type(notification.Type(d.Type)).
+		Actor(o).
+		Comment(id.CommentIDFromRef(d.Comment)).
+		ReadAt(d.ReadAt).
+		Build()
+}
+
+func NewNotificationPreference(p *notification.Preference) (*NotificationPreferenceDocument, string) {
+	uid := p.User().String()
+	settings := lo.MapToSlice(p.Settings(), func(t notification.Type, s notification.Setting) NotificationSettingDocument {
+		return NotificationSettingDocument{Type: t.String(), Email: s.Email, InApp: s.InApp}
+	})
+	return &NotificationPreferenceDocument{
+		ID:       uid,
+		Settings: settings,
+	}, uid
+}
+
+func (d *NotificationPreferenceDocument) Model() (*notification.Preference, error) {
+	uid, err := accountdomain.UserIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	p := notification.NewPreference(uid)
+	for _, s := range d.Settings {
+		t, ok := notification.TypeFrom(s.Type)
+		if !ok {
+			// settings of removed types are ignored
+			continue
+		}
+		if err := p.SetSetting(t, notification.Setting{Email: s.Email, InApp: s.InApp}); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,49 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationDocument_Model(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	cid := id.NewCommentID()
+	n := notification.New().
+		NewID().
+		User(accountdomain.NewUserID()).
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).
+		Request(id.NewRequestID()).
+		Type(notification.TypeRequestComment).
+		Actor(operator.OperatorFromUser(accountdomain.NewUserID())).
+		Comment(&cid).
+		ReadAt(&now).
+		MustBuild()
+
+	doc, nid := NewNotification(n)
+	assert.Equal(t, n.ID().String(), nid)
+	assert.True(t, doc.Read)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, n, got)
+}
+
+func TestNotificationPreferenceDocument_Model(t *testing.T) {
+	p := notification.NewPreference(accountdomain.NewUserID())
+	assert.NoError(t, p.SetSetting(notification.TypeRequestClosed, notification.Setting{Email: false, InApp: true}))
+
+	doc, uid := NewNotificationPreference(p)
+	assert.Equal(t, p.User().String(), uid)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, p.Settings(), got.Settings())
+	assert.Equal(t, p.User(), got.User())
+}
@@ -0,0 +1,71 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/util"
+)
+
+type PreviewTokenDocument struct {
+	ID          string
+	Workspace   string
+	Project     string
+	Request     *string
+	User        *string
+	Integration *string
+	ExpiresAt   time.Time
+	RevokedAt   *time.Time
+}
+
+type PreviewTokenConsumer = mongox.SliceFuncConsumer[*PreviewTokenDocument, *preview.Token]
+
+func NewPreviewTokenConsumer() *PreviewTokenConsumer {
+	return NewConsumer[*PreviewTokenDocument, *preview.Token]()
+}
+
+func NewPreviewToken(t *preview.Token) (*PreviewTokenDocument, string) {
+	if t == nil {
+		return nil, ""
+	}
+	tid := t.ID().String()
+	return &PreviewTokenDocument{
+		ID:          tid,
+		Workspace:   t.Workspace().String(),
+		Project:     t.Project().String(),
+		Request:     t.Request().StringRef(),
+		User:        t.User().StringRef(),
+		Integration: t.Integration().StringRef(),
+		ExpiresAt:   t.ExpiresAt(),
+		RevokedAt:   util.CloneRef(t.RevokedAt()),
+	}, tid
+}
+
+func (d *PreviewTokenDocument) Model() (*preview.Token, error) {
+	tid, err := id.PreviewTokenIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := accountdomain.WorkspaceIDFrom(d.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	return preview.New().
+		ID(tid).
+		Workspace(wid).
+		Project(pid).
+		Request(id.RequestIDFromRef(d.Request)).
+		User(accountdomain.UserIDFromRef(d.User)).
+		Integration(id.IntegrationIDFromRef(d.Integration)).
+		ExpiresAt(d.ExpiresAt).
+		RevokedAt(d.RevokedAt).
+		Build()
+}
@@ -0,0 +1,44 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPreviewToken(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	rid := id.NewRequestID()
+	uid := accountdomain.NewUserID()
+
+	tk := preview.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Request(&rid).User(&uid).ExpiresAt(now.Add(time.Hour)).MustBuild()
+	tk.Revoke(now)
+
+	doc, tid := NewPreviewToken(tk)
+	assert.Equal(t, tk.ID().String(), tid)
+	assert.Equal(t, &PreviewTokenDocument{
+		ID:        tid,
+		Workspace: tk.Workspace().String(),
+		Project:   tk.Project().String(),
+		Request:   rid.StringRef(),
+		User:      uid.StringRef(),
+		ExpiresAt: now.Add(time.Hour),
+		RevokedAt: &now,
+	}, doc)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, tk, got)
+
+	doc, tid = NewPreviewToken(nil)
+	assert.Nil(t, doc)
+	assert.Empty(t, tid)
+
+	_, err = (&PreviewTokenDocument{ID: "x"}).Model()
+	assert.Error(t, err)
+}
@@ -5,7 +5,9 @@ import (
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/mongox"
@@ -13,21 +15,37 @@ import (
 )
 
 type ProjectDocument struct {
-	ID           string
-	UpdatedAt    time.Time
-	Name         string
-	Description  string
-	Alias        string
-	ImageURL     string
-	Workspace    string
-	Publication  *ProjectPublicationDocument
-	RequestRoles []string
+	ID             string
+	UpdatedAt      time.Time
+	Name           string
+	Description    string
+	Alias          string
+	ImageURL       string
+	Workspace      string
+	Publication    *ProjectPublicationDocument
+	RequestRoles   []string
+	Locales        *ProjectLocalesDocument
+	ApprovalPolicy *ProjectApprovalPolicyDocument
+}
+
+type ProjectApprovalPolicyDocument struct {
+	MinApprovals      int
+	RequiredReviewers []string
+	RequiredRoles     []string
+	ResetOnChange     bool
+}
+
+type ProjectLocalesDocument struct {
+	Default   string
+	Locales   []string
+	Fallbacks map[string]string
 }
 
 type ProjectPublicationDocument struct {
 	AssetPublic bool
 	Scope       string
 	Token       *string
+	CacheMaxAge int
 }
 
 func NewProject(project *project.Project) (*ProjectDocument, string) {
@@ -39,15 +57,17 @@ func NewProject(project *project.Project) (*ProjectDocument, string) {
 	}
 
 	return &ProjectDocument{
-		ID:           pid,
-		UpdatedAt:    project.UpdatedAt(),
-		Name:         project.Name(),
-		Description:  project.Description(),
-		Alias:        project.Alias(),
-		ImageURL:     imageURL,
-		Workspace:    project.Workspace().String(),
-		Publication:  NewProjectPublication(project.Publication()),
-		RequestRoles: fromRequestRoles(project.RequestRoles()),
+		ID:             pid,
+		UpdatedAt:      project.UpdatedAt(),
+		Name:           project.Name(),
+		Description:    project.Description(),
+		Alias:          project.Alias(),
+		ImageURL:       imageURL,
+		Workspace:      project.Workspace().String(),
+		Publication:    NewProjectPublication(project.Publication()),
+		RequestRoles:   fromRequestRoles(project.RequestRoles()),
+		Locales:        NewProjectLocales(project.Locales()),
+		ApprovalPolicy: NewProjectApprovalPolicy(project.ApprovalPolicy()),
 	}, pid
 }
 
@@ -63,6 +83,37 @@ func NewProjectPublication(p *project.Publication) *ProjectPublicationDocument {
 		AssetPublic: p.AssetPublic(),
 		Scope:       string(p.Scope()),
 		Token:       t,
+		CacheMaxAge: p.CacheMaxAge(),
+	}
+}
+
+func NewProjectLocales(l *locale.Settings) *ProjectLocalesDocument {
+	if l == nil {
+		return nil
+	}
+	var fallbacks map[string]string
+	if f := l.Fallbacks(); len(f) > 0 {
+		fallbacks = make(map[string]string, len(f))
+		for k, v := range f {
+			fallbacks[k.String()] = v.String()
+		}
+	}
+	return &ProjectLocalesDocument{
+		Default:   l.Default().String(),
+		Locales:   l.Locales().Strings(),
+		Fallbacks: fallbacks,
+	}
+}
+
+func NewProjectApprovalPolicy(p *request.ApprovalPolicy) *ProjectApprovalPolicyDocument {
+	if p == nil {
+		return nil
+	}
+	return &ProjectApprovalPolicyDocument{
+		MinApprovals:      p.MinApprovals(),
+		RequiredReviewers: p.RequiredReviewers().Strings(),
+		RequiredRoles:     fromRequestRoles(p.RequiredRoles()),
+		ResetOnChange:     p.ResetOnChange(),
 	}
 }
 
@@ -93,18 +144,54 @@ func (d *ProjectDocument) Model() (*project.Project, error) {
 		ImageURL(imageURL).
 		Publication(d.Publication.Model()).
 		RequestRoles(toRequestRoles(d.RequestRoles)).
+		Locales(d.Locales.Model()).
+		ApprovalPolicy(d.ApprovalPolicy.Model()).
 		Build()
 }
 
+func (d *ProjectLocalesDocument) Model() *locale.Settings {
+	if d == nil {
+		return nil
+	}
+	locales := lo.Map(d.Locales, func(l string, _ int) locale.Locale { return locale.Locale(l) })
+	fallbacks := make(map[locale.Locale]locale.Locale, len(d.Fallbacks))
+	for k, v := range d.Fallbacks {
+		fallbacks[locale.Locale(k)] = locale.Locale(v)
+	}
+	s, err := locale.NewSettings(locale.Locale(d.Default), locales, fallbacks)
+	if err != nil {
+		return nil
+	}
+	return s
+}
+
+func (d *ProjectApprovalPolicyDocument) Model() *request.ApprovalPolicy {
+	if d == nil {
+		return nil
+	}
+	reviewers, err := id.UserIDListFrom(d.RequiredReviewers)
+	if err != nil {
+		return nil
+	}
+	p, err := request.NewApprovalPolicy(d.MinApprovals, reviewers, toRequestRoles(d.RequiredRoles), d.ResetOnChange)
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
 func (d *ProjectPublicationDocument) Model() *project.Publication {
 	if d == nil {
 		return nil
 	}
+	var p *project.Publication
 	if d.Token != nil {
-		return project.NewPublicationWithToken(project.PublicationScope(d.Scope), d.AssetPublic, *d.Token)
+		p = project.NewPublicationWithToken(project.PublicationScope(d.Scope), d.AssetPublic, *d.Token)
 	} else {
-		return project.NewPublication(project.PublicationScope(d.Scope), d.AssetPublic)
+		p = project.NewPublication(project.PublicationScope(d.Scope), d.AssetPublic)
 	}
+	p.SetCacheMaxAge(d.CacheMaxAge)
+	return p
 }
 
 type ProjectConsumer = mongox.SliceFuncConsumer[*ProjectDocument, *project.Project]
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -251,3 +253,21 @@ func TestProjectPublicationDocument_Model(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectApprovalPolicyDocument_Model(t *testing.T) {
+	u := accountdomain.NewUserID()
+	p := lo.Must(request.NewApprovalPolicy(2, accountdomain.UserIDList{u}, []workspace.Role{workspace.RoleOwner}, true))
+
+	doc := NewProjectApprovalPolicy(p)
+	assert.Equal(t, &ProjectApprovalPolicyDocument{
+		MinApprovals:      2,
+		RequiredReviewers: []string{u.String()},
+		RequiredRoles:     []string{"owner"},
+		ResetOnChange:     true,
+	}, doc)
+	assert.Equal(t, p, doc.Model())
+
+	assert.Nil(t, NewProjectApprovalPolicy(nil))
+	assert.Nil(t, (*ProjectApprovalPolicyDocument)(nil).Model())
+	assert.Nil(t, (&ProjectApprovalPolicyDocument{MinApprovals: 0}).Model())
+}
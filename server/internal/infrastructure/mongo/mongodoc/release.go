@@ -0,0 +1,108 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type ReleaseDocument struct {
+	ID          string
+	Workspace   string
+	Project     string
+	Name        string
+	Description string
+	State       string
+	Items       []ReleaseItem
+	PublishedAt *time.Time
+	UpdatedAt   time.Time
+}
+
+type ReleaseItem struct {
+	Item     string
+	Version  string
+	Previous *string
+}
+
+type ReleaseConsumer = mongox.SliceFuncConsumer[*ReleaseDocument, *release.Release]
+
+func NewReleaseConsumer() *ReleaseConsumer {
+	return NewConsumer[*ReleaseDocument, *release.Release]()
+}
+
+func NewRelease(r *release.Release) (*ReleaseDocument, string) {
+	if r == nil {
+		return nil, ""
+	}
+	rid := r.ID().String()
+	return &ReleaseDocument{
+		ID:          rid,
+		Workspace:   r.Workspace().String(),
+		Project:     r.Project().String(),
+		Name:        r.Name(),
+		Description: r.Description(),
+		State:       string(r.State()),
+		Items: lo.Map(r.Items(), func(i *release.Item, _ int) ReleaseItem {
+			var prev *string
+			if p := i.Previous(); p != nil {
+				prev = lo.ToPtr(p.String())
+			}
+			return ReleaseItem{
+				Item:     i.Item().String(),
+				Version:  i.Version().String(),
+				Previous: prev,
+			}
+		}),
+		PublishedAt: util.CloneRef(r.PublishedAt()),
+		UpdatedAt:   r.UpdatedAt(),
+	}, rid
+}
+
+func (d *ReleaseDocument) Model() (*release.Release, error) {
+	rid, err := id.ReleaseIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := accountdomain.WorkspaceIDFrom(d.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+	state, _ := release.StateFrom(d.State)
+
+	items, err := util.TryMap(d.Items, func(i ReleaseItem) (*release.Item, error) {
+		iid, err := id.ItemIDFrom(i.Item)
+		if err != nil {
+			return nil, err
+		}
+		v := version.ParseVersion(&i.Version)
+		if v == nil {
+			return nil, release.ErrInvalidID
+		}
+		return release.NewItemWithPrevious(iid, *v, version.ParseVersion(i.Previous))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return release.New().
+		ID(rid).
+		Workspace(wid).
+		Project(pid).
+		Name(d.Name).
+		Description(d.Description).
+		State(state).
+		Items(items).
+		PublishedAt(d.PublishedAt).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
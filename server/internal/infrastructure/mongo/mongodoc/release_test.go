@@ -0,0 +1,42 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRelease(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	prev := version.New()
+	iid1, iid2 := id.NewItemID(), id.NewItemID()
+
+	r := release.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Name("spring").Description("desc").MustBuild()
+	lo.Must0(r.AddItem(lo.Must(release.NewItem(iid1, version.New()))))
+	lo.Must0(r.AddItem(lo.Must(release.NewItem(iid2, version.New()))))
+	lo.Must0(r.Publish(map[id.ItemID]*version.Version{iid1: &prev}, now))
+
+	doc, rid := NewRelease(r)
+	assert.Equal(t, r.ID().String(), rid)
+	assert.Equal(t, "published", doc.State)
+	assert.Equal(t, lo.ToPtr(prev.String()), doc.Items[0].Previous)
+	assert.Nil(t, doc.Items[1].Previous)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, r, got)
+
+	doc, rid = NewRelease(nil)
+	assert.Nil(t, doc)
+	assert.Empty(t, rid)
+
+	_, err = (&ReleaseDocument{ID: id.NewReleaseID().String(), Workspace: r.Workspace().String(), Project: r.Project().String(), Name: "a b"}).Model()
+	assert.Equal(t, release.ErrInvalidName, err)
+}
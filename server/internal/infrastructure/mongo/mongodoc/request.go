@@ -22,6 +22,7 @@ type RequestDocument struct {
 	Description string
 	CreatedBy   string
 	Reviewers   []string
+	Reviews     []RequestReview
 	State       string
 	UpdatedAt   time.Time
 	ApprovedAt  *time.Time
@@ -35,6 +36,12 @@ type RequestItem struct {
 	Ref     *string
 }
 
+type RequestReview struct {
+	Reviewer  string
+	Decision  string
+	CreatedAt time.Time
+}
+
 type RequestConsumer = mongox.SliceFuncConsumer[*RequestDocument, *request.Request]
 
 func NewRequestConsumer() *RequestConsumer {
@@ -72,6 +79,7 @@ func NewRequest(r *request.Request) (*RequestDocument, string) {
 		Reviewers: lo.Map(r.Reviewers(), func(u accountdomain.UserID, i int) string {
 			return u.String()
 		}),
+		Reviews:    newRequestReviews(r.Reviews()),
 		State:      r.State().String(),
 		UpdatedAt:  r.UpdatedAt(),
 		ApprovedAt: r.ApprovedAt(),
@@ -117,6 +125,10 @@ func (d *RequestDocument) Model() (*request.Request, error) {
 	if err != nil {
 		return nil, err
 	}
+	reviews, err := requestReviewsModel(d.Reviews)
+	if err != nil {
+		return nil, err
+	}
 	items, err := util.TryMap(d.Items, func(ri RequestItem) (*request.Item, error) {
 		iid, err := id.ItemIDFrom(ri.Item)
 		if err != nil {
@@ -151,7 +163,34 @@ func (d *RequestDocument) Model() (*request.Request, error) {
 		ClosedAt(d.ClosedAt).
 		ApprovedAt(d.ApprovedAt).
 		Reviewers(reviewers).
+		Reviews(reviews).
 		Thread(id.ThreadIDFromRef(d.Thread))
 
 	return builder.Build()
 }
+
+func newRequestReviews(reviews []*request.Review) []RequestReview {
+	if len(reviews) == 0 {
+		return nil
+	}
+	return lo.Map(reviews, func(rv *request.Review, _ int) RequestReview {
+		return RequestReview{
+			Reviewer:  rv.Reviewer().String(),
+			Decision:  rv.Decision().String(),
+			CreatedAt: rv.CreatedAt(),
+		}
+	})
+}
+
+func requestReviewsModel(reviews []RequestReview) ([]*request.Review, error) {
+	if len(reviews) == 0 {
+		return nil, nil
+	}
+	return util.TryMap(reviews, func(rv RequestReview) (*request.Review, error) {
+		uid, err := accountdomain.UserIDFrom(rv.Reviewer)
+		if err != nil {
+			return nil, err
+		}
+		return request.NewReview(uid, request.DecisionFrom(rv.Decision), rv.CreatedAt), nil
+	})
+}
@@ -369,3 +369,30 @@ func TestRequestDocument_Model(t *testing.T) {
 		})
 	}
 }
+
+func TestRequestDocument_Reviews(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	itm, _ := request.NewItem(item.NewID(), lo.ToPtr(version.New().String()))
+	u1, u2 := user.NewID(), user.NewID()
+	req := request.New().NewID().Project(project.NewID()).Workspace(user.NewWorkspaceID()).CreatedBy(user.NewID()).
+		Title("a").Items(request.ItemList{itm}).Reviewers(accountdomain.UserIDList{u1, u2}).
+		Reviews([]*request.Review{
+			request.NewReview(u1, request.DecisionApproved, now),
+			request.NewReview(u2, request.DecisionChangesRequested, now),
+		}).
+		UpdatedAt(now).MustBuild()
+
+	doc, _ := NewRequest(req)
+	assert.Equal(t, []RequestReview{
+		{Reviewer: u1.String(), Decision: "approved", CreatedAt: now},
+		{Reviewer: u2.String(), Decision: "changes_requested", CreatedAt: now},
+	}, doc.Reviews)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.Equal(t, req.Reviews(), got.Reviews())
+
+	doc.Reviews[0].Reviewer = "x"
+	_, err = doc.Model()
+	assert.Error(t, err)
+}
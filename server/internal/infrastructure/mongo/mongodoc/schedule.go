@@ -0,0 +1,84 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+)
+
+type ScheduleDocument struct {
+	ID          string
+	Workspace   string
+	Project     string
+	ModelID     string
+	Item        string
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+	User        *string
+	Integration *string
+	UpdatedAt   time.Time
+}
+
+type ScheduleConsumer = mongox.SliceFuncConsumer[*ScheduleDocument, *schedule.Schedule]
+
+func NewScheduleConsumer() *ScheduleConsumer {
+	return NewConsumer[*ScheduleDocument, *schedule.Schedule]()
+}
+
+func NewSchedule(s *schedule.Schedule) (*ScheduleDocument, string) {
+	if s == nil {
+		return nil, ""
+	}
+	sid := s.ID().String()
+	return &ScheduleDocument{
+		ID:          sid,
+		Workspace:   s.Workspace().String(),
+		Project:     s.Project().String(),
+		ModelID:     s.Model().String(),
+		Item:        s.Item().String(),
+		PublishAt:   s.PublishAt(),
+		UnpublishAt: s.UnpublishAt(),
+		User:        s.User().StringRef(),
+		Integration: s.Integration().StringRef(),
+		UpdatedAt:   s.UpdatedAt(),
+	}, sid
+}
+
+func (d *ScheduleDocument) Model() (*schedule.Schedule, error) {
+	sid, err := id.ScheduleIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := accountdomain.WorkspaceIDFrom(d.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+	mid, err := id.ModelIDFrom(d.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	iid, err := id.ItemIDFrom(d.Item)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule.New().
+		ID(sid).
+		Workspace(wid).
+		Project(pid).
+		Model(mid).
+		Item(iid).
+		PublishAt(d.PublishAt).
+		UnpublishAt(d.UnpublishAt).
+		User(accountdomain.UserIDFromRef(d.User)).
+		Integration(id.IntegrationIDFromRef(d.Integration)).
+		UpdatedAt(d.UpdatedAt).
+		Build()
+}
@@ -3,6 +3,7 @@ package mongodoc
 import (
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/expression"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
@@ -13,25 +14,39 @@ import (
 )
 
 type SchemaDocument struct {
-	ID         string
-	Workspace  string
-	Project    string
-	Fields     []FieldDocument
-	TitleField *string
+	ID              string
+	Workspace       string
+	Project         string
+	Fields          []FieldDocument
+	TitleField      *string
+	ValidationRules []ValidationRuleDocument `bson:",omitempty"`
 }
 
 type FieldDocument struct {
-	ID           string
-	Name         string
-	Description  string
-	Order        int
-	Key          string
-	Unique       bool
-	Multiple     bool
-	Required     bool
-	UpdatedAt    time.Time
-	DefaultValue *ValueDocument
-	TypeProperty TypePropertyDocument
+	ID              string
+	Name            string
+	Description     string
+	Order           int
+	Key             string
+	Unique          bool
+	Multiple        bool
+	Required        bool
+	Localized       bool `bson:",omitempty"`
+	UpdatedAt       time.Time
+	DefaultValue    *ValueDocument
+	TypeProperty    TypePropertyDocument
+	ValidationRules []ValidationRuleDocument `bson:",omitempty"`
+	Expression      string                   `bson:",omitempty"`
+}
+
+type ValidationRuleDocument struct {
+	Type       string
+	Pattern    string `bson:",omitempty"`
+	Count      int    `bson:",omitempty"`
+	Field      string `bson:",omitempty"`
+	Operator   string `bson:",omitempty"`
+	OtherField string `bson:",omitempty"`
+	Message    string `bson:",omitempty"`
 }
 
 type TypePropertyDocument struct {
@@ -107,10 +122,13 @@ func NewSchema(s *schema.Schema) (*SchemaDocument, string) {
 			Unique:      f.Unique(),
 			Multiple:    f.Multiple(),
 			Required:    f.Required(),
+			Localized:   f.Localized(),
 			UpdatedAt:   f.UpdatedAt(),
 			TypeProperty: TypePropertyDocument{
 				Type: string(f.Type()),
 			},
+			ValidationRules: newValidationRules(f.ValidationRules()),
+			Expression:      f.Expression().String(),
 		}
 
 		if len(f.DefaultValue().Values()) > 0 && !f.DefaultValue().First().IsEmpty() {
@@ -207,9 +225,49 @@ func NewSchema(s *schema.Schema) (*SchemaDocument, string) {
 		Project:    s.Project().String(),
 		Fields:     fieldsDoc,
 		TitleField: s.TitleField().StringRef(),
+
+		ValidationRules: newValidationRules(s.ValidationRules()),
 	}, sId
 }
 
+func newValidationRules(rules []*schema.ValidationRule) []ValidationRuleDocument {
+	if len(rules) == 0 {
+		return nil
+	}
+	return lo.Map(rules, func(r *schema.ValidationRule, _ int) ValidationRuleDocument {
+		d := ValidationRuleDocument{
+			Type:     string(r.Type()),
+			Pattern:  r.Pattern(),
+			Count:    r.Count(),
+			Operator: string(r.Operator()),
+			Message:  r.Message(),
+		}
+		if r.Type() == schema.ValidationRuleTypeCompare {
+			d.Field = r.Field().String()
+			d.OtherField = r.OtherField().String()
+		}
+		return d
+	})
+}
+
+func (d ValidationRuleDocument) Model() (*schema.ValidationRule, error) {
+	var fid, oid id.FieldID
+	if d.Type == string(schema.ValidationRuleTypeCompare) {
+		var err error
+		if fid, err = id.FieldIDFrom(d.Field); err != nil {
+			return nil, err
+		}
+		if oid, err = id.FieldIDFrom(d.OtherField); err != nil {
+			return nil, err
+		}
+	}
+	return schema.NewValidationRule(schema.ValidationRuleType(d.Type), d.Pattern, d.Count, fid, schema.CompareOperator(d.Operator), oid, d.Message)
+}
+
+func validationRulesFrom(docs []ValidationRuleDocument) ([]*schema.ValidationRule, error) {
+	return util.TryMap(docs, ValidationRuleDocument.Model)
+}
+
 func (d *SchemaDocument) Model() (*schema.Schema, error) {
 	sId, err := id.SchemaIDFrom(d.ID)
 	if err != nil {
@@ -320,6 +378,18 @@ func (d *SchemaDocument) Model() (*schema.Schema, error) {
 			return nil, err
 		}
 
+		rules, err := validationRulesFrom(fd.ValidationRules)
+		if err != nil {
+			return nil, err
+		}
+
+		var expr *expression.Expr
+		if fd.Expression != "" {
+			if expr, err = expression.Parse(fd.Expression); err != nil {
+				return nil, err
+			}
+		}
+
 		return schema.NewField(tp).
 			ID(fid).
 			Name(fd.Name).
@@ -327,22 +397,31 @@ func (d *SchemaDocument) Model() (*schema.Schema, error) {
 			Multiple(fd.Multiple).
 			Order(fd.Order).
 			Required(fd.Required).
+			Localized(fd.Localized).
 			Description(fd.Description).
 			Key(id.NewKey(fd.Key)).
 			UpdatedAt(fd.UpdatedAt).
 			DefaultValue(fd.DefaultValue.MultipleValue()).
+			ValidationRules(rules).
+			Expression(expr).
 			Build()
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	rules, err := validationRulesFrom(d.ValidationRules)
+	if err != nil {
+		return nil, err
+	}
+
 	return schema.New().
 		ID(sId).
 		Workspace(wId).
 		Project(pId).
 		Fields(f).
 		TitleField(fid).
+		ValidationRules(rules).
 		Build()
 }
 
@@ -3,6 +3,7 @@ package mongodoc
 import (
 	"testing"
 
+	"github.com/reearth/reearth-cms/server/pkg/expression"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
@@ -105,3 +106,56 @@ func TestSchemaDocument_Model(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaDocument_ValidationRules(t *testing.T) {
+	f1 := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Key(id.NewKey("start")).MustBuild()
+	f2 := schema.NewField(schema.NewText(nil).TypeProperty()).
+		NewID().
+		Key(id.NewKey("code")).
+		ValidationRules([]*schema.ValidationRule{lo.Must(schema.NewPatternRule("^[a-z]+$", "lower case"))}).
+		MustBuild()
+	f3 := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Key(id.NewKey("end")).MustBuild()
+	s := schema.New().
+		NewID().
+		Workspace(user.NewWorkspaceID()).
+		Project(project.NewID()).
+		Fields(schema.FieldList{f1, f2, f3}).
+		ValidationRules([]*schema.ValidationRule{lo.Must(schema.NewCompareRule(f3.ID(), schema.CompareOperatorGreaterThan, f1.ID(), ""))}).
+		MustBuild()
+
+	doc, _ := NewSchema(s)
+	assert.Equal(t, []ValidationRuleDocument{{Type: "pattern", Pattern: "^[a-z]+$", Message: "lower case"}}, doc.Fields[1].ValidationRules)
+	assert.Equal(t, []ValidationRuleDocument{{Type: "compare", Field: f3.ID().String(), Operator: "gt", OtherField: f1.ID().String()}}, doc.ValidationRules)
+	assert.Nil(t, doc.Fields[0].ValidationRules)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	r := got.Field(f2.ID()).ValidationRules()[0]
+	assert.Equal(t, "^[a-z]+$", r.Pattern())
+	assert.Equal(t, "lower case", r.Message())
+	assert.Equal(t, s.ValidationRules(), got.ValidationRules())
+}
+
+func TestSchemaDocument_Expression(t *testing.T) {
+	f1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild()
+	f2 := schema.NewField(schema.NewText(nil).TypeProperty()).
+		NewID().
+		Key(id.NewKey("slug")).
+		Expression(expression.MustParse("slug(title)")).
+		MustBuild()
+	s := schema.New().
+		NewID().
+		Workspace(user.NewWorkspaceID()).
+		Project(project.NewID()).
+		Fields(schema.FieldList{f1, f2}).
+		MustBuild()
+
+	doc, _ := NewSchema(s)
+	assert.Equal(t, "", doc.Fields[0].Expression)
+	assert.Equal(t, "slug(title)", doc.Fields[1].Expression)
+
+	got, err := doc.Model()
+	assert.NoError(t, err)
+	assert.False(t, got.Field(f1.ID()).IsComputed())
+	assert.Equal(t, "slug(title)", got.Field(f2.ID()).Expression().String())
+}
@@ -0,0 +1,100 @@
+package mongodoc
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+)
+
+type TrashDocument struct {
+	ID          string
+	Workspace   string
+	Project     string
+	Type        string
+	Item        *string
+	Asset       *string
+	ModelID     *string
+	Name        string
+	User        *string
+	Integration *string
+	DeletedAt   time.Time
+}
+
+type TrashConsumer = mongox.SliceFuncConsumer[*TrashDocument, *trash.Entry]
+
+func NewTrashConsumer() *TrashConsumer {
+	return NewConsumer[*TrashDocument, *trash.Entry]()
+}
+
+func NewTrash(e *trash.Entry) (*TrashDocument, string) {
+	if e == nil {
+		return nil, ""
+	}
+	tid := e.ID().String()
+	return &TrashDocument{
+		ID:          tid,
+		Workspace:   e.Workspace().String(),
+		Project:     e.Project().String(),
+		Type:        string(e.Type()),
+		Item:        e.Item().StringRef(),
+		Asset:       e.Asset().StringRef(),
+		ModelID:     e.Model().StringRef(),
+		Name:        e.Name(),
+		User:        e.User().StringRef(),
+		Integration: e.Integration().StringRef(),
+		DeletedAt:   e.DeletedAt(),
+	}, tid
+}
+
+func (d *TrashDocument) Model() (*trash.Entry, error) {
+	tid, err := id.TrashIDFrom(d.ID)
+	if err != nil {
+		return nil, err
+	}
+	wid, err := accountdomain.WorkspaceIDFrom(d.Workspace)
+	if err != nil {
+		return nil, err
+	}
+	pid, err := id.ProjectIDFrom(d.Project)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := trash.TypeFrom(d.Type)
+	if !ok {
+		return nil, trash.ErrInvalidType
+	}
+
+	b := trash.New().
+		ID(tid).
+		Workspace(wid).
+		Project(pid).
+		Name(d.Name).
+		User(accountdomain.UserIDFromRef(d.User)).
+		Integration(id.IntegrationIDFromRef(d.Integration)).
+		DeletedAt(d.DeletedAt)
+
+	mid := id.ModelIDFromRef(d.ModelID)
+	switch t {
+	case trash.TypeItem:
+		iid := id.ItemIDFromRef(d.Item)
+		if iid == nil || mid == nil {
+			return nil, trash.ErrInvalidID
+		}
+		b = b.Item(*iid, *mid)
+	case trash.TypeAsset:
+		aid := id.AssetIDFromRef(d.Asset)
+		if aid == nil {
+			return nil, trash.ErrInvalidID
+		}
+		b = b.Asset(*aid)
+	case trash.TypeModel:
+		if mid == nil {
+			return nil, trash.ErrInvalidID
+		}
+		b = b.Model(*mid)
+	}
+	return b.Build()
+}
@@ -0,0 +1,42 @@
+package mongodoc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrash(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	uid := accountdomain.NewUserID()
+	iid := id.NewIntegrationID()
+	wid := accountdomain.NewWorkspaceID()
+	pid := id.NewProjectID()
+
+	entries := []*trash.Entry{
+		trash.New().NewID().Workspace(wid).Project(pid).Item(id.NewItemID(), id.NewModelID()).User(&uid).DeletedAt(now).MustBuild(),
+		trash.New().NewID().Workspace(wid).Project(pid).Asset(id.NewAssetID()).Name("a.png").Integration(&iid).DeletedAt(now).MustBuild(),
+		trash.New().NewID().Workspace(wid).Project(pid).Model(id.NewModelID()).Name("model").User(&uid).DeletedAt(now).MustBuild(),
+	}
+
+	for _, e := range entries {
+		doc, tid := NewTrash(e)
+		assert.Equal(t, e.ID().String(), tid)
+		assert.Equal(t, string(e.Type()), doc.Type)
+
+		got, err := doc.Model()
+		assert.NoError(t, err)
+		assert.Equal(t, e, got)
+	}
+
+	doc, tid := NewTrash(nil)
+	assert.Nil(t, doc)
+	assert.Empty(t, tid)
+
+	_, err := (&TrashDocument{ID: id.NewTrashID().String(), Workspace: wid.String(), Project: pid.String(), Type: "item"}).Model()
+	assert.ErrorIs(t, err, trash.ErrInvalidID)
+}
@@ -30,7 +30,11 @@ func (c *Collection) Client() *mongox.Collection {
 }
 
 func (c *Collection) FindOne(ctx context.Context, filter any, q version.Query, consumer mongox.Consumer) error {
-	return c.client.FindOne(ctx, apply(q, filter), consumer)
+	f, err := c.filter(ctx, q, filter)
+	if err != nil {
+		return err
+	}
+	return c.client.FindOne(ctx, f, consumer)
 }
 
 func (c *Collection) Find(ctx context.Context, filter any, q version.Query, consumer mongox.Consumer) error {
@@ -38,7 +42,11 @@ func (c *Collection) Find(ctx context.Context, filter any, q version.Query, cons
 		Locale:   "simple",
 		Strength: 1,
 	})
-	return c.client.Find(ctx, apply(q, filter), consumer, opt)
+	f, err := c.filter(ctx, q, filter)
+	if err != nil {
+		return err
+	}
+	return c.client.Find(ctx, f, consumer, opt)
 }
 
 func (c *Collection) Aggregate(ctx context.Context, pipeline []any, q version.Query, consumer mongox.Consumer) error {
@@ -46,15 +54,27 @@ func (c *Collection) Aggregate(ctx context.Context, pipeline []any, q version.Qu
 		Locale:   "simple",
 		Strength: 1,
 	})
-	return c.client.Aggregate(ctx, applyToPipeline(q, pipeline), consumer, opt)
+	p, err := c.pipeline(ctx, q, pipeline)
+	if err != nil {
+		return err
+	}
+	return c.client.Aggregate(ctx, p, consumer, opt)
 }
 
 func (c *Collection) Paginate(ctx context.Context, filter any, q version.Query, s *usecasex.Sort, p *usecasex.Pagination, consumer mongox.Consumer) (*usecasex.PageInfo, error) {
-	return c.client.Paginate(ctx, apply(q, filter), s, p, consumer)
+	f, err := c.filter(ctx, q, filter)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.Paginate(ctx, f, s, p, consumer)
 }
 
 func (c *Collection) Count(ctx context.Context, filter any, q version.Query) (int64, error) {
-	return c.client.Count(ctx, apply(q, filter))
+	f, err := c.filter(ctx, q, filter)
+	if err != nil {
+		return 0, err
+	}
+	return c.client.Count(ctx, f)
 }
 
 func (c *Collection) PaginateAggregation(ctx context.Context, pipeline []any, q version.Query, s *usecasex.Sort, p *usecasex.Pagination, consumer mongox.Consumer) (*usecasex.PageInfo, error) {
@@ -62,11 +82,61 @@ func (c *Collection) PaginateAggregation(ctx context.Context, pipeline []any, q
 		Locale:   "simple",
 		Strength: 1,
 	})
-	return c.client.PaginateAggregation(ctx, applyToPipeline(q, pipeline), s, p, consumer, opt)
+	pl, err := c.pipeline(ctx, q, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.PaginateAggregation(ctx, pl, s, p, consumer, opt)
 }
 
 func (c *Collection) CountAggregation(ctx context.Context, pipeline []any, q version.Query) (int64, error) {
-	return c.client.CountAggregation(ctx, applyToPipeline(q, pipeline))
+	p, err := c.pipeline(ctx, q, pipeline)
+	if err != nil {
+		return 0, err
+	}
+	return c.client.CountAggregation(ctx, p)
+}
+
+// filter applies the query to the filter.
+// Overlay queries need the ids of the documents which the ref points because a filter can not refer to other documents.
+func (c *Collection) filter(ctx context.Context, q version.Query, filter any) (res any, err error) {
+	res = apply(q, filter)
+	q.Match(version.QueryMatch{
+		Overlay: func(ref, base version.Ref) {
+			var ids []string
+			if ids, err = c.idsByRef(ctx, ref); err == nil {
+				res = applyOverlay(ref, base, ids, filter)
+			}
+		},
+	})
+	return
+}
+
+func (c *Collection) pipeline(ctx context.Context, q version.Query, pipeline []any) (res []any, err error) {
+	res = applyToPipeline(q, pipeline)
+	q.Match(version.QueryMatch{
+		Overlay: func(ref, base version.Ref) {
+			var ids []string
+			if ids, err = c.idsByRef(ctx, ref); err == nil {
+				res = applyOverlayToPipeline(ref, base, ids, pipeline)
+			}
+		},
+	})
+	return
+}
+
+func (c *Collection) idsByRef(ctx context.Context, ref version.Ref) ([]string, error) {
+	res, err := c.client.Client().Distinct(ctx, "id", bson.M{
+		refsKey: bson.M{"$in": []string{ref.String()}},
+		metaKey: bson.M{"$exists": false},
+	})
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return lo.FilterMap(res, func(v any, _ int) (string, bool) {
+		s, ok := v.(string)
+		return s, ok
+	}), nil
 }
 
 func (c *Collection) SaveOne(ctx context.Context, id string, d any, parent *version.VersionOrRef) error {
@@ -194,6 +264,36 @@ func (c *Collection) SaveAll(ctx context.Context, ids []string, docs []any, pare
 	return nil
 }
 
+// ReplaceOne overwrites the document of the version without creating a new version. Its parents and refs are kept.
+func (c *Collection) ReplaceOne(ctx context.Context, id string, v version.Version, d any) error {
+	q := bson.M{
+		"id":    id,
+		metaKey: true,
+	}
+	if archived, err := c.IsArchived(ctx, q); err != nil {
+		return err
+	} else if archived {
+		return version.ErrArchived
+	}
+
+	meta, err := c.meta(ctx, id, v.OrRef().Ref())
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.client.Client().ReplaceOne(ctx, bson.M{
+		"id":       id,
+		versionKey: v,
+	}, &Document[any]{
+		Data: d,
+		Meta: *meta,
+	}); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+
+	return nil
+}
+
 func (c *Collection) DeleteRef(ctx context.Context, ids []string, ref version.Ref) error {
 	if _, err := c.client.Client().UpdateMany(ctx, bson.M{
 		"id":    bson.M{"$in": ids},
@@ -207,6 +307,10 @@ func (c *Collection) DeleteRef(ctx context.Context, ids []string, ref version.Re
 }
 
 func (c *Collection) UpdateRef(ctx context.Context, id string, ref version.Ref, dest *version.VersionOrRef) error {
+	if ref.IsRecorded() {
+		return c.updateRecordedRef(ctx, id, ref, dest)
+	}
+
 	if err := c.DeleteRef(ctx, []string{id}, ref); err != nil {
 		return err
 	}
@@ -224,6 +328,50 @@ func (c *Collection) UpdateRef(ctx context.Context, id string, ref version.Ref,
 	return nil
 }
 
+// updateRecordedRef moves the ref and records the periods in which the ref pointed the versions to read them at a point in time.
+func (c *Collection) updateRecordedRef(ctx context.Context, id string, ref version.Ref, dest *version.VersionOrRef) error {
+	prev, err := c.meta(ctx, id, ref.OrVersion().Ref())
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+	var next *Meta
+	if dest != nil {
+		if next, err = c.meta(ctx, id, dest); err != nil && !errors.Is(err, rerror.ErrNotFound) {
+			return err
+		}
+	}
+	if prev != nil && next != nil && prev.Version == next.Version {
+		return nil
+	}
+
+	now := util.Now()
+	if prev != nil {
+		if _, err := c.client.Client().UpdateOne(ctx, bson.M{
+			"id":       id,
+			versionKey: prev.Version,
+		}, bson.M{
+			"$pull": bson.M{refsKey: ref},
+			"$set":  bson.M{historyKey: prev.endPeriod(ref, now)},
+		}); err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+	}
+	if next != nil {
+		if _, err := c.client.Client().UpdateOne(ctx, bson.M{
+			"id":       id,
+			versionKey: next.Version,
+		}, bson.M{
+			"$push": bson.M{
+				refsKey:    ref,
+				historyKey: Period{Ref: ref, From: now},
+			},
+		}); err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+	}
+	return nil
+}
+
 func (c *Collection) IsArchived(ctx context.Context, filter any) (bool, error) {
 	cons := mongox.SliceConsumer[MetadataDocument]{}
 	q := mongox.And(filter, "", bson.M{
@@ -314,6 +462,10 @@ func (c *Collection) Indexes() []mongox.Index {
 			Name: "mongogit_meta_refs",
 			Key:  bson.D{{Key: metaKey, Value: 1}, {Key: refsKey, Value: 1}},
 		},
+		{
+			Name: "mongogit_history",
+			Key:  bson.D{{Key: historyKey + ".ref", Value: 1}, {Key: historyKey + ".from", Value: 1}},
+		},
 	}
 }
 
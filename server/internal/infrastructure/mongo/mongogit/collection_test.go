@@ -2,6 +2,7 @@ package mongogit
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/reearth/reearthx/mongox/mongotest"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
@@ -145,6 +147,48 @@ func TestCollection_Find(t *testing.T) {
 	assert.Empty(t, consumer4.Result)
 }
 
+func TestCollection_FindOverlay(t *testing.T) {
+	ctx := context.Background()
+	col := initCollection(t)
+	c := col.Client().Client()
+
+	type d struct {
+		ID string
+		A  string
+	}
+
+	_, _ = c.InsertMany(ctx, []any{
+		&Document[bson.M]{
+			Data: bson.M{"id": "x", "a": "public"},
+			Meta: Meta{Version: version.New(), Refs: []version.Ref{"public"}},
+		},
+		&Document[bson.M]{
+			Data: bson.M{"id": "x", "a": "release"},
+			Meta: Meta{Version: version.New(), Refs: []version.Ref{"latest", "release"}},
+		},
+		&Document[bson.M]{
+			Data: bson.M{"id": "y", "a": "public"},
+			Meta: Meta{Version: version.New(), Refs: []version.Ref{"latest", "public"}},
+		},
+		&Document[bson.M]{
+			Data: bson.M{"id": "z", "a": "release"},
+			Meta: Meta{Version: version.New(), Refs: []version.Ref{"latest", "release"}},
+		},
+	})
+
+	consumer := &mongox.SliceConsumer[d]{}
+	assert.NoError(t, col.Find(ctx, bson.M{}, version.Overlay("release", version.Public), consumer))
+	assert.Equal(t, []d{{ID: "x", A: "release"}, {ID: "y", A: "public"}, {ID: "z", A: "release"}}, consumer.Result)
+
+	got, err := col.Count(ctx, bson.M{}, version.Overlay("release", version.Public))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), got)
+
+	consumer2 := &mongox.SliceConsumer[d]{}
+	assert.NoError(t, col.Aggregate(ctx, []any{bson.M{"$sort": bson.M{"id": -1}}}, version.Overlay("release", version.Public), consumer2))
+	assert.Equal(t, []d{{ID: "z", A: "release"}, {ID: "y", A: "public"}, {ID: "x", A: "release"}}, consumer2.Result)
+}
+
 func TestCollection_Count(t *testing.T) {
 	ctx := context.Background()
 	col := initCollection(t)
@@ -658,6 +702,111 @@ func TestCollection_UpdateRef(t *testing.T) {
 	assert.Equal(t, Meta{ObjectID: meta.ObjectID, Version: v3, Refs: []version.Ref{}}, meta)
 }
 
+func TestCollection_UpdateRecordedRef(t *testing.T) {
+	ctx := context.Background()
+	col := initCollection(t)
+	c := col.Client().Client()
+
+	type d struct {
+		ID string
+		A  string
+	}
+
+	t0 := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	t1, t2, t3 := t0.Add(time.Hour), t0.Add(2*time.Hour), t0.Add(3*time.Hour)
+	v1, v2 := version.New(), version.New()
+	// v1 has been public since before the moves were recorded
+	_, _ = c.InsertMany(ctx, []any{
+		&Document[bson.M]{
+			Data: bson.M{"id": "x", "a": "a"},
+			Meta: Meta{ObjectID: primitive.NewObjectIDFromTimestamp(t0), Version: v1, Refs: []version.Ref{"public"}},
+		},
+		&Document[bson.M]{
+			Data: bson.M{"id": "x", "a": "b"},
+			Meta: Meta{ObjectID: primitive.NewObjectIDFromTimestamp(t1), Version: v2, Parents: []version.Version{v1}, Refs: []version.Ref{"latest"}},
+		},
+	})
+
+	find := func(at time.Time) []d {
+		consumer := &mongox.SliceConsumer[d]{}
+		err := col.Find(ctx, bson.M{}, version.At(version.Public, at), consumer)
+		if errors.Is(err, rerror.ErrNotFound) {
+			return nil
+		}
+		assert.NoError(t, err)
+		return consumer.Result
+	}
+	assert.Nil(t, find(t0.Add(-time.Second)))
+	assert.Equal(t, []d{{ID: "x", A: "a"}}, find(t0))
+
+	// move public to v2 at t2
+	unmock := util.MockNow(t2)
+	assert.NoError(t, col.UpdateRef(ctx, "x", version.Public, v2.OrRef().Ref()))
+	unmock()
+	var meta Meta
+	assert.NoError(t, c.FindOne(ctx, bson.M{"id": "x", versionKey: v1}).Decode(&meta))
+	assert.Empty(t, meta.Refs)
+	assert.Len(t, meta.History, 1)
+	assert.True(t, t0.Equal(meta.History[0].From))
+	assert.True(t, t2.Equal(*meta.History[0].To))
+	assert.NoError(t, c.FindOne(ctx, bson.M{"id": "x", versionKey: v2}).Decode(&meta))
+	assert.Equal(t, []version.Ref{"latest", "public"}, meta.Refs)
+	assert.Len(t, meta.History, 1)
+	assert.True(t, t2.Equal(meta.History[0].From))
+	assert.Nil(t, meta.History[0].To)
+
+	// unpublish at t3
+	unmock = util.MockNow(t3)
+	assert.NoError(t, col.UpdateRef(ctx, "x", version.Public, nil))
+	unmock()
+
+	assert.Nil(t, find(t0.Add(-time.Second)))
+	assert.Equal(t, []d{{ID: "x", A: "a"}}, find(t0))
+	assert.Equal(t, []d{{ID: "x", A: "a"}}, find(t2.Add(-time.Second)))
+	assert.Equal(t, []d{{ID: "x", A: "b"}}, find(t2))
+	assert.Nil(t, find(t3))
+
+	consumer := &mongox.SliceConsumer[d]{}
+	assert.NoError(t, col.Aggregate(ctx, []any{}, version.At(version.Public, t2), consumer))
+	assert.Equal(t, []d{{ID: "x", A: "b"}}, consumer.Result)
+}
+
+func TestCollection_ReplaceOne(t *testing.T) {
+	ctx := context.Background()
+	col := initCollection(t)
+	c := col.Client().Client()
+
+	type Data struct {
+		ID string
+		A  string
+	}
+
+	v1, v2 := version.New(), version.New()
+	_, _ = c.InsertMany(ctx, []any{
+		bson.M{"id": "x", "a": "aaa", versionKey: v1, refsKey: []string{"public"}},
+		bson.M{"id": "x", "a": "bbb", versionKey: v2, parentsKey: []version.Version{v1}, refsKey: []string{"latest"}},
+	})
+
+	assert.NoError(t, col.ReplaceOne(ctx, "x", v1, Data{ID: "x", A: "ccc"}))
+
+	var meta Meta
+	var data Data
+	got := c.FindOne(ctx, bson.M{"id": "x", versionKey: v1})
+	assert.NoError(t, got.Decode(&meta))
+	assert.NoError(t, got.Decode(&data))
+	assert.Equal(t, Meta{ObjectID: meta.ObjectID, Version: v1, Refs: []version.Ref{version.Public}}, meta)
+	assert.Equal(t, Data{ID: "x", A: "ccc"}, data)
+
+	got = c.FindOne(ctx, bson.M{"id": "x", versionKey: v2})
+	assert.NoError(t, got.Decode(&meta))
+	assert.NoError(t, got.Decode(&data))
+	assert.Equal(t, Meta{ObjectID: meta.ObjectID, Version: v2, Parents: []version.Version{v1}, Refs: []version.Ref{version.Latest}}, meta)
+	assert.Equal(t, Data{ID: "x", A: "bbb"}, data)
+
+	// nonexistent version
+	assert.Equal(t, rerror.ErrNotFound, col.ReplaceOne(ctx, "x", version.New(), Data{}))
+}
+
 func TestCollection_IsArchived(t *testing.T) {
 	ctx := context.Background()
 	col := initCollection(t)
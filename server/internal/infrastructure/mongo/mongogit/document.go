@@ -1,6 +1,7 @@
 package mongogit
 
 import (
+	"slices"
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/version"
@@ -14,6 +15,7 @@ const (
 	versionKey = "__v"
 	parentsKey = "__w"
 	refsKey    = "__r"
+	historyKey = "__h"
 	metaKey    = "__"
 )
 
@@ -76,12 +78,33 @@ type Meta struct {
 	Version  version.Version    `json:"__v,omitempty" bson:"__v,omitempty"`
 	Parents  []version.Version  `json:"__w,omitempty" bson:"__w,omitempty"`
 	Refs     []version.Ref      `json:"__r,omitempty" bson:"__r,omitempty"`
+	History  []Period           `json:"__h,omitempty" bson:"__h,omitempty"`
+}
+
+// Period is a period in which a recorded ref pointed the version.
+type Period struct {
+	Ref  version.Ref `json:"ref" bson:"ref"`
+	From time.Time   `json:"from" bson:"from"`
+	To   *time.Time  `json:"to,omitempty" bson:"to,omitempty"`
 }
 
 func (m Meta) Timestamp() time.Time {
 	return m.ObjectID.Timestamp()
 }
 
+// endPeriod returns the history in which the period of the ref ends at the time.
+func (m Meta) endPeriod(ref version.Ref, t time.Time) []Period {
+	res := slices.Clone(m.History)
+	for i, p := range res {
+		if p.Ref == ref && p.To == nil {
+			res[i].To = &t
+			return res
+		}
+	}
+	// the ref has pointed the version since before its moves were recorded
+	return append(res, Period{Ref: ref, From: m.Timestamp(), To: &t})
+}
+
 func ToValue[T any](m Meta, inner T) *version.Value[T] {
 	var parents version.Versions
 	var refs version.Refs
@@ -116,12 +139,16 @@ func (meta Meta) apply(d any) (any, error) {
 		m = mongox.AppendE(m, bson.E{Key: "_id", Value: meta.ObjectID})
 	}
 
-	return mongox.AppendE(
+	m = mongox.AppendE(
 		m,
 		bson.E{Key: versionKey, Value: meta.Version},
 		bson.E{Key: parentsKey, Value: meta.Parents},
 		bson.E{Key: refsKey, Value: meta.Refs},
-	), nil
+	)
+	if len(meta.History) > 0 {
+		m = mongox.AppendE(m, bson.E{Key: historyKey, Value: meta.History})
+	}
+	return m, nil
 }
 
 type MetadataDocument struct {
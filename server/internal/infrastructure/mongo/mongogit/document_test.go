@@ -2,6 +2,7 @@ package mongogit
 
 import (
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/samber/lo"
@@ -104,3 +105,28 @@ func TestMeta_Apply(t *testing.T) {
 		{Key: refsKey, Value: []version.Ref{version.Latest}},
 	}, got)
 }
+
+func TestMeta_EndPeriod(t *testing.T) {
+	t0 := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	t1, t2 := t0.Add(time.Hour), t0.Add(2*time.Hour)
+
+	// the ref has pointed the version since before its moves were recorded
+	m := Meta{ObjectID: primitive.NewObjectIDFromTimestamp(t0)}
+	got := m.endPeriod(version.Public, t2)
+	assert.Equal(t, []Period{{Ref: version.Public, From: m.Timestamp(), To: &t2}}, got)
+	assert.Nil(t, m.History)
+
+	m = Meta{
+		ObjectID: primitive.NewObjectIDFromTimestamp(t0),
+		History: []Period{
+			{Ref: version.Public, From: t0, To: &t1},
+			{Ref: version.Public, From: t1},
+		},
+	}
+	got = m.endPeriod(version.Public, t2)
+	assert.Equal(t, []Period{
+		{Ref: version.Public, From: t0, To: &t1},
+		{Ref: version.Public, From: t1, To: &t2},
+	}, got)
+	assert.Nil(t, m.History[1].To)
+}
@@ -1,9 +1,13 @@
 package mongogit
 
 import (
+	"encoding/binary"
+	"time"
+
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/mongox"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func apply(q version.Query, f any) (res any) {
@@ -23,6 +27,9 @@ func apply(q version.Query, f any) (res any) {
 				},
 			))
 		},
+		At: func(r version.Ref, t time.Time) {
+			res = mongox.And(f, "", atFilter(r, t))
+		},
 	})
 	return
 }
@@ -64,6 +71,18 @@ func applyToPipeline(q version.Query, pipeline []any) (res []any) {
 				pipeline...,
 			)
 		},
+		At: func(r version.Ref, t time.Time) {
+			res = append(
+				[]any{
+					bson.M{
+						"$match": mongox.And(bson.M{
+							metaKey: bson.M{"$exists": false},
+						}, "", atFilter(r, t)),
+					},
+				},
+				pipeline...,
+			)
+		},
 	})
 	return
 }
@@ -71,3 +90,63 @@ func applyToPipeline(q version.Query, pipeline []any) (res []any) {
 func excludeMetadata(f any) any {
 	return mongox.And(f, metaKey, bson.M{"$exists": false})
 }
+
+// applyOverlay matches the documents pointed by the ref, and the documents pointed by the base ref except for the excluded ids which the ref points.
+func applyOverlay(ref, base version.Ref, excluded []string, f any) any {
+	return mongox.And(excludeMetadata(f), "", overlayFilter(ref, base, excluded))
+}
+
+func applyOverlayToPipeline(ref, base version.Ref, excluded []string, pipeline []any) []any {
+	return append(
+		[]any{
+			bson.M{
+				"$match": mongox.And(bson.M{
+					metaKey: bson.M{"$exists": false},
+				}, "", overlayFilter(ref, base, excluded)),
+			},
+		},
+		pipeline...,
+	)
+}
+
+func overlayFilter(ref, base version.Ref, excluded []string) bson.M {
+	return bson.M{
+		"$or": []any{
+			bson.M{refsKey: bson.M{"$in": []string{ref.String()}}},
+			bson.M{
+				refsKey: bson.M{"$in": []string{base.String()}},
+				"id":    bson.M{"$nin": excluded},
+			},
+		},
+	}
+}
+
+// atFilter matches the versions which the recorded ref pointed at the time.
+// Versions which the ref has pointed since before its moves were recorded are regarded as pointed since they were created.
+func atFilter(ref version.Ref, t time.Time) bson.M {
+	return bson.M{
+		"$or": []any{
+			bson.M{
+				historyKey: bson.M{
+					"$elemMatch": bson.M{
+						"ref":  ref.String(),
+						"from": bson.M{"$lte": t},
+						"$or":  []any{bson.M{"to": nil}, bson.M{"to": bson.M{"$gt": t}}},
+					},
+				},
+			},
+			bson.M{
+				refsKey:             bson.M{"$in": []string{ref.String()}},
+				historyKey + ".ref": bson.M{"$ne": ref.String()},
+				// object ids only have seconds, so versions created within the second are included
+				"_id": bson.M{"$lt": minObjectID(t.Add(time.Second))},
+			},
+		},
+	}
+}
+
+// minObjectID returns the smallest object id created at the second of the time.
+func minObjectID(t time.Time) (res primitive.ObjectID) {
+	binary.BigEndian.PutUint32(res[0:4], uint32(t.Unix()))
+	return
+}
@@ -1,11 +1,14 @@
 package mongogit
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/stretchr/testify/assert"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 func TestQuery_Apply(t *testing.T) {
@@ -75,3 +78,80 @@ func TestQuery_ApplyToPipeline(t *testing.T) {
 		applyToPipeline(version.Eq(version.Latest.OrVersion()), []any{bson.M{"a": "b"}}),
 	)
 }
+
+func TestQuery_ApplyAt(t *testing.T) {
+	now := time.Date(2024, 4, 1, 0, 0, 0, 500, time.UTC)
+	at := bson.M{"$or": []any{
+		bson.M{historyKey: bson.M{"$elemMatch": bson.M{
+			"ref":  "public",
+			"from": bson.M{"$lte": now},
+			"$or":  []any{bson.M{"to": nil}, bson.M{"to": bson.M{"$gt": now}}},
+		}}},
+		bson.M{
+			refsKey:             bson.M{"$in": []string{"public"}},
+			historyKey + ".ref": bson.M{"$ne": "public"},
+			"_id":               bson.M{"$lt": minObjectID(now.Add(time.Second))},
+		},
+	}}
+	assert.Equal(t, at, atFilter(version.Public, now))
+
+	assert.Equal(
+		t,
+		bson.M{"$and": []any{
+			bson.M{
+				"a":     "b",
+				metaKey: bson.M{"$exists": false},
+			},
+			at,
+		}},
+		apply(version.At(version.Public, now), bson.M{"a": "b"}),
+	)
+	assert.Equal(
+		t,
+		[]any{
+			bson.M{"$match": bson.M{"$and": []any{
+				bson.M{metaKey: bson.M{"$exists": false}},
+				at,
+			}}},
+			bson.M{"a": "b"},
+		},
+		applyToPipeline(version.At(version.Public, now), []any{bson.M{"a": "b"}}),
+	)
+}
+
+func TestMinObjectID(t *testing.T) {
+	now := time.Date(2024, 4, 1, 0, 0, 0, 500, time.UTC)
+	got := minObjectID(now)
+	assert.True(t, now.Truncate(time.Second).Equal(got.Timestamp()))
+	oid := primitive.NewObjectIDFromTimestamp(now)
+	assert.LessOrEqual(t, bytes.Compare(got[:], oid[:]), 0)
+}
+
+func TestQuery_ApplyOverlay(t *testing.T) {
+	or := bson.M{"$or": []any{
+		bson.M{refsKey: bson.M{"$in": []string{"release"}}},
+		bson.M{refsKey: bson.M{"$in": []string{"public"}}, "id": bson.M{"$nin": []string{"x"}}},
+	}}
+	assert.Equal(
+		t,
+		bson.M{"$and": []any{
+			bson.M{
+				"a":     "b",
+				metaKey: bson.M{"$exists": false},
+			},
+			or,
+		}},
+		applyOverlay("release", version.Public, []string{"x"}, bson.M{"a": "b"}),
+	)
+	assert.Equal(
+		t,
+		[]any{
+			bson.M{"$match": bson.M{"$and": []any{
+				bson.M{metaKey: bson.M{"$exists": false}},
+				or,
+			}}},
+			bson.M{"a": "b"},
+		},
+		applyOverlayToPipeline("release", version.Public, []string{"x"}, []any{bson.M{"a": "b"}}),
+	)
+}
@@ -0,0 +1,139 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	notificationIndexes                 = []string{"user,read"}
+	notificationUniqueIndexes           = []string{"id"}
+	notificationPreferenceUniqueIndexes = []string{"id"}
+)
+
+type Notification struct {
+	client *mongox.Collection
+}
+
+func NewNotification(client *mongox.Client) repo.Notification {
+	return &Notification{client: client.WithCollection("notification")}
+}
+
+func (r *Notification) Init() error {
+	return createIndexes(context.Background(), r.client, notificationIndexes, notificationUniqueIndexes)
+}
+
+func (r *Notification) FindByID(ctx context.Context, nid id.NotificationID) (*notification.Notification, error) {
+	return r.findOne(ctx, bson.M{"id": nid.String()})
+}
+
+func (r *Notification) FindByIDs(ctx context.Context, ids id.NotificationIDList) (notification.List, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	res, err := r.find(ctx, bson.M{"id": bson.M{"$in": ids.Strings()}})
+	if err != nil {
+		return nil, err
+	}
+	return res.SortByNewest(), nil
+}
+
+func (r *Notification) FindByUser(ctx context.Context, uid accountdomain.UserID, filter repo.NotificationFilter, pagination *usecasex.Pagination) (notification.List, *usecasex.PageInfo, error) {
+	f := bson.M{"user": uid.String()}
+	if filter.UnreadOnly {
+		f["read"] = false
+	}
+
+	c := mongodoc.NewNotificationConsumer()
+	// IDs are ordered by the creation time
+	pageInfo, err := r.client.Paginate(ctx, f, &usecasex.Sort{Key: "id", Reverted: true}, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *Notification) CountUnread(ctx context.Context, uid accountdomain.UserID) (int64, error) {
+	c, err := r.client.Count(ctx, bson.M{"user": uid.String(), "read": false})
+	if err != nil {
+		return 0, rerror.ErrInternalBy(err)
+	}
+	return c, nil
+}
+
+func (r *Notification) Save(ctx context.Context, n *notification.Notification) error {
+	doc, nid := mongodoc.NewNotification(n)
+	return r.client.SaveOne(ctx, nid, doc)
+}
+
+func (r *Notification) SaveAll(ctx context.Context, l notification.List) error {
+	if len(l) == 0 {
+		return nil
+	}
+	docs, ids := mongodoc.NewNotifications(l)
+	return r.client.SaveAll(ctx, ids, docs)
+}
+
+func (r *Notification) MarkAllRead(ctx context.Context, uid accountdomain.UserID, t time.Time) error {
+	err := r.client.UpdateMany(ctx, bson.M{"user": uid.String(), "read": false}, bson.M{"read": true, "readat": t})
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
+
+func (r *Notification) find(ctx context.Context, filter any) (notification.List, error) {
+	c := mongodoc.NewNotificationConsumer()
+	if err := r.client.Find(ctx, filter, c); err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, nil
+}
+
+func (r *Notification) findOne(ctx context.Context, filter any) (*notification.Notification, error) {
+	c := mongodoc.NewNotificationConsumer()
+	if err := r.client.FindOne(ctx, filter, c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+type NotificationPreference struct {
+	client *mongox.Collection
+}
+
+func NewNotificationPreference(client *mongox.Client) repo.NotificationPreference {
+	return &NotificationPreference{client: client.WithCollection("notificationpreference")}
+}
+
+func (r *NotificationPreference) Init() error {
+	return createIndexes(context.Background(), r.client, nil, notificationPreferenceUniqueIndexes)
+}
+
+func (r *NotificationPreference) FindByUsers(ctx context.Context, ids accountdomain.UserIDList) ([]*notification.Preference, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	c := mongodoc.NewNotificationPreferenceConsumer()
+	if err := r.client.Find(ctx, bson.M{"id": bson.M{"$in": ids.Strings()}}, c); err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, nil
+}
+
+func (r *NotificationPreference) Save(ctx context.Context, p *notification.Preference) error {
+	doc, uid := mongodoc.NewNotificationPreference(p)
+	return r.client.SaveOne(ctx, uid, doc)
+}
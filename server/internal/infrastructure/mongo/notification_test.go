@@ -0,0 +1,76 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotification_FindByUser(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	newNotification := func(u accountdomain.UserID) *notification.Notification {
+		return notification.New().NewID().User(u).Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+			Request(id.NewRequestID()).Type(notification.TypeReviewRequested).MustBuild()
+	}
+	n1 := newNotification(uid)
+	time.Sleep(time.Millisecond)
+	n2 := newNotification(uid)
+	n3 := newNotification(accountdomain.NewUserID())
+	n1.MarkRead(time.Now().Truncate(time.Millisecond))
+
+	initDB := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(initDB(t))
+	r := NewNotification(client)
+	ctx := context.Background()
+	assert.NoError(t, r.(*Notification).Init())
+	assert.NoError(t, r.SaveAll(ctx, notification.List{n1, n2, n3}))
+
+	got, pi, err := r.FindByUser(ctx, uid, repo.NotificationFilter{}, usecasex.CursorPagination{First: lo.ToPtr(int64(10))}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n2, n1}, got)
+	assert.Equal(t, int64(2), pi.TotalCount)
+
+	got, _, err = r.FindByUser(ctx, uid, repo.NotificationFilter{UnreadOnly: true}, usecasex.CursorPagination{First: lo.ToPtr(int64(10))}.Wrap())
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n2}, got)
+
+	c, err := r.CountUnread(ctx, uid)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), c)
+
+	got2, err := r.FindByID(ctx, n3.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, n3, got2)
+
+	assert.NoError(t, r.MarkAllRead(ctx, uid, time.Now()))
+	c, err = r.CountUnread(ctx, uid)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), c)
+}
+
+func TestNotificationPreference_FindByUsers(t *testing.T) {
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	p := notification.NewPreference(u1)
+	assert.NoError(t, p.SetSetting(notification.TypeRequestComment, notification.Setting{InApp: true}))
+
+	initDB := mongotest.Connect(t)
+	client := mongox.NewClientWithDatabase(initDB(t))
+	r := NewNotificationPreference(client)
+	ctx := context.Background()
+	assert.NoError(t, r.Save(ctx, p))
+
+	got, err := r.FindByUsers(ctx, accountdomain.UserIDList{u1, u2})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, p.Settings(), got[0].Settings())
+}
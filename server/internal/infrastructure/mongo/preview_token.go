@@ -0,0 +1,74 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	previewTokenIndexes       = []string{"project,!id"}
+	previewTokenUniqueIndexes = []string{"id"}
+)
+
+type PreviewToken struct {
+	client *mongox.Collection
+	f      repo.ProjectFilter
+}
+
+func NewPreviewToken(client *mongox.Client) repo.PreviewToken {
+	return &PreviewToken{client: client.WithCollection("preview_token")}
+}
+
+func (r *PreviewToken) Init() error {
+	return createIndexes(context.Background(), r.client, previewTokenIndexes, previewTokenUniqueIndexes)
+}
+
+func (r *PreviewToken) Filtered(f repo.ProjectFilter) repo.PreviewToken {
+	return &PreviewToken{
+		client: r.client,
+		f:      r.f.Merge(f),
+	}
+}
+
+func (r *PreviewToken) FindByID(ctx context.Context, tid id.PreviewTokenID) (*preview.Token, error) {
+	c := mongodoc.NewPreviewTokenConsumer()
+	if err := r.client.FindOne(ctx, r.readFilter(bson.M{"id": tid.String()}), c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *PreviewToken) FindByProject(ctx context.Context, pid id.ProjectID, pagination *usecasex.Pagination) (preview.List, *usecasex.PageInfo, error) {
+	if !r.f.CanRead(pid) {
+		return nil, usecasex.EmptyPageInfo(), nil
+	}
+
+	c := mongodoc.NewPreviewTokenConsumer()
+	pageInfo, err := r.client.Paginate(ctx, r.readFilter(bson.M{
+		"project": pid.String(),
+	}), &usecasex.Sort{Key: "id", Reverted: true}, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *PreviewToken) Save(ctx context.Context, t *preview.Token) error {
+	if !r.f.CanWrite(t.Project()) {
+		return repo.ErrOperationDenied
+	}
+	doc, tid := mongodoc.NewPreviewToken(t)
+	return r.client.SaveOne(ctx, tid, doc)
+}
+
+func (r *PreviewToken) readFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Readable)
+}
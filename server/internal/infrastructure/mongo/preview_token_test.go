@@ -0,0 +1,46 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewToken(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	pid := id.NewProjectID()
+	exp := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	t1 := preview.New().NewID().Workspace(wid).Project(pid).ExpiresAt(exp).MustBuild()
+	t2 := preview.New().NewID().Workspace(wid).Project(pid).Request(id.NewRequestID().Ref()).ExpiresAt(exp).MustBuild()
+
+	client := mongox.NewClientWithDatabase(mongotest.Connect(t)(t))
+	r := NewPreviewToken(client)
+	assert.NoError(t, r.(*PreviewToken).Init())
+	assert.NoError(t, r.Save(ctx, t1))
+	assert.NoError(t, r.Save(ctx, t2))
+
+	got, err := r.FindByID(ctx, t2.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, t2, got)
+
+	list, _, err := r.FindByProject(ctx, pid, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.PreviewTokenID{t2.ID(), t1.ID()}, lo.Map(list, func(v *preview.Token, _ int) id.PreviewTokenID { return v.ID() }))
+
+	// filtered
+	fr := r.Filtered(repo.ProjectFilter{Readable: id.ProjectIDList{}, Writable: id.ProjectIDList{}})
+	_, err = fr.FindByID(ctx, t1.ID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+	assert.Equal(t, repo.ErrOperationDenied, fr.Save(ctx, t1))
+}
@@ -0,0 +1,90 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	releaseIndexes       = []string{"project,!id"}
+	releaseUniqueIndexes = []string{"id", "project,name"}
+)
+
+type Release struct {
+	client *mongox.Collection
+	f      repo.ProjectFilter
+}
+
+func NewRelease(client *mongox.Client) repo.Release {
+	return &Release{client: client.WithCollection("release")}
+}
+
+func (r *Release) Init() error {
+	return createIndexes(context.Background(), r.client, releaseIndexes, releaseUniqueIndexes)
+}
+
+func (r *Release) Filtered(f repo.ProjectFilter) repo.Release {
+	return &Release{
+		client: r.client,
+		f:      r.f.Merge(f),
+	}
+}
+
+func (r *Release) FindByID(ctx context.Context, rid id.ReleaseID) (*release.Release, error) {
+	return r.findOne(ctx, bson.M{"id": rid.String()})
+}
+
+func (r *Release) FindByName(ctx context.Context, pid id.ProjectID, name string) (*release.Release, error) {
+	return r.findOne(ctx, bson.M{"project": pid.String(), "name": name})
+}
+
+func (r *Release) FindByProject(ctx context.Context, pid id.ProjectID, pagination *usecasex.Pagination) (release.List, *usecasex.PageInfo, error) {
+	if !r.f.CanRead(pid) {
+		return nil, usecasex.EmptyPageInfo(), nil
+	}
+
+	c := mongodoc.NewReleaseConsumer()
+	pageInfo, err := r.client.Paginate(ctx, r.readFilter(bson.M{
+		"project": pid.String(),
+	}), &usecasex.Sort{Key: "id", Reverted: true}, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *Release) Save(ctx context.Context, v *release.Release) error {
+	if !r.f.CanWrite(v.Project()) {
+		return repo.ErrOperationDenied
+	}
+	doc, rid := mongodoc.NewRelease(v)
+	return r.client.SaveOne(ctx, rid, doc)
+}
+
+func (r *Release) Remove(ctx context.Context, rid id.ReleaseID) error {
+	return r.client.RemoveOne(ctx, r.writeFilter(bson.M{"id": rid.String()}))
+}
+
+func (r *Release) findOne(ctx context.Context, filter any) (*release.Release, error) {
+	c := mongodoc.NewReleaseConsumer()
+	if err := r.client.FindOne(ctx, r.readFilter(filter), c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *Release) readFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Readable)
+}
+
+func (r *Release) writeFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Writable)
+}
@@ -0,0 +1,58 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelease(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	pid := id.NewProjectID()
+	r1 := release.New().NewID().Workspace(wid).Project(pid).Name("spring").MustBuild()
+	lo.Must0(r1.AddItem(lo.Must(release.NewItem(id.NewItemID(), version.New()))))
+	r2 := release.New().NewID().Workspace(wid).Project(pid).Name("autumn").MustBuild()
+
+	client := mongox.NewClientWithDatabase(mongotest.Connect(t)(t))
+	r := NewRelease(client)
+	assert.NoError(t, r.(*Release).Init())
+	assert.NoError(t, r.Save(ctx, r1))
+	assert.NoError(t, r.Save(ctx, r2))
+
+	got, err := r.FindByID(ctx, r1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, r1, got)
+
+	got, err = r.FindByName(ctx, pid, "autumn")
+	assert.NoError(t, err)
+	assert.Equal(t, r2.ID(), got.ID())
+
+	list, _, err := r.FindByProject(ctx, pid, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.ReleaseID{r2.ID(), r1.ID()}, lo.Map(list, func(v *release.Release, _ int) id.ReleaseID { return v.ID() }))
+
+	// the name is unique in the project
+	r3 := release.New().NewID().Workspace(wid).Project(pid).Name("spring").MustBuild()
+	assert.Error(t, r.Save(ctx, r3))
+
+	// filtered
+	fr := r.Filtered(repo.ProjectFilter{Readable: id.ProjectIDList{}, Writable: id.ProjectIDList{}})
+	_, err = fr.FindByID(ctx, r1.ID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+	assert.Equal(t, repo.ErrOperationDenied, fr.Save(ctx, r1))
+
+	assert.NoError(t, r.Remove(ctx, r1.ID()))
+	_, err = r.FindByID(ctx, r1.ID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
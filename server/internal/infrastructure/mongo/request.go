@@ -18,7 +18,7 @@ import (
 )
 
 var (
-	requestIndexes       = []string{"project", "items.item"}
+	requestIndexes       = []string{"project", "items.item", "thread"}
 	requestUniqueIndexes = []string{"id"}
 )
 
@@ -65,6 +65,12 @@ func (r *Request) FindByIDs(ctx context.Context, ids id.RequestIDList) (request.
 	return filterRequests(ids, res), nil
 }
 
+func (r *Request) FindByThread(ctx context.Context, tid id.ThreadID) (*request.Request, error) {
+	return r.findOne(ctx, bson.M{
+		"thread": tid.String(),
+	})
+}
+
 func (r *Request) FindByItems(ctx context.Context, list id.ItemIDList, uFilter *repo.RequestFilter) (request.List, error) {
 
 	filter := bson.M{
@@ -0,0 +1,117 @@
+package mongo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/mongox"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	scheduleIndexes       = []string{"project", "item", "publishat", "unpublishat"}
+	scheduleUniqueIndexes = []string{"id"}
+)
+
+type Schedule struct {
+	client *mongox.Collection
+	f      repo.ProjectFilter
+}
+
+func NewSchedule(client *mongox.Client) repo.Schedule {
+	return &Schedule{client: client.WithCollection("schedule")}
+}
+
+func (r *Schedule) Init() error {
+	return createIndexes(context.Background(), r.client, scheduleIndexes, scheduleUniqueIndexes)
+}
+
+func (r *Schedule) Filtered(f repo.ProjectFilter) repo.Schedule {
+	return &Schedule{
+		client: r.client,
+		f:      r.f.Merge(f),
+	}
+}
+
+func (r *Schedule) FindByID(ctx context.Context, sid id.ScheduleID) (*schedule.Schedule, error) {
+	return r.findOne(ctx, bson.M{
+		"id": sid.String(),
+	})
+}
+
+func (r *Schedule) FindByItems(ctx context.Context, ids id.ItemIDList) (schedule.List, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return r.find(ctx, bson.M{
+		"item": bson.M{
+			"$in": ids.Strings(),
+		},
+	})
+}
+
+func (r *Schedule) FindByProject(ctx context.Context, pid id.ProjectID) (schedule.List, error) {
+	if !r.f.CanRead(pid) {
+		return nil, nil
+	}
+	res, err := r.find(ctx, bson.M{
+		"project": pid.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.SortByNextAt(), nil
+}
+
+func (r *Schedule) FindDue(ctx context.Context, now time.Time) (schedule.List, error) {
+	res, err := r.find(ctx, bson.M{
+		"$or": []bson.M{
+			{"publishat": bson.M{"$lte": now}},
+			{"unpublishat": bson.M{"$lte": now}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.SortByNextAt(), nil
+}
+
+func (r *Schedule) Save(ctx context.Context, s *schedule.Schedule) error {
+	if !r.f.CanWrite(s.Project()) {
+		return repo.ErrOperationDenied
+	}
+	doc, sid := mongodoc.NewSchedule(s)
+	return r.client.SaveOne(ctx, sid, doc)
+}
+
+func (r *Schedule) Remove(ctx context.Context, sid id.ScheduleID) error {
+	return r.client.RemoveOne(ctx, r.writeFilter(bson.M{"id": sid.String()}))
+}
+
+func (r *Schedule) findOne(ctx context.Context, filter any) (*schedule.Schedule, error) {
+	c := mongodoc.NewScheduleConsumer()
+	if err := r.client.FindOne(ctx, r.readFilter(filter), c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *Schedule) find(ctx context.Context, filter any) (schedule.List, error) {
+	c := mongodoc.NewScheduleConsumer()
+	if err := r.client.Find(ctx, r.readFilter(filter), c); err != nil {
+		return nil, err
+	}
+	return c.Result, nil
+}
+
+func (r *Schedule) readFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Readable)
+}
+
+func (r *Schedule) writeFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Writable)
+}
@@ -0,0 +1,113 @@
+package mongo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mongo/mongodoc"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/mongox"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var (
+	trashIndexes       = []string{"project,!deletedat,!id", "deletedat"}
+	trashUniqueIndexes = []string{"id"}
+)
+
+type Trash struct {
+	client *mongox.Collection
+	f      repo.ProjectFilter
+}
+
+func NewTrash(client *mongox.Client) repo.Trash {
+	return &Trash{client: client.WithCollection("trash")}
+}
+
+func (r *Trash) Init() error {
+	return createIndexes(context.Background(), r.client, trashIndexes, trashUniqueIndexes)
+}
+
+func (r *Trash) Filtered(f repo.ProjectFilter) repo.Trash {
+	return &Trash{
+		client: r.client,
+		f:      r.f.Merge(f),
+	}
+}
+
+func (r *Trash) FindByID(ctx context.Context, tid id.TrashID) (*trash.Entry, error) {
+	c := mongodoc.NewTrashConsumer()
+	if err := r.client.FindOne(ctx, r.readFilter(bson.M{"id": tid.String()}), c); err != nil {
+		return nil, err
+	}
+	return c.Result[0], nil
+}
+
+func (r *Trash) FindByProject(ctx context.Context, pid id.ProjectID, pagination *usecasex.Pagination) (trash.List, *usecasex.PageInfo, error) {
+	if !r.f.CanRead(pid) {
+		return nil, usecasex.EmptyPageInfo(), nil
+	}
+
+	c := mongodoc.NewTrashConsumer()
+	pageInfo, err := r.client.Paginate(ctx, r.readFilter(bson.M{
+		"project": pid.String(),
+	}), &usecasex.Sort{Key: "deletedat", Reverted: true}, pagination, c)
+	if err != nil {
+		return nil, nil, rerror.ErrInternalBy(err)
+	}
+	return c.Result, pageInfo, nil
+}
+
+func (r *Trash) Save(ctx context.Context, e *trash.Entry) error {
+	if !r.f.CanWrite(e.Project()) {
+		return repo.ErrOperationDenied
+	}
+	doc, tid := mongodoc.NewTrash(e)
+	return r.client.SaveOne(ctx, tid, doc)
+}
+
+func (r *Trash) Remove(ctx context.Context, tid id.TrashID) error {
+	return r.client.RemoveOne(ctx, r.writeFilter(bson.M{"id": tid.String()}))
+}
+
+func (r *Trash) readFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Readable)
+}
+
+func (r *Trash) writeFilter(filter any) any {
+	return applyProjectFilter(filter, r.f.Writable)
+}
+
+// setTrashed sets or clears the trash flag of the documents which match the filter.
+// It returns ErrNotFound when no documents are changed.
+func setTrashed(ctx context.Context, c *mongox.Collection, filter any, trashed bool) error {
+	var update bson.M
+	if trashed {
+		filter = excludeTrashed(filter)
+		update = bson.M{"$set": bson.M{"trashed": true}}
+	} else {
+		filter = mongox.And(filter, "trashed", true)
+		update = bson.M{"$unset": bson.M{"trashed": ""}}
+	}
+
+	res, err := c.Client().UpdateMany(ctx, filter, update)
+	if err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	if res.ModifiedCount == 0 {
+		return rerror.ErrNotFound
+	}
+	return nil
+}
+
+// excludeTrashed hides the documents which are moved to the trash.
+func excludeTrashed(filter any) any {
+	return mongox.And(filter, "trashed", bson.M{"$ne": true})
+}
+
+func excludeTrashedFromPipeline(pipeline []any) []any {
+	return append([]any{bson.M{"$match": bson.M{"trashed": bson.M{"$ne": true}}}}, pipeline...)
+}
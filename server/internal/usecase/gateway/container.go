@@ -1,8 +1,10 @@
 package gateway
 
 type Container struct {
-	Authenticator Authenticator
-	File          File
-	Mailer        Mailer
-	TaskRunner    TaskRunner
+	Authenticator   Authenticator
+	File            File
+	ItemSearch      ItemSearch
+	Mailer          Mailer
+	ProjectArchiver ProjectArchiver
+	TaskRunner      TaskRunner
 }
@@ -0,0 +1,61 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/search"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"golang.org/x/exp/slices"
+)
+
+// ItemSearchDocument is an item in the full-text search index. An item has one document for each ref.
+type ItemSearchDocument struct {
+	ID      id.ItemID
+	Project id.ProjectID
+	Model   id.ModelID
+	Schema  id.SchemaID
+	Ref     version.Ref
+	Terms   search.Terms
+}
+
+type ItemSearchQuery struct {
+	Project id.ProjectID
+	Model   id.ModelID
+	Schema  *id.SchemaID
+	Ref     version.Ref
+	Keyword string
+	// Offset is the number of hits to skip.
+	Offset int64
+	// Limit is the maximum number of hits. All hits are returned when it is zero.
+	Limit int64
+}
+
+type ItemSearchHit struct {
+	ID    id.ItemID
+	Score float64
+}
+
+type ItemSearch interface {
+	// Index adds the documents to the index or replaces them.
+	Index(context.Context, []ItemSearchDocument) error
+	// Delete removes the documents of the items at the ref, or at all refs when the ref is nil.
+	Delete(context.Context, id.ItemIDList, *version.Ref) error
+	// DeleteByModel removes all documents of the model.
+	DeleteByModel(context.Context, id.ModelID) error
+	// Search returns a page of the items matching all terms of the keyword ordered by the relevance, and the total number of the items.
+	Search(context.Context, ItemSearchQuery) ([]ItemSearchHit, int64, error)
+}
+
+// SortItemSearchHits sorts the hits by the score in descending order. Hits with the same score are sorted by the ID.
+func SortItemSearchHits(hits []ItemSearchHit) {
+	slices.SortStableFunc(hits, func(a, b ItemSearchHit) int {
+		if a.Score != b.Score {
+			if a.Score > b.Score {
+				return -1
+			}
+			return 1
+		}
+		return a.ID.Compare(b.ID)
+	})
+}
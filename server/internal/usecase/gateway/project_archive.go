@@ -0,0 +1,46 @@
+package gateway
+
+import (
+	"context"
+	"io"
+
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/group"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var ErrInvalidProjectArchive error = rerror.NewE(i18n.T("invalid project archive"))
+
+// ProjectArchive is a project with everything in it, to be moved between environments.
+type ProjectArchive struct {
+	Project *project.Project
+	Models  model.List
+	// Schemas contains the schemas and the metadata schemas of the models and the schemas of the groups.
+	Schemas schema.List
+	Groups  group.List
+	Views   view.List
+	// Items contains all versions of the items. The versions of an item are ordered from the oldest.
+	Items      item.VersionedList
+	Assets     asset.List
+	AssetFiles map[id.AssetID]*asset.File
+	Threads    thread.List
+	// AssetContent opens the content of the asset.
+	AssetContent func(context.Context, *asset.Asset) (io.ReadCloser, error)
+}
+
+type ProjectArchiver interface {
+	// Write writes the project as a zip archive.
+	Write(context.Context, io.Writer, *ProjectArchive) error
+	// Read reads a project from a zip archive written by Write. Every ID of the project is replaced with a new one,
+	// and the project and its threads are moved to the workspace, so the project can be imported next to the original one.
+	Read(context.Context, io.ReaderAt, int64, accountdomain.WorkspaceID) (*ProjectArchive, error)
+}
@@ -4,8 +4,13 @@ import (
 	"context"
 
 	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
 )
 
+// ErrUnsupportedTask is returned when the runner can not run the type of the task.
+var ErrUnsupportedTask error = rerror.NewE(i18n.T("unsupported task"))
+
 type TaskRunner interface {
 	Run(context.Context, task.Payload) error
 	Retry(context.Context, string) error
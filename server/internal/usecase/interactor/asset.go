@@ -1,10 +1,13 @@
 package interactor
 
 import (
+	"bufio"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"path"
 	"strings"
 	"time"
@@ -18,7 +21,10 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/file"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/imaging"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
@@ -26,15 +32,23 @@ import (
 )
 
 type Asset struct {
-	repos       *repo.Container
-	gateways    *gateway.Container
-	ignoreEvent bool
+	repos           *repo.Container
+	gateways        *gateway.Container
+	ignoreEvent     bool
+	host            string
+	transformSecret []byte
 }
 
 func NewAsset(r *repo.Container, g *gateway.Container) interfaces.Asset {
+	return newAsset(r, g, "", "")
+}
+
+func newAsset(r *repo.Container, g *gateway.Container, host, transformSecret string) *Asset {
 	return &Asset{
-		repos:    r,
-		gateways: g,
+		repos:           r,
+		gateways:        g,
+		host:            host,
+		transformSecret: []byte(transformSecret),
 	}
 }
 
@@ -49,6 +63,30 @@ func (i *Asset) FindByID(ctx context.Context, aid id.AssetID, _ *usecase.Operato
 	return a, nil
 }
 
+func (i *Asset) TransformURL(ctx context.Context, aid id.AssetID, o imaging.Options, operator *usecase.Operator) (string, error) {
+	if len(i.transformSecret) == 0 {
+		return "", imaging.ErrTransformsDisabled
+	}
+
+	a, err := i.repos.Asset.FindByID(ctx, aid)
+	if err != nil {
+		return "", err
+	}
+	if !a.Public() && !operator.IsReadableProject(a.Project()) {
+		return "", rerror.ErrNotFound
+	}
+	if pt := a.PreviewType(); pt == nil || *pt != asset.PreviewTypeImage {
+		return "", imaging.ErrUnsupportedFormat
+	}
+
+	p := imaging.AssetPath(a.UUID(), a.FileName())
+	if p == "" {
+		return "", rerror.ErrNotFound
+	}
+	u := strings.TrimSuffix(i.host, "/") + path.Dir(p) + "/" + url.PathEscape(a.FileName())
+	return u + "?" + o.SignedQuery(i.transformSecret, p), nil
+}
+
 func (i *Asset) FindByUUID(ctx context.Context, uuid string, _ *usecase.Operator) (*asset.Asset, error) {
 	a, err := i.repos.Asset.FindByUUID(ctx, uuid)
 	if err != nil {
@@ -77,6 +115,7 @@ func (i *Asset) Search(ctx context.Context, projectID id.ProjectID, filter inter
 		Keyword:      filter.Keyword,
 		Pagination:   filter.Pagination,
 		ContentTypes: filter.ContentTypes,
+		Metadata:     filter.Metadata,
 	})
 	if err != nil {
 		return nil, nil, err
@@ -129,6 +168,13 @@ func (i *Asset) DownloadByID(ctx context.Context, aid id.AssetID, headers map[st
 	return f, headers, nil
 }
 
+const (
+	// maxMetadataFileSize is the largest size of files which metadata is extracted from.
+	maxMetadataFileSize = 100 * 1024 * 1024
+	// maxMetadataArchiveFiles is the largest number of files in an archive which metadata is extracted from.
+	maxMetadataArchiveFiles = 1000
+)
+
 func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op *usecase.Operator) (result *asset.Asset, afile *asset.File, err error) {
 	if op.AcOperator.User == nil && op.Integration == nil {
 		return nil, nil, interfaces.ErrInvalidOperator
@@ -183,10 +229,7 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 				}
 			}
 
-			needDecompress := false
-			if ext := strings.ToLower(path.Ext(file.Name)); ext == ".zip" || ext == ".7z" {
-				needDecompress = true
-			}
+			needDecompress := isArchive(file.Name)
 
 			es := lo.ToPtr(asset.ArchiveExtractionStatusDone)
 			if needDecompress {
@@ -260,9 +303,150 @@ func (i *Asset) Create(ctx context.Context, inp interfaces.CreateAssetParam, op
 		return nil, nil, err
 	}
 
+	// metadata of archives is extracted after they are decompressed
+	if !isArchive(a.FileName()) {
+		a = i.triggerMetadataExtraction(ctx, a, op)
+	}
+
 	return a, f, nil
 }
 
+func isArchive(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	return ext == ".zip" || ext == ".7z"
+}
+
+func (i *Asset) ExtractMetadata(ctx context.Context, aid id.AssetID, op *usecase.Operator) (*asset.Asset, error) {
+	if op.AcOperator.User == nil && op.Integration == nil && !op.Machine {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	a, err := i.repos.Asset.FindByID(ctx, aid)
+	if err != nil {
+		return nil, err
+	}
+	a.SetAccessInfoResolver(i.gateways.File.GetAccessInfoResolver())
+	if !op.CanUpdate(a) {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	// files are read out of the transaction since it takes long
+	var m *asset.Metadata
+	if isArchive(a.FileName()) {
+		if lo.FromPtr(a.ArchiveExtractionStatus()) != asset.ArchiveExtractionStatusDone {
+			return a, nil
+		}
+		m = i.extractArchiveMetadata(ctx, a)
+	} else {
+		m = i.extractMetadata(ctx, a.UUID(), a.FileName())
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*asset.Asset, error) {
+		a, err := i.repos.Asset.FindByID(ctx, aid)
+		if err != nil {
+			return nil, err
+		}
+
+		a.UpdateMetadata(m)
+		if err := i.repos.Asset.Save(ctx, a); err != nil {
+			return nil, err
+		}
+		a.SetAccessInfoResolver(i.gateways.File.GetAccessInfoResolver())
+		return a, nil
+	})
+}
+
+// triggerMetadataExtraction extracts the metadata of the saved asset and returns the updated asset.
+// The many files of a decompressed archive are read by the task runner. A single file is read here since at most maxMetadataFileSize is read,
+// and so are archives when the task runner is not configured or can not run the task.
+// Failures are only logged since the asset is usable without metadata.
+func (i *Asset) triggerMetadataExtraction(ctx context.Context, a *asset.Asset, op *usecase.Operator) *asset.Asset {
+	if !isArchive(a.FileName()) && !asset.CanExtractMetadata(a.FileName()) {
+		return a
+	}
+
+	if isArchive(a.FileName()) && i.gateways.TaskRunner != nil {
+		taskPayload := task.ExtractAssetMetadataPayload{
+			AssetID: a.ID().String(),
+		}
+		err := i.gateways.TaskRunner.Run(ctx, taskPayload.Payload())
+		if err == nil {
+			return a
+		}
+		if !errors.Is(err, gateway.ErrUnsupportedTask) {
+			log.Warnfc(ctx, "asset: failed to run metadata extraction of asset %s: %v", a.ID(), err)
+			return a
+		}
+	}
+
+	res, err := i.ExtractMetadata(ctx, a.ID(), op)
+	if err != nil {
+		log.Warnfc(ctx, "asset: failed to extract metadata of asset %s: %v", a.ID(), err)
+		return a
+	}
+	return res
+}
+
+// extractArchiveMetadata extracts the metadata of the decompressed files of the archive and merges them.
+func (i *Asset) extractArchiveMetadata(ctx context.Context, a *asset.Asset) *asset.Metadata {
+	files, err := i.gateways.File.GetAssetFiles(ctx, a.UUID())
+	if err != nil {
+		log.Warnfc(ctx, "asset: failed to get files of asset %s to extract metadata: %v", a.ID(), err)
+		return nil
+	}
+
+	var m *asset.Metadata
+	n := 0
+	for _, f := range files {
+		if isArchive(f.Name) || !asset.CanExtractMetadata(f.Name) || f.Size > maxMetadataFileSize {
+			continue
+		}
+		if n == maxMetadataArchiveFiles {
+			break
+		}
+		n++
+		m = m.Merge(i.extractMetadata(ctx, a.UUID(), f.Name))
+	}
+	return m
+}
+
+// extractMetadata reads the file of the asset and extracts its metadata.
+// Failures are only logged since the asset is usable without metadata.
+func (i *Asset) extractMetadata(ctx context.Context, uuid, name string) *asset.Metadata {
+	r, _, err := i.gateways.File.ReadAsset(ctx, uuid, name, nil)
+	if err != nil {
+		log.Warnfc(ctx, "asset: failed to read %s of asset %s to extract metadata: %v", name, uuid, err)
+		return nil
+	}
+	defer func() { _ = r.Close() }()
+
+	// some storages return the gzip-encoded content as it is
+	br := bufio.NewReader(r)
+	var content io.Reader = br
+	if b, err := br.Peek(2); err == nil && b[0] == 0x1f && b[1] == 0x8b {
+		gr, err := gzip.NewReader(br)
+		if err != nil {
+			log.Warnfc(ctx, "asset: failed to read %s of asset %s to extract metadata: %v", name, uuid, err)
+			return nil
+		}
+		defer func() { _ = gr.Close() }()
+		content = gr
+	}
+
+	// the decompressed content is limited as well as the stored file
+	lr := &io.LimitedReader{R: content, N: maxMetadataFileSize + 1}
+	m, err := asset.ExtractMetadata(lr, name)
+	if lr.N <= 0 {
+		log.Warnfc(ctx, "asset: metadata of %s of asset %s was not extracted because it is too large", name, uuid)
+		return nil
+	}
+	if err != nil {
+		log.Warnfc(ctx, "asset: %v", err)
+		return nil
+	}
+	return m
+}
+
 func (i *Asset) Decompress(ctx context.Context, aId id.AssetID, operator *usecase.Operator) (*asset.Asset, error) {
 	if operator.AcOperator.User == nil && operator.Integration == nil {
 		return nil, interfaces.ErrInvalidOperator
@@ -551,7 +735,8 @@ func (i *Asset) UpdateFiles(ctx context.Context, aid id.AssetID, s *asset.Archiv
 		return nil, interfaces.ErrInvalidOperator
 	}
 
-	return Run1(
+	updated := false
+	a, err := Run1(
 		ctx, op, i.repos,
 		Usecase().Transaction(),
 		func(ctx context.Context) (*asset.Asset, error) {
@@ -631,9 +816,18 @@ func (i *Asset) UpdateFiles(ctx context.Context, aid id.AssetID, s *asset.Archiv
 				return nil, fmt.Errorf("failed to create an event: %v", err)
 			}
 
+			updated = true
 			return a, nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated && lo.FromPtr(s) == asset.ArchiveExtractionStatusDone {
+		a = i.triggerMetadataExtraction(ctx, a, op)
+	}
+	return a, nil
 }
 
 func detectPreviewType(files []gateway.FileEntry) *asset.PreviewType {
@@ -673,21 +867,17 @@ func (i *Asset) Delete(ctx context.Context, aId id.AssetID, operator *usecase.Op
 				return aId, interfaces.ErrOperationDenied
 			}
 
-			uuid := a.UUID()
-			filename := a.FileName()
-			if uuid != "" && filename != "" {
-				if err := i.gateways.File.DeleteAsset(ctx, uuid, filename); err != nil {
-					return aId, err
-				}
+			// the files are kept until the asset is purged from the trash
+			if err := i.repos.Asset.Trash(ctx, aId); err != nil {
+				return aId, err
 			}
 
-			err = i.repos.Asset.Delete(ctx, aId)
+			p, err := i.repos.Project.FindByID(ctx, a.Project())
 			if err != nil {
 				return aId, err
 			}
 
-			p, err := i.repos.Project.FindByID(ctx, a.Project())
-			if err != nil {
+			if err := saveTrashEntry(ctx, i.repos, trash.New().Asset(aId).Name(a.FileName()), p.Workspace(), p.ID(), operator); err != nil {
 				return aId, err
 			}
 
@@ -734,22 +924,23 @@ func (i *Asset) BatchDelete(ctx context.Context, assetIDs id.AssetIDList, operat
 				return assetIDs, nil
 			}
 
-			UUIDList := lo.FilterMap(assets, func(a *asset.Asset, _ int) (string, bool) {
-				if a == nil || a.UUID() == "" || a.FileName() == "" {
-					return "", false
-				}
-				return a.UUID(), true
-			})
-
-			// deletes assets' files in
-			err = i.gateways.File.DeleteAssets(ctx, UUIDList)
+			projects, err := i.repos.Project.FindByIDs(ctx, lo.Uniq(lo.Map(assets, func(a *asset.Asset, _ int) id.ProjectID { return a.Project() })))
 			if err != nil {
 				return assetIDs, err
 			}
 
-			err = i.repos.Asset.BatchDelete(ctx, assetIDs)
-			if err != nil {
-				return assetIDs, err
+			// the files are kept until the assets are purged from the trash
+			for _, a := range assets {
+				if err := i.repos.Asset.Trash(ctx, a.ID()); err != nil {
+					return assetIDs, err
+				}
+				p, ok := lo.Find(projects, func(p *project.Project) bool { return p.ID() == a.Project() })
+				if !ok {
+					return assetIDs, rerror.ErrNotFound
+				}
+				if err := saveTrashEntry(ctx, i.repos, trash.New().Asset(a.ID()).Name(a.FileName()), p.Workspace(), p.ID(), operator); err != nil {
+					return assetIDs, err
+				}
 			}
 
 			return assetIDs, nil
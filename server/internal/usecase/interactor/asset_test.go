@@ -19,6 +19,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/file"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/imaging"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearthx/account/accountdomain"
@@ -977,6 +978,166 @@ func TestAsset_Create(t *testing.T) {
 	}
 }
 
+func TestAsset_ExtractMetadata(t *testing.T) {
+	ctx := context.Background()
+	ws := workspace.New().NewID().MustBuild()
+	p := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	u := user.New().NewID().Name("aaa").Email("aaa@bbb.com").Workspace(ws.ID()).MustBuild()
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:               lo.ToPtr(u.ID()),
+			WritableWorkspaces: []accountdomain.WorkspaceID{ws.ID()},
+		},
+		WritableProjects: id.ProjectIDList{p.ID()},
+	}
+	machine := &usecase.Operator{AcOperator: &accountusecase.Operator{}, Machine: true}
+
+	newUC := func(runner gateway.TaskRunner) (*Asset, afero.Fs) {
+		db := memory.New()
+		assert.NoError(t, db.Project.Save(ctx, p))
+		mfs := afero.NewMemMapFs()
+		f, _ := fs.NewFile(mfs, "")
+		return &Asset{
+			repos:       db,
+			gateways:    &gateway.Container{File: f, TaskRunner: runner},
+			ignoreEvent: true,
+		}, mfs
+	}
+	create := func(uc *Asset, name, content string) *asset.Asset {
+		a, _, err := uc.Create(ctx, interfaces.CreateAssetParam{
+			ProjectID: p.ID(),
+			File: &file.File{
+				Name:    name,
+				Content: io.NopCloser(strings.NewReader(content)),
+				Size:    int64(len(content)),
+			},
+		}, op)
+		assert.NoError(t, err)
+		return a
+	}
+	// decompress writes the files of the archive and marks it decompressed
+	decompress := func(uc *Asset, mfs afero.Fs, z *asset.Asset) *asset.Asset {
+		dir := path.Join("assets", z.UUID()[:2], z.UUID()[2:])
+		assert.NoError(t, afero.WriteFile(mfs, path.Join(dir, "z/a.geojson"), []byte(`{"type": "Feature", "geometry": {"type": "Point", "coordinates": [130, 30]}}`), 0666))
+		assert.NoError(t, afero.WriteFile(mfs, path.Join(dir, "z/b/b.czml"), []byte(`[{"id": "a", "position": {"cartographicDegrees": [140, 40, 0]}}]`), 0666))
+		assert.NoError(t, afero.WriteFile(mfs, path.Join(dir, "z/c.txt"), []byte("Hello"), 0666))
+		a, err := uc.UpdateFiles(ctx, z.ID(), lo.ToPtr(asset.ArchiveExtractionStatusDone), machine)
+		assert.NoError(t, err)
+		return a
+	}
+	zipMetadata := &asset.Metadata{
+		BBox:         &asset.BBox{MinLng: 130, MinLat: 30, MaxLng: 140, MaxLat: 40},
+		FeatureCount: lo.ToPtr(2),
+	}
+	csvMetadata := &asset.Metadata{Headers: []string{"id", "name"}, RowCount: lo.ToPtr(2)}
+
+	// single files are read in the server and the archives are read by the task
+	runner := &mockRunner{}
+	uc, mfs := newUC(runner)
+	a := create(uc, "a.csv", "id,name\n1,a\n2,b\n")
+	assert.Equal(t, csvMetadata, a.Metadata())
+	// invalid content does not fail the upload
+	assert.Nil(t, create(uc, "b.geojson", "{").Metadata())
+	assert.Nil(t, create(uc, "c.txt", "Hello").Metadata())
+
+	res, _, err := uc.Search(ctx, p.ID(), interfaces.AssetFilter{
+		Metadata: &asset.MetadataFilter{Header: lo.ToPtr("name")},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, id.AssetIDList{a.ID()}, res.IDs())
+
+	z := create(uc, "z.zip", "zip")
+	assert.Nil(t, z.Metadata())
+	assert.Nil(t, decompress(uc, mfs, z).Metadata())
+	assert.Equal(t, []task.Payload{
+		(&task.DecompressAssetPayload{AssetID: z.ID().String(), Path: z.UUID()[:2] + "/" + z.UUID()[2:] + "/z.zip"}).Payload(),
+		(&task.ExtractAssetMetadataPayload{AssetID: z.ID().String()}).Payload(),
+	}, runner.payloads)
+
+	got, err := uc.ExtractMetadata(ctx, z.ID(), machine)
+	assert.NoError(t, err)
+	assert.Equal(t, zipMetadata, got.Metadata())
+
+	_, err = uc.ExtractMetadata(ctx, a.ID(), &usecase.Operator{AcOperator: &accountusecase.Operator{User: lo.ToPtr(u.ID())}})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+
+	// archives are read in the server when the task runner can not run the task
+	uc, mfs = newUC(&mockRunner{unsupported: func(p task.Payload) bool { return p.ExtractAssetMetadata != nil }})
+	z = create(uc, "z.zip", "zip")
+	assert.Equal(t, zipMetadata, decompress(uc, mfs, z).Metadata())
+}
+
+func TestAsset_ExtractMetadata_NoTaskRunner(t *testing.T) {
+	ctx := context.Background()
+	ws := workspace.New().NewID().MustBuild()
+	p := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	u := user.New().NewID().Name("aaa").Email("aaa@bbb.com").Workspace(ws.ID()).MustBuild()
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:               lo.ToPtr(u.ID()),
+			WritableWorkspaces: []accountdomain.WorkspaceID{ws.ID()},
+		},
+		WritableProjects: id.ProjectIDList{p.ID()},
+	}
+
+	db := memory.New()
+	assert.NoError(t, db.Project.Save(ctx, p))
+	f, _ := fs.NewFile(afero.NewMemMapFs(), "")
+	uc := &Asset{
+		repos:       db,
+		gateways:    &gateway.Container{File: f},
+		ignoreEvent: true,
+	}
+
+	a, _, err := uc.Create(ctx, interfaces.CreateAssetParam{
+		ProjectID: p.ID(),
+		File: &file.File{
+			Name:    "a.geojson",
+			Content: io.NopCloser(strings.NewReader(`{"type": "Feature", "geometry": {"type": "Point", "coordinates": [130, 30]}}`)),
+		},
+	}, op)
+	assert.NoError(t, err)
+	want := &asset.Metadata{BBox: &asset.BBox{MinLng: 130, MinLat: 30, MaxLng: 130, MaxLat: 30}, FeatureCount: lo.ToPtr(1)}
+	assert.Equal(t, want, a.Metadata())
+
+	got, err := db.Asset.FindByID(ctx, a.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, want, got.Metadata())
+}
+
+func TestAsset_TransformURL(t *testing.T) {
+	ctx := context.Background()
+	pid := id.NewProjectID()
+	build := func(name string, pt *asset.PreviewType) *asset.Asset {
+		return asset.New().NewID().Project(pid).CreatedByUser(accountdomain.NewUserID()).Size(1000).
+			Thread(id.NewThreadID().Ref()).UUID("0123456789abcdef").FileName(name).Type(pt).MustBuild()
+	}
+	img := build("a b.png", lo.ToPtr(asset.PreviewTypeImage))
+	csv := build("a.csv", lo.ToPtr(asset.PreviewTypeUnknown))
+
+	db := memory.New()
+	assert.NoError(t, db.Asset.Save(ctx, img))
+	assert.NoError(t, db.Asset.Save(ctx, csv))
+	g := &gateway.Container{File: lo.Must(fs.NewFile(afero.NewMemMapFs(), ""))}
+	op := &usecase.Operator{ReadableProjects: id.ProjectIDList{pid}}
+	o := imaging.Options{Width: 100, Format: imaging.FormatWebP}
+
+	u, err := newAsset(db, g, "https://example.com/", "secret").TransformURL(ctx, img.ID(), o, op)
+	assert.NoError(t, err)
+	p := imaging.AssetPath(img.UUID(), img.FileName())
+	assert.Equal(t, "https://example.com/assets/01/23456789abcdef/a%20b.png?"+o.SignedQuery([]byte("secret"), p), u)
+
+	_, err = newAsset(db, g, "", "").TransformURL(ctx, img.ID(), o, op)
+	assert.ErrorIs(t, err, imaging.ErrTransformsDisabled)
+
+	// the URL is not issued for the operators who can not read the private asset
+	_, err = newAsset(db, g, "", "secret").TransformURL(ctx, img.ID(), o, &usecase.Operator{})
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	_, err = newAsset(db, g, "", "secret").TransformURL(ctx, csv.ID(), o, op)
+	assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+}
+
 func TestAsset_Update(t *testing.T) {
 	g := gateway.Container{
 		File: lo.Must(fs.NewFile(afero.NewMemMapFs(), "")),
@@ -1445,6 +1606,11 @@ func TestAsset_Delete(t *testing.T) {
 
 			_, err = db.Asset.FindByID(ctx, tc.args.id)
 			assert.Equal(t, rerror.ErrNotFound, err)
+
+			entries, _, err := db.Trash.FindByProject(ctx, proj1.ID(), nil)
+			assert.NoError(t, err)
+			assert.Len(t, entries, 1)
+			assert.Equal(t, &aid1, entries[0].Asset())
 		})
 	}
 }
@@ -1468,13 +1634,21 @@ func mockFs() afero.Fs {
 }
 
 // mockRunner implements gateway.TaskRunner
-type mockRunner struct{}
+type mockRunner struct {
+	payloads []task.Payload
+	// unsupported reports whether the runner can not run the task
+	unsupported func(task.Payload) bool
+}
 
 func NewMockRunner() gateway.TaskRunner {
 	return &mockRunner{}
 }
 
-func (r *mockRunner) Run(context.Context, task.Payload) error {
+func (r *mockRunner) Run(_ context.Context, p task.Payload) error {
+	if r.unsupported != nil && r.unsupported(p) {
+		return gateway.ErrUnsupportedTask
+	}
+	r.payloads = append(r.payloads, p)
 	return nil
 }
 
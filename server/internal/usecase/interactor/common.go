@@ -23,25 +23,39 @@ import (
 type ContainerConfig struct {
 	SignupSecret    string
 	AuthSrvUIDomain string
+	// PreviewSecret is the secret to sign preview tokens. Preview tokens cannot be issued when it is empty.
+	PreviewSecret string
+	// WebHost is the URL of the web app, which is used to link pages from emails.
+	WebHost string
+	// AssetHost is the URL of this server, which serves the transformed images of assets.
+	AssetHost string
+	// AssetTransformSecret is the secret to sign image transformations. Transform URLs cannot be issued when it is empty.
+	AssetTransformSecret string
 }
 
 func New(r *repo.Container, g *gateway.Container,
 	ar *accountrepo.Container, ag *accountgateway.Container,
 	config ContainerConfig) interfaces.Container {
 	return interfaces.Container{
-		Asset:             NewAsset(r, g),
+		Asset:             newAsset(r, g, config.AssetHost, config.AssetTransformSecret),
 		Workspace:         accountinteractor.NewWorkspace(ar, nil),
 		User:              accountinteractor.NewMultiUser(ar, ag, config.SignupSecret, config.AuthSrvUIDomain, ar.Users),
 		Project:           NewProject(r, g),
 		Item:              NewItem(r, g),
 		View:              NewView(r, g),
-		Request:           NewRequest(r, g),
+		Schedule:          NewSchedule(r, g),
+		ItemTemplate:      NewItemTemplate(r, g),
+		Trash:             NewTrash(r, g),
+		Release:           NewRelease(r, g),
+		PreviewToken:      NewPreviewToken(r, g, config.PreviewSecret),
+		Request:           NewRequest(r, g, config.WebHost),
 		Model:             NewModel(r, g),
 		Schema:            NewSchema(r, g),
 		Integration:       NewIntegration(r, g),
-		Thread:            NewThread(r, g),
+		Thread:            NewThread(r, g, config.WebHost),
 		Group:             NewGroup(r, g),
 		WorkspaceSettings: NewWorkspaceSettings(r, g),
+		Notification:      NewNotification(r, g),
 	}
 }
 
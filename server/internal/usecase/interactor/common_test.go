@@ -133,13 +133,19 @@ func TestNew(t *testing.T) {
 		User:              accountinteractor.NewUser(&accountrepo.Container{}, nil, "", ""),
 		Item:              NewItem(nil, nil),
 		View:              NewView(nil, nil),
+		Schedule:          NewSchedule(nil, nil),
+		ItemTemplate:      NewItemTemplate(nil, nil),
+		Trash:             NewTrash(nil, nil),
+		Release:           NewRelease(nil, nil),
+		PreviewToken:      NewPreviewToken(nil, nil, ""),
 		Project:           NewProject(nil, nil),
-		Request:           NewRequest(nil, nil),
+		Request:           NewRequest(nil, nil, ""),
 		Model:             NewModel(nil, nil),
 		Schema:            NewSchema(nil, nil),
 		Integration:       NewIntegration(nil, nil),
-		Thread:            NewThread(nil, nil),
+		Thread:            NewThread(nil, nil, ""),
 		Group:             NewGroup(nil, nil),
 		WorkspaceSettings: NewWorkspaceSettings(nil, nil),
+		Notification:      NewNotification(nil, nil),
 	}, uc)
 }
@@ -14,8 +14,12 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/release"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/rerror"
@@ -32,6 +36,8 @@ type Item struct {
 	repos       *repo.Container
 	gateways    *gateway.Container
 	ignoreEvent bool
+	// pendingEvents holds events until the batch they belong to is committed.
+	pendingEvents *[]Event
 }
 
 func NewItem(r *repo.Container, g *gateway.Container) *Item {
@@ -45,8 +51,16 @@ func (i Item) FindByID(ctx context.Context, itemID id.ItemID, _ *usecase.Operato
 	return i.repos.Item.FindByID(ctx, itemID, nil)
 }
 
-func (i Item) FindPublicByID(ctx context.Context, itemID id.ItemID, _ *usecase.Operator) (item.Versioned, error) {
-	return i.repos.Item.FindByID(ctx, itemID, version.Public.Ref())
+func (i Item) FindPublicByID(ctx context.Context, itemID id.ItemID, ref *version.Ref, op *usecase.Operator) (item.Versioned, error) {
+	r := publicRef(ref, op)
+	it, err := i.repos.Item.FindByID(ctx, itemID, r)
+	if err != nil {
+		return nil, err
+	}
+	if isPreviewRef(r, op) && !op.IsReadableProject(it.Value().Project()) {
+		return nil, rerror.ErrNotFound
+	}
+	return it, nil
 }
 
 func (i Item) FindByIDs(ctx context.Context, ids id.ItemIDList, _ *usecase.Operator) (item.VersionedList, error) {
@@ -101,13 +115,38 @@ func (i Item) ItemStatus(ctx context.Context, itemsIds id.ItemIDList, _ *usecase
 	return res, nil
 }
 
-func (i Item) FindPublicByModel(ctx context.Context, modelID id.ModelID, p *usecasex.Pagination, _ *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
+func (i Item) FindPublicByModel(ctx context.Context, modelID id.ModelID, ref *version.Ref, p *usecasex.Pagination, op *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
 	m, err := i.repos.Model.FindByID(ctx, modelID)
 	if err != nil {
 		return nil, nil, err
 	}
+	r := publicRef(ref, op)
+	if isPreviewRef(r, op) && !op.IsReadableProject(m.Project()) {
+		return nil, nil, rerror.ErrNotFound
+	}
 	// TODO: check operation for projects that publication type is limited
-	return i.repos.Item.FindByModel(ctx, m.ID(), version.Public.Ref(), nil, p)
+	return i.repos.Item.FindByModel(ctx, m.ID(), r, nil, p)
+}
+
+// publicRef returns the ref of a release to preview it, a snapshot of the public ref, or the public ref.
+// The latest ref and the refs of requests expose drafts, so they are returned only when the preview token of the operator grants them.
+// Other refs are not exposed to the public.
+func publicRef(ref *version.Ref, op *usecase.Operator) *version.Ref {
+	if ref == nil {
+		return version.Public.Ref()
+	}
+	if r, _, ok := ref.Snapshot(); ok && r == version.Public {
+		return ref
+	}
+	if release.IsRef(*ref) || isPreviewRef(ref, op) {
+		return ref
+	}
+	return version.Public.Ref()
+}
+
+// isPreviewRef reports whether the ref is the draft ref granted by the preview token of the operator.
+func isPreviewRef(ref *version.Ref, op *usecase.Operator) bool {
+	return ref != nil && op != nil && op.PreviewRef != nil && *ref == *op.PreviewRef
 }
 
 func (i Item) FindBySchema(ctx context.Context, schemaID id.SchemaID, sort *usecasex.Sort, p *usecasex.Pagination, _ *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
@@ -138,7 +177,86 @@ func (i Item) FindAllVersionsByID(ctx context.Context, itemID id.ItemID, _ *usec
 	return i.repos.Item.FindAllVersionsByID(ctx, itemID)
 }
 
+// Diff compares the two versions of the item. The metadata item is compared at the same ref when a ref is given.
+func (i Item) Diff(ctx context.Context, itemID id.ItemID, from, to version.VersionOrRef, _ *usecase.Operator) (item.Diff, error) {
+	fromItem, err := i.repos.Item.FindVersionByID(ctx, itemID, from)
+	if err != nil {
+		return item.Diff{}, err
+	}
+	toItem, err := i.repos.Item.FindVersionByID(ctx, itemID, to)
+	if err != nil {
+		return item.Diff{}, err
+	}
+
+	d := item.CompareItems(toItem.Value(), fromItem.Value())
+
+	fromMeta, err := i.metadataVersionOf(ctx, fromItem, from)
+	if err != nil {
+		return item.Diff{}, err
+	}
+	toMeta, err := i.metadataVersionOf(ctx, toItem, to)
+	if err != nil {
+		return item.Diff{}, err
+	}
+	d.MetadataFields = item.CompareFields(metadataFields(toMeta), metadataFields(fromMeta))
+
+	return d, nil
+}
+
+// metadataVersionOf returns the version of the metadata item which was current while the version of the item was current.
+// The metadata item is versioned separately, so its last version saved before the next version of the item is used.
+func (i Item) metadataVersionOf(ctx context.Context, itv item.Versioned, ver version.VersionOrRef) (item.Versioned, error) {
+	mid := itv.Value().MetadataItem()
+	if mid == nil {
+		return nil, nil
+	}
+
+	if isRef := version.MatchVersionOrRef(ver, nil, func(version.Ref) bool { return true }); isRef {
+		mv, err := i.repos.Item.FindVersionByID(ctx, *mid, ver)
+		if errors.Is(err, rerror.ErrNotFound) {
+			return nil, nil
+		}
+		return mv, err
+	}
+
+	versions, err := i.repos.Item.FindAllVersionsByID(ctx, itv.Value().ID())
+	if err != nil {
+		return nil, err
+	}
+	var until *time.Time
+	for _, v := range versions {
+		if t := v.Time(); v.Parents().Has(itv.Version()) && (until == nil || t.Before(*until)) {
+			until = &t
+		}
+	}
+
+	mvs, err := i.repos.Item.FindAllVersionsByID(ctx, *mid)
+	if err != nil {
+		return nil, err
+	}
+	var res item.Versioned
+	for _, mv := range mvs {
+		if until != nil && !mv.Time().Before(*until) {
+			continue
+		}
+		if res == nil || mv.Time().After(res.Time()) {
+			res = mv
+		}
+	}
+	return res, nil
+}
+
+func metadataFields(mv item.Versioned) item.Fields {
+	if mv == nil {
+		return nil
+	}
+	return mv.Value().Fields()
+}
+
 func (i Item) Search(ctx context.Context, sp schema.Package, q *item.Query, p *usecasex.Pagination, _ *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error) {
+	if res, pi, ok, err := i.searchItems(ctx, sp, q, p); ok || err != nil {
+		return res, pi, err
+	}
 	return i.repos.Item.Search(ctx, sp, q, p)
 }
 
@@ -183,127 +301,139 @@ func (i Item) Create(ctx context.Context, param interfaces.CreateItemParam, oper
 	}
 
 	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.Versioned, error) {
-		m, err := i.repos.Model.FindByID(ctx, param.ModelID)
-		if err != nil {
-			return nil, err
-		}
-		//if m.Schema() != param.SchemaID {
-		//	return nil, interfaces.ErrInvalidSchema
-		//}
+		return i.create(ctx, param, operator)
+	})
+}
 
-		s, err := i.repos.Schema.FindByID(ctx, param.SchemaID)
-		if err != nil {
-			return nil, err
-		}
+func (i Item) create(ctx context.Context, param interfaces.CreateItemParam, operator *usecase.Operator) (item.Versioned, error) {
+	m, err := i.repos.Model.FindByID(ctx, param.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	//if m.Schema() != param.SchemaID {
+	//	return nil, interfaces.ErrInvalidSchema
+	//}
 
-		if !operator.IsWritableWorkspace(s.Workspace()) {
-			return nil, interfaces.ErrOperationDenied
-		}
+	s, err := i.repos.Schema.FindByID(ctx, param.SchemaID)
+	if err != nil {
+		return nil, err
+	}
 
-		modelSchemaFields, otherFields := filterFieldParamsBySchema(param.Fields, s)
+	if !operator.IsWritableWorkspace(s.Workspace()) {
+		return nil, interfaces.ErrOperationDenied
+	}
 
-		fields, err := itemFieldsFromParams(modelSchemaFields, s)
-		if err != nil {
-			return nil, err
-		}
+	prj, err := i.repos.Project.FindByID(ctx, s.Project())
+	if err != nil {
+		return nil, err
+	}
 
-		if err := i.checkUnique(ctx, fields, s, m.ID(), nil); err != nil {
-			return nil, err
-		}
+	modelSchemaFields, otherFields := filterFieldParamsBySchema(param.Fields, s)
 
-		groupFields, groupSchemas, err := i.handleGroupFields(ctx, otherFields, s, m.ID(), fields)
-		if err != nil {
-			return nil, err
-		}
+	fields, err := localizedItemFieldsFromParams(modelSchemaFields, s, prj.Locales(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.checkUnique(ctx, fields, s, m.ID(), nil); err != nil {
+		return nil, err
+	}
 
-		isMetadata := m.Metadata() != nil && param.SchemaID == *m.Metadata()
+	groupFields, groupSchemas, err := i.handleGroupFields(ctx, otherFields, s, m.ID(), fields)
+	if err != nil {
+		return nil, err
+	}
 
-		fields = append(fields, groupFields...)
-		ib := item.New().
-			NewID().
-			Schema(s.ID()).
-			IsMetadata(isMetadata).
-			Project(s.Project()).
-			Model(m.ID()).
-			Fields(fields)
+	isMetadata := m.Metadata() != nil && param.SchemaID == *m.Metadata()
 
-		if operator.AcOperator.User != nil {
-			ib = ib.User(*operator.AcOperator.User)
-		}
-		if operator.Integration != nil {
-			ib = ib.Integration(*operator.Integration)
-		}
+	fields = append(fields, groupFields...)
+	ib := item.New().
+		NewID().
+		Schema(s.ID()).
+		IsMetadata(isMetadata).
+		Project(s.Project()).
+		Model(m.ID()).
+		Fields(fields)
 
-		var mi item.Versioned
-		if param.MetadataID != nil {
-			mi, err = i.repos.Item.FindByID(ctx, *param.MetadataID, nil)
-			if err != nil {
-				return nil, err
-			}
-			if m.Metadata() == nil || *m.Metadata() != mi.Value().Schema() {
-				return nil, interfaces.ErrMetadataMismatch
-			}
-			ib = ib.MetadataItem(param.MetadataID)
-		}
+	if operator.AcOperator.User != nil {
+		ib = ib.User(*operator.AcOperator.User)
+	}
+	if operator.Integration != nil {
+		ib = ib.Integration(*operator.Integration)
+	}
 
-		it, err := ib.Build()
+	var mi item.Versioned
+	if param.MetadataID != nil {
+		mi, err = i.repos.Item.FindByID(ctx, *param.MetadataID, nil)
 		if err != nil {
 			return nil, err
 		}
-
-		if err = i.handleReferenceFields(ctx, *s, it, item.Fields{}); err != nil {
-			return nil, err
-		}
-
-		if err := i.repos.Item.Save(ctx, it); err != nil {
-			return nil, err
+		if m.Metadata() == nil || *m.Metadata() != mi.Value().Schema() {
+			return nil, interfaces.ErrMetadataMismatch
 		}
+		ib = ib.MetadataItem(param.MetadataID)
+	}
 
-		if mi != nil {
-			mi.Value().SetOriginalItem(it.ID())
-			if err := i.repos.Item.Save(ctx, mi.Value()); err != nil {
-				return nil, err
-			}
-		}
+	it, err := ib.Build()
+	if err != nil {
+		return nil, err
+	}
 
-		vi, err := i.repos.Item.FindByID(ctx, it.ID(), nil)
-		if err != nil {
-			return nil, err
-		}
+	if err := it.Compute(s, groupSchemas); err != nil {
+		return nil, err
+	}
+	if err := it.ValidateRules(s, groupSchemas); err != nil {
+		return nil, err
+	}
 
-		refItems, err := i.getReferencedItems(ctx, fields)
-		if err != nil {
-			return nil, err
-		}
+	if err = i.handleReferenceFields(ctx, *s, it, item.Fields{}); err != nil {
+		return nil, err
+	}
 
-		if isMetadata {
-			return vi, nil
-		}
+	if err := i.repos.Item.Save(ctx, it); err != nil {
+		return nil, err
+	}
+	i.indexItems(ctx, version.Latest, it)
 
-		prj, err := i.repos.Project.FindByID(ctx, s.Project())
-		if err != nil {
+	if mi != nil {
+		mi.Value().SetOriginalItem(it.ID())
+		if err := i.repos.Item.Save(ctx, mi.Value()); err != nil {
 			return nil, err
 		}
+	}
 
-		if err := i.event(ctx, Event{
-			Project:   prj,
-			Workspace: s.Workspace(),
-			Type:      event.ItemCreate,
-			Object:    vi,
-			WebhookObject: item.ItemModelSchema{
-				Item:            vi.Value(),
-				Model:           m,
-				Schema:          s,
-				GroupSchemas:    groupSchemas,
-				ReferencedItems: refItems,
-			},
-			Operator: operator.Operator(),
-		}); err != nil {
-			return nil, err
-		}
+	vi, err := i.repos.Item.FindByID(ctx, it.ID(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	refItems, err := i.getReferencedItems(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
 
+	if isMetadata {
 		return vi, nil
-	})
+	}
+
+	if err := i.event(ctx, Event{
+		Project:   prj,
+		Workspace: s.Workspace(),
+		Type:      event.ItemCreate,
+		Object:    vi,
+		WebhookObject: item.ItemModelSchema{
+			Item:            vi.Value(),
+			Model:           m,
+			Schema:          s,
+			GroupSchemas:    groupSchemas,
+			ReferencedItems: refItems,
+		},
+		Operator: operator.Operator(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return vi, nil
 }
 
 func (i Item) LastModifiedByModel(ctx context.Context, model id.ModelID, _ *usecase.Operator) (time.Time, error) {
@@ -319,313 +449,590 @@ func (i Item) Update(ctx context.Context, param interfaces.UpdateItemParam, oper
 	}
 
 	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.Versioned, error) {
-		itm, err := i.repos.Item.FindByID(ctx, param.ItemID, nil)
+		return i.update(ctx, param, operator)
+	})
+}
+
+func (i Item) update(ctx context.Context, param interfaces.UpdateItemParam, operator *usecase.Operator) (item.Versioned, error) {
+	itm, err := i.repos.Item.FindByID(ctx, param.ItemID, nil)
+	if err != nil {
+		return nil, err
+	}
+	itv := itm.Value()
+	if !operator.CanUpdate(itv) {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	m, err := i.repos.Model.FindByID(ctx, itv.Model())
+	if err != nil {
+		return nil, err
+	}
+
+	if param.Version != nil && itm.Version() != *param.Version {
+		return nil, interfaces.ErrItemConflicted
+	}
+
+	s, err := i.repos.Schema.FindByID(ctx, itv.Schema())
+	if err != nil {
+		return nil, err
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, s.Project())
+	if err != nil {
+		return nil, err
+	}
+
+	modelSchemaFields, otherFields := filterFieldParamsBySchema(param.Fields, s)
+
+	fields, err := localizedItemFieldsFromParams(modelSchemaFields, s, prj.Locales(), itv)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.checkUnique(ctx, fields, s, itv.Model(), itv); err != nil {
+		return nil, err
+	}
+
+	oldFields := itv.Fields()
+	itv.UpdateFields(fields)
+
+	groupFields, groupSchemas, err := i.handleGroupFields(ctx, otherFields, s, m.ID(), itv.Fields())
+	if err != nil {
+		return nil, err
+	}
+	itv.UpdateFields(groupFields)
+
+	if err := itv.Compute(s, groupSchemas); err != nil {
+		return nil, err
+	}
+	if err := itv.ValidateRules(s, groupSchemas); err != nil {
+		return nil, err
+	}
+
+	if operator.AcOperator.User != nil {
+		itv.SetUpdatedByUser(*operator.AcOperator.User)
+	} else if operator.Integration != nil {
+		itv.SetUpdatedByIntegration(*operator.Integration)
+	}
+
+	var mi item.Versioned
+	if param.MetadataID != nil {
+		mi, err = i.repos.Item.FindByID(ctx, *param.MetadataID, nil)
 		if err != nil {
 			return nil, err
 		}
-		itv := itm.Value()
-		if !operator.CanUpdate(itv) {
-			return nil, interfaces.ErrOperationDenied
+		if m.Metadata() == nil || *m.Metadata() != mi.Value().Schema() {
+			return nil, interfaces.ErrMetadataMismatch
+		}
+		itv.SetMetadataItem(*param.MetadataID)
+		if mi.Value().OriginalItem() == nil {
+			mi.Value().SetOriginalItem(itv.ID())
+			if err = i.repos.Item.Save(ctx, mi.Value()); err != nil {
+				return nil, err
+			}
 		}
+	}
 
-		m, err := i.repos.Model.FindByID(ctx, itv.Model())
-		if err != nil {
-			return nil, err
+	if err := i.repos.Item.Save(ctx, itv); err != nil {
+		return nil, err
+	}
+	i.indexItems(ctx, version.Latest, itv)
+	if err := i.resetRequestReviews(ctx, prj, itv.ID()); err != nil {
+		return nil, err
+	}
+
+	// re-fetch item so the new version is returned
+	itm, err = i.repos.Item.FindByID(ctx, param.ItemID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = i.handleReferenceFields(ctx, *s, itm.Value(), oldFields); err != nil {
+		return nil, err
+	}
+	refItems, err := i.getReferencedItems(ctx, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.event(ctx, Event{
+		Project:   prj,
+		Workspace: s.Workspace(),
+		Type:      event.ItemUpdate,
+		Object:    itm,
+		WebhookObject: item.ItemModelSchema{
+			Item:            itv,
+			Model:           m,
+			Schema:          s,
+			GroupSchemas:    groupSchemas,
+			ReferencedItems: refItems,
+			Changes:         item.CompareFields(itv.Fields(), oldFields),
+		},
+		Operator: operator.Operator(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return itm, nil
+}
+
+// resetRequestReviews clears the reviews of the waiting requests which include the changed item when the approval policy of the project resets them on changes.
+func (i Item) resetRequestReviews(ctx context.Context, prj *project.Project, iid id.ItemID) error {
+	if !prj.ApprovalPolicy().ResetOnChange() {
+		return nil
+	}
+	reqs, err := i.repos.Request.FindByItems(ctx, id.ItemIDList{iid}, &repo.RequestFilter{State: []request.State{request.StateWaiting}})
+	if err != nil {
+		return err
+	}
+	for _, req := range reqs {
+		if len(req.Reviews()) == 0 {
+			continue
+		}
+		req.ResetReviews()
+		req.SetUpdatedAt(util.Now())
+		if err := i.repos.Request.Save(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i Item) Restore(ctx context.Context, itemID id.ItemID, ver version.VersionOrRef, operator *usecase.Operator) (interfaces.RestoreItemResult, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return interfaces.RestoreItemResult{}, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (interfaces.RestoreItemResult, error) {
+		return i.restore(ctx, itemID, ver, operator)
+	})
+}
+
+func (i Item) restore(ctx context.Context, itemID id.ItemID, ver version.VersionOrRef, operator *usecase.Operator) (interfaces.RestoreItemResult, error) {
+	itm, err := i.repos.Item.FindByID(ctx, itemID, nil)
+	if err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+	itv := itm.Value()
+	if !operator.CanUpdate(itv) {
+		return interfaces.RestoreItemResult{}, interfaces.ErrOperationDenied
+	}
+
+	old, err := i.repos.Item.FindVersionByID(ctx, itemID, ver)
+	if err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	m, err := i.repos.Model.FindByID(ctx, itv.Model())
+	if err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	s, err := i.repos.Schema.FindByID(ctx, itv.Schema())
+	if err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	groupSchemas, err := i.groupSchemas(ctx, s)
+	if err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, s.Project())
+	if err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	// the values of the version are checked against the current schema
+	res := interfaces.RestoreItemResult{}
+	var fields, groupFields item.Fields
+	oldFieldIDs := id.FieldIDList{}
+	for _, f := range old.Value().Fields() {
+		oldFieldIDs = oldFieldIDs.Add(f.FieldID())
+
+		var sf *schema.Field
+		if f.ItemGroup() == nil {
+			sf = s.Field(f.FieldID())
+		} else {
+			sf = groupSchemas.Fields().Find(f.FieldID())
+		}
+		if sf == nil {
+			res.RemovedFields = res.RemovedFields.Add(f.FieldID())
+			continue
+		}
+		if sf.Type() != f.Type() {
+			res.TypeChangedFields = res.TypeChangedFields.Add(f.FieldID())
+			continue
+		}
+		if err := sf.Validate(f.Value()); err != nil {
+			return interfaces.RestoreItemResult{}, fmt.Errorf("%w: id=%s key=%s", err, sf.ID(), sf.Name())
+		}
+
+		if f.ItemGroup() == nil {
+			fields = append(fields, f)
+		} else {
+			groupFields = append(groupFields, f)
+		}
+	}
+
+	// fields which existed at the version but had no values are cleared
+	for _, sf := range s.Fields() {
+		if oldFieldIDs.Has(sf.ID()) || itv.Field(sf.ID()) == nil || !sf.CreatedAt().Before(old.Time()) {
+			continue
+		}
+		if err := sf.Validate(nil); err != nil {
+			return interfaces.RestoreItemResult{}, fmt.Errorf("%w: id=%s key=%s", err, sf.ID(), sf.Name())
+		}
+		fields = append(fields, item.NewField(sf.ID(), value.NewMultiple(sf.Type(), nil), nil))
+	}
+
+	if err := i.checkUnique(ctx, fields, s, itv.Model(), itv); err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	oldFields := itv.Fields()
+	itv.UpdateFields(append(fields, groupFields...))
+	// the computed fields are recomputed with the current expressions
+	if err := itv.Compute(s, groupSchemas); err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	if operator.AcOperator.User != nil {
+		itv.SetUpdatedByUser(*operator.AcOperator.User)
+	} else if operator.Integration != nil {
+		itv.SetUpdatedByIntegration(*operator.Integration)
+	}
+
+	if err := i.repos.Item.Save(ctx, itv); err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+	i.indexItems(ctx, version.Latest, itv)
+	if err := i.resetRequestReviews(ctx, prj, itv.ID()); err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	// re-fetch item so the new version is returned
+	itm, err = i.repos.Item.FindByID(ctx, itemID, nil)
+	if err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	if err = i.handleReferenceFields(ctx, *s, itm.Value(), oldFields); err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+	refItems, err := i.getReferencedItems(ctx, fields)
+	if err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	res.Item = itm
+	res.Changes = item.CompareFields(itv.Fields(), oldFields)
+
+	if err := i.event(ctx, Event{
+		Project:   prj,
+		Workspace: s.Workspace(),
+		Type:      event.ItemUpdate,
+		Object:    itm,
+		WebhookObject: item.ItemModelSchema{
+			Item:            itv,
+			Model:           m,
+			Schema:          s,
+			GroupSchemas:    groupSchemas,
+			ReferencedItems: refItems,
+			Changes:         res.Changes,
+		},
+		Operator: operator.Operator(),
+	}); err != nil {
+		return interfaces.RestoreItemResult{}, err
+	}
+
+	return res, nil
+}
+
+// groupSchemas returns the schemas of the groups used by the group fields of the schema.
+func (i Item) groupSchemas(ctx context.Context, s *schema.Schema) (schema.List, error) {
+	gIDs := s.Groups()
+	if len(gIDs) == 0 {
+		return nil, nil
+	}
+	groups, err := i.repos.Group.FindByIDs(ctx, gIDs)
+	if err != nil {
+		return nil, err
+	}
+	return i.repos.Schema.FindByIDs(ctx, groups.SchemaIDs())
+}
+
+func (i Item) Delete(ctx context.Context, itemID id.ItemID, operator *usecase.Operator) error {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return interfaces.ErrInvalidOperator
+	}
+
+	return Run0(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) error {
+		_, _, err := i.delete(ctx, itemID, operator)
+		return err
+	})
+}
+
+func (i Item) delete(ctx context.Context, itemID id.ItemID, operator *usecase.Operator) (item.Versioned, *schema.Schema, error) {
+	itm, err := i.repos.Item.FindByID(ctx, itemID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	s, err := i.repos.Schema.FindByID(ctx, itm.Value().Schema())
+	if err != nil {
+		return nil, nil, err
+	}
+	if !operator.CanUpdate(itm.Value()) {
+		return nil, nil, interfaces.ErrOperationDenied
+	}
+
+	// unlink the items referenced by two-way reference fields while the item itself keeps the references so that they can be re-linked on restore
+	for _, sf := range s.FieldsByType(value.TypeReference) {
+		if err := i.handleReferenceField(ctx, *sf, itemID, nil, itm.Value().Field(sf.ID())); err != nil {
+			return nil, nil, err
+		}
+	}
+	if itm.Value().IsMetadata() {
+		if err := i.repos.Item.Remove(ctx, itemID); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		// the item is moved to the trash together with its metadata item so that it can be restored until it is purged
+		if mid := itm.Value().MetadataItem(); mid != nil {
+			if err := i.repos.Item.Trash(ctx, *mid); err != nil && !errors.Is(err, rerror.ErrNotFound) {
+				return nil, nil, err
+			}
+		}
+		if err := i.repos.Item.Trash(ctx, itemID); err != nil {
+			return nil, nil, err
+		}
+		tb := trash.New().Item(itemID, itm.Value().Model()).Name(lo.FromPtr(itm.Value().GetTitle(s)))
+		if err := saveTrashEntry(ctx, i.repos, tb, s.Workspace(), itm.Value().Project(), operator); err != nil {
+			return nil, nil, err
 		}
+	}
+	i.unindexItems(ctx, nil, itemID)
+	return itm, s, nil
+}
 
-		if param.Version != nil && itm.Version() != *param.Version {
-			return nil, interfaces.ErrItemConflicted
-		}
+// deleteEvent emits the ItemDelete event of the item deleted by a batch.
+func (i Item) deleteEvent(ctx context.Context, itm item.Versioned, s *schema.Schema, operator *usecase.Operator) error {
+	if i.ignoreEvent || itm.Value().IsMetadata() {
+		return nil
+	}
 
-		s, err := i.repos.Schema.FindByID(ctx, itv.Schema())
-		if err != nil {
-			return nil, err
-		}
+	m, err := i.repos.Model.FindByID(ctx, itm.Value().Model())
+	if err != nil {
+		return err
+	}
 
-		modelSchemaFields, otherFields := filterFieldParamsBySchema(param.Fields, s)
+	prj, err := i.repos.Project.FindByID(ctx, s.Project())
+	if err != nil {
+		return err
+	}
 
-		fields, err := itemFieldsFromParams(modelSchemaFields, s)
-		if err != nil {
-			return nil, err
-		}
+	return i.event(ctx, Event{
+		Project:   prj,
+		Workspace: s.Workspace(),
+		Type:      event.ItemDelete,
+		Object:    itm,
+		WebhookObject: item.ItemModelSchema{
+			Item:   itm.Value(),
+			Model:  m,
+			Schema: s,
+		},
+		Operator: operator.Operator(),
+	})
+}
 
-		if err := i.checkUnique(ctx, fields, s, itv.Model(), itv); err != nil {
-			return nil, err
-		}
+func (i Item) Unpublish(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.VersionedList, error) {
+		return i.unpublish(ctx, itemIDs, operator)
+	})
+}
 
-		oldFields := itv.Fields()
-		itv.UpdateFields(fields)
+func (i Item) unpublish(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
+	items, err := i.repos.Item.FindByIDs(ctx, itemIDs, nil)
+	if err != nil {
+		return nil, err
+	}
 
-		groupFields, groupSchemas, err := i.handleGroupFields(ctx, otherFields, s, m.ID(), itv.Fields())
-		if err != nil {
-			return nil, err
-		}
-		itv.UpdateFields(groupFields)
+	// check all items were found
+	if len(items) != len(itemIDs) {
+		return nil, interfaces.ErrItemMissing
+	}
 
-		if operator.AcOperator.User != nil {
-			itv.SetUpdatedByUser(*operator.AcOperator.User)
-		} else if operator.Integration != nil {
-			itv.SetUpdatedByIntegration(*operator.Integration)
-		}
+	// check all items on the same models
+	s := lo.CountBy(items, func(itm item.Versioned) bool {
+		return itm.Value().Model() == items[0].Value().Model()
+	})
+	if s != len(items) {
+		return nil, interfaces.ErrItemsShouldBeOnSameModel
+	}
 
-		var mi item.Versioned
-		if param.MetadataID != nil {
-			mi, err = i.repos.Item.FindByID(ctx, *param.MetadataID, nil)
-			if err != nil {
-				return nil, err
-			}
-			if m.Metadata() == nil || *m.Metadata() != mi.Value().Schema() {
-				return nil, interfaces.ErrMetadataMismatch
-			}
-			itv.SetMetadataItem(*param.MetadataID)
-			if mi.Value().OriginalItem() == nil {
-				mi.Value().SetOriginalItem(itv.ID())
-				if err = i.repos.Item.Save(ctx, mi.Value()); err != nil {
-					return nil, err
-				}
-			}
-		}
+	m, err := i.repos.Model.FindByID(ctx, items[0].Value().Model())
+	if err != nil {
+		return nil, err
+	}
 
-		if err := i.repos.Item.Save(ctx, itv); err != nil {
-			return nil, err
-		}
+	prj, err := i.repos.Project.FindByID(ctx, m.Project())
+	if err != nil {
+		return nil, err
+	}
 
-		// re-fetch item so the new version is returned
-		itm, err = i.repos.Item.FindByID(ctx, param.ItemID, nil)
-		if err != nil {
-			return nil, err
-		}
+	sch, err := i.repos.Schema.FindByID(ctx, m.Schema())
+	if err != nil {
+		return nil, err
+	}
 
-		if err = i.handleReferenceFields(ctx, *s, itm.Value(), oldFields); err != nil {
-			return nil, err
-		}
-		refItems, err := i.getReferencedItems(ctx, fields)
-		if err != nil {
+	if !operator.IsMaintainingWorkspace(prj.Workspace()) {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	// remove public ref from the items
+	for _, itm := range items {
+		if err := i.repos.Item.UpdateRef(ctx, itm.Value().ID(), version.Public, nil); err != nil {
 			return nil, err
 		}
+	}
+	i.unindexItems(ctx, version.Public.Ref(), itemIDs...)
 
-		prj, err := i.repos.Project.FindByID(ctx, s.Project())
+	for _, itm := range items {
+		refItems, err := i.getReferencedItems(ctx, itm.Value().Fields())
 		if err != nil {
 			return nil, err
 		}
-
 		if err := i.event(ctx, Event{
 			Project:   prj,
-			Workspace: s.Workspace(),
-			Type:      event.ItemUpdate,
+			Workspace: prj.Workspace(),
+			Type:      event.ItemUnpublish,
 			Object:    itm,
 			WebhookObject: item.ItemModelSchema{
-				Item:            itv,
+				Item:            itm.Value(),
 				Model:           m,
-				Schema:          s,
-				GroupSchemas:    groupSchemas,
+				Schema:          sch,
 				ReferencedItems: refItems,
-				Changes:         item.CompareFields(itv.Fields(), oldFields),
 			},
 			Operator: operator.Operator(),
 		}); err != nil {
 			return nil, err
 		}
-
-		return itm, nil
-	})
-}
-
-func (i Item) Delete(ctx context.Context, itemID id.ItemID, operator *usecase.Operator) error {
-	if operator.AcOperator.User == nil && operator.Integration == nil {
-		return interfaces.ErrInvalidOperator
 	}
 
-	return Run0(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) error {
-		itm, err := i.repos.Item.FindByID(ctx, itemID, nil)
-		if err != nil {
-			return err
-		}
-		s, err := i.repos.Schema.FindByID(ctx, itm.Value().Schema())
-		if err != nil {
-			return err
-		}
-		if !operator.CanUpdate(itm.Value()) {
-			return interfaces.ErrOperationDenied
-		}
-
-		oldFields := itm.Value().Fields()
-		itm.Value().ClearReferenceFields()
-		if err := i.handleReferenceFields(ctx, *s, itm.Value(), oldFields); err != nil {
-			return err
-		}
-		if itm.Value().MetadataItem() != nil {
-			err = i.repos.Item.Remove(ctx, itemID)
-			if err != nil {
-				return err
-			}
-		}
-		return i.repos.Item.Remove(ctx, itemID)
-	})
+	return items, nil
 }
 
-func (i Item) Unpublish(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
+func (i Item) Publish(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
 	if operator.AcOperator.User == nil && operator.Integration == nil {
 		return nil, interfaces.ErrInvalidOperator
 	}
 	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.VersionedList, error) {
-		items, err := i.repos.Item.FindByIDs(ctx, itemIDs, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// check all items were found
-		if len(items) != len(itemIDs) {
-			return nil, interfaces.ErrItemMissing
-		}
-
-		// check all items on the same models
-		s := lo.CountBy(items, func(itm item.Versioned) bool {
-			return itm.Value().Model() == items[0].Value().Model()
-		})
-		if s != len(items) {
-			return nil, interfaces.ErrItemsShouldBeOnSameModel
-		}
-
-		m, err := i.repos.Model.FindByID(ctx, items[0].Value().Model())
-		if err != nil {
-			return nil, err
-		}
-
-		prj, err := i.repos.Project.FindByID(ctx, m.Project())
-		if err != nil {
-			return nil, err
-		}
+		return i.publish(ctx, itemIDs, operator)
+	})
+}
 
-		sch, err := i.repos.Schema.FindByID(ctx, m.Schema())
-		if err != nil {
-			return nil, err
-		}
+func (i Item) publish(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
+	items, err := i.repos.Item.FindByIDs(ctx, itemIDs, nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if !operator.IsMaintainingWorkspace(prj.Workspace()) {
-			return nil, interfaces.ErrInvalidOperator
-		}
+	// check all items were found
+	if len(items) == 0 || len(items) != len(itemIDs) {
+		return nil, interfaces.ErrItemMissing
+	}
 
-		// remove public ref from the items
-		for _, itm := range items {
-			if err := i.repos.Item.UpdateRef(ctx, itm.Value().ID(), version.Public, nil); err != nil {
-				return nil, err
-			}
-		}
+	m, err := i.repos.Model.FindByID(ctx, items[0].Value().Model())
+	if err != nil {
+		return nil, err
+	}
 
-		for _, itm := range items {
-			refItems, err := i.getReferencedItems(ctx, itm.Value().Fields())
-			if err != nil {
-				return nil, err
-			}
-			if err := i.event(ctx, Event{
-				Project:   prj,
-				Workspace: prj.Workspace(),
-				Type:      event.ItemUnpublish,
-				Object:    itm,
-				WebhookObject: item.ItemModelSchema{
-					Item:            itm.Value(),
-					Model:           m,
-					Schema:          sch,
-					ReferencedItems: refItems,
-				},
-				Operator: operator.Operator(),
-			}); err != nil {
-				return nil, err
-			}
-		}
+	prj, err := i.repos.Project.FindByID(ctx, m.Project())
+	if err != nil {
+		return nil, err
+	}
 
-		return items, nil
-	})
-}
+	sch, err := i.repos.Schema.FindByID(ctx, m.Schema())
+	if err != nil {
+		return nil, err
+	}
 
-func (i Item) Publish(ctx context.Context, itemIDs id.ItemIDList, operator *usecase.Operator) (item.VersionedList, error) {
-	if operator.AcOperator.User == nil && operator.Integration == nil {
+	if !operator.IsMaintainingWorkspace(prj.Workspace()) {
 		return nil, interfaces.ErrInvalidOperator
 	}
-	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.VersionedList, error) {
-		items, err := i.repos.Item.FindByIDs(ctx, itemIDs, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		// check all items were found
-		if len(items) == 0 || len(items) != len(itemIDs) {
-			return nil, interfaces.ErrItemMissing
-		}
 
-		m, err := i.repos.Model.FindByID(ctx, items[0].Value().Model())
-		if err != nil {
+	// add public ref to the items
+	for _, itm := range items {
+		if err := i.repos.Item.UpdateRef(ctx, itm.Value().ID(), version.Public, version.Latest.OrVersion().Ref()); err != nil {
 			return nil, err
 		}
+	}
+	i.indexItems(ctx, version.Public, items.Unwrap()...)
 
-		prj, err := i.repos.Project.FindByID(ctx, m.Project())
+	for _, itm := range items {
+		refItems, err := i.getReferencedItems(ctx, itm.Value().Fields())
 		if err != nil {
 			return nil, err
 		}
 
-		sch, err := i.repos.Schema.FindByID(ctx, m.Schema())
-		if err != nil {
+		if err := i.event(ctx, Event{
+			Project:   prj,
+			Workspace: prj.Workspace(),
+			Type:      event.ItemPublish,
+			Object:    itm,
+			WebhookObject: item.ItemModelSchema{
+				Item:            itm.Value(),
+				Model:           m,
+				Schema:          sch,
+				ReferencedItems: refItems,
+			},
+			Operator: operator.Operator(),
+		}); err != nil {
 			return nil, err
 		}
+	}
 
-		if !operator.IsMaintainingWorkspace(prj.Workspace()) {
-			return nil, interfaces.ErrInvalidOperator
-		}
-
-		// add public ref to the items
-		for _, itm := range items {
-			if err := i.repos.Item.UpdateRef(ctx, itm.Value().ID(), version.Public, version.Latest.OrVersion().Ref()); err != nil {
-				return nil, err
-			}
-		}
-
-		for _, itm := range items {
-			refItems, err := i.getReferencedItems(ctx, itm.Value().Fields())
-			if err != nil {
-				return nil, err
-			}
-
-			if err := i.event(ctx, Event{
-				Project:   prj,
-				Workspace: prj.Workspace(),
-				Type:      event.ItemPublish,
-				Object:    itm,
-				WebhookObject: item.ItemModelSchema{
-					Item:            itm.Value(),
-					Model:           m,
-					Schema:          sch,
-					ReferencedItems: refItems,
-				},
-				Operator: operator.Operator(),
-			}); err != nil {
-				return nil, err
-			}
-		}
-
-		return items, nil
-	})
+	return items, nil
 }
 
 func (i Item) checkUnique(ctx context.Context, itemFields []*item.Field, s *schema.Schema, mid id.ModelID, itm *item.Item) error {
 	var fieldsArg []repo.FieldAndValue
 	for _, f := range itemFields {
-		if itm != nil {
-			oldF := itm.Field(f.FieldID())
-			if oldF != nil && f.Value().Equal(oldF.Value()) {
-				continue
-			}
-		}
-
 		sf := s.Field(f.FieldID())
 		if sf == nil {
 			return interfaces.ErrInvalidField
 		}
 
+		if !sf.Unique() {
+			continue
+		}
+
+		// values must be unique in each locale
+		if sf.Localized() {
+			for l, v := range f.Locales() {
+				if itm != nil && v.Equal(itm.Field(f.FieldID()).LocaleValue(l)) {
+					continue
+				}
+				fieldsArg = append(fieldsArg, repo.FieldAndValue{
+					Field:  f.FieldID(),
+					Value:  v,
+					Locale: l,
+				})
+			}
+		}
+
 		newV := f.Value()
-		if !sf.Unique() || newV.IsEmpty() {
+		if newV.IsEmpty() {
 			continue
 		}
+		if itm != nil {
+			if oldF := itm.Field(f.FieldID()); oldF != nil && newV.Equal(oldF.Value()) {
+				continue
+			}
+		}
 
 		fieldsArg = append(fieldsArg, repo.FieldAndValue{
 			Field: f.FieldID(),
@@ -810,17 +1217,14 @@ func itemFieldsFromParams(fields []interfaces.ItemFieldParam, s *schema.Schema)
 		if sf == nil {
 			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidField, f.Field, f.Key)
 		}
-
-		if !sf.Multiple() {
-			f.Value = []any{f.Value}
+		if sf.IsComputed() {
+			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrComputedFieldNotWritable, sf.ID(), sf.Name())
 		}
 
-		as, ok := f.Value.([]any)
-		if !ok {
-			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidValue, f.Field, f.Key)
+		m, err := multipleFromParam(f, sf)
+		if err != nil {
+			return nil, err
 		}
-
-		m := value.NewMultiple(sf.Type(), as)
 		if err := sf.Validate(m); err != nil {
 			return nil, fmt.Errorf("%w: id=%s key=%s", err, sf.ID(), sf.Name())
 		}
@@ -829,6 +1233,101 @@ func itemFieldsFromParams(fields []interfaces.ItemFieldParam, s *schema.Schema)
 	})
 }
 
+func multipleFromParam(f interfaces.ItemFieldParam, sf *schema.Field) (*value.Multiple, error) {
+	if !sf.Multiple() {
+		f.Value = []any{f.Value}
+	}
+
+	as, ok := f.Value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidValue, f.Field, f.Key)
+	}
+
+	return value.NewMultiple(sf.Type(), as), nil
+}
+
+// localizedItemFieldsFromParams builds the fields like itemFieldsFromParams, and also sets the values of the params for the locales other than the default locale.
+// The locale values that the fields of the item already have are kept unless they are overwritten by the params.
+func localizedItemFieldsFromParams(params []interfaces.ItemFieldParam, s *schema.Schema, ls *locale.Settings, itm *item.Item) (item.Fields, error) {
+	var baseParams, localeParams []interfaces.ItemFieldParam
+	for _, p := range params {
+		if p.Locale == "" || p.Locale == ls.Default() {
+			baseParams = append(baseParams, p)
+			continue
+		}
+		if !ls.Has(p.Locale) {
+			return nil, fmt.Errorf("%w: %s", interfaces.ErrUnknownLocale, p.Locale)
+		}
+		localeParams = append(localeParams, p)
+	}
+
+	fields, err := itemFieldsFromParams(baseParams, s)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldFields item.Fields
+	if itm != nil {
+		oldFields = itm.Fields()
+	}
+	for _, f := range fields {
+		if f.ItemGroup() == nil {
+			copyLocaleValues(f, oldFields.Field(f.FieldID()))
+		}
+	}
+
+	for _, p := range localeParams {
+		sf := s.FieldByIDOrKey(p.Field, p.Key)
+		if sf == nil {
+			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidField, p.Field, p.Key)
+		}
+		if !sf.Localized() {
+			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrFieldNotLocalized, sf.ID(), sf.Name())
+		}
+
+		m, err := multipleFromParam(p, sf)
+		if err != nil {
+			return nil, err
+		}
+		if err := sf.ValidateValue(m); err != nil {
+			return nil, fmt.Errorf("%w: id=%s key=%s locale=%s", err, sf.ID(), sf.Name(), p.Locale)
+		}
+
+		f := fields.Field(sf.ID())
+		if f == nil {
+			if of := oldFields.Field(sf.ID()); of != nil {
+				f = item.NewField(sf.ID(), of.Value(), nil)
+				copyLocaleValues(f, of)
+			} else {
+				f = item.NewField(sf.ID(), value.NewMultiple(sf.Type(), nil), nil)
+			}
+			fields = append(fields, f)
+		}
+		f.SetLocaleValue(p.Locale, m)
+	}
+
+	// required fields need a value for every locale
+	for _, f := range fields {
+		sf := s.Field(f.FieldID())
+		if sf == nil || !sf.Localized() || !sf.Required() {
+			continue
+		}
+		for _, l := range ls.Locales() {
+			if l != ls.Default() && f.LocaleValue(l).IsEmpty() {
+				return nil, fmt.Errorf("%w: id=%s key=%s locale=%s", schema.ErrValueRequired, sf.ID(), sf.Name(), l)
+			}
+		}
+	}
+
+	return fields, nil
+}
+
+func copyLocaleValues(dst, src *item.Field) {
+	for l, v := range src.Locales() {
+		dst.SetLocaleValue(l, v)
+	}
+}
+
 func (i Item) event(ctx context.Context, e Event) error {
 	return i.events(ctx, []Event{e})
 }
@@ -837,6 +1336,10 @@ func (i Item) events(ctx context.Context, e []Event) error {
 	if i.ignoreEvent {
 		return nil
 	}
+	if i.pendingEvents != nil {
+		*i.pendingEvents = append(*i.pendingEvents, e...)
+		return nil
+	}
 
 	_, err := createEvents(ctx, i.repos, i.gateways, e)
 	return err
@@ -860,7 +1363,7 @@ func (i Item) getReferencedItems(ctx context.Context, fields []*item.Field) ([]i
 }
 
 // ItemsAsCSV exports items data in content to csv file by schema package.
-func (i Item) ItemsAsCSV(ctx context.Context, schemaPackage *schema.Package, page *int, perPage *int, operator *usecase.Operator) (interfaces.ExportItemsToCSVResponse, error) {
+func (i Item) ItemsAsCSV(ctx context.Context, schemaPackage *schema.Package, page *int, perPage *int, l *locale.Locale, operator *usecase.Operator) (interfaces.ExportItemsToCSVResponse, error) {
 	if operator.AcOperator.User == nil && operator.Integration == nil {
 		return interfaces.ExportItemsToCSVResponse{}, interfaces.ErrInvalidOperator
 	}
@@ -874,6 +1377,11 @@ func (i Item) ItemsAsCSV(ctx context.Context, schemaPackage *schema.Package, pag
 			return interfaces.ExportItemsToCSVResponse{}, err
 		}
 
+		items, err = i.localize(ctx, items, schemaPackage.Schema(), l)
+		if err != nil {
+			return interfaces.ExportItemsToCSVResponse{}, err
+		}
+
 		pr, pw := io.Pipe()
 		err = csvFromItems(pw, items, schemaPackage.Schema())
 		if err != nil {
@@ -887,7 +1395,7 @@ func (i Item) ItemsAsCSV(ctx context.Context, schemaPackage *schema.Package, pag
 }
 
 // ItemsAsGeoJSON converts items to Geo JSON type given the schema package
-func (i Item) ItemsAsGeoJSON(ctx context.Context, schemaPackage *schema.Package, page *int, perPage *int, operator *usecase.Operator) (interfaces.ExportItemsToGeoJSONResponse, error) {
+func (i Item) ItemsAsGeoJSON(ctx context.Context, schemaPackage *schema.Package, page *int, perPage *int, l *locale.Locale, operator *usecase.Operator) (interfaces.ExportItemsToGeoJSONResponse, error) {
 
 	if operator.AcOperator.User == nil && operator.Integration == nil {
 		return interfaces.ExportItemsToGeoJSONResponse{}, interfaces.ErrInvalidOperator
@@ -903,6 +1411,11 @@ func (i Item) ItemsAsGeoJSON(ctx context.Context, schemaPackage *schema.Package,
 			return interfaces.ExportItemsToGeoJSONResponse{}, err
 		}
 
+		items, err = i.localize(ctx, items, schemaPackage.Schema(), l)
+		if err != nil {
+			return interfaces.ExportItemsToGeoJSONResponse{}, err
+		}
+
 		featureCollections, err := featureCollectionFromItems(items, schemaPackage.Schema())
 		if err != nil {
 			return interfaces.ExportItemsToGeoJSONResponse{}, err
@@ -915,6 +1428,18 @@ func (i Item) ItemsAsGeoJSON(ctx context.Context, schemaPackage *schema.Package,
 	})
 }
 
+// localize resolves the values of localized fields for the locale with the locale settings of the project.
+func (i Item) localize(ctx context.Context, items item.VersionedList, s *schema.Schema, l *locale.Locale) (item.VersionedList, error) {
+	if l == nil {
+		return items, nil
+	}
+	prj, err := i.repos.Project.FindByID(ctx, s.Project())
+	if err != nil {
+		return nil, err
+	}
+	return items.Localize(prj.Locales(), *l), nil
+}
+
 func fromPagination(page, perPage *int) *usecasex.Pagination {
 	p := int64(1)
 	if page != nil && *page > 0 {
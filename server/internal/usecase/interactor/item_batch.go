@@ -0,0 +1,183 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+)
+
+func (i Item) BatchCreate(ctx context.Context, params []interfaces.BatchCreateItemParam, atomic bool, operator *usecase.Operator) ([]interfaces.BatchItemResult, error) {
+	return i.batch(ctx, len(params), atomic, operator, func(i Item, ctx context.Context, k int) (res interfaces.BatchItemResult, err error) {
+		p := params[k]
+		if p.Metadata != nil {
+			res.Metadata, err = i.create(ctx, *p.Metadata, operator)
+			if err != nil {
+				return res, err
+			}
+			p.MetadataID = res.Metadata.Value().ID().Ref()
+		}
+
+		res.Item, err = i.create(ctx, p.CreateItemParam, operator)
+		if err != nil {
+			return res, err
+		}
+		res.ID = res.Item.Value().ID().Ref()
+		return res, nil
+	})
+}
+
+func (i Item) BatchUpdate(ctx context.Context, params []interfaces.BatchUpdateItemParam, atomic bool, operator *usecase.Operator) ([]interfaces.BatchItemResult, error) {
+	return i.batch(ctx, len(params), atomic, operator, func(i Item, ctx context.Context, k int) (res interfaces.BatchItemResult, err error) {
+		p := params[k]
+		res.ID = p.ItemID.Ref()
+		if len(p.Fields) == 0 && p.MetadataFields == nil {
+			return res, interfaces.ErrItemFieldRequired
+		}
+
+		if p.MetadataFields != nil {
+			itm, err := i.repos.Item.FindByID(ctx, p.ItemID, nil)
+			if err != nil {
+				return res, err
+			}
+			if !operator.CanUpdate(itm.Value()) {
+				return res, interfaces.ErrOperationDenied
+			}
+
+			if mid := itm.Value().MetadataItem(); mid != nil {
+				res.Metadata, err = i.update(ctx, interfaces.UpdateItemParam{ItemID: *mid, Fields: p.MetadataFields}, operator)
+				if err != nil {
+					return res, err
+				}
+			} else {
+				m, err := i.repos.Model.FindByID(ctx, itm.Value().Model())
+				if err != nil {
+					return res, err
+				}
+				if m.Metadata() == nil {
+					return res, interfaces.ErrMetadataMismatch
+				}
+				res.Metadata, err = i.create(ctx, interfaces.CreateItemParam{SchemaID: *m.Metadata(), ModelID: m.ID(), Fields: p.MetadataFields}, operator)
+				if err != nil {
+					return res, err
+				}
+			}
+			p.MetadataID = res.Metadata.Value().ID().Ref()
+
+			if len(p.Fields) == 0 && itm.Value().MetadataItem() == nil {
+				// link the new metadata item to the item without changing its fields
+				itm.Value().SetMetadataItem(*p.MetadataID)
+				if err := i.repos.Item.Save(ctx, itm.Value()); err != nil {
+					return res, err
+				}
+			}
+		}
+
+		if len(p.Fields) > 0 {
+			res.Item, err = i.update(ctx, p.UpdateItemParam, operator)
+		} else {
+			res.Item, err = i.repos.Item.FindByID(ctx, p.ItemID, nil)
+		}
+		return res, err
+	})
+}
+
+func (i Item) BatchDelete(ctx context.Context, ids id.ItemIDList, atomic bool, operator *usecase.Operator) ([]interfaces.BatchItemResult, error) {
+	return i.batch(ctx, len(ids), atomic, operator, func(i Item, ctx context.Context, k int) (interfaces.BatchItemResult, error) {
+		res := interfaces.BatchItemResult{ID: ids[k].Ref()}
+		itm, s, err := i.delete(ctx, ids[k], operator)
+		if err != nil {
+			return res, err
+		}
+		return res, i.deleteEvent(ctx, itm, s, operator)
+	})
+}
+
+func (i Item) BatchPublish(ctx context.Context, ids id.ItemIDList, atomic bool, operator *usecase.Operator) ([]interfaces.BatchItemResult, error) {
+	return i.batch(ctx, len(ids), atomic, operator, func(i Item, ctx context.Context, k int) (interfaces.BatchItemResult, error) {
+		res := interfaces.BatchItemResult{ID: ids[k].Ref()}
+		items, err := i.publish(ctx, id.ItemIDList{ids[k]}, operator)
+		if err != nil {
+			return res, err
+		}
+		res.Item = items[0]
+		return res, nil
+	})
+}
+
+// batch runs f for each item of a batch.
+// Each item is processed in its own transaction, or all the items are processed in one transaction when atomic is true.
+func (i Item) batch(ctx context.Context, n int, atomic bool, operator *usecase.Operator, f func(Item, context.Context, int) (interfaces.BatchItemResult, error)) ([]interfaces.BatchItemResult, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if n == 0 {
+		return nil, interfaces.ErrEmptyBatch
+	}
+	if n > interfaces.MaxBatchItems {
+		return nil, interfaces.ErrTooManyItemsInBatch
+	}
+
+	results := make([]interfaces.BatchItemResult, n)
+
+	if !atomic {
+		for k := range results {
+			err := Run0(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (err error) {
+				results[k], err = f(i, ctx, k)
+				return
+			})
+			results[k].Err = err
+		}
+		return results, nil
+	}
+
+	var events []Event
+	err := Run0(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) error {
+		events = nil
+		bi := i
+		bi.pendingEvents = &events
+
+		for k := range results {
+			res, err := f(bi, ctx, k)
+			if err != nil {
+				results[k] = interfaces.BatchItemResult{ID: res.ID, Err: err}
+				for l := range results {
+					if l != k {
+						results[l] = interfaces.BatchItemResult{ID: lo.Ternary(l < k, results[l].ID, nil), Err: interfaces.ErrBatchAborted}
+					}
+				}
+				return err
+			}
+			results[k] = res
+		}
+		return nil
+	})
+	if err != nil {
+		return results, err
+	}
+
+	// events are emitted only after all the changes are committed
+	return results, i.emitPendingEvents(ctx, events)
+}
+
+func (i Item) emitPendingEvents(ctx context.Context, events []Event) error {
+	// webhooks are sent per workspace
+	var workspaces []accountdomain.WorkspaceID
+	groups := map[accountdomain.WorkspaceID][]Event{}
+	for _, e := range events {
+		if _, ok := groups[e.Workspace]; !ok {
+			workspaces = append(workspaces, e.Workspace)
+		}
+		groups[e.Workspace] = append(groups[e.Workspace], e)
+	}
+
+	for _, w := range workspaces {
+		if _, err := createEvents(ctx, i.repos, i.gateways, groups[w]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_BatchCreate(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf := schema.NewField(schema.NewText(lo.ToPtr(10)).TypeProperty()).NewID().Name("f").Unique(true).Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(wid, prj.ID())
+
+	param := func(v string) interfaces.BatchCreateItemParam {
+		return interfaces.BatchCreateItemParam{
+			CreateItemParam: interfaces.CreateItemParam{
+				SchemaID: s.ID(),
+				ModelID:  m.ID(),
+				Fields:   []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: v}},
+			},
+		}
+	}
+
+	// empty
+	res, err := itemUC.BatchCreate(ctx, nil, false, op)
+	assert.Equal(t, interfaces.ErrEmptyBatch, err)
+	assert.Nil(t, res)
+
+	// too many items
+	res, err = itemUC.BatchCreate(ctx, make([]interfaces.BatchCreateItemParam, interfaces.MaxBatchItems+1), false, op)
+	assert.Equal(t, interfaces.ErrTooManyItemsInBatch, err)
+	assert.Nil(t, res)
+
+	// invalid operator
+	res, err = itemUC.BatchCreate(ctx, []interfaces.BatchCreateItemParam{param("a")}, false, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+	assert.Nil(t, res)
+
+	// non-atomic: the duplicated value fails alone
+	res, err = itemUC.BatchCreate(ctx, []interfaces.BatchCreateItemParam{param("a"), param("a"), param("b")}, false, op)
+	assert.NoError(t, err)
+	assert.Len(t, res, 3)
+	assert.NoError(t, res[0].Err)
+	assert.Equal(t, value.TypeText.Value("a").AsMultiple(), res[0].Item.Value().Field(sf.ID()).Value())
+	assert.Equal(t, res[0].Item.Value().ID().Ref(), res[0].ID)
+	assert.Error(t, res[1].Err)
+	assert.Nil(t, res[1].Item)
+	assert.NoError(t, res[2].Err)
+
+	// atomic: the failed item aborts the others
+	res, err = itemUC.BatchCreate(ctx, []interfaces.BatchCreateItemParam{param("c"), param("a"), param("d")}, true, op)
+	assert.Error(t, err)
+	assert.Len(t, res, 3)
+	assert.Equal(t, interfaces.ErrBatchAborted, res[0].Err)
+	assert.Nil(t, res[0].Item)
+	assert.Equal(t, err, res[1].Err)
+	assert.Equal(t, interfaces.ErrBatchAborted, res[2].Err)
+
+	// atomic: ok
+	res, err = itemUC.BatchCreate(ctx, []interfaces.BatchCreateItemParam{param("e"), param("f")}, true, op)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	for _, r := range res {
+		assert.NoError(t, r.Err)
+		assert.NotNil(t, r.Item)
+	}
+}
+
+func TestItem_BatchUpdate(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf := schema.NewField(schema.NewText(lo.ToPtr(10)).TypeProperty()).NewID().Name("f").Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	msf := schema.NewField(schema.NewBool().TypeProperty()).NewID().Name("m").Key(id.RandomKey()).MustBuild()
+	ms := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{msf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Metadata(ms.ID().Ref()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Schema.Save(ctx, ms))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(wid, prj.ID())
+
+	created, err := itemUC.BatchCreate(ctx, []interfaces.BatchCreateItemParam{
+		{CreateItemParam: interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "a"}}}},
+		{CreateItemParam: interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "b"}}}},
+	}, true, op)
+	assert.NoError(t, err)
+	i1, i2 := *created[0].ID, *created[1].ID
+
+	res, err := itemUC.BatchUpdate(ctx, []interfaces.BatchUpdateItemParam{
+		{UpdateItemParam: interfaces.UpdateItemParam{ItemID: i1, Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "x"}}}},
+		{UpdateItemParam: interfaces.UpdateItemParam{ItemID: i2}, MetadataFields: []interfaces.ItemFieldParam{{Field: msf.ID().Ref(), Value: true}}},
+		{UpdateItemParam: interfaces.UpdateItemParam{ItemID: id.NewItemID(), Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "y"}}}},
+		{UpdateItemParam: interfaces.UpdateItemParam{ItemID: i1}},
+	}, false, op)
+	assert.NoError(t, err)
+	assert.Len(t, res, 4)
+
+	assert.NoError(t, res[0].Err)
+	assert.Equal(t, value.TypeText.Value("x").AsMultiple(), res[0].Item.Value().Field(sf.ID()).Value())
+
+	// the metadata item is created and linked to the item
+	assert.NoError(t, res[1].Err)
+	assert.NotNil(t, res[1].Metadata)
+	assert.Equal(t, res[1].Metadata.Value().ID().Ref(), res[1].Item.Value().MetadataItem())
+	it2, err := db.Item.FindByID(ctx, i2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, res[1].Metadata.Value().ID().Ref(), it2.Value().MetadataItem())
+
+	assert.ErrorIs(t, res[2].Err, rerror.ErrNotFound)
+	assert.Equal(t, interfaces.ErrItemFieldRequired, res[3].Err)
+}
+
+func TestItem_BatchDeleteAndPublish(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf := schema.NewField(schema.NewText(lo.ToPtr(10)).TypeProperty()).NewID().Name("f").Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(wid, prj.ID())
+
+	created, err := itemUC.BatchCreate(ctx, []interfaces.BatchCreateItemParam{
+		{CreateItemParam: interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "a"}}}},
+		{CreateItemParam: interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "b"}}}},
+	}, true, op)
+	assert.NoError(t, err)
+	ids := id.ItemIDList{*created[0].ID, *created[1].ID}
+
+	// publish
+	res, err := itemUC.BatchPublish(ctx, ids, true, op)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	for k, r := range res {
+		assert.NoError(t, r.Err)
+		assert.Equal(t, ids[k], r.Item.Value().ID())
+		pub, err := db.Item.FindByID(ctx, ids[k], version.Public.Ref())
+		assert.NoError(t, err)
+		assert.Equal(t, ids[k], pub.Value().ID())
+	}
+
+	// atomic delete with a missing item
+	res, err = itemUC.BatchDelete(ctx, id.ItemIDList{id.NewItemID(), ids[0]}, true, op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	assert.ErrorIs(t, res[0].Err, rerror.ErrNotFound)
+	assert.Equal(t, interfaces.ErrBatchAborted, res[1].Err)
+
+	// delete
+	res, err = itemUC.BatchDelete(ctx, ids, false, op)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	for k, r := range res {
+		assert.NoError(t, r.Err)
+		assert.Equal(t, ids[k].Ref(), r.ID)
+		_, err := db.Item.FindByID(ctx, ids[k], nil)
+		assert.ErrorIs(t, err, rerror.ErrNotFound)
+	}
+}
+
+func batchTestOperator(wid accountdomain.WorkspaceID, pid id.ProjectID) *usecase.Operator {
+	return &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:                   accountdomain.NewUserID().Ref(),
+			ReadableWorkspaces:     []accountdomain.WorkspaceID{wid},
+			WritableWorkspaces:     []accountdomain.WorkspaceID{wid},
+			MaintainableWorkspaces: []accountdomain.WorkspaceID{wid},
+		},
+		ReadableProjects:     []id.ProjectID{pid},
+		WritableProjects:     []id.ProjectID{pid},
+		MaintainableProjects: []id.ProjectID{pid},
+	}
+}
@@ -0,0 +1,181 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+func (i Item) CreateFromTemplate(ctx context.Context, param interfaces.CreateItemFromTemplateParam, operator *usecase.Operator) (item.Versioned, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.Versioned, error) {
+		t, err := i.repos.ItemTemplate.FindByID(ctx, param.TemplateID)
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := i.repos.Model.FindByID(ctx, t.Model())
+		if err != nil {
+			return nil, err
+		}
+
+		fields, metadataFields := t.Values()
+		return i.createCopy(ctx, m, fields, metadataFields, param.Fields, param.MetadataFields, operator)
+	})
+}
+
+func (i Item) Duplicate(ctx context.Context, itemID id.ItemID, operator *usecase.Operator) (item.Versioned, error) {
+	if operator.AcOperator.User == nil && operator.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, i.repos, Usecase().Transaction(), func(ctx context.Context) (item.Versioned, error) {
+		itm, err := i.repos.Item.FindByID(ctx, itemID, nil)
+		if err != nil {
+			return nil, err
+		}
+		itv := itm.Value()
+		if itv.IsMetadata() {
+			return nil, interfaces.ErrCannotDuplicateMetadata
+		}
+
+		m, err := i.repos.Model.FindByID(ctx, itv.Model())
+		if err != nil {
+			return nil, err
+		}
+
+		var metadataFields item.Fields
+		if mid := itv.MetadataItem(); mid != nil {
+			mi, err := i.repos.Item.FindByID(ctx, *mid, nil)
+			if err != nil {
+				return nil, err
+			}
+			metadataFields = mi.Value().Fields()
+		}
+
+		return i.createCopy(ctx, m, itv.Fields().Copy(), metadataFields.Copy(), nil, nil, operator)
+	})
+}
+
+// createCopy creates a new item of the model, and its metadata item when there are metadata values, from the copied values overwritten by the params.
+func (i Item) createCopy(ctx context.Context, m *model.Model, fields, metadataFields item.Fields, params, metadataParams []interfaces.ItemFieldParam, operator *usecase.Operator) (item.Versioned, error) {
+	s, err := i.repos.Schema.FindByID(ctx, m.Schema())
+	if err != nil {
+		return nil, err
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, s.Project())
+	if err != nil {
+		return nil, err
+	}
+
+	groupSchemas, err := i.groupSchemas(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+
+	var metadataID *item.ID
+	if m.Metadata() != nil {
+		ms, err := i.repos.Schema.FindByID(ctx, *m.Metadata())
+		if err != nil {
+			return nil, err
+		}
+		metadataGroupSchemas, err := i.groupSchemas(ctx, ms)
+		if err != nil {
+			return nil, err
+		}
+
+		mp := overrideFieldParams(copiedFieldParams(metadataFields, ms, metadataGroupSchemas), metadataParams, ms, metadataGroupSchemas, prj.Locales())
+		if len(mp) > 0 {
+			mi, err := i.create(ctx, interfaces.CreateItemParam{SchemaID: ms.ID(), ModelID: m.ID(), Fields: mp}, operator)
+			if err != nil {
+				return nil, err
+			}
+			metadataID = mi.Value().ID().Ref()
+		}
+	} else if len(metadataParams) > 0 {
+		return nil, interfaces.ErrMetadataMismatch
+	}
+
+	return i.create(ctx, interfaces.CreateItemParam{
+		SchemaID:   s.ID(),
+		ModelID:    m.ID(),
+		MetadataID: metadataID,
+		Fields:     overrideFieldParams(copiedFieldParams(fields, s, groupSchemas), params, s, groupSchemas, prj.Locales()),
+	}, operator)
+}
+
+// copiedFieldParams converts the fields to the params to create another item with the same values.
+// The fields which can not be copied to another item are skipped: the fields removed from the schema or whose types were changed,
+// the computed fields, the unique fields, and the two-way reference fields whose referenced items can refer to only one item.
+func copiedFieldParams(fields item.Fields, s *schema.Schema, groupSchemas schema.List) []interfaces.ItemFieldParam {
+	var res []interfaces.ItemFieldParam
+	for _, f := range fields {
+		sf := paramField(interfaces.ItemFieldParam{Field: f.FieldID().Ref(), Group: f.ItemGroup()}, s, groupSchemas)
+		if sf == nil || sf.Type() != f.Type() || sf.IsComputed() || sf.Unique() {
+			continue
+		}
+		if fr, ok := schema.FieldReferenceFromTypeProperty(sf.TypeProperty()); ok && fr.IsTowWay() {
+			continue
+		}
+
+		if !f.Value().IsEmpty() {
+			res = append(res, interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: paramValue(sf, f.Value()), Group: f.ItemGroup()})
+		}
+		for l, v := range f.Locales() {
+			res = append(res, interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: paramValue(sf, v), Group: f.ItemGroup(), Locale: l})
+		}
+	}
+	return res
+}
+
+// overrideFieldParams replaces the params with the overrides which set the same field of the same group instance in the same locale.
+func overrideFieldParams(params, overrides []interfaces.ItemFieldParam, s *schema.Schema, groupSchemas schema.List, ls *locale.Settings) []interfaces.ItemFieldParam {
+	if len(overrides) == 0 {
+		return params
+	}
+
+	sameTarget := func(p, o interfaces.ItemFieldParam) bool {
+		pf := paramField(p, s, groupSchemas)
+		of := paramField(o, s, groupSchemas)
+		return pf != nil && of != nil && pf.ID() == of.ID() &&
+			lo.FromPtr(p.Group) == lo.FromPtr(o.Group) &&
+			(ls.IsDefault(p.Locale) && ls.IsDefault(o.Locale) || p.Locale == o.Locale)
+	}
+
+	res := lo.Reject(params, func(p interfaces.ItemFieldParam, _ int) bool {
+		return lo.ContainsBy(overrides, func(o interfaces.ItemFieldParam) bool { return sameTarget(p, o) })
+	})
+	return append(res, overrides...)
+}
+
+// paramField returns the field which the param sets from the schema, or from the group schemas when the param sets a field of a group instance.
+func paramField(p interfaces.ItemFieldParam, s *schema.Schema, groupSchemas schema.List) *schema.Field {
+	if p.Group == nil {
+		return s.FieldByIDOrKey(p.Field, p.Key)
+	}
+	for _, gs := range groupSchemas {
+		if f := gs.FieldByIDOrKey(p.Field, p.Key); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+func paramValue(sf *schema.Field, m *value.Multiple) any {
+	if sf.Multiple() {
+		return m.Interface()
+	}
+	return m.First().Interface()
+}
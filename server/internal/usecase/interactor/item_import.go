@@ -14,6 +14,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/utils"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
@@ -327,9 +328,12 @@ func (i Item) saveChunk(ctx context.Context, prj *project.Project, m *model.Mode
 				itemsToSave = append(itemsToSave, mi.Value())
 			}
 
-			modelSchemaFields, otherFields := filterFieldParamsBySchema(itemParam.Fields, s)
+			// the values of the computed fields in the imported data are ignored as they are recomputed
+			modelSchemaFields, otherFields := filterFieldParamsBySchema(lo.Reject(itemParam.Fields, func(p interfaces.ItemFieldParam, _ int) bool {
+				return s.FieldByIDOrKey(p.Field, p.Key).IsComputed()
+			}), s)
 
-			fields, err := itemFieldsFromParams(modelSchemaFields, s)
+			fields, err := localizedItemFieldsFromParams(modelSchemaFields, s, prj.Locales(), it)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -341,13 +345,20 @@ func (i Item) saveChunk(ctx context.Context, prj *project.Project, m *model.Mode
 			oldFields := it.Fields()
 			it.UpdateFields(fields)
 
-			groupFields, _, err := i.handleGroupFields(ctx, otherFields, s, m.ID(), it.Fields())
+			groupFields, groupSchemas, err := i.handleGroupFields(ctx, otherFields, s, m.ID(), it.Fields())
 			if err != nil {
 				return nil, nil, err
 			}
 
 			it.UpdateFields(groupFields)
 
+			if err := it.Compute(s, groupSchemas); err != nil {
+				return nil, nil, err
+			}
+			if err := it.ValidateRules(s, groupSchemas); err != nil {
+				return nil, nil, err
+			}
+
 			if err = i.handleReferenceFields(ctx, *s, it, oldFields); err != nil {
 				return nil, nil, err
 			}
@@ -371,6 +382,7 @@ func (i Item) saveChunk(ctx context.Context, prj *project.Project, m *model.Mode
 		if err := i.repos.Item.SaveAll(ctx, itemsToSave); err != nil {
 			return nil, nil, err
 		}
+		i.indexItems(ctx, version.Latest, itemsToSave...)
 		return itemsToSave, itemsEvent, nil
 	}
 
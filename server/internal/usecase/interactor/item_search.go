@@ -0,0 +1,194 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+const reindexPageSize = 100
+
+// searchItems finds a page of the items matching the keyword of the query with the search index ordered by relevance.
+// The search index paginates the hits, so only a query without filters and sorts and with an offset or a first page is handled.
+// It returns false when the query cannot be handled by the search index and the keyword should be searched in the database.
+func (i Item) searchItems(ctx context.Context, sp schema.Package, q *item.Query, p *usecasex.Pagination) (item.VersionedList, *usecasex.PageInfo, bool, error) {
+	// staging and snapshot refs are not indexed
+	if ref := q.Ref().OrLatest(); i.gateways == nil || i.gateways.ItemSearch == nil || q.Keyword() == "" || ref.IsStaging() || ref.IsSnapshot() {
+		return nil, nil, false, nil
+	}
+	if q.Filter() != nil || q.Sort() != nil {
+		return nil, nil, false, nil
+	}
+	offset, limit, ok := searchPage(p)
+	if !ok {
+		return nil, nil, false, nil
+	}
+
+	hits, total, err := i.gateways.ItemSearch.Search(ctx, gateway.ItemSearchQuery{
+		Project: q.Project(),
+		Model:   q.Model(),
+		Schema:  q.Schema(),
+		Ref:     *q.Ref().OrLatest(),
+		Keyword: q.Keyword(),
+		Offset:  offset,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	var items item.VersionedList
+	if len(hits) > 0 {
+		ids := lo.Map(hits, func(h gateway.ItemSearchHit, _ int) id.ItemID { return h.ID })
+		items, _, err = i.repos.Item.Search(ctx, sp, q.WithIDs(ids), nil)
+		if err != nil {
+			return nil, nil, false, err
+		}
+	}
+	return items, usecasex.NewPageInfo(total, nil, nil, offset+int64(len(hits)) < total, offset > 0), true, nil
+}
+
+// searchPage returns the offset and the limit of the pagination. The limit is zero when all hits are requested.
+func searchPage(p *usecasex.Pagination) (int64, int64, bool) {
+	switch {
+	case p == nil:
+		return 0, 0, true
+	case p.Offset != nil:
+		return p.Offset.Offset, p.Offset.Limit, true
+	case p.Cursor != nil && p.Cursor.After == nil && p.Cursor.Before == nil && p.Cursor.Last == nil:
+		return 0, lo.FromPtr(p.Cursor.First), true
+	}
+	return 0, 0, false
+}
+
+// indexItems adds the items to the search index at the ref after the transaction of the context is committed.
+// It does nothing when the search index is not configured.
+func indexItems(ctx context.Context, r *repo.Container, g *gateway.Container, ref version.Ref, items ...*item.Item) {
+	items = lo.Filter(items, func(it *item.Item, _ int) bool { return it != nil })
+	if g == nil || g.ItemSearch == nil || len(items) == 0 {
+		return
+	}
+	afterCommit(ctx, func(ctx context.Context) {
+		// the index is repaired by reindexing the model when it fails
+		if err := indexItemsNow(ctx, r, g, ref, items...); err != nil {
+			log.Errorfc(ctx, "search: failed to index items: %v", err)
+		}
+	})
+}
+
+func indexItemsNow(ctx context.Context, r *repo.Container, g *gateway.Container, ref version.Ref, items ...*item.Item) error {
+	schemas, err := r.Schema.FindByIDs(ctx, lo.Uniq(lo.Map(items, func(it *item.Item, _ int) id.SchemaID { return it.Schema() })))
+	if err != nil {
+		return err
+	}
+
+	docs := lo.Map(items, func(it *item.Item, _ int) gateway.ItemSearchDocument {
+		var title *id.FieldID
+		if s := schemas.Schema(it.Schema().Ref()); s != nil {
+			title = s.TitleField()
+		}
+		return gateway.ItemSearchDocument{
+			ID:      it.ID(),
+			Project: it.Project(),
+			Model:   it.Model(),
+			Schema:  it.Schema(),
+			Ref:     ref,
+			Terms:   it.SearchTerms(title),
+		}
+	})
+	return g.ItemSearch.Index(ctx, docs)
+}
+
+// unindexItems removes the items from the search index at the ref, or at all refs when the ref is nil, after the transaction of the context is committed.
+func unindexItems(ctx context.Context, g *gateway.Container, ref *version.Ref, ids ...id.ItemID) {
+	if g == nil || g.ItemSearch == nil || len(ids) == 0 {
+		return
+	}
+	afterCommit(ctx, func(ctx context.Context) {
+		if err := g.ItemSearch.Delete(ctx, ids, ref); err != nil {
+			log.Errorfc(ctx, "search: failed to unindex items: %v", err)
+		}
+	})
+}
+
+// unindexModel removes all items of the model from the search index after the transaction of the context is committed.
+func unindexModel(ctx context.Context, g *gateway.Container, mid id.ModelID) {
+	if g == nil || g.ItemSearch == nil {
+		return
+	}
+	afterCommit(ctx, func(ctx context.Context) {
+		if err := g.ItemSearch.DeleteByModel(ctx, mid); err != nil {
+			log.Errorfc(ctx, "search: failed to unindex model %s: %v", mid, err)
+		}
+	})
+}
+
+func (i Item) indexItems(ctx context.Context, ref version.Ref, items ...*item.Item) {
+	indexItems(ctx, i.repos, i.gateways, ref, items...)
+}
+
+func (i Item) unindexItems(ctx context.Context, ref *version.Ref, ids ...id.ItemID) {
+	unindexItems(ctx, i.gateways, ref, ids...)
+}
+
+func (i Item) Reindex(ctx context.Context, modelID id.ModelID, operator *usecase.Operator) (int, error) {
+	if i.gateways == nil || i.gateways.ItemSearch == nil {
+		return 0, interfaces.ErrSearchIndexNotConfigured
+	}
+
+	m, err := i.repos.Model.FindByID(ctx, modelID)
+	if err != nil {
+		return 0, err
+	}
+	if !operator.IsMaintainingProject(m.Project()) {
+		return 0, interfaces.ErrOperationDenied
+	}
+
+	if err := i.gateways.ItemSearch.DeleteByModel(ctx, modelID); err != nil {
+		return 0, err
+	}
+
+	return indexModel(ctx, i.repos, i.gateways, modelID)
+}
+
+// indexModel adds the latest and the public versions of all items of the model to the search index.
+// It returns the number of the indexed items.
+func indexModel(ctx context.Context, r *repo.Container, g *gateway.Container, modelID id.ModelID) (int, error) {
+	if g == nil || g.ItemSearch == nil {
+		return 0, nil
+	}
+
+	count := 0
+	for _, ref := range []version.Ref{version.Latest, version.Public} {
+		var cur *usecasex.Cursor
+		for {
+			items, pi, err := r.Item.FindByModel(ctx, modelID, ref.Ref(), nil, usecasex.CursorPagination{
+				After: cur,
+				First: lo.ToPtr(int64(reindexPageSize)),
+			}.Wrap())
+			if err != nil {
+				return count, err
+			}
+			if err := indexItemsNow(ctx, r, g, ref, items.Unwrap()...); err != nil {
+				return count, err
+			}
+			count += len(items)
+
+			if pi == nil || !pi.HasNextPage {
+				break
+			}
+			cur = pi.EndCursor
+		}
+	}
+	return count, nil
+}
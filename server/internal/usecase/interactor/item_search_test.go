@@ -0,0 +1,107 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_Search_Index(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("title").Key(id.RandomKey()).MustBuild()
+	sf2 := schema.NewField(schema.NewTextArea(nil).TypeProperty()).NewID().Name("body").Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).TitleField(sf1.ID().Ref()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemSearch := lo.Must(fs.NewItemSearch(afero.NewMemMapFs()))
+	itemUC := NewItem(db, &gateway.Container{ItemSearch: itemSearch})
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(wid, prj.ID())
+
+	create := func(title, body string) item.Versioned {
+		return lo.Must(itemUC.Create(ctx, interfaces.CreateItemParam{
+			SchemaID: s.ID(),
+			ModelID:  m.ID(),
+			Fields: []interfaces.ItemFieldParam{
+				{Field: sf1.ID().Ref(), Value: title},
+				{Field: sf2.ID().Ref(), Value: body},
+			},
+		}, op))
+	}
+	search := func(keyword string, ref *version.Ref) id.ItemIDList {
+		q := item.NewQuery(prj.ID(), m.ID(), s.ID().Ref(), keyword, ref)
+		res, _, err := itemUC.Search(ctx, schema.Package{}, q, usecasex.CursorPagination{First: lo.ToPtr(int64(10))}.Wrap(), op)
+		assert.NoError(t, err)
+		return lo.Map(res, func(i item.Versioned, _ int) id.ItemID { return i.Value().ID() })
+	}
+
+	i1 := create("天気予報", "東京は晴れ")
+	i2 := create("東京タワー", "観光")
+	i3 := create("Osaka", "weather")
+
+	// the title field is ranked higher
+	assert.Equal(t, id.ItemIDList{i2.Value().ID(), i1.Value().ID()}, search("東京", nil))
+	assert.Equal(t, id.ItemIDList{i1.Value().ID()}, search("天気", nil))
+	assert.Equal(t, id.ItemIDList{i3.Value().ID()}, search("WEATHER", nil))
+	assert.Empty(t, search("名古屋", nil))
+
+	// paginated by the search index
+	q := item.NewQuery(prj.ID(), m.ID(), s.ID().Ref(), "東京", nil)
+	res, pi, err := itemUC.Search(ctx, schema.Package{}, q, usecasex.OffsetPagination{Offset: 1, Limit: 1}.Wrap(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, []id.ItemID{i1.Value().ID()}, lo.Map(res, func(i item.Versioned, _ int) id.ItemID { return i.Value().ID() }))
+	assert.Equal(t, int64(2), pi.TotalCount)
+	assert.False(t, pi.HasNextPage)
+	assert.True(t, pi.HasPreviousPage)
+
+	// update
+	lo.Must(itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: i3.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{{Field: sf1.ID().Ref(), Value: "名古屋"}},
+	}, op))
+	assert.Equal(t, id.ItemIDList{i3.Value().ID()}, search("名古屋", nil))
+
+	// publish and unpublish
+	assert.Empty(t, search("東京", version.Public.Ref()))
+	lo.Must(itemUC.Publish(ctx, id.ItemIDList{i2.Value().ID()}, op))
+	assert.Equal(t, id.ItemIDList{i2.Value().ID()}, search("東京", version.Public.Ref()))
+	lo.Must(itemUC.Unpublish(ctx, id.ItemIDList{i2.Value().ID()}, op))
+	assert.Empty(t, search("東京", version.Public.Ref()))
+
+	// delete
+	assert.NoError(t, itemUC.Delete(ctx, i2.Value().ID(), op))
+	assert.Equal(t, id.ItemIDList{i1.Value().ID()}, search("東京", nil))
+
+	// reindex
+	assert.NoError(t, itemSearch.DeleteByModel(ctx, m.ID()))
+	assert.Empty(t, search("東京", nil))
+	n, err := itemUC.Reindex(ctx, m.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, id.ItemIDList{i1.Value().ID()}, search("東京", nil))
+
+	// without the search index
+	_, err = NewItem(db, nil).Reindex(ctx, m.ID(), op)
+	assert.Equal(t, interfaces.ErrSearchIndexNotConfigured, err)
+}
@@ -0,0 +1,282 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type ItemTemplate struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+	item     *Item
+}
+
+func NewItemTemplate(r *repo.Container, g *gateway.Container) interfaces.ItemTemplate {
+	return &ItemTemplate{
+		repos:    r,
+		gateways: g,
+		item:     NewItem(r, g),
+	}
+}
+
+func (i ItemTemplate) FindByID(ctx context.Context, tid id.ItemTemplateID, _ *usecase.Operator) (*template.Template, error) {
+	return i.repos.ItemTemplate.FindByID(ctx, tid)
+}
+
+func (i ItemTemplate) FindByModel(ctx context.Context, mid id.ModelID, _ *usecase.Operator) (template.List, error) {
+	return i.repos.ItemTemplate.FindByModel(ctx, mid)
+}
+
+func (i ItemTemplate) Create(ctx context.Context, param interfaces.CreateItemTemplateParam, op *usecase.Operator) (*template.Template, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*template.Template, error) {
+		m, err := i.repos.Model.FindByID(ctx, param.ModelID)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsWritableProject(m.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		fields, metadataFields := param.Fields, param.MetadataFields
+		if param.SourceItem != nil {
+			fields, metadataFields, err = i.sourceItemParams(ctx, m, *param.SourceItem)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		tf, err := i.templateFields(ctx, m, m.Schema(), fields)
+		if err != nil {
+			return nil, err
+		}
+		tmf, err := i.templateMetadataFields(ctx, m, metadataFields)
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := template.New().
+			NewID().
+			Project(m.Project()).
+			Model(m.ID()).
+			Name(param.Name).
+			Description(param.Description).
+			Fields(tf).
+			MetadataFields(tmf).
+			User(op.AcOperator.User).
+			Integration(op.Integration).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.ItemTemplate.Save(ctx, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+}
+
+func (i ItemTemplate) Update(ctx context.Context, param interfaces.UpdateItemTemplateParam, op *usecase.Operator) (*template.Template, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*template.Template, error) {
+		t, err := i.repos.ItemTemplate.FindByID(ctx, param.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsWritableProject(t.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		m, err := i.repos.Model.FindByID(ctx, t.Model())
+		if err != nil {
+			return nil, err
+		}
+
+		if param.Name != nil {
+			if err := t.SetName(*param.Name); err != nil {
+				return nil, err
+			}
+		}
+		if param.Description != nil {
+			t.SetDescription(*param.Description)
+		}
+		if param.Fields != nil {
+			fields, err := i.templateFields(ctx, m, m.Schema(), param.Fields)
+			if err != nil {
+				return nil, err
+			}
+			t.SetFields(fields)
+		}
+		if param.MetadataFields != nil {
+			fields, err := i.templateMetadataFields(ctx, m, param.MetadataFields)
+			if err != nil {
+				return nil, err
+			}
+			t.SetMetadataFields(fields)
+		}
+
+		if op.AcOperator.User != nil {
+			t.SetUser(*op.AcOperator.User)
+		} else if op.Integration != nil {
+			t.SetIntegration(*op.Integration)
+		}
+		t.SetUpdatedAt(util.Now())
+
+		if err := i.repos.ItemTemplate.Save(ctx, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+}
+
+func (i ItemTemplate) Delete(ctx context.Context, tid id.ItemTemplateID, op *usecase.Operator) error {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return interfaces.ErrInvalidOperator
+	}
+
+	return Run0(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) error {
+		t, err := i.repos.ItemTemplate.FindByID(ctx, tid)
+		if err != nil {
+			return err
+		}
+		if !op.IsWritableProject(t.Project()) {
+			return interfaces.ErrOperationDenied
+		}
+		return i.repos.ItemTemplate.Remove(ctx, tid)
+	})
+}
+
+// sourceItemParams returns the params which copy the values of the item and its metadata item.
+func (i ItemTemplate) sourceItemParams(ctx context.Context, m *model.Model, iid id.ItemID) ([]interfaces.ItemFieldParam, []interfaces.ItemFieldParam, error) {
+	itm, err := i.repos.Item.FindByID(ctx, iid, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	itv := itm.Value()
+	if itv.Model() != m.ID() || itv.IsMetadata() {
+		return nil, nil, interfaces.ErrItemsShouldBeOnSameModel
+	}
+
+	s, groupSchemas, err := i.schemas(ctx, m.Schema())
+	if err != nil {
+		return nil, nil, err
+	}
+	fields := copiedFieldParams(itv.Fields().Copy(), s, groupSchemas)
+
+	var metadataFields []interfaces.ItemFieldParam
+	if mid := itv.MetadataItem(); mid != nil && m.Metadata() != nil {
+		mi, err := i.repos.Item.FindByID(ctx, *mid, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		ms, metadataGroupSchemas, err := i.schemas(ctx, *m.Metadata())
+		if err != nil {
+			return nil, nil, err
+		}
+		metadataFields = copiedFieldParams(mi.Value().Fields().Copy(), ms, metadataGroupSchemas)
+	}
+	return fields, metadataFields, nil
+}
+
+func (i ItemTemplate) templateMetadataFields(ctx context.Context, m *model.Model, params []interfaces.ItemFieldParam) (item.Fields, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	if m.Metadata() == nil {
+		return nil, interfaces.ErrMetadataMismatch
+	}
+	return i.templateFields(ctx, m, *m.Metadata(), params)
+}
+
+// templateFields builds the fields of the template from the params.
+// The values are validated like the values of items, but required fields can be left empty as the items created from the template can set them.
+func (i ItemTemplate) templateFields(ctx context.Context, m *model.Model, sid id.SchemaID, params []interfaces.ItemFieldParam) (item.Fields, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	s, groupSchemas, err := i.schemas(ctx, sid)
+	if err != nil {
+		return nil, err
+	}
+	prj, err := i.repos.Project.FindByID(ctx, m.Project())
+	if err != nil {
+		return nil, err
+	}
+	ls := prj.Locales()
+
+	var res item.Fields
+	for _, p := range params {
+		sf := paramField(p, s, groupSchemas)
+		if sf == nil {
+			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrInvalidField, p.Field, p.Key)
+		}
+		if sf.IsComputed() {
+			return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrComputedFieldNotWritable, sf.ID(), sf.Name())
+		}
+		if !ls.IsDefault(p.Locale) {
+			if !ls.Has(p.Locale) {
+				return nil, fmt.Errorf("%w: %s", interfaces.ErrUnknownLocale, p.Locale)
+			}
+			if !sf.Localized() {
+				return nil, fmt.Errorf("%w: id=%s key=%s", interfaces.ErrFieldNotLocalized, sf.ID(), sf.Name())
+			}
+		}
+
+		v, err := multipleFromParam(p, sf)
+		if err != nil {
+			return nil, err
+		}
+		if err := sf.ValidateValue(v); err != nil {
+			return nil, fmt.Errorf("%w: id=%s key=%s", err, sf.ID(), sf.Name())
+		}
+
+		_, idx, found := lo.FindIndexOf(res, func(f *item.Field) bool {
+			return f.FieldID() == sf.ID() && lo.FromPtr(f.ItemGroup()) == lo.FromPtr(p.Group)
+		})
+		if !found {
+			res = append(res, item.NewField(sf.ID(), value.NewMultiple(sf.Type(), nil), p.Group))
+			idx = len(res) - 1
+		}
+		if ls.IsDefault(p.Locale) {
+			f := item.NewField(sf.ID(), v, p.Group)
+			copyLocaleValues(f, res[idx])
+			res[idx] = f
+		} else {
+			res[idx].SetLocaleValue(p.Locale, v)
+		}
+	}
+	return res, nil
+}
+
+func (i ItemTemplate) schemas(ctx context.Context, sid id.SchemaID) (*schema.Schema, schema.List, error) {
+	s, err := i.repos.Schema.FindByID(ctx, sid)
+	if err != nil {
+		return nil, nil, err
+	}
+	groupSchemas, err := i.item.groupSchemas(ctx, s)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, groupSchemas, nil
+}
@@ -0,0 +1,215 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/group"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+type itemTemplateTestData struct {
+	db                         *repo.Container
+	prj                        *project.Project
+	m                          *model.Model
+	title, body, code, groupSF *schema.Field
+	groupTextSF, metaSF        *schema.Field
+}
+
+func TestItemTemplate(t *testing.T) {
+	ctx := context.Background()
+	d := newItemTemplateTestData(t)
+	uc := NewItemTemplate(d.db, nil)
+	op := batchTestOperator(d.prj.Workspace(), d.prj.ID())
+	g := id.NewItemGroupID()
+
+	// invalid operator
+	_, err := uc.Create(ctx, interfaces.CreateItemTemplateParam{ModelID: d.m.ID(), Name: "a"}, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+
+	// no permission
+	reader := batchTestOperator(d.prj.Workspace(), d.prj.ID())
+	reader.WritableProjects = nil
+	reader.MaintainableProjects = nil
+	_, err = uc.Create(ctx, interfaces.CreateItemTemplateParam{ModelID: d.m.ID(), Name: "a"}, reader)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// empty name
+	_, err = uc.Create(ctx, interfaces.CreateItemTemplateParam{ModelID: d.m.ID(), Name: " "}, op)
+	assert.Equal(t, template.ErrEmptyName, err)
+
+	// invalid values
+	_, err = uc.Create(ctx, interfaces.CreateItemTemplateParam{ModelID: d.m.ID(), Name: "a", Fields: []interfaces.ItemFieldParam{
+		{Field: d.title.ID().Ref(), Value: "too long text"},
+	}}, op)
+	assert.Error(t, err)
+	_, err = uc.Create(ctx, interfaces.CreateItemTemplateParam{ModelID: d.m.ID(), Name: "a", Fields: []interfaces.ItemFieldParam{
+		{Field: id.NewFieldID().Ref(), Value: "a"},
+	}}, op)
+	assert.ErrorIs(t, err, interfaces.ErrInvalidField)
+
+	// the required title can be left empty
+	tm, err := uc.Create(ctx, interfaces.CreateItemTemplateParam{
+		ModelID:     d.m.ID(),
+		Name:        "article",
+		Description: "desc",
+		Fields: []interfaces.ItemFieldParam{
+			{Field: d.body.ID().Ref(), Value: "body"},
+			{Field: d.groupSF.ID().Ref(), Value: []any{g.String()}},
+			{Field: d.groupTextSF.ID().Ref(), Value: "in group", Group: g.Ref()},
+		},
+		MetadataFields: []interfaces.ItemFieldParam{{Key: d.metaSF.Key().Ref(), Value: true}},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "article", tm.Name())
+	assert.Equal(t, "desc", tm.Description())
+	assert.Len(t, tm.Fields(), 3)
+	assert.Equal(t, value.TypeBool.Value(true).AsMultiple(), tm.MetadataFields().Field(d.metaSF.ID()).Value())
+	assert.Equal(t, op.AcOperator.User, tm.User())
+
+	got, err := uc.FindByModel(ctx, d.m.ID(), op)
+	assert.NoError(t, err)
+	assert.Len(t, got, 1)
+
+	// update
+	tm, err = uc.Update(ctx, interfaces.UpdateItemTemplateParam{
+		ID:     tm.ID(),
+		Name:   lo.ToPtr("article 2"),
+		Fields: []interfaces.ItemFieldParam{{Field: d.body.ID().Ref(), Value: "body 2"}},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "article 2", tm.Name())
+	assert.Equal(t, "desc", tm.Description())
+	assert.Len(t, tm.Fields(), 1)
+	assert.Len(t, tm.MetadataFields(), 1)
+
+	// delete
+	assert.NoError(t, uc.Delete(ctx, tm.ID(), op))
+	_, err = uc.FindByID(ctx, tm.ID(), op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+}
+
+func TestItem_CreateFromTemplateAndDuplicate(t *testing.T) {
+	ctx := context.Background()
+	d := newItemTemplateTestData(t)
+	templateUC := NewItemTemplate(d.db, nil)
+	itemUC := NewItem(d.db, nil)
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(d.prj.Workspace(), d.prj.ID())
+	g := id.NewItemGroupID()
+
+	tm, err := templateUC.Create(ctx, interfaces.CreateItemTemplateParam{
+		ModelID: d.m.ID(),
+		Name:    "article",
+		Fields: []interfaces.ItemFieldParam{
+			{Field: d.title.ID().Ref(), Value: "title"},
+			{Field: d.body.ID().Ref(), Value: "body"},
+			{Field: d.groupSF.ID().Ref(), Value: []any{g.String()}},
+			{Field: d.groupTextSF.ID().Ref(), Value: "in group", Group: g.Ref()},
+		},
+		MetadataFields: []interfaces.ItemFieldParam{{Field: d.metaSF.ID().Ref(), Value: true}},
+	}, op)
+	assert.NoError(t, err)
+
+	// the values of the template are overwritten by the params
+	itm, err := itemUC.CreateFromTemplate(ctx, interfaces.CreateItemFromTemplateParam{
+		TemplateID: tm.ID(),
+		Fields: []interfaces.ItemFieldParam{
+			{Key: d.title.Key().Ref(), Value: "new title"},
+			{Field: d.code.ID().Ref(), Value: "c1"},
+		},
+	}, op)
+	assert.NoError(t, err)
+	itv := itm.Value()
+	assert.Equal(t, "new title", itv.Field(d.title.ID()).Value().First().Interface())
+	assert.Equal(t, "body", itv.Field(d.body.ID()).Value().First().Interface())
+	assert.Equal(t, "c1", itv.Field(d.code.ID()).Value().First().Interface())
+	groups, ok := itv.Field(d.groupSF.ID()).Value().ValuesGroup()
+	assert.True(t, ok)
+	assert.Len(t, groups, 1)
+	assert.NotEqual(t, g, groups[0])
+	assert.Equal(t, "in group", item.Fields(itv.Fields()).FieldsByGroup(groups[0])[0].Value().First().Interface())
+	assert.NotNil(t, itv.MetadataItem())
+	mi, err := d.db.Item.FindByID(ctx, *itv.MetadataItem(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, itv.ID().Ref(), mi.Value().OriginalItem())
+	assert.Equal(t, value.TypeBool.Value(true).AsMultiple(), mi.Value().Field(d.metaSF.ID()).Value())
+
+	// the template is not changed
+	tm2, err := templateUC.FindByID(ctx, tm.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, tm.Fields(), tm2.Fields())
+
+	_, err = itemUC.CreateFromTemplate(ctx, interfaces.CreateItemFromTemplateParam{TemplateID: id.NewItemTemplateID()}, op)
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	// duplicate: the unique field is not copied
+	dup, err := itemUC.Duplicate(ctx, itv.ID(), op)
+	assert.NoError(t, err)
+	dv := dup.Value()
+	assert.NotEqual(t, itv.ID(), dv.ID())
+	assert.Equal(t, "new title", dv.Field(d.title.ID()).Value().First().Interface())
+	assert.Nil(t, dv.Field(d.code.ID()))
+	dgroups, _ := dv.Field(d.groupSF.ID()).Value().ValuesGroup()
+	assert.NotEqual(t, groups, dgroups)
+	assert.Equal(t, "in group", item.Fields(dv.Fields()).FieldsByGroup(dgroups[0])[0].Value().First().Interface())
+	assert.NotEqual(t, itv.MetadataItem(), dv.MetadataItem())
+	versions, err := d.db.Item.FindAllVersionsByID(ctx, dv.ID())
+	assert.NoError(t, err)
+	assert.Len(t, versions, 1)
+
+	_, err = itemUC.Duplicate(ctx, *itv.MetadataItem(), op)
+	assert.Equal(t, interfaces.ErrCannotDuplicateMetadata, err)
+
+	// a template from the item
+	tm3, err := templateUC.Create(ctx, interfaces.CreateItemTemplateParam{ModelID: d.m.ID(), Name: "copy", SourceItem: itv.ID().Ref()}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "new title", tm3.Fields().Field(d.title.ID()).Value().First().Interface())
+	assert.Nil(t, tm3.Fields().Field(d.code.ID()))
+	assert.Len(t, tm3.MetadataFields(), 1)
+}
+
+func newItemTemplateTestData(t *testing.T) itemTemplateTestData {
+	t.Helper()
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+
+	groupTextSF := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("g").Key(id.RandomKey()).MustBuild()
+	gs := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{groupTextSF}).MustBuild()
+	gr := group.New().NewID().Project(prj.ID()).Schema(gs.ID()).Name("group").Key(id.RandomKey()).MustBuild()
+
+	title := schema.NewField(schema.NewText(lo.ToPtr(10)).TypeProperty()).NewID().Name("title").Required(true).Key(id.NewKey("title")).MustBuild()
+	body := schema.NewField(schema.NewTextArea(nil).TypeProperty()).NewID().Name("body").Key(id.NewKey("body")).MustBuild()
+	code := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("code").Unique(true).Key(id.NewKey("code")).MustBuild()
+	groupSF := schema.NewField(schema.NewGroup(gr.ID()).TypeProperty()).NewID().Name("group").Multiple(true).Key(id.NewKey("group")).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{title, body, code, groupSF}).MustBuild()
+	metaSF := schema.NewField(schema.NewBool().TypeProperty()).NewID().Name("meta").Key(id.NewKey("meta")).MustBuild()
+	ms := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{metaSF}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Metadata(ms.ID().Ref()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, gs))
+	lo.Must0(db.Group.Save(ctx, gr))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Schema.Save(ctx, ms))
+	lo.Must0(db.Model.Save(ctx, m))
+
+	return itemTemplateTestData{db: db, prj: prj, m: m, title: title, body: body, code: code, groupSF: groupSF, groupTextSF: groupTextSF, metaSF: metaSF}
+}
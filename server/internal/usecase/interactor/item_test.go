@@ -11,9 +11,11 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/expression"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/request"
@@ -917,6 +919,355 @@ func TestItem_Update(t *testing.T) {
 	assert.Nil(t, item)
 }
 
+func TestItem_Localized(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	ls := lo.Must(locale.NewSettings("en", locale.List{"en", "ja", "fr"}, map[locale.Locale]locale.Locale{"fr": "ja"}))
+	prj := project.New().NewID().Workspace(wid).Locales(ls).MustBuild()
+	sf := schema.NewField(schema.NewText(lo.ToPtr(10)).TypeProperty()).NewID().Name("f").Key(id.RandomKey()).Localized(true).Required(true).Unique(true).MustBuild()
+	sf2 := schema.NewField(schema.NewText(lo.ToPtr(10)).TypeProperty()).NewID().Name("g").Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(wid, prj.ID())
+
+	fields := func(f ...interfaces.ItemFieldParam) interfaces.CreateItemParam {
+		return interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: f}
+	}
+
+	// unknown locale
+	_, err := itemUC.Create(ctx, fields(
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "a"},
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "b", Locale: "de"},
+	), op)
+	assert.ErrorIs(t, err, interfaces.ErrUnknownLocale)
+
+	// field is not localized
+	_, err = itemUC.Create(ctx, fields(
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "a"},
+		interfaces.ItemFieldParam{Field: sf2.ID().Ref(), Value: "b", Locale: "ja"},
+	), op)
+	assert.ErrorIs(t, err, interfaces.ErrFieldNotLocalized)
+
+	// required field needs the values of all locales
+	_, err = itemUC.Create(ctx, fields(
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "a"},
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "b", Locale: "ja"},
+	), op)
+	assert.ErrorIs(t, err, schema.ErrValueRequired)
+
+	// ok
+	it, err := itemUC.Create(ctx, fields(
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "a"},
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "b", Locale: "ja"},
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "c", Locale: "fr"},
+	), op)
+	assert.NoError(t, err)
+	f := it.Value().Field(sf.ID())
+	assert.Equal(t, value.TypeText.Value("a").AsMultiple(), f.Value())
+	assert.Equal(t, value.TypeText.Value("b").AsMultiple(), f.LocaleValue("ja"))
+	assert.Equal(t, value.TypeText.Value("c").AsMultiple(), f.LocaleValue("fr"))
+
+	// unique values are checked per locale
+	_, err = itemUC.Create(ctx, fields(
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "x"},
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "b", Locale: "ja"},
+		interfaces.ItemFieldParam{Field: sf.ID().Ref(), Value: "y", Locale: "fr"},
+	), op)
+	assert.Equal(t, interfaces.ErrDuplicatedItemValue, err)
+
+	// updating a locale keeps the other values
+	it, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "d", Locale: "fr"}},
+	}, op)
+	assert.NoError(t, err)
+	f = it.Value().Field(sf.ID())
+	assert.Equal(t, value.TypeText.Value("a").AsMultiple(), f.Value())
+	assert.Equal(t, value.TypeText.Value("b").AsMultiple(), f.LocaleValue("ja"))
+	assert.Equal(t, value.TypeText.Value("d").AsMultiple(), f.LocaleValue("fr"))
+
+	// resolve values of a locale
+	assert.Equal(t, value.TypeText.Value("d").AsMultiple(), it.Value().Localize(ls, "fr").Field(sf.ID()).Value())
+	assert.Equal(t, value.TypeText.Value("a").AsMultiple(), it.Value().Localize(ls, "en").Field(sf.ID()).Value())
+}
+
+func TestItem_ValidationRules(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	tags := schema.NewField(schema.NewText(nil).TypeProperty()).
+		NewID().
+		Name("tags").
+		Key(id.NewKey("tags")).
+		Multiple(true).
+		ValidationRules([]*schema.ValidationRule{lo.Must(schema.NewCountRule(schema.ValidationRuleTypeMaxCount, 2, "at most two tags"))}).
+		MustBuild()
+	start := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Name("start").Key(id.NewKey("start")).MustBuild()
+	end := schema.NewField(schema.NewDateTime().TypeProperty()).NewID().Name("end").Key(id.NewKey("end")).MustBuild()
+	s := schema.New().
+		NewID().
+		Workspace(wid).
+		Project(prj.ID()).
+		Fields(schema.FieldList{tags, start, end}).
+		ValidationRules([]*schema.ValidationRule{lo.Must(schema.NewCompareRule(end.ID(), schema.CompareOperatorGreaterThan, start.ID(), ""))}).
+		MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(wid, prj.ID())
+
+	now := time.Now().Truncate(time.Millisecond)
+	_, err := itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: []interfaces.ItemFieldParam{
+		{Field: tags.ID().Ref(), Value: []any{"a", "b", "c"}},
+		{Field: start.ID().Ref(), Value: now},
+		{Field: end.ID().Ref(), Value: now.Add(-time.Hour)},
+	}}, op)
+	var errs schema.ValidationErrors
+	assert.True(t, errors.As(err, &errs))
+	assert.Equal(t, []string{"tags", "end"}, lo.Map(errs, func(e *schema.ValidationError, _ int) string { return e.Key }))
+	assert.Equal(t, "at most two tags", errs[0].Reason())
+
+	it, err := itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: []interfaces.ItemFieldParam{
+		{Field: tags.ID().Ref(), Value: []any{"a", "b"}},
+		{Field: start.ID().Ref(), Value: now},
+		{Field: end.ID().Ref(), Value: now.Add(time.Hour)},
+	}}, op)
+	assert.NoError(t, err)
+
+	// the values which are not updated are also checked
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{{Field: start.ID().Ref(), Value: now.Add(2 * time.Hour)}},
+	}, op)
+	assert.ErrorIs(t, err, schema.ErrComparisonFailed)
+}
+
+func TestItem_ComputedFields(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	first := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("first").Key(id.NewKey("first")).MustBuild()
+	last := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("last").Key(id.NewKey("last")).MustBuild()
+	full := schema.NewField(schema.NewText(nil).TypeProperty()).
+		NewID().
+		Name("full").
+		Key(id.NewKey("full")).
+		Expression(expression.MustParse(`first + " " + last`)).
+		MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{first, last, full}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(s.Project()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(wid, prj.ID())
+
+	it, err := itemUC.Create(ctx, interfaces.CreateItemParam{SchemaID: s.ID(), ModelID: m.ID(), Fields: []interfaces.ItemFieldParam{
+		{Field: first.ID().Ref(), Value: "John"},
+		{Field: last.ID().Ref(), Value: "Doe"},
+	}}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "John Doe", it.Value().Field(full.ID()).Value().First().Interface())
+
+	// the computed field is recomputed on every save
+	it, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{{Field: last.ID().Ref(), Value: "Smith"}},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "John Smith", it.Value().Field(full.ID()).Value().First().Interface())
+
+	// the computed field is read-only
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: it.Value().ID(),
+		Fields: []interfaces.ItemFieldParam{{Key: id.NewKey("full").Ref(), Value: "x"}},
+	}, op)
+	assert.ErrorIs(t, err, interfaces.ErrComputedFieldNotWritable)
+}
+
+func TestItem_Diff(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	pid := id.NewProjectID()
+	sid, mid := id.NewSchemaID(), id.NewModelID()
+	fid, mfid := id.NewFieldID(), id.NewFieldID()
+
+	ctx := context.Background()
+	db := memory.New()
+	itemUC := NewItem(db, nil)
+	op := batchTestOperator(wid, pid)
+
+	b := func(iid id.ItemID, f id.FieldID, v string) *item.Builder {
+		return item.New().ID(iid).Schema(sid).Model(mid).Project(pid).Thread(id.NewThreadID().Ref()).
+			Fields(item.Fields{item.NewField(f, value.TypeText.Value(v).AsMultiple(), nil)})
+	}
+	iid, miid := id.NewItemID(), id.NewItemID()
+	lo.Must0(db.Item.Save(ctx, b(iid, fid, "a").MustBuild()))
+	lo.Must0(db.Item.Save(ctx, b(miid, mfid, "x").IsMetadata(true).MustBuild()))
+	v1 := lo.Must(db.Item.FindByID(ctx, iid, nil)).Version()
+	lo.Must0(db.Item.Save(ctx, b(iid, fid, "b").MetadataItem(miid.Ref()).MustBuild()))
+	lo.Must0(db.Item.Save(ctx, b(miid, mfid, "y").IsMetadata(true).MustBuild()))
+
+	d, err := itemUC.Diff(ctx, iid, v1.OrRef(), version.Latest.OrVersion(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, item.FieldChanges{{
+		ID:            fid,
+		Type:          item.FieldChangeTypeUpdate,
+		PreviousValue: value.TypeText.Value("a").AsMultiple(),
+		CurrentValue:  value.TypeText.Value("b").AsMultiple(),
+	}}, d.Fields)
+	assert.Equal(t, &item.MetadataItemChange{Current: miid.Ref()}, d.MetadataItem)
+	// the metadata item is not linked to the first version
+	assert.Equal(t, item.FieldChanges{{
+		ID:           mfid,
+		Type:         item.FieldChangeTypeAdd,
+		CurrentValue: value.TypeText.Value("y").AsMultiple(),
+	}}, d.MetadataFields)
+
+	d, err = itemUC.Diff(ctx, iid, version.Latest.OrVersion(), version.Latest.OrVersion(), op)
+	assert.NoError(t, err)
+	assert.True(t, d.IsEmpty())
+
+	_, err = itemUC.Diff(ctx, iid, version.New().OrRef(), version.Latest.OrVersion(), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+}
+
+func TestItem_Restore(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf := schema.NewField(schema.NewText(lo.ToPtr(10)).TypeProperty()).NewID().Name("f").Key(id.RandomKey()).MustBuild()
+	sf2 := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("g").Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf, sf2}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+	removed := id.NewFieldID()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	op := batchTestOperator(wid, prj.ID())
+
+	iid := id.NewItemID()
+	b := func(fields ...*item.Field) *item.Item {
+		return item.New().ID(iid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).Fields(fields).MustBuild()
+	}
+	lo.Must0(db.Item.Save(ctx, b(
+		item.NewField(sf.ID(), value.TypeText.Value("a").AsMultiple(), nil),
+		item.NewField(sf2.ID(), value.TypeBool.Value(true).AsMultiple(), nil),
+		item.NewField(removed, value.TypeText.Value("x").AsMultiple(), nil),
+	)))
+	v1 := lo.Must(db.Item.FindByID(ctx, iid, nil)).Version()
+	lo.Must0(db.Item.Save(ctx, b(
+		item.NewField(sf.ID(), value.TypeText.Value("b").AsMultiple(), nil),
+		item.NewField(sf2.ID(), value.TypeText.Value("c").AsMultiple(), nil),
+	)))
+
+	res, err := itemUC.Restore(ctx, iid, v1.OrRef(), op)
+	assert.NoError(t, err)
+	assert.NotEqual(t, v1, res.Item.Version())
+	assert.Equal(t, value.TypeText.Value("a").AsMultiple(), res.Item.Value().Field(sf.ID()).Value())
+	// the field whose type was changed keeps the current value
+	assert.Equal(t, value.TypeText.Value("c").AsMultiple(), res.Item.Value().Field(sf2.ID()).Value())
+	assert.Equal(t, id.FieldIDList{removed}, res.RemovedFields)
+	assert.Equal(t, id.FieldIDList{sf2.ID()}, res.TypeChangedFields)
+	assert.Equal(t, item.FieldChanges{{
+		ID:            sf.ID(),
+		Type:          item.FieldChangeTypeUpdate,
+		PreviousValue: value.TypeText.Value("b").AsMultiple(),
+		CurrentValue:  value.TypeText.Value("a").AsMultiple(),
+	}}, res.Changes)
+
+	_, err = itemUC.Restore(ctx, iid, version.New().OrRef(), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	_, err = itemUC.Restore(ctx, iid, v1.OrRef(), &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+}
+
+func TestItem_FindPublicAt(t *testing.T) {
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	itemUC := NewItem(db, nil)
+	op := batchTestOperator(wid, prj.ID())
+
+	t0 := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	t1, t2, t3 := t0.Add(time.Hour), t0.Add(2*time.Hour), t0.Add(3*time.Hour)
+	iid := id.NewItemID()
+	save := func(now time.Time) version.Version {
+		defer util.MockNow(now)()
+		lo.Must0(db.Item.Save(ctx, item.New().ID(iid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).MustBuild()))
+		return lo.Must(db.Item.FindByID(ctx, iid, nil)).Version()
+	}
+	publish := func(now time.Time, v *version.Version) {
+		defer util.MockNow(now)()
+		var vr *version.VersionOrRef
+		if v != nil {
+			vr = v.OrRef().Ref()
+		}
+		lo.Must0(db.Item.UpdateRef(ctx, iid, version.Public, vr))
+	}
+
+	// v1 is published at t1 and replaced with v2 at t2, which is unpublished at t3
+	v1 := save(t0)
+	publish(t1, &v1)
+	v2 := save(t1.Add(time.Minute))
+	publish(t2, &v2)
+	publish(t3, nil)
+
+	at := func(t time.Time) *version.Ref {
+		return version.Public.At(t).Ref()
+	}
+	_, err := itemUC.FindPublicByID(ctx, iid, at(t0), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	got, err := itemUC.FindPublicByID(ctx, iid, at(t1), op)
+	assert.NoError(t, err)
+	assert.Equal(t, v1, got.Version())
+	got, err = itemUC.FindPublicByID(ctx, iid, at(t2.Add(-time.Second)), op)
+	assert.NoError(t, err)
+	assert.Equal(t, v1, got.Version())
+	got, err = itemUC.FindPublicByID(ctx, iid, at(t2), op)
+	assert.NoError(t, err)
+	assert.Equal(t, v2, got.Version())
+	_, err = itemUC.FindPublicByID(ctx, iid, at(t3), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	_, err = itemUC.FindPublicByID(ctx, iid, nil, op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	list, _, err := itemUC.FindPublicByModel(ctx, m.ID(), at(t2), nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []version.Version{v2}, lo.Map(list, func(v item.Versioned, _ int) version.Version { return v.Version() }))
+
+	// snapshots of other refs are not exposed
+	got, err = itemUC.FindPublicByID(ctx, iid, version.Latest.At(t1).Ref(), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	assert.Nil(t, got)
+}
+
 func TestItem_Delete(t *testing.T) {
 	wid := accountdomain.NewWorkspaceID()
 	pid := id.NewProjectID()
@@ -1040,7 +1391,7 @@ func TestWorkFlow(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, map[id.ItemID]item.Status{i.ID(): item.StatusReview}, status)
 
-	requestUC := NewRequest(db, nil)
+	requestUC := NewRequest(db, nil, "")
 	_, err = requestUC.Approve(ctx, req1.ID(), op)
 	assert.NoError(t, err)
 
@@ -1239,7 +1590,7 @@ func TestItem_ItemsAsCSV(t *testing.T) {
 			itemUC := NewItem(db, nil)
 			itemUC.ignoreEvent = true
 
-			pr, err := itemUC.ItemsAsCSV(ctx, tt.args.schemaPackage, tt.args.page, tt.args.perPage, tt.args.op)
+			pr, err := itemUC.ItemsAsCSV(ctx, tt.args.schemaPackage, tt.args.page, tt.args.perPage, nil, tt.args.op)
 
 			var result []byte
 			if pr.PipeReader != nil {
@@ -1413,7 +1764,7 @@ func TestItem_ItemsAsGeoJSON(t *testing.T) {
 			}
 			itemUC := NewItem(db, nil)
 			itemUC.ignoreEvent = true
-			result, err := itemUC.ItemsAsGeoJSON(ctx, tt.args.schemaPackage, tt.args.page, tt.args.perPage, tt.args.op)
+			result, err := itemUC.ItemsAsGeoJSON(ctx, tt.args.schemaPackage, tt.args.page, tt.args.perPage, nil, tt.args.op)
 
 			assert.Equal(t, tt.want, result.FeatureCollections)
 			assert.Equal(t, tt.wantError, err)
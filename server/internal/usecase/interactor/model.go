@@ -14,6 +14,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/task"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
@@ -209,12 +210,21 @@ func (i Model) Delete(ctx context.Context, modelID id.ModelID, operator *usecase
 				return err
 			}
 			res := models.Remove(modelID)
-			if err := i.repos.Model.Remove(ctx, modelID); err != nil {
+			// the model is moved to the trash with its schemas and items which are purged together
+			if err := i.repos.Model.Trash(ctx, modelID); err != nil {
 				return err
 			}
 			if err := i.repos.Model.SaveAll(ctx, res); err != nil {
 				return err
 			}
+			p, err := i.repos.Project.FindByID(ctx, m.Project())
+			if err != nil {
+				return err
+			}
+			if err := saveTrashEntry(ctx, i.repos, trash.New().Model(modelID).Name(m.Name()), p.Workspace(), p.ID(), operator); err != nil {
+				return err
+			}
+			unindexModel(ctx, i.gateways, modelID)
 			return nil
 		})
 }
@@ -668,7 +668,7 @@ func TestModel_Copy(t *testing.T) {
 				Name:    lo.ToPtr("Copied Model"),
 			},
 			setupMock: func() {
-				mRunner.EXPECT().Run(ctx, gomock.Any()).Times(1).Return(nil)
+				mRunner.EXPECT().Run(gomock.Any(), gomock.Any()).Times(1).Return(nil)
 			},
 			wantErr: false,
 			validate: func(t *testing.T, got *model.Model) {
@@ -686,7 +686,7 @@ func TestModel_Copy(t *testing.T) {
 				Name:    lo.ToPtr("Copied Model"),
 			},
 			setupMock: func() {
-				mRunner.EXPECT().Run(ctx, gomock.Any()).Times(0)
+				mRunner.EXPECT().Run(gomock.Any(), gomock.Any()).Times(0)
 			},
 			wantErr: true,
 			validate: func(t *testing.T, got *model.Model) {
@@ -700,7 +700,7 @@ func TestModel_Copy(t *testing.T) {
 				Name:    lo.ToPtr("Copied Model"),
 			},
 			setupMock: func() {
-				mRunner.EXPECT().Run(ctx, gomock.Any()).Times(1).Return(errors.New("task runner error"))
+				mRunner.EXPECT().Run(gomock.Any(), gomock.Any()).Times(1).Return(errors.New("task runner error"))
 			},
 			wantErr: true,
 			validate: func(t *testing.T, got *model.Model) {
@@ -0,0 +1,331 @@
+package interactor
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	htmltmpl "html/template"
+	"strings"
+	texttmpl "text/template"
+
+	i18nFS "github.com/reearth/reearth-cms/server/i18n"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/text/language"
+)
+
+var (
+	//go:embed emails/notification_text.tmpl
+	notificationTextTMPLStr string
+	//go:embed emails/notification_html.tmpl
+	notificationHTMLTMPLStr string
+
+	notificationTextTMPL = texttmpl.Must(texttmpl.New("notification").Parse(notificationTextTMPLStr))
+	notificationHTMLTMPL = htmltmpl.Must(htmltmpl.New("notification").Parse(notificationHTMLTMPLStr))
+
+	notificationBundle = i18n.NewBundle(language.English)
+
+	notificationGreeting = i18n.T("Hi")
+	notificationSuffix   = i18n.T("You can choose which notifications you receive by email in the notification settings.")
+	notificationMessages = map[notification.Type]*i18n.Message{
+		notification.TypeReviewRequested:  i18n.T("You were asked to review a request."),
+		notification.TypeRequestApproved:  i18n.T("Your request was approved."),
+		notification.TypeChangesRequested: i18n.T("Changes were requested on your request."),
+		notification.TypeRequestClosed:    i18n.T("Your request was closed."),
+		notification.TypeRequestComment:   i18n.T("Your request has a new comment."),
+	}
+	notificationSubjects = map[notification.Type]*i18n.Message{
+		notification.TypeReviewRequested:  i18n.T("Review requested"),
+		notification.TypeRequestApproved:  i18n.T("Request approved"),
+		notification.TypeChangesRequested: i18n.T("Changes requested"),
+		notification.TypeRequestClosed:    i18n.T("Request closed"),
+		notification.TypeRequestComment:   i18n.T("New comment"),
+	}
+	notificationLinkLabel = i18n.T("Open the request")
+)
+
+func init() {
+	notificationBundle.MustLoadFS(i18nFS.LocalsFS, "en.yml", "ja.yml")
+}
+
+type Notification struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+}
+
+func NewNotification(r *repo.Container, g *gateway.Container) interfaces.Notification {
+	return &Notification{
+		repos:    r,
+		gateways: g,
+	}
+}
+
+func (i *Notification) FindByUser(ctx context.Context, filter interfaces.NotificationFilter, p *usecasex.Pagination, op *usecase.Operator) (notification.List, *usecasex.PageInfo, error) {
+	if op.AcOperator.User == nil {
+		return nil, nil, interfaces.ErrInvalidOperator
+	}
+	return i.repos.Notification.FindByUser(ctx, *op.AcOperator.User, repo.NotificationFilter{UnreadOnly: filter.UnreadOnly}, p)
+}
+
+func (i *Notification) CountUnread(ctx context.Context, op *usecase.Operator) (int64, error) {
+	if op.AcOperator.User == nil {
+		return 0, interfaces.ErrInvalidOperator
+	}
+	return i.repos.Notification.CountUnread(ctx, *op.AcOperator.User)
+}
+
+func (i *Notification) MarkRead(ctx context.Context, ids id.NotificationIDList, op *usecase.Operator) (notification.List, error) {
+	if op.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (notification.List, error) {
+		list, err := i.repos.Notification.FindByIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		if len(list) != len(lo.Uniq(ids)) {
+			return nil, interfaces.ErrPartialNotFound
+		}
+
+		now := util.Now()
+		for _, n := range list {
+			// users can read only their own notifications
+			if n.User() != *op.AcOperator.User {
+				return nil, interfaces.ErrOperationDenied
+			}
+			n.MarkRead(now)
+		}
+
+		if err := i.repos.Notification.SaveAll(ctx, list); err != nil {
+			return nil, err
+		}
+		return list, nil
+	})
+}
+
+func (i *Notification) MarkAllRead(ctx context.Context, op *usecase.Operator) error {
+	if op.AcOperator.User == nil {
+		return interfaces.ErrInvalidOperator
+	}
+	return i.repos.Notification.MarkAllRead(ctx, *op.AcOperator.User, util.Now())
+}
+
+func (i *Notification) FindPreference(ctx context.Context, op *usecase.Operator) (*notification.Preference, error) {
+	if op.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	return findNotificationPreference(ctx, i.repos, *op.AcOperator.User)
+}
+
+func (i *Notification) UpdatePreference(ctx context.Context, param interfaces.UpdateNotificationPreferenceParam, op *usecase.Operator) (*notification.Preference, error) {
+	if op.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*notification.Preference, error) {
+		p, err := findNotificationPreference(ctx, i.repos, *op.AcOperator.User)
+		if err != nil {
+			return nil, err
+		}
+		for t, s := range param.Settings {
+			if err := p.SetSetting(t, s); err != nil {
+				return nil, err
+			}
+		}
+		if err := i.repos.NotificationPreference.Save(ctx, p); err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
+// findNotificationPreference returns the preference of the user, or a new preference with the default settings.
+func findNotificationPreference(ctx context.Context, r *repo.Container, u accountdomain.UserID) (*notification.Preference, error) {
+	prefs, err := r.NotificationPreference.FindByUsers(ctx, accountdomain.UserIDList{u})
+	if err != nil {
+		return nil, err
+	}
+	if len(prefs) > 0 {
+		return prefs[0], nil
+	}
+	return notification.NewPreference(u), nil
+}
+
+// notifyRequest notifies the users of an activity on the request in the app and by email following their preferences.
+// The user who caused the activity is not notified. Emails are sent after the transaction is committed,
+// and a failure in sending an email is logged and does not fail the activity.
+// webHost is the URL of the web app used to link the request from emails, and the link is omitted when it is empty.
+func notifyRequest(ctx context.Context, r *repo.Container, g *gateway.Container, webHost string, ty notification.Type, prj *project.Project, req *request.Request, users accountdomain.UserIDList, c *thread.Comment, op *usecase.Operator) error {
+	if r == nil || r.Notification == nil || r.NotificationPreference == nil {
+		return nil
+	}
+
+	users = lo.Filter(lo.Uniq(users), func(u accountdomain.UserID, _ int) bool {
+		return op == nil || op.AcOperator.User == nil || u != *op.AcOperator.User
+	})
+	if len(users) == 0 {
+		return nil
+	}
+
+	prefs, err := r.NotificationPreference.FindByUsers(ctx, users)
+	if err != nil {
+		return err
+	}
+	prefMap := lo.SliceToMap(prefs, func(p *notification.Preference) (accountdomain.UserID, *notification.Preference) {
+		return p.User(), p
+	})
+
+	var comment *id.CommentID
+	if c != nil {
+		comment = c.ID().Ref()
+	}
+
+	var inApp notification.List
+	var email accountdomain.UserIDList
+	for _, u := range users {
+		s := prefMap[u].Setting(ty)
+		if s.InApp {
+			b := notification.New().
+				NewID().
+				User(u).
+				Workspace(req.Workspace()).
+				Project(req.Project()).
+				Request(req.ID()).
+				Type(ty).
+				Comment(comment)
+			if op != nil {
+				b = b.Actor(op.Operator())
+			}
+			n, err := b.Build()
+			if err != nil {
+				return err
+			}
+			inApp = append(inApp, n)
+		}
+		if s.Email {
+			email = append(email, u)
+		}
+	}
+
+	if len(inApp) > 0 {
+		if err := r.Notification.SaveAll(ctx, inApp); err != nil {
+			return err
+		}
+	}
+
+	if len(email) == 0 || g == nil || g.Mailer == nil || r.User == nil {
+		return nil
+	}
+
+	// emails can not be rolled back, so they are sent only when the activity is committed
+	afterCommit(ctx, func(ctx context.Context) {
+		recipients, err := r.User.FindByIDs(ctx, email)
+		if err != nil {
+			log.Errorfc(ctx, "notification: failed to find the recipients of request %s: %v", req.ID(), err)
+			return
+		}
+		for _, u := range recipients {
+			subject, text, html, err := notificationEmail(u, ty, prj, req, webHost)
+			if err != nil {
+				log.Errorfc(ctx, "notification: failed to render an email to user %s: %v", u.ID(), err)
+				continue
+			}
+			if err := g.Mailer.SendMail([]gateway.Contact{{Email: u.Email(), Name: u.Name()}}, subject, text, html); err != nil {
+				log.Errorfc(ctx, "notification: failed to send an email to user %s: %v", u.ID(), err)
+			}
+		}
+	})
+	return nil
+}
+
+type notificationEmailData struct {
+	Lang      string
+	Subject   string
+	Greeting  string
+	UserName  string
+	Message   string
+	Detail    string
+	Link      string
+	LinkLabel string
+	Suffix    string
+}
+
+// notificationEmail renders the email of the notification in the language of the user.
+// The subject is "[project] kind: request title" so that the emails of a request are easy to find in a mailbox.
+func notificationEmail(u *user.User, ty notification.Type, prj *project.Project, req *request.Request, webHost string) (subject, text, html string, err error) {
+	lang := u.Lang()
+	if lang == language.Und {
+		lang = language.English
+	}
+	l := i18n.NewLocalizer(notificationBundle, lang.String())
+
+	m, ok := notificationMessages[ty]
+	if !ok {
+		return "", "", "", notification.ErrInvalidType
+	}
+	sm, ok := notificationSubjects[ty]
+	if !ok {
+		return "", "", "", notification.ErrInvalidType
+	}
+
+	subject = fmt.Sprintf("%s: %s", localizeMessage(l, sm), req.Title())
+	detail := req.Title()
+	if prj != nil {
+		subject = fmt.Sprintf("[%s] %s", prj.Name(), subject)
+		detail = fmt.Sprintf("%s / %s", prj.Name(), req.Title())
+	}
+
+	data := notificationEmailData{
+		Lang:      lang.String(),
+		Subject:   subject,
+		Greeting:  localizeMessage(l, notificationGreeting),
+		UserName:  u.Name(),
+		Message:   localizeMessage(l, m),
+		Detail:    detail,
+		Link:      requestURL(webHost, req),
+		LinkLabel: localizeMessage(l, notificationLinkLabel),
+		Suffix:    localizeMessage(l, notificationSuffix),
+	}
+
+	var textBuf, htmlBuf bytes.Buffer
+	if err := notificationTextTMPL.Execute(&textBuf, data); err != nil {
+		return "", "", "", err
+	}
+	if err := notificationHTMLTMPL.Execute(&htmlBuf, data); err != nil {
+		return "", "", "", err
+	}
+	return data.Subject, textBuf.String(), htmlBuf.String(), nil
+}
+
+// requestURL returns the URL of the page of the request in the web app, or an empty string when the host is unknown.
+func requestURL(webHost string, req *request.Request) string {
+	if webHost == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/workspace/%s/project/%s/request/%s", strings.TrimSuffix(webHost, "/"), req.Workspace(), req.Project(), req.ID())
+}
+
+// localizeMessage returns the message in the language of the localizer.
+// The English messages are the IDs themselves, so the ID is used when there is no translation.
+func localizeMessage(l *i18n.Localizer, m *i18n.Message) string {
+	if s, err := l.Localize(&i18n.LocalizeConfig{MessageID: m.ID}); err == nil && s != "" {
+		return s
+	}
+	return m.ID
+}
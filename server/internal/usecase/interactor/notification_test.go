@@ -0,0 +1,165 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/mailer"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestNotifyRequest(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	u1 := user.New().NewID().Name("aaa").Email("aaa@example.com").Workspace(wid).Lang(language.Japanese).MustBuild()
+	u2 := user.New().NewID().Name("bbb").Email("bbb@example.com").Workspace(wid).MustBuild()
+	u3 := user.New().NewID().Name("ccc").Email("ccc@example.com").Workspace(wid).MustBuild()
+	prj := project.New().NewID().Workspace(wid).Name("prj").MustBuild()
+	ri, _ := request.NewItem(id.NewItemID(), nil)
+	req := request.New().NewID().Workspace(wid).Project(prj.ID()).CreatedBy(u3.ID()).
+		Reviewers(accountdomain.UserIDList{u1.ID(), u2.ID()}).Items(request.ItemList{ri}).Title("foo").MustBuild()
+
+	db := memory.New()
+	assert.NoError(t, db.User.Save(ctx, u1))
+	assert.NoError(t, db.User.Save(ctx, u2))
+	assert.NoError(t, db.User.Save(ctx, u3))
+	// u2 does not receive emails
+	pref := notification.NewPreference(u2.ID())
+	assert.NoError(t, pref.SetSetting(notification.TypeReviewRequested, notification.Setting{InApp: true}))
+	assert.NoError(t, db.NotificationPreference.Save(ctx, pref))
+
+	m := mailer.NewMock()
+	gw := &gateway.Container{Mailer: m}
+	op := &usecase.Operator{AcOperator: &accountusecase.Operator{User: lo.ToPtr(u3.ID())}}
+
+	users := accountdomain.UserIDList{u1.ID(), u2.ID(), u3.ID()}
+
+	// emails are not sent when the activity is rolled back
+	err := Run0(ctx, op, db, Usecase().Transaction(), func(ctx context.Context) error {
+		assert.NoError(t, notifyRequest(ctx, db, gw, "https://cms.example.com/", notification.TypeReviewRequested, prj, req, users, nil, op))
+		return errors.New("rollback")
+	})
+	assert.Error(t, err)
+	assert.Empty(t, m.Mails())
+	db = memory.New()
+	assert.NoError(t, db.User.Save(ctx, u1))
+	assert.NoError(t, db.User.Save(ctx, u2))
+	assert.NoError(t, db.User.Save(ctx, u3))
+	assert.NoError(t, db.NotificationPreference.Save(ctx, pref))
+
+	err = Run0(ctx, op, db, Usecase().Transaction(), func(ctx context.Context) error {
+		return notifyRequest(ctx, db, gw, "https://cms.example.com/", notification.TypeReviewRequested, prj, req, users, nil, op)
+	})
+	assert.NoError(t, err)
+
+	// the operator is not notified
+	for _, u := range []*user.User{u1, u2} {
+		list, _, err := db.Notification.FindByUser(ctx, u.ID(), repo.NotificationFilter{}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(list))
+		assert.Equal(t, notification.TypeReviewRequested, list[0].Type())
+		assert.Equal(t, req.ID(), list[0].Request())
+		assert.Equal(t, u3.ID(), *list[0].Actor().User())
+	}
+	list, _, err := db.Notification.FindByUser(ctx, u3.ID(), repo.NotificationFilter{}, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	// the email is localized in the language of the user
+	mails := m.Mails()
+	assert.Equal(t, 1, len(mails))
+	assert.Equal(t, []gateway.Contact{{Email: "aaa@example.com", Name: "aaa"}}, mails[0].To)
+	assert.Equal(t, "[prj] レビュー依頼: foo", mails[0].Subject)
+	assert.Contains(t, mails[0].PlainContent, "リクエストのレビューを依頼されました。")
+	assert.Contains(t, mails[0].PlainContent, "prj / foo")
+	assert.Contains(t, mails[0].HTMLContent, "prj / foo")
+	link := fmt.Sprintf("https://cms.example.com/workspace/%s/project/%s/request/%s", wid, prj.ID(), req.ID())
+	assert.Contains(t, mails[0].PlainContent, link)
+	assert.Contains(t, mails[0].HTMLContent, `href="`+link+`"`)
+}
+
+func TestNotification(t *testing.T) {
+	ctx := context.Background()
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	newNotification := func(u accountdomain.UserID) *notification.Notification {
+		return notification.New().NewID().User(u).Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+			Request(id.NewRequestID()).Type(notification.TypeRequestApproved).MustBuild()
+	}
+	n1, n2, n3 := newNotification(u1), newNotification(u1), newNotification(u2)
+
+	db := memory.New()
+	assert.NoError(t, db.Notification.SaveAll(ctx, notification.List{n1, n2, n3}))
+	uc := NewNotification(db, nil)
+	op := &usecase.Operator{AcOperator: &accountusecase.Operator{User: lo.ToPtr(u1)}}
+
+	c, err := uc.CountUnread(ctx, op)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), c)
+
+	// users can not read notifications of others
+	_, err = uc.MarkRead(ctx, id.NotificationIDList{n1.ID(), n3.ID()}, op)
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+
+	res, err := uc.MarkRead(ctx, id.NotificationIDList{n1.ID()}, op)
+	assert.NoError(t, err)
+	assert.True(t, res[0].IsRead())
+
+	list, _, err := uc.FindByUser(ctx, interfaces.NotificationFilter{UnreadOnly: true}, nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.List{n2}, list)
+
+	assert.NoError(t, uc.MarkAllRead(ctx, op))
+	c, err = uc.CountUnread(ctx, op)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), c)
+	assert.False(t, n3.IsRead())
+
+	_, err = uc.CountUnread(ctx, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.ErrorIs(t, err, interfaces.ErrInvalidOperator)
+}
+
+func TestNotification_UpdatePreference(t *testing.T) {
+	ctx := context.Background()
+	uid := accountdomain.NewUserID()
+	db := memory.New()
+	uc := NewNotification(db, nil)
+	op := &usecase.Operator{AcOperator: &accountusecase.Operator{User: lo.ToPtr(uid)}}
+
+	p, err := uc.FindPreference(ctx, op)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.DefaultSetting, p.Setting(notification.TypeRequestClosed))
+
+	p, err = uc.UpdatePreference(ctx, interfaces.UpdateNotificationPreferenceParam{
+		Settings: map[notification.Type]notification.Setting{
+			notification.TypeRequestClosed: {Email: false, InApp: true},
+		},
+	}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.Setting{Email: false, InApp: true}, p.Setting(notification.TypeRequestClosed))
+
+	p, err = uc.FindPreference(ctx, op)
+	assert.NoError(t, err)
+	assert.Equal(t, notification.Setting{Email: false, InApp: true}, p.Setting(notification.TypeRequestClosed))
+	assert.Equal(t, notification.DefaultSetting, p.Setting(notification.TypeRequestApproved))
+
+	_, err = uc.UpdatePreference(ctx, interfaces.UpdateNotificationPreferenceParam{
+		Settings: map[notification.Type]notification.Setting{"xxx": {}},
+	}, op)
+	assert.ErrorIs(t, err, notification.ErrInvalidType)
+}
@@ -0,0 +1,137 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+)
+
+type PreviewToken struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+	secret   []byte
+}
+
+func NewPreviewToken(r *repo.Container, g *gateway.Container, secret string) interfaces.PreviewToken {
+	return &PreviewToken{
+		repos:    r,
+		gateways: g,
+		secret:   []byte(secret),
+	}
+}
+
+func (i PreviewToken) FindByProject(ctx context.Context, pid id.ProjectID, p *usecasex.Pagination, _ *usecase.Operator) (preview.List, *usecasex.PageInfo, error) {
+	return i.repos.PreviewToken.FindByProject(ctx, pid, p)
+}
+
+func (i PreviewToken) Issue(ctx context.Context, param interfaces.IssuePreviewTokenParam, op *usecase.Operator) (*preview.Token, string, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, "", interfaces.ErrInvalidOperator
+	}
+	if len(i.secret) == 0 {
+		return nil, "", interfaces.ErrPreviewTokenNotConfigured
+	}
+	expiresAt, err := preview.ExpiresAt(util.Now(), param.TTL)
+	if err != nil {
+		return nil, "", err
+	}
+
+	t, err := Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*preview.Token, error) {
+		prj, err := i.repos.Project.FindByID(ctx, param.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsWritableProject(prj.ID()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		if param.RequestID != nil {
+			req, err := i.repos.Request.FindByID(ctx, *param.RequestID)
+			if err != nil {
+				return nil, err
+			}
+			if req.Project() != prj.ID() {
+				return nil, rerror.ErrNotFound
+			}
+			// requests created before the items of requests were staged are staged here
+			if req.State() != request.StateClosed {
+				if err := stageRequestItems(ctx, i.repos, req, nil); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		t, err := preview.New().
+			NewID().
+			Workspace(prj.Workspace()).
+			Project(prj.ID()).
+			Request(param.RequestID).
+			User(op.AcOperator.User).
+			Integration(op.Integration).
+			ExpiresAt(expiresAt).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.PreviewToken.Save(ctx, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return t, t.Sign(i.secret), nil
+}
+
+func (i PreviewToken) Revoke(ctx context.Context, tid id.PreviewTokenID, op *usecase.Operator) (*preview.Token, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*preview.Token, error) {
+		t, err := i.repos.PreviewToken.FindByID(ctx, tid)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsWritableProject(t.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		t.Revoke(util.Now())
+		if err := i.repos.PreviewToken.Save(ctx, t); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+}
+
+func (i PreviewToken) Verify(ctx context.Context, token string) (*preview.Token, error) {
+	tid, err := preview.ParseID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := i.repos.PreviewToken.FindByID(ctx, tid)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return nil, preview.ErrInvalidToken
+		}
+		return nil, err
+	}
+	if err := t.Verify(token, i.secret, util.Now()); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,115 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreviewToken(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	uc := NewPreviewToken(db, nil, "secret")
+	itemUC := NewItem(db, nil)
+	op := batchTestOperator(wid, prj.ID())
+
+	// a is public at a1 and the request contains its newer version a2
+	a := id.NewItemID()
+	save := func() version.Version {
+		lo.Must0(db.Item.Save(ctx, item.New().ID(a).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).
+			Thread(id.NewThreadID().Ref()).MustBuild()))
+		return lo.Must(db.Item.FindByID(ctx, a, nil)).Version()
+	}
+	a1 := save()
+	lo.Must0(db.Item.UpdateRef(ctx, a, version.Public, a1.OrRef().Ref()))
+	a2 := save()
+	req := request.New().NewID().Workspace(wid).Project(prj.ID()).CreatedBy(accountdomain.NewUserID()).
+		Thread(id.NewThreadID().Ref()).Title("req").
+		Items(request.ItemList{lo.Must(request.NewItemWithVersion(a, a2.OrRef()))}).MustBuild()
+	lo.Must0(db.Request.Save(ctx, req))
+
+	// issue
+	_, _, err := NewPreviewToken(db, nil, "").Issue(ctx, interfaces.IssuePreviewTokenParam{ProjectID: prj.ID()}, op)
+	assert.Equal(t, interfaces.ErrPreviewTokenNotConfigured, err)
+	reader := batchTestOperator(wid, prj.ID())
+	reader.WritableProjects = nil
+	reader.MaintainableProjects = nil
+	_, _, err = uc.Issue(ctx, interfaces.IssuePreviewTokenParam{ProjectID: prj.ID()}, reader)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	_, _, err = uc.Issue(ctx, interfaces.IssuePreviewTokenParam{ProjectID: prj.ID(), TTL: lo.ToPtr(30 * 24 * time.Hour)}, op)
+	assert.Equal(t, preview.ErrInvalidExpiration, err)
+	_, _, err = uc.Issue(ctx, interfaces.IssuePreviewTokenParam{ProjectID: prj.ID(), RequestID: id.NewRequestID().Ref()}, op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	pt, token, err := uc.Issue(ctx, interfaces.IssuePreviewTokenParam{ProjectID: prj.ID()}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, version.Latest, pt.Ref())
+	assert.Equal(t, op.AcOperator.User, pt.User())
+
+	rpt, rtoken, err := uc.Issue(ctx, interfaces.IssuePreviewTokenParam{ProjectID: prj.ID(), RequestID: req.ID().Ref()}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, req.Ref(), rpt.Ref())
+
+	list, _, err := uc.FindByProject(ctx, prj.ID(), nil, op)
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	// verify
+	got, err := uc.Verify(ctx, token)
+	assert.NoError(t, err)
+	assert.Equal(t, pt.ID(), got.ID())
+	_, err = NewPreviewToken(db, nil, "other").Verify(ctx, token)
+	assert.Equal(t, preview.ErrInvalidToken, err)
+	_, err = uc.Verify(ctx, rtoken[:len(rtoken)-1])
+	assert.Equal(t, preview.ErrInvalidToken, err)
+	_, err = uc.Verify(ctx, preview.New().NewID().Workspace(wid).Project(prj.ID()).ExpiresAt(time.Now().Add(time.Hour)).MustBuild().Sign([]byte("secret")))
+	assert.Equal(t, preview.ErrInvalidToken, err)
+
+	// the request token previews the version of the request
+	got, err = uc.Verify(ctx, rtoken)
+	assert.NoError(t, err)
+	previewOp := &usecase.Operator{ReadableProjects: id.ProjectIDList{prj.ID()}, PreviewRef: got.Ref().Ref()}
+	v, err := itemUC.FindPublicByID(ctx, a, got.Ref().Ref(), previewOp)
+	assert.NoError(t, err)
+	assert.Equal(t, a2, v.Version())
+	// the ref of the request is not exposed without the token
+	v, err = itemUC.FindPublicByID(ctx, a, got.Ref().Ref(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, a1, v.Version())
+	v, err = itemUC.FindPublicByID(ctx, a, nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, a1, v.Version())
+
+	// revoke
+	_, err = uc.Revoke(ctx, pt.ID(), reader)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	revoked, err := uc.Revoke(ctx, pt.ID(), op)
+	assert.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt())
+	_, err = uc.Verify(ctx, token)
+	assert.Equal(t, preview.ErrRevoked, err)
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 )
 
@@ -112,6 +116,9 @@ func (i *Project) Update(ctx context.Context, p interfaces.UpdateProjectParam, o
 				if p.Publication.AssetPublic != nil {
 					pub.SetAssetPublic(*p.Publication.AssetPublic)
 				}
+				if p.Publication.CacheMaxAge != nil {
+					pub.SetCacheMaxAge(*p.Publication.CacheMaxAge)
+				}
 				proj.SetPublication(pub)
 			}
 
@@ -119,6 +126,35 @@ func (i *Project) Update(ctx context.Context, p interfaces.UpdateProjectParam, o
 				proj.SetRequestRoles(p.RequestRoles)
 			}
 
+			if p.Locales != nil {
+				var ls *locale.Settings
+				if p.Locales.Default != "" {
+					if ls, err = locale.NewSettings(p.Locales.Default, p.Locales.Locales, p.Locales.Fallbacks); err != nil {
+						return nil, err
+					}
+				}
+				proj.SetLocales(ls)
+			}
+
+			if p.ApprovalPolicy != nil {
+				var ap *request.ApprovalPolicy
+				if p.ApprovalPolicy.MinApprovals != 0 {
+					ws, err := i.repos.Workspace.FindByID(ctx, proj.Workspace())
+					if err != nil {
+						return nil, err
+					}
+					for _, u := range p.ApprovalPolicy.RequiredReviewers {
+						if !ws.Members().IsOwnerOrMaintainer(u) {
+							return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
+						}
+					}
+					if ap, err = request.NewApprovalPolicy(p.ApprovalPolicy.MinApprovals, p.ApprovalPolicy.RequiredReviewers, p.ApprovalPolicy.RequiredRoles, p.ApprovalPolicy.ResetOnChange); err != nil {
+						return nil, err
+					}
+				}
+				proj.SetApprovalPolicy(ap)
+			}
+
 			if err := i.repos.Project.Save(ctx, proj); err != nil {
 				return nil, err
 			}
@@ -0,0 +1,420 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/view"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+func (i *Project) Export(ctx context.Context, pid id.ProjectID, op *usecase.Operator) (interfaces.ExportProjectResponse, error) {
+	if i.gateways == nil || i.gateways.ProjectArchiver == nil {
+		return interfaces.ExportProjectResponse{}, gateway.ErrUnsupportedOperation
+	}
+	if !op.IsMaintainingProject(pid) {
+		return interfaces.ExportProjectResponse{}, interfaces.ErrOperationDenied
+	}
+
+	pa, err := Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*gateway.ProjectArchive, error) {
+		return i.loadArchive(ctx, pid)
+	})
+	if err != nil {
+		return interfaces.ExportProjectResponse{}, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		err := i.gateways.ProjectArchiver.Write(ctx, pw, pa)
+		if err != nil {
+			log.Errorfc(ctx, "project: failed to export project %s: %v", pid, err)
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	return interfaces.ExportProjectResponse{
+		PipeReader: pr,
+	}, nil
+}
+
+// loadArchive loads the project with all its models, schemas, groups, views, items with all versions, assets and threads.
+func (i *Project) loadArchive(ctx context.Context, pid id.ProjectID) (*gateway.ProjectArchive, error) {
+	prj, err := i.repos.Project.FindByID(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	models, _, err := i.repos.Model.FindByProject(ctx, pid, nil)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := i.repos.Group.FindByProject(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	var sids id.SchemaIDList
+	var views view.List
+	var iids id.ItemIDList
+	for _, m := range models {
+		sids = append(sids, m.Schema())
+		if m.Metadata() != nil {
+			sids = append(sids, *m.Metadata())
+		}
+
+		vl, err := i.repos.View.FindByModel(ctx, m.ID())
+		if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+			return nil, err
+		}
+		views = append(views, vl...)
+
+		// metadata items belong to the model too
+		items, _, err := i.repos.Item.FindByModel(ctx, m.ID(), nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		iids = append(iids, items.Unwrap().IDs()...)
+	}
+	for _, g := range groups {
+		sids = append(sids, g.Schema())
+	}
+
+	schemas, err := i.repos.Schema.FindByIDs(ctx, sids)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions item.VersionedList
+	if len(iids) > 0 {
+		if versions, err = i.repos.Item.FindAllVersionsByIDs(ctx, iids); err != nil {
+			return nil, err
+		}
+	}
+	slices.SortStableFunc(versions, func(a, b item.Versioned) int {
+		return a.Time().Compare(b.Time())
+	})
+
+	assets, _, err := i.repos.Asset.Search(ctx, pid, repo.AssetFilter{})
+	if err != nil {
+		return nil, err
+	}
+	files, err := i.repos.AssetFile.FindByIDs(ctx, assets.IDs())
+	if err != nil {
+		return nil, err
+	}
+
+	var thids id.ThreadIDList
+	for _, v := range versions {
+		if th := v.Value().Thread(); th != nil {
+			thids = append(thids, *th)
+		}
+	}
+	for _, a := range assets {
+		if th := a.Thread(); th != nil {
+			thids = append(thids, *th)
+		}
+	}
+	threads, err := i.repos.Thread.FindByIDs(ctx, lo.Uniq(thids))
+	if err != nil {
+		return nil, err
+	}
+
+	pa := &gateway.ProjectArchive{
+		Project:    prj,
+		Models:     models,
+		Schemas:    schemas,
+		Groups:     groups,
+		Views:      views,
+		Items:      versions,
+		Assets:     assets,
+		AssetFiles: files,
+		Threads:    threads,
+	}
+	if i.gateways.File != nil {
+		pa.AssetContent = func(ctx context.Context, a *asset.Asset) (io.ReadCloser, error) {
+			r, _, err := i.gateways.File.ReadAsset(ctx, a.UUID(), a.FileName(), nil)
+			return r, err
+		}
+	}
+	return pa, nil
+}
+
+func (i *Project) Import(ctx context.Context, param interfaces.ImportProjectParam, op *usecase.Operator) (_ *project.Project, err error) {
+	if i.gateways == nil || i.gateways.ProjectArchiver == nil {
+		return nil, gateway.ErrUnsupportedOperation
+	}
+	if param.Reader == nil {
+		return nil, interfaces.ErrFileNotIncluded
+	}
+	if !op.IsMaintainingWorkspace(param.WorkspaceID) {
+		return nil, interfaces.ErrOperationDenied
+	}
+
+	pa, err := i.gateways.ProjectArchiver.Read(ctx, param.Reader, param.Size, param.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the project is validated before uploading the contents of the assets, so an invalid import does not leave files behind
+	prj := pa.Project
+	if param.Alias != nil {
+		if err := prj.UpdateAlias(*param.Alias); err != nil {
+			return nil, err
+		}
+	}
+	if err := i.checkImportedAlias(ctx, prj); err != nil {
+		return nil, err
+	}
+
+	// the contents are uploaded before the transaction as well as creating assets, and removed when the import fails
+	uuids := map[id.AssetID]string{}
+	defer func() {
+		if err != nil {
+			i.removeArchivedAssets(ctx, pa.Assets, uuids)
+		}
+	}()
+	for _, a := range pa.Assets {
+		uuid, err := i.uploadArchivedAsset(ctx, pa, a)
+		if err != nil {
+			return nil, err
+		}
+		uuids[a.ID()] = uuid
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().WithMaintainableWorkspaces(param.WorkspaceID).Transaction(),
+		func(ctx context.Context) (*project.Project, error) {
+			// the alias may be taken while the assets are uploaded
+			if err := i.checkImportedAlias(ctx, prj); err != nil {
+				return nil, err
+			}
+			// the token of the original project must not be used to access the imported one
+			if pub := prj.Publication(); pub != nil && pub.Token() != "" {
+				pub.GenerateToken()
+			}
+			if err := i.importApprovalPolicy(ctx, prj); err != nil {
+				return nil, err
+			}
+			prj.SetUpdatedAt(util.Now())
+
+			if err := i.repos.Project.Save(ctx, prj); err != nil {
+				return nil, err
+			}
+
+			// the repos are filtered by the projects of the operator, which do not include the new project yet
+			op.AddNewProject(prj.ID())
+			r := i.repos.Filtered(repo.WorkspaceFilterFromOperator(op), repo.ProjectFilterFromOperator(op))
+
+			for _, s := range pa.Schemas {
+				if err := r.Schema.Save(ctx, s); err != nil {
+					return nil, err
+				}
+			}
+			for _, m := range pa.Models {
+				if err := r.Model.Save(ctx, m); err != nil {
+					return nil, err
+				}
+			}
+			for _, g := range pa.Groups {
+				if err := r.Group.Save(ctx, g); err != nil {
+					return nil, err
+				}
+			}
+			if err := r.View.SaveAll(ctx, pa.Views); err != nil {
+				return nil, err
+			}
+			if err := r.Thread.SaveAll(ctx, pa.Threads); err != nil {
+				return nil, err
+			}
+
+			for _, a := range pa.Assets {
+				if err := i.importAsset(ctx, r, pa, a, uuids[a.ID()], op); err != nil {
+					return nil, err
+				}
+			}
+
+			// versions are saved from the oldest, and the refs follow the versions they pointed to
+			latest := map[id.ItemID]*item.Item{}
+			public := map[id.ItemID]*item.Item{}
+			for _, v := range pa.Items {
+				it := v.Value()
+				if err := r.Item.Save(ctx, it); err != nil {
+					return nil, err
+				}
+				latest[it.ID()] = it
+				for _, ref := range v.Refs().Values() {
+					if ref.IsSpecial() {
+						continue
+					}
+					if err := r.Item.UpdateRef(ctx, it.ID(), ref, version.Latest.OrVersion().Ref()); err != nil {
+						return nil, err
+					}
+					if ref == version.Public {
+						public[it.ID()] = it
+					}
+				}
+			}
+
+			indexItems(ctx, r, i.gateways, version.Latest, lo.Values(latest)...)
+			indexItems(ctx, r, i.gateways, version.Public, lo.Values(public)...)
+
+			return prj, nil
+		})
+}
+
+func (i *Project) checkImportedAlias(ctx context.Context, prj *project.Project) error {
+	if prj.Alias() == "" {
+		return nil
+	}
+	if ok, _ := i.repos.Project.IsAliasAvailable(ctx, prj.Alias()); !ok {
+		return interfaces.ErrProjectAliasAlreadyUsed
+	}
+	return nil
+}
+
+// removeArchivedAssets removes the contents of the assets uploaded by an import which failed.
+func (i *Project) removeArchivedAssets(ctx context.Context, assets asset.List, uuids map[id.AssetID]string) {
+	for _, a := range assets {
+		uuid, ok := uuids[a.ID()]
+		if !ok {
+			continue
+		}
+		if err := i.gateways.File.DeleteAsset(ctx, uuid, a.FileName()); err != nil {
+			log.Errorfc(ctx, "project: failed to remove the content of imported asset %s: %v", a.ID(), err)
+		}
+	}
+}
+
+// uploadArchivedAsset uploads the content of the asset in the archive and returns the new UUID of the asset.
+func (i *Project) uploadArchivedAsset(ctx context.Context, pa *gateway.ProjectArchive, a *asset.Asset) (string, error) {
+	if i.gateways.File == nil || pa.AssetContent == nil {
+		return "", gateway.ErrUnsupportedOperation
+	}
+
+	c, err := pa.AssetContent(ctx, a)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = c.Close() }()
+
+	f := &file.File{
+		Content: c,
+		Name:    a.FileName(),
+		Size:    int64(a.Size()),
+	}
+	if af := pa.AssetFiles[a.ID()]; af != nil {
+		f.ContentType = af.ContentType()
+		f.ContentEncoding = af.ContentEncoding()
+	}
+
+	uuid, _, err := i.gateways.File.UploadAsset(ctx, f)
+	return uuid, err
+}
+
+// importAsset saves the asset with the uploaded content. Archives are extracted again since the extracted files are not in the project archive.
+func (i *Project) importAsset(ctx context.Context, r *repo.Container, pa *gateway.ProjectArchive, a *asset.Asset, uuid string, op *usecase.Operator) error {
+	needDecompress := false
+	if ext := strings.ToLower(path.Ext(a.FileName())); ext == ".zip" || ext == ".7z" {
+		needDecompress = a.ArchiveExtractionStatus() == nil || *a.ArchiveExtractionStatus() != asset.ArchiveExtractionStatusSkipped
+	}
+
+	es := a.ArchiveExtractionStatus()
+	if needDecompress {
+		es = lo.ToPtr(asset.ArchiveExtractionStatusPending)
+	}
+
+	ab := asset.New().
+		ID(a.ID()).
+		Project(a.Project()).
+		CreatedAt(a.CreatedAt()).
+		FileName(a.FileName()).
+		Size(a.Size()).
+		Type(a.PreviewType()).
+		UUID(uuid).
+		Thread(a.Thread()).
+		ArchiveExtractionStatus(es).
+		FlatFiles(a.FlatFiles()).
+		Public(a.Public()).
+		Metadata(a.Metadata())
+	switch {
+	case a.User() != nil:
+		ab.CreatedByUser(*a.User())
+	case a.Integration() != nil:
+		ab.CreatedByIntegration(*a.Integration())
+	case op.AcOperator.User != nil:
+		ab.CreatedByUser(*op.AcOperator.User)
+	case op.Integration != nil:
+		ab.CreatedByIntegration(*op.Integration)
+	}
+	na, err := ab.Build()
+	if err != nil {
+		return err
+	}
+
+	f := asset.NewFile().
+		Name(a.FileName()).
+		Path(a.FileName()).
+		Size(a.Size()).
+		GuessContentTypeIfEmpty()
+	if af := pa.AssetFiles[a.ID()]; af != nil {
+		f = asset.NewFile().
+			Name(af.Name()).
+			Path(af.Path()).
+			Size(af.Size()).
+			ContentType(af.ContentType()).
+			ContentEncoding(af.ContentEncoding())
+	}
+	root := f.Build()
+
+	if err := r.Asset.Save(ctx, na); err != nil {
+		return err
+	}
+	if err := r.AssetFile.Save(ctx, na.ID(), root); err != nil {
+		return err
+	}
+
+	if needDecompress {
+		return (&Asset{repos: r, gateways: i.gateways}).triggerDecompressEvent(ctx, na, root)
+	}
+	return nil
+}
+
+// importApprovalPolicy removes the required reviewers who can not review requests in the workspace of the project.
+func (i *Project) importApprovalPolicy(ctx context.Context, prj *project.Project) error {
+	ap := prj.ApprovalPolicy()
+	if ap == nil || len(ap.RequiredReviewers()) == 0 {
+		return nil
+	}
+
+	ws, err := i.repos.Workspace.FindByID(ctx, prj.Workspace())
+	if err != nil {
+		return err
+	}
+	reviewers := lo.Filter(ap.RequiredReviewers(), func(u accountdomain.UserID, _ int) bool {
+		return ws.Members().IsOwnerOrMaintainer(u)
+	})
+	nap, err := request.NewApprovalPolicy(ap.MinApprovals(), reviewers, ap.RequiredRoles(), ap.ResetOnChange())
+	if err != nil {
+		return err
+	}
+	prj.SetApprovalPolicy(nap)
+	return nil
+}
@@ -0,0 +1,186 @@
+package interactor
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/archive"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/fs"
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/file"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProject_ExportImport(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).Alias("project-a").Name("prj").MustBuild()
+
+	// two models referring to each other with corresponding fields
+	sid1, sid2 := id.NewSchemaID(), id.NewSchemaID()
+	fid1, fid2, fid3 := id.NewFieldID(), id.NewFieldID(), id.NewFieldID()
+	mid1, mid2 := id.NewModelID(), id.NewModelID()
+	cf := &schema.CorrespondingField{Title: "title", Key: "key"}
+	sf1 := schema.NewField(schema.NewText(nil).TypeProperty()).ID(fid1).Key(id.NewKey("name")).MustBuild()
+	sf2 := schema.NewField(schema.NewReference(mid2, sid2, fid3.Ref(), cf).TypeProperty()).ID(fid2).Key(id.NewKey("ref")).MustBuild()
+	sf3 := schema.NewField(schema.NewReference(mid1, sid1, fid2.Ref(), cf).TypeProperty()).ID(fid3).Key(id.NewKey("key")).MustBuild()
+	s1 := schema.New().ID(sid1).Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf1, sf2}).MustBuild()
+	s2 := schema.New().ID(sid2).Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf3}).MustBuild()
+	m1 := model.New().ID(mid1).Schema(sid1).Key(id.NewKey("model1")).Project(prj.ID()).MustBuild()
+	m2 := model.New().ID(mid2).Schema(sid2).Key(id.NewKey("model2")).Project(prj.ID()).MustBuild()
+
+	iid1, iid2 := id.NewItemID(), id.NewItemID()
+	newItem1 := func(name string) *item.Item {
+		return item.New().ID(iid1).Schema(sid1).Model(mid1).Project(prj.ID()).User(uid).Fields([]*item.Field{
+			item.NewField(fid1, value.TypeText.Value(name).AsMultiple(), nil),
+			item.NewField(fid2, value.TypeReference.Value(iid2).AsMultiple(), nil),
+		}).MustBuild()
+	}
+	i2 := item.New().ID(iid2).Schema(sid2).Model(mid2).Project(prj.ID()).User(uid).Fields([]*item.Field{
+		item.NewField(fid3, value.TypeReference.Value(iid1).AsMultiple(), nil),
+	}).MustBuild()
+
+	mfs := afero.NewMemMapFs()
+	countFiles := func() (n int) {
+		_ = afero.Walk(mfs, "assets", func(_ string, fi os.FileInfo, _ error) error {
+			if fi != nil && !fi.IsDir() {
+				n++
+			}
+			return nil
+		})
+		return
+	}
+	f := lo.Must(fs.NewFile(mfs, ""))
+	uuid, size, err := f.UploadAsset(ctx, &file.File{Content: io.NopCloser(strings.NewReader("hello")), Name: "a.txt", Size: 5})
+	require.NoError(t, err)
+	a := asset.New().NewID().Project(prj.ID()).CreatedByUser(uid).FileName("a.txt").Size(uint64(size)).UUID(uuid).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s1))
+	lo.Must0(db.Schema.Save(ctx, s2))
+	lo.Must0(db.Model.Save(ctx, m1))
+	lo.Must0(db.Model.Save(ctx, m2))
+	lo.Must0(db.Item.Save(ctx, newItem1("a")))
+	lo.Must0(db.Item.UpdateRef(ctx, iid1, version.Public, version.Latest.OrVersion().Ref()))
+	lo.Must0(db.Item.Save(ctx, newItem1("b")))
+	lo.Must0(db.Item.Save(ctx, i2))
+	lo.Must0(db.Asset.Save(ctx, a))
+	lo.Must0(db.AssetFile.Save(ctx, a.ID(), asset.NewFile().Name("a.txt").Path("a.txt").Size(uint64(size)).Build()))
+
+	uc := NewProject(db, &gateway.Container{File: f, ProjectArchiver: archive.NewProjectArchiver()})
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:                   &uid,
+			MaintainableWorkspaces: accountdomain.WorkspaceIDList{wid},
+		},
+		MaintainableProjects: id.ProjectIDList{prj.ID()},
+	}
+
+	// export
+	_, err = uc.Export(ctx, prj.ID(), &usecase.Operator{AcOperator: &accountusecase.Operator{User: &uid}})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+
+	res, err := uc.Export(ctx, prj.ID(), op)
+	require.NoError(t, err)
+	b, err := io.ReadAll(res.PipeReader)
+	require.NoError(t, err)
+
+	// import
+	param := interfaces.ImportProjectParam{WorkspaceID: wid, Reader: bytes.NewReader(b), Size: int64(len(b))}
+	_, err = uc.Import(ctx, param, op)
+	assert.ErrorIs(t, err, interfaces.ErrProjectAliasAlreadyUsed)
+	// no asset is uploaded by an import which fails
+	assert.Equal(t, 1, countFiles())
+
+	param.Alias = lo.ToPtr("project-b")
+	got, err := uc.Import(ctx, param, op)
+	require.NoError(t, err)
+	assert.NotEqual(t, prj.ID(), got.ID())
+	assert.Equal(t, "project-b", got.Alias())
+	assert.Equal(t, "prj", got.Name())
+	assert.Equal(t, wid, got.Workspace())
+
+	models, _, err := db.Model.FindByProject(ctx, got.ID(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(models))
+	nm1, _ := lo.Find(models, func(m *model.Model) bool { return m.Key().String() == "model1" })
+	nm2, _ := lo.Find(models, func(m *model.Model) bool { return m.Key().String() == "model2" })
+	require.NotNil(t, nm1)
+	require.NotNil(t, nm2)
+	assert.NotEqual(t, mid1, nm1.ID())
+
+	ns1, err := db.Schema.FindByID(ctx, nm1.Schema())
+	require.NoError(t, err)
+	ns2, err := db.Schema.FindByID(ctx, nm2.Schema())
+	require.NoError(t, err)
+	nsf2, nsf3 := ns1.FieldByIDOrKey(nil, id.NewKey("ref").Ref()), ns2.FieldByIDOrKey(nil, id.NewKey("key").Ref())
+	require.NotNil(t, nsf2)
+	require.NotNil(t, nsf3)
+	assert.NotEqual(t, fid2, nsf2.ID())
+	ref2, ref3 := fieldReference(nsf2), fieldReference(nsf3)
+	assert.Equal(t, nm2.ID(), ref2.Model())
+	assert.Equal(t, nsf3.ID(), *ref2.CorrespondingFieldID())
+	assert.Equal(t, nm1.ID(), ref3.Model())
+	assert.Equal(t, nsf2.ID(), *ref3.CorrespondingFieldID())
+
+	items1, _, err := db.Item.FindByModel(ctx, nm1.ID(), nil, nil, nil)
+	require.NoError(t, err)
+	items2, _, err := db.Item.FindByModel(ctx, nm2.ID(), nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(items1))
+	require.Equal(t, 1, len(items2))
+	ni1, ni2 := items1[0].Value(), items2[0].Value()
+	assert.NotEqual(t, iid1, ni1.ID())
+	nameID := ns1.FieldByIDOrKey(nil, id.NewKey("name").Ref()).ID()
+	assert.Equal(t, "b", lo.Must(ni1.Field(nameID).Value().First().ValueString()))
+	assert.Equal(t, ni2.ID(), lo.Must(ni1.Field(nsf2.ID()).Value().First().ValueReference()))
+	assert.Equal(t, ni1.ID(), lo.Must(ni2.Field(nsf3.ID()).Value().First().ValueReference()))
+
+	// the version history and the public ref are kept
+	versions, err := db.Item.FindAllVersionsByID(ctx, ni1.ID())
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(versions))
+	pub, err := db.Item.FindByID(ctx, ni1.ID(), version.Public.Ref())
+	require.NoError(t, err)
+	assert.Equal(t, "a", lo.Must(pub.Value().Field(nameID).Value().First().ValueString()))
+
+	// the asset is uploaded again
+	assets, _, err := db.Asset.Search(ctx, got.ID(), repo.AssetFilter{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(assets))
+	assert.NotEqual(t, a.ID(), assets[0].ID())
+	assert.NotEqual(t, uuid, assets[0].UUID())
+	r, _, err := f.ReadAsset(ctx, assets[0].UUID(), "a.txt", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(lo.Must(io.ReadAll(r))))
+}
+
+func fieldReference(f *schema.Field) (res *schema.FieldReference) {
+	f.TypeProperty().Match(schema.TypePropertyMatch{
+		Reference: func(r *schema.FieldReference) { res = r },
+	})
+	return
+}
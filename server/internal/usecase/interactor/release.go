@@ -0,0 +1,415 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type Release struct {
+	repos       *repo.Container
+	gateways    *gateway.Container
+	ignoreEvent bool
+}
+
+func NewRelease(r *repo.Container, g *gateway.Container) interfaces.Release {
+	return &Release{
+		repos:    r,
+		gateways: g,
+	}
+}
+
+func (i Release) FindByID(ctx context.Context, rid id.ReleaseID, _ *usecase.Operator) (*release.Release, error) {
+	return i.repos.Release.FindByID(ctx, rid)
+}
+
+func (i Release) FindByName(ctx context.Context, pid id.ProjectID, name string, _ *usecase.Operator) (*release.Release, error) {
+	return i.repos.Release.FindByName(ctx, pid, name)
+}
+
+func (i Release) FindByProject(ctx context.Context, pid id.ProjectID, p *usecasex.Pagination, _ *usecase.Operator) (release.List, *usecasex.PageInfo, error) {
+	return i.repos.Release.FindByProject(ctx, pid, p)
+}
+
+func (i Release) Create(ctx context.Context, param interfaces.CreateReleaseParam, op *usecase.Operator) (*release.Release, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*release.Release, error) {
+		prj, err := i.repos.Project.FindByID(ctx, param.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsWritableProject(prj.ID()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+		if err := i.checkName(ctx, prj.ID(), nil, param.Name); err != nil {
+			return nil, err
+		}
+
+		r, err := release.New().
+			NewID().
+			Workspace(prj.Workspace()).
+			Project(prj.ID()).
+			Name(param.Name).
+			Description(lo.FromPtr(param.Description)).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Release.Save(ctx, r); err != nil {
+			return nil, err
+		}
+		return r, nil
+	})
+}
+
+func (i Release) Update(ctx context.Context, param interfaces.UpdateReleaseParam, op *usecase.Operator) (*release.Release, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*release.Release, error) {
+		r, err := i.repos.Release.FindByID(ctx, param.ReleaseID)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsWritableProject(r.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		if param.Name != nil && *param.Name != r.Name() {
+			if err := i.checkName(ctx, r.Project(), r.ID().Ref(), *param.Name); err != nil {
+				return nil, err
+			}
+			if err := r.SetName(*param.Name); err != nil {
+				return nil, err
+			}
+		}
+		if param.Description != nil {
+			r.SetDescription(*param.Description)
+		}
+		r.SetUpdatedAt(util.Now())
+
+		if err := i.repos.Release.Save(ctx, r); err != nil {
+			return nil, err
+		}
+		return r, nil
+	})
+}
+
+// Delete deletes the release and removes its ref from the items. The public versions of the items are kept.
+func (i Release) Delete(ctx context.Context, rid id.ReleaseID, op *usecase.Operator) error {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return interfaces.ErrInvalidOperator
+	}
+
+	return Run0(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) error {
+		r, err := i.repos.Release.FindByID(ctx, rid)
+		if err != nil {
+			return err
+		}
+		if !op.IsMaintainingProject(r.Project()) {
+			return interfaces.ErrOperationDenied
+		}
+
+		for _, it := range r.Items() {
+			if err := i.repos.Item.UpdateRef(ctx, it.Item(), r.Ref(), nil); err != nil {
+				return err
+			}
+		}
+		return i.repos.Release.Remove(ctx, rid)
+	})
+}
+
+func (i Release) AddItems(ctx context.Context, rid id.ReleaseID, params []interfaces.ReleaseItemParam, op *usecase.Operator) (*release.Release, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*release.Release, error) {
+		r, err := i.repos.Release.FindByID(ctx, rid)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsWritableProject(r.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		for _, p := range params {
+			v, err := i.itemVersion(ctx, r.Project(), p)
+			if err != nil {
+				return nil, err
+			}
+			it, err := release.NewItem(p.Item, v)
+			if err != nil {
+				return nil, err
+			}
+			if err := r.AddItem(it); err != nil {
+				return nil, err
+			}
+			if err := i.repos.Item.UpdateRef(ctx, p.Item, r.Ref(), v.OrRef().Ref()); err != nil {
+				return nil, err
+			}
+		}
+		r.SetUpdatedAt(util.Now())
+
+		if err := i.repos.Release.Save(ctx, r); err != nil {
+			return nil, err
+		}
+		return r, nil
+	})
+}
+
+func (i Release) RemoveItems(ctx context.Context, rid id.ReleaseID, items id.ItemIDList, op *usecase.Operator) (*release.Release, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*release.Release, error) {
+		r, err := i.repos.Release.FindByID(ctx, rid)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsWritableProject(r.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		for _, iid := range items {
+			if err := r.RemoveItem(iid); err != nil {
+				return nil, err
+			}
+			if err := i.repos.Item.UpdateRef(ctx, iid, r.Ref(), nil); err != nil {
+				return nil, err
+			}
+		}
+		r.SetUpdatedAt(util.Now())
+
+		if err := i.repos.Release.Save(ctx, r); err != nil {
+			return nil, err
+		}
+		return r, nil
+	})
+}
+
+func (i Release) Publish(ctx context.Context, rid id.ReleaseID, op *usecase.Operator) (*release.Release, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*release.Release, error) {
+		r, err := i.repos.Release.FindByID(ctx, rid)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsMaintainingProject(r.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		ids := r.Items().IDs()
+		latest, err := i.repos.Item.FindByIDs(ctx, ids, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(latest) != len(ids) {
+			return nil, interfaces.ErrItemMissing
+		}
+
+		previous, err := i.publicVersions(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		if err := r.Publish(previous, util.Now()); err != nil {
+			return nil, err
+		}
+
+		for _, it := range r.Items() {
+			if err := i.repos.Item.UpdateRef(ctx, it.Item(), version.Public, it.Version().OrRef().Ref()); err != nil {
+				return nil, err
+			}
+		}
+		if err := i.repos.Release.Save(ctx, r); err != nil {
+			return nil, err
+		}
+
+		published, err := i.repos.Item.FindByIDs(ctx, ids, version.Public.Ref())
+		if err != nil {
+			return nil, err
+		}
+		indexItems(ctx, i.repos, i.gateways, version.Public, published.Unwrap()...)
+		if err := i.publishEvents(ctx, r, published, op); err != nil {
+			return nil, err
+		}
+		return r, nil
+	})
+}
+
+// Rollback restores the public versions of the items which are still public at the versions published by the release.
+// Items which have been published again after the release are kept as they are.
+func (i Release) Rollback(ctx context.Context, rid id.ReleaseID, op *usecase.Operator) (*release.Release, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*release.Release, error) {
+		r, err := i.repos.Release.FindByID(ctx, rid)
+		if err != nil {
+			return nil, err
+		}
+		if !op.IsMaintainingProject(r.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+		if err := r.Rollback(util.Now()); err != nil {
+			return nil, err
+		}
+
+		current, err := i.publicVersions(ctx, r.Items().IDs())
+		if err != nil {
+			return nil, err
+		}
+
+		var restored, unpublished id.ItemIDList
+		for _, it := range r.Items() {
+			if cur := current[it.Item()]; cur == nil || *cur != it.Version() {
+				continue
+			}
+			var dest *version.VersionOrRef
+			if prev := it.Previous(); prev != nil {
+				dest = prev.OrRef().Ref()
+				restored = append(restored, it.Item())
+			} else {
+				unpublished = append(unpublished, it.Item())
+			}
+			if err := i.repos.Item.UpdateRef(ctx, it.Item(), version.Public, dest); err != nil {
+				return nil, err
+			}
+		}
+		if err := i.repos.Release.Save(ctx, r); err != nil {
+			return nil, err
+		}
+
+		if len(restored) > 0 {
+			items, err := i.repos.Item.FindByIDs(ctx, restored, version.Public.Ref())
+			if err != nil {
+				return nil, err
+			}
+			indexItems(ctx, i.repos, i.gateways, version.Public, items.Unwrap()...)
+		}
+		unindexItems(ctx, i.gateways, version.Public.Ref(), unpublished...)
+		return r, nil
+	})
+}
+
+// checkName returns an error when another release of the project has the name.
+func (i Release) checkName(ctx context.Context, pid id.ProjectID, self *id.ReleaseID, name string) error {
+	r, err := i.repos.Release.FindByName(ctx, pid, name)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if self != nil && r.ID() == *self {
+		return nil
+	}
+	return id.ErrDuplicatedKey
+}
+
+// itemVersion returns the version of the item to stage, which defaults to the latest version.
+func (i Release) itemVersion(ctx context.Context, pid id.ProjectID, p interfaces.ReleaseItemParam) (version.Version, error) {
+	vr := version.Latest.OrVersion()
+	if p.Version != nil {
+		vr = p.Version.OrRef()
+	}
+	itv, err := i.repos.Item.FindVersionByID(ctx, p.Item, vr)
+	if err != nil {
+		return version.Version{}, err
+	}
+	if itv.Value().Project() != pid {
+		return version.Version{}, interfaces.ErrOperationDenied
+	}
+	return itv.Version(), nil
+}
+
+func (i Release) publicVersions(ctx context.Context, ids id.ItemIDList) (map[id.ItemID]*version.Version, error) {
+	public, err := i.repos.Item.FindByIDs(ctx, ids, version.Public.Ref())
+	if err != nil {
+		return nil, err
+	}
+	return lo.SliceToMap(public, func(itv item.Versioned) (id.ItemID, *version.Version) {
+		return itv.Value().ID(), itv.Version().Ref()
+	}), nil
+}
+
+func (i Release) publishEvents(ctx context.Context, r *release.Release, items item.VersionedList, op *usecase.Operator) error {
+	if i.ignoreEvent {
+		return nil
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, r.Project())
+	if err != nil {
+		return err
+	}
+
+	models := map[id.ModelID]*model.Model{}
+	schemas := map[id.SchemaID]*schema.Schema{}
+	events := make([]Event, 0, len(items))
+	for _, itv := range items {
+		itm := itv.Value()
+		m, ok := models[itm.Model()]
+		if !ok {
+			if m, err = i.repos.Model.FindByID(ctx, itm.Model()); err != nil {
+				return err
+			}
+			models[m.ID()] = m
+		}
+		s, ok := schemas[itm.Schema()]
+		if !ok {
+			if s, err = i.repos.Schema.FindByID(ctx, itm.Schema()); err != nil {
+				return err
+			}
+			schemas[s.ID()] = s
+		}
+
+		events = append(events, i.publishEvent(prj, itv, m, s, op))
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	_, err = createEvents(ctx, i.repos, i.gateways, events)
+	return err
+}
+
+func (i Release) publishEvent(prj *project.Project, itv item.Versioned, m *model.Model, s *schema.Schema, op *usecase.Operator) Event {
+	return Event{
+		Project:   prj,
+		Workspace: prj.Workspace(),
+		Type:      event.ItemPublish,
+		Object:    itv,
+		WebhookObject: item.ItemModelSchema{
+			Item:   itv.Value(),
+			Model:  m,
+			Schema: s,
+		},
+		Operator: op.Operator(),
+	}
+}
@@ -0,0 +1,177 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelease(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Name("f").Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	uc := NewRelease(db, nil).(*Release)
+	uc.ignoreEvent = true
+	itemUC := NewItem(db, nil)
+	op := batchTestOperator(wid, prj.ID())
+
+	save := func(iid id.ItemID, v string) version.Version {
+		lo.Must0(db.Item.Save(ctx, item.New().ID(iid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).
+			Thread(id.NewThreadID().Ref()).
+			Fields([]*item.Field{item.NewField(sf.ID(), value.TypeText.Value(v).AsMultiple(), nil)}).
+			MustBuild()))
+		return lo.Must(db.Item.FindByID(ctx, iid, nil)).Version()
+	}
+	// a is public at a1 and has a newer version a2, and b has never been published
+	a, b := id.NewItemID(), id.NewItemID()
+	a1 := save(a, "a1")
+	lo.Must0(db.Item.UpdateRef(ctx, a, version.Public, a1.OrRef().Ref()))
+	a2 := save(a, "a2")
+	b1 := save(b, "b1")
+
+	// create
+	_, err := uc.Create(ctx, interfaces.CreateReleaseParam{ProjectID: prj.ID(), Name: "spring"}, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+	reader := batchTestOperator(wid, prj.ID())
+	reader.WritableProjects = nil
+	reader.MaintainableProjects = nil
+	_, err = uc.Create(ctx, interfaces.CreateReleaseParam{ProjectID: prj.ID(), Name: "spring"}, reader)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	_, err = uc.Create(ctx, interfaces.CreateReleaseParam{ProjectID: prj.ID(), Name: "spring release"}, op)
+	assert.Equal(t, release.ErrInvalidName, err)
+
+	r, err := uc.Create(ctx, interfaces.CreateReleaseParam{ProjectID: prj.ID(), Name: "spring", Description: lo.ToPtr("desc")}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "spring", r.Name())
+	assert.Equal(t, "desc", r.Description())
+	assert.Equal(t, release.StateDraft, r.State())
+
+	_, err = uc.Create(ctx, interfaces.CreateReleaseParam{ProjectID: prj.ID(), Name: "spring"}, op)
+	assert.Equal(t, id.ErrDuplicatedKey, err)
+
+	// add items
+	r, err = uc.AddItems(ctx, r.ID(), []interfaces.ReleaseItemParam{{Item: a}, {Item: b, Version: &b1}}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{a, b}, r.Items().IDs())
+	assert.Equal(t, a2, r.Items().Find(a).Version())
+
+	_, err = uc.AddItems(ctx, r.ID(), []interfaces.ReleaseItemParam{{Item: id.NewItemID()}}, op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	// preview
+	got, err := itemUC.FindPublicByID(ctx, a, r.Ref().Ref(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, a2, got.Version())
+	got, err = itemUC.FindPublicByID(ctx, a, nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, a1, got.Version())
+	_, err = itemUC.FindPublicByID(ctx, b, nil, op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	// other refs are not exposed
+	got, err = itemUC.FindPublicByID(ctx, a, version.Latest.Ref(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, a1, got.Version())
+	// drafts are exposed only with the ref granted by a preview token of the project
+	previewOp := &usecase.Operator{ReadableProjects: id.ProjectIDList{prj.ID()}, PreviewRef: version.Latest.Ref()}
+	got, err = itemUC.FindPublicByID(ctx, a, version.Latest.Ref(), previewOp)
+	assert.NoError(t, err)
+	assert.Equal(t, a2, got.Version())
+	_, err = itemUC.FindPublicByID(ctx, a, version.Latest.Ref(), &usecase.Operator{ReadableProjects: id.ProjectIDList{id.NewProjectID()}, PreviewRef: version.Latest.Ref()})
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	list, _, err := itemUC.FindPublicByModel(ctx, m.ID(), r.Ref().Ref(), nil, op)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []version.Version{a2, b1}, lo.Map(list, func(v item.Versioned, _ int) version.Version { return v.Version() }))
+	list, _, err = itemUC.FindPublicByModel(ctx, m.ID(), nil, nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []version.Version{a1}, lo.Map(list, func(v item.Versioned, _ int) version.Version { return v.Version() }))
+	list, _, err = itemUC.FindPublicByModel(ctx, m.ID(), version.Latest.Ref(), nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []version.Version{a1}, lo.Map(list, func(v item.Versioned, _ int) version.Version { return v.Version() }))
+	list, _, err = itemUC.FindPublicByModel(ctx, m.ID(), version.Latest.Ref(), nil, previewOp)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []version.Version{a2, b1}, lo.Map(list, func(v item.Versioned, _ int) version.Version { return v.Version() }))
+
+	// publish
+	_, err = uc.Publish(ctx, r.ID(), reader)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+	r, err = uc.Publish(ctx, r.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, release.StatePublished, r.State())
+	assert.NotNil(t, r.PublishedAt())
+	assert.Equal(t, &a1, r.Items().Find(a).Previous())
+	assert.Nil(t, r.Items().Find(b).Previous())
+	assert.Equal(t, a2, lo.Must(db.Item.FindByID(ctx, a, version.Public.Ref())).Version())
+	assert.Equal(t, b1, lo.Must(db.Item.FindByID(ctx, b, version.Public.Ref())).Version())
+
+	_, err = uc.Publish(ctx, r.ID(), op)
+	assert.Equal(t, release.ErrAlreadyPublished, err)
+	_, err = uc.AddItems(ctx, r.ID(), []interfaces.ReleaseItemParam{{Item: b}}, op)
+	assert.Equal(t, release.ErrAlreadyPublished, err)
+
+	// rollback
+	r, err = uc.Rollback(ctx, r.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, release.StateRolledBack, r.State())
+	assert.Equal(t, a1, lo.Must(db.Item.FindByID(ctx, a, version.Public.Ref())).Version())
+	_, err = db.Item.FindByID(ctx, b, version.Public.Ref())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	_, err = uc.Rollback(ctx, r.ID(), op)
+	assert.Equal(t, release.ErrNotPublished, err)
+
+	// an empty release cannot be published
+	r2, err := uc.Create(ctx, interfaces.CreateReleaseParam{ProjectID: prj.ID(), Name: "summer"}, op)
+	assert.NoError(t, err)
+	_, err = uc.Publish(ctx, r2.ID(), op)
+	assert.Equal(t, release.ErrEmpty, err)
+
+	// update
+	_, err = uc.Update(ctx, interfaces.UpdateReleaseParam{ReleaseID: r2.ID(), Name: lo.ToPtr("spring")}, op)
+	assert.Equal(t, id.ErrDuplicatedKey, err)
+	r2, err = uc.Update(ctx, interfaces.UpdateReleaseParam{ReleaseID: r2.ID(), Name: lo.ToPtr("autumn")}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, "autumn", r2.Name())
+
+	// remove items and delete
+	r2, err = uc.AddItems(ctx, r2.ID(), []interfaces.ReleaseItemParam{{Item: a}}, op)
+	assert.NoError(t, err)
+	r2, err = uc.RemoveItems(ctx, r2.ID(), id.ItemIDList{a}, op)
+	assert.NoError(t, err)
+	assert.Empty(t, r2.Items())
+	all := lo.Must(db.Item.FindAllVersionsByID(ctx, a))
+	assert.False(t, lo.ContainsBy(all, func(v item.Versioned) bool { return v.Refs().Has(r2.Ref()) }))
+
+	assert.Equal(t, interfaces.ErrOperationDenied, uc.Delete(ctx, r2.ID(), reader))
+	assert.NoError(t, uc.Delete(ctx, r2.ID(), op))
+	_, err = uc.FindByName(ctx, prj.ID(), "autumn", op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	res, _, err := uc.FindByProject(ctx, prj.ID(), nil, op)
+	assert.NoError(t, err)
+	assert.Equal(t, release.List{r}, res)
+}
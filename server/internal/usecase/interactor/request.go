@@ -12,24 +12,31 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/usecasex"
 	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
 )
 
 type Request struct {
 	repos       *repo.Container
 	gateways    *gateway.Container
+	webHost     string
 	ignoreEvent bool
 }
 
-func NewRequest(r *repo.Container, g *gateway.Container) *Request {
+func NewRequest(r *repo.Container, g *gateway.Container, webHost string) *Request {
 	return &Request{
 		repos:    r,
 		gateways: g,
+		webHost:  webHost,
 	}
 }
 
@@ -106,7 +113,10 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 					return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
 				}
 			}
-			builder.Reviewers(param.Reviewers)
+		}
+		// the required reviewers of the approval policy always review the request
+		if reviewers := withRequiredReviewers(param.Reviewers, p.ApprovalPolicy()); len(reviewers) > 0 {
+			builder.Reviewers(reviewers)
 		}
 
 		req, err := builder.Build()
@@ -117,6 +127,21 @@ func (r Request) Create(ctx context.Context, param interfaces.CreateRequestParam
 		if err := r.repos.Request.Save(ctx, req); err != nil {
 			return nil, err
 		}
+		if err := stageRequestItems(ctx, r.repos, req, nil); err != nil {
+			return nil, err
+		}
+
+		if err := r.requestEvent(ctx, event.RequestCreate, p, req, operator); err != nil {
+			return nil, err
+		}
+		if req.State() == request.StateWaiting {
+			if err := r.requestEvent(ctx, event.RequestSubmit, p, req, operator); err != nil {
+				return nil, err
+			}
+			if err := r.notify(ctx, notification.TypeReviewRequested, p, req, req.Reviewers(), operator); err != nil {
+				return nil, err
+			}
+		}
 
 		return req, nil
 	})
@@ -144,6 +169,14 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 			return nil, interfaces.ErrOperationDenied
 		}
 
+		prj, err := r.repos.Project.FindByID(ctx, req.Project())
+		if err != nil {
+			return nil, err
+		}
+
+		prevState := req.State()
+		prevReviewers := req.Reviewers()
+		prevItems := req.Items()
 		if param.State != nil {
 			if *param.State == request.StateApproved {
 				return nil, rerror.NewE(i18n.T("can't update by approve"))
@@ -168,7 +201,7 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 					return nil, rerror.NewE(i18n.T("reviewer should be owner or maintainer"))
 				}
 			}
-			req.SetReviewers(param.Reviewers)
+			req.SetReviewers(withRequiredReviewers(param.Reviewers, prj.ApprovalPolicy()))
 		}
 
 		if param.Items != nil {
@@ -179,12 +212,58 @@ func (r Request) Update(ctx context.Context, param interfaces.UpdateRequestParam
 			if err := req.SetItems(*items); err != nil {
 				return nil, err
 			}
+			if prj.ApprovalPolicy().ResetOnChange() {
+				req.ResetReviews()
+			}
 		}
 		req.SetUpdatedAt(util.Now())
 		if err := r.repos.Request.Save(ctx, req); err != nil {
 			return nil, err
 		}
 
+		if req.State() == request.StateClosed {
+			if prevState != request.StateClosed {
+				if err := unstageRequestItems(ctx, r.repos, req.Ref(), prevItems); err != nil {
+					return nil, err
+				}
+			}
+		} else if param.Items != nil || prevState == request.StateClosed {
+			if err := stageRequestItems(ctx, r.repos, req, prevItems); err != nil {
+				return nil, err
+			}
+		}
+
+		if req.State() != prevState {
+			var ty event.Type
+			switch req.State() {
+			case request.StateWaiting:
+				ty = event.RequestSubmit
+			case request.StateClosed:
+				ty = event.RequestClose
+			}
+			if ty != "" {
+				if err := r.requestEvent(ctx, ty, prj, req, operator); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// reviewers are notified when the request is submitted, or when they are added to the waiting request
+		if req.State() == request.StateWaiting {
+			reviewers := req.Reviewers()
+			if prevState == request.StateWaiting {
+				reviewers = lo.Filter(reviewers, func(u accountdomain.UserID, _ int) bool { return !prevReviewers.Has(u) })
+			}
+			if err := r.notify(ctx, notification.TypeReviewRequested, prj, req, reviewers, operator); err != nil {
+				return nil, err
+			}
+		}
+		if req.State() == request.StateClosed && prevState != request.StateClosed {
+			if err := r.notify(ctx, notification.TypeRequestClosed, prj, req, accountdomain.UserIDList{req.CreatedBy()}, operator); err != nil {
+				return nil, err
+			}
+		}
+
 		return req, nil
 	})
 }
@@ -229,8 +308,43 @@ func (r Request) CloseAll(ctx context.Context, pid id.ProjectID, ids id.RequestI
 		return err
 	}
 
+	closing := lo.Filter(reqs, func(req *request.Request, _ int) bool { return req.State() != request.StateClosed })
 	reqs.UpdateStatus(request.StateClosed)
-	return r.repos.Request.SaveAll(ctx, pid, reqs)
+	if err := r.repos.Request.SaveAll(ctx, pid, reqs); err != nil {
+		return err
+	}
+
+	if len(closing) == 0 {
+		return nil
+	}
+	for _, req := range closing {
+		if err := unstageRequestItems(ctx, r.repos, req.Ref(), req.Items()); err != nil {
+			return err
+		}
+	}
+	prj, err := r.repos.Project.FindByID(ctx, pid)
+	if err != nil {
+		return err
+	}
+	for _, req := range closing {
+		if err := r.notify(ctx, notification.TypeRequestClosed, prj, req, accountdomain.UserIDList{req.CreatedBy()}, operator); err != nil {
+			return err
+		}
+	}
+
+	if r.ignoreEvent {
+		return nil
+	}
+	_, err = createEvents(ctx, r.repos, r.gateways, lo.Map(closing, func(req *request.Request, _ int) Event {
+		return Event{
+			Project:   prj,
+			Workspace: req.Workspace(),
+			Type:      event.RequestClose,
+			Object:    req,
+			Operator:  operator.Operator(),
+		}
+	}))
+	return err
 }
 
 func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *usecase.Operator) (*request.Request, error) {
@@ -259,11 +373,42 @@ func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *
 		if req.State() != request.StateWaiting {
 			return nil, rerror.NewE(i18n.T("only requests with status waiting can be approved"))
 		}
+
+		var roleOf func(request.UserID) workspace.Role
+		if len(prj.ApprovalPolicy().RequiredRoles()) > 0 {
+			ws, err := r.repos.Workspace.FindByID(ctx, req.Workspace())
+			if err != nil {
+				return nil, err
+			}
+			roleOf = ws.Members().UserRole
+		}
+
+		req.SetReview(request.NewReview(*operator.AcOperator.User, request.DecisionApproved, util.Now()))
+		// the request waits for other reviewers until the approval policy of the project is satisfied
+		if !prj.ApprovalPolicy().IsSatisfied(req, roleOf) {
+			req.SetUpdatedAt(util.Now())
+			if err := r.repos.Request.Save(ctx, req); err != nil {
+				return nil, err
+			}
+			if err := r.requestEvent(ctx, event.RequestReview, prj, req, operator); err != nil {
+				return nil, err
+			}
+			return req, nil
+		}
 		req.SetState(request.StateApproved)
 
 		if err := r.repos.Request.Save(ctx, req); err != nil {
 			return nil, err
 		}
+		if err := r.requestEvent(ctx, event.RequestReview, prj, req, operator); err != nil {
+			return nil, err
+		}
+		if err := r.requestEvent(ctx, event.RequestApprove, prj, req, operator); err != nil {
+			return nil, err
+		}
+		if err := r.notify(ctx, notification.TypeRequestApproved, prj, req, accountdomain.UserIDList{req.CreatedBy()}, operator); err != nil {
+			return nil, err
+		}
 
 		// apply changes to items (publish items)
 		for _, itm := range req.Items() {
@@ -278,6 +423,12 @@ func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *
 			}
 		}
 
+		publicItems, err := r.repos.Item.FindByIDs(ctx, req.Items().IDs(), version.Public.Ref())
+		if err != nil {
+			return nil, err
+		}
+		indexItems(ctx, r.repos, r.gateways, version.Public, publicItems.Unwrap()...)
+
 		items, err := r.repos.Item.FindByIDs(ctx, req.Items().IDs(), nil)
 		if err != nil {
 			return nil, err
@@ -314,6 +465,101 @@ func (r Request) Approve(ctx context.Context, requestID id.RequestID, operator *
 	})
 }
 
+func (r Request) RequestChanges(ctx context.Context, requestID id.RequestID, operator *usecase.Operator) (*request.Request, error) {
+	if operator.AcOperator.User == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, operator, r.repos, Usecase().Transaction(), func(ctx context.Context) (*request.Request, error) {
+		req, err := r.repos.Request.FindByID(ctx, requestID)
+		if err != nil {
+			return nil, err
+		}
+		if !operator.IsOwningWorkspace(req.Workspace()) && !operator.IsMaintainingWorkspace(req.Workspace()) {
+			return nil, interfaces.ErrInvalidOperator
+		}
+		// only reviewers can request changes
+		if !req.Reviewers().Has(*operator.AcOperator.User) {
+			return nil, rerror.NewE(i18n.T("only reviewers can request changes"))
+		}
+		if req.State() != request.StateWaiting {
+			return nil, rerror.NewE(i18n.T("only requests with status waiting can be reviewed"))
+		}
+
+		prj, err := r.repos.Project.FindByID(ctx, req.Project())
+		if err != nil {
+			return nil, err
+		}
+
+		req.SetReview(request.NewReview(*operator.AcOperator.User, request.DecisionChangesRequested, util.Now()))
+		req.SetUpdatedAt(util.Now())
+		if err := r.repos.Request.Save(ctx, req); err != nil {
+			return nil, err
+		}
+		if err := r.requestEvent(ctx, event.RequestReview, prj, req, operator); err != nil {
+			return nil, err
+		}
+		if err := r.notify(ctx, notification.TypeChangesRequested, prj, req, accountdomain.UserIDList{req.CreatedBy()}, operator); err != nil {
+			return nil, err
+		}
+
+		return req, nil
+	})
+}
+
+// stageRequestItems points the ref of the request to the versions of its items to preview them, and removes the ref from the items which were removed from the request.
+func stageRequestItems(ctx context.Context, r *repo.Container, req *request.Request, prev request.ItemList) error {
+	ids := req.Items().IDs()
+	removed := lo.Filter(prev, func(itm *request.Item, _ int) bool { return !ids.Has(itm.Item()) })
+	if err := unstageRequestItems(ctx, r, req.Ref(), removed); err != nil {
+		return err
+	}
+	for _, itm := range req.Items() {
+		if itm.Pointer().IsZero() {
+			continue
+		}
+		if err := r.Item.UpdateRef(ctx, itm.Item(), req.Ref(), itm.Pointer().Ref()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unstageRequestItems removes the ref of the request from the items.
+func unstageRequestItems(ctx context.Context, r *repo.Container, ref version.Ref, items request.ItemList) error {
+	for _, itm := range items {
+		if err := r.Item.UpdateRef(ctx, itm.Item(), ref, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withRequiredReviewers adds the required reviewers of the approval policy to the reviewers.
+func withRequiredReviewers(reviewers accountdomain.UserIDList, p *request.ApprovalPolicy) accountdomain.UserIDList {
+	res := slices.Clone(reviewers)
+	for _, u := range p.RequiredReviewers() {
+		if !res.Has(u) {
+			res = append(res, u)
+		}
+	}
+	return res
+}
+
+func (r Request) requestEvent(ctx context.Context, ty event.Type, prj *project.Project, req *request.Request, operator *usecase.Operator) error {
+	return r.event(ctx, Event{
+		Project:   prj,
+		Workspace: req.Workspace(),
+		Type:      ty,
+		Object:    req,
+		Operator:  operator.Operator(),
+	})
+}
+
+func (r Request) notify(ctx context.Context, ty notification.Type, prj *project.Project, req *request.Request, users accountdomain.UserIDList, operator *usecase.Operator) error {
+	return notifyRequest(ctx, r.repos, r.gateways, r.webHost, ty, prj, req, users, nil, operator)
+}
+
 func (r Request) event(ctx context.Context, e Event) error {
 	if r.ignoreEvent {
 		return nil
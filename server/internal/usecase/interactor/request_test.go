@@ -8,15 +8,18 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/account/accountusecase"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/reearth/reearthx/util"
@@ -107,7 +110,7 @@ func TestRequest_FindByID(t *testing.T) {
 				err := db.Request.Save(ctx, p)
 				assert.NoError(t, err)
 			}
-			requestUC := NewRequest(db, nil)
+			requestUC := NewRequest(db, nil, "")
 
 			got, err := requestUC.FindByID(ctx, tc.args.id, tc.args.operator)
 			if tc.wantErr != nil {
@@ -214,7 +217,7 @@ func TestRequest_FindByIDs(t *testing.T) {
 				err := db.Request.Save(ctx, p)
 				assert.NoError(t, err)
 			}
-			requestUC := NewRequest(db, nil)
+			requestUC := NewRequest(db, nil, "")
 
 			got, err := requestUC.FindByIDs(ctx, tc.args.ids, tc.args.operator)
 			if tc.wantErr != nil {
@@ -335,7 +338,7 @@ func TestRequest_FindByProject(t *testing.T) {
 				err := db.Request.Save(ctx, p)
 				assert.NoError(t, err)
 			}
-			requestUC := NewRequest(db, nil)
+			requestUC := NewRequest(db, nil, "")
 
 			got, _, err := requestUC.FindByProject(ctx, tc.args.pid, tc.args.filter, nil, nil, tc.args.operator)
 			if tc.wantErr != nil {
@@ -397,7 +400,7 @@ func TestRequest_Approve(t *testing.T) {
 	err = db.Request.Save(ctx, req1)
 	assert.NoError(t, err)
 
-	requestUC := NewRequest(db, nil)
+	requestUC := NewRequest(db, nil, "")
 	_, err = requestUC.Approve(ctx, req1.ID(), op)
 	assert.NoError(t, err)
 
@@ -407,3 +410,150 @@ func TestRequest_Approve(t *testing.T) {
 	expected := version.MustBeValue(itm.Version(), nil, version.NewRefs(version.Public, version.Latest), now, i)
 	assert.Equal(t, expected, itm)
 }
+
+func TestRequest_Approve_ApprovalPolicy(t *testing.T) {
+	now := util.Now()
+	defer util.MockNow(now)()
+
+	wid := accountdomain.NewWorkspaceID()
+	u1 := user.New().Name("aaa").NewID().Email("aaa@bbb.com").Workspace(wid).MustBuild()
+	u2 := user.New().Name("bbb").NewID().Email("bbb@bbb.com").Workspace(wid).MustBuild()
+	ap, err := request.NewApprovalPolicy(2, nil, nil, false)
+	assert.NoError(t, err)
+	prj := project.New().NewID().ApprovalPolicy(ap).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).RandomKey().MustBuild()
+	i := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	assert.NoError(t, db.Project.Save(ctx, prj))
+	assert.NoError(t, db.Schema.Save(ctx, s))
+	assert.NoError(t, db.Model.Save(ctx, m))
+	assert.NoError(t, db.Item.Save(ctx, i))
+
+	vi, err := db.Item.FindByID(ctx, i.ID(), nil)
+	assert.NoError(t, err)
+	ri, _ := request.NewItem(i.ID(), lo.ToPtr(vi.Version().String()))
+	req := request.New().
+		NewID().
+		Workspace(wid).
+		Project(prj.ID()).
+		Reviewers(accountdomain.UserIDList{u1.ID(), u2.ID()}).
+		CreatedBy(accountdomain.NewUserID()).
+		Thread(id.NewThreadID().Ref()).
+		Items(request.ItemList{ri}).
+		Title("foo").
+		MustBuild()
+	assert.NoError(t, db.Request.Save(ctx, req))
+
+	op := func(u *user.User) *usecase.Operator {
+		return &usecase.Operator{
+			AcOperator: &accountusecase.Operator{
+				User:             lo.ToPtr(u.ID()),
+				OwningWorkspaces: accountdomain.WorkspaceIDList{wid},
+			},
+		}
+	}
+	requestUC := NewRequest(db, nil, "")
+	requestUC.ignoreEvent = true
+
+	// the first approval does not satisfy the policy
+	res, err := requestUC.Approve(ctx, req.ID(), op(u1))
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateWaiting, res.State())
+	assert.Equal(t, request.DecisionApproved, res.Review(u1.ID()).Decision())
+	_, err = db.Item.FindByID(ctx, i.ID(), version.Public.Ref())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+
+	res, err = requestUC.RequestChanges(ctx, req.ID(), op(u2))
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateWaiting, res.State())
+	assert.Equal(t, request.DecisionChangesRequested, res.Review(u2.ID()).Decision())
+
+	// the decision of the reviewer is replaced by the approval
+	res, err = requestUC.Approve(ctx, req.ID(), op(u2))
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateApproved, res.State())
+	assert.Equal(t, 2, len(res.Reviews()))
+	_, err = db.Item.FindByID(ctx, i.ID(), version.Public.Ref())
+	assert.NoError(t, err)
+
+	_, err = requestUC.RequestChanges(ctx, req.ID(), op(u1))
+	assert.Equal(t, "only requests with status waiting can be reviewed", err.Error())
+
+	// the creator is notified of the requested changes and the approval
+	notifications, _, err := db.Notification.FindByUser(ctx, req.CreatedBy(), repo.NotificationFilter{}, nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []notification.Type{notification.TypeRequestApproved, notification.TypeChangesRequested}, lo.Map(notifications, func(n *notification.Notification, _ int) notification.Type { return n.Type() }))
+}
+
+func TestRequest_Approve_ApprovalPolicyRoles(t *testing.T) {
+	u1 := user.New().Name("aaa").NewID().Email("aaa@bbb.com").MustBuild()
+	u2 := user.New().Name("bbb").NewID().Email("bbb@bbb.com").MustBuild()
+	ws := workspace.New().NewID().Members(map[user.ID]workspace.Member{
+		u1.ID(): {Role: workspace.RoleMaintainer},
+		u2.ID(): {Role: workspace.RoleOwner},
+	}).MustBuild()
+	ap := lo.Must(request.NewApprovalPolicy(1, nil, []workspace.Role{workspace.RoleOwner}, true))
+	prj := project.New().NewID().Workspace(ws.ID()).ApprovalPolicy(ap).MustBuild()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	s := schema.New().NewID().Workspace(ws.ID()).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Project(prj.ID()).Schema(s.ID()).RandomKey().MustBuild()
+	i := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, i))
+
+	vi := lo.Must(db.Item.FindByID(ctx, i.ID(), nil))
+	ri, _ := request.NewItem(i.ID(), lo.ToPtr(vi.Version().String()))
+	req := request.New().
+		NewID().
+		Workspace(ws.ID()).
+		Project(prj.ID()).
+		Reviewers(accountdomain.UserIDList{u1.ID(), u2.ID()}).
+		CreatedBy(u1.ID()).
+		Thread(id.NewThreadID().Ref()).
+		Items(request.ItemList{ri}).
+		Title("foo").
+		MustBuild()
+	lo.Must0(db.Request.Save(ctx, req))
+
+	op := func(u *user.User) *usecase.Operator {
+		return &usecase.Operator{
+			AcOperator: &accountusecase.Operator{
+				User:             lo.ToPtr(u.ID()),
+				OwningWorkspaces: accountdomain.WorkspaceIDList{ws.ID()},
+			},
+			MaintainableProjects: id.ProjectIDList{prj.ID()},
+		}
+	}
+	requestUC := NewRequest(db, nil, "")
+	requestUC.ignoreEvent = true
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+
+	// the maintainer does not satisfy the required owner
+	res, err := requestUC.Approve(ctx, req.ID(), op(u1))
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateWaiting, res.State())
+	assert.Len(t, res.Reviews(), 1)
+
+	// updating an item of the request resets the reviews
+	_, err = itemUC.Update(ctx, interfaces.UpdateItemParam{
+		ItemID: i.ID(),
+		Fields: []interfaces.ItemFieldParam{{Field: sf.ID().Ref(), Value: "changed"}},
+	}, op(u1))
+	assert.NoError(t, err)
+	res = lo.Must(db.Request.FindByID(ctx, req.ID()))
+	assert.Empty(t, res.Reviews())
+
+	res, err = requestUC.Approve(ctx, req.ID(), op(u2))
+	assert.NoError(t, err)
+	assert.Equal(t, request.StateApproved, res.State())
+}
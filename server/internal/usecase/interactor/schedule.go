@@ -0,0 +1,266 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+const scheduleLockName = "schedule"
+
+type Schedule struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+	item     *Item
+}
+
+func NewSchedule(r *repo.Container, g *gateway.Container) interfaces.Schedule {
+	return &Schedule{
+		repos:    r,
+		gateways: g,
+		item:     NewItem(r, g),
+	}
+}
+
+func (i Schedule) FindByProject(ctx context.Context, pid id.ProjectID, _ *usecase.Operator) (schedule.List, error) {
+	return i.repos.Schedule.FindByProject(ctx, pid)
+}
+
+func (i Schedule) FindByItems(ctx context.Context, ids id.ItemIDList, _ *usecase.Operator) (schedule.List, error) {
+	return i.repos.Schedule.FindByItems(ctx, ids)
+}
+
+func (i Schedule) ScheduleItems(ctx context.Context, param interfaces.ScheduleItemsParam, op *usecase.Operator) (schedule.List, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+	if len(param.Items) == 0 {
+		return nil, interfaces.ErrEmptyIDsList
+	}
+
+	now := util.Now()
+	if (param.PublishAt != nil && !param.PublishAt.After(now)) || (param.UnpublishAt != nil && !param.UnpublishAt.After(now)) {
+		return nil, interfaces.ErrScheduleInPast
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (schedule.List, error) {
+		items, wid, err := i.checkItems(ctx, param.Items, op)
+		if err != nil {
+			return nil, err
+		}
+
+		existing, err := i.repos.Schedule.FindByItems(ctx, param.Items)
+		if err != nil {
+			return nil, err
+		}
+
+		res := make(schedule.List, 0, len(items))
+		for _, itm := range items {
+			s := existing.FindByItem(itm.ID())
+			if s == nil {
+				s, err = schedule.New().
+					NewID().
+					Workspace(wid).
+					Project(itm.Project()).
+					Model(itm.Model()).
+					Item(itm.ID()).
+					PublishAt(param.PublishAt).
+					UnpublishAt(param.UnpublishAt).
+					Build()
+			} else {
+				err = s.SetTimes(param.PublishAt, param.UnpublishAt)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if op.AcOperator.User != nil {
+				s.SetUser(*op.AcOperator.User)
+			} else {
+				s.SetIntegration(*op.Integration)
+			}
+			s.SetUpdatedAt(now)
+
+			if err := i.repos.Schedule.Save(ctx, s); err != nil {
+				return nil, err
+			}
+			res = append(res, s)
+		}
+		return res, nil
+	})
+}
+
+func (i Schedule) CancelItems(ctx context.Context, ids id.ItemIDList, op *usecase.Operator) error {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return interfaces.ErrInvalidOperator
+	}
+	if len(ids) == 0 {
+		return interfaces.ErrEmptyIDsList
+	}
+
+	return Run0(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) error {
+		if _, _, err := i.checkItems(ctx, ids, op); err != nil {
+			return err
+		}
+
+		schedules, err := i.repos.Schedule.FindByItems(ctx, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range schedules {
+			if err := i.repos.Schedule.Remove(ctx, s.ID()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (i Schedule) RunDue(ctx context.Context, now time.Time) (int, error) {
+	if err := i.repos.Lock.Lock(ctx, scheduleLockName); err != nil {
+		if errors.Is(err, repo.ErrFailedToLock) || errors.Is(err, repo.ErrAlreadyLocked) {
+			// another instance is running the schedules
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer func() {
+		if err := i.repos.Lock.Unlock(ctx, scheduleLockName); err != nil {
+			log.Errorfc(ctx, "schedule: failed to unlock: %v", err)
+		}
+	}()
+
+	schedules, err := i.repos.Schedule.FindDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, s := range schedules {
+		if err := i.run(ctx, s, now); err != nil {
+			log.Errorfc(ctx, "schedule: failed to run schedule %s of item %s: %v", s.ID(), s.Item(), err)
+		}
+	}
+	return len(schedules), nil
+}
+
+func (i Schedule) run(ctx context.Context, s *schedule.Schedule, now time.Time) error {
+	op, err := i.operator(ctx, s)
+	if err == nil {
+		// the actions overdue together are applied in the order of their times
+		for _, a := range s.DueActions(now) {
+			if a == schedule.ActionPublish {
+				_, err = i.item.Publish(ctx, id.ItemIDList{s.Item()}, op)
+			} else {
+				_, err = i.item.Unpublish(ctx, id.ItemIDList{s.Item()}, op)
+			}
+			if err == nil || isPermanentScheduleError(err) {
+				s.Clear(a)
+			}
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil && isPermanentScheduleError(err) {
+		// the schedule can never succeed, so it is dropped
+		log.Warnfc(ctx, "schedule: schedule %s of item %s was dropped: %v", s.ID(), s.Item(), err)
+		s.ClearPublishAt()
+		s.ClearUnpublishAt()
+		err = nil
+	}
+
+	if s.IsEmpty() {
+		if err2 := i.repos.Schedule.Remove(ctx, s.ID()); err2 != nil {
+			return err2
+		}
+		return err
+	}
+
+	// a failed schedule is kept and retried at the next run
+	s.SetUpdatedAt(now)
+	if err2 := i.repos.Schedule.Save(ctx, s); err2 != nil {
+		return err2
+	}
+	return err
+}
+
+// operator returns the operator who registered the schedule, as long as they can still publish items in the workspace.
+func (i Schedule) operator(ctx context.Context, s *schedule.Schedule) (*usecase.Operator, error) {
+	ws, err := i.repos.Workspace.FindByID(ctx, s.Workspace())
+	if err != nil {
+		return nil, err
+	}
+
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			MaintainableWorkspaces: accountdomain.WorkspaceIDList{ws.ID()},
+		},
+		MaintainableProjects: id.ProjectIDList{s.Project()},
+	}
+
+	var role workspace.Role
+	if u := s.User(); u != nil {
+		role = ws.Members().UserRole(*u)
+		op.AcOperator.User = u
+	} else if in := s.Integration(); in != nil {
+		aid, err := accountdomain.IntegrationIDFrom(in.String())
+		if err != nil {
+			return nil, err
+		}
+		role = ws.Members().IntegrationRole(aid)
+		op.Integration = in
+	}
+
+	if !role.Includes(workspace.RoleMaintainer) {
+		return nil, interfaces.ErrOperationDenied
+	}
+	return op, nil
+}
+
+func (i Schedule) checkItems(ctx context.Context, ids id.ItemIDList, op *usecase.Operator) ([]*item.Item, accountdomain.WorkspaceID, error) {
+	items, err := i.repos.Item.FindByIDs(ctx, ids, nil)
+	if err != nil {
+		return nil, accountdomain.WorkspaceID{}, err
+	}
+	if len(items) != len(ids) {
+		return nil, accountdomain.WorkspaceID{}, interfaces.ErrItemMissing
+	}
+	if lo.CountBy(items, func(itm item.Versioned) bool {
+		return itm.Value().Model() == items[0].Value().Model()
+	}) != len(items) {
+		return nil, accountdomain.WorkspaceID{}, interfaces.ErrItemsShouldBeOnSameModel
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, items[0].Value().Project())
+	if err != nil {
+		return nil, accountdomain.WorkspaceID{}, err
+	}
+	if !op.IsMaintainingWorkspace(prj.Workspace()) {
+		return nil, accountdomain.WorkspaceID{}, interfaces.ErrInvalidOperator
+	}
+
+	return items.Unwrap(), prj.Workspace(), nil
+}
+
+func isPermanentScheduleError(err error) bool {
+	return errors.Is(err, rerror.ErrNotFound) ||
+		errors.Is(err, interfaces.ErrItemMissing) ||
+		errors.Is(err, interfaces.ErrInvalidOperator) ||
+		errors.Is(err, interfaces.ErrOperationDenied)
+}
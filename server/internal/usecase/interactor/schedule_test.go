@@ -0,0 +1,157 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearthx/account/accountdomain/user"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer util.MockNow(now)()
+
+	u := user.New().NewID().Name("aaa").Email("aaa@bbb.com").MustBuild()
+	ws := workspace.New().NewID().Members(map[user.ID]workspace.Member{
+		u.ID(): {Role: workspace.RoleMaintainer},
+	}).MustBuild()
+	prj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	s := schema.New().NewID().Workspace(ws.ID()).Project(prj.ID()).MustBuild()
+	m := model.New().NewID().Project(prj.ID()).Schema(s.ID()).RandomKey().MustBuild()
+	i1 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).MustBuild()
+	i2 := item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).MustBuild()
+	i3 := item.New().NewID().Schema(s.ID()).Model(id.NewModelID()).Project(prj.ID()).Thread(id.NewThreadID().Ref()).MustBuild()
+
+	ctx := context.Background()
+	db := memory.New()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, i1))
+	lo.Must0(db.Item.Save(ctx, i2))
+	lo.Must0(db.Item.Save(ctx, i3))
+
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:                   lo.ToPtr(u.ID()),
+			MaintainableWorkspaces: id.WorkspaceIDList{ws.ID()},
+		},
+	}
+	uc := NewSchedule(db, nil)
+	publishAt := now.Add(time.Hour)
+	unpublishAt := now.Add(2 * time.Hour)
+
+	// invalid params
+	_, err := uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID()}, PublishAt: &publishAt}, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+	_, err = uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID()}, PublishAt: &now}, op)
+	assert.Equal(t, interfaces.ErrScheduleInPast, err)
+	_, err = uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID(), i3.ID()}, PublishAt: &publishAt}, op)
+	assert.Equal(t, interfaces.ErrItemsShouldBeOnSameModel, err)
+	_, err = uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID(), id.NewItemID()}, PublishAt: &publishAt}, op)
+	assert.Equal(t, interfaces.ErrItemMissing, err)
+	_, err = uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID()}, PublishAt: &publishAt}, &usecase.Operator{
+		AcOperator: &accountusecase.Operator{User: lo.ToPtr(u.ID()), WritableWorkspaces: id.WorkspaceIDList{ws.ID()}},
+	})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+
+	// schedule
+	res, err := uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID(), i2.ID()}, PublishAt: &publishAt}, op)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, &publishAt, res[0].PublishAt())
+	assert.Equal(t, lo.ToPtr(u.ID()), res[0].User())
+
+	// replace the existing schedule
+	res, err = uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID()}, PublishAt: &publishAt, UnpublishAt: &unpublishAt}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, &unpublishAt, res[0].UnpublishAt())
+
+	got, err := uc.FindByProject(ctx, prj.ID(), op)
+	assert.NoError(t, err)
+	assert.Len(t, got, 2)
+
+	// cancel
+	assert.NoError(t, uc.CancelItems(ctx, id.ItemIDList{i2.ID()}, op))
+	got, err = uc.FindByItems(ctx, id.ItemIDList{i1.ID(), i2.ID()}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, id.ItemIDList{i1.ID()}, got.Items())
+
+	status := func() item.Status {
+		st, err := NewItem(db, nil).ItemStatus(ctx, id.ItemIDList{i1.ID()}, op)
+		assert.NoError(t, err)
+		return st[i1.ID()]
+	}
+
+	// nothing is due yet
+	n, err := uc.RunDue(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, item.StatusDraft, status())
+
+	// publish
+	n, err = uc.RunDue(ctx, publishAt)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, item.StatusPublic, status())
+	got, err = uc.FindByItems(ctx, id.ItemIDList{i1.ID()}, op)
+	assert.NoError(t, err)
+	assert.Nil(t, got[0].PublishAt())
+	assert.Equal(t, &unpublishAt, got[0].UnpublishAt())
+
+	// unpublish
+	n, err = uc.RunDue(ctx, unpublishAt)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, item.StatusDraft, status())
+	got, err = uc.FindByItems(ctx, id.ItemIDList{i1.ID()}, op)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	// the actions overdue together are applied in the order of their times
+	_, err = NewItem(db, nil).Publish(ctx, id.ItemIDList{i1.ID()}, op)
+	assert.NoError(t, err)
+	_, err = uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID()}, PublishAt: &unpublishAt, UnpublishAt: &publishAt}, op)
+	assert.NoError(t, err)
+	n, err = uc.RunDue(ctx, unpublishAt.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, item.StatusPublic, status())
+	got, err = uc.FindByItems(ctx, id.ItemIDList{i1.ID()}, op)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i1.ID()}, PublishAt: &publishAt, UnpublishAt: &unpublishAt}, op)
+	assert.NoError(t, err)
+	n, err = uc.RunDue(ctx, unpublishAt.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, item.StatusDraft, status())
+
+	// the schedule is dropped when the user can no longer publish items
+	_, err = uc.ScheduleItems(ctx, interfaces.ScheduleItemsParam{Items: id.ItemIDList{i2.ID()}, PublishAt: &publishAt}, op)
+	assert.NoError(t, err)
+	lo.Must0(ws.Members().UpdateUserRole(u.ID(), workspace.RoleWriter))
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	n, err = uc.RunDue(ctx, publishAt)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	got, err = uc.FindByItems(ctx, id.ItemIDList{i2.ID()}, op)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/expression"
 	"github.com/reearth/reearth-cms/server/pkg/group"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
@@ -116,15 +117,23 @@ func (i Schema) CreateField(ctx context.Context, param interfaces.CreateFieldPar
 			return nil, schema.ErrInvalidKey
 		}
 
+		expr, err := parseExpression(param.Expression)
+		if err != nil {
+			return nil, err
+		}
+
 		f, err := schema.NewField(param.TypeProperty).
 			NewID().
 			Unique(param.Unique).
 			Multiple(param.Multiple).
 			Required(param.Required).
+			Localized(param.Localized).
 			Name(param.Name).
 			Description(lo.FromPtr(param.Description)).
 			Key(id.NewKey(param.Key)).
 			DefaultValue(param.DefaultValue).
+			ValidationRules(param.ValidationRules).
+			Expression(expr).
 			Build()
 		if err != nil {
 			return nil, err
@@ -150,6 +159,14 @@ func (i Schema) CreateField(ctx context.Context, param interfaces.CreateFieldPar
 			}
 		}
 
+		if f.IsComputed() {
+			s2 := s.Clone()
+			s2.AddField(f)
+			if err := s2.ValidateExpressions(); err != nil {
+				return nil, err
+			}
+		}
+
 		s.AddField(f)
 
 		if err := setTitleField(&param.IsTitle, s, f.ID().Ref()); err != nil {
@@ -239,6 +256,10 @@ func (i Schema) UpdateField(ctx context.Context, param interfaces.UpdateFieldPar
 			return nil, err
 		}
 
+		if err := s.ValidateExpressions(); err != nil {
+			return nil, err
+		}
+
 		if err := setTitleField(param.IsTitle, s, f.ID().Ref()); err != nil {
 			return nil, err
 		}
@@ -336,6 +357,14 @@ func (i Schema) DeleteField(ctx context.Context, schemaId id.SchemaID, fieldID i
 			if f == nil {
 				return interfaces.ErrFieldNotFound
 			}
+
+			// the field can not be deleted while a computed field refers to it
+			s2 := s.Clone()
+			s2.RemoveField(fieldID)
+			if err := s2.ValidateExpressions(); err != nil {
+				return err
+			}
+
 			if f.Type() == value.TypeReference {
 				err := i.deleteCorrespondingField(ctx, s, f)
 				if err != nil {
@@ -391,6 +420,9 @@ func (i Schema) UpdateFields(ctx context.Context, sid id.SchemaID, params []inte
 				return nil, err
 			}
 		}
+		if err := s.ValidateExpressions(); err != nil {
+			return nil, err
+		}
 		if err := i.repos.Schema.Save(ctx, s); err != nil {
 			return nil, err
 		}
@@ -441,13 +473,65 @@ func updateField(param interfaces.UpdateFieldParam, f *schema.Field) error {
 		f.SetRequired(*param.Required)
 	}
 
+	if param.Localized != nil {
+		if err := f.SetLocalized(*param.Localized); err != nil {
+			return err
+		}
+	}
+
 	if param.Unique != nil {
 		f.SetUnique(*param.Unique)
 	}
 
+	if param.ValidationRules != nil {
+		if err := f.SetValidationRules(param.ValidationRules); err != nil {
+			return err
+		}
+	}
+
+	if param.Expression != nil {
+		expr, err := parseExpression(*param.Expression)
+		if err != nil {
+			return err
+		}
+		if err := f.SetExpression(expr); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// parseExpression parses the expression of a computed field. An empty string means that the field is not computed.
+func parseExpression(src string) (*expression.Expr, error) {
+	if src == "" {
+		return nil, nil
+	}
+	return expression.Parse(src)
+}
+
+func (i Schema) UpdateValidationRules(ctx context.Context, param interfaces.UpdateValidationRulesParam, op *usecase.Operator) (*schema.Schema, error) {
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*schema.Schema, error) {
+		s, err := i.repos.Schema.FindByID(ctx, param.SchemaID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !op.IsMaintainingProject(s.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		if err := s.SetValidationRules(param.Rules); err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Schema.Save(ctx, s); err != nil {
+			return nil, err
+		}
+		return s, nil
+	})
+}
+
 func (i Schema) GetSchemasAndGroupSchemasByIDs(ctx context.Context, list id.SchemaIDList, _ *usecase.Operator) (schemas schema.List, groupSchemas schema.List, err error) {
 	schemas, err = i.repos.Schema.FindByIDs(ctx, list)
 	if err != nil {
@@ -512,15 +596,23 @@ func (i Schema) CreateFields(ctx context.Context, sId id.SchemaID, createFieldsP
 					return nil, id.ErrDuplicatedKey
 				}
 
+				expr, err := parseExpression(createFieldParam.Expression)
+				if err != nil {
+					return nil, err
+				}
+
 				newField, err := schema.NewField(createFieldParam.TypeProperty).
 					NewID().
 					Unique(createFieldParam.Unique).
 					Multiple(createFieldParam.Multiple).
 					Required(createFieldParam.Required).
+					Localized(createFieldParam.Localized).
 					Name(createFieldParam.Name).
 					Description(lo.FromPtr(createFieldParam.Description)).
 					Key(id.NewKey(createFieldParam.Key)).
 					DefaultValue(createFieldParam.DefaultValue).
+					ValidationRules(createFieldParam.ValidationRules).
+					Expression(expr).
 					Build()
 				if err != nil {
 					return nil, err
@@ -553,6 +645,10 @@ func (i Schema) CreateFields(ctx context.Context, sId id.SchemaID, createFieldsP
 				}
 			}
 
+			if err := s.ValidateExpressions(); err != nil {
+				return nil, err
+			}
+
 			if err := i.repos.Schema.Save(ctx, s); err != nil {
 				return nil, err
 			}
@@ -0,0 +1,203 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/samber/lo"
+)
+
+const convertFieldPageSize = 100
+
+func (i Schema) ConvertField(ctx context.Context, param interfaces.ConvertFieldParam, op *usecase.Operator) (*interfaces.ConvertFieldResult, error) {
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*interfaces.ConvertFieldResult, error) {
+		s, err := i.repos.Schema.FindByID(ctx, param.SchemaID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !op.IsMaintainingProject(s.Project()) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		// only the fields of models are supported as the values of group fields are stored in the items of other schemas
+		m, err := i.repos.Model.FindByID(ctx, param.ModelID)
+		if err != nil {
+			return nil, err
+		}
+		if m.Schema() != s.ID() && lo.FromPtr(m.Metadata()) != s.ID() {
+			return nil, interfaces.ErrFieldTypeNotConvertible
+		}
+
+		f := s.Field(param.FieldID)
+		if f == nil {
+			return nil, interfaces.ErrFieldNotFound
+		}
+		if param.TypeProperty == nil || !schema.IsConvertible(f.Type(), param.TypeProperty.Type()) {
+			return nil, interfaces.ErrFieldTypeNotConvertible
+		}
+
+		// the field is converted as a copy first so that nothing is changed when the converted values break the unique constraint
+		c := f.Clone()
+		if err := convertField(param, c); err != nil {
+			return nil, err
+		}
+		if err := i.checkConvertedUnique(ctx, s.ID(), c); err != nil {
+			return nil, err
+		}
+
+		if param.DryRun {
+			f = c
+		} else {
+			if err := convertField(param, f); err != nil {
+				return nil, err
+			}
+			if err := i.repos.Schema.Save(ctx, s); err != nil {
+				return nil, err
+			}
+		}
+
+		res := &interfaces.ConvertFieldResult{Field: f}
+		var cur *usecasex.Cursor
+		for {
+			items, pi, err := i.repos.Item.FindBySchema(ctx, s.ID(), nil, nil, usecasex.CursorPagination{
+				After: cur,
+				First: lo.ToPtr(int64(convertFieldPageSize)),
+			}.Wrap())
+			if err != nil {
+				return nil, err
+			}
+
+			if param.AllVersions && len(items) > 0 {
+				items, err = i.repos.Item.FindAllVersionsByIDs(ctx, items.Unwrap().IDs())
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			for _, v := range items {
+				if err := i.convertItem(ctx, v, f, param.DryRun, res); err != nil {
+					return nil, err
+				}
+			}
+
+			if pi == nil || !pi.HasNextPage {
+				break
+			}
+			cur = pi.EndCursor
+		}
+		return res, nil
+	})
+}
+
+// convertField changes the type property of the field, converting the current default value unless a new one is given.
+func convertField(param interfaces.ConvertFieldParam, f *schema.Field) error {
+	dv := f.DefaultValue()
+	_ = f.SetDefaultValue(nil)
+
+	if param.Multiple != nil {
+		f.SetMultiple(*param.Multiple)
+	}
+	if err := f.SetTypeProperty(param.TypeProperty); err != nil {
+		return err
+	}
+
+	if param.DefaultValue != nil {
+		dv = param.DefaultValue
+	} else {
+		dv, _ = f.ConvertValue(dv)
+	}
+	return f.SetDefaultValue(dv)
+}
+
+// checkConvertedUnique returns ErrDuplicatedItemValue when the converted values of the unique field collide in the latest versions of the items,
+// e.g. when different texts are cast to the same number.
+func (i Schema) checkConvertedUnique(ctx context.Context, sid id.SchemaID, f *schema.Field) error {
+	if !f.Unique() {
+		return nil
+	}
+
+	seen := map[string]id.ItemID{}
+	var cur *usecasex.Cursor
+	for {
+		items, pi, err := i.repos.Item.FindBySchema(ctx, sid, nil, nil, usecasex.CursorPagination{
+			After: cur,
+			First: lo.ToPtr(int64(convertFieldPageSize)),
+		}.Wrap())
+		if err != nil {
+			return err
+		}
+
+		for _, v := range items {
+			it := v.Value()
+			g, _ := it.Field(f.ID()).Convert(f)
+			if g == nil {
+				continue
+			}
+
+			values := map[locale.Locale]*value.Multiple{"": g.Value()}
+			for l, lv := range g.Locales() {
+				values[l] = lv
+			}
+			for l, mv := range values {
+				if mv.IsEmpty() {
+					continue
+				}
+				key := fmt.Sprintf("%s\x00%v", l, mv.Interface())
+				if other, ok := seen[key]; ok && other != it.ID() {
+					return interfaces.ErrDuplicatedItemValue
+				}
+				seen[key] = it.ID()
+			}
+		}
+
+		if pi == nil || !pi.HasNextPage {
+			return nil
+		}
+		cur = pi.EndCursor
+	}
+}
+
+// convertItem casts the values of the field in the item version and overwrites the version unless it is a dry run.
+func (i Schema) convertItem(ctx context.Context, v item.Versioned, f *schema.Field, dryRun bool, res *interfaces.ConvertFieldResult) error {
+	it := v.Value()
+	fields := lo.Filter(it.Fields(), func(g *item.Field, _ int) bool { return g.FieldID() == f.ID() })
+	if len(fields) == 0 {
+		return nil
+	}
+	res.Items++
+
+	if dryRun {
+		// the item must not be changed as it may be shared with the repository
+		for _, g := range fields {
+			_, r := g.Convert(f)
+			res.ConversionResult = res.ConversionResult.Add(r)
+		}
+		return nil
+	}
+
+	res.ConversionResult = res.ConversionResult.Add(it.ConvertField(f))
+	if err := i.repos.Item.ReplaceVersion(ctx, v); err != nil {
+		if errors.Is(err, version.ErrArchived) {
+			return nil
+		}
+		return err
+	}
+
+	for _, ref := range []version.Ref{version.Latest, version.Public} {
+		if v.Refs().Has(ref) {
+			indexItems(ctx, i.repos, i.gateways, ref, it)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_ConvertField(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).Alias("project-a").Name("prj").MustBuild()
+
+	fid := id.NewFieldID()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).ID(fid).Key(id.NewKey("count")).Multiple(true).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.NewKey("model1")).Project(prj.ID()).MustBuild()
+
+	iid1, iid2 := id.NewItemID(), id.NewItemID()
+	newItem := func(iid id.ItemID, v ...any) *item.Item {
+		return item.New().ID(iid).Schema(s.ID()).Model(m.ID()).Project(prj.ID()).User(uid).Fields([]*item.Field{
+			item.NewField(fid, value.NewMultiple(value.TypeText, v), nil),
+		}).MustBuild()
+	}
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	lo.Must0(db.Item.Save(ctx, newItem(iid1, "1")))
+	lo.Must0(db.Item.Save(ctx, newItem(iid1, "2", "3")))
+	lo.Must0(db.Item.Save(ctx, newItem(iid2, "x")))
+
+	uc := NewSchema(db, &gateway.Container{})
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:                   &uid,
+			MaintainableWorkspaces: accountdomain.WorkspaceIDList{wid},
+		},
+		MaintainableProjects: id.ProjectIDList{prj.ID()},
+	}
+	param := interfaces.ConvertFieldParam{
+		ModelID:      m.ID(),
+		SchemaID:     s.ID(),
+		FieldID:      fid,
+		TypeProperty: lo.Must(schema.NewInteger(nil, nil)).TypeProperty(),
+		Multiple:     lo.ToPtr(false),
+		DryRun:       true,
+	}
+	valueOf := func(iid id.ItemID, vr version.VersionOrRef) *value.Multiple {
+		v := lo.Must(db.Item.FindVersionByID(ctx, iid, vr))
+		return v.Value().Field(fid).Value()
+	}
+
+	// dry run
+	res, err := uc.ConvertField(ctx, param, op)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Items)
+	assert.Equal(t, schema.ConversionResult{Converted: 1, Failed: 1, Lost: 1}, res.ConversionResult)
+	assert.Equal(t, value.TypeInteger, res.Field.Type())
+	assert.Equal(t, value.TypeText, lo.Must(db.Schema.FindByID(ctx, s.ID())).Field(fid).Type())
+	assert.Equal(t, value.NewMultiple(value.TypeText, []any{"2", "3"}), valueOf(iid1, version.Latest.OrVersion()))
+
+	res, err = uc.ConvertField(ctx, param, &usecase.Operator{AcOperator: &accountusecase.Operator{User: &uid}})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+	assert.Nil(t, res)
+
+	// references can not be converted
+	_, err = uc.ConvertField(ctx, interfaces.ConvertFieldParam{
+		ModelID:      m.ID(),
+		SchemaID:     s.ID(),
+		FieldID:      fid,
+		TypeProperty: schema.NewReference(m.ID(), s.ID(), nil, nil).TypeProperty(),
+	}, op)
+	assert.ErrorIs(t, err, interfaces.ErrFieldTypeNotConvertible)
+
+	// only the latest versions
+	param.DryRun = false
+	res, err = uc.ConvertField(ctx, param, op)
+	require.NoError(t, err)
+	assert.Equal(t, schema.ConversionResult{Converted: 1, Failed: 1, Lost: 1}, res.ConversionResult)
+	f := lo.Must(db.Schema.FindByID(ctx, s.ID())).Field(fid)
+	assert.Equal(t, value.TypeInteger, f.Type())
+	assert.False(t, f.Multiple())
+	assert.Equal(t, value.TypeInteger.Value(int64(2)).AsMultiple(), valueOf(iid1, version.Latest.OrVersion()))
+	assert.Nil(t, lo.Must(db.Item.FindByID(ctx, iid2, nil)).Value().Field(fid))
+
+	versions := lo.Must(db.Item.FindAllVersionsByID(ctx, iid1))
+	assert.Equal(t, 2, len(versions))
+	assert.Equal(t, value.TypeText.Value("1").AsMultiple(), valueOf(iid1, versions[0].Version().OrRef()))
+
+	// all versions
+	param.TypeProperty = lo.Must(schema.NewNumber(nil, nil)).TypeProperty()
+	param.AllVersions = true
+	res, err = uc.ConvertField(ctx, param, op)
+	require.NoError(t, err)
+	assert.Equal(t, 2, res.Items)
+	assert.Equal(t, schema.ConversionResult{Converted: 2}, res.ConversionResult)
+	assert.Equal(t, value.TypeNumber.Value(float64(1)).AsMultiple(), valueOf(iid1, versions[0].Version().OrRef()))
+	assert.Equal(t, value.TypeNumber.Value(float64(2)).AsMultiple(), valueOf(iid1, version.Latest.OrVersion()))
+	assert.Equal(t, 2, len(lo.Must(db.Item.FindAllVersionsByID(ctx, iid1))))
+}
+
+func TestSchema_ConvertField_Unique(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).Alias("project-a").Name("prj").MustBuild()
+
+	fid := id.NewFieldID()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).ID(fid).Key(id.NewKey("code")).Unique(true).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf}).MustBuild()
+	m := model.New().NewID().Schema(s.ID()).Key(id.NewKey("model1")).Project(prj.ID()).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+	lo.Must0(db.Model.Save(ctx, m))
+	for _, v := range []string{"1", "01"} {
+		lo.Must0(db.Item.Save(ctx, item.New().NewID().Schema(s.ID()).Model(m.ID()).Project(prj.ID()).User(uid).Fields([]*item.Field{
+			item.NewField(fid, value.TypeText.Value(v).AsMultiple(), nil),
+		}).MustBuild()))
+	}
+
+	uc := NewSchema(db, &gateway.Container{})
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:                   &uid,
+			MaintainableWorkspaces: accountdomain.WorkspaceIDList{wid},
+		},
+		MaintainableProjects: id.ProjectIDList{prj.ID()},
+	}
+	param := interfaces.ConvertFieldParam{
+		ModelID:      m.ID(),
+		SchemaID:     s.ID(),
+		FieldID:      fid,
+		TypeProperty: lo.Must(schema.NewInteger(nil, nil)).TypeProperty(),
+		DryRun:       true,
+	}
+
+	// "1" and "01" are cast to the same number
+	_, err := uc.ConvertField(ctx, param, op)
+	assert.ErrorIs(t, err, interfaces.ErrDuplicatedItemValue)
+
+	param.DryRun = false
+	_, err = uc.ConvertField(ctx, param, op)
+	assert.ErrorIs(t, err, interfaces.ErrDuplicatedItemValue)
+	assert.Equal(t, value.TypeText, lo.Must(db.Schema.FindByID(ctx, s.ID())).Field(fid).Type())
+
+	// values which stay distinct can be converted
+	param.TypeProperty = schema.NewTextArea(nil).TypeProperty()
+	_, err = uc.ConvertField(ctx, param, op)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,306 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/group"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/schemaspec"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/samber/lo"
+)
+
+type specState struct {
+	models  model.List
+	groups  group.List
+	schemas schema.List
+}
+
+func (i Schema) PlanSpec(ctx context.Context, param interfaces.SchemaSpecParam, op *usecase.Operator) (schemaspec.Plan, error) {
+	if !op.IsMaintainingProject(param.ProjectID) {
+		return nil, interfaces.ErrOperationDenied
+	}
+	if err := param.Spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	st, err := i.loadSpecState(ctx, param.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return schemaspec.Diff(schemaspec.FromProject(st.models, st.groups, st.schemas), param.Spec), nil
+}
+
+func (i Schema) ApplySpec(ctx context.Context, param interfaces.SchemaSpecParam, op *usecase.Operator) (schemaspec.Plan, error) {
+	if !op.IsMaintainingProject(param.ProjectID) {
+		return nil, interfaces.ErrOperationDenied
+	}
+	if err := param.Spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (schemaspec.Plan, error) {
+		prj, err := i.repos.Project.FindByID(ctx, param.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		st, err := i.loadSpecState(ctx, prj.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		current := schemaspec.FromProject(st.models, st.groups, st.schemas)
+		plan := schemaspec.Diff(current, param.Spec)
+		if plan.HasDestructiveChanges() && !param.AllowDestructive {
+			return nil, interfaces.ErrDestructiveSchemaChange
+		}
+		if len(plan) == 0 {
+			return plan, nil
+		}
+
+		if err := i.applySpec(ctx, prj, st, current, param.Spec, op); err != nil {
+			return nil, err
+		}
+		return plan, nil
+	})
+}
+
+func (i Schema) loadSpecState(ctx context.Context, pid id.ProjectID) (*specState, error) {
+	models, _, err := i.repos.Model.FindByProject(ctx, pid, nil)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := i.repos.Group.FindByProject(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	sids := lo.Map(models, func(m *model.Model, _ int) id.SchemaID { return m.Schema() })
+	schemas, err := i.repos.Schema.FindByIDs(ctx, append(sids, groups.SchemaIDs()...))
+	if err != nil {
+		return nil, err
+	}
+	return &specState{models: models, groups: groups, schemas: schemas}, nil
+}
+
+func (i Schema) applySpec(ctx context.Context, prj *project.Project, st *specState, current, spec *schemaspec.Spec, op *usecase.Operator) error {
+	schemas := lo.SliceToMap(st.schemas, func(s *schema.Schema) (id.SchemaID, *schema.Schema) { return s.ID(), s })
+	newSchema := func() (*schema.Schema, error) {
+		s, err := schema.New().NewID().Workspace(prj.Workspace()).Project(prj.ID()).TitleField(nil).Build()
+		if err != nil {
+			return nil, err
+		}
+		schemas[s.ID()] = s
+		return s, nil
+	}
+	r := &schemaspec.Resolver{
+		Models:      map[string]*model.Model{},
+		Groups:      map[string]*group.Group{},
+		ModelFields: map[string]map[string]id.FieldID{},
+	}
+
+	groups := lo.SliceToMap(st.groups, func(g *group.Group) (string, *group.Group) { return g.Key().String(), g })
+	var gl group.List
+	for o, gs := range spec.Groups {
+		g := groups[gs.Key]
+		if g == nil {
+			s, err := newSchema()
+			if err != nil {
+				return err
+			}
+			g, err = group.New().NewID().Schema(s.ID()).Project(prj.ID()).Key(id.NewKey(gs.Key)).Build()
+			if err != nil {
+				return err
+			}
+		}
+		g.SetName(gs.NameOrKey())
+		g.SetDescription(gs.Description)
+		g.SetOrder(o)
+		r.Groups[gs.Key] = g
+		gl = append(gl, g)
+	}
+
+	models := lo.SliceToMap(st.models, func(m *model.Model) (string, *model.Model) { return m.Key().String(), m })
+	var ml model.List
+	for o, ms := range spec.Models {
+		m := models[ms.Key]
+		if m == nil {
+			s, err := newSchema()
+			if err != nil {
+				return err
+			}
+			m, err = model.New().NewID().Schema(s.ID()).Project(prj.ID()).Key(id.NewKey(ms.Key)).Public(false).Build()
+			if err != nil {
+				return err
+			}
+		}
+		m.SetName(ms.NameOrKey())
+		m.SetDescription(ms.Description)
+		m.SetOrder(o)
+		r.Models[ms.Key] = m
+		ml = append(ml, m)
+	}
+
+	// the IDs of the fields are decided before building the fields, as two-way references refer to each other
+	groupFields := map[string]map[string]id.FieldID{}
+	for _, gs := range spec.Groups {
+		groupFields[gs.Key] = specFieldIDs(schemas[r.Groups[gs.Key].Schema()], lo.FromPtr(current.Group(gs.Key)).Fields, gs.Fields)
+	}
+	for _, ms := range spec.Models {
+		r.ModelFields[ms.Key] = specFieldIDs(schemas[r.Models[ms.Key].Schema()], lo.FromPtr(current.Model(ms.Key)).Fields, ms.Fields)
+	}
+
+	for _, gs := range spec.Groups {
+		s := schemas[r.Groups[gs.Key].Schema()]
+		if err := applySpecFields(s, gs.Fields, groupFields[gs.Key], r); err != nil {
+			return err
+		}
+		if err := applySpecRules(s, gs.ValidationRules, groupFields[gs.Key]); err != nil {
+			return err
+		}
+		if err := i.repos.Schema.Save(ctx, s); err != nil {
+			return err
+		}
+	}
+	for _, ms := range spec.Models {
+		s := schemas[r.Models[ms.Key].Schema()]
+		if err := applySpecFields(s, ms.Fields, r.ModelFields[ms.Key], r); err != nil {
+			return err
+		}
+		var tf *id.FieldID
+		if ms.TitleField != "" {
+			tf = lo.ToPtr(r.ModelFields[ms.Key][ms.TitleField])
+		}
+		if err := s.SetTitleField(tf); err != nil {
+			return err
+		}
+		if err := applySpecRules(s, ms.ValidationRules, r.ModelFields[ms.Key]); err != nil {
+			return err
+		}
+		if err := i.repos.Schema.Save(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	if err := i.repos.Group.SaveAll(ctx, gl); err != nil {
+		return err
+	}
+	if err := i.repos.Model.SaveAll(ctx, ml); err != nil {
+		return err
+	}
+
+	// the models removed from the spec are moved to the trash so that they can be restored
+	for _, m := range st.models {
+		if spec.Model(m.Key().String()) != nil {
+			continue
+		}
+		if err := i.repos.Model.Trash(ctx, m.ID()); err != nil {
+			return err
+		}
+		if err := saveTrashEntry(ctx, i.repos, trash.New().Model(m.ID()).Name(m.Name()), prj.Workspace(), prj.ID(), op); err != nil {
+			return err
+		}
+		unindexModel(ctx, i.gateways, m.ID())
+	}
+	for _, g := range st.groups {
+		if spec.Group(g.Key().String()) != nil {
+			continue
+		}
+		if err := i.repos.Group.Remove(ctx, g.ID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// specFieldIDs returns the IDs of the fields in the spec. The current fields are kept unless they need to be replaced.
+func specFieldIDs(s *schema.Schema, current, fields []schemaspec.Field) map[string]id.FieldID {
+	res := make(map[string]id.FieldID, len(fields))
+	for _, f := range fields {
+		sf := s.FieldByIDOrKey(nil, id.NewKey(f.Key).Ref())
+		cf, ok := lo.Find(current, func(cf schemaspec.Field) bool { return cf.Key == f.Key })
+		if sf != nil && ok && !f.RequiresReplacement(cf) {
+			res[f.Key] = sf.ID()
+		} else {
+			res[f.Key] = id.NewFieldID()
+		}
+	}
+	return res
+}
+
+// applySpecFields removes the fields which are not in the spec or replaced, and then creates or updates the fields as the spec.
+func applySpecFields(s *schema.Schema, fields []schemaspec.Field, ids map[string]id.FieldID, r *schemaspec.Resolver) error {
+	for _, f := range s.Fields() {
+		if fid, ok := ids[f.Key().String()]; !ok || fid != f.ID() {
+			s.RemoveField(f.ID())
+		}
+	}
+
+	for o, fs := range fields {
+		f := s.FieldByIDOrKey(lo.ToPtr(ids[fs.Key]), nil)
+		tp, err := fs.TypeProperty(r, f)
+		if err != nil {
+			return err
+		}
+
+		rules, err := fs.SchemaValidationRules()
+		if err != nil {
+			return err
+		}
+
+		if f == nil {
+			expr, err := parseExpression(fs.Expression)
+			if err != nil {
+				return err
+			}
+			f, err = schema.NewField(tp).
+				ID(ids[fs.Key]).
+				Key(id.NewKey(fs.Key)).
+				Name(fs.NameOrKey()).
+				Description(fs.Description).
+				Multiple(fs.Multiple).
+				Required(fs.Required).
+				Unique(fs.Unique).
+				Localized(fs.Localized).
+				ValidationRules(rules).
+				Expression(expr).
+				Build()
+			if err != nil {
+				return err
+			}
+			s.AddField(f)
+			f.SetOrder(o)
+			continue
+		}
+
+		if err := updateField(interfaces.UpdateFieldParam{
+			Name:            lo.ToPtr(fs.NameOrKey()),
+			Description:     lo.ToPtr(fs.Description),
+			Order:           lo.ToPtr(o),
+			Multiple:        lo.ToPtr(fs.Multiple),
+			Required:        lo.ToPtr(fs.Required),
+			Unique:          lo.ToPtr(fs.Unique),
+			Localized:       lo.ToPtr(fs.Localized),
+			TypeProperty:    tp,
+			ValidationRules: rules,
+			Expression:      lo.ToPtr(fs.Expression),
+		}, f); err != nil {
+			return err
+		}
+	}
+	return s.ValidateExpressions()
+}
+
+// applySpecRules replaces the compare rules of the schema with the rules in the spec.
+func applySpecRules(s *schema.Schema, rules []schemaspec.ValidationRule, ids map[string]id.FieldID) error {
+	sr, err := schemaspec.CompareRules(rules, ids)
+	if err != nil {
+		return err
+	}
+	return s.SetValidationRules(sr)
+}
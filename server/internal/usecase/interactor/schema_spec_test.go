@@ -0,0 +1,190 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/schemaspec"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchema_ApplySpec(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).Alias("project-a").Name("prj").MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	uc := NewSchema(db, &gateway.Container{})
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:                   &uid,
+			MaintainableWorkspaces: accountdomain.WorkspaceIDList{wid},
+		},
+		MaintainableProjects: id.ProjectIDList{prj.ID()},
+	}
+
+	spec := lo.Must(schemaspec.ParseBytes([]byte(`
+groups:
+  - key: address
+    fields:
+      - key: city
+        type: text
+models:
+  - key: shop
+    name: Shop
+    titleField: name
+    fields:
+      - key: name
+        type: text
+        required: true
+      - key: address
+        type: group
+        group: address
+      - key: staff
+        type: reference
+        reference:
+          model: staff
+          correspondingField: shop
+      - key: memo
+        type: textArea
+      - key: code
+        type: text
+        validationRules:
+          - type: pattern
+            pattern: "^[A-Z]+$"
+            message: upper case only
+      - key: openAt
+        type: integer
+      - key: closeAt
+        type: integer
+      - key: label
+        type: text
+        expression: 'concat(name, " (", code, ")")'
+    validationRules:
+      - type: compare
+        field: openAt
+        operator: lt
+        otherField: closeAt
+  - key: staff
+    fields:
+      - key: shop
+        type: reference
+        reference:
+          model: shop
+          correspondingField: staff
+`)))
+	param := interfaces.SchemaSpecParam{ProjectID: prj.ID(), Spec: spec}
+
+	// plan does not change anything
+	plan, err := uc.PlanSpec(ctx, param, op)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(lo.Filter(plan, func(c schemaspec.Change, _ int) bool { return c.Field == "" })))
+	models, _, err := db.Model.FindByProject(ctx, prj.ID(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, models)
+
+	// the operator should maintain the project
+	_, err = uc.PlanSpec(ctx, param, &usecase.Operator{AcOperator: &accountusecase.Operator{User: &uid}})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+
+	plan, err = uc.ApplySpec(ctx, param, op)
+	require.NoError(t, err)
+	assert.False(t, plan.HasDestructiveChanges())
+
+	shop := lo.Must(db.Model.FindByKey(ctx, prj.ID(), "shop"))
+	staff := lo.Must(db.Model.FindByKey(ctx, prj.ID(), "staff"))
+	address := lo.Must(db.Group.FindByKey(ctx, prj.ID(), "address"))
+	assert.Equal(t, "Shop", shop.Name())
+	assert.Equal(t, "staff", staff.Name())
+
+	s := lo.Must(db.Schema.FindByID(ctx, shop.Schema()))
+	assert.Equal(t, []string{"name", "address", "staff", "memo", "code", "openAt", "closeAt", "label"}, lo.Map(s.Fields(), func(f *schema.Field, _ int) string { return f.Key().String() }))
+	assert.Equal(t, s.FieldByIDOrKey(nil, id.NewKey("name").Ref()).ID(), lo.FromPtr(s.TitleField()))
+	assert.True(t, s.FieldByIDOrKey(nil, id.NewKey("name").Ref()).Required())
+	s.FieldByIDOrKey(nil, id.NewKey("address").Ref()).TypeProperty().Match(schema.TypePropertyMatch{
+		Group: func(g *schema.FieldGroup) { assert.Equal(t, address.ID(), g.Group()) },
+	})
+
+	// both sides of the reference refer to each other
+	s2 := lo.Must(db.Schema.FindByID(ctx, staff.Schema()))
+	s.FieldByIDOrKey(nil, id.NewKey("staff").Ref()).TypeProperty().Match(schema.TypePropertyMatch{
+		Reference: func(r *schema.FieldReference) {
+			assert.Equal(t, staff.ID(), r.Model())
+			assert.Equal(t, s2.FieldByIDOrKey(nil, id.NewKey("shop").Ref()).ID(), lo.FromPtr(r.CorrespondingFieldID()))
+		},
+	})
+	s2.FieldByIDOrKey(nil, id.NewKey("shop").Ref()).TypeProperty().Match(schema.TypePropertyMatch{
+		Reference: func(r *schema.FieldReference) {
+			assert.Equal(t, shop.ID(), r.Model())
+			assert.Equal(t, s.FieldByIDOrKey(nil, id.NewKey("staff").Ref()).ID(), lo.FromPtr(r.CorrespondingFieldID()))
+		},
+	})
+
+	// validation rules and expressions are applied
+	code := s.FieldByIDOrKey(nil, id.NewKey("code").Ref())
+	require.Equal(t, 1, len(code.ValidationRules()))
+	assert.Equal(t, "^[A-Z]+$", code.ValidationRules()[0].Pattern())
+	assert.Equal(t, `concat(name, " (", code, ")")`, s.FieldByIDOrKey(nil, id.NewKey("label").Ref()).Expression().String())
+	require.Equal(t, 1, len(s.ValidationRules()))
+	assert.Equal(t, s.FieldByIDOrKey(nil, id.NewKey("openAt").Ref()).ID(), s.ValidationRules()[0].Field())
+	assert.Equal(t, s.FieldByIDOrKey(nil, id.NewKey("closeAt").Ref()).ID(), s.ValidationRules()[0].OtherField())
+
+	// applying the same spec again is a no-op, so the spec round trips with the rules and the expressions
+	plan, err = uc.ApplySpec(ctx, param, op)
+	require.NoError(t, err)
+	assert.Empty(t, plan)
+
+	// removing a field is destructive
+	spec.Models[0].Fields = append(spec.Models[0].Fields[:3], spec.Models[0].Fields[4:]...)
+	spec.Models[0].Fields[0].MaxLength = lo.ToPtr(100)
+	_, err = uc.ApplySpec(ctx, param, op)
+	assert.ErrorIs(t, err, interfaces.ErrDestructiveSchemaChange)
+	s = lo.Must(db.Schema.FindByID(ctx, shop.Schema()))
+	assert.NotNil(t, s.FieldByIDOrKey(nil, id.NewKey("memo").Ref()))
+
+	param.AllowDestructive = true
+	plan, err = uc.ApplySpec(ctx, param, op)
+	require.NoError(t, err)
+	// the fields after the removed one are reordered
+	assert.Equal(t, schemaspec.Plan{
+		{Type: schemaspec.ChangeTypeUpdate, Model: "shop", Field: "name", Attributes: []string{"maxLength"}},
+		{Type: schemaspec.ChangeTypeUpdate, Model: "shop", Field: "code", Attributes: []string{"order"}},
+		{Type: schemaspec.ChangeTypeUpdate, Model: "shop", Field: "openAt", Attributes: []string{"order"}},
+		{Type: schemaspec.ChangeTypeUpdate, Model: "shop", Field: "closeAt", Attributes: []string{"order"}},
+		{Type: schemaspec.ChangeTypeUpdate, Model: "shop", Field: "label", Attributes: []string{"order"}},
+		{Type: schemaspec.ChangeTypeDelete, Model: "shop", Field: "memo", Destructive: true},
+	}, plan)
+	s = lo.Must(db.Schema.FindByID(ctx, shop.Schema()))
+	assert.Nil(t, s.FieldByIDOrKey(nil, id.NewKey("memo").Ref()))
+	s.FieldByIDOrKey(nil, id.NewKey("name").Ref()).TypeProperty().Match(schema.TypePropertyMatch{
+		Text: func(f *schema.FieldText) { assert.Equal(t, lo.ToPtr(100), f.MaxLength()) },
+	})
+	assert.Equal(t, value.TypeText, s.FieldByIDOrKey(nil, id.NewKey("name").Ref()).Type())
+
+	// removed models are moved to the trash
+	spec.Models = spec.Models[:1]
+	spec.Models[0].Fields = lo.Filter(spec.Models[0].Fields, func(f schemaspec.Field, _ int) bool { return f.Key != "staff" })
+	_, err = uc.ApplySpec(ctx, param, op)
+	require.NoError(t, err)
+	_, err = db.Model.FindByID(ctx, staff.ID())
+	assert.ErrorIs(t, err, rerror.ErrNotFound)
+	entries, _, err := db.Trash.FindByProject(ctx, prj.ID(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, staff.ID(), lo.FromPtr(entries[0].Model()))
+}
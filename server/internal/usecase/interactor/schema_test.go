@@ -12,6 +12,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/expression"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/project"
@@ -502,3 +503,93 @@ func mockAssetFiles() afero.Fs {
 	}
 	return fs
 }
+
+func TestSchema_UpdateValidationRules(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	f1 := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("min")).MustBuild()
+	f2 := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("max")).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{f1, f2}).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+
+	uc := NewSchema(db, &gateway.Container{})
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:                   &uid,
+			MaintainableWorkspaces: accountdomain.WorkspaceIDList{wid},
+		},
+		MaintainableProjects: id.ProjectIDList{prj.ID()},
+	}
+	rule := lo.Must(schema.NewCompareRule(f2.ID(), schema.CompareOperatorGreaterThanOrEqual, f1.ID(), ""))
+
+	_, err := uc.UpdateValidationRules(ctx, interfaces.UpdateValidationRulesParam{SchemaID: s.ID(), Rules: []*schema.ValidationRule{rule}}, &usecase.Operator{AcOperator: &accountusecase.Operator{User: &uid}})
+	assert.ErrorIs(t, err, interfaces.ErrOperationDenied)
+
+	_, err = uc.UpdateValidationRules(ctx, interfaces.UpdateValidationRulesParam{SchemaID: s.ID(), Rules: []*schema.ValidationRule{lo.Must(schema.NewPatternRule("a", ""))}}, op)
+	assert.True(t, rerror.Is(err, schema.ErrInvalidValidationRule))
+
+	got, err := uc.UpdateValidationRules(ctx, interfaces.UpdateValidationRulesParam{SchemaID: s.ID(), Rules: []*schema.ValidationRule{rule}}, op)
+	assert.NoError(t, err)
+	assert.Equal(t, []*schema.ValidationRule{rule}, got.ValidationRules())
+	assert.Equal(t, []*schema.ValidationRule{rule}, lo.Must(db.Schema.FindByID(ctx, s.ID())).ValidationRules())
+
+	got, err = uc.UpdateValidationRules(ctx, interfaces.UpdateValidationRulesParam{SchemaID: s.ID(), Rules: []*schema.ValidationRule{}}, op)
+	assert.NoError(t, err)
+	assert.Nil(t, got.ValidationRules())
+}
+
+func TestSchema_ComputedFields(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	uid := accountdomain.NewUserID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	title := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild()
+	s := schema.New().NewID().Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{title}).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s))
+
+	uc := NewSchema(db, &gateway.Container{})
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:                   &uid,
+			MaintainableWorkspaces: accountdomain.WorkspaceIDList{wid},
+		},
+		MaintainableProjects: id.ProjectIDList{prj.ID()},
+	}
+	param := func(key, expr string) interfaces.CreateFieldParam {
+		return interfaces.CreateFieldParam{
+			SchemaID:     s.ID(),
+			Type:         value.TypeText,
+			Name:         key,
+			Key:          key,
+			TypeProperty: schema.NewText(nil).TypeProperty(),
+			Expression:   expr,
+		}
+	}
+
+	_, err := uc.CreateField(ctx, param("slug", "slug(title"), op)
+	assert.True(t, rerror.Is(err, expression.ErrInvalidExpression))
+	_, err = uc.CreateField(ctx, param("slug", "slug(name)"), op)
+	assert.True(t, rerror.Is(err, expression.ErrInvalidExpression))
+
+	f, err := uc.CreateField(ctx, param("slug", "slug(title)"), op)
+	assert.NoError(t, err)
+	assert.Equal(t, "slug(title)", f.Expression().String())
+
+	// the fields which computed fields refer to can not be renamed or deleted
+	_, err = uc.UpdateField(ctx, interfaces.UpdateFieldParam{SchemaID: s.ID(), FieldID: title.ID(), Key: lo.ToPtr("name")}, op)
+	assert.True(t, rerror.Is(err, expression.ErrInvalidExpression))
+	assert.True(t, rerror.Is(uc.DeleteField(ctx, s.ID(), title.ID(), op), expression.ErrInvalidExpression))
+
+	f, err = uc.UpdateField(ctx, interfaces.UpdateFieldParam{SchemaID: s.ID(), FieldID: f.ID(), Expression: lo.ToPtr("")}, op)
+	assert.NoError(t, err)
+	assert.False(t, f.IsComputed())
+	assert.NoError(t, uc.DeleteField(ctx, s.ID(), title.ID(), op))
+}
@@ -2,24 +2,34 @@ package interactor
 
 import (
 	"context"
+	"errors"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
 	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
 )
 
 type Thread struct {
-	repos    *repo.Container
-	gateways *gateway.Container
+	repos       *repo.Container
+	gateways    *gateway.Container
+	webHost     string
+	ignoreEvent bool
 }
 
-func NewThread(r *repo.Container, g *gateway.Container) interfaces.Thread {
+func NewThread(r *repo.Container, g *gateway.Container, webHost string) interfaces.Thread {
 	return &Thread{
 		repos:    r,
 		gateways: g,
+		webHost:  webHost,
 	}
 }
 
@@ -141,6 +151,13 @@ func (i *Thread) addComment(ctx context.Context, thid id.ThreadID, content strin
 		return nil, nil, err
 	}
 
+	if err := i.commentEvent(ctx, event.CommentCreate, th, comment, op); err != nil {
+		return nil, nil, err
+	}
+	if err := i.notifyComment(ctx, th, comment, op); err != nil {
+		return nil, nil, err
+	}
+
 	return th, comment, nil
 }
 
@@ -169,6 +186,10 @@ func (i *Thread) UpdateComment(ctx context.Context, thid id.ThreadID, cid id.Com
 				return nil, nil, err
 			}
 
+			if err := i.commentEvent(ctx, event.CommentUpdate, th, th.Comment(cid), op); err != nil {
+				return nil, nil, err
+			}
+
 			return th, th.Comment(cid), nil
 		},
 	)
@@ -191,6 +212,7 @@ func (i *Thread) DeleteComment(ctx context.Context, thid id.ThreadID, cid id.Com
 				return nil, interfaces.ErrOperationDenied
 			}
 
+			comment := th.Comment(cid)
 			if err := th.DeleteComment(cid); err != nil {
 				return nil, err
 			}
@@ -199,7 +221,69 @@ func (i *Thread) DeleteComment(ctx context.Context, thid id.ThreadID, cid id.Com
 				return nil, err
 			}
 
+			if err := i.commentEvent(ctx, event.CommentDelete, th, comment, op); err != nil {
+				return nil, err
+			}
+
 			return th, nil
 		},
 	)
 }
+
+// commentEvent emits the comment event, and also a request.comment event when a comment is added to the thread of a request.
+func (i *Thread) commentEvent(ctx context.Context, ty event.Type, th *thread.Thread, c *thread.Comment, op *usecase.Operator) error {
+	if i.ignoreEvent {
+		return nil
+	}
+
+	req, err := i.repos.Request.FindByThread(ctx, th.ID())
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+
+	var prj *project.Project
+	if req != nil {
+		if prj, err = i.repos.Project.FindByID(ctx, req.Project()); err != nil {
+			return err
+		}
+	}
+
+	events := []Event{{
+		Project:       prj,
+		Workspace:     th.Workspace(),
+		Type:          ty,
+		Object:        th,
+		WebhookObject: thread.ThreadComment{Thread: th, Comment: c},
+		Operator:      op.Operator(),
+	}}
+	if req != nil && ty == event.CommentCreate {
+		events = append(events, Event{
+			Project:       prj,
+			Workspace:     req.Workspace(),
+			Type:          event.RequestComment,
+			Object:        req,
+			WebhookObject: request.RequestComment{Request: req, Comment: c},
+			Operator:      op.Operator(),
+		})
+	}
+
+	_, err = createEvents(ctx, i.repos, i.gateways, events)
+	return err
+}
+
+// notifyComment notifies the creator of the request when a comment is added to the thread of the request.
+func (i *Thread) notifyComment(ctx context.Context, th *thread.Thread, c *thread.Comment, op *usecase.Operator) error {
+	req, err := i.repos.Request.FindByThread(ctx, th.ID())
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	prj, err := i.repos.Project.FindByID(ctx, req.Project())
+	if err != nil {
+		return err
+	}
+	return notifyRequest(ctx, i.repos, i.gateways, i.webHost, notification.TypeRequestComment, prj, req, accountdomain.UserIDList{req.CreatedBy()}, c, op)
+}
@@ -2,16 +2,27 @@ package interactor
 
 import (
 	"context"
+	"net/url"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
 	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway/gatewaymock"
 	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/integration"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/task"
 	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/account/accountusecase"
 	"github.com/reearth/reearthx/rerror"
 	"github.com/samber/lo"
@@ -100,7 +111,7 @@ func TestThread_FindByID(t *testing.T) {
 				err := db.Thread.Save(ctx, a.Clone())
 				assert.NoError(t, err)
 			}
-			threadUC := NewThread(db, nil)
+			threadUC := NewThread(db, nil, "")
 
 			got, err := threadUC.FindByID(ctx, tc.args.id, tc.args.operator)
 			if tc.wantErr != nil {
@@ -193,7 +204,7 @@ func TestThread_FindByIDs(t *testing.T) {
 				err := db.Thread.Save(ctx, a.Clone())
 				assert.NoError(t, err)
 			}
-			threadUC := NewThread(db, nil)
+			threadUC := NewThread(db, nil, "")
 
 			got, err := threadUC.FindByIDs(ctx, tc.arg, &usecase.Operator{AcOperator: &accountusecase.Operator{}})
 			if tc.wantErr != nil {
@@ -273,7 +284,7 @@ func TestThreadRepo_CreateThreadWithComment(t *testing.T) {
 			err := itemUC.repos.Item.Save(ctx, i)
 			assert.NoError(t, err)
 
-			threadUC := NewThread(db, nil)
+			threadUC := NewThread(db, nil, "")
 			th, _, err := threadUC.CreateThreadWithComment(ctx, interfaces.CreateThreadWithCommentInput{
 				WorkspaceID:  tc.arg.WorkspaceID,
 				ResourceID:   tc.arg.ResourceID,
@@ -372,7 +383,7 @@ func TestThread_AddComment(t *testing.T) {
 			err := db.Thread.Save(ctx, thread)
 			assert.NoError(t, err)
 
-			threadUC := NewThread(db, nil)
+			threadUC := NewThread(db, nil, "")
 			if tc.mockError && tc.wantErr != nil {
 				thid := id.NewThreadID()
 				_, _, err := threadUC.AddComment(ctx, thid, tc.args.content, tc.args.operator)
@@ -484,7 +495,7 @@ func TestThread_UpdateComment(t *testing.T) {
 			err := db.Thread.Save(ctx, thread)
 			assert.NoError(t, err)
 
-			threadUC := NewThread(db, nil)
+			threadUC := NewThread(db, nil, "")
 			if tc.mockError && tc.wantErr != nil {
 				thid := id.NewThreadID()
 				_, _, err := threadUC.UpdateComment(ctx, thid, tc.args.comment.ID(), tc.args.content, tc.args.operator)
@@ -573,7 +584,7 @@ func TestThread_DeleteComment(t *testing.T) {
 			err := db.Thread.Save(ctx, thread1)
 			assert.NoError(t, err)
 
-			threadUC := NewThread(db, nil)
+			threadUC := NewThread(db, nil, "")
 			if tc.mockError && tc.wantErr != nil {
 				thid := id.NewThreadID()
 				_, err := threadUC.DeleteComment(ctx, thid, tc.args.commentId, tc.args.operator)
@@ -596,3 +607,54 @@ func TestThread_DeleteComment(t *testing.T) {
 		})
 	}
 }
+
+func TestThread_AddComment_Event(t *testing.T) {
+	ctx := context.Background()
+	uid := accountdomain.NewUserID()
+	ws := workspace.New().NewID().MustBuild()
+	wh := integration.NewWebhookBuilder().NewID().Name("aaa").
+		Url(lo.Must(url.Parse("https://example.com"))).Active(true).
+		Trigger(integration.WebhookTrigger{event.CommentCreate: true, event.RequestComment: true}).MustBuild()
+	in := integration.New().NewID().Developer(uid).Name("xxx").Webhook([]*integration.Webhook{wh}).MustBuild()
+	iid, err := accountdomain.IntegrationIDFrom(in.ID().String())
+	assert.NoError(t, err)
+	lo.Must0(ws.Members().AddIntegration(iid, workspace.RoleOwner, uid))
+
+	prj := project.New().NewID().Workspace(ws.ID()).MustBuild()
+	th := thread.New().NewID().Workspace(ws.ID()).Comments([]*thread.Comment{}).MustBuild()
+	ri, _ := request.NewItemWithVersion(id.NewItemID(), version.New().OrRef())
+	req := request.New().NewID().Workspace(ws.ID()).Project(prj.ID()).CreatedBy(uid).
+		Thread(th.ID().Ref()).Items(request.ItemList{ri}).Title("foo").MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Workspace.Save(ctx, ws))
+	lo.Must0(db.Integration.Save(ctx, in))
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Thread.Save(ctx, th))
+	lo.Must0(db.Request.Save(ctx, req))
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	mRunner := gatewaymock.NewMockTaskRunner(mockCtrl)
+	var payloads []*task.WebhookPayload
+	mRunner.EXPECT().Run(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, p task.Payload) error {
+		payloads = append(payloads, p.Webhook)
+		return nil
+	})
+
+	op := &usecase.Operator{
+		AcOperator: &accountusecase.Operator{
+			User:             &uid,
+			OwningWorkspaces: []accountdomain.WorkspaceID{ws.ID()},
+		},
+	}
+	_, c, err := NewThread(db, &gateway.Container{TaskRunner: mRunner}, "").AddComment(ctx, th.ID(), "aaa", op)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(payloads))
+	assert.Equal(t, event.Type(event.CommentCreate), payloads[0].Event.Type())
+	assert.Equal(t, c, payloads[0].Override.(thread.ThreadComment).Comment)
+	assert.Equal(t, event.Type(event.RequestComment), payloads[1].Event.Type())
+	assert.Equal(t, req.ID(), payloads[1].Override.(request.RequestComment).Request.ID())
+	assert.Equal(t, prj.ID().String(), payloads[1].Event.Project().ID)
+}
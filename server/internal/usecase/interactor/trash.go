@@ -0,0 +1,241 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/internal/usecase/repo"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type Trash struct {
+	repos    *repo.Container
+	gateways *gateway.Container
+	item     *Item
+}
+
+func NewTrash(r *repo.Container, g *gateway.Container) interfaces.Trash {
+	return &Trash{
+		repos:    r,
+		gateways: g,
+		item:     NewItem(r, g),
+	}
+}
+
+func (i Trash) FindByProject(ctx context.Context, pid id.ProjectID, p *usecasex.Pagination, _ *usecase.Operator) (trash.List, *usecasex.PageInfo, error) {
+	return i.repos.Trash.FindByProject(ctx, pid, p)
+}
+
+func (i Trash) Restore(ctx context.Context, tid id.TrashID, op *usecase.Operator) (*trash.Entry, error) {
+	if op.AcOperator.User == nil && op.Integration == nil {
+		return nil, interfaces.ErrInvalidOperator
+	}
+
+	return Run1(ctx, op, i.repos, Usecase().Transaction(), func(ctx context.Context) (*trash.Entry, error) {
+		e, err := i.repos.Trash.FindByID(ctx, tid)
+		if err != nil {
+			return nil, err
+		}
+		if !canRestore(e, op) {
+			return nil, interfaces.ErrOperationDenied
+		}
+
+		switch e.Type() {
+		case trash.TypeItem:
+			err = i.restoreItem(ctx, e)
+		case trash.TypeAsset:
+			err = i.restoreAsset(ctx, e)
+		case trash.TypeModel:
+			err = i.restoreModel(ctx, e)
+		default:
+			err = trash.ErrInvalidType
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := i.repos.Trash.Remove(ctx, e.ID()); err != nil {
+			return nil, err
+		}
+		return e, nil
+	})
+}
+
+// canRestore returns true when the operator maintains the project, or the operator is a writer who deleted the entity.
+// Models can be restored only by maintainers as they can be deleted only by maintainers.
+func canRestore(e *trash.Entry, op *usecase.Operator) bool {
+	if op.IsMaintainingProject(e.Project()) {
+		return true
+	}
+	return e.Type() != trash.TypeModel && op.IsWritableProject(e.Project()) && e.IsDeletedBy(op.AcOperator.User, op.Integration)
+}
+
+func (i Trash) restoreItem(ctx context.Context, e *trash.Entry) error {
+	if _, err := i.repos.Model.FindByID(ctx, *e.Model()); err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return interfaces.ErrModelOfItemTrashed
+		}
+		return err
+	}
+
+	if err := i.repos.Item.Restore(ctx, *e.Item()); err != nil {
+		return err
+	}
+	itv, err := i.repos.Item.FindByID(ctx, *e.Item(), nil)
+	if err != nil {
+		return err
+	}
+	itm := itv.Value()
+
+	if mid := itm.MetadataItem(); mid != nil {
+		if err := i.repos.Item.Restore(ctx, *mid); err != nil && !errors.Is(err, rerror.ErrNotFound) {
+			return err
+		}
+	}
+
+	s, err := i.repos.Schema.FindByID(ctx, itm.Schema())
+	if err != nil {
+		return err
+	}
+	if err := i.relinkReferenceFields(ctx, s, itm); err != nil {
+		return err
+	}
+	if err := i.relinkThread(ctx, e.Workspace(), itm.Thread()); err != nil {
+		return err
+	}
+
+	i.item.indexItems(ctx, version.Latest, itm)
+	pub, err := i.repos.Item.FindByID(ctx, itm.ID(), version.Public.Ref())
+	if err != nil && !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+	if pub != nil {
+		i.item.indexItems(ctx, version.Public, pub.Value())
+	}
+	return nil
+}
+
+// relinkReferenceFields sets the restored item to the corresponding fields of the items referenced by two-way reference fields again.
+// The references to the items which no longer exist are cleared.
+func (i Trash) relinkReferenceFields(ctx context.Context, s *schema.Schema, itm *item.Item) error {
+	changed := false
+	for _, sf := range s.FieldsByType(value.TypeReference) {
+		fr, ok := schema.FieldReferenceFromTypeProperty(sf.TypeProperty())
+		if !ok || !fr.IsTowWay() {
+			continue
+		}
+		ref, ok := itm.Field(sf.ID()).Value().First().ValueReference()
+		if !ok || ref.IsEmpty() {
+			continue
+		}
+		if _, err := i.repos.Item.FindByID(ctx, ref, nil); err != nil {
+			if !errors.Is(err, rerror.ErrNotFound) {
+				return err
+			}
+			itm.ClearField(sf.ID())
+			changed = true
+		}
+	}
+	if changed {
+		if err := i.repos.Item.Save(ctx, itm); err != nil {
+			return err
+		}
+	}
+
+	oldFields := lo.Filter(itm.Fields(), func(f *item.Field, _ int) bool { return f.Type() != value.TypeReference })
+	return i.item.handleReferenceFields(ctx, *s, itm, oldFields)
+}
+
+// relinkThread creates the thread again when the thread of the restored entity no longer exists so that its ID can be resolved.
+func (i Trash) relinkThread(ctx context.Context, wid accountdomain.WorkspaceID, thid *id.ThreadID) error {
+	if thid == nil {
+		return nil
+	}
+	if _, err := i.repos.Thread.FindByID(ctx, *thid); err == nil || !errors.Is(err, rerror.ErrNotFound) {
+		return err
+	}
+	th, err := thread.New().ID(*thid).Workspace(wid).Build()
+	if err != nil {
+		return err
+	}
+	return i.repos.Thread.Save(ctx, th)
+}
+
+func (i Trash) restoreAsset(ctx context.Context, e *trash.Entry) error {
+	if err := i.repos.Asset.Restore(ctx, *e.Asset()); err != nil {
+		return err
+	}
+	a, err := i.repos.Asset.FindByID(ctx, *e.Asset())
+	if err != nil {
+		return err
+	}
+	return i.relinkThread(ctx, e.Workspace(), a.Thread())
+}
+
+func (i Trash) restoreModel(ctx context.Context, e *trash.Entry) error {
+	mid := *e.Model()
+	if err := i.repos.Model.Restore(ctx, mid); err != nil {
+		return err
+	}
+	m, err := i.repos.Model.FindByID(ctx, mid)
+	if err != nil {
+		return err
+	}
+
+	models, _, err := i.repos.Model.FindByProject(ctx, m.Project(), usecasex.CursorPagination{First: lo.ToPtr(int64(1000))}.Wrap())
+	if err != nil {
+		return err
+	}
+	// another model may have taken the key while the model was in the trash
+	if lo.ContainsBy(models, func(m2 *model.Model) bool { return m2.ID() != mid && m2.Key() == m.Key() }) {
+		if err := i.repos.Model.Trash(ctx, mid); err != nil {
+			return err
+		}
+		return id.ErrDuplicatedKey
+	}
+
+	m.SetOrder(len(models) - 1)
+	m.SetUpdatedAt(util.Now())
+	if err := i.repos.Model.Save(ctx, m); err != nil {
+		return err
+	}
+
+	afterCommit(ctx, func(ctx context.Context) {
+		if _, err := indexModel(ctx, i.repos, i.gateways, mid); err != nil {
+			log.Errorfc(ctx, "search: failed to index model %s: %v", mid, err)
+		}
+	})
+	return nil
+}
+
+// saveTrashEntry records the entity which is moved to the trash by the operator.
+func saveTrashEntry(ctx context.Context, r *repo.Container, b *trash.Builder, wid accountdomain.WorkspaceID, pid id.ProjectID, op *usecase.Operator) error {
+	e, err := b.
+		NewID().
+		Workspace(wid).
+		Project(pid).
+		User(op.AcOperator.User).
+		Integration(op.Integration).
+		DeletedAt(util.Now()).
+		Build()
+	if err != nil {
+		return err
+	}
+	return r.Trash.Save(ctx, e)
+}
@@ -0,0 +1,207 @@
+package interactor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/internal/infrastructure/memory"
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/internal/usecase/interfaces"
+	"github.com/reearth/reearth-cms/server/pkg/asset"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/project"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountusecase"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrash_RestoreItem(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+
+	sid1, sid2 := id.NewSchemaID(), id.NewSchemaID()
+	mid1, mid2 := id.NewModelID(), id.NewModelID()
+	fid1, fid2 := id.NewFieldID(), id.NewFieldID()
+	cf := &schema.CorrespondingField{Title: "title", Key: "key"}
+	sf1 := schema.NewField(schema.NewReference(mid2, sid2, fid2.Ref(), cf).TypeProperty()).ID(fid1).Name("ref").Key(id.RandomKey()).MustBuild()
+	sf2 := schema.NewField(schema.NewReference(mid1, sid1, fid1.Ref(), cf).TypeProperty()).ID(fid2).Name("ref").Key(id.RandomKey()).MustBuild()
+	s1 := schema.New().ID(sid1).Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf1}).MustBuild()
+	s2 := schema.New().ID(sid2).Workspace(wid).Project(prj.ID()).Fields(schema.FieldList{sf2}).MustBuild()
+	m1 := model.New().ID(mid1).Schema(sid1).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+	m2 := model.New().ID(mid2).Schema(sid2).Key(id.RandomKey()).Project(prj.ID()).MustBuild()
+
+	iid1, iid2 := id.NewItemID(), id.NewItemID()
+	thid := id.NewThreadID()
+	i1 := item.New().ID(iid1).Schema(sid1).Model(mid1).Project(prj.ID()).Thread(thid.Ref()).
+		Fields([]*item.Field{item.NewField(fid1, value.TypeReference.Value(iid2).AsMultiple(), nil)}).MustBuild()
+	i2 := item.New().ID(iid2).Schema(sid2).Model(mid2).Project(prj.ID()).Thread(id.NewThreadID().Ref()).
+		Fields([]*item.Field{item.NewField(fid2, value.TypeReference.Value(iid1).AsMultiple(), nil)}).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Schema.Save(ctx, s1))
+	lo.Must0(db.Schema.Save(ctx, s2))
+	lo.Must0(db.Model.Save(ctx, m1))
+	lo.Must0(db.Model.Save(ctx, m2))
+	lo.Must0(db.Item.Save(ctx, i1))
+	lo.Must0(db.Item.Save(ctx, i2))
+
+	op := batchTestOperator(wid, prj.ID())
+	itemUC := NewItem(db, nil)
+	itemUC.ignoreEvent = true
+	uc := NewTrash(db, nil)
+
+	// delete moves the item to the trash and unlinks the corresponding item
+	assert.NoError(t, itemUC.Delete(ctx, iid1, op))
+	_, err := db.Item.FindByID(ctx, iid1, nil)
+	assert.Equal(t, rerror.ErrNotFound, err)
+	ref, err := db.Item.FindByID(ctx, iid2, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, ref.Value().Field(fid2))
+
+	entries, _, err := uc.FindByProject(ctx, prj.ID(), nil, op)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	e := entries[0]
+	assert.Equal(t, trash.TypeItem, e.Type())
+	assert.Equal(t, &iid1, e.Item())
+	assert.Equal(t, &mid1, e.Model())
+	assert.Equal(t, op.AcOperator.User, e.User())
+
+	// invalid operator
+	_, err = uc.Restore(ctx, e.ID(), &usecase.Operator{AcOperator: &accountusecase.Operator{}})
+	assert.Equal(t, interfaces.ErrInvalidOperator, err)
+
+	// writers can restore only what they deleted
+	writer := batchTestOperator(wid, prj.ID())
+	writer.MaintainableProjects = nil
+	_, err = uc.Restore(ctx, e.ID(), writer)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// not found
+	_, err = uc.Restore(ctx, id.NewTrashID(), op)
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	// restore re-links the reference and the thread
+	got, err := uc.Restore(ctx, e.ID(), op)
+	assert.NoError(t, err)
+	assert.Equal(t, e, got)
+
+	restored, err := db.Item.FindByID(ctx, iid1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, i1.Fields(), restored.Value().Fields())
+	ref, err = db.Item.FindByID(ctx, iid2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, value.TypeReference.Value(iid1).AsMultiple(), ref.Value().Field(fid2).Value())
+	th, err := db.Thread.FindByID(ctx, thid)
+	assert.NoError(t, err)
+	assert.Equal(t, wid, th.Workspace())
+
+	entries, _, err = uc.FindByProject(ctx, prj.ID(), nil, op)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// the item of a trashed model can not be restored
+	assert.NoError(t, itemUC.Delete(ctx, iid1, op))
+	assert.NoError(t, NewModel(db, nil).Delete(ctx, mid1, op))
+	entries, _, err = uc.FindByProject(ctx, prj.ID(), nil, op)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	itemEntry, _ := lo.Find(entries, func(e *trash.Entry) bool { return e.Type() == trash.TypeItem })
+	_, err = uc.Restore(ctx, itemEntry.ID(), op)
+	assert.Equal(t, interfaces.ErrModelOfItemTrashed, err)
+}
+
+func TestTrash_RestoreAsset(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	op := batchTestOperator(wid, prj.ID())
+	a := asset.New().NewID().Project(prj.ID()).NewUUID().CreatedByUser(*op.AcOperator.User).
+		FileName("a.png").Size(100).Thread(id.NewThreadID().Ref()).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Asset.Save(ctx, a))
+
+	assetUC := Asset{repos: db, ignoreEvent: true}
+	_, err := assetUC.Delete(ctx, a.ID(), op)
+	assert.NoError(t, err)
+	_, err = db.Asset.FindByID(ctx, a.ID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	uc := NewTrash(db, nil)
+	entries, _, err := uc.FindByProject(ctx, prj.ID(), nil, op)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "a.png", entries[0].Name())
+
+	// writers can restore what they deleted
+	op.MaintainableProjects = nil
+	_, err = uc.Restore(ctx, entries[0].ID(), op)
+	assert.NoError(t, err)
+
+	got, err := db.Asset.FindByID(ctx, a.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, a.ID(), got.ID())
+}
+
+func TestTrash_RestoreModel(t *testing.T) {
+	ctx := context.Background()
+	wid := accountdomain.NewWorkspaceID()
+	prj := project.New().NewID().Workspace(wid).MustBuild()
+	m1 := model.New().NewID().Schema(id.NewSchemaID()).Key(id.NewKey("model1")).Project(prj.ID()).Name("m1").Order(0).MustBuild()
+	m2 := model.New().NewID().Schema(id.NewSchemaID()).Key(id.NewKey("model2")).Project(prj.ID()).Name("m2").Order(1).MustBuild()
+
+	db := memory.New()
+	lo.Must0(db.Project.Save(ctx, prj))
+	lo.Must0(db.Model.Save(ctx, m1))
+	lo.Must0(db.Model.Save(ctx, m2))
+
+	op := batchTestOperator(wid, prj.ID())
+	modelUC := NewModel(db, nil)
+	uc := NewTrash(db, nil)
+
+	assert.NoError(t, modelUC.Delete(ctx, m1.ID(), op))
+	_, err := db.Model.FindByID(ctx, m1.ID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+	got, err := db.Model.FindByID(ctx, m2.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, got.Order())
+
+	entries, _, err := uc.FindByProject(ctx, prj.ID(), nil, op)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "m1", entries[0].Name())
+
+	// only maintainers can restore models
+	writer := batchTestOperator(wid, prj.ID())
+	writer.AcOperator.User = op.AcOperator.User
+	writer.MaintainableProjects = nil
+	_, err = uc.Restore(ctx, entries[0].ID(), writer)
+	assert.Equal(t, interfaces.ErrOperationDenied, err)
+
+	// the key is taken by another model
+	m3 := model.New().NewID().Schema(id.NewSchemaID()).Key(id.NewKey("model1")).Project(prj.ID()).Order(1).MustBuild()
+	lo.Must0(db.Model.Save(ctx, m3))
+	_, err = uc.Restore(ctx, entries[0].ID(), op)
+	assert.Equal(t, id.ErrDuplicatedKey, err)
+	_, err = db.Model.FindByID(ctx, m1.ID())
+	assert.Equal(t, rerror.ErrNotFound, err)
+
+	// restored models are placed at the end
+	lo.Must0(db.Model.Remove(ctx, m3.ID()))
+	_, err = uc.Restore(ctx, entries[0].ID(), op)
+	assert.NoError(t, err)
+	got, err = db.Model.FindByID(ctx, m1.ID())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.Order())
+}
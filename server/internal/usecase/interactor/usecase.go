@@ -89,14 +89,47 @@ func Run3[A, B, C any](ctx context.Context, op *usecase.Operator, r *repo.Contai
 		tr = r.Transaction
 	}
 
+	// functions registered by afterCommit are called when the outermost run succeeds
+	fctx := ctx
+	h, nested := ctx.Value(afterCommitKey{}).(*afterCommitFuncs)
+	if !nested {
+		h = &afterCommitFuncs{}
+		ctx = context.WithValue(ctx, afterCommitKey{}, h)
+	}
+
 	err = usecasex.DoTransaction(ctx, tr, transactionRetry, func(ctx context.Context) error {
+		if !nested {
+			// the functions of an aborted attempt are discarded
+			h.funcs = nil
+		}
 		a, b, c, err = f(ctx)
 		return err
 	})
 
+	if err == nil && !nested {
+		for _, f := range h.funcs {
+			f(fctx)
+		}
+	}
 	return
 }
 
+type afterCommitKey struct{}
+
+type afterCommitFuncs struct {
+	funcs []func(context.Context)
+}
+
+// afterCommit registers f to be called after the transaction of the context is committed, so f does not see changes which are rolled back.
+// f is called immediately when the context is not in a run.
+func afterCommit(ctx context.Context, f func(context.Context)) {
+	if h, ok := ctx.Value(afterCommitKey{}).(*afterCommitFuncs); ok {
+		h.funcs = append(h.funcs, f)
+		return
+	}
+	f(ctx)
+}
+
 func (u *uc) checkPermission(op *usecase.Operator) error {
 	if op == nil {
 		return nil
@@ -179,3 +179,40 @@ func TestRun(t *testing.T) {
 	assert.Same(t, err, goterr)
 	assert.True(t, tr.IsCommitted())
 }
+
+func TestAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("test")
+	r := &repo.Container{}
+
+	// called after the outermost run
+	var calls []string
+	tr := &usecasex.NopTransaction{}
+	r.Transaction = tr
+	_ = Run0(ctx, nil, r, Usecase().Transaction(), func(ctx context.Context) error {
+		afterCommit(ctx, func(context.Context) {
+			assert.True(t, tr.IsCommitted())
+			calls = append(calls, "outer")
+		})
+		return Run0(ctx, nil, r, Usecase().Transaction(), func(ctx context.Context) error {
+			afterCommit(ctx, func(context.Context) { calls = append(calls, "inner") })
+			assert.Empty(t, calls)
+			return nil
+		})
+	})
+	assert.Equal(t, []string{"outer", "inner"}, calls)
+
+	// not called when the transaction fails
+	calls = nil
+	r.Transaction = &usecasex.NopTransaction{CommitError: err}
+	goterr := Run0(ctx, nil, r, Usecase().Transaction(), func(ctx context.Context) error {
+		afterCommit(ctx, func(context.Context) { calls = append(calls, "aborted") })
+		return nil
+	})
+	assert.Same(t, err, goterr)
+	assert.Empty(t, calls)
+
+	// called immediately outside of runs
+	afterCommit(ctx, func(context.Context) { calls = append(calls, "now") })
+	assert.Equal(t, []string{"now"}, calls)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/file"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/imaging"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/idx"
 	"github.com/reearth/reearthx/rerror"
@@ -49,6 +50,7 @@ type AssetFilter struct {
 	Keyword      *string
 	Pagination   *usecasex.Pagination
 	ContentTypes []string
+	Metadata     *asset.MetadataFilter
 }
 
 type AssetUpload struct {
@@ -62,6 +64,7 @@ type AssetUpload struct {
 type Asset interface {
 	FindByID(context.Context, id.AssetID, *usecase.Operator) (*asset.Asset, error)
 	FindByUUID(context.Context, string, *usecase.Operator) (*asset.Asset, error)
+	TransformURL(context.Context, id.AssetID, imaging.Options, *usecase.Operator) (string, error)
 	FindByIDs(context.Context, []id.AssetID, *usecase.Operator) (asset.List, error)
 	Search(context.Context, id.ProjectID, AssetFilter, *usecase.Operator) (asset.List, *usecasex.PageInfo, error)
 	FindFileByID(context.Context, id.AssetID, *usecase.Operator) (*asset.File, error)
@@ -70,6 +73,7 @@ type Asset interface {
 	Create(context.Context, CreateAssetParam, *usecase.Operator) (*asset.Asset, *asset.File, error)
 	Update(context.Context, UpdateAssetParam, *usecase.Operator) (*asset.Asset, error)
 	UpdateFiles(context.Context, id.AssetID, *asset.ArchiveExtractionStatus, *usecase.Operator) (*asset.Asset, error)
+	ExtractMetadata(context.Context, id.AssetID, *usecase.Operator) (*asset.Asset, error)
 	Delete(context.Context, id.AssetID, *usecase.Operator) (id.AssetID, error)
 	BatchDelete(context.Context, id.AssetIDList, *usecase.Operator) ([]id.AssetID, error)
 	Decompress(context.Context, id.AssetID, *usecase.Operator) (*asset.Asset, error)
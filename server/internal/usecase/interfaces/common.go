@@ -22,6 +22,11 @@ type Container struct {
 	User              accountinterfaces.User
 	Item              Item
 	View              View
+	Schedule          Schedule
+	ItemTemplate      ItemTemplate
+	Trash             Trash
+	Release           Release
+	PreviewToken      PreviewToken
 	Project           Project
 	Request           Request
 	Model             Model
@@ -29,4 +34,5 @@ type Container struct {
 	Integration       Integration
 	Thread            Thread
 	Group             Group
+	Notification      Notification
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/integrationapi"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/version"
@@ -27,14 +28,27 @@ var (
 	ErrItemMissing              = rerror.NewE(i18n.T("one or more items not found"))
 	ErrItemConflicted           = rerror.NewE(i18n.T("item has been changed before you change it"))
 	ErrMetadataMismatch         = rerror.NewE(i18n.T("metadata item and schema mismatch"))
+	ErrUnknownLocale            = rerror.NewE(i18n.T("locale is not enabled in the project"))
+	ErrFieldNotLocalized        = rerror.NewE(i18n.T("field is not localized"))
+	ErrComputedFieldNotWritable = rerror.NewE(i18n.T("computed field can not be written"))
+	ErrCannotDuplicateMetadata  = rerror.NewE(i18n.T("metadata item can not be duplicated"))
+	ErrEmptyBatch               = rerror.NewE(i18n.T("batch must contain at least one item"))
+	ErrTooManyItemsInBatch      = rerror.NewE(i18n.T("too many items in a batch"))
+	ErrBatchAborted             = rerror.NewE(i18n.T("batch was aborted because another item failed"))
+	ErrSearchIndexNotConfigured = rerror.NewE(i18n.T("search index is not configured"))
 )
 
+// MaxBatchItems is the maximum number of items that can be processed by one batch operation.
+const MaxBatchItems = 100
+
 type ItemFieldParam struct {
 	Field *item.FieldID
 	Key   *id.Key
 	// Type  value.Type
 	Value any
 	Group *id.ItemGroupID
+	// Locale sets the value of a localized field for the locale. The base value is set when it is empty or the default locale of the project.
+	Locale locale.Locale
 }
 
 type CreateItemParam struct {
@@ -51,6 +65,45 @@ type UpdateItemParam struct {
 	Version    *version.Version
 }
 
+// CreateItemFromTemplateParam creates an item from the values of the template.
+// Fields and MetadataFields overwrite the values of the template which set the same fields.
+type CreateItemFromTemplateParam struct {
+	TemplateID     id.ItemTemplateID
+	Fields         []ItemFieldParam
+	MetadataFields []ItemFieldParam
+}
+
+type RestoreItemResult struct {
+	Item item.Versioned
+	// Changes are the changes from the latest version to the restored version.
+	Changes item.FieldChanges
+	// RemovedFields are the fields of the version which were not restored because they were deleted from the schema.
+	RemovedFields id.FieldIDList
+	// TypeChangedFields are the fields of the version which were not restored because their types were changed.
+	TypeChangedFields id.FieldIDList
+}
+
+type BatchCreateItemParam struct {
+	CreateItemParam
+	// Metadata creates a metadata item for the item when set.
+	Metadata *CreateItemParam
+}
+
+type BatchUpdateItemParam struct {
+	UpdateItemParam
+	// MetadataFields updates the metadata item of the item, or creates it when the item does not have one yet.
+	MetadataFields []ItemFieldParam
+}
+
+// BatchItemResult is the result of one item in a batch operation.
+// When the batch is atomic and one of the items failed, the others have ErrBatchAborted as Err.
+type BatchItemResult struct {
+	ID       *id.ItemID
+	Item     item.Versioned
+	Metadata item.Versioned
+	Err      error
+}
+
 type ImportFormatType string
 
 const (
@@ -126,26 +179,45 @@ type ExportItemsToGeoJSONResponse struct {
 
 type Item interface {
 	FindByID(context.Context, id.ItemID, *usecase.Operator) (item.Versioned, error)
-	FindPublicByID(context.Context, id.ItemID, *usecase.Operator) (item.Versioned, error)
+	// FindPublicByID finds the public version of the item. When the ref of a release, or the draft ref granted by the preview token of the operator, is given to preview, the version at the ref is found instead.
+	FindPublicByID(context.Context, id.ItemID, *version.Ref, *usecase.Operator) (item.Versioned, error)
 	FindByIDs(context.Context, id.ItemIDList, *usecase.Operator) (item.VersionedList, error)
 	FindByAssets(context.Context, id.AssetIDList, *usecase.Operator) (map[id.AssetID]item.VersionedList, error)
 	FindBySchema(context.Context, id.SchemaID, *usecasex.Sort, *usecasex.Pagination, *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error)
-	FindPublicByModel(context.Context, id.ModelID, *usecasex.Pagination, *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error)
+	FindPublicByModel(context.Context, id.ModelID, *version.Ref, *usecasex.Pagination, *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error)
 	FindVersionByID(context.Context, id.ItemID, version.VersionOrRef, *usecase.Operator) (item.Versioned, error)
 	FindAllVersionsByID(context.Context, id.ItemID, *usecase.Operator) (item.VersionedList, error)
+	// Diff returns the changes of the fields and the metadata item from the first version to the second version.
+	Diff(context.Context, id.ItemID, version.VersionOrRef, version.VersionOrRef, *usecase.Operator) (item.Diff, error)
+	// Search finds items by the query. The keyword is looked up in the search index when it is configured.
 	Search(context.Context, schema.Package, *item.Query, *usecasex.Pagination, *usecase.Operator) (item.VersionedList, *usecasex.PageInfo, error)
+	// Reindex rebuilds the search index of the latest and public items of the model and returns the number of indexed items.
+	Reindex(context.Context, id.ModelID, *usecase.Operator) (int, error)
 	ItemStatus(context.Context, id.ItemIDList, *usecase.Operator) (map[id.ItemID]item.Status, error)
 	LastModifiedByModel(context.Context, id.ModelID, *usecase.Operator) (time.Time, error)
 	IsItemReferenced(context.Context, id.ItemID, id.FieldID, *usecase.Operator) (bool, error)
 	Create(context.Context, CreateItemParam, *usecase.Operator) (item.Versioned, error)
+	// CreateFromTemplate creates a new draft item, and its metadata item, from the values of the template.
+	CreateFromTemplate(context.Context, CreateItemFromTemplateParam, *usecase.Operator) (item.Versioned, error)
+	// Duplicate creates a new draft item, and its metadata item, with the latest values of the item but without its versions and thread.
+	Duplicate(context.Context, id.ItemID, *usecase.Operator) (item.Versioned, error)
 	Update(context.Context, UpdateItemParam, *usecase.Operator) (item.Versioned, error)
 	Delete(context.Context, id.ItemID, *usecase.Operator) error
+	// Restore creates a new version of the item from the field values of the given version.
+	Restore(context.Context, id.ItemID, version.VersionOrRef, *usecase.Operator) (RestoreItemResult, error)
 	Publish(context.Context, id.ItemIDList, *usecase.Operator) (item.VersionedList, error)
 	Unpublish(context.Context, id.ItemIDList, *usecase.Operator) (item.VersionedList, error)
+	// BatchCreate, BatchUpdate, BatchDelete and BatchPublish process up to MaxBatchItems items and return the result of each item in order.
+	// When atomic is true, nothing is changed unless all the items succeed, and the error of the failed item is returned.
+	BatchCreate(context.Context, []BatchCreateItemParam, bool, *usecase.Operator) ([]BatchItemResult, error)
+	BatchUpdate(context.Context, []BatchUpdateItemParam, bool, *usecase.Operator) ([]BatchItemResult, error)
+	BatchDelete(context.Context, id.ItemIDList, bool, *usecase.Operator) ([]BatchItemResult, error)
+	BatchPublish(context.Context, id.ItemIDList, bool, *usecase.Operator) ([]BatchItemResult, error)
 	Import(context.Context, ImportItemsParam, *usecase.Operator) (ImportItemsResponse, error)
 	TriggerImportJob(context.Context, id.AssetID, id.ModelID, string, string, string, bool, *usecase.Operator) error
 	// ItemsAsCSV exports items data in content to csv file by schema package.
-	ItemsAsCSV(context.Context, *schema.Package, *int, *int, *usecase.Operator) (ExportItemsToCSVResponse, error)
+	// Values of localized fields are resolved for the locale when it is given.
+	ItemsAsCSV(context.Context, *schema.Package, *int, *int, *locale.Locale, *usecase.Operator) (ExportItemsToCSVResponse, error)
 	// ItemsAsGeoJSON converts items to Geo JSON type given thge schema package.
-	ItemsAsGeoJSON(context.Context, *schema.Package, *int, *int, *usecase.Operator) (ExportItemsToGeoJSONResponse, error)
+	ItemsAsGeoJSON(context.Context, *schema.Package, *int, *int, *locale.Locale, *usecase.Operator) (ExportItemsToGeoJSONResponse, error)
 }
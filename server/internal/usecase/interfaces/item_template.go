@@ -0,0 +1,38 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+)
+
+type CreateItemTemplateParam struct {
+	ModelID     id.ModelID
+	Name        string
+	Description string
+	// Fields and MetadataFields are the values of the item and its metadata item which pre-fill the items created from the template.
+	// Unlike items, required fields can be left empty.
+	Fields         []ItemFieldParam
+	MetadataFields []ItemFieldParam
+	// SourceItem copies the values of the item and its metadata item to the template instead of Fields and MetadataFields when set.
+	SourceItem *id.ItemID
+}
+
+type UpdateItemTemplateParam struct {
+	ID          id.ItemTemplateID
+	Name        *string
+	Description *string
+	// Fields and MetadataFields replace the values of the template when they are not nil.
+	Fields         []ItemFieldParam
+	MetadataFields []ItemFieldParam
+}
+
+type ItemTemplate interface {
+	FindByID(context.Context, id.ItemTemplateID, *usecase.Operator) (*template.Template, error)
+	FindByModel(context.Context, id.ModelID, *usecase.Operator) (template.List, error)
+	Create(context.Context, CreateItemTemplateParam, *usecase.Operator) (*template.Template, error)
+	Update(context.Context, UpdateItemTemplateParam, *usecase.Operator) (*template.Template, error)
+	Delete(context.Context, id.ItemTemplateID, *usecase.Operator) error
+}
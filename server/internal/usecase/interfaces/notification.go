@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type NotificationFilter struct {
+	UnreadOnly bool
+}
+
+type UpdateNotificationPreferenceParam struct {
+	// Settings replaces the settings of the types. Other types keep the current settings.
+	Settings map[notification.Type]notification.Setting
+}
+
+// Notification is the inbox of the operator user.
+type Notification interface {
+	FindByUser(context.Context, NotificationFilter, *usecasex.Pagination, *usecase.Operator) (notification.List, *usecasex.PageInfo, error)
+	CountUnread(context.Context, *usecase.Operator) (int64, error)
+	MarkRead(context.Context, id.NotificationIDList, *usecase.Operator) (notification.List, error)
+	MarkAllRead(context.Context, *usecase.Operator) error
+	FindPreference(context.Context, *usecase.Operator) (*notification.Preference, error)
+	UpdatePreference(context.Context, UpdateNotificationPreferenceParam, *usecase.Operator) (*notification.Preference, error)
+}
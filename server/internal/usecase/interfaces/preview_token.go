@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+var ErrPreviewTokenNotConfigured = rerror.NewE(i18n.T("preview tokens are not configured"))
+
+type IssuePreviewTokenParam struct {
+	ProjectID id.ProjectID
+	// RequestID scopes the token to the items of the request. The token previews the latest versions of all items of the project when it is nil.
+	RequestID *id.RequestID
+	// TTL is the lifetime of the token, which defaults to preview.DefaultTTL.
+	TTL *time.Duration
+}
+
+type PreviewToken interface {
+	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination, *usecase.Operator) (preview.List, *usecasex.PageInfo, error)
+	// Issue issues a token and returns it with the signed token string, which cannot be obtained later.
+	Issue(context.Context, IssuePreviewTokenParam, *usecase.Operator) (*preview.Token, string, error)
+	Revoke(context.Context, id.PreviewTokenID, *usecase.Operator) (*preview.Token, error)
+	// Verify returns the token of the signed token string when it is valid.
+	Verify(context.Context, string) (*preview.Token, error)
+}
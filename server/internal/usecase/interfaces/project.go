@@ -2,9 +2,11 @@ package interfaces
 
 import (
 	"context"
+	"io"
 
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/project"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
@@ -28,11 +30,43 @@ type UpdateProjectParam struct {
 	Alias        *string
 	Publication  *UpdateProjectPublicationParam
 	RequestRoles []workspace.Role
+	Locales      *UpdateProjectLocalesParam
+	// ApprovalPolicy replaces the approval policy of requests. A MinApprovals of zero removes the policy.
+	ApprovalPolicy *UpdateProjectApprovalPolicyParam
+}
+
+// UpdateProjectLocalesParam replaces the locale settings of the project. An empty Default disables localization.
+type UpdateProjectLocalesParam struct {
+	Default   locale.Locale
+	Locales   locale.List
+	Fallbacks map[locale.Locale]locale.Locale
+}
+
+type UpdateProjectApprovalPolicyParam struct {
+	MinApprovals      int
+	RequiredReviewers accountdomain.UserIDList
+	RequiredRoles     []workspace.Role
+	ResetOnChange     bool
 }
 
 type UpdateProjectPublicationParam struct {
 	Scope       *project.PublicationScope
 	AssetPublic *bool
+	CacheMaxAge *int
+}
+
+// ImportProjectParam imports a project from an archive written by Export into the workspace.
+type ImportProjectParam struct {
+	WorkspaceID accountdomain.WorkspaceID
+	// Alias replaces the alias of the project in the archive.
+	Alias  *string
+	Reader io.ReaderAt
+	Size   int64
+}
+
+// ExportProjectResponse contains the project archive in zip format.
+type ExportProjectResponse struct {
+	PipeReader *io.PipeReader
 }
 
 var (
@@ -50,4 +84,6 @@ type Project interface {
 	CheckAlias(context.Context, string) (bool, error)
 	Delete(context.Context, id.ProjectID, *usecase.Operator) error
 	RegenerateToken(context.Context, id.ProjectID, *usecase.Operator) (*project.Project, error)
+	Export(context.Context, id.ProjectID, *usecase.Operator) (ExportProjectResponse, error)
+	Import(context.Context, ImportProjectParam, *usecase.Operator) (*project.Project, error)
 }
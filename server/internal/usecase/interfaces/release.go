@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type CreateReleaseParam struct {
+	ProjectID   id.ProjectID
+	Name        string
+	Description *string
+}
+
+type UpdateReleaseParam struct {
+	ReleaseID   id.ReleaseID
+	Name        *string
+	Description *string
+}
+
+type ReleaseItemParam struct {
+	Item id.ItemID
+	// Version is the version of the item to stage. The latest version is staged when it is nil.
+	Version *version.Version
+}
+
+type Release interface {
+	FindByID(context.Context, id.ReleaseID, *usecase.Operator) (*release.Release, error)
+	FindByName(context.Context, id.ProjectID, string, *usecase.Operator) (*release.Release, error)
+	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination, *usecase.Operator) (release.List, *usecasex.PageInfo, error)
+	Create(context.Context, CreateReleaseParam, *usecase.Operator) (*release.Release, error)
+	Update(context.Context, UpdateReleaseParam, *usecase.Operator) (*release.Release, error)
+	Delete(context.Context, id.ReleaseID, *usecase.Operator) error
+	// AddItems stages the item versions under the ref of the release.
+	AddItems(context.Context, id.ReleaseID, []ReleaseItemParam, *usecase.Operator) (*release.Release, error)
+	RemoveItems(context.Context, id.ReleaseID, id.ItemIDList, *usecase.Operator) (*release.Release, error)
+	// Publish moves the public ref of all items of the release to the staged versions at once.
+	Publish(context.Context, id.ReleaseID, *usecase.Operator) (*release.Release, error)
+	// Rollback moves the public ref of the items of the published release back to the versions which were public before the release.
+	Rollback(context.Context, id.ReleaseID, *usecase.Operator) (*release.Release, error)
+}
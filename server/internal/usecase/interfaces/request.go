@@ -48,6 +48,8 @@ type Request interface {
 	FindByItem(context.Context, id.ItemID, *RequestFilter, *usecase.Operator) (request.List, error)
 	Create(context.Context, CreateRequestParam, *usecase.Operator) (*request.Request, error)
 	Update(context.Context, UpdateRequestParam, *usecase.Operator) (*request.Request, error)
+	// Approve records the approval of the operator and publishes the items when the approval policy of the project is satisfied.
 	Approve(context.Context, id.RequestID, *usecase.Operator) (*request.Request, error)
+	RequestChanges(context.Context, id.RequestID, *usecase.Operator) (*request.Request, error)
 	CloseAll(context.Context, id.ProjectID, id.RequestIDList, *usecase.Operator) error
 }
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+type ScheduleItemsParam struct {
+	Items       id.ItemIDList
+	PublishAt   *time.Time
+	UnpublishAt *time.Time
+}
+
+var (
+	ErrScheduleInPast = rerror.NewE(i18n.T("scheduled time must be in the future"))
+)
+
+type Schedule interface {
+	FindByProject(context.Context, id.ProjectID, *usecase.Operator) (schedule.List, error)
+	FindByItems(context.Context, id.ItemIDList, *usecase.Operator) (schedule.List, error)
+	// ScheduleItems creates or replaces the schedules of the items.
+	ScheduleItems(context.Context, ScheduleItemsParam, *usecase.Operator) (schedule.List, error)
+	CancelItems(context.Context, id.ItemIDList, *usecase.Operator) error
+	// RunDue publishes and unpublishes the items whose schedules are due at the given time, and returns the number of the processed schedules.
+	RunDue(context.Context, time.Time) (int, error)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/reearth/reearth-cms/server/internal/usecase"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/schemaspec"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
@@ -21,9 +22,14 @@ type CreateFieldParam struct {
 	Multiple     bool
 	Unique       bool
 	Required     bool
+	Localized    bool
 	IsTitle      bool
 	TypeProperty *schema.TypeProperty
 	DefaultValue *value.Multiple
+	// ValidationRules are the pattern and count rules of the field.
+	ValidationRules []*schema.ValidationRule
+	// Expression makes the field computed from the other fields of the item unless it is empty.
+	Expression string
 }
 
 type UpdateFieldParam struct {
@@ -37,9 +43,41 @@ type UpdateFieldParam struct {
 	Multiple     *bool
 	Unique       *bool
 	Required     *bool
+	Localized    *bool
 	IsTitle      *bool
 	TypeProperty *schema.TypeProperty
 	DefaultValue *value.Multiple
+	// ValidationRules replaces the rules of the field unless it is nil. An empty list removes the rules.
+	ValidationRules []*schema.ValidationRule
+	// Expression replaces the expression of the field unless it is nil. An empty string makes the field writable again.
+	Expression *string
+}
+
+// UpdateValidationRulesParam replaces the rules across the fields of the schema. An empty list removes the rules.
+type UpdateValidationRulesParam struct {
+	SchemaID id.SchemaID
+	Rules    []*schema.ValidationRule
+}
+
+type ConvertFieldParam struct {
+	ModelID      id.ModelID
+	SchemaID     id.SchemaID
+	FieldID      id.FieldID
+	TypeProperty *schema.TypeProperty
+	Multiple     *bool
+	// DefaultValue replaces the default value. The current default value is converted when it is nil.
+	DefaultValue *value.Multiple
+	// AllVersions rewrites the values of all versions of the items instead of only the latest versions.
+	AllVersions bool
+	// DryRun reports how the values would be converted without changing the field and the items.
+	DryRun bool
+}
+
+type ConvertFieldResult struct {
+	Field *schema.Field
+	// Items is the number of the item versions which have values of the field.
+	Items int
+	schema.ConversionResult
 }
 
 type ModelData struct {
@@ -58,6 +96,13 @@ type GuessSchemaField struct {
 	Key  string
 }
 
+type SchemaSpecParam struct {
+	ProjectID id.ProjectID
+	Spec      *schemaspec.Spec
+	// AllowDestructive allows to apply the changes which can lose the contents of the items.
+	AllowDestructive bool
+}
+
 var (
 	ErrInvalidTypeProperty                         = rerror.NewE(i18n.T("invalid type property"))
 	ErrReferencedFiledKeyExists                    = rerror.NewE(i18n.T("referenced field key exists"))
@@ -69,6 +114,8 @@ var (
 	ErrInvalidContentType                    error = rerror.NewE(i18n.T("invalid content type"))
 	ErrInvalidJSONSchema                     error = rerror.NewE(i18n.T("invalid json schema"))
 	ErrInvalidContentTypeForSchemaConversion error = rerror.NewE(i18n.T("invalid content type for schema conversion"))
+	ErrDestructiveSchemaChange               error = rerror.NewE(i18n.T("destructive schema change is not allowed"))
+	ErrFieldTypeNotConvertible               error = rerror.NewE(i18n.T("field type can not be converted"))
 )
 
 type Schema interface {
@@ -82,6 +129,13 @@ type Schema interface {
 	UpdateField(context.Context, UpdateFieldParam, *usecase.Operator) (*schema.Field, error)
 	UpdateFields(context.Context, id.SchemaID, []UpdateFieldParam, *usecase.Operator) (schema.FieldList, error)
 	DeleteField(context.Context, id.SchemaID, id.FieldID, *usecase.Operator) error
+	UpdateValidationRules(context.Context, UpdateValidationRulesParam, *usecase.Operator) (*schema.Schema, error)
+	// ConvertField changes the type of the field and casts the values of the items to the new type.
+	ConvertField(context.Context, ConvertFieldParam, *usecase.Operator) (*ConvertFieldResult, error)
 	GetSchemasAndGroupSchemasByIDs(context.Context, id.SchemaIDList, *usecase.Operator) (schema.List, schema.List, error)
 	GuessSchemaFieldsByAsset(context.Context, id.AssetID, id.ModelID, *usecase.Operator) (*GuessSchemaFieldsData, error)
+	// PlanSpec computes the changes to make the models and the groups of the project match the spec.
+	PlanSpec(context.Context, SchemaSpecParam, *usecase.Operator) (schemaspec.Plan, error)
+	// ApplySpec makes the models and the groups of the project match the spec in a transaction.
+	ApplySpec(context.Context, SchemaSpecParam, *usecase.Operator) (schemaspec.Plan, error)
 }
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/internal/usecase"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+var ErrModelOfItemTrashed = rerror.NewE(i18n.T("the model of the item is in the trash"))
+
+type Trash interface {
+	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination, *usecase.Operator) (trash.List, *usecasex.PageInfo, error)
+	// Restore moves the entity of the entry back from the trash and removes the entry.
+	Restore(context.Context, id.TrashID, *usecase.Operator) (*trash.Entry, error)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
@@ -15,6 +16,8 @@ import (
 type FieldAndValue struct {
 	Field schema.FieldID
 	Value *value.Multiple
+	// Locale matches the value of the locale instead of the base value when it is not empty.
+	Locale locale.Locale
 }
 
 type CopyParams struct {
@@ -43,7 +46,13 @@ type Item interface {
 	Save(context.Context, *item.Item) error
 	SaveAll(context.Context, item.List) error
 	UpdateRef(context.Context, id.ItemID, version.Ref, *version.VersionOrRef) error
+	// ReplaceVersion overwrites the content of the existing version of the item without creating a new version.
+	ReplaceVersion(context.Context, item.Versioned) error
 	Remove(context.Context, id.ItemID) error
 	Archive(context.Context, id.ItemID, id.ProjectID, bool) error
+	// Trash hides all versions of the item from the other methods until it is restored.
+	Trash(context.Context, id.ItemID) error
+	// Restore restores the item in the trash. It returns ErrNotFound when the item is not in the trash.
+	Restore(context.Context, id.ItemID) error
 	Copy(context.Context, CopyParams) (*string, *string, error)
 }
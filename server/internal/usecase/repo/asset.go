@@ -13,6 +13,7 @@ type AssetFilter struct {
 	Keyword      *string
 	Pagination   *usecasex.Pagination
 	ContentTypes []string
+	Metadata     *asset.MetadataFilter
 }
 
 type Asset interface {
@@ -24,6 +25,10 @@ type Asset interface {
 	Save(context.Context, *asset.Asset) error
 	Delete(context.Context, id.AssetID) error
 	BatchDelete(context.Context, id.AssetIDList) error
+	// Trash hides the asset from the other methods until it is restored.
+	Trash(context.Context, id.AssetID) error
+	// Restore restores the asset in the trash. It returns ErrNotFound when the asset is not in the trash.
+	Restore(context.Context, id.AssetID) error
 }
 
 type AssetFile interface {
@@ -23,6 +23,11 @@ type Container struct {
 	Schema            Schema
 	Item              Item
 	View              View
+	Schedule          Schedule
+	ItemTemplate      ItemTemplate
+	Trash             Trash
+	Release           Release
+	PreviewToken      PreviewToken
 	Integration       Integration
 	Thread            Thread
 	Event             Event
@@ -30,6 +35,9 @@ type Container struct {
 	Group             Group
 	WorkspaceSettings WorkspaceSettings
 	Transaction       usecasex.Transaction
+	// notifications are owned by users, so they are not filtered by workspaces or projects
+	Notification           Notification
+	NotificationPreference NotificationPreference
 }
 
 var (
@@ -52,6 +60,11 @@ func (c *Container) Filtered(workspace WorkspaceFilter, project ProjectFilter) *
 		Group:             c.Group.Filtered(project),
 		Item:              c.Item.Filtered(project),
 		View:              c.View.Filtered(project),
+		Schedule:          c.Schedule.Filtered(project),
+		ItemTemplate:      c.ItemTemplate.Filtered(project),
+		Trash:             c.Trash.Filtered(project),
+		Release:           c.Release.Filtered(project),
+		PreviewToken:      c.PreviewToken.Filtered(project),
 		Project:           c.Project.Filtered(workspace),
 		Model:             c.Model.Filtered(project),
 		Schema:            c.Schema.Filtered(workspace),
@@ -59,6 +72,9 @@ func (c *Container) Filtered(workspace WorkspaceFilter, project ProjectFilter) *
 		Integration:       c.Integration,
 		WorkspaceSettings: c.WorkspaceSettings,
 		Event:             c.Event,
+		// notifications are owned by users, so they are not filtered by workspaces or projects
+		Notification:           c.Notification,
+		NotificationPreference: c.NotificationPreference,
 	}
 }
 
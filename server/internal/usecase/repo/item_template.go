@@ -0,0 +1,16 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+)
+
+type ItemTemplate interface {
+	Filtered(ProjectFilter) ItemTemplate
+	FindByID(context.Context, id.ItemTemplateID) (*template.Template, error)
+	FindByModel(context.Context, id.ModelID) (template.List, error)
+	Save(context.Context, *template.Template) error
+	Remove(context.Context, id.ItemTemplateID) error
+}
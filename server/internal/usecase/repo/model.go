@@ -21,4 +21,8 @@ type Model interface {
 	Save(context.Context, *model.Model) error
 	SaveAll(context.Context, model.List) error
 	Remove(context.Context, id.ModelID) error
+	// Trash hides the model from the other methods until it is restored.
+	Trash(context.Context, id.ModelID) error
+	// Restore restores the model in the trash. It returns ErrNotFound when the model is not in the trash.
+	Restore(context.Context, id.ModelID) error
 }
@@ -0,0 +1,33 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/notification"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type NotificationFilter struct {
+	UnreadOnly bool
+}
+
+type Notification interface {
+	FindByID(context.Context, id.NotificationID) (*notification.Notification, error)
+	FindByIDs(context.Context, id.NotificationIDList) (notification.List, error)
+	// FindByUser returns the notifications of the user, newest first.
+	FindByUser(context.Context, accountdomain.UserID, NotificationFilter, *usecasex.Pagination) (notification.List, *usecasex.PageInfo, error)
+	CountUnread(context.Context, accountdomain.UserID) (int64, error)
+	Save(context.Context, *notification.Notification) error
+	SaveAll(context.Context, notification.List) error
+	// MarkAllRead marks all unread notifications of the user as read at the time.
+	MarkAllRead(context.Context, accountdomain.UserID, time.Time) error
+}
+
+type NotificationPreference interface {
+	// FindByUsers returns the preferences of the users. Users who have not saved a preference are not included.
+	FindByUsers(context.Context, accountdomain.UserIDList) ([]*notification.Preference, error)
+	Save(context.Context, *notification.Preference) error
+}
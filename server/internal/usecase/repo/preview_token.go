@@ -0,0 +1,17 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type PreviewToken interface {
+	Filtered(ProjectFilter) PreviewToken
+	FindByID(context.Context, id.PreviewTokenID) (*preview.Token, error)
+	// FindByProject returns the tokens of the project from the most recently issued.
+	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination) (preview.List, *usecasex.PageInfo, error)
+	Save(context.Context, *preview.Token) error
+}
@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/release"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type Release interface {
+	Filtered(ProjectFilter) Release
+	FindByID(context.Context, id.ReleaseID) (*release.Release, error)
+	FindByName(context.Context, id.ProjectID, string) (*release.Release, error)
+	// FindByProject returns the releases of the project from the most recently created.
+	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination) (release.List, *usecasex.PageInfo, error)
+	Save(context.Context, *release.Release) error
+	Remove(context.Context, id.ReleaseID) error
+}
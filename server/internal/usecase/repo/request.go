@@ -22,6 +22,7 @@ type Request interface {
 	FindByID(context.Context, id.RequestID) (*request.Request, error)
 	FindByIDs(context.Context, id.RequestIDList) (request.List, error)
 	FindByItems(context.Context, id.ItemIDList, *RequestFilter) (request.List, error)
+	FindByThread(context.Context, id.ThreadID) (*request.Request, error)
 	Save(context.Context, *request.Request) error
 	SaveAll(context.Context, id.ProjectID, request.List) error
 }
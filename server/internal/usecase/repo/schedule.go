@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+)
+
+type Schedule interface {
+	Filtered(ProjectFilter) Schedule
+	FindByID(context.Context, id.ScheduleID) (*schedule.Schedule, error)
+	FindByItems(context.Context, id.ItemIDList) (schedule.List, error)
+	FindByProject(context.Context, id.ProjectID) (schedule.List, error)
+	// FindDue returns schedules whose publish or unpublish time is at or before the given time.
+	FindDue(context.Context, time.Time) (schedule.List, error)
+	Save(context.Context, *schedule.Schedule) error
+	Remove(context.Context, id.ScheduleID) error
+}
@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/usecasex"
+)
+
+type Trash interface {
+	Filtered(ProjectFilter) Trash
+	FindByID(context.Context, id.TrashID) (*trash.Entry, error)
+	// FindByProject returns the entries of the project from the most recently deleted.
+	FindByProject(context.Context, id.ProjectID, *usecasex.Pagination) (trash.List, *usecasex.PageInfo, error)
+	Save(context.Context, *trash.Entry) error
+	Remove(context.Context, id.TrashID) error
+}
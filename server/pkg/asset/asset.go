@@ -22,6 +22,7 @@ type Asset struct {
 	archiveExtractionStatus *ArchiveExtractionStatus
 	flatFiles               bool
 	public                  bool
+	metadata                *Metadata
 	accessInfoResolver      *AccessInfoResolver
 }
 
@@ -96,6 +97,10 @@ func (a *Asset) Public() bool {
 	return a.public
 }
 
+func (a *Asset) Metadata() *Metadata {
+	return a.metadata
+}
+
 func (a *Asset) AccessInfo() AccessInfo {
 	defaultAccessInfo := AccessInfo{
 		Url:    "",
@@ -130,6 +135,10 @@ func (a *Asset) UpdatePublic(public bool) {
 	a.public = public
 }
 
+func (a *Asset) UpdateMetadata(m *Metadata) {
+	a.metadata = m
+}
+
 func (a *Asset) SetAccessInfoResolver(resolver AccessInfoResolver) {
 	if resolver == nil {
 		a.accessInfoResolver = nil
@@ -159,5 +168,6 @@ func (a *Asset) Clone() *Asset {
 		archiveExtractionStatus: a.archiveExtractionStatus,
 		flatFiles:               a.flatFiles,
 		public:                  a.public,
+		metadata:                a.metadata.Clone(),
 	}
 }
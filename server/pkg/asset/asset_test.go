@@ -151,3 +151,16 @@ func TestAsset_Clone(t *testing.T) {
 	assert.NotSame(t, a, got)
 	assert.Nil(t, (*Asset)(nil).Clone())
 }
+
+func TestAsset_UpdateMetadata(t *testing.T) {
+	a := New().NewID().Project(NewProjectID()).CreatedByUser(accountdomain.NewUserID()).Size(1000).NewUUID().MustBuild()
+	assert.Nil(t, a.Metadata())
+
+	m := &Metadata{Width: lo.ToPtr(10), Height: lo.ToPtr(20)}
+	a.UpdateMetadata(m)
+	assert.Equal(t, m, a.Metadata())
+
+	got := a.Clone()
+	assert.Equal(t, m, got.Metadata())
+	assert.NotSame(t, m, got.Metadata())
+}
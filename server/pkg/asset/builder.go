@@ -123,3 +123,8 @@ func (b *Builder) Public(public bool) *Builder {
 	b.a.public = public
 	return b
 }
+
+func (b *Builder) Metadata(m *Metadata) *Builder {
+	b.a.metadata = m
+	return b
+}
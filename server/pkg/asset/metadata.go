@@ -0,0 +1,226 @@
+package asset
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+var ErrInvalidBBox = rerror.NewE(i18n.T("invalid bounding box"))
+
+// Metadata is extracted from the content of the file of an asset. Only the fields for the type of the file are set.
+type Metadata struct {
+	// images
+	Width      *int
+	Height     *int
+	CapturedAt *time.Time
+	Location   *Location
+	// GeoJSON and CZML
+	BBox         *BBox
+	FeatureCount *int
+	// glTF
+	GLTF *GLTFStats
+	// CSV
+	Headers  []string
+	RowCount *int
+}
+
+// Location is a point in WGS84 degrees.
+type Location struct {
+	Lat float64
+	Lng float64
+}
+
+// BBox is a bounding box in WGS84 degrees.
+type BBox struct {
+	MinLng float64
+	MinLat float64
+	MaxLng float64
+	MaxLat float64
+}
+
+type GLTFStats struct {
+	Scenes     int
+	Nodes      int
+	Meshes     int
+	Materials  int
+	Textures   int
+	Animations int
+}
+
+func (m *Metadata) IsEmpty() bool {
+	return m == nil || (m.Width == nil && m.Height == nil && m.CapturedAt == nil && m.Location == nil &&
+		m.BBox == nil && m.FeatureCount == nil && m.GLTF == nil && m.Headers == nil && m.RowCount == nil)
+}
+
+func (m *Metadata) Clone() *Metadata {
+	if m == nil {
+		return nil
+	}
+	return &Metadata{
+		Width:        util.CloneRef(m.Width),
+		Height:       util.CloneRef(m.Height),
+		CapturedAt:   util.CloneRef(m.CapturedAt),
+		Location:     util.CloneRef(m.Location),
+		BBox:         util.CloneRef(m.BBox),
+		FeatureCount: util.CloneRef(m.FeatureCount),
+		GLTF:         util.CloneRef(m.GLTF),
+		Headers:      slices.Clone(m.Headers),
+		RowCount:     util.CloneRef(m.RowCount),
+	}
+}
+
+// Merge combines the metadata of the files of an archive. The boxes are joined and the counts are summed,
+// while the image metadata and the CSV headers of the first file which has them are kept.
+func (m *Metadata) Merge(o *Metadata) *Metadata {
+	if m.IsEmpty() {
+		return o.Clone()
+	}
+	if o.IsEmpty() {
+		return m.Clone()
+	}
+	r := m.Clone()
+	if r.Width == nil && r.Height == nil {
+		r.Width, r.Height = util.CloneRef(o.Width), util.CloneRef(o.Height)
+	}
+	if r.CapturedAt == nil {
+		r.CapturedAt = util.CloneRef(o.CapturedAt)
+	}
+	if r.Location == nil {
+		r.Location = util.CloneRef(o.Location)
+	}
+	if o.BBox != nil {
+		r.BBox = r.BBox.extend(o.BBox.MinLng, o.BBox.MinLat).extend(o.BBox.MaxLng, o.BBox.MaxLat)
+	}
+	r.FeatureCount = sumRef(r.FeatureCount, o.FeatureCount)
+	if o.GLTF != nil {
+		g := lo.FromPtr(r.GLTF)
+		g.Scenes += o.GLTF.Scenes
+		g.Nodes += o.GLTF.Nodes
+		g.Meshes += o.GLTF.Meshes
+		g.Materials += o.GLTF.Materials
+		g.Textures += o.GLTF.Textures
+		g.Animations += o.GLTF.Animations
+		r.GLTF = &g
+	}
+	if r.Headers == nil {
+		r.Headers = slices.Clone(o.Headers)
+	}
+	r.RowCount = sumRef(r.RowCount, o.RowCount)
+	return r
+}
+
+func sumRef(a, b *int) *int {
+	if a == nil {
+		return util.CloneRef(b)
+	}
+	if b == nil {
+		return a
+	}
+	return lo.ToPtr(*a + *b)
+}
+
+// ParseBBox parses a box in the form of "minLng,minLat,maxLng,maxLat".
+func ParseBBox(s string) (*BBox, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return nil, ErrInvalidBBox
+	}
+	var v [4]float64
+	for i, p := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+		if err != nil {
+			return nil, ErrInvalidBBox
+		}
+		v[i] = f
+	}
+	if v[0] > v[2] || v[1] > v[3] {
+		return nil, ErrInvalidBBox
+	}
+	return &BBox{MinLng: v[0], MinLat: v[1], MaxLng: v[2], MaxLat: v[3]}, nil
+}
+
+// Contains reports whether the point is in the box.
+func (b BBox) Contains(l Location) bool {
+	return b.MinLng <= l.Lng && l.Lng <= b.MaxLng && b.MinLat <= l.Lat && l.Lat <= b.MaxLat
+}
+
+// Intersects reports whether the boxes share any point.
+func (b BBox) Intersects(o BBox) bool {
+	return b.MinLng <= o.MaxLng && o.MinLng <= b.MaxLng && b.MinLat <= o.MaxLat && o.MinLat <= b.MaxLat
+}
+
+// extend returns the box extended to contain the point. The box of the point is returned when the box is nil.
+func (b *BBox) extend(lng, lat float64) *BBox {
+	if b == nil {
+		return &BBox{MinLng: lng, MinLat: lat, MaxLng: lng, MaxLat: lat}
+	}
+	b.MinLng, b.MaxLng = min(b.MinLng, lng), max(b.MaxLng, lng)
+	b.MinLat, b.MaxLat = min(b.MinLat, lat), max(b.MaxLat, lat)
+	return b
+}
+
+// MetadataFilter filters assets by their metadata. Assets which do not have the filtered metadata do not match.
+type MetadataFilter struct {
+	MinWidth  *int
+	MaxWidth  *int
+	MinHeight *int
+	MaxHeight *int
+	// CapturedAfter and CapturedBefore are inclusive.
+	CapturedAfter  *time.Time
+	CapturedBefore *time.Time
+	// BBox matches assets whose bounding box intersects it, or whose location is in it.
+	BBox *BBox
+	// Header matches CSV assets which have the column.
+	Header *string
+}
+
+func (f *MetadataFilter) IsEmpty() bool {
+	return f == nil || (f.MinWidth == nil && f.MaxWidth == nil && f.MinHeight == nil && f.MaxHeight == nil &&
+		f.CapturedAfter == nil && f.CapturedBefore == nil && f.BBox == nil && f.Header == nil)
+}
+
+func (f *MetadataFilter) Match(m *Metadata) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	if m == nil {
+		return false
+	}
+	if !matchRange(m.Width, f.MinWidth, f.MaxWidth) || !matchRange(m.Height, f.MinHeight, f.MaxHeight) {
+		return false
+	}
+	if f.CapturedAfter != nil && (m.CapturedAt == nil || m.CapturedAt.Before(*f.CapturedAfter)) {
+		return false
+	}
+	if f.CapturedBefore != nil && (m.CapturedAt == nil || m.CapturedAt.After(*f.CapturedBefore)) {
+		return false
+	}
+	if f.BBox != nil {
+		inBox := m.BBox != nil && f.BBox.Intersects(*m.BBox)
+		inLocation := m.Location != nil && f.BBox.Contains(*m.Location)
+		if !inBox && !inLocation {
+			return false
+		}
+	}
+	if f.Header != nil && !slices.Contains(m.Headers, *f.Header) {
+		return false
+	}
+	return true
+}
+
+func matchRange(v, minV, maxV *int) bool {
+	if minV == nil && maxV == nil {
+		return true
+	}
+	if v == nil {
+		return false
+	}
+	return (minV == nil || *minV <= *v) && (maxV == nil || *v <= *maxV)
+}
@@ -0,0 +1,397 @@
+package asset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/rwcarlsen/goexif/exif"
+	"github.com/samber/lo"
+	_ "golang.org/x/image/tiff"
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	errInvalidGLB     = errors.New("invalid glb")
+	errInvalidGeoJSON = errors.New("invalid geojson")
+	errInvalidCZML    = errors.New("invalid czml")
+)
+
+// maxImageHeaderSize is the size of the beginning of image files which the dimensions and EXIF are read from.
+const maxImageHeaderSize = 1 << 20
+
+// ExtractMetadata reads the content of a file and extracts the metadata for the type of the file, which is detected with its extension.
+// It returns nil when metadata is not extracted from the type of the file.
+func ExtractMetadata(r io.Reader, name string) (*Metadata, error) {
+	extract := metadataExtractor(name)
+	if extract == nil {
+		return nil, nil
+	}
+	m, err := extract(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract metadata from %s: %w", name, err)
+	}
+	return m, nil
+}
+
+// CanExtractMetadata reports whether metadata is extracted from the type of the file.
+func CanExtractMetadata(name string) bool {
+	return metadataExtractor(name) != nil
+}
+
+func metadataExtractor(name string) func(io.Reader) (*Metadata, error) {
+	ext := strings.ToLower(path.Ext(name))
+	switch {
+	case lo.Contains(imageExtensions, ext):
+		return extractImageMetadata
+	case ext == ".geojson":
+		return extractGeoJSONMetadata
+	case ext == ".czml":
+		return extractCZMLMetadata
+	case ext == ".gltf":
+		return extractGLTFMetadata
+	case ext == ".glb":
+		return extractGLBMetadata
+	case ext == csvExtension:
+		return extractCSVMetadata
+	}
+	return nil
+}
+
+// extractImageMetadata reads only the beginning of the image, which has the header and EXIF of usual images.
+func extractImageMetadata(r io.Reader) (*Metadata, error) {
+	b, err := io.ReadAll(io.LimitReader(r, maxImageHeaderSize))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	m := &Metadata{
+		Width:  lo.ToPtr(cfg.Width),
+		Height: lo.ToPtr(cfg.Height),
+	}
+
+	// images without EXIF are common, so they are not errors
+	if x, err := exif.Decode(bytes.NewReader(b)); err == nil {
+		if t, err := x.DateTime(); err == nil {
+			m.CapturedAt = &t
+		}
+		if lat, lng, err := x.LatLong(); err == nil {
+			m.Location = &Location{Lat: lat, Lng: lng}
+		}
+	}
+	return m, nil
+}
+
+type geoJSONObject struct {
+	Type        string          `json:"type"`
+	Features    []geoJSONObject `json:"features"`
+	Geometry    *geoJSONObject  `json:"geometry"`
+	Geometries  []geoJSONObject `json:"geometries"`
+	Coordinates any             `json:"coordinates"`
+}
+
+// extractGeoJSONMetadata streams the root object so that the features of a collection are decoded one by one.
+func extractGeoJSONMetadata(r io.Reader) (*Metadata, error) {
+	d := json.NewDecoder(r)
+	if err := expectDelim(d, '{', errInvalidGeoJSON); err != nil {
+		return nil, err
+	}
+
+	var o geoJSONObject
+	var b *BBox
+	features := 0
+	for d.More() {
+		k, err := d.Token()
+		if err != nil {
+			return nil, err
+		}
+		switch k {
+		case "type":
+			err = d.Decode(&o.Type)
+		case "features":
+			err = decodeArray(d, errInvalidGeoJSON, func() error {
+				var f geoJSONObject
+				if err := d.Decode(&f); err != nil {
+					return err
+				}
+				features++
+				b = f.bbox(b)
+				return nil
+			})
+		case "geometry":
+			err = d.Decode(&o.Geometry)
+		case "geometries":
+			err = d.Decode(&o.Geometries)
+		case "coordinates":
+			err = d.Decode(&o.Coordinates)
+		default:
+			err = skipValue(d)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err := d.Token(); err != nil {
+		return nil, err
+	}
+
+	count := 1
+	if o.Type == "FeatureCollection" {
+		count = features
+	}
+	return &Metadata{
+		BBox:         o.bbox(b),
+		FeatureCount: &count,
+	}, nil
+}
+
+func (o *geoJSONObject) bbox(b *BBox) *BBox {
+	if o == nil {
+		return b
+	}
+	for i := range o.Features {
+		b = o.Features[i].bbox(b)
+	}
+	for i := range o.Geometries {
+		b = o.Geometries[i].bbox(b)
+	}
+	b = o.Geometry.bbox(b)
+	return coordinatesBBox(o.Coordinates, b)
+}
+
+// coordinatesBBox extends the box with the positions in nested arrays of GeoJSON coordinates.
+func coordinatesBBox(c any, b *BBox) *BBox {
+	a, ok := c.([]any)
+	if !ok || len(a) == 0 {
+		return b
+	}
+	if lng, ok := a[0].(float64); ok {
+		if len(a) >= 2 {
+			if lat, ok := a[1].(float64); ok {
+				return b.extend(lng, lat)
+			}
+		}
+		return b
+	}
+	for _, v := range a {
+		b = coordinatesBBox(v, b)
+	}
+	return b
+}
+
+type czmlPacket struct {
+	ID       string          `json:"id"`
+	Position json.RawMessage `json:"position"`
+	Polyline *czmlShape      `json:"polyline"`
+	Polygon  *czmlShape      `json:"polygon"`
+	Wall     *czmlShape      `json:"wall"`
+	Corridor *czmlShape      `json:"corridor"`
+}
+
+type czmlShape struct {
+	Positions json.RawMessage `json:"positions"`
+}
+
+type czmlPosition struct {
+	CartographicDegrees []float64 `json:"cartographicDegrees"`
+}
+
+// extractCZMLMetadata streams the packets of the document one by one.
+func extractCZMLMetadata(r io.Reader) (*Metadata, error) {
+	d := json.NewDecoder(r)
+	var b *BBox
+	count := 0
+	err := decodeArray(d, errInvalidCZML, func() error {
+		var p czmlPacket
+		if err := d.Decode(&p); err != nil {
+			return err
+		}
+		if p.ID == "document" {
+			return nil
+		}
+		count++
+
+		// a position is [lng, lat, height] or samples of [time, lng, lat, height]
+		if d := czmlDegrees(p.Position); len(d) == 3 {
+			b = b.extend(d[0], d[1])
+		} else if len(d)%4 == 0 {
+			for i := 0; i+3 < len(d); i += 4 {
+				b = b.extend(d[i+1], d[i+2])
+			}
+		}
+
+		// positions of shapes are lists of [lng, lat, height]
+		for _, s := range []*czmlShape{p.Polyline, p.Polygon, p.Wall, p.Corridor} {
+			if s == nil {
+				continue
+			}
+			if d := czmlDegrees(s.Positions); len(d)%3 == 0 {
+				for i := 0; i+2 < len(d); i += 3 {
+					b = b.extend(d[i], d[i+1])
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metadata{
+		BBox:         b,
+		FeatureCount: &count,
+	}, nil
+}
+
+// czmlDegrees returns the cartographic degrees of a position property. Positions in other forms such as references are ignored.
+func czmlDegrees(raw json.RawMessage) []float64 {
+	if len(raw) == 0 {
+		return nil
+	}
+	var p czmlPosition
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil
+	}
+	return p.CartographicDegrees
+}
+
+// expectDelim reads the next token, which should be the delimiter.
+func expectDelim(d *json.Decoder, delim json.Delim, invalid error) error {
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+	if t != delim {
+		return invalid
+	}
+	return nil
+}
+
+// decodeArray calls f for each element of the next array, where f should decode the element.
+func decodeArray(d *json.Decoder, invalid error, f func() error) error {
+	if err := expectDelim(d, '[', invalid); err != nil {
+		return err
+	}
+	for d.More() {
+		if err := f(); err != nil {
+			return err
+		}
+	}
+	_, err := d.Token()
+	return err
+}
+
+// skipValue reads the next value token by token without keeping it.
+func skipValue(d *json.Decoder) error {
+	depth := 0
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+type gltfDocument struct {
+	Scenes     []json.RawMessage `json:"scenes"`
+	Nodes      []json.RawMessage `json:"nodes"`
+	Meshes     []json.RawMessage `json:"meshes"`
+	Materials  []json.RawMessage `json:"materials"`
+	Textures   []json.RawMessage `json:"textures"`
+	Animations []json.RawMessage `json:"animations"`
+}
+
+func extractGLTFMetadata(r io.Reader) (*Metadata, error) {
+	var d gltfDocument
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, err
+	}
+	return &Metadata{
+		GLTF: &GLTFStats{
+			Scenes:     len(d.Scenes),
+			Nodes:      len(d.Nodes),
+			Meshes:     len(d.Meshes),
+			Materials:  len(d.Materials),
+			Textures:   len(d.Textures),
+			Animations: len(d.Animations),
+		},
+	}, nil
+}
+
+// extractGLBMetadata reads the JSON chunk, which is the first chunk of a binary glTF.
+func extractGLBMetadata(r io.Reader) (*Metadata, error) {
+	var h struct {
+		Magic       [4]byte
+		Version     uint32
+		Length      uint32
+		ChunkLength uint32
+		ChunkType   [4]byte
+	}
+	if err := binary.Read(r, binary.LittleEndian, &h); err != nil {
+		return nil, err
+	}
+	if string(h.Magic[:]) != "glTF" || string(h.ChunkType[:]) != "JSON" {
+		return nil, errInvalidGLB
+	}
+	return extractGLTFMetadata(io.LimitReader(r, int64(h.ChunkLength)))
+}
+
+func extractCSVMetadata(r io.Reader) (*Metadata, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return &Metadata{Headers: []string{}, RowCount: lo.ToPtr(0)}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	headers := make([]string, len(header))
+	for i, h := range header {
+		headers[i] = strings.TrimSpace(h)
+	}
+	if len(headers) > 0 {
+		headers[0] = strings.TrimPrefix(headers[0], "\ufeff")
+	}
+
+	rows := 0
+	for {
+		if _, err := cr.Read(); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		rows++
+	}
+
+	return &Metadata{
+		Headers:  headers,
+		RowCount: &rows,
+	}, nil
+}
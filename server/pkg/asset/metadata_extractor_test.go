@@ -0,0 +1,202 @@
+package asset
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractMetadata_Image(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 30, 20))
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, img))
+	got, err := ExtractMetadata(buf, "a.PNG")
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{Width: lo.ToPtr(30), Height: lo.ToPtr(20)}, got)
+
+	buf = &bytes.Buffer{}
+	assert.NoError(t, jpeg.Encode(buf, img, nil))
+	got, err = ExtractMetadata(bytes.NewReader(withEXIF(buf.Bytes())), "a.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, lo.ToPtr(30), got.Width)
+	assert.Equal(t, lo.ToPtr(20), got.Height)
+	assert.Equal(t, lo.ToPtr(time.Date(2024, 1, 2, 3, 4, 5, 0, time.Local)), got.CapturedAt)
+	assert.Equal(t, &Location{Lat: 35.5, Lng: 139.75}, got.Location)
+
+	// only the beginning of the file is read
+	r := io.MultiReader(bytes.NewReader(buf.Bytes()), bytes.NewReader(make([]byte, maxImageHeaderSize)), iotest.ErrReader(errors.New("read")))
+	got, err = ExtractMetadata(r, "a.jpg")
+	assert.NoError(t, err)
+	assert.Equal(t, lo.ToPtr(30), got.Width)
+
+	_, err = ExtractMetadata(strings.NewReader("xxx"), "a.png")
+	assert.Error(t, err)
+}
+
+func TestExtractMetadata_GeoJSON(t *testing.T) {
+	got, err := ExtractMetadata(strings.NewReader(`{
+		"type": "FeatureCollection",
+		"features": [
+			{"type": "Feature", "geometry": {"type": "Point", "coordinates": [139.7, 35.6]}},
+			{"type": "Feature", "geometry": {"type": "Polygon", "coordinates": [[[130, 30], [131, 31], [130, 31], [130, 30]]]}},
+			{"type": "Feature", "geometry": {"type": "GeometryCollection", "geometries": [{"type": "LineString", "coordinates": [[140, 36, 10], [141, 37, 10]]}]}}
+		]
+	}`), "a.geojson")
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{
+		BBox:         &BBox{MinLng: 130, MinLat: 30, MaxLng: 141, MaxLat: 37},
+		FeatureCount: lo.ToPtr(3),
+	}, got)
+
+	// members other than the geometries are skipped
+	got, err = ExtractMetadata(strings.NewReader(`{
+		"type": "FeatureCollection",
+		"name": "a",
+		"crs": {"type": "name", "properties": {"name": "urn:ogc:def:crs:OGC:1.3:CRS84"}},
+		"bbox": [0, 0, 1, 1],
+		"features": [{"type": "Feature", "properties": {"a": [1, {"b": null}]}, "geometry": {"type": "Point", "coordinates": [139.7, 35.6]}}]
+	}`), "a.geojson")
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{
+		BBox:         &BBox{MinLng: 139.7, MinLat: 35.6, MaxLng: 139.7, MaxLat: 35.6},
+		FeatureCount: lo.ToPtr(1),
+	}, got)
+
+	got, err = ExtractMetadata(strings.NewReader(`{"type": "Feature", "properties": {}, "geometry": {"type": "Point", "coordinates": [139.7, 35.6]}}`), "a.geojson")
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{
+		BBox:         &BBox{MinLng: 139.7, MinLat: 35.6, MaxLng: 139.7, MaxLat: 35.6},
+		FeatureCount: lo.ToPtr(1),
+	}, got)
+
+	_, err = ExtractMetadata(strings.NewReader("{"), "a.geojson")
+	assert.Error(t, err)
+	_, err = ExtractMetadata(strings.NewReader("[]"), "a.geojson")
+	assert.Error(t, err)
+	_, err = ExtractMetadata(strings.NewReader(`{"type": "FeatureCollection", "features": {}}`), "a.geojson")
+	assert.Error(t, err)
+}
+
+func TestExtractMetadata_CZML(t *testing.T) {
+	got, err := ExtractMetadata(strings.NewReader(`[
+		{"id": "document", "version": "1.0"},
+		{"id": "a", "position": {"cartographicDegrees": [139.7, 35.6, 0]}},
+		{"id": "b", "position": {"epoch": "2024-01-01T00:00:00Z", "cartographicDegrees": [0, 140, 36, 0, 10, 141, 37, 0]}},
+		{"id": "c", "polyline": {"positions": {"cartographicDegrees": [130, 30, 0, 131, 31, 0]}}},
+		{"id": "d", "position": {"reference": "a#position"}}
+	]`), "a.czml")
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{
+		BBox:         &BBox{MinLng: 130, MinLat: 30, MaxLng: 141, MaxLat: 37},
+		FeatureCount: lo.ToPtr(4),
+	}, got)
+
+	_, err = ExtractMetadata(strings.NewReader(`{"id": "document"}`), "a.czml")
+	assert.Error(t, err)
+	_, err = ExtractMetadata(strings.NewReader(`[{"id": "document"}`), "a.czml")
+	assert.Error(t, err)
+}
+
+func TestExtractMetadata_GLTF(t *testing.T) {
+	doc := `{"scenes": [{}], "nodes": [{}, {}], "meshes": [{}], "materials": [{}, {}, {}]}`
+	want := &Metadata{GLTF: &GLTFStats{Scenes: 1, Nodes: 2, Meshes: 1, Materials: 3}}
+
+	got, err := ExtractMetadata(strings.NewReader(doc), "a.gltf")
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	glb := &bytes.Buffer{}
+	glb.WriteString("glTF")
+	_ = binary.Write(glb, binary.LittleEndian, []uint32{2, uint32(20 + len(doc)), uint32(len(doc))})
+	glb.WriteString("JSON")
+	glb.WriteString(doc)
+	glb.WriteString("binary chunk")
+	got, err = ExtractMetadata(glb, "a.glb")
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = ExtractMetadata(strings.NewReader("glTFxxxxxxxxxxxxxxxxxxxx"), "a.glb")
+	assert.Error(t, err)
+}
+
+func TestExtractMetadata_CSV(t *testing.T) {
+	got, err := ExtractMetadata(strings.NewReader("\ufeffid, name\n1,a\n2,\"b\nc\"\n3\n"), "a.csv")
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{Headers: []string{"id", "name"}, RowCount: lo.ToPtr(3)}, got)
+
+	got, err = ExtractMetadata(strings.NewReader(""), "a.csv")
+	assert.NoError(t, err)
+	assert.Equal(t, &Metadata{Headers: []string{}, RowCount: lo.ToPtr(0)}, got)
+}
+
+func TestExtractMetadata_Unknown(t *testing.T) {
+	got, err := ExtractMetadata(strings.NewReader("xxx"), "a.txt")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestCanExtractMetadata(t *testing.T) {
+	assert.True(t, CanExtractMetadata("a/b.GeoJSON"))
+	assert.True(t, CanExtractMetadata("a.jpg"))
+	assert.False(t, CanExtractMetadata("a.txt"))
+	assert.False(t, CanExtractMetadata("a.zip"))
+}
+
+// withEXIF inserts an EXIF segment with the capture time 2024-01-02 03:04:05 and the location N35.5 E139.75 to the JPEG.
+func withEXIF(j []byte) []byte {
+	le := binary.LittleEndian
+	tiff := &bytes.Buffer{}
+	entry := func(tag, typ uint16, count, value uint32) {
+		_ = binary.Write(tiff, le, tag)
+		_ = binary.Write(tiff, le, typ)
+		_ = binary.Write(tiff, le, count)
+		_ = binary.Write(tiff, le, value)
+	}
+	ascii := func(s string) uint32 {
+		var v [4]byte
+		copy(v[:], s)
+		return le.Uint32(v[:])
+	}
+
+	tiff.WriteString("II*\x00")
+	_ = binary.Write(tiff, le, uint32(8))
+	// IFD0 at 8
+	_ = binary.Write(tiff, le, uint16(2))
+	entry(0x8769, 4, 1, 38) // Exif IFD
+	entry(0x8825, 4, 1, 76) // GPS IFD
+	_ = binary.Write(tiff, le, uint32(0))
+	// Exif IFD at 38
+	_ = binary.Write(tiff, le, uint16(1))
+	entry(0x9003, 2, 20, 56) // DateTimeOriginal
+	_ = binary.Write(tiff, le, uint32(0))
+	tiff.WriteString("2024:01:02 03:04:05\x00")
+	// GPS IFD at 76
+	_ = binary.Write(tiff, le, uint16(4))
+	entry(0x0001, 2, 2, ascii("N"))
+	entry(0x0002, 5, 3, 130)
+	entry(0x0003, 2, 2, ascii("E"))
+	entry(0x0004, 5, 3, 154)
+	_ = binary.Write(tiff, le, uint32(0))
+	_ = binary.Write(tiff, le, []uint32{35, 1, 30, 1, 0, 1})
+	_ = binary.Write(tiff, le, []uint32{139, 1, 45, 1, 0, 1})
+
+	app1 := &bytes.Buffer{}
+	app1.Write([]byte{0xff, 0xe1})
+	_ = binary.Write(app1, binary.BigEndian, uint16(2+6+tiff.Len()))
+	app1.WriteString("Exif\x00\x00")
+	app1.Write(tiff.Bytes())
+
+	return append(append(append([]byte{}, j[:2]...), app1.Bytes()...), j[2:]...)
+}
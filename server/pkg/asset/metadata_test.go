@@ -0,0 +1,105 @@
+package asset
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadata_IsEmpty(t *testing.T) {
+	assert.True(t, (*Metadata)(nil).IsEmpty())
+	assert.True(t, (&Metadata{}).IsEmpty())
+	assert.False(t, (&Metadata{RowCount: lo.ToPtr(0)}).IsEmpty())
+}
+
+func TestMetadata_Clone(t *testing.T) {
+	m := &Metadata{
+		Width:    lo.ToPtr(1),
+		Location: &Location{Lat: 1, Lng: 2},
+		Headers:  []string{"a"},
+	}
+	got := m.Clone()
+	assert.Equal(t, m, got)
+	assert.NotSame(t, m.Width, got.Width)
+	assert.NotSame(t, m.Location, got.Location)
+	assert.Nil(t, (*Metadata)(nil).Clone())
+}
+
+func TestMetadata_Merge(t *testing.T) {
+	a := &Metadata{BBox: &BBox{MinLng: 130, MinLat: 30, MaxLng: 131, MaxLat: 31}, FeatureCount: lo.ToPtr(2)}
+	b := &Metadata{BBox: &BBox{MinLng: 135, MinLat: 29, MaxLng: 140, MaxLat: 30}, FeatureCount: lo.ToPtr(3)}
+	c := &Metadata{Width: lo.ToPtr(10), Height: lo.ToPtr(20), GLTF: &GLTFStats{Nodes: 1}}
+	d := &Metadata{Width: lo.ToPtr(30), Height: lo.ToPtr(40), GLTF: &GLTFStats{Nodes: 2, Meshes: 1}}
+	e := &Metadata{Headers: []string{"a"}, RowCount: lo.ToPtr(1)}
+	f := &Metadata{Headers: []string{"b"}, RowCount: lo.ToPtr(2)}
+
+	assert.Equal(t, &Metadata{BBox: &BBox{MinLng: 130, MinLat: 29, MaxLng: 140, MaxLat: 31}, FeatureCount: lo.ToPtr(5)}, a.Merge(b))
+	assert.Equal(t, &Metadata{Width: lo.ToPtr(10), Height: lo.ToPtr(20), GLTF: &GLTFStats{Nodes: 3, Meshes: 1}}, c.Merge(d))
+	assert.Equal(t, &Metadata{Headers: []string{"a"}, RowCount: lo.ToPtr(3)}, e.Merge(f))
+	assert.Equal(t, a, (*Metadata)(nil).Merge(a))
+	assert.Equal(t, a, a.Merge(nil))
+	// the merged metadata is not changed
+	assert.Equal(t, &BBox{MinLng: 130, MinLat: 30, MaxLng: 131, MaxLat: 31}, a.BBox)
+	assert.Nil(t, (*Metadata)(nil).Merge(nil))
+}
+
+func TestParseBBox(t *testing.T) {
+	got, err := ParseBBox("130.5, 30,135,35.5")
+	assert.NoError(t, err)
+	assert.Equal(t, &BBox{MinLng: 130.5, MinLat: 30, MaxLng: 135, MaxLat: 35.5}, got)
+
+	for _, s := range []string{"", "1,2,3", "1,2,3,x", "3,2,1,4", "1,4,3,2"} {
+		_, err := ParseBBox(s)
+		assert.Equal(t, ErrInvalidBBox, err, s)
+	}
+}
+
+func TestBBox(t *testing.T) {
+	b := BBox{MinLng: 0, MinLat: 0, MaxLng: 10, MaxLat: 10}
+	assert.True(t, b.Contains(Location{Lat: 5, Lng: 10}))
+	assert.False(t, b.Contains(Location{Lat: 5, Lng: 11}))
+	assert.True(t, b.Intersects(BBox{MinLng: 10, MinLat: 10, MaxLng: 20, MaxLat: 20}))
+	assert.False(t, b.Intersects(BBox{MinLng: 11, MinLat: 0, MaxLng: 20, MaxLat: 10}))
+
+	var e *BBox
+	e = e.extend(1, 2)
+	e = e.extend(-1, 3)
+	assert.Equal(t, &BBox{MinLng: -1, MinLat: 2, MaxLng: 1, MaxLat: 3}, e)
+}
+
+func TestMetadataFilter_Match(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	image := &Metadata{Width: lo.ToPtr(800), Height: lo.ToPtr(600), CapturedAt: &now, Location: &Location{Lat: 35, Lng: 139}}
+	geo := &Metadata{BBox: &BBox{MinLng: 130, MinLat: 30, MaxLng: 135, MaxLat: 35}, FeatureCount: lo.ToPtr(3)}
+	table := &Metadata{Headers: []string{"id", "name"}, RowCount: lo.ToPtr(2)}
+
+	tests := []struct {
+		name   string
+		filter *MetadataFilter
+		want   []*Metadata
+	}{
+		{name: "nil", filter: nil, want: []*Metadata{nil, image, geo, table}},
+		{name: "min width", filter: &MetadataFilter{MinWidth: lo.ToPtr(800)}, want: []*Metadata{image}},
+		{name: "max height", filter: &MetadataFilter{MaxHeight: lo.ToPtr(599)}, want: nil},
+		{name: "captured after", filter: &MetadataFilter{CapturedAfter: &now}, want: []*Metadata{image}},
+		{name: "captured before", filter: &MetadataFilter{CapturedBefore: lo.ToPtr(now.Add(-time.Second))}, want: nil},
+		{name: "bbox with location", filter: &MetadataFilter{BBox: &BBox{MinLng: 138, MinLat: 34, MaxLng: 140, MaxLat: 36}}, want: []*Metadata{image}},
+		{name: "bbox with bbox", filter: &MetadataFilter{BBox: &BBox{MinLng: 134, MinLat: 34, MaxLng: 136, MaxLat: 36}}, want: []*Metadata{geo}},
+		{name: "header", filter: &MetadataFilter{Header: lo.ToPtr("name")}, want: []*Metadata{table}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lo.Filter([]*Metadata{nil, image, geo, table}, func(m *Metadata, _ int) bool {
+				return tt.filter.Match(m)
+			})
+			if tt.want == nil {
+				assert.Empty(t, got)
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -18,6 +18,15 @@ const (
 	AssetDecompress  = "asset.decompress"
 	AssetDelete      = "asset.delete"
 	AssetBatchDelete = "asset.batchdelete"
+	RequestCreate    = "request.create"
+	RequestSubmit    = "request.submit"
+	RequestReview    = "request.review"
+	RequestApprove   = "request.approve"
+	RequestClose     = "request.close"
+	RequestComment   = "request.comment"
+	CommentCreate    = "comment.create"
+	CommentUpdate    = "comment.update"
+	CommentDelete    = "comment.delete"
 )
 
 type Event[T any] struct {
@@ -0,0 +1,303 @@
+package expression
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type node interface {
+	eval(values map[string]any) (any, error)
+}
+
+type literalNode struct {
+	v any
+}
+
+func (n *literalNode) eval(map[string]any) (any, error) {
+	return n.v, nil
+}
+
+type fieldNode struct {
+	key string
+}
+
+func (n *fieldNode) eval(values map[string]any) (any, error) {
+	return normalize(values[n.key]), nil
+}
+
+type unaryNode struct {
+	op string
+	x  node
+}
+
+func (n *unaryNode) eval(values map[string]any) (any, error) {
+	x, err := n.x.eval(values)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		return !truthy(x), nil
+	}
+	if x == nil {
+		return nil, nil
+	}
+	f, ok := x.(float64)
+	if !ok {
+		return nil, fmt.Errorf("- can not be applied to %s", typeName(x))
+	}
+	return -f, nil
+}
+
+type binaryNode struct {
+	op   string
+	l, r node
+}
+
+func (n *binaryNode) eval(values map[string]any) (any, error) {
+	l, err := n.l.eval(values)
+	if err != nil {
+		return nil, err
+	}
+
+	// the logical operators do not evaluate the right side when the result is determined by the left side
+	switch n.op {
+	case "&&":
+		if !truthy(l) {
+			return false, nil
+		}
+		r, err := n.r.eval(values)
+		return truthy(r), err
+	case "||":
+		if truthy(l) {
+			return true, nil
+		}
+		r, err := n.r.eval(values)
+		return truthy(r), err
+	}
+
+	r, err := n.r.eval(values)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return equal(l, r), nil
+	case "!=":
+		return !equal(l, r), nil
+	case "<", "<=", ">", ">=":
+		return compare(n.op, l, r)
+	case "+":
+		_, ls := l.(string)
+		_, rs := r.(string)
+		if ls || rs {
+			return toString(l) + toString(r), nil
+		}
+	}
+
+	if l == nil || r == nil {
+		return nil, nil
+	}
+	lf, lok := l.(float64)
+	rf, rok := r.(float64)
+	if !lok || !rok {
+		return nil, fmt.Errorf("%s can not be applied to %s and %s", n.op, typeName(l), typeName(r))
+	}
+	switch n.op {
+	case "+":
+		return lf + rf, nil
+	case "-":
+		return lf - rf, nil
+	case "*":
+		return lf * rf, nil
+	case "/":
+		if rf == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return lf / rf, nil
+	case "%":
+		if rf == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return math.Mod(lf, rf), nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", n.op)
+}
+
+type callNode struct {
+	name string
+	f    *function
+	args []node
+}
+
+func (n *callNode) eval(values map[string]any) (any, error) {
+	// if evaluates only the selected branch
+	if n.name == "if" {
+		c, err := n.args[0].eval(values)
+		if err != nil {
+			return nil, err
+		}
+		if truthy(c) {
+			return n.args[1].eval(values)
+		}
+		return n.args[2].eval(values)
+	}
+
+	args := make([]any, 0, len(n.args))
+	for _, a := range n.args {
+		v, err := a.eval(values)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	res, err := n.f.fn(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", n.name, err)
+	}
+	return res, nil
+}
+
+// normalize converts the values of fields to the types which the expressions handle.
+func normalize(v any) any {
+	switch w := v.(type) {
+	case nil, bool, float64, string, time.Time, map[string]any:
+		return w
+	case []any:
+		res := make([]any, len(w))
+		for i, x := range w {
+			res[i] = normalize(x)
+		}
+		return res
+	case fmt.Stringer:
+		return w.String()
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	case reflect.Float32:
+		return rv.Float()
+	case reflect.Slice:
+		res := make([]any, rv.Len())
+		for i := range res {
+			res[i] = normalize(rv.Index(i).Interface())
+		}
+		return res
+	}
+	return fmt.Sprint(v)
+}
+
+func truthy(v any) bool {
+	switch w := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return w
+	case float64:
+		return w != 0
+	case string:
+		return w != ""
+	case []any:
+		return len(w) > 0
+	}
+	return true
+}
+
+func equal(l, r any) bool {
+	if lt, ok := l.(time.Time); ok {
+		rt, ok := r.(time.Time)
+		return ok && lt.Equal(rt)
+	}
+	return reflect.DeepEqual(l, r)
+}
+
+func compare(op string, l, r any) (any, error) {
+	if l == nil || r == nil {
+		return nil, nil
+	}
+
+	var c int
+	switch lv := l.(type) {
+	case float64:
+		rv, ok := r.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s can not be applied to %s and %s", op, typeName(l), typeName(r))
+		}
+		c = cmp.Compare(lv, rv)
+	case string:
+		rv, ok := r.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s can not be applied to %s and %s", op, typeName(l), typeName(r))
+		}
+		c = strings.Compare(lv, rv)
+	case time.Time:
+		rv, ok := r.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("%s can not be applied to %s and %s", op, typeName(l), typeName(r))
+		}
+		c = lv.Compare(rv)
+	default:
+		return nil, fmt.Errorf("%s can not be applied to %s", op, typeName(l))
+	}
+
+	switch op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	}
+	return c >= 0, nil
+}
+
+func toString(v any) string {
+	switch w := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return w
+	case float64:
+		return strconv.FormatFloat(w, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(w)
+	case time.Time:
+		return w.Format(time.RFC3339)
+	case []any:
+		s := make([]string, len(w))
+		for i, x := range w {
+			s[i] = toString(x)
+		}
+		return strings.Join(s, ", ")
+	}
+	return fmt.Sprint(v)
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case time.Time:
+		return "datetime"
+	case []any:
+		return "list"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,74 @@
+package expression
+
+import (
+	"fmt"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+// MaxLength is the maximum length of the source of an expression.
+const MaxLength = 1000
+
+var (
+	ErrInvalidExpression = rerror.NewE(i18n.T("invalid expression"))
+	ErrEvaluationFailed  = rerror.NewE(i18n.T("failed to evaluate the expression"))
+)
+
+// Expr is a parsed expression which computes a value from the values of the other fields of an item.
+//
+// The expressions are sandboxed: they can only read the values given to Eval and call the built-in functions,
+// and their evaluation always terminates as the language has no loops, recursion or user-defined functions.
+//
+// The values are nil, bool, float64, string, time.Time and []any. The fields are referred to by their keys,
+// e.g. `first_name + " " + last_name`, or with field("key") when the key is not an identifier, e.g. `slug(field("page-title"))`.
+type Expr struct {
+	src    string
+	root   node
+	fields []string
+}
+
+func Parse(src string) (*Expr, error) {
+	if len(src) > MaxLength {
+		return nil, &rerror.Error{Label: ErrInvalidExpression, Err: fmt.Errorf("expression is longer than %d characters", MaxLength)}
+	}
+
+	p := &parser{lexer: newLexer(src)}
+	root, err := p.parse()
+	if err != nil {
+		return nil, &rerror.Error{Label: ErrInvalidExpression, Err: err}
+	}
+	return &Expr{src: src, root: root, fields: p.fields}, nil
+}
+
+func MustParse(src string) *Expr {
+	e, err := Parse(src)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+func (e *Expr) String() string {
+	if e == nil {
+		return ""
+	}
+	return e.src
+}
+
+// Fields returns the keys of the fields which the expression refers to.
+func (e *Expr) Fields() []string {
+	if e == nil {
+		return nil
+	}
+	return append([]string{}, e.fields...)
+}
+
+// Eval computes the value of the expression from the values of the fields keyed by the field keys.
+func (e *Expr) Eval(values map[string]any) (any, error) {
+	v, err := e.root.eval(values)
+	if err != nil {
+		return nil, &rerror.Error{Label: ErrEvaluationFailed, Err: err}
+	}
+	return v, nil
+}
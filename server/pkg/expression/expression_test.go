@@ -0,0 +1,124 @@
+package expression
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearthx/rerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	e, err := Parse(`first_name + " " + field("last-name")`)
+	assert.NoError(t, err)
+	assert.Equal(t, `first_name + " " + field("last-name")`, e.String())
+	assert.Equal(t, []string{"first_name", "last-name"}, e.Fields())
+
+	e, err = Parse(`if(a > 1, upper(a), b) == a`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, e.Fields())
+
+	for _, src := range []string{
+		"",
+		"a +",
+		"(a",
+		"a b",
+		"foo(a)",
+		"lower()",
+		"lower(a, b)",
+		"field(a)",
+		`field("a", "b")`,
+		`"abc`,
+		`"\x"`,
+		"a # b",
+		"1..2",
+		strings.Repeat("(", 40) + "a" + strings.Repeat(")", 40),
+		strings.Repeat("a", MaxLength+1),
+	} {
+		_, err := Parse(src)
+		assert.True(t, rerror.Is(err, ErrInvalidExpression), src)
+	}
+
+	assert.Panics(t, func() { MustParse("a +") })
+	assert.Equal(t, "", (*Expr)(nil).String())
+	assert.Nil(t, (*Expr)(nil).Fields())
+}
+
+func TestExpr_Eval(t *testing.T) {
+	d := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	values := map[string]any{
+		"title":    "Hello, Wörld!",
+		"first":    "John",
+		"last":     "Doe",
+		"empty":    "",
+		"n":        int64(3),
+		"f":        1.5,
+		"b":        true,
+		"d":        d,
+		"refs":     []any{"a", "b", "c"},
+		"geometry": `{"type":"LineString","coordinates":[[139.1,35.5],[140.2,35.1],[139.8,36.0]]}`,
+		"point":    map[string]any{"type": "Point", "coordinates": []any{1.0, 2.0}},
+		"collection": `{"type":"GeometryCollection","geometries":[` +
+			`{"type":"Point","coordinates":[-1,5]},{"type":"Polygon","coordinates":[[[0,0],[3,0],[3,4],[0,0]]]}]}`,
+	}
+
+	tests := []struct {
+		src  string
+		want any
+	}{
+		{`slug(title)`, "hello-world"},
+		{`first + " " + last`, "John Doe"},
+		{`concat(first, " ", missing, last)`, "John Doe"},
+		{`join(refs, "/")`, "a/b/c"},
+		{`len(refs)`, 3.0},
+		{`len(title)`, 13.0},
+		{`len(missing)`, 0.0},
+		{`n * 2 + f`, 7.5},
+		{`n % 2`, 1.0},
+		{`-n`, -3.0},
+		{`n + missing`, nil},
+		{`n / 2 > 1 && b`, true},
+		{`!b || n < 0`, false},
+		{`missing || n`, true},
+		{`d > d`, false},
+		{`d == d`, true},
+		{`"b" > "a"`, true},
+		{`n != 3`, false},
+		{`coalesce(empty, missing, last)`, "Doe"},
+		{`if(b, upper(first), 1 / 0)`, "JOHN"},
+		{`lower(trim("  ABC "))`, "abc"},
+		{`lower(missing)`, nil},
+		{`number("1.25") + 1`, 2.25},
+		{`string(n) + "px"`, "3px"},
+		{`round(2.345, 2)`, 2.35},
+		{`round(f)`, 2.0},
+		{`"date: " + d`, "date: 2024-01-02T03:04:05Z"},
+		{`bbox(geometry)`, []any{139.1, 35.1, 140.2, 36.0}},
+		{`bbox(point)`, []any{1.0, 2.0, 1.0, 2.0}},
+		{`bbox(collection)`, []any{-1.0, 0.0, 3.0, 5.0}},
+		{`bbox(missing)`, nil},
+		{`'it\'s'`, "it's"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			got, err := MustParse(tt.src).Eval(values)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, src := range []string{
+		`n / 0`,
+		`n - "a"`,
+		`n < "a"`,
+		`-title`,
+		`number("x")`,
+		`bbox("{")`,
+		`len(n)`,
+	} {
+		_, err := MustParse(src).Eval(values)
+		assert.True(t, rerror.Is(err, ErrEvaluationFailed), src)
+	}
+}
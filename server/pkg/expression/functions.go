@@ -0,0 +1,206 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/samber/lo"
+	"golang.org/x/text/unicode/norm"
+)
+
+type function struct {
+	min, max int // max is -1 when the function takes any number of arguments
+	fn       func(args []any) (any, error)
+}
+
+var functions = map[string]*function{
+	"concat":   {min: 0, max: -1, fn: concat},
+	"join":     {min: 2, max: 2, fn: join},
+	"lower":    {min: 1, max: 1, fn: stringFunc(strings.ToLower)},
+	"upper":    {min: 1, max: 1, fn: stringFunc(strings.ToUpper)},
+	"trim":     {min: 1, max: 1, fn: stringFunc(strings.TrimSpace)},
+	"slug":     {min: 1, max: 1, fn: stringFunc(slug)},
+	"len":      {min: 1, max: 1, fn: length},
+	"coalesce": {min: 1, max: -1, fn: coalesce},
+	"if":       {min: 3, max: 3}, // evaluated by callNode so that only the selected branch is evaluated
+	"bbox":     {min: 1, max: 1, fn: bbox},
+	"number":   {min: 1, max: 1, fn: number},
+	"string":   {min: 1, max: 1, fn: func(args []any) (any, error) { return toString(args[0]), nil }},
+	"round":    {min: 1, max: 2, fn: round},
+}
+
+func stringFunc(f func(string) string) func([]any) (any, error) {
+	return func(args []any) (any, error) {
+		if args[0] == nil {
+			return nil, nil
+		}
+		return f(toString(args[0])), nil
+	}
+}
+
+func concat(args []any) (any, error) {
+	var b strings.Builder
+	for _, a := range args {
+		b.WriteString(toString(a))
+	}
+	return b.String(), nil
+}
+
+func join(args []any) (any, error) {
+	sep := toString(args[1])
+	l, ok := args[0].([]any)
+	if !ok {
+		return toString(args[0]), nil
+	}
+	s := lo.FilterMap(l, func(v any, _ int) (string, bool) {
+		return toString(v), v != nil
+	})
+	return strings.Join(s, sep), nil
+}
+
+func slug(s string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range norm.NFKD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// drop the diacritical marks decomposed from the letters
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			hyphen = true
+		}
+	}
+	return b.String()
+}
+
+func length(args []any) (any, error) {
+	switch v := args[0].(type) {
+	case nil:
+		return float64(0), nil
+	case string:
+		return float64(len([]rune(v))), nil
+	case []any:
+		return float64(len(v)), nil
+	}
+	return nil, fmt.Errorf("len can not be applied to %s", typeName(args[0]))
+}
+
+func coalesce(args []any) (any, error) {
+	for _, a := range args {
+		if truthy(a) {
+			return a, nil
+		}
+	}
+	return nil, nil
+}
+
+func number(args []any) (any, error) {
+	switch v := args[0].(type) {
+	case nil, float64:
+		return v, nil
+	case bool:
+		return float64(lo.Ternary(v, 1, 0)), nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", v)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("%s can not be converted to a number", typeName(args[0]))
+}
+
+func round(args []any) (any, error) {
+	if args[0] == nil {
+		return nil, nil
+	}
+	f, ok := args[0].(float64)
+	if !ok {
+		return nil, fmt.Errorf("round can not be applied to %s", typeName(args[0]))
+	}
+	digits := 0.0
+	if len(args) > 1 {
+		if digits, ok = args[1].(float64); !ok {
+			return nil, fmt.Errorf("the number of digits must be a number")
+		}
+	}
+	p := math.Pow(10, math.Trunc(digits))
+	return math.Round(f*p) / p, nil
+}
+
+// bbox returns the bounding box [minX, minY, maxX, maxY] of a GeoJSON geometry given as a string or an object.
+func bbox(args []any) (any, error) {
+	g, err := geometry(args[0])
+	if err != nil || g == nil {
+		return nil, err
+	}
+
+	box := []float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	found := false
+	var walk func(v any)
+	walk = func(v any) {
+		l, ok := v.([]any)
+		if !ok {
+			return
+		}
+		if len(l) >= 2 {
+			x, ok1 := l[0].(float64)
+			y, ok2 := l[1].(float64)
+			if ok1 && ok2 {
+				box[0], box[1] = math.Min(box[0], x), math.Min(box[1], y)
+				box[2], box[3] = math.Max(box[2], x), math.Max(box[3], y)
+				found = true
+				return
+			}
+		}
+		for _, w := range l {
+			walk(w)
+		}
+	}
+
+	var walkGeometry func(g map[string]any)
+	walkGeometry = func(g map[string]any) {
+		walk(g["coordinates"])
+		if gs, ok := g["geometries"].([]any); ok {
+			for _, w := range gs {
+				if m, ok := w.(map[string]any); ok {
+					walkGeometry(m)
+				}
+			}
+		}
+	}
+	walkGeometry(g)
+
+	if !found {
+		return nil, nil
+	}
+	return lo.Map(box, func(f float64, _ int) any { return f }), nil
+}
+
+func geometry(v any) (map[string]any, error) {
+	switch w := v.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return w, nil
+	case string:
+		if strings.TrimSpace(w) == "" {
+			return nil, nil
+		}
+		var g map[string]any
+		if err := json.Unmarshal([]byte(w), &g); err != nil {
+			return nil, fmt.Errorf("invalid geometry: %w", err)
+		}
+		return g, nil
+	}
+	return nil, fmt.Errorf("bbox can not be applied to %s", typeName(v))
+}
@@ -0,0 +1,197 @@
+package expression
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+// maxDepth limits the nesting of expressions so that parsing and evaluation do not exhaust the stack.
+const maxDepth = 32
+
+type parser struct {
+	lexer  *lexer
+	tok    token
+	depth  int
+	fields []string
+}
+
+func (p *parser) parse() (node, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, p.unexpected()
+	}
+	return n, nil
+}
+
+func (p *parser) advance() (err error) {
+	p.tok, err = p.lexer.next()
+	return
+}
+
+func (p *parser) unexpected() error {
+	return fmt.Errorf("unexpected %s at %d", p.tok, p.tok.pos)
+}
+
+func (p *parser) isOperator(ops ...string) bool {
+	return p.tok.kind == tokenOperator && lo.Contains(ops, p.tok.text)
+}
+
+func (p *parser) expect(op string) error {
+	if !p.isOperator(op) {
+		return p.unexpected()
+	}
+	return p.advance()
+}
+
+func (p *parser) expr() (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxDepth {
+		return nil, fmt.Errorf("expression is nested too deeply")
+	}
+	return p.binary(0)
+}
+
+// precedences lists the binary operators from the lowest precedence.
+var precedences = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!=", "<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) binary(level int) (node, error) {
+	if level == len(precedences) {
+		return p.unary()
+	}
+
+	l, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator(precedences[level]...) {
+		op := p.tok.text
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		r, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		l = &binaryNode{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *parser) unary() (node, error) {
+	if p.isOperator("-", "!") {
+		op := p.tok.text
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: op, x: x}, nil
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokenNumber, tokenString:
+		return &literalNode{v: tok.value}, p.advance()
+	case tokenIdent:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		if p.isOperator("(") {
+			return p.call(tok)
+		}
+		switch tok.text {
+		case "true":
+			return &literalNode{v: true}, nil
+		case "false":
+			return &literalNode{v: false}, nil
+		case "null":
+			return &literalNode{v: nil}, nil
+		}
+		return p.field(tok.text), nil
+	case tokenOperator:
+		if tok.text == "(" {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			n, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			return n, p.expect(")")
+		}
+	}
+	return nil, p.unexpected()
+}
+
+func (p *parser) call(name token) (node, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	var args []node
+	for !p.isOperator(")") {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		a, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, a)
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	// field("key") refers to the field whose key is not an identifier, so the key must be known when parsing
+	if name.text == "field" {
+		if len(args) != 1 {
+			return nil, fmt.Errorf("field takes a field key at %d", name.pos)
+		}
+		var key string
+		lit, ok := args[0].(*literalNode)
+		if ok {
+			key, ok = lit.v.(string)
+		}
+		if !ok {
+			return nil, fmt.Errorf("field takes a string literal at %d", name.pos)
+		}
+		return p.field(key), nil
+	}
+
+	f, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at %d", name.text, name.pos)
+	}
+	if len(args) < f.min || f.max >= 0 && len(args) > f.max {
+		return nil, fmt.Errorf("wrong number of arguments for %s at %d", name.text, name.pos)
+	}
+	return &callNode{name: name.text, f: f, args: args}, nil
+}
+
+func (p *parser) field(key string) node {
+	if !lo.Contains(p.fields, key) {
+		p.fields = append(p.fields, key)
+	}
+	return &fieldNode{key: key}
+}
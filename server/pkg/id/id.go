@@ -253,6 +253,54 @@ var MustViewID = idx.Must[View]
 var ViewIDFrom = idx.From[View]
 var ViewIDFromRef = idx.FromRef[View]
 
+type ItemTemplate struct{}
+
+func (ItemTemplate) Type() string { return "item_template" }
+
+type ItemTemplateID = idx.ID[ItemTemplate]
+type ItemTemplateIDList = idx.List[ItemTemplate]
+
+var NewItemTemplateID = idx.New[ItemTemplate]
+var MustItemTemplateID = idx.Must[ItemTemplate]
+var ItemTemplateIDFrom = idx.From[ItemTemplate]
+var ItemTemplateIDFromRef = idx.FromRef[ItemTemplate]
+
+type Trash struct{}
+
+func (Trash) Type() string { return "trash" }
+
+type TrashID = idx.ID[Trash]
+type TrashIDList = idx.List[Trash]
+
+var NewTrashID = idx.New[Trash]
+var MustTrashID = idx.Must[Trash]
+var TrashIDFrom = idx.From[Trash]
+var TrashIDFromRef = idx.FromRef[Trash]
+
+type Release struct{}
+
+func (Release) Type() string { return "release" }
+
+type ReleaseID = idx.ID[Release]
+type ReleaseIDList = idx.List[Release]
+
+var NewReleaseID = idx.New[Release]
+var MustReleaseID = idx.Must[Release]
+var ReleaseIDFrom = idx.From[Release]
+var ReleaseIDFromRef = idx.FromRef[Release]
+
+type PreviewToken struct{}
+
+func (PreviewToken) Type() string { return "preview_token" }
+
+type PreviewTokenID = idx.ID[PreviewToken]
+type PreviewTokenIDList = idx.List[PreviewToken]
+
+var NewPreviewTokenID = idx.New[PreviewToken]
+var MustPreviewTokenID = idx.Must[PreviewToken]
+var PreviewTokenIDFrom = idx.From[PreviewToken]
+var PreviewTokenIDFromRef = idx.FromRef[PreviewToken]
+
 type Resource struct{}
 
 func (Resource) Type() string { return "resource" }
@@ -264,3 +312,27 @@ var NewResourceID = idx.New[Resource]
 var MustResourceID = idx.Must[Resource]
 var ResourceIDFrom = idx.From[Resource]
 var ResourceIDFromRef = idx.FromRef[Resource]
+
+type Schedule struct{}
+
+func (Schedule) Type() string { return "schedule" }
+
+type ScheduleID = idx.ID[Schedule]
+type ScheduleIDList = idx.List[Schedule]
+
+var NewScheduleID = idx.New[Schedule]
+var MustScheduleID = idx.Must[Schedule]
+var ScheduleIDFrom = idx.From[Schedule]
+var ScheduleIDFromRef = idx.FromRef[Schedule]
+
+type Notification struct{}
+
+func (Notification) Type() string { return "notification" }
+
+type NotificationID = idx.ID[Notification]
+type NotificationIDList = idx.List[Notification]
+
+var NewNotificationID = idx.New[Notification]
+var MustNotificationID = idx.Must[Notification]
+var NotificationIDFrom = idx.From[Notification]
+var NotificationIDFromRef = idx.FromRef[Notification]
@@ -67,4 +67,7 @@ func TestAsset_Type(t *testing.T) {
 
 	ig := ItemGroup{}
 	assert.Equal(t, "item_group", ig.Type())
+
+	no := Notification{}
+	assert.Equal(t, "notification", no.Type())
 }
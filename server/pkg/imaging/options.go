@@ -0,0 +1,211 @@
+package imaging
+
+import (
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var (
+	ErrInvalidSize        = rerror.NewE(i18n.T("invalid image size"))
+	ErrInvalidFit         = rerror.NewE(i18n.T("invalid image fit"))
+	ErrUnsupportedFormat  = rerror.NewE(i18n.T("unsupported image format"))
+	ErrInvalidImage       = rerror.NewE(i18n.T("invalid image"))
+	ErrImageTooLarge      = rerror.NewE(i18n.T("image too large"))
+	ErrInvalidSignature   = rerror.NewE(i18n.T("invalid signature of the image transformation"))
+	ErrTransformsDisabled = rerror.NewE(i18n.T("image transformations are not configured"))
+)
+
+const (
+	// MaxSize is the largest width or height of a transformed image.
+	MaxSize = 4096
+	// MaxSourcePixels is the largest number of pixels of an image which can be transformed.
+	MaxSourcePixels = 50_000_000
+	// MaxSourceSize is the largest size in bytes of an image file which can be transformed.
+	MaxSourceSize = 100 << 20
+
+	widthParam     = "w"
+	heightParam    = "h"
+	fitParam       = "fit"
+	formatParam    = "format"
+	signatureParam = "s"
+
+	assetDir      = "assets"
+	derivativeDir = "derivatives"
+)
+
+type Fit string
+
+const (
+	// FitContain scales the image to fit within the box keeping the aspect ratio.
+	FitContain Fit = "contain"
+	// FitCover scales the image to fill the box keeping the aspect ratio, and crops the overflow around the center.
+	FitCover Fit = "cover"
+	// FitFill stretches the image to the box.
+	FitFill Fit = "fill"
+)
+
+func FitFrom(s string) (Fit, bool) {
+	switch f := Fit(strings.ToLower(s)); f {
+	case FitContain, FitCover, FitFill:
+		return f, true
+	}
+	return "", false
+}
+
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+	FormatWebP Format = "webp"
+)
+
+func FormatFrom(s string) (Format, bool) {
+	switch f := Format(strings.ToLower(s)); f {
+	case FormatJPEG, FormatPNG, FormatWebP:
+		return f, true
+	case "jpg":
+		return FormatJPEG, true
+	}
+	return "", false
+}
+
+// FormatFromExtension returns the format of an image file from its extension.
+func FormatFromExtension(ext string) (Format, bool) {
+	switch strings.ToLower(ext) {
+	case ".jpg", ".jpeg":
+		return FormatJPEG, true
+	case ".png":
+		return FormatPNG, true
+	case ".webp":
+		return FormatWebP, true
+	}
+	return "", false
+}
+
+func (f Format) Extension() string {
+	if f == FormatJPEG {
+		return ".jpg"
+	}
+	return "." + string(f)
+}
+
+func (f Format) ContentType() string {
+	return "image/" + string(f)
+}
+
+// Options describes how an image is transformed. Width or height is 0 when it follows the aspect ratio of the source image.
+type Options struct {
+	Width  int
+	Height int
+	Fit    Fit
+	Format Format
+}
+
+// ParseQuery reads the options from the query of an asset URL. It returns nil when the query has no transformation.
+func ParseQuery(q url.Values) (*Options, error) {
+	if q.Get(widthParam) == "" && q.Get(heightParam) == "" && q.Get(fitParam) == "" && q.Get(formatParam) == "" {
+		return nil, nil
+	}
+
+	o := &Options{}
+	var err error
+	if o.Width, err = parseSize(q.Get(widthParam)); err != nil {
+		return nil, err
+	}
+	if o.Height, err = parseSize(q.Get(heightParam)); err != nil {
+		return nil, err
+	}
+	if s := q.Get(fitParam); s != "" {
+		f, ok := FitFrom(s)
+		if !ok {
+			return nil, ErrInvalidFit
+		}
+		o.Fit = f
+	}
+	if s := q.Get(formatParam); s != "" {
+		f, ok := FormatFrom(s)
+		if !ok {
+			return nil, ErrUnsupportedFormat
+		}
+		o.Format = f
+	}
+	return o, nil
+}
+
+func parseSize(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v <= 0 || v > MaxSize {
+		return 0, ErrInvalidSize
+	}
+	return v, nil
+}
+
+// FitOrDefault returns the fit of the options, which is FitContain when it is not specified.
+func (o Options) FitOrDefault() Fit {
+	if o.Fit == "" {
+		return FitContain
+	}
+	return o.Fit
+}
+
+// OutputFormat returns the format of the transformed image. It is the format of the source file when it is not specified.
+// GIF is not an output format, so the first frame of a GIF is encoded as PNG, and the derivative is named with ".png".
+func (o Options) OutputFormat(filename string) (Format, bool) {
+	if o.Format != "" {
+		return o.Format, true
+	}
+	ext := path.Ext(filename)
+	if strings.EqualFold(ext, ".gif") {
+		return FormatPNG, true
+	}
+	return FormatFromExtension(ext)
+}
+
+// Query returns the canonical query of the options, which is signed.
+func (o Options) Query() string {
+	q := url.Values{}
+	if o.Width > 0 {
+		q.Set(widthParam, strconv.Itoa(o.Width))
+	}
+	if o.Height > 0 {
+		q.Set(heightParam, strconv.Itoa(o.Height))
+	}
+	if o.Fit != "" {
+		q.Set(fitParam, string(o.Fit))
+	}
+	if o.Format != "" {
+		q.Set(formatParam, string(o.Format))
+	}
+	return q.Encode()
+}
+
+// Name returns the file name of the derivative transformed from the file with the options.
+func (o Options) Name(filename string) string {
+	f, _ := o.OutputFormat(filename)
+	return strconv.Itoa(o.Width) + "x" + strconv.Itoa(o.Height) + "_" + string(o.FitOrDefault()) + f.Extension()
+}
+
+// AssetPath returns the path of the URL of the asset file which the options are signed for.
+func AssetPath(uuid, filename string) string {
+	if len(uuid) < 3 || filename == "" {
+		return ""
+	}
+	return "/" + path.Join(assetDir, uuid[:2], uuid[2:], filename)
+}
+
+// DerivativePath returns the path in the file storage where the derivative of the asset file transformed with the options is cached.
+func (o Options) DerivativePath(uuid, filename string) string {
+	if len(uuid) < 3 || filename == "" {
+		return ""
+	}
+	return path.Join(derivativeDir, uuid[:2], uuid[2:], filename, o.Name(filename))
+}
@@ -0,0 +1,71 @@
+package imaging
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		want    *Options
+		wantErr error
+	}{
+		{name: "no transformation", query: "foo=bar", want: nil},
+		{name: "all", query: "w=200&h=100&fit=COVER&format=jpg", want: &Options{Width: 200, Height: 100, Fit: FitCover, Format: FormatJPEG}},
+		{name: "width only", query: "w=200", want: &Options{Width: 200}},
+		{name: "format only", query: "format=webp", want: &Options{Format: FormatWebP}},
+		{name: "invalid width", query: "w=abc", wantErr: ErrInvalidSize},
+		{name: "zero height", query: "h=0", wantErr: ErrInvalidSize},
+		{name: "too large", query: "w=4097", wantErr: ErrInvalidSize},
+		{name: "invalid fit", query: "w=1&fit=zoom", wantErr: ErrInvalidFit},
+		{name: "unknown format", query: "format=bmp", wantErr: ErrUnsupportedFormat},
+		{name: "avif", query: "format=avif", wantErr: ErrUnsupportedFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, _ := url.ParseQuery(tt.query)
+			got, err := ParseQuery(q)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOptions_OutputFormat(t *testing.T) {
+	f, ok := Options{}.OutputFormat("a.JPEG")
+	assert.True(t, ok)
+	assert.Equal(t, FormatJPEG, f)
+
+	// the first frame of a GIF is encoded as PNG
+	f, ok = Options{}.OutputFormat("a.GIF")
+	assert.True(t, ok)
+	assert.Equal(t, FormatPNG, f)
+	assert.Equal(t, "10x0_contain.png", Options{Width: 10}.Name("a.gif"))
+	_, ok = FormatFromExtension(".gif")
+	assert.False(t, ok)
+
+	_, ok = Options{}.OutputFormat("a.tiff")
+	assert.False(t, ok)
+
+	f, ok = Options{Format: FormatWebP}.OutputFormat("a.tiff")
+	assert.True(t, ok)
+	assert.Equal(t, FormatWebP, f)
+}
+
+func TestOptions_Query(t *testing.T) {
+	assert.Equal(t, "", Options{}.Query())
+	assert.Equal(t, "fit=cover&format=webp&h=100&w=200", Options{Width: 200, Height: 100, Fit: FitCover, Format: FormatWebP}.Query())
+}
+
+func TestOptions_DerivativePath(t *testing.T) {
+	o := Options{Width: 200, Format: FormatWebP}
+	assert.Equal(t, "derivatives/ab/cdef/a.png/200x0_contain.webp", o.DerivativePath("abcdef", "a.png"))
+	assert.Equal(t, "derivatives/ab/cdef/a.jpeg/0x100_cover.jpg", Options{Height: 100, Fit: FitCover}.DerivativePath("abcdef", "a.jpeg"))
+	assert.Equal(t, "", o.DerivativePath("", "a.png"))
+	assert.Equal(t, "", o.DerivativePath("abcdef", ""))
+}
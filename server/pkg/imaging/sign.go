@@ -0,0 +1,37 @@
+package imaging
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+)
+
+// Sign returns the signature of the options for the path of an asset URL, which is given as the "s" query parameter.
+// It is the HMAC-SHA256 of the path and the canonical query joined by "?", encoded in unpadded base64url.
+func (o Options) Sign(secret []byte, p string) string {
+	m := hmac.New(sha256.New, secret)
+	_, _ = m.Write([]byte(p + "?" + o.Query()))
+	return base64.RawURLEncoding.EncodeToString(m.Sum(nil))
+}
+
+// Verify returns an error when the query does not have the signature of the options for the path.
+func (o Options) Verify(secret []byte, p string, q url.Values) error {
+	if len(secret) == 0 {
+		return ErrTransformsDisabled
+	}
+	sig := q.Get(signatureParam)
+	if sig == "" || !hmac.Equal([]byte(sig), []byte(o.Sign(secret, p))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// SignedQuery returns the query of the options with the signature for the path.
+func (o Options) SignedQuery(secret []byte, p string) string {
+	q := o.Query()
+	if q != "" {
+		q += "&"
+	}
+	return q + signatureParam + "=" + o.Sign(secret, p)
+}
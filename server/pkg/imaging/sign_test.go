@@ -0,0 +1,30 @@
+package imaging
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var secret = []byte("secret")
+
+func TestOptions_Verify(t *testing.T) {
+	p := "/assets/ab/cdef/a.png"
+	o := Options{Width: 200, Height: 100, Fit: FitCover}
+
+	q, _ := url.ParseQuery(o.SignedQuery(secret, p))
+	assert.NoError(t, o.Verify(secret, p, q))
+
+	// the order and the case of the parameters do not matter
+	q2, _ := url.ParseQuery("fit=cover&h=100&w=200&s=" + q.Get("s"))
+	o2, err := ParseQuery(q2)
+	assert.NoError(t, err)
+	assert.NoError(t, o2.Verify(secret, p, q2))
+
+	assert.Equal(t, ErrInvalidSignature, o.Verify([]byte("other"), p, q))
+	assert.Equal(t, ErrInvalidSignature, o.Verify(secret, "/assets/ab/cdef/b.png", q))
+	assert.Equal(t, ErrInvalidSignature, Options{Width: 400, Height: 100, Fit: FitCover}.Verify(secret, p, q))
+	assert.Equal(t, ErrInvalidSignature, o.Verify(secret, p, url.Values{}))
+	assert.Equal(t, ErrTransformsDisabled, o.Verify(nil, p, q))
+}
@@ -0,0 +1,126 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+)
+
+const (
+	jpegQuality = 85
+	// maxHeaderSize is the largest size of the header which the dimensions of an image are read from.
+	maxHeaderSize = 1 << 20
+)
+
+// Transform decodes the image of the file, and returns the image resized and encoded with the options.
+// The dimensions are read from the header before the image is decoded, and the file is read up to MaxSourceSize.
+func Transform(r io.Reader, filename string, o Options) ([]byte, Format, error) {
+	format, ok := o.OutputFormat(filename)
+	if !ok {
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	lr := &io.LimitedReader{R: r, N: MaxSourceSize + 1}
+	header := &bytes.Buffer{}
+	cfg, _, err := image.DecodeConfig(io.TeeReader(io.LimitReader(lr, maxHeaderSize), header))
+	if err != nil {
+		return nil, "", ErrInvalidImage
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 {
+		return nil, "", ErrInvalidImage
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxSourcePixels {
+		return nil, "", ErrImageTooLarge
+	}
+
+	// the header read for the dimensions is decoded again with the rest of the file
+	src, _, err := image.Decode(io.MultiReader(header, lr))
+	if lr.N <= 0 {
+		return nil, "", ErrImageTooLarge
+	}
+	if err != nil {
+		return nil, "", ErrInvalidImage
+	}
+
+	img := resize(src, o, format != FormatJPEG)
+
+	buf := &bytes.Buffer{}
+	switch format {
+	case FormatJPEG:
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: jpegQuality})
+	case FormatPNG:
+		err = png.Encode(buf, img)
+	case FormatWebP:
+		err = nativewebp.Encode(buf, img, nil)
+	default:
+		return nil, "", ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return buf.Bytes(), format, nil
+}
+
+// resize draws the source image to the box of the options. The transparent area is filled in white when alpha is not kept.
+func resize(src image.Image, o Options, alpha bool) image.Image {
+	sb := src.Bounds()
+	w, h, sr := box(sb, o)
+
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	op := draw.Src
+	if !alpha {
+		draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		op = draw.Over
+	}
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, sr, op, nil)
+	return dst
+}
+
+// box returns the size of the transformed image and the rectangle of the source image which is drawn to it.
+func box(sb image.Rectangle, o Options) (int, int, image.Rectangle) {
+	sw, sh := sb.Dx(), sb.Dy()
+	w, h := o.Width, o.Height
+
+	switch {
+	case w == 0 && h == 0:
+		return sw, sh, sb
+	case w == 0:
+		return atLeastOne(sw * h / sh), h, sb
+	case h == 0:
+		return w, atLeastOne(sh * w / sw), sb
+	}
+
+	switch o.FitOrDefault() {
+	case FitCover:
+		// crop the source around its center to the aspect ratio of the box
+		cw, ch := sw, sh
+		if sw*h > sh*w {
+			cw = atLeastOne(sh * w / h)
+		} else {
+			ch = atLeastOne(sw * h / w)
+		}
+		x, y := sb.Min.X+(sw-cw)/2, sb.Min.Y+(sh-ch)/2
+		return w, h, image.Rect(x, y, x+cw, y+ch)
+	case FitFill:
+		return w, h, sb
+	default:
+		if sw*h > sh*w {
+			return w, atLeastOne(sh * w / sw), sb
+		}
+		return atLeastOne(sw * h / sh), h, sb
+	}
+}
+
+func atLeastOne(v int) int {
+	if v < 1 {
+		return 1
+	}
+	return v
+}
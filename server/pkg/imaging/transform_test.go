@@ -0,0 +1,104 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), A: 255})
+		}
+	}
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, img))
+	return buf.Bytes()
+}
+
+func TestTransform(t *testing.T) {
+	src := testPNG(t, 400, 200)
+
+	tests := []struct {
+		name       string
+		options    Options
+		wantFormat Format
+		wantW      int
+		wantH      int
+	}{
+		{name: "width only", options: Options{Width: 100}, wantFormat: FormatPNG, wantW: 100, wantH: 50},
+		{name: "height only", options: Options{Height: 100}, wantFormat: FormatPNG, wantW: 200, wantH: 100},
+		{name: "contain", options: Options{Width: 100, Height: 100}, wantFormat: FormatPNG, wantW: 100, wantH: 50},
+		{name: "cover", options: Options{Width: 100, Height: 100, Fit: FitCover}, wantFormat: FormatPNG, wantW: 100, wantH: 100},
+		{name: "fill", options: Options{Width: 100, Height: 100, Fit: FitFill}, wantFormat: FormatPNG, wantW: 100, wantH: 100},
+		{name: "jpeg", options: Options{Width: 40, Format: FormatJPEG}, wantFormat: FormatJPEG, wantW: 40, wantH: 20},
+		{name: "webp", options: Options{Width: 40, Format: FormatWebP}, wantFormat: FormatWebP, wantW: 40, wantH: 20},
+		{name: "format only", options: Options{Format: FormatWebP}, wantFormat: FormatWebP, wantW: 400, wantH: 200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, f, err := Transform(bytes.NewReader(src), "a.png", tt.options)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFormat, f)
+
+			cfg, name, err := image.DecodeConfig(bytes.NewReader(res))
+			assert.NoError(t, err)
+			assert.Equal(t, string(tt.wantFormat), name)
+			assert.Equal(t, tt.wantW, cfg.Width)
+			assert.Equal(t, tt.wantH, cfg.Height)
+		})
+	}
+}
+
+func TestTransform_GIF(t *testing.T) {
+	img := image.NewPaletted(image.Rect(0, 0, 40, 20), color.Palette{color.Black, color.White})
+	buf := &bytes.Buffer{}
+	assert.NoError(t, gif.EncodeAll(buf, &gif.GIF{Image: []*image.Paletted{img, img}, Delay: []int{10, 10}}))
+
+	// the first frame is encoded as PNG when the format is not specified
+	res, f, err := Transform(bytes.NewReader(buf.Bytes()), "a.gif", Options{Width: 20})
+	assert.NoError(t, err)
+	assert.Equal(t, FormatPNG, f)
+	cfg, name, err := image.DecodeConfig(bytes.NewReader(res))
+	assert.NoError(t, err)
+	assert.Equal(t, "png", name)
+	assert.Equal(t, 20, cfg.Width)
+	assert.Equal(t, 10, cfg.Height)
+}
+
+func TestTransform_Error(t *testing.T) {
+	_, _, err := Transform(strings.NewReader("not an image"), "a.png", Options{Width: 10})
+	assert.Equal(t, ErrInvalidImage, err)
+
+	_, _, err = Transform(bytes.NewReader(testPNG(t, 10, 10)), "a.tiff", Options{Width: 10})
+	assert.Equal(t, ErrUnsupportedFormat, err)
+
+	// the dimensions are checked before the image is decoded
+	_, _, err = Transform(bytes.NewReader(pngHeader(10000, 6000)), "a.png", Options{Width: 10})
+	assert.Equal(t, ErrImageTooLarge, err)
+}
+
+// pngHeader returns the signature and the IHDR chunk of a PNG image, which has the dimensions but no pixels.
+func pngHeader(w, h uint32) []byte {
+	ihdr := []byte("IHDR")
+	ihdr = binary.BigEndian.AppendUint32(ihdr, w)
+	ihdr = binary.BigEndian.AppendUint32(ihdr, h)
+	ihdr = append(ihdr, 8, 6, 0, 0, 0)
+
+	b := []byte("\x89PNG\r\n\x1a\n")
+	b = binary.BigEndian.AppendUint32(b, uint32(len(ihdr)-4))
+	b = append(b, ihdr...)
+	return binary.BigEndian.AppendUint32(b, crc32.ChecksumIEEE(ihdr))
+}
@@ -32,9 +32,41 @@ func NewAsset(a *asset.Asset, f *asset.File, all bool) *Asset {
 		File:                    ToAssetFile(f, all),
 		ArchiveExtractionStatus: ToAssetArchiveExtractionStatus(a.ArchiveExtractionStatus()),
 		Public:                  ai.Public,
+		Metadata:                NewAssetMetadata(a.Metadata()),
 	}
 }
 
+func NewAssetMetadata(m *asset.Metadata) *AssetMetadata {
+	if m.IsEmpty() {
+		return nil
+	}
+	res := &AssetMetadata{
+		Width:        m.Width,
+		Height:       m.Height,
+		CapturedAt:   m.CapturedAt,
+		FeatureCount: m.FeatureCount,
+		Headers:      lo.EmptyableToPtr(m.Headers),
+		RowCount:     m.RowCount,
+	}
+	if l := m.Location; l != nil {
+		res.Location = &AssetLocation{Lat: l.Lat, Lng: l.Lng}
+	}
+	if b := m.BBox; b != nil {
+		res.Bbox = &[]float64{b.MinLng, b.MinLat, b.MaxLng, b.MaxLat}
+	}
+	if g := m.GLTF; g != nil {
+		res.Gltf = &AssetGltfStats{
+			Scenes:     g.Scenes,
+			Nodes:      g.Nodes,
+			Meshes:     g.Meshes,
+			Materials:  g.Materials,
+			Textures:   g.Textures,
+			Animations: g.Animations,
+		}
+	}
+	return res
+}
+
 func ToAssetArchiveExtractionStatus(s *asset.ArchiveExtractionStatus) *AssetArchiveExtractionStatus {
 	if s == nil {
 		return nil
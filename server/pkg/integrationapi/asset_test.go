@@ -234,3 +234,24 @@ func TestToPreviewType(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAssetMetadata(t *testing.T) {
+	assert.Nil(t, NewAssetMetadata(nil))
+	assert.Nil(t, NewAssetMetadata(&asset.Metadata{}))
+
+	assert.Equal(t, &AssetMetadata{
+		Width:    lo.ToPtr(10),
+		Location: &AssetLocation{Lat: 35, Lng: 139},
+		Bbox:     &[]float64{1, 2, 3, 4},
+		Gltf:     &AssetGltfStats{Nodes: 2},
+		Headers:  &[]string{"a"},
+		RowCount: lo.ToPtr(3),
+	}, NewAssetMetadata(&asset.Metadata{
+		Width:    lo.ToPtr(10),
+		Location: &asset.Location{Lat: 35, Lng: 139},
+		BBox:     &asset.BBox{MinLng: 1, MinLat: 2, MaxLng: 3, MaxLat: 4},
+		GLTF:     &asset.GLTFStats{Nodes: 2},
+		Headers:  []string{"a"},
+		RowCount: lo.ToPtr(3),
+	}))
+}
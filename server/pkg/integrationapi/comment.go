@@ -28,3 +28,15 @@ func NewComment(c *thread.Comment) *Comment {
 		CreatedAt:  lo.ToPtr(c.CreatedAt()),
 	}
 }
+
+type ThreadComment struct {
+	ThreadID string   `json:"threadId"`
+	Comment  *Comment `json:"comment"`
+}
+
+func NewThreadComment(tc thread.ThreadComment) ThreadComment {
+	return ThreadComment{
+		ThreadID: tc.Thread.ID().String(),
+		Comment:  NewComment(tc.Comment),
+	}
+}
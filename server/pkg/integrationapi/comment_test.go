@@ -60,3 +60,13 @@ func TestNewComment(t *testing.T) {
 		})
 	}
 }
+
+func TestNewThreadComment(t *testing.T) {
+	c := thread.NewComment(thread.NewCommentID(), operator.OperatorFromUser(thread.NewUserID()), "test")
+	th := thread.New().NewID().Workspace(thread.NewWorkspaceID()).Comments([]*thread.Comment{c}).MustBuild()
+
+	assert.Equal(t, ThreadComment{
+		ThreadID: th.ID().String(),
+		Comment:  NewComment(c),
+	}, NewThreadComment(thread.ThreadComment{Thread: th, Comment: c}))
+}
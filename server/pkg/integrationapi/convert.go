@@ -6,6 +6,8 @@ import (
 	"github.com/reearth/reearth-cms/server/pkg/asset"
 	"github.com/reearth/reearth-cms/server/pkg/event"
 	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
 )
@@ -29,11 +31,16 @@ func New(obj any, v string) (res any, err error) {
 		res = NewVersionedItem(o, nil, nil, nil, nil, nil, nil)
 	case item.ItemModelSchema:
 		res = NewItemModelSchema(o, nil)
+	case *request.Request:
+		res = NewRequest(o)
+	case request.RequestComment:
+		res = NewRequestComment(o)
+	case thread.ThreadComment:
+		res = NewThreadComment(o)
 	// TODO: add later
 	// case *schema.Schema:
 	// case *project.Project:
 	// case *model.Model:
-	// case *thread.Thread:
 	// case *integration.Integration:
 	// case *user.Workspace:
 	// case *user.User:
@@ -4,6 +4,7 @@ import (
 	"github.com/oapi-codegen/runtime/types"
 	"github.com/reearth/reearth-cms/server/pkg/item"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/util"
 	"github.com/samber/lo"
@@ -37,10 +38,37 @@ func NewVersionedItem(ver item.Versioned, s *schema.Schema, assets *AssetContext
 	}
 }
 
+func toLocaleValues(f *item.Field, sf *schema.Field, assets *AssetContext) *map[string]any {
+	locales := f.Locales()
+	if !sf.Localized() || len(locales) == 0 {
+		return nil
+	}
+	res := make(map[string]any, len(locales))
+	for l, v := range locales {
+		res[l.String()] = ToValues(v, sf, assets)
+	}
+	return &res
+}
+
 func NewItem(i *item.Item, ss schema.List, assets *AssetContext) Item {
+	fs := newFields(i.Fields(), ss, assets)
+
+	return Item{
+		Id:             i.ID().Ref(),
+		ModelId:        i.Model().Ref().StringRef(),
+		Fields:         &fs,
+		MetadataItemId: i.MetadataItem(),
+		OriginalItemId: i.OriginalItem(),
+		IsMetadata:     lo.ToPtr(i.IsMetadata()),
+		CreatedAt:      lo.ToPtr(i.ID().Timestamp()),
+		UpdatedAt:      lo.ToPtr(i.Timestamp()),
+	}
+}
+
+func newFields(fields item.Fields, ss schema.List, assets *AssetContext) []Field {
 	var fs []Field
 	for _, s := range ss {
-		t := lo.FilterMap(i.Fields(), func(f *item.Field, _ int) (Field, bool) {
+		t := lo.FilterMap(fields, func(f *item.Field, _ int) (Field, bool) {
 			if s == nil {
 				return Field{}, false
 			}
@@ -50,24 +78,67 @@ func NewItem(i *item.Item, ss schema.List, assets *AssetContext) Item {
 			}
 
 			return Field{
-				Id:    f.FieldID().Ref(),
-				Type:  lo.ToPtr(ToValueType(f.Type())),
-				Value: lo.ToPtr(ToValues(f.Value(), sf, assets)),
-				Key:   util.ToPtrIfNotEmpty(sf.Key().String()),
-				Group: f.ItemGroup(),
+				Id:      f.FieldID().Ref(),
+				Type:    lo.ToPtr(ToValueType(f.Type())),
+				Value:   lo.ToPtr(ToValues(f.Value(), sf, assets)),
+				Key:     util.ToPtrIfNotEmpty(sf.Key().String()),
+				Group:   f.ItemGroup(),
+				Locales: toLocaleValues(f, sf, assets),
 			}, true
 		})
 		fs = append(fs, t...)
 	}
+	return fs
+}
 
-	return Item{
-		Id:             i.ID().Ref(),
-		ModelId:        i.Model().Ref().StringRef(),
-		Fields:         &fs,
-		MetadataItemId: i.MetadataItem(),
-		OriginalItemId: i.OriginalItem(),
-		IsMetadata:     lo.ToPtr(i.IsMetadata()),
-		CreatedAt:      lo.ToPtr(i.ID().Timestamp()),
-		UpdatedAt:      lo.ToPtr(i.Timestamp()),
+func NewItemDiff(d item.Diff, sp *schema.Package) ItemDiff {
+	res := ItemDiff{
+		Fields:          lo.ToPtr(ToItemFieldChanges(d.Fields, sp)),
+		MetadataChanged: lo.ToPtr(d.MetadataItem != nil),
+		MetadataFields:  lo.ToPtr(ToItemFieldChanges(d.MetadataFields, sp)),
+	}
+	if d.MetadataItem != nil {
+		res.PreviousMetadataId = d.MetadataItem.Previous
+		res.CurrentMetadataId = d.MetadataItem.Current
 	}
+	return res
+}
+
+func ToItemFieldChanges(changes item.FieldChanges, sp *schema.Package) []ItemFieldChange {
+	return lo.Map(changes, func(c item.FieldChange, _ int) ItemFieldChange {
+		sf := sp.Field(c.ID)
+		values := func(m *value.Multiple) any {
+			// values of the fields which were deleted from the schema are returned as lists
+			if sf == nil {
+				return m.Interface()
+			}
+			return ToValues(m, sf, nil)
+		}
+		list := func(vs []*value.Value) *[]any {
+			return lo.ToPtr(lo.Map(vs, func(v *value.Value, _ int) any {
+				if sf == nil {
+					return v.Interface()
+				}
+				return ToValue(v, sf, nil)
+			}))
+		}
+
+		res := ItemFieldChange{
+			Id:            c.ID.Ref(),
+			Group:         c.Group,
+			Type:          lo.ToPtr(string(c.Type)),
+			AddedValues:   list(c.AddedValues()),
+			RemovedValues: list(c.RemovedValues()),
+		}
+		if sf != nil {
+			res.Key = util.ToPtrIfNotEmpty(sf.Key().String())
+		}
+		if c.PreviousValue != nil {
+			res.PreviousValue = lo.ToPtr(values(c.PreviousValue))
+		}
+		if c.CurrentValue != nil {
+			res.CurrentValue = lo.ToPtr(values(c.CurrentValue))
+		}
+		return res
+	})
 }
@@ -0,0 +1,44 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+func NewItemTemplate(t *template.Template, sp *schema.Package) *ItemTemplate {
+	if t == nil {
+		return nil
+	}
+
+	var fields, metadataFields []Field
+	if sp != nil {
+		fields = newFields(t.Fields(), append(sp.GroupSchemas(), sp.Schema()), nil)
+		if sp.MetaSchema() != nil {
+			metadataFields = newFields(t.MetadataFields(), schema.List{sp.MetaSchema()}, nil)
+		}
+	}
+
+	return &ItemTemplate{
+		Id:             t.ID(),
+		ModelId:        t.Model(),
+		ProjectId:      t.Project(),
+		Name:           t.Name(),
+		Description:    util.ToPtrIfNotEmpty(t.Description()),
+		Fields:         lo.ToPtr(lo.Ternary(fields == nil, []Field{}, fields)),
+		MetadataFields: lo.ToPtr(lo.Ternary(metadataFields == nil, []Field{}, metadataFields)),
+		UserId:         t.User(),
+		IntegrationId:  t.Integration(),
+		CreatedAt:      t.CreatedAt(),
+		UpdatedAt:      t.UpdatedAt(),
+	}
+}
+
+func NewItemTemplates(l template.List, sp *schema.Package) []ItemTemplate {
+	res := make([]ItemTemplate, 0, len(l))
+	for _, t := range l {
+		res = append(res, *NewItemTemplate(t, sp))
+	}
+	return res
+}
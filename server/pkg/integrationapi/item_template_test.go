@@ -0,0 +1,61 @@
+package integrationapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/item/template"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItemTemplate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pid := id.NewProjectID()
+	sf := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild()
+	msf := schema.NewField(schema.NewBool().TypeProperty()).NewID().Key(id.NewKey("flag")).MustBuild()
+	s := schema.New().NewID().Project(pid).Workspace(accountdomain.NewWorkspaceID()).Fields([]*schema.Field{sf}).MustBuild()
+	ms := schema.New().NewID().Project(pid).Workspace(accountdomain.NewWorkspaceID()).Fields([]*schema.Field{msf}).MustBuild()
+	sp := schema.NewPackage(s, ms, nil, nil)
+
+	uid := accountdomain.NewUserID()
+	tp := template.New().NewID().Project(pid).Model(id.NewModelID()).Name("tpl").
+		Fields(item.Fields{item.NewField(sf.ID(), value.TypeText.Value("a").AsMultiple(), nil)}).
+		MetadataFields(item.Fields{item.NewField(msf.ID(), value.TypeBool.Value(true).AsMultiple(), nil)}).
+		User(&uid).UpdatedAt(now).MustBuild()
+
+	expected := ItemTemplate{
+		Id:        tp.ID(),
+		ModelId:   tp.Model(),
+		ProjectId: pid,
+		Name:      "tpl",
+		Fields: &[]Field{{
+			Id:    sf.ID().Ref(),
+			Type:  lo.ToPtr(ValueTypeText),
+			Value: lo.ToPtr(any("a")),
+			Key:   lo.ToPtr("title"),
+		}},
+		MetadataFields: &[]Field{{
+			Id:    msf.ID().Ref(),
+			Type:  lo.ToPtr(ValueTypeBool),
+			Value: lo.ToPtr(any(true)),
+			Key:   lo.ToPtr("flag"),
+		}},
+		UserId:    &uid,
+		CreatedAt: tp.CreatedAt(),
+		UpdatedAt: now,
+	}
+	assert.Equal(t, &expected, NewItemTemplate(tp, sp))
+	assert.Equal(t, []ItemTemplate{expected}, NewItemTemplates(template.List{tp}, sp))
+	assert.Nil(t, NewItemTemplate(nil, sp))
+
+	// fields are omitted without the schemas
+	res := NewItemTemplate(tp, nil)
+	assert.Equal(t, &[]Field{}, res.Fields)
+	assert.Equal(t, &[]Field{}, res.MetadataFields)
+}
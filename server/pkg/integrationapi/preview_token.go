@@ -0,0 +1,30 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+)
+
+func NewPreviewToken(t *preview.Token) *PreviewToken {
+	if t == nil {
+		return nil
+	}
+
+	return &PreviewToken{
+		Id:            t.ID(),
+		ProjectId:     t.Project(),
+		RequestId:     t.Request(),
+		UserId:        t.User(),
+		IntegrationId: t.Integration(),
+		CreatedAt:     t.CreatedAt(),
+		ExpiresAt:     t.ExpiresAt(),
+		RevokedAt:     t.RevokedAt(),
+	}
+}
+
+func NewPreviewTokens(l preview.List) []PreviewToken {
+	res := make([]PreviewToken, 0, len(l))
+	for _, t := range l {
+		res = append(res, *NewPreviewToken(t))
+	}
+	return res
+}
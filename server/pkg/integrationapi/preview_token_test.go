@@ -0,0 +1,30 @@
+package integrationapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/preview"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPreviewToken(t *testing.T) {
+	now := time.Now().Truncate(time.Millisecond)
+	iid := id.NewIntegrationID()
+	rid := id.NewRequestID()
+	pt := preview.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Request(&rid).Integration(&iid).ExpiresAt(now).MustBuild()
+
+	assert.Equal(t, &PreviewToken{
+		Id:            pt.ID(),
+		ProjectId:     pt.Project(),
+		RequestId:     &rid,
+		IntegrationId: &iid,
+		CreatedAt:     pt.CreatedAt(),
+		ExpiresAt:     now,
+	}, NewPreviewToken(pt))
+	assert.Nil(t, NewPreviewToken(nil))
+	assert.Equal(t, []PreviewToken{*NewPreviewToken(pt)}, NewPreviewTokens(preview.List{pt}))
+}
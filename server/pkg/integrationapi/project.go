@@ -12,6 +12,7 @@ func NewProject(p *project.Project) Project {
 		publication.Scope = ToProjectPublicationScope(p.Publication().Scope())
 		publication.AssetPublic = lo.ToPtr(p.Publication().AssetPublic())
 		publication.Token = lo.ToPtr(p.Publication().Token())
+		publication.CacheMaxAge = lo.ToPtr(p.Publication().CacheMaxAge())
 	}
 
 	var requestRoles *[]ProjectRequestRole = nil
@@ -0,0 +1,88 @@
+package integrationapi
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+)
+
+type Request struct {
+	ID          string          `json:"id"`
+	WorkspaceID string          `json:"workspaceId"`
+	ProjectID   string          `json:"projectId"`
+	ThreadID    *string         `json:"threadId,omitempty"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	State       string          `json:"state"`
+	CreatedByID string          `json:"createdById"`
+	ReviewerIDs []string        `json:"reviewerIds"`
+	Reviews     []RequestReview `json:"reviews"`
+	Items       []RequestItem   `json:"items"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+	ApprovedAt  *time.Time      `json:"approvedAt,omitempty"`
+	ClosedAt    *time.Time      `json:"closedAt,omitempty"`
+}
+
+type RequestItem struct {
+	ItemID  string  `json:"itemId"`
+	Version *string `json:"version,omitempty"`
+	Ref     *string `json:"ref,omitempty"`
+}
+
+type RequestReview struct {
+	ReviewerID string    `json:"reviewerId"`
+	Decision   string    `json:"decision"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type RequestComment struct {
+	Request Request  `json:"request"`
+	Comment *Comment `json:"comment"`
+}
+
+func NewRequest(r *request.Request) Request {
+	return Request{
+		ID:          r.ID().String(),
+		WorkspaceID: r.Workspace().String(),
+		ProjectID:   r.Project().String(),
+		ThreadID:    r.Thread().StringRef(),
+		Title:       r.Title(),
+		Description: r.Description(),
+		State:       r.State().String(),
+		CreatedByID: r.CreatedBy().String(),
+		ReviewerIDs: lo.Map(r.Reviewers(), func(u accountdomain.UserID, _ int) string { return u.String() }),
+		Reviews: lo.Map(r.Reviews(), func(rv *request.Review, _ int) RequestReview {
+			return RequestReview{
+				ReviewerID: rv.Reviewer().String(),
+				Decision:   rv.Decision().String(),
+				CreatedAt:  rv.CreatedAt(),
+			}
+		}),
+		Items: lo.Map(r.Items(), func(itm *request.Item, _ int) RequestItem {
+			return version.MatchVersionOrRef(
+				itm.Pointer(),
+				func(v version.Version) RequestItem {
+					return RequestItem{ItemID: itm.Item().String(), Version: lo.ToPtr(v.String())}
+				},
+				func(r version.Ref) RequestItem {
+					return RequestItem{ItemID: itm.Item().String(), Ref: lo.ToPtr(r.String())}
+				},
+			)
+		}),
+		CreatedAt:  r.CreatedAt(),
+		UpdatedAt:  r.UpdatedAt(),
+		ApprovedAt: r.ApprovedAt(),
+		ClosedAt:   r.ClosedAt(),
+	}
+}
+
+func NewRequestComment(rc request.RequestComment) RequestComment {
+	return RequestComment{
+		Request: NewRequest(rc.Request),
+		Comment: NewComment(rc.Comment),
+	}
+}
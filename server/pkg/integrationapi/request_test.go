@@ -0,0 +1,75 @@
+package integrationapi
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequest(t *testing.T) {
+	v := version.New()
+	itm, _ := request.NewItemWithVersion(id.NewItemID(), v.OrRef())
+	uid := accountdomain.NewUserID()
+	rv := request.NewReview(uid, request.DecisionChangesRequested, util.Now())
+	req := request.New().
+		NewID().
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).
+		CreatedBy(accountdomain.NewUserID()).
+		Thread(id.NewThreadID().Ref()).
+		Reviewers(accountdomain.UserIDList{uid}).
+		Reviews([]*request.Review{rv}).
+		Items(request.ItemList{itm}).
+		Title("foo").
+		Description("bar").
+		State(request.StateWaiting).
+		MustBuild()
+
+	assert.Equal(t, Request{
+		ID:          req.ID().String(),
+		WorkspaceID: req.Workspace().String(),
+		ProjectID:   req.Project().String(),
+		ThreadID:    req.Thread().StringRef(),
+		Title:       "foo",
+		Description: "bar",
+		State:       "waiting",
+		CreatedByID: req.CreatedBy().String(),
+		ReviewerIDs: []string{uid.String()},
+		Reviews: []RequestReview{{
+			ReviewerID: uid.String(),
+			Decision:   "changes_requested",
+			CreatedAt:  rv.CreatedAt(),
+		}},
+		Items: []RequestItem{{
+			ItemID:  itm.Item().String(),
+			Version: lo.ToPtr(v.String()),
+		}},
+		CreatedAt: req.CreatedAt(),
+		UpdatedAt: req.UpdatedAt(),
+	}, NewRequest(req))
+}
+
+func TestNewRequestComment(t *testing.T) {
+	itm, _ := request.NewItemWithVersion(id.NewItemID(), version.New().OrRef())
+	req := request.New().
+		NewID().
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).
+		CreatedBy(accountdomain.NewUserID()).
+		Items(request.ItemList{itm}).
+		Title("foo").
+		MustBuild()
+	c := thread.NewComment(thread.NewCommentID(), operator.OperatorFromUser(accountdomain.NewUserID()), "test")
+
+	res := NewRequestComment(request.RequestComment{Request: req, Comment: c})
+	assert.Equal(t, NewRequest(req), res.Request)
+	assert.Equal(t, NewComment(c), res.Comment)
+}
@@ -0,0 +1,31 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+)
+
+func NewItemSchedule(s *schedule.Schedule) *ItemSchedule {
+	if s == nil {
+		return nil
+	}
+	return &ItemSchedule{
+		Id:            s.ID(),
+		ItemId:        s.Item(),
+		ModelId:       s.Model(),
+		ProjectId:     s.Project(),
+		PublishAt:     s.PublishAt(),
+		UnpublishAt:   s.UnpublishAt(),
+		UserId:        s.User(),
+		IntegrationId: s.Integration(),
+		CreatedAt:     s.CreatedAt(),
+		UpdatedAt:     s.UpdatedAt(),
+	}
+}
+
+func NewItemSchedules(l schedule.List) []ItemSchedule {
+	res := make([]ItemSchedule, 0, len(l))
+	for _, s := range l {
+		res = append(res, *NewItemSchedule(s))
+	}
+	return res
+}
@@ -0,0 +1,33 @@
+package integrationapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schedule"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItemSchedule(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	iid := id.NewIntegrationID()
+	s := schedule.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Model(id.NewModelID()).Item(id.NewItemID()).
+		UnpublishAt(lo.ToPtr(now)).Integration(&iid).UpdatedAt(now).MustBuild()
+
+	expected := ItemSchedule{
+		Id:            s.ID(),
+		ItemId:        s.Item(),
+		ModelId:       s.Model(),
+		ProjectId:     s.Project(),
+		UnpublishAt:   lo.ToPtr(now),
+		IntegrationId: &iid,
+		CreatedAt:     s.CreatedAt(),
+		UpdatedAt:     now,
+	}
+	assert.Equal(t, &expected, NewItemSchedule(s))
+	assert.Nil(t, NewItemSchedule(nil))
+	assert.Equal(t, []ItemSchedule{expected}, NewItemSchedules(schedule.List{s}))
+}
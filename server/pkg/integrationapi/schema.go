@@ -74,10 +74,12 @@ func NewSchema(i *schema.Schema) Schema {
 	}
 	fs := lo.Map(i.Fields(), func(f *schema.Field, _ int) SchemaField {
 		return SchemaField{
-			Id:       f.ID().Ref(),
-			Type:     lo.ToPtr(ValueType(f.Type())),
-			Key:      lo.ToPtr(f.Key().String()),
-			Required: lo.ToPtr(f.Required()),
+			Id:         f.ID().Ref(),
+			Type:       lo.ToPtr(ValueType(f.Type())),
+			Key:        lo.ToPtr(f.Key().String()),
+			Required:   lo.ToPtr(f.Required()),
+			Localized:  lo.ToPtr(f.Localized()),
+			Expression: lo.EmptyableToPtr(f.Expression().String()),
 		}
 	})
 	var tf *id.FieldID
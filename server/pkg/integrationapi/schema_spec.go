@@ -0,0 +1,96 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/schemaspec"
+	"github.com/samber/lo"
+)
+
+func (s SchemaSpec) Into() *schemaspec.Spec {
+	return &schemaspec.Spec{
+		Models: lo.Map(lo.FromPtr(s.Models), func(m SchemaSpecModel, _ int) schemaspec.Model {
+			return schemaspec.Model{
+				Key:         m.Key,
+				Name:        lo.FromPtr(m.Name),
+				Description: lo.FromPtr(m.Description),
+				TitleField:  lo.FromPtr(m.TitleField),
+				Fields:      intoSchemaSpecFields(m.Fields),
+
+				ValidationRules: intoSchemaSpecValidationRules(m.ValidationRules),
+			}
+		}),
+		Groups: lo.Map(lo.FromPtr(s.Groups), func(g SchemaSpecGroup, _ int) schemaspec.Group {
+			return schemaspec.Group{
+				Key:         g.Key,
+				Name:        lo.FromPtr(g.Name),
+				Description: lo.FromPtr(g.Description),
+				Fields:      intoSchemaSpecFields(g.Fields),
+
+				ValidationRules: intoSchemaSpecValidationRules(g.ValidationRules),
+			}
+		}),
+	}
+}
+
+func intoSchemaSpecFields(fields *[]SchemaSpecField) []schemaspec.Field {
+	return lo.Map(lo.FromPtr(fields), func(f SchemaSpecField, _ int) schemaspec.Field {
+		res := schemaspec.Field{
+			Key:         f.Key,
+			Type:        FromValueType(&f.Type),
+			Name:        lo.FromPtr(f.Name),
+			Description: lo.FromPtr(f.Description),
+			Multiple:    lo.FromPtr(f.Multiple),
+			Required:    lo.FromPtr(f.Required),
+			Unique:      lo.FromPtr(f.Unique),
+			Localized:   lo.FromPtr(f.Localized),
+			MaxLength:   f.MaxLength,
+			Min:         f.Min,
+			Max:         f.Max,
+			Options:     lo.FromPtr(f.Options),
+			Group:       lo.FromPtr(f.Group),
+			Tags: lo.Map(lo.FromPtr(f.Tags), func(t SchemaSpecTag, _ int) schemaspec.Tag {
+				return schemaspec.Tag{Name: t.Name, Color: lo.FromPtr(t.Color)}
+			}),
+			GeometryTypes:   lo.FromPtr(f.GeometryTypes),
+			ValidationRules: intoSchemaSpecValidationRules(f.ValidationRules),
+			Expression:      lo.FromPtr(f.Expression),
+		}
+		if f.Reference != nil {
+			res.Reference = &schemaspec.Reference{
+				Model:              f.Reference.Model,
+				CorrespondingField: lo.FromPtr(f.Reference.CorrespondingField),
+			}
+		}
+		return res
+	})
+}
+
+func intoSchemaSpecValidationRules(rules *[]SchemaSpecValidationRule) []schemaspec.ValidationRule {
+	return lo.Map(lo.FromPtr(rules), func(r SchemaSpecValidationRule, _ int) schemaspec.ValidationRule {
+		return schemaspec.ValidationRule{
+			Type:       schema.ValidationRuleType(r.Type),
+			Pattern:    lo.FromPtr(r.Pattern),
+			Count:      lo.FromPtr(r.Count),
+			Field:      lo.FromPtr(r.Field),
+			Operator:   schema.CompareOperator(lo.FromPtr(r.Operator)),
+			OtherField: lo.FromPtr(r.OtherField),
+			Message:    lo.FromPtr(r.Message),
+		}
+	})
+}
+
+func NewSchemaPlan(p schemaspec.Plan) SchemaPlan {
+	return SchemaPlan{
+		Changes: lo.ToPtr(lo.Map(p, func(c schemaspec.Change, _ int) SchemaChange {
+			return SchemaChange{
+				Type:        lo.ToPtr(SchemaChangeType(c.Type)),
+				Model:       lo.EmptyableToPtr(c.Model),
+				Group:       lo.EmptyableToPtr(c.Group),
+				Field:       lo.EmptyableToPtr(c.Field),
+				Attributes:  lo.EmptyableToPtr(c.Attributes),
+				Destructive: lo.ToPtr(c.Destructive),
+			}
+		})),
+		Destructive: lo.ToPtr(p.HasDestructiveChanges()),
+	}
+}
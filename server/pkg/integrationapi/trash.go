@@ -0,0 +1,32 @@
+package integrationapi
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+)
+
+func NewTrashEntry(e *trash.Entry) *TrashEntry {
+	if e == nil {
+		return nil
+	}
+
+	return &TrashEntry{
+		Id:            e.ID(),
+		ProjectId:     e.Project(),
+		Type:          TrashEntryType(e.Type()),
+		ItemId:        e.Item(),
+		AssetId:       e.Asset(),
+		ModelId:       e.Model(),
+		Name:          e.Name(),
+		UserId:        e.User(),
+		IntegrationId: e.Integration(),
+		DeletedAt:     e.DeletedAt(),
+	}
+}
+
+func NewTrashEntries(l trash.List) []TrashEntry {
+	res := make([]TrashEntry, 0, len(l))
+	for _, e := range l {
+		res = append(res, *NewTrashEntry(e))
+	}
+	return res
+}
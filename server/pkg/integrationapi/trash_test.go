@@ -0,0 +1,31 @@
+package integrationapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTrashEntry(t *testing.T) {
+	now := time.Now()
+	iid := id.NewIntegrationID()
+	aid := id.NewAssetID()
+	e := trash.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).
+		Asset(aid).Name("a.png").Integration(&iid).DeletedAt(now).MustBuild()
+
+	assert.Equal(t, &TrashEntry{
+		Id:            e.ID(),
+		ProjectId:     e.Project(),
+		Type:          TrashEntryTypeAsset,
+		AssetId:       &aid,
+		Name:          "a.png",
+		IntegrationId: &iid,
+		DeletedAt:     now,
+	}, NewTrashEntry(e))
+	assert.Nil(t, NewTrashEntry(nil))
+	assert.Equal(t, []TrashEntry{*NewTrashEntry(e)}, NewTrashEntries(trash.List{e}))
+}
@@ -169,6 +169,39 @@ const (
 	RefOrVersionRefPublic RefOrVersionRef = "public"
 )
 
+// Defines values for SchemaChangeType.
+const (
+	SchemaChangeTypeCreate  SchemaChangeType = "create"
+	SchemaChangeTypeDelete  SchemaChangeType = "delete"
+	SchemaChangeTypeReplace SchemaChangeType = "replace"
+	SchemaChangeTypeUpdate  SchemaChangeType = "update"
+)
+
+// Defines values for SchemaSpecValidationRuleOperator.
+const (
+	Eq  SchemaSpecValidationRuleOperator = "eq"
+	Gt  SchemaSpecValidationRuleOperator = "gt"
+	Gte SchemaSpecValidationRuleOperator = "gte"
+	Lt  SchemaSpecValidationRuleOperator = "lt"
+	Lte SchemaSpecValidationRuleOperator = "lte"
+	Ne  SchemaSpecValidationRuleOperator = "ne"
+)
+
+// Defines values for SchemaSpecValidationRuleType.
+const (
+	Compare  SchemaSpecValidationRuleType = "compare"
+	MaxCount SchemaSpecValidationRuleType = "maxCount"
+	MinCount SchemaSpecValidationRuleType = "minCount"
+	Pattern  SchemaSpecValidationRuleType = "pattern"
+)
+
+// Defines values for TrashEntryType.
+const (
+	TrashEntryTypeAsset TrashEntryType = "asset"
+	TrashEntryTypeItem  TrashEntryType = "item"
+	TrashEntryTypeModel TrashEntryType = "model"
+)
+
 // Defines values for ValueType.
 const (
 	ValueTypeAsset          ValueType = "asset"
@@ -208,6 +241,20 @@ const (
 	SortParamUpdatedAt SortParam = "updatedAt"
 )
 
+// Defines values for AssetTransformURLParamsFit.
+const (
+	Contain AssetTransformURLParamsFit = "contain"
+	Cover   AssetTransformURLParamsFit = "cover"
+	Fill    AssetTransformURLParamsFit = "fill"
+)
+
+// Defines values for AssetTransformURLParamsFormat.
+const (
+	Jpeg AssetTransformURLParamsFormat = "jpeg"
+	Png  AssetTransformURLParamsFormat = "png"
+	Webp AssetTransformURLParamsFormat = "webp"
+)
+
 // Defines values for ItemGetParamsRef.
 const (
 	ItemGetParamsRefLatest ItemGetParamsRef = "latest"
@@ -235,9 +282,9 @@ const (
 
 // Defines values for ModelImportMultipartBodyStrategy.
 const (
-	ModelImportMultipartBodyStrategyInsert ModelImportMultipartBodyStrategy = "insert"
-	ModelImportMultipartBodyStrategyUpdate ModelImportMultipartBodyStrategy = "update"
-	ModelImportMultipartBodyStrategyUpsert ModelImportMultipartBodyStrategy = "upsert"
+	Insert ModelImportMultipartBodyStrategy = "insert"
+	Update ModelImportMultipartBodyStrategy = "update"
+	Upsert ModelImportMultipartBodyStrategy = "upsert"
 )
 
 // Defines values for ItemFilterParamsSort.
@@ -384,13 +431,16 @@ type Asset struct {
 	CreatedAt               time.Time                     `json:"createdAt"`
 	File                    *File                         `json:"file,omitempty"`
 	Id                      id.AssetID                    `json:"id"`
-	Name                    *string                       `json:"name,omitempty"`
-	PreviewType             *AssetPreviewType             `json:"previewType,omitempty"`
-	ProjectId               id.ProjectID                  `json:"projectId"`
-	Public                  bool                          `json:"public"`
-	TotalSize               *float32                      `json:"totalSize,omitempty"`
-	UpdatedAt               time.Time                     `json:"updatedAt"`
-	Url                     string                        `json:"url"`
+
+	// Metadata Metadata extracted from the file of the asset when it is uploaded. The metadata of the files of an archive is merged after it is decompressed. Only the fields for the type of the file are set.
+	Metadata    *AssetMetadata    `json:"metadata,omitempty"`
+	Name        *string           `json:"name,omitempty"`
+	PreviewType *AssetPreviewType `json:"previewType,omitempty"`
+	ProjectId   id.ProjectID      `json:"projectId"`
+	Public      bool              `json:"public"`
+	TotalSize   *float32          `json:"totalSize,omitempty"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+	Url         string            `json:"url"`
 }
 
 // AssetArchiveExtractionStatus defines model for Asset.ArchiveExtractionStatus.
@@ -402,6 +452,36 @@ type AssetPreviewType string
 // AssetEmbedding defines model for assetEmbedding.
 type AssetEmbedding string
 
+// AssetGltfStats defines model for assetGltfStats.
+type AssetGltfStats struct {
+	Animations int `json:"animations"`
+	Materials  int `json:"materials"`
+	Meshes     int `json:"meshes"`
+	Nodes      int `json:"nodes"`
+	Scenes     int `json:"scenes"`
+	Textures   int `json:"textures"`
+}
+
+// AssetLocation defines model for assetLocation.
+type AssetLocation struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// AssetMetadata Metadata extracted from the file of the asset when it is uploaded. The metadata of the files of an archive is merged after it is decompressed. Only the fields for the type of the file are set.
+type AssetMetadata struct {
+	// Bbox minLng, minLat, maxLng and maxLat
+	Bbox         *[]float64      `json:"bbox,omitempty"`
+	CapturedAt   *time.Time      `json:"capturedAt,omitempty"`
+	FeatureCount *int            `json:"featureCount,omitempty"`
+	Gltf         *AssetGltfStats `json:"gltf,omitempty"`
+	Headers      *[]string       `json:"headers,omitempty"`
+	Height       *int            `json:"height,omitempty"`
+	Location     *AssetLocation  `json:"location,omitempty"`
+	RowCount     *int            `json:"rowCount,omitempty"`
+	Width        *int            `json:"width,omitempty"`
+}
+
 // Comment defines model for comment.
 type Comment struct {
 	AuthorId   *any               `json:"authorId,omitempty"`
@@ -480,8 +560,14 @@ type Field struct {
 	Group *id.ItemGroupID `json:"group,omitempty"`
 	Id    *id.FieldID     `json:"id,omitempty"`
 	Key   *string         `json:"key,omitempty"`
-	Type  *ValueType      `json:"type,omitempty"`
-	Value *interface{}    `json:"value,omitempty"`
+
+	// Locale Locale of the value of a localized field. The value of the default locale is set if it is omitted.
+	Locale *string `json:"locale,omitempty"`
+
+	// Locales Values of a localized field for the locales other than the default locale.
+	Locales *map[string]interface{} `json:"locales,omitempty"`
+	Type    *ValueType              `json:"type,omitempty"`
+	Value   *interface{}            `json:"value,omitempty"`
 }
 
 // FieldSelector defines model for fieldSelector.
@@ -526,6 +612,81 @@ type Item struct {
 	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
 }
 
+// ItemBatchResult defines model for itemBatchResult.
+type ItemBatchResult struct {
+	Failed    *int                    `json:"failed,omitempty"`
+	Results   *[]ItemBatchResultEntry `json:"results,omitempty"`
+	Succeeded *int                    `json:"succeeded,omitempty"`
+}
+
+// ItemBatchResultEntry defines model for itemBatchResultEntry.
+type ItemBatchResultEntry struct {
+	Error *string        `json:"error,omitempty"`
+	Id    *id.ItemID     `json:"id,omitempty"`
+	Index *int           `json:"index,omitempty"`
+	Item  *VersionedItem `json:"item,omitempty"`
+}
+
+// ItemDiff defines model for itemDiff.
+type ItemDiff struct {
+	CurrentMetadataId  *id.ItemID         `json:"currentMetadataId,omitempty"`
+	Fields             *[]ItemFieldChange `json:"fields,omitempty"`
+	MetadataChanged    *bool              `json:"metadataChanged,omitempty"`
+	MetadataFields     *[]ItemFieldChange `json:"metadataFields,omitempty"`
+	PreviousMetadataId *id.ItemID         `json:"previousMetadataId,omitempty"`
+}
+
+// ItemFieldChange defines model for itemFieldChange.
+type ItemFieldChange struct {
+	AddedValues   *[]interface{}  `json:"addedValues,omitempty"`
+	CurrentValue  *interface{}    `json:"currentValue,omitempty"`
+	Group         *id.ItemGroupID `json:"group,omitempty"`
+	Id            *id.FieldID     `json:"id,omitempty"`
+	Key           *string         `json:"key,omitempty"`
+	PreviousValue *interface{}    `json:"previousValue,omitempty"`
+	RemovedValues *[]interface{}  `json:"removedValues,omitempty"`
+
+	// Type One of add, update and delete
+	Type *string `json:"type,omitempty"`
+}
+
+// ItemRestoreResult defines model for itemRestoreResult.
+type ItemRestoreResult struct {
+	Changes           *[]ItemFieldChange `json:"changes,omitempty"`
+	Item              *VersionedItem     `json:"item,omitempty"`
+	RemovedFields     *[]id.FieldID      `json:"removedFields,omitempty"`
+	TypeChangedFields *[]id.FieldID      `json:"typeChangedFields,omitempty"`
+}
+
+// ItemSchedule defines model for itemSchedule.
+type ItemSchedule struct {
+	CreatedAt     time.Time             `json:"createdAt"`
+	Id            id.ScheduleID         `json:"id"`
+	IntegrationId *id.IntegrationID     `json:"integrationId,omitempty"`
+	ItemId        id.ItemID             `json:"itemId"`
+	ModelId       id.ModelID            `json:"modelId"`
+	ProjectId     id.ProjectID          `json:"projectId"`
+	PublishAt     *time.Time            `json:"publishAt,omitempty"`
+	UnpublishAt   *time.Time            `json:"unpublishAt,omitempty"`
+	UpdatedAt     time.Time             `json:"updatedAt"`
+	UserId        *accountdomain.UserID `json:"userId,omitempty"`
+}
+
+// ItemTemplate defines model for itemTemplate.
+type ItemTemplate struct {
+	CreatedAt      time.Time             `json:"createdAt"`
+	Description    *string               `json:"description,omitempty"`
+	Fields         *[]Field              `json:"fields,omitempty"`
+	Id             id.ItemTemplateID     `json:"id"`
+	IntegrationId  *id.IntegrationID     `json:"integrationId,omitempty"`
+	MetadataFields *[]Field              `json:"metadataFields,omitempty"`
+	ModelId        id.ModelID            `json:"modelId"`
+	Name           string                `json:"name"`
+	ProjectId      id.ProjectID          `json:"projectId"`
+	UpdatedAt      time.Time             `json:"updatedAt"`
+	UserId         *accountdomain.UserID `json:"userId,omitempty"`
+}
+
 // Model defines model for model.
 type Model struct {
 	CreatedAt        *time.Time    `json:"createdAt,omitempty"`
@@ -543,6 +704,18 @@ type Model struct {
 	UpdatedAt        *time.Time    `json:"updatedAt,omitempty"`
 }
 
+// PreviewToken defines model for previewToken.
+type PreviewToken struct {
+	CreatedAt     time.Time             `json:"createdAt"`
+	ExpiresAt     time.Time             `json:"expiresAt"`
+	Id            id.PreviewTokenID     `json:"id"`
+	IntegrationId *id.IntegrationID     `json:"integrationId,omitempty"`
+	ProjectId     id.ProjectID          `json:"projectId"`
+	RequestId     *id.RequestID         `json:"requestId,omitempty"`
+	RevokedAt     *time.Time            `json:"revokedAt,omitempty"`
+	UserId        *accountdomain.UserID `json:"userId,omitempty"`
+}
+
 // Project defines model for project.
 type Project struct {
 	Alias        string                    `json:"alias"`
@@ -559,6 +732,7 @@ type Project struct {
 // ProjectPublication defines model for projectPublication.
 type ProjectPublication struct {
 	AssetPublic *bool                    `json:"assetPublic,omitempty"`
+	CacheMaxAge *int                     `json:"cacheMaxAge,omitempty"`
 	Scope       *ProjectPublicationScope `json:"scope,omitempty"`
 	Token       *string                  `json:"token,omitempty"`
 }
@@ -587,13 +761,28 @@ type Schema struct {
 	TitleField *id.FieldID    `json:"titleField,omitempty"`
 }
 
+// SchemaChange defines model for schemaChange.
+type SchemaChange struct {
+	Attributes  *[]string         `json:"attributes,omitempty"`
+	Destructive *bool             `json:"destructive,omitempty"`
+	Field       *string           `json:"field,omitempty"`
+	Group       *string           `json:"group,omitempty"`
+	Model       *string           `json:"model,omitempty"`
+	Type        *SchemaChangeType `json:"type,omitempty"`
+}
+
+// SchemaChangeType defines model for SchemaChange.Type.
+type SchemaChangeType string
+
 // SchemaField defines model for schemaField.
 type SchemaField struct {
-	Id       *id.FieldID `json:"id,omitempty"`
-	Key      *string     `json:"key,omitempty"`
-	Multiple *bool       `json:"multiple,omitempty"`
-	Required *bool       `json:"required,omitempty"`
-	Type     *ValueType  `json:"type,omitempty"`
+	Expression *string     `json:"expression,omitempty"`
+	Id         *id.FieldID `json:"id,omitempty"`
+	Key        *string     `json:"key,omitempty"`
+	Localized  *bool       `json:"localized,omitempty"`
+	Multiple   *bool       `json:"multiple,omitempty"`
+	Required   *bool       `json:"required,omitempty"`
+	Type       *ValueType  `json:"type,omitempty"`
 }
 
 // SchemaJSON defines model for schemaJSON.
@@ -618,6 +807,111 @@ type SchemaJSONProperties struct {
 	Type        string      `json:"type"`
 }
 
+// SchemaPlan defines model for schemaPlan.
+type SchemaPlan struct {
+	Changes *[]SchemaChange `json:"changes,omitempty"`
+
+	// Destructive True when some of the changes can lose the contents of the items
+	Destructive *bool `json:"destructive,omitempty"`
+}
+
+// SchemaSpec defines model for schemaSpec.
+type SchemaSpec struct {
+	Groups *[]SchemaSpecGroup `json:"groups,omitempty"`
+	Models *[]SchemaSpecModel `json:"models,omitempty"`
+}
+
+// SchemaSpecField defines model for schemaSpecField.
+type SchemaSpecField struct {
+	Description *string `json:"description,omitempty"`
+
+	// Expression The expression which computes the value from the other fields referred to by their keys
+	Expression    *string   `json:"expression,omitempty"`
+	GeometryTypes *[]string `json:"geometryTypes,omitempty"`
+
+	// Group The key of the group of group fields
+	Group     *string  `json:"group,omitempty"`
+	Key       string   `json:"key"`
+	Localized *bool    `json:"localized,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Multiple  *bool    `json:"multiple,omitempty"`
+	Name      *string  `json:"name,omitempty"`
+
+	// Options The options of select fields
+	Options   *[]string            `json:"options,omitempty"`
+	Reference *SchemaSpecReference `json:"reference,omitempty"`
+	Required  *bool                `json:"required,omitempty"`
+	Tags      *[]SchemaSpecTag     `json:"tags,omitempty"`
+	Type      ValueType            `json:"type"`
+	Unique    *bool                `json:"unique,omitempty"`
+
+	// ValidationRules The pattern and count rules of the field
+	ValidationRules *[]SchemaSpecValidationRule `json:"validationRules,omitempty"`
+}
+
+// SchemaSpecGroup defines model for schemaSpecGroup.
+type SchemaSpecGroup struct {
+	Description *string            `json:"description,omitempty"`
+	Fields      *[]SchemaSpecField `json:"fields,omitempty"`
+	Key         string             `json:"key"`
+	Name        *string            `json:"name,omitempty"`
+
+	// ValidationRules The compare rules across the fields
+	ValidationRules *[]SchemaSpecValidationRule `json:"validationRules,omitempty"`
+}
+
+// SchemaSpecModel defines model for schemaSpecModel.
+type SchemaSpecModel struct {
+	Description *string            `json:"description,omitempty"`
+	Fields      *[]SchemaSpecField `json:"fields,omitempty"`
+	Key         string             `json:"key"`
+	Name        *string            `json:"name,omitempty"`
+
+	// TitleField The key of the title field
+	TitleField *string `json:"titleField,omitempty"`
+
+	// ValidationRules The compare rules across the fields
+	ValidationRules *[]SchemaSpecValidationRule `json:"validationRules,omitempty"`
+}
+
+// SchemaSpecReference defines model for schemaSpecReference.
+type SchemaSpecReference struct {
+	// CorrespondingField The key of the field of the referenced model which refers back to this field
+	CorrespondingField *string `json:"correspondingField,omitempty"`
+
+	// Model The key of the referenced model
+	Model string `json:"model"`
+}
+
+// SchemaSpecTag defines model for schemaSpecTag.
+type SchemaSpecTag struct {
+	Color *string `json:"color,omitempty"`
+	Name  string  `json:"name"`
+}
+
+// SchemaSpecValidationRule defines model for schemaSpecValidationRule.
+type SchemaSpecValidationRule struct {
+	Count *int `json:"count,omitempty"`
+
+	// Field The key of the field compared by compare rules
+	Field    *string                           `json:"field,omitempty"`
+	Message  *string                           `json:"message,omitempty"`
+	Operator *SchemaSpecValidationRuleOperator `json:"operator,omitempty"`
+
+	// OtherField The key of the field compared with by compare rules
+	OtherField *string                      `json:"otherField,omitempty"`
+	Pattern    *string                      `json:"pattern,omitempty"`
+	Type       SchemaSpecValidationRuleType `json:"type"`
+}
+
+// SchemaSpecValidationRuleOperator defines model for SchemaSpecValidationRule.Operator.
+type SchemaSpecValidationRuleOperator string
+
+// SchemaSpecValidationRuleType defines model for SchemaSpecValidationRule.Type.
+type SchemaSpecValidationRuleType string
+
 // TagResponse defines model for tagResponse.
 type TagResponse struct {
 	Color *string   `json:"color,omitempty"`
@@ -625,6 +919,23 @@ type TagResponse struct {
 	Name  *string   `json:"name,omitempty"`
 }
 
+// TrashEntry defines model for trashEntry.
+type TrashEntry struct {
+	AssetId       *id.AssetID           `json:"assetId,omitempty"`
+	DeletedAt     time.Time             `json:"deletedAt"`
+	Id            id.TrashID            `json:"id"`
+	IntegrationId *id.IntegrationID     `json:"integrationId,omitempty"`
+	ItemId        *id.ItemID            `json:"itemId,omitempty"`
+	ModelId       *id.ModelID           `json:"modelId,omitempty"`
+	Name          string                `json:"name"`
+	ProjectId     id.ProjectID          `json:"projectId"`
+	Type          TrashEntryType        `json:"type"`
+	UserId        *accountdomain.UserID `json:"userId,omitempty"`
+}
+
+// TrashEntryType defines model for TrashEntry.Type.
+type TrashEntryType string
+
 // ValueType defines model for valueType.
 type ValueType string
 
@@ -656,6 +967,9 @@ type AssetIdParam = id.AssetID
 // AssetParam defines model for assetParam.
 type AssetParam = AssetEmbedding
 
+// AtParam defines model for atParam.
+type AtParam = time.Time
+
 // CommentIdParam defines model for commentIdParam.
 type CommentIdParam = id.CommentID
 
@@ -677,6 +991,9 @@ type ItemIdParam = id.ItemID
 // KeywordParam defines model for keywordParam.
 type KeywordParam = string
 
+// LocaleParam defines model for localeParam.
+type LocaleParam = string
+
 // ModelIdOrKeyParam defines model for modelIdOrKeyParam.
 type ModelIdOrKeyParam = model.IDOrKey
 
@@ -689,6 +1006,9 @@ type PageParam = int
 // PerPageParam defines model for perPageParam.
 type PerPageParam = int
 
+// PreviewTokenIdParam defines model for previewTokenIdParam.
+type PreviewTokenIdParam = id.PreviewTokenID
+
 // ProjectIdOrAliasParam defines model for projectIdOrAliasParam.
 type ProjectIdOrAliasParam = project.IDOrAlias
 
@@ -707,6 +1027,12 @@ type SortDirParam string
 // SortParam defines model for sortParam.
 type SortParam string
 
+// TemplateIdParam defines model for templateIdParam.
+type TemplateIdParam = id.ItemTemplateID
+
+// TrashIdParam defines model for trashIdParam.
+type TrashIdParam = id.TrashID
+
 // WorkspaceIdParam defines model for workspaceIdParam.
 type WorkspaceIdParam = accountdomain.WorkspaceID
 
@@ -725,6 +1051,27 @@ type AssetCommentUpdateJSONBody struct {
 	Content *string `json:"content,omitempty"`
 }
 
+// AssetTransformURLParams defines parameters for AssetTransformURL.
+type AssetTransformURLParams struct {
+	// W Width of the transformed image
+	W *int `form:"w,omitempty" json:"w,omitempty"`
+
+	// H Height of the transformed image
+	H *int `form:"h,omitempty" json:"h,omitempty"`
+
+	// Fit How the image is fitted to the width and height
+	Fit *AssetTransformURLParamsFit `form:"fit,omitempty" json:"fit,omitempty"`
+
+	// Format Format of the transformed image. The format of the source image is kept when omitted, except that the first frame of a GIF is encoded as PNG.
+	Format *AssetTransformURLParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// AssetTransformURLParamsFit defines parameters for AssetTransformURL.
+type AssetTransformURLParamsFit string
+
+// AssetTransformURLParamsFormat defines parameters for AssetTransformURL.
+type AssetTransformURLParamsFormat string
+
 // GroupUpdateJSONBody defines parameters for GroupUpdate.
 type GroupUpdateJSONBody struct {
 	Description *string `json:"description,omitempty"`
@@ -732,6 +1079,31 @@ type GroupUpdateJSONBody struct {
 	Name        *string `json:"name,omitempty"`
 }
 
+// ItemBatchDeleteJSONBody defines parameters for ItemBatchDelete.
+type ItemBatchDeleteJSONBody struct {
+	// Atomic when true, no item is changed unless all the items succeed
+	Atomic  *bool       `json:"atomic,omitempty"`
+	ItemIds []id.ItemID `json:"itemIds"`
+}
+
+// ItemBatchUpdateJSONBody defines parameters for ItemBatchUpdate.
+type ItemBatchUpdateJSONBody struct {
+	// Atomic when true, no item is changed unless all the items succeed
+	Atomic *bool `json:"atomic,omitempty"`
+	Items  []struct {
+		Fields         *[]Field  `json:"fields,omitempty"`
+		Id             id.ItemID `json:"id"`
+		MetadataFields *[]Field  `json:"metadataFields,omitempty"`
+	} `json:"items"`
+}
+
+// ItemBatchPublishJSONBody defines parameters for ItemBatchPublish.
+type ItemBatchPublishJSONBody struct {
+	// Atomic when true, no item is changed unless all the items succeed
+	Atomic  *bool       `json:"atomic,omitempty"`
+	ItemIds []id.ItemID `json:"itemIds"`
+}
+
 // ItemGetParams defines parameters for ItemGet.
 type ItemGetParams struct {
 	// Ref Used to select a ref or ver
@@ -739,6 +1111,9 @@ type ItemGetParams struct {
 
 	// Asset Specifies whether asset data are embedded in the results
 	Asset *AssetParam `form:"asset,omitempty" json:"asset,omitempty"`
+
+	// At Used to read the items as they were public at the time
+	At *AtParam `form:"at,omitempty" json:"at,omitempty"`
 }
 
 // ItemGetParamsRef defines parameters for ItemGet.
@@ -761,6 +1136,21 @@ type ItemCommentUpdateJSONBody struct {
 	Content *string `json:"content,omitempty"`
 }
 
+// ItemDiffParams defines parameters for ItemDiff.
+type ItemDiffParams struct {
+	// From A version or a ref such as latest and public
+	From string `form:"from" json:"from"`
+
+	// To A version or a ref such as latest and public
+	To string `form:"to" json:"to"`
+}
+
+// ItemRestoreJSONBody defines parameters for ItemRestore.
+type ItemRestoreJSONBody struct {
+	// Version A version or a ref such as public
+	Version string `json:"version"`
+}
+
 // ModelUpdateJSONBody defines parameters for ModelUpdate.
 type ModelUpdateJSONBody struct {
 	Description *string `json:"description,omitempty"`
@@ -832,6 +1222,9 @@ type ItemFilterParams struct {
 
 	// Keyword keyword string
 	Keyword *KeywordParam `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// At Used to read the items as they were public at the time
+	At *AtParam `form:"at,omitempty" json:"at,omitempty"`
 }
 
 // ItemFilterParamsSort defines parameters for ItemFilter.
@@ -857,6 +1250,9 @@ type ItemsAsCSVParams struct {
 	// PerPage Used to select the page
 	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
 
+	// Locale Locale to resolve the values of localized fields. Values of the default locale of the project are returned if it is omitted.
+	Locale *LocaleParam `form:"locale,omitempty" json:"locale,omitempty"`
+
 	// Ref Used to select a ref or ver
 	Ref *ItemsAsCSVParamsRef `form:"ref,omitempty" json:"ref,omitempty"`
 }
@@ -872,6 +1268,9 @@ type ItemsAsGeoJSONParams struct {
 	// PerPage Used to select the page
 	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
 
+	// Locale Locale to resolve the values of localized fields. Values of the default locale of the project are returned if it is omitted.
+	Locale *LocaleParam `form:"locale,omitempty" json:"locale,omitempty"`
+
 	// Ref Used to select a ref or ver
 	Ref *ItemsAsGeoJSONParamsRef `form:"ref,omitempty" json:"ref,omitempty"`
 }
@@ -879,6 +1278,25 @@ type ItemsAsGeoJSONParams struct {
 // ItemsAsGeoJSONParamsRef defines parameters for ItemsAsGeoJSON.
 type ItemsAsGeoJSONParamsRef string
 
+// ItemBatchCreateJSONBody defines parameters for ItemBatchCreate.
+type ItemBatchCreateJSONBody struct {
+	// Atomic when true, no item is changed unless all the items succeed
+	Atomic *bool `json:"atomic,omitempty"`
+	Items  []struct {
+		Fields         *[]Field `json:"fields,omitempty"`
+		MetadataFields *[]Field `json:"metadataFields,omitempty"`
+	} `json:"items"`
+}
+
+// ItemTemplateCreateJSONBody defines parameters for ItemTemplateCreate.
+type ItemTemplateCreateJSONBody struct {
+	Description    *string    `json:"description,omitempty"`
+	Fields         *[]Field   `json:"fields,omitempty"`
+	MetadataFields *[]Field   `json:"metadataFields,omitempty"`
+	Name           string     `json:"name"`
+	SourceItemId   *id.ItemID `json:"sourceItemId,omitempty"`
+}
+
 // GroupFilterParams defines parameters for GroupFilter.
 type GroupFilterParams struct {
 	// Page Used to select the page
@@ -957,18 +1375,22 @@ type ModelUpdateWithProjectJSONBody struct {
 
 // FieldCreateWithProjectJSONBody defines parameters for FieldCreateWithProject.
 type FieldCreateWithProjectJSONBody struct {
-	Key      *string    `json:"key,omitempty"`
-	Multiple *bool      `json:"multiple,omitempty"`
-	Required *bool      `json:"required,omitempty"`
-	Type     *ValueType `json:"type,omitempty"`
+	Expression *string    `json:"expression,omitempty"`
+	Key        *string    `json:"key,omitempty"`
+	Localized  *bool      `json:"localized,omitempty"`
+	Multiple   *bool      `json:"multiple,omitempty"`
+	Required   *bool      `json:"required,omitempty"`
+	Type       *ValueType `json:"type,omitempty"`
 }
 
 // FieldUpdateWithProjectJSONBody defines parameters for FieldUpdateWithProject.
 type FieldUpdateWithProjectJSONBody struct {
-	Key      *string    `json:"key,omitempty"`
-	Multiple *bool      `json:"multiple,omitempty"`
-	Required *bool      `json:"required,omitempty"`
-	Type     *ValueType `json:"type,omitempty"`
+	Expression *string    `json:"expression,omitempty"`
+	Key        *string    `json:"key,omitempty"`
+	Localized  *bool      `json:"localized,omitempty"`
+	Multiple   *bool      `json:"multiple,omitempty"`
+	Required   *bool      `json:"required,omitempty"`
+	Type       *ValueType `json:"type,omitempty"`
 }
 
 // ItemFilterWithProjectParams defines parameters for ItemFilterWithProject.
@@ -990,6 +1412,9 @@ type ItemFilterWithProjectParams struct {
 
 	// Asset Specifies whether asset data are embedded in the results
 	Asset *AssetParam `form:"asset,omitempty" json:"asset,omitempty"`
+
+	// At Used to read the items as they were public at the time
+	At *AtParam `form:"at,omitempty" json:"at,omitempty"`
 }
 
 // ItemFilterWithProjectParamsSort defines parameters for ItemFilterWithProject.
@@ -1015,6 +1440,9 @@ type ItemsWithProjectAsCSVParams struct {
 	// PerPage Used to select the page
 	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
 
+	// Locale Locale to resolve the values of localized fields. Values of the default locale of the project are returned if it is omitted.
+	Locale *LocaleParam `form:"locale,omitempty" json:"locale,omitempty"`
+
 	// Ref Used to select a ref or ver
 	Ref *ItemsWithProjectAsCSVParamsRef `form:"ref,omitempty" json:"ref,omitempty"`
 }
@@ -1030,6 +1458,9 @@ type ItemsWithProjectAsGeoJSONParams struct {
 	// PerPage Used to select the page
 	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
 
+	// Locale Locale to resolve the values of localized fields. Values of the default locale of the project are returned if it is omitted.
+	Locale *LocaleParam `form:"locale,omitempty" json:"locale,omitempty"`
+
 	// Ref Used to select a ref or ver
 	Ref *ItemsWithProjectAsGeoJSONParamsRef `form:"ref,omitempty" json:"ref,omitempty"`
 }
@@ -1037,6 +1468,30 @@ type ItemsWithProjectAsGeoJSONParams struct {
 // ItemsWithProjectAsGeoJSONParamsRef defines parameters for ItemsWithProjectAsGeoJSON.
 type ItemsWithProjectAsGeoJSONParamsRef string
 
+// PreviewTokenListParams defines parameters for PreviewTokenList.
+type PreviewTokenListParams struct {
+	// Page Used to select the page
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Used to select the page
+	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
+}
+
+// PreviewTokenIssueJSONBody defines parameters for PreviewTokenIssue.
+type PreviewTokenIssueJSONBody struct {
+	RequestId *id.RequestID `json:"requestId,omitempty"`
+
+	// Ttl Lifetime of the token in seconds. Defaults to one hour and cannot exceed seven days.
+	Ttl *int `json:"ttl,omitempty"`
+}
+
+// ScheduleCreateJSONBody defines parameters for ScheduleCreate.
+type ScheduleCreateJSONBody struct {
+	ItemIds     []id.ItemID `json:"itemIds"`
+	PublishAt   *time.Time  `json:"publishAt,omitempty"`
+	UnpublishAt *time.Time  `json:"unpublishAt,omitempty"`
+}
+
 // SchemaFilterParams defines parameters for SchemaFilter.
 type SchemaFilterParams struct {
 	// Sort Used to define the order of the response list
@@ -1055,6 +1510,21 @@ type SchemaFilterParams struct {
 	Keyword *KeywordParam `form:"keyword,omitempty" json:"keyword,omitempty"`
 }
 
+// SchemaSpecApplyParams defines parameters for SchemaSpecApply.
+type SchemaSpecApplyParams struct {
+	// AllowDestructive Allows the changes which can lose the contents of the items, such as deleting fields.
+	AllowDestructive *bool `form:"allowDestructive,omitempty" json:"allowDestructive,omitempty"`
+}
+
+// TrashListParams defines parameters for TrashList.
+type TrashListParams struct {
+	// Page Used to select the page
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Used to select the page
+	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
+}
+
 // AssetFilterParams defines parameters for AssetFilter.
 type AssetFilterParams struct {
 	// Sort Used to define the order of the response list
@@ -1071,6 +1541,30 @@ type AssetFilterParams struct {
 
 	// Keyword keyword string
 	Keyword *KeywordParam `form:"keyword,omitempty" json:"keyword,omitempty"`
+
+	// MinWidth Minimum width of images
+	MinWidth *int `form:"minWidth,omitempty" json:"minWidth,omitempty"`
+
+	// MaxWidth Maximum width of images
+	MaxWidth *int `form:"maxWidth,omitempty" json:"maxWidth,omitempty"`
+
+	// MinHeight Minimum height of images
+	MinHeight *int `form:"minHeight,omitempty" json:"minHeight,omitempty"`
+
+	// MaxHeight Maximum height of images
+	MaxHeight *int `form:"maxHeight,omitempty" json:"maxHeight,omitempty"`
+
+	// CapturedAfter Images captured at or after the time
+	CapturedAfter *time.Time `form:"capturedAfter,omitempty" json:"capturedAfter,omitempty"`
+
+	// CapturedBefore Images captured at or before the time
+	CapturedBefore *time.Time `form:"capturedBefore,omitempty" json:"capturedBefore,omitempty"`
+
+	// Bbox Assets whose bounding box intersects, or whose location is in, the box of "minLng,minLat,maxLng,maxLat"
+	Bbox *string `form:"bbox,omitempty" json:"bbox,omitempty"`
+
+	// Header CSV assets which have the column
+	Header *string `form:"header,omitempty" json:"header,omitempty"`
 }
 
 // AssetFilterParamsSort defines parameters for AssetFilter.
@@ -1106,18 +1600,36 @@ type AssetUploadCreateJSONBody struct {
 
 // FieldCreateJSONBody defines parameters for FieldCreate.
 type FieldCreateJSONBody struct {
-	Key      *string    `json:"key,omitempty"`
-	Multiple *bool      `json:"multiple,omitempty"`
-	Required *bool      `json:"required,omitempty"`
-	Type     *ValueType `json:"type,omitempty"`
+	Expression *string    `json:"expression,omitempty"`
+	Key        *string    `json:"key,omitempty"`
+	Localized  *bool      `json:"localized,omitempty"`
+	Multiple   *bool      `json:"multiple,omitempty"`
+	Required   *bool      `json:"required,omitempty"`
+	Type       *ValueType `json:"type,omitempty"`
 }
 
 // FieldUpdateJSONBody defines parameters for FieldUpdate.
 type FieldUpdateJSONBody struct {
-	Key      *string    `json:"key,omitempty"`
-	Multiple *bool      `json:"multiple,omitempty"`
-	Required *bool      `json:"required,omitempty"`
-	Type     *ValueType `json:"type,omitempty"`
+	Expression *string    `json:"expression,omitempty"`
+	Key        *string    `json:"key,omitempty"`
+	Localized  *bool      `json:"localized,omitempty"`
+	Multiple   *bool      `json:"multiple,omitempty"`
+	Required   *bool      `json:"required,omitempty"`
+	Type       *ValueType `json:"type,omitempty"`
+}
+
+// ItemTemplateUpdateJSONBody defines parameters for ItemTemplateUpdate.
+type ItemTemplateUpdateJSONBody struct {
+	Description    *string  `json:"description,omitempty"`
+	Fields         *[]Field `json:"fields,omitempty"`
+	MetadataFields *[]Field `json:"metadataFields,omitempty"`
+	Name           *string  `json:"name,omitempty"`
+}
+
+// ItemCreateFromTemplateJSONBody defines parameters for ItemCreateFromTemplate.
+type ItemCreateFromTemplateJSONBody struct {
+	Fields         *[]Field `json:"fields,omitempty"`
+	MetadataFields *[]Field `json:"metadataFields,omitempty"`
 }
 
 // ProjectFilterParams defines parameters for ProjectFilter.
@@ -1146,6 +1658,12 @@ type ProjectCreateParams struct {
 	PerPage *PerPageParam `form:"perPage,omitempty" json:"perPage,omitempty"`
 }
 
+// ProjectImportMultipartBody defines parameters for ProjectImport.
+type ProjectImportMultipartBody struct {
+	Alias *string            `json:"alias,omitempty"`
+	File  openapi_types.File `json:"file"`
+}
+
 // ProjectUpdateJSONBody defines parameters for ProjectUpdate.
 type ProjectUpdateJSONBody struct {
 	Alias        *string               `json:"alias,omitempty"`
@@ -1167,6 +1685,15 @@ type AssetCommentUpdateJSONRequestBody AssetCommentUpdateJSONBody
 // GroupUpdateJSONRequestBody defines body for GroupUpdate for application/json ContentType.
 type GroupUpdateJSONRequestBody GroupUpdateJSONBody
 
+// ItemBatchDeleteJSONRequestBody defines body for ItemBatchDelete for application/json ContentType.
+type ItemBatchDeleteJSONRequestBody ItemBatchDeleteJSONBody
+
+// ItemBatchUpdateJSONRequestBody defines body for ItemBatchUpdate for application/json ContentType.
+type ItemBatchUpdateJSONRequestBody ItemBatchUpdateJSONBody
+
+// ItemBatchPublishJSONRequestBody defines body for ItemBatchPublish for application/json ContentType.
+type ItemBatchPublishJSONRequestBody ItemBatchPublishJSONBody
+
 // ItemUpdateJSONRequestBody defines body for ItemUpdate for application/json ContentType.
 type ItemUpdateJSONRequestBody ItemUpdateJSONBody
 
@@ -1176,6 +1703,9 @@ type ItemCommentCreateJSONRequestBody ItemCommentCreateJSONBody
 // ItemCommentUpdateJSONRequestBody defines body for ItemCommentUpdate for application/json ContentType.
 type ItemCommentUpdateJSONRequestBody ItemCommentUpdateJSONBody
 
+// ItemRestoreJSONRequestBody defines body for ItemRestore for application/json ContentType.
+type ItemRestoreJSONRequestBody ItemRestoreJSONBody
+
 // ModelUpdateJSONRequestBody defines body for ModelUpdate for application/json ContentType.
 type ModelUpdateJSONRequestBody ModelUpdateJSONBody
 
@@ -1194,6 +1724,12 @@ type ItemFilterJSONRequestBody ItemFilterJSONBody
 // ItemCreateJSONRequestBody defines body for ItemCreate for application/json ContentType.
 type ItemCreateJSONRequestBody ItemCreateJSONBody
 
+// ItemBatchCreateJSONRequestBody defines body for ItemBatchCreate for application/json ContentType.
+type ItemBatchCreateJSONRequestBody ItemBatchCreateJSONBody
+
+// ItemTemplateCreateJSONRequestBody defines body for ItemTemplateCreate for application/json ContentType.
+type ItemTemplateCreateJSONRequestBody ItemTemplateCreateJSONBody
+
 // GroupCreateJSONRequestBody defines body for GroupCreate for application/json ContentType.
 type GroupCreateJSONRequestBody GroupCreateJSONBody
 
@@ -1215,6 +1751,18 @@ type FieldUpdateWithProjectJSONRequestBody FieldUpdateWithProjectJSONBody
 // ItemCreateWithProjectJSONRequestBody defines body for ItemCreateWithProject for application/json ContentType.
 type ItemCreateWithProjectJSONRequestBody ItemCreateWithProjectJSONBody
 
+// PreviewTokenIssueJSONRequestBody defines body for PreviewTokenIssue for application/json ContentType.
+type PreviewTokenIssueJSONRequestBody PreviewTokenIssueJSONBody
+
+// ScheduleCreateJSONRequestBody defines body for ScheduleCreate for application/json ContentType.
+type ScheduleCreateJSONRequestBody ScheduleCreateJSONBody
+
+// SchemaSpecApplyJSONRequestBody defines body for SchemaSpecApply for application/json ContentType.
+type SchemaSpecApplyJSONRequestBody = SchemaSpec
+
+// SchemaSpecPlanJSONRequestBody defines body for SchemaSpecPlan for application/json ContentType.
+type SchemaSpecPlanJSONRequestBody = SchemaSpec
+
 // AssetCreateJSONRequestBody defines body for AssetCreate for application/json ContentType.
 type AssetCreateJSONRequestBody AssetCreateJSONBody
 
@@ -1230,9 +1778,18 @@ type FieldCreateJSONRequestBody FieldCreateJSONBody
 // FieldUpdateJSONRequestBody defines body for FieldUpdate for application/json ContentType.
 type FieldUpdateJSONRequestBody FieldUpdateJSONBody
 
+// ItemTemplateUpdateJSONRequestBody defines body for ItemTemplateUpdate for application/json ContentType.
+type ItemTemplateUpdateJSONRequestBody ItemTemplateUpdateJSONBody
+
+// ItemCreateFromTemplateJSONRequestBody defines body for ItemCreateFromTemplate for application/json ContentType.
+type ItemCreateFromTemplateJSONRequestBody ItemCreateFromTemplateJSONBody
+
 // ProjectCreateJSONRequestBody defines body for ProjectCreate for application/json ContentType.
 type ProjectCreateJSONRequestBody ProjectCreateJSONBody
 
+// ProjectImportMultipartRequestBody defines body for ProjectImport for multipart/form-data ContentType.
+type ProjectImportMultipartRequestBody ProjectImportMultipartBody
+
 // ProjectUpdateJSONRequestBody defines body for ProjectUpdate for application/json ContentType.
 type ProjectUpdateJSONRequestBody ProjectUpdateJSONBody
 
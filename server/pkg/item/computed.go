@@ -0,0 +1,71 @@
+package item
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+// Compute recomputes the values of the computed fields of the item from its other fields.
+// The computed fields of each group are computed from the fields of the same group with the schema of the group.
+func (i *Item) Compute(s *schema.Schema, groupSchemas schema.List) error {
+	if err := i.compute(s, nil, lo.Filter(i.fields, func(f *Field, _ int) bool { return f.ItemGroup() == nil })); err != nil {
+		return err
+	}
+
+	var groups []ItemGroupID
+	groupFields := map[ItemGroupID][]*Field{}
+	for _, f := range i.fields {
+		if g := f.ItemGroup(); g != nil {
+			if _, ok := groupFields[*g]; !ok {
+				groups = append(groups, *g)
+			}
+			groupFields[*g] = append(groupFields[*g], f)
+		}
+	}
+	for _, g := range groups {
+		fields := groupFields[g]
+		gs, ok := lo.Find(groupSchemas, func(gs *schema.Schema) bool { return gs.HasField(fields[0].FieldID()) })
+		if !ok {
+			continue
+		}
+		if err := i.compute(gs, &g, fields); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *Item) compute(s *schema.Schema, g *ItemGroupID, fields []*Field) error {
+	values := make(map[schema.FieldID]*value.Multiple, len(fields))
+	for _, f := range fields {
+		values[f.FieldID()] = f.Value()
+	}
+
+	computed, err := s.Compute(values)
+	if err != nil {
+		return err
+	}
+
+	for _, sf := range s.Fields() {
+		fid := sf.ID()
+		m, ok := computed[fid]
+		if !ok {
+			continue
+		}
+		_, idx, found := lo.FindIndexOf(i.fields, func(f *Field) bool {
+			return f.FieldID() == fid && (g == nil && f.ItemGroup() == nil || g != nil && f.ItemGroup() != nil && *f.ItemGroup() == *g)
+		})
+		switch {
+		case found && m.IsEmpty():
+			// the field is removed when the result is null so that the item does not keep the previous result
+			i.fields = slices.Delete(i.fields, idx, idx+1)
+		case found:
+			i.fields[idx] = NewField(fid, m, g)
+		case !m.IsEmpty():
+			i.fields = append(i.fields, NewField(fid, m, g))
+		}
+	}
+	return nil
+}
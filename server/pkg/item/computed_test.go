@@ -0,0 +1,45 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/expression"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_Compute(t *testing.T) {
+	title := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild()
+	slug := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("slug")).Expression(expression.MustParse("slug(title)")).MustBuild()
+	gf := schema.NewField(schema.NewGroup(id.NewGroupID()).TypeProperty()).NewID().Key(id.NewKey("group")).Multiple(true).MustBuild()
+	s := schema.New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(schema.FieldList{title, slug, gf}).MustBuild()
+
+	first := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("first")).MustBuild()
+	last := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("last")).MustBuild()
+	full := schema.NewField(schema.NewText(nil).TypeProperty()).NewID().Key(id.NewKey("full")).Expression(expression.MustParse(`trim(first + " " + last)`)).MustBuild()
+	gs := schema.New().NewID().Workspace(s.Workspace()).Project(s.Project()).Fields(schema.FieldList{first, last, full}).MustBuild()
+
+	g1, g2 := id.NewItemGroupID(), id.NewItemGroupID()
+	i := New().NewID().Schema(s.ID()).Model(id.NewModelID()).Project(s.Project()).Thread(id.NewThreadID().Ref()).Fields([]*Field{
+		NewField(title.ID(), value.TypeText.Value("Hello World").AsMultiple(), nil),
+		NewField(slug.ID(), value.TypeText.Value("old").AsMultiple(), nil),
+		NewField(gf.ID(), value.NewMultiple(value.TypeGroup, []any{g1, g2}), nil),
+		NewField(first.ID(), value.TypeText.Value("John").AsMultiple(), g1.Ref()),
+		NewField(last.ID(), value.TypeText.Value("Doe").AsMultiple(), g1.Ref()),
+		NewField(first.ID(), value.TypeText.Value("Jane").AsMultiple(), g2.Ref()),
+	}).MustBuild()
+
+	assert.NoError(t, i.Compute(s, schema.List{gs}))
+	assert.Equal(t, "hello-world", i.Field(slug.ID()).Value().First().Interface())
+	assert.Equal(t, "John Doe", i.FieldByItemGroupAndID(full.ID(), g1).Value().First().Interface())
+	assert.Equal(t, "Jane", i.FieldByItemGroupAndID(full.ID(), g2).Value().First().Interface())
+	assert.Equal(t, 8, len(i.Fields()))
+
+	// the computed field is removed when the result is null
+	i.ClearField(title.ID())
+	assert.NoError(t, i.Compute(s, schema.List{gs}))
+	assert.Nil(t, i.Field(slug.ID()))
+}
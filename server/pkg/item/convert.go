@@ -0,0 +1,50 @@
+package item
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+// Convert returns a copy of the field whose values, including the ones of the locales, are cast to the type of the schema field.
+// It returns nil when no value is left.
+func (f *Field) Convert(sf *schema.Field) (*Field, schema.ConversionResult) {
+	if f == nil {
+		return nil, schema.ConversionResult{}
+	}
+
+	v, res := sf.ConvertValue(f.value)
+	g := &Field{
+		field: f.field,
+		group: f.ItemGroup(),
+		value: v,
+	}
+	for l, lv := range f.locales {
+		c, r := sf.ConvertValue(lv)
+		res = res.Add(r)
+		g.SetLocaleValue(l, c)
+	}
+
+	if g.value == nil {
+		if len(g.locales) == 0 {
+			return nil, res
+		}
+		g.value = value.NewMultiple(sf.Type(), nil)
+	}
+	return g, res
+}
+
+// ConvertField casts the values of the field to the type of the schema field.
+// The timestamp is kept as the content of the item is not edited.
+func (i *Item) ConvertField(sf *schema.Field) schema.ConversionResult {
+	var res schema.ConversionResult
+	i.fields = lo.FilterMap(i.fields, func(f *Field, _ int) (*Field, bool) {
+		if f.FieldID() != sf.ID() {
+			return f, true
+		}
+		g, r := f.Convert(sf)
+		res = res.Add(r)
+		return g, g != nil
+	})
+	return res
+}
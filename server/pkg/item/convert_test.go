@@ -0,0 +1,54 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestField_Convert(t *testing.T) {
+	fid := id.NewFieldID()
+	sf := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).ID(fid).Key(id.RandomKey()).MustBuild()
+
+	f := NewField(fid, value.NewMultiple(value.TypeText, []any{"1", "x"}), nil)
+	f.SetLocaleValue(locale.Locale("ja"), value.TypeText.Value("2").AsMultiple())
+	got, res := f.Convert(sf)
+	assert.Equal(t, value.TypeInteger.Value(int64(1)).AsMultiple(), got.Value())
+	assert.Equal(t, value.TypeInteger.Value(int64(2)).AsMultiple(), got.LocaleValue("ja"))
+	assert.Equal(t, schema.ConversionResult{Converted: 2, Failed: 1}, res)
+	// the original field is not changed
+	assert.Equal(t, value.TypeText, f.Type())
+
+	// the base value is emptied but the locale is kept
+	f = NewField(fid, value.TypeText.Value("x").AsMultiple(), nil)
+	f.SetLocaleValue(locale.Locale("ja"), value.TypeText.Value("2").AsMultiple())
+	got, res = f.Convert(sf)
+	assert.True(t, got.Value().IsEmpty())
+	assert.Equal(t, value.TypeInteger, got.Type())
+	assert.Equal(t, schema.ConversionResult{Converted: 1, Failed: 1}, res)
+
+	got, res = NewField(fid, value.TypeText.Value("x").AsMultiple(), nil).Convert(sf)
+	assert.Nil(t, got)
+	assert.Equal(t, schema.ConversionResult{Failed: 1}, res)
+}
+
+func TestItem_ConvertField(t *testing.T) {
+	fid1, fid2 := id.NewFieldID(), id.NewFieldID()
+	sf := schema.NewField(lo.Must(schema.NewNumber(nil, nil)).TypeProperty()).ID(fid1).Key(id.RandomKey()).Multiple(true).MustBuild()
+	i := New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Fields([]*Field{
+		NewField(fid1, value.NewMultiple(value.TypeInteger, []any{1, 2}), nil),
+		NewField(fid2, value.TypeText.Value("a").AsMultiple(), nil),
+	}).MustBuild()
+	ts := i.Timestamp()
+
+	res := i.ConvertField(sf)
+	assert.Equal(t, schema.ConversionResult{Converted: 2}, res)
+	assert.Equal(t, value.NewMultiple(value.TypeNumber, []any{1.0, 2.0}), i.Field(fid1).Value())
+	assert.Equal(t, value.TypeText.Value("a").AsMultiple(), i.Field(fid2).Value())
+	assert.Equal(t, ts, i.Timestamp())
+}
@@ -0,0 +1,35 @@
+package item
+
+// Diff is the field level difference between two versions of an item.
+type Diff struct {
+	Fields FieldChanges
+	// MetadataItem is set when the metadata item linked to the item was changed.
+	MetadataItem *MetadataItemChange
+	// MetadataFields holds the changes of the fields of the metadata item.
+	MetadataFields FieldChanges
+}
+
+type MetadataItemChange struct {
+	Previous *ID
+	Current  *ID
+}
+
+// CompareItems returns the difference from o to n. MetadataFields are not set because the metadata item is stored separately.
+func CompareItems(n, o *Item) Diff {
+	d := Diff{
+		Fields: CompareFields(n.Fields(), o.Fields()),
+	}
+
+	nm, om := n.MetadataItem(), o.MetadataItem()
+	if (nm == nil) != (om == nil) || (nm != nil && *nm != *om) {
+		d.MetadataItem = &MetadataItemChange{
+			Previous: om.CloneRef(),
+			Current:  nm.CloneRef(),
+		}
+	}
+	return d
+}
+
+func (d Diff) IsEmpty() bool {
+	return len(d.Fields) == 0 && d.MetadataItem == nil && len(d.MetadataFields) == 0
+}
@@ -0,0 +1,32 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareItems(t *testing.T) {
+	fId := id.NewFieldID()
+	mId := id.NewItemID()
+	b := func() *Builder {
+		return New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Thread(id.NewThreadID().Ref())
+	}
+
+	o := b().Fields(Fields{NewField(fId, value.TypeText.Value("a").AsMultiple(), nil)}).MustBuild()
+	n := b().Fields(Fields{NewField(fId, value.TypeText.Value("b").AsMultiple(), nil)}).MetadataItem(mId.Ref()).MustBuild()
+
+	d := CompareItems(n, o)
+	assert.Equal(t, FieldChanges{{
+		ID:            fId,
+		Type:          FieldChangeTypeUpdate,
+		PreviousValue: value.TypeText.Value("a").AsMultiple(),
+		CurrentValue:  value.TypeText.Value("b").AsMultiple(),
+	}}, d.Fields)
+	assert.Equal(t, &MetadataItemChange{Current: mId.Ref()}, d.MetadataItem)
+	assert.False(t, d.IsEmpty())
+
+	assert.True(t, CompareItems(n, n).IsEmpty())
+}
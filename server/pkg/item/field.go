@@ -1,15 +1,19 @@
 package item
 
 import (
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/util"
+	"golang.org/x/exp/maps"
 )
 
 type Field struct {
 	field FieldID
 	group *ItemGroupID
 	value *value.Multiple
+	// locales holds the values of the locales other than the default locale of the project.
+	locales map[locale.Locale]*value.Multiple
 }
 
 func NewField(field FieldID, v *value.Multiple, ig *ItemGroupID) *Field {
@@ -48,3 +52,50 @@ func (f *Field) ItemGroup() *ItemGroupID {
 func (f *Field) IsGeometryField() bool {
 	return f.Type() == value.TypeGeometryObject || f.Type() == value.TypeGeometryEditor
 }
+
+// Locales returns the values of the locales other than the default locale.
+func (f *Field) Locales() map[locale.Locale]*value.Multiple {
+	if f == nil {
+		return nil
+	}
+	return maps.Clone(f.locales)
+}
+
+func (f *Field) LocaleValue(l locale.Locale) *value.Multiple {
+	if f == nil {
+		return nil
+	}
+	return f.locales[l]
+}
+
+// SetLocaleValue sets the value of the locale. An empty value removes the locale from the field.
+func (f *Field) SetLocaleValue(l locale.Locale, v *value.Multiple) {
+	if v.IsEmpty() {
+		delete(f.locales, l)
+		return
+	}
+	if f.locales == nil {
+		f.locales = map[locale.Locale]*value.Multiple{}
+	}
+	f.locales[l] = v
+}
+
+// ValueFor resolves the value of the locale by following the fallback chain of the settings.
+// The base value is used for the default locale and when no locale in the chain has a value.
+func (f *Field) ValueFor(s *locale.Settings, l locale.Locale) *value.Multiple {
+	if f == nil {
+		return nil
+	}
+	for _, c := range s.Chain(l) {
+		var v *value.Multiple
+		if s.IsDefault(c) {
+			v = f.value
+		} else {
+			v = f.locales[c]
+		}
+		if !v.IsEmpty() {
+			return v
+		}
+	}
+	return f.value
+}
@@ -17,23 +17,52 @@ type FieldChanges []FieldChange
 
 type FieldChange struct {
 	ID            FieldID
+	Group         *ItemGroupID
 	Type          FieldChangeType
 	CurrentValue  *value.Multiple
 	PreviousValue *value.Multiple
 }
 
+type fieldChangeKey struct {
+	field FieldID
+	group ItemGroupID
+}
+
+func fieldChangeKeys(fields Fields) (map[fieldChangeKey]*Field, []fieldChangeKey) {
+	m := make(map[fieldChangeKey]*Field, len(fields))
+	keys := make([]fieldChangeKey, 0, len(fields))
+	for _, f := range fields {
+		if f == nil {
+			continue
+		}
+		k := fieldChangeKey{field: f.FieldID()}
+		if g := f.ItemGroup(); g != nil {
+			k.group = *g
+		}
+		if _, ok := m[k]; !ok {
+			keys = append(keys, k)
+		}
+		m[k] = f
+	}
+	return m, keys
+}
+
+// CompareFields returns the changes from o to n. Fields in groups are compared for each item group.
 func CompareFields(n, o Fields) FieldChanges {
-	nFields, oFields := n.Map(), o.Map()
+	nFields, nKeys := fieldChangeKeys(n)
+	oFields, oKeys := fieldChangeKeys(o)
 
 	changes := make([]FieldChange, 0, len(nFields)+len(oFields))
 
-	for fieldID, newField := range nFields {
-		oldField, exists := oFields[fieldID]
+	for _, k := range nKeys {
+		newField := nFields[k]
+		oldField, exists := oFields[k]
 
 		if !exists {
 			// add
 			change := FieldChange{
-				ID:            fieldID,
+				ID:            k.field,
+				Group:         newField.ItemGroup(),
 				Type:          FieldChangeTypeAdd,
 				PreviousValue: nil,
 				CurrentValue:  newField.Value(),
@@ -49,7 +78,8 @@ func CompareFields(n, o Fields) FieldChanges {
 
 		// update
 		change := FieldChange{
-			ID:            fieldID,
+			ID:            k.field,
+			Group:         newField.ItemGroup(),
 			Type:          FieldChangeTypeUpdate,
 			PreviousValue: oldField.Value(),
 			CurrentValue:  newField.Value(),
@@ -58,14 +88,16 @@ func CompareFields(n, o Fields) FieldChanges {
 		changes = append(changes, change)
 	}
 
-	for fieldID, oldField := range oFields {
-		if _, exists := nFields[fieldID]; exists {
+	for _, k := range oKeys {
+		if _, exists := nFields[k]; exists {
 			continue
 		}
+		oldField := oFields[k]
 
 		// delete
 		change := FieldChange{
-			ID:            fieldID,
+			ID:            k.field,
+			Group:         oldField.ItemGroup(),
 			Type:          FieldChangeTypeDelete,
 			PreviousValue: oldField.Value(),
 			CurrentValue:  nil,
@@ -74,9 +106,32 @@ func CompareFields(n, o Fields) FieldChanges {
 		changes = append(changes, change)
 	}
 
-	slices.SortFunc(changes, func(a, b FieldChange) int {
+	slices.SortStableFunc(changes, func(a, b FieldChange) int {
 		return a.ID.Timestamp().Compare(b.ID.Timestamp())
 	})
 
 	return changes
 }
+
+// AddedValues returns the values which are in the current value but not in the previous value.
+func (c FieldChange) AddedValues() []*value.Value {
+	return diffValues(c.CurrentValue, c.PreviousValue)
+}
+
+// RemovedValues returns the values which are in the previous value but not in the current value.
+func (c FieldChange) RemovedValues() []*value.Value {
+	return diffValues(c.PreviousValue, c.CurrentValue)
+}
+
+func diffValues(a, b *value.Multiple) []*value.Value {
+	rest := slices.Clone(b.Values())
+	var res []*value.Value
+	for _, v := range a.Values() {
+		if i := slices.IndexFunc(rest, func(w *value.Value) bool { return v.Equal(w) }); i >= 0 {
+			rest = slices.Delete(rest, i, i+1)
+			continue
+		}
+		res = append(res, v)
+	}
+	return res
+}
@@ -11,6 +11,7 @@ import (
 func TestCompareFields(t *testing.T) {
 	fId := id.NewFieldID()
 	fId2 := id.NewFieldID()
+	ig, ig2 := id.NewItemGroupID().Ref(), id.NewItemGroupID().Ref()
 	// fId3 := id.NewFieldID()
 	// fId4 := id.NewFieldID()
 
@@ -98,6 +99,28 @@ func TestCompareFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "group",
+			args: args{
+				n: Fields{
+					NewField(fId, value.New(value.TypeText, "a").AsMultiple(), ig),
+					NewField(fId, value.New(value.TypeText, "c").AsMultiple(), ig2),
+				},
+				o: Fields{
+					NewField(fId, value.New(value.TypeText, "a").AsMultiple(), ig),
+					NewField(fId, value.New(value.TypeText, "b").AsMultiple(), ig2),
+				},
+			},
+			want: FieldChanges{
+				{
+					ID:            fId,
+					Group:         ig2,
+					Type:          FieldChangeTypeUpdate,
+					PreviousValue: value.New(value.TypeText, "b").AsMultiple(),
+					CurrentValue:  value.New(value.TypeText, "c").AsMultiple(),
+				},
+			},
+		},
 		// TODO: Flasky test
 		// {
 		// 	name: "multiple changes",
@@ -143,3 +166,13 @@ func TestCompareFields(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldChange_AddedValues(t *testing.T) {
+	c := FieldChange{
+		PreviousValue: value.NewMultiple(value.TypeText, []any{"a", "b", "b"}),
+		CurrentValue:  value.NewMultiple(value.TypeText, []any{"b", "c"}),
+	}
+	assert.Equal(t, []*value.Value{value.TypeText.Value("c")}, c.AddedValues())
+	assert.Equal(t, []*value.Value{value.TypeText.Value("a"), value.TypeText.Value("b")}, c.RemovedValues())
+	assert.Nil(t, FieldChange{}.AddedValues())
+}
@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,3 +21,34 @@ func TestNewField(t *testing.T) {
 
 	assert.Equal(t, value.TypeBool, f.Type())
 }
+
+func TestField_Locales(t *testing.T) {
+	f := NewField(id.NewFieldID(), value.TypeText.Value("hello").AsMultiple(), nil)
+	assert.Nil(t, f.Locales())
+
+	f.SetLocaleValue("ja", value.TypeText.Value("こんにちは").AsMultiple())
+	f.SetLocaleValue("fr", value.TypeText.Value("bonjour").AsMultiple())
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), f.LocaleValue("ja"))
+	assert.Len(t, f.Locales(), 2)
+
+	f.SetLocaleValue("fr", nil)
+	assert.Nil(t, f.LocaleValue("fr"))
+	assert.Equal(t, map[locale.Locale]*value.Multiple{"ja": value.TypeText.Value("こんにちは").AsMultiple()}, f.Locales())
+
+	assert.Nil(t, (*Field)(nil).Locales())
+	assert.Nil(t, (*Field)(nil).LocaleValue("ja"))
+}
+
+func TestField_ValueFor(t *testing.T) {
+	s := lo.Must(locale.NewSettings("en", locale.List{"ja", "fr", "de"}, map[locale.Locale]locale.Locale{"de": "fr"}))
+	f := NewField(id.NewFieldID(), value.TypeText.Value("hello").AsMultiple(), nil)
+	f.SetLocaleValue("ja", value.TypeText.Value("こんにちは").AsMultiple())
+	f.SetLocaleValue("fr", value.TypeText.Value("bonjour").AsMultiple())
+
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), f.ValueFor(s, "en"))
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), f.ValueFor(s, "ja"))
+	assert.Equal(t, value.TypeText.Value("bonjour").AsMultiple(), f.ValueFor(s, "de"))
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), f.ValueFor(s, "it"))
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), f.ValueFor(nil, "ja"))
+	assert.Nil(t, (*Field)(nil).ValueFor(s, "ja"))
+}
@@ -41,3 +41,37 @@ func (f Fields) FieldsByGroup(iid id.ItemGroupID) []*Field {
 		return f.ItemGroup() != nil && *f.ItemGroup() == iid
 	})
 }
+
+// Copy returns copies of the fields whose group instances have new IDs, so that the copies can be saved to another item without sharing the group instances with the original fields.
+func (f Fields) Copy() Fields {
+	groups := map[ItemGroupID]ItemGroupID{}
+	newGroup := func(g ItemGroupID) ItemGroupID {
+		if ng, ok := groups[g]; ok {
+			return ng
+		}
+		ng := id.NewItemGroupID()
+		groups[g] = ng
+		return ng
+	}
+
+	var res Fields
+	for _, field := range f {
+		if field == nil {
+			continue
+		}
+		v := field.Value().Clone()
+		if gs, ok := v.ValuesGroup(); ok && v.Type() == value.TypeGroup {
+			v = value.NewMultiple(value.TypeGroup, lo.Map(gs, func(g value.Group, _ int) any { return newGroup(g) }))
+		}
+		var g *ItemGroupID
+		if field.ItemGroup() != nil {
+			g = newGroup(*field.ItemGroup()).Ref()
+		}
+		nf := NewField(field.FieldID(), v, g)
+		for l, lv := range field.Locales() {
+			nf.SetLocaleValue(l, lv.Clone())
+		}
+		res = append(res, nf)
+	}
+	return res
+}
@@ -60,3 +60,39 @@ func TestFields_FieldsByGroup(t *testing.T) {
 
 	assert.Equal(t, 2, len(f.FieldsByGroup(ig)))
 }
+
+func TestFields_Copy(t *testing.T) {
+	fId1 := id.NewFieldID()
+	fId2 := id.NewFieldID()
+	fId3 := id.NewFieldID()
+	g1 := id.NewItemGroupID()
+	g2 := id.NewItemGroupID()
+
+	f1 := NewField(fId1, value.TypeText.Value("value1").AsMultiple(), nil)
+	f1.SetLocaleValue("ja", value.TypeText.Value("値1").AsMultiple())
+	f := Fields{
+		f1,
+		NewField(fId2, value.NewMultiple(value.TypeGroup, []any{g1, g2}), nil),
+		NewField(fId3, value.TypeText.Value("a").AsMultiple(), g1.Ref()),
+		NewField(fId3, value.TypeText.Value("b").AsMultiple(), g2.Ref()),
+		nil,
+	}
+
+	got := f.Copy()
+	assert.Len(t, got, 4)
+	assert.Equal(t, f[0], got[0])
+	assert.NotSame(t, f[0], got[0])
+
+	groups, ok := got[1].Value().ValuesGroup()
+	assert.True(t, ok)
+	assert.Len(t, groups, 2)
+	assert.NotContains(t, groups, g1)
+	assert.NotContains(t, groups, g2)
+	assert.Equal(t, groups[0], *got[2].ItemGroup())
+	assert.Equal(t, groups[1], *got[3].ItemGroup())
+	assert.Equal(t, "a", got[2].Value().First().Interface())
+
+	// the original fields are not changed
+	assert.Equal(t, g1, *f[2].ItemGroup())
+	assert.Nil(t, Fields(nil).Copy())
+}
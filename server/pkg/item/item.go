@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 
 	"github.com/reearth/reearth-cms/server/pkg/model"
 	"github.com/reearth/reearth-cms/server/pkg/schema"
@@ -202,6 +203,23 @@ func (i *Item) FilterFields(list FieldIDList) *Item {
 	return i
 }
 
+// Localize returns a copy of the item whose field values are resolved for the locale with the fallback chain of the settings.
+// The item itself is returned when the settings are nil.
+func (i *Item) Localize(s *locale.Settings, l locale.Locale) *Item {
+	if i == nil || s == nil {
+		return i
+	}
+
+	c := *i
+	c.fields = lo.Map(i.fields, func(f *Field, _ int) *Field {
+		if len(f.locales) == 0 {
+			return f
+		}
+		return NewField(f.field, f.ValueFor(s, l), f.group)
+	})
+	return &c
+}
+
 func (i *Item) HasField(fid FieldID, value any) bool {
 	for _, field := range i.fields {
 		if field.field == fid && field.value == value {
@@ -1,6 +1,7 @@
 package item
 
 import (
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/samber/lo"
 )
@@ -19,6 +20,15 @@ func (l List) FilterFields(lids FieldIDList) List {
 	})
 }
 
+func (l List) Localize(s *locale.Settings, loc locale.Locale) List {
+	if s == nil {
+		return l
+	}
+	return lo.Map(l, func(i *Item, _ int) *Item {
+		return i.Localize(s, loc)
+	})
+}
+
 func (l List) Item(iID ID) (*Item, bool) {
 	return lo.Find(l, func(i *Item) bool {
 		return i.ID() == iID
@@ -39,6 +49,15 @@ func (l VersionedList) FilterFields(fields FieldIDList) VersionedList {
 	})
 }
 
+func (l VersionedList) Localize(s *locale.Settings, loc locale.Locale) VersionedList {
+	if s == nil {
+		return l
+	}
+	return lo.Map(l, func(a Versioned, _ int) Versioned {
+		return version.ValueFrom(a, a.Value().Localize(s, loc))
+	})
+}
+
 func (l VersionedList) Unwrap() List {
 	if l == nil {
 		return nil
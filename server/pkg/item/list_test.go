@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -137,6 +139,36 @@ func TestVersionedList_FilterFields(t *testing.T) {
 	assert.Equal(t, vl.FilterFields(id.FieldIDList{fId}), vl.FilterFields(id.FieldIDList{fId}))
 }
 
+func TestVersionedList_Localize(t *testing.T) {
+	now := time.Now()
+	fId, fId2 := id.NewFieldID(), id.NewFieldID()
+	f := NewField(fId, value.TypeText.Value("hello").AsMultiple(), nil)
+	f.SetLocaleValue("ja", value.TypeText.Value("こんにちは").AsMultiple())
+	f2 := NewField(fId2, value.TypeBool.Value(true).AsMultiple(), nil)
+	i := New().NewID().
+		Schema(id.NewSchemaID()).
+		Model(id.NewModelID()).
+		Project(id.NewProjectID()).
+		Thread(id.NewThreadID().Ref()).
+		Fields([]*Field{f, f2}).
+		MustBuild()
+	vl := VersionedList{
+		version.MustBeValue(version.New(), nil, version.NewRefs(version.Latest), now, i),
+	}
+	s := lo.Must(locale.NewSettings("en", locale.List{"ja"}, nil))
+
+	got := vl.Localize(s, "ja")
+	assert.Equal(t, vl[0].Version(), got[0].Version())
+	assert.Equal(t, value.TypeText.Value("こんにちは").AsMultiple(), got[0].Value().Field(fId).Value())
+	assert.Same(t, f2, got[0].Value().Field(fId2))
+	// the original item is not changed
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), i.Field(fId).Value())
+
+	assert.Equal(t, value.TypeText.Value("hello").AsMultiple(), vl.Localize(s, "en")[0].Value().Field(fId).Value())
+	assert.Equal(t, vl, vl.Localize(nil, "ja"))
+	assert.Equal(t, List{i}.Localize(s, "ja")[0], got[0].Value())
+}
+
 func TestVersionedList_Item(t *testing.T) {
 	now := time.Now()
 	fId := id.NewFieldID()
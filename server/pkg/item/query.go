@@ -13,6 +13,7 @@ type Query struct {
 	model   id.ModelID
 	keyword string
 	ref     *version.Ref
+	ids     id.ItemIDList
 
 	sort   *view.Sort
 	filter *view.Condition
@@ -38,6 +39,21 @@ func (q *Query) WithFilter(filter *view.Condition) *Query {
 	return q
 }
 
+// WithIDs restricts the query to the items found by the search index for the keyword.
+// The keyword is not matched against the fields any more, and the items are ordered as the list when no sort is given.
+func (q *Query) WithIDs(ids id.ItemIDList) *Query {
+	q.ids = ids
+	if q.ids == nil {
+		q.ids = id.ItemIDList{}
+	}
+	return q
+}
+
+// IDs returns nil when the query is not restricted by WithIDs.
+func (q *Query) IDs() id.ItemIDList {
+	return q.ids
+}
+
 func (q *Query) Keyword() string {
 	return q.keyword
 }
@@ -54,6 +54,15 @@ func TestQuery_WithSort(t *testing.T) {
 	assert.Equal(t, s, q.Sort())
 }
 
+func TestQuery_WithIDs(t *testing.T) {
+	q := &Query{}
+	assert.Nil(t, q.IDs())
+	assert.Equal(t, q, q.WithIDs(nil))
+	assert.Equal(t, id.ItemIDList{}, q.IDs())
+	ids := id.ItemIDList{id.NewItemID()}
+	assert.Equal(t, ids, q.WithIDs(ids).IDs())
+}
+
 func TestQuery_WithFilter(t *testing.T) {
 	q := &Query{}
 	f := &view.Condition{}
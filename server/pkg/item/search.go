@@ -0,0 +1,41 @@
+package item
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/search"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+)
+
+const (
+	searchWeightTitle = 3
+	searchWeightField = 1
+)
+
+// SearchTerms returns the terms of the item for the full-text search index.
+// The text values of all fields and all locales are indexed, and the terms of the title field are weighted.
+func (i *Item) SearchTerms(title *FieldID) search.Terms {
+	if i == nil {
+		return nil
+	}
+
+	terms := search.NewTerms()
+	terms.Add(i.ID().String(), searchWeightField)
+	for _, f := range i.Fields() {
+		w := searchWeightField
+		if title != nil && f.FieldID() == *title && f.ItemGroup() == nil {
+			w = searchWeightTitle
+		}
+		addSearchTerms(terms, f.Value(), w)
+		for _, v := range f.Locales() {
+			addSearchTerms(terms, v, w)
+		}
+	}
+	return terms
+}
+
+func addSearchTerms(terms search.Terms, m *value.Multiple, w int) {
+	for _, v := range m.Values() {
+		if s, ok := v.ValueString(); ok {
+			terms.Add(s, w)
+		}
+	}
+}
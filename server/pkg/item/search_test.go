@@ -0,0 +1,33 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/search"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_SearchTerms(t *testing.T) {
+	fTitle, fBody, fNum := id.NewFieldID(), id.NewFieldID(), id.NewFieldID()
+	body := NewField(fBody, value.TypeTextArea.Value("tokyo weather").AsMultiple(), nil)
+	body.SetLocaleValue(locale.MustParse("ja"), value.TypeTextArea.Value("天気").AsMultiple())
+	i := New().NewID().Schema(id.NewSchemaID()).Model(id.NewModelID()).Project(id.NewProjectID()).Thread(id.NewThreadID().Ref()).
+		Fields([]*Field{
+			NewField(fTitle, value.TypeText.Value("Tokyo").AsMultiple(), nil),
+			body,
+			NewField(fNum, value.TypeInteger.Value(1).AsMultiple(), nil),
+		}).MustBuild()
+
+	want := search.Terms{"tokyo": 4, "weather": 1, "天気": 1, "気": 1}
+	want.Add(i.ID().String(), 1)
+	assert.Equal(t, want, i.SearchTerms(&fTitle))
+
+	want = search.Terms{"tokyo": 2, "weather": 1, "天気": 1, "気": 1}
+	want.Add(i.ID().String(), 1)
+	assert.Equal(t, want, i.SearchTerms(nil))
+
+	assert.Nil(t, (*Item)(nil).SearchTerms(nil))
+}
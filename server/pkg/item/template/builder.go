@@ -0,0 +1,86 @@
+package template
+
+import (
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/samber/lo"
+)
+
+type Builder struct {
+	t *Template
+}
+
+func New() *Builder {
+	return &Builder{t: &Template{}}
+}
+
+func (b *Builder) Build() (*Template, error) {
+	if b.t.id.IsNil() || b.t.project.IsNil() || b.t.model.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.t.name == "" {
+		return nil, ErrEmptyName
+	}
+	return b.t, nil
+}
+
+func (b *Builder) MustBuild() *Template {
+	return lo.Must(b.Build())
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.t.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.t.id = NewID()
+	return b
+}
+
+func (b *Builder) Project(project ProjectID) *Builder {
+	b.t.project = project
+	return b
+}
+
+func (b *Builder) Model(model ModelID) *Builder {
+	b.t.model = model
+	return b
+}
+
+func (b *Builder) Name(name string) *Builder {
+	b.t.name = strings.TrimSpace(name)
+	return b
+}
+
+func (b *Builder) Description(description string) *Builder {
+	b.t.description = description
+	return b
+}
+
+func (b *Builder) Fields(fields item.Fields) *Builder {
+	b.t.fields = fields
+	return b
+}
+
+func (b *Builder) MetadataFields(fields item.Fields) *Builder {
+	b.t.metadataFields = fields
+	return b
+}
+
+func (b *Builder) User(user *UserID) *Builder {
+	b.t.user = user.CloneRef()
+	return b
+}
+
+func (b *Builder) Integration(integration *IntegrationID) *Builder {
+	b.t.integration = integration.CloneRef()
+	return b
+}
+
+func (b *Builder) UpdatedAt(t time.Time) *Builder {
+	b.t.updatedAt = t
+	return b
+}
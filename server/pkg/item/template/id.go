@@ -0,0 +1,20 @@
+package template
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type ID = id.ItemTemplateID
+type IDList = id.ItemTemplateIDList
+type ProjectID = id.ProjectID
+type ModelID = id.ModelID
+type IntegrationID = id.IntegrationID
+type UserID = accountdomain.UserID
+
+var NewID = id.NewItemTemplateID
+var MustID = id.MustItemTemplateID
+var IDFrom = id.ItemTemplateIDFrom
+var IDFromRef = id.ItemTemplateIDFromRef
+
+var ErrInvalidID = id.ErrInvalidID
@@ -0,0 +1,21 @@
+package template
+
+import (
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+type List []*Template
+
+// SortByName sorts the templates by their names, and by their IDs when the names are the same.
+func (l List) SortByName() List {
+	m := slices.Clone(l)
+	slices.SortStableFunc(m, func(a, b *Template) int {
+		if c := strings.Compare(a.Name(), b.Name()); c != 0 {
+			return c
+		}
+		return a.ID().Compare(b.ID())
+	})
+	return m
+}
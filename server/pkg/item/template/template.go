@@ -0,0 +1,152 @@
+package template
+
+import (
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var ErrEmptyName = rerror.NewE(i18n.T("template name is required"))
+
+// Template holds the field values which pre-fill the items created from it.
+// The values of the group fields and their group instances are held in the same way as items.
+type Template struct {
+	id             ID
+	project        ProjectID
+	model          ModelID
+	name           string
+	description    string
+	fields         item.Fields
+	metadataFields item.Fields
+	user           *UserID
+	integration    *IntegrationID
+	updatedAt      time.Time
+}
+
+func (t *Template) ID() ID {
+	return t.id
+}
+
+func (t *Template) Project() ProjectID {
+	return t.project
+}
+
+func (t *Template) Model() ModelID {
+	return t.model
+}
+
+func (t *Template) Name() string {
+	return t.name
+}
+
+func (t *Template) Description() string {
+	return t.description
+}
+
+func (t *Template) Fields() item.Fields {
+	return t.fields
+}
+
+// MetadataFields returns the values of the metadata item of the items created from the template.
+func (t *Template) MetadataFields() item.Fields {
+	return t.metadataFields
+}
+
+func (t *Template) User() *UserID {
+	return t.user
+}
+
+func (t *Template) Integration() *IntegrationID {
+	return t.integration
+}
+
+func (t *Template) CreatedAt() time.Time {
+	return t.id.Timestamp()
+}
+
+func (t *Template) UpdatedAt() time.Time {
+	if t.updatedAt.IsZero() {
+		return t.id.Timestamp()
+	}
+	return t.updatedAt
+}
+
+func (t *Template) SetName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ErrEmptyName
+	}
+	t.name = name
+	return nil
+}
+
+func (t *Template) SetDescription(description string) {
+	t.description = description
+}
+
+func (t *Template) SetFields(fields item.Fields) {
+	t.fields = fields
+}
+
+func (t *Template) SetMetadataFields(fields item.Fields) {
+	t.metadataFields = fields
+}
+
+func (t *Template) SetUser(u UserID) {
+	t.user = u.Clone().Ref()
+	t.integration = nil
+}
+
+func (t *Template) SetIntegration(i IntegrationID) {
+	t.integration = i.Clone().Ref()
+	t.user = nil
+}
+
+func (t *Template) SetUpdatedAt(updatedAt time.Time) {
+	t.updatedAt = updatedAt
+}
+
+// Values returns copies of the fields and the metadata fields of the template to be saved to a new item.
+func (t *Template) Values() (item.Fields, item.Fields) {
+	return t.fields.Copy(), t.metadataFields.Copy()
+}
+
+func (t *Template) Clone() *Template {
+	if t == nil {
+		return nil
+	}
+	return &Template{
+		id:             t.id.Clone(),
+		project:        t.project.Clone(),
+		model:          t.model.Clone(),
+		name:           t.name,
+		description:    t.description,
+		fields:         cloneFields(t.fields),
+		metadataFields: cloneFields(t.metadataFields),
+		user:           t.user.CloneRef(),
+		integration:    t.integration.CloneRef(),
+		updatedAt:      t.updatedAt,
+	}
+}
+
+// cloneFields clones the fields keeping the IDs of the group instances unlike item.Fields.Copy.
+func cloneFields(fields item.Fields) item.Fields {
+	if fields == nil {
+		return nil
+	}
+	res := make(item.Fields, 0, len(fields))
+	for _, f := range fields {
+		if f == nil {
+			continue
+		}
+		nf := item.NewField(f.FieldID(), f.Value().Clone(), f.ItemGroup())
+		for l, v := range f.Locales() {
+			nf.SetLocaleValue(l, v.Clone())
+		}
+		res = append(res, nf)
+	}
+	return res
+}
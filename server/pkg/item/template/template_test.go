@@ -0,0 +1,89 @@
+package template
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/item"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder(t *testing.T) {
+	pid := id.NewProjectID()
+	mid := id.NewModelID()
+	uid := accountdomain.NewUserID()
+	now := time.Now()
+
+	tm, err := New().NewID().Project(pid).Model(mid).Name(" article ").Description("desc").User(&uid).UpdatedAt(now).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, pid, tm.Project())
+	assert.Equal(t, mid, tm.Model())
+	assert.Equal(t, "article", tm.Name())
+	assert.Equal(t, "desc", tm.Description())
+	assert.Equal(t, &uid, tm.User())
+	assert.Nil(t, tm.Integration())
+	assert.Equal(t, now, tm.UpdatedAt())
+	assert.Equal(t, tm.ID().Timestamp(), tm.CreatedAt())
+
+	_, err = New().Project(pid).Model(mid).Name("a").Build()
+	assert.Equal(t, ErrInvalidID, err)
+	_, err = New().NewID().Model(mid).Name("a").Build()
+	assert.Equal(t, ErrInvalidID, err)
+	_, err = New().NewID().Project(pid).Model(mid).Name(" ").Build()
+	assert.Equal(t, ErrEmptyName, err)
+	assert.Panics(t, func() { New().MustBuild() })
+}
+
+func TestTemplate(t *testing.T) {
+	fid := id.NewFieldID()
+	gid := id.NewFieldID()
+	g := id.NewItemGroupID()
+	fields := item.Fields{
+		item.NewField(fid, value.TypeText.Value("a").AsMultiple(), nil),
+		item.NewField(gid, value.NewMultiple(value.TypeGroup, []any{g}), nil),
+		item.NewField(fid, value.TypeText.Value("b").AsMultiple(), g.Ref()),
+	}
+	metadata := item.Fields{item.NewField(fid, value.TypeBool.Value(true).AsMultiple(), nil)}
+
+	tm := New().NewID().Project(id.NewProjectID()).Model(id.NewModelID()).Name("a").MustBuild()
+	assert.Equal(t, ErrEmptyName, tm.SetName(""))
+	assert.NoError(t, tm.SetName("b"))
+	assert.Equal(t, "b", tm.Name())
+	tm.SetDescription("d")
+	assert.Equal(t, "d", tm.Description())
+	tm.SetFields(fields)
+	tm.SetMetadataFields(metadata)
+	assert.Equal(t, fields, tm.Fields())
+	assert.Equal(t, metadata, tm.MetadataFields())
+
+	iid := id.NewIntegrationID()
+	tm.SetIntegration(iid)
+	assert.Equal(t, &iid, tm.Integration())
+	uid := accountdomain.NewUserID()
+	tm.SetUser(uid)
+	assert.Equal(t, &uid, tm.User())
+	assert.Nil(t, tm.Integration())
+
+	c := tm.Clone()
+	assert.Equal(t, tm, c)
+	assert.NotSame(t, tm.Fields()[0], c.Fields()[0])
+	assert.Nil(t, (*Template)(nil).Clone())
+
+	f, m := tm.Values()
+	assert.Len(t, f, 3)
+	assert.NotEqual(t, g, *f[2].ItemGroup())
+	assert.Equal(t, metadata, m)
+}
+
+func TestList_SortByName(t *testing.T) {
+	b := func(name string) *Template {
+		return New().NewID().Project(id.NewProjectID()).Model(id.NewModelID()).Name(name).MustBuild()
+	}
+	t1 := b("b")
+	t2 := b("a")
+	t3 := b("b")
+	assert.Equal(t, List{t2, t1, t3}, List{t3, t1, t2}.SortByName())
+}
@@ -0,0 +1,85 @@
+package item
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+// ValidateRules checks the values of the item against the validation rules of the schema fields and the schema.
+// The fields of each group are checked with the schema of the group which has the fields. The values of the locales are checked as well, and the compare rules fall back to the default values for the fields without the locale.
+// It returns schema.ValidationErrors which has all the violations, or nil when there is no violation.
+func (i *Item) ValidateRules(s *schema.Schema, groupSchemas schema.List) error {
+	errs := validateRules(s, lo.Filter(i.fields, func(f *Field, _ int) bool { return f.ItemGroup() == nil }))
+
+	var groups []ItemGroupID
+	groupFields := map[ItemGroupID][]*Field{}
+	for _, f := range i.fields {
+		if g := f.ItemGroup(); g != nil {
+			if _, ok := groupFields[*g]; !ok {
+				groups = append(groups, *g)
+			}
+			groupFields[*g] = append(groupFields[*g], f)
+		}
+	}
+	for _, g := range groups {
+		fields := groupFields[g]
+		gs, ok := lo.Find(groupSchemas, func(gs *schema.Schema) bool { return gs.HasField(fields[0].FieldID()) })
+		if ok {
+			errs = append(errs, validateRules(gs, fields)...)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func validateRules(s *schema.Schema, fields []*Field) schema.ValidationErrors {
+	var errs schema.ValidationErrors
+	values := make(map[schema.FieldID]*value.Multiple, len(fields))
+	var locales []locale.Locale
+	for _, f := range fields {
+		sf := s.Field(f.FieldID())
+		values[f.FieldID()] = f.Value()
+		errs = append(errs, sf.ValidateRules(f.Value())...)
+		for _, l := range slices.Sorted(maps.Keys(f.Locales())) {
+			errs = append(errs, withLocale(sf.ValidateRules(f.LocaleValue(l)), l)...)
+			if !slices.Contains(locales, l) {
+				locales = append(locales, l)
+			}
+		}
+	}
+
+	compareErrs := s.ValidateRules(values)
+	errs = append(errs, compareErrs...)
+
+	slices.Sort(locales)
+	for _, l := range locales {
+		localeValues := maps.Clone(values)
+		for _, f := range fields {
+			if v := f.LocaleValue(l); v != nil {
+				localeValues[f.FieldID()] = v
+			}
+		}
+		// the violations which are already reported for the default values are not repeated
+		errs = append(errs, withLocale(lo.Reject(s.ValidateRules(localeValues), func(e *schema.ValidationError, _ int) bool {
+			return lo.ContainsBy(compareErrs, func(d *schema.ValidationError) bool {
+				return d.Field == e.Field && d.Rule == e.Rule && d.Message == e.Message
+			})
+		}), l)...)
+	}
+	return errs
+}
+
+func withLocale(errs schema.ValidationErrors, l locale.Locale) schema.ValidationErrors {
+	for _, e := range errs {
+		e.Locale = string(l)
+	}
+	return errs
+}
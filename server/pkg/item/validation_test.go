@@ -0,0 +1,67 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestItem_ValidateRules(t *testing.T) {
+	lower := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("lower")).MustBuild()
+	upper := schema.NewField(lo.Must(schema.NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("upper")).MustBuild()
+	gf := schema.NewField(schema.NewGroup(id.NewGroupID()).TypeProperty()).NewID().Key(id.NewKey("group")).Multiple(true).MustBuild()
+	s := schema.New().
+		NewID().
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).
+		Fields(schema.FieldList{lower, upper, gf}).
+		ValidationRules([]*schema.ValidationRule{lo.Must(schema.NewCompareRule(upper.ID(), schema.CompareOperatorGreaterThanOrEqual, lower.ID(), ""))}).
+		MustBuild()
+
+	code := schema.NewField(schema.NewText(nil).TypeProperty()).
+		NewID().
+		Key(id.NewKey("code")).
+		ValidationRules([]*schema.ValidationRule{lo.Must(schema.NewPatternRule("^[0-9]+$", ""))}).
+		MustBuild()
+	gs := schema.New().NewID().Workspace(s.Workspace()).Project(s.Project()).Fields(schema.FieldList{code}).MustBuild()
+
+	g1, g2 := id.NewItemGroupID(), id.NewItemGroupID()
+	newItem := func(minValue, maxValue int64, codes ...string) *Item {
+		fields := []*Field{
+			NewField(lower.ID(), value.TypeInteger.Value(minValue).AsMultiple(), nil),
+			NewField(upper.ID(), value.TypeInteger.Value(maxValue).AsMultiple(), nil),
+			NewField(gf.ID(), value.NewMultiple(value.TypeGroup, []any{g1, g2}), nil),
+		}
+		for i, c := range codes {
+			fields = append(fields, NewField(code.ID(), value.TypeText.Value(c).AsMultiple(), []ItemGroupID{g1, g2}[i].Ref()))
+		}
+		return New().NewID().Schema(s.ID()).Model(id.NewModelID()).Project(s.Project()).Thread(id.NewThreadID().Ref()).Fields(fields).MustBuild()
+	}
+
+	assert.NoError(t, newItem(1, 2, "1", "2").ValidateRules(s, schema.List{gs}))
+
+	err := newItem(2, 1, "1", "x").ValidateRules(s, schema.List{gs})
+	assert.Equal(t, schema.ValidationErrors{
+		{Field: upper.ID(), Key: "upper", Rule: schema.ValidationRuleTypeCompare, Err: schema.ErrComparisonFailed},
+		{Field: code.ID(), Key: "code", Rule: schema.ValidationRuleTypePattern, Err: schema.ErrPatternMismatch},
+	}, err)
+
+	// the fields of groups are not checked without the schemas of the groups
+	assert.NoError(t, newItem(1, 2, "x").ValidateRules(s, nil))
+
+	// the values of the locales are checked, and the compare rules fall back to the default values
+	it := newItem(1, 2, "1")
+	it.Field(lower.ID()).SetLocaleValue("ja", value.TypeInteger.Value(int64(3)).AsMultiple())
+	it.FieldByItemGroupAndID(code.ID(), g1).SetLocaleValue("en", value.TypeText.Value("x").AsMultiple())
+	err = it.ValidateRules(s, schema.List{gs})
+	assert.Equal(t, schema.ValidationErrors{
+		{Field: upper.ID(), Key: "upper", Locale: "ja", Rule: schema.ValidationRuleTypeCompare, Err: schema.ErrComparisonFailed},
+		{Field: code.ID(), Key: "code", Locale: "en", Rule: schema.ValidationRuleTypePattern, Err: schema.ErrPatternMismatch},
+	}, err)
+	assert.EqualError(t, err, "upper (ja): values do not satisfy the comparison; code (en): value does not match the pattern")
+}
@@ -0,0 +1,70 @@
+package locale
+
+import (
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"golang.org/x/text/language"
+)
+
+var ErrInvalidLocale = rerror.NewE(i18n.T("invalid locale"))
+
+// Locale is a BCP 47 language tag such as "en" or "ja-JP".
+type Locale string
+
+type List []Locale
+
+func Parse(s string) (Locale, error) {
+	t, err := language.Parse(s)
+	if err != nil || t == language.Und {
+		return "", ErrInvalidLocale
+	}
+	return Locale(t.String()), nil
+}
+
+func MustParse(s string) Locale {
+	l, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+// ParseRef parses s and returns nil when s is empty or invalid.
+func ParseRef(s *string) *Locale {
+	if s == nil || *s == "" {
+		return nil
+	}
+	l, err := Parse(*s)
+	if err != nil {
+		return nil
+	}
+	return &l
+}
+
+func (l Locale) String() string {
+	return string(l)
+}
+
+func (l Locale) Ref() *Locale {
+	return &l
+}
+
+func (l List) Has(locale Locale) bool {
+	for _, m := range l {
+		if m == locale {
+			return true
+		}
+	}
+	return false
+}
+
+func (l List) Strings() []string {
+	if l == nil {
+		return nil
+	}
+	res := make([]string, 0, len(l))
+	for _, m := range l {
+		res = append(res, m.String())
+	}
+	return res
+}
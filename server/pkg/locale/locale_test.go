@@ -0,0 +1,40 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	l, err := Parse("en")
+	assert.NoError(t, err)
+	assert.Equal(t, Locale("en"), l)
+
+	l, err = Parse("ja-jp")
+	assert.NoError(t, err)
+	assert.Equal(t, Locale("ja-JP"), l)
+
+	_, err = Parse("")
+	assert.Equal(t, ErrInvalidLocale, err)
+
+	_, err = Parse("not a locale")
+	assert.Equal(t, ErrInvalidLocale, err)
+}
+
+func TestParseRef(t *testing.T) {
+	assert.Nil(t, ParseRef(nil))
+	assert.Nil(t, ParseRef(new(string)))
+	s := "x!"
+	assert.Nil(t, ParseRef(&s))
+	s = "ja"
+	assert.Equal(t, Locale("ja").Ref(), ParseRef(&s))
+}
+
+func TestList(t *testing.T) {
+	l := List{"en", "ja"}
+	assert.True(t, l.Has("ja"))
+	assert.False(t, l.Has("fr"))
+	assert.Equal(t, []string{"en", "ja"}, l.Strings())
+	assert.Nil(t, List(nil).Strings())
+}
@@ -0,0 +1,96 @@
+package locale
+
+import (
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+var ErrInvalidFallback = rerror.NewE(i18n.T("fallback locale must be one of the locales"))
+
+// Settings holds the locales that can be used for the localized values of a project.
+// Values of the default locale are stored as the base values of the fields.
+type Settings struct {
+	defaultLocale Locale
+	locales       List
+	fallbacks     map[Locale]Locale
+}
+
+func NewSettings(defaultLocale Locale, locales List, fallbacks map[Locale]Locale) (*Settings, error) {
+	if defaultLocale == "" {
+		return nil, ErrInvalidLocale
+	}
+	if !slices.Contains(locales, defaultLocale) {
+		locales = append(List{defaultLocale}, locales...)
+	}
+	for from, to := range fallbacks {
+		if !locales.Has(from) || !locales.Has(to) || from == to {
+			return nil, ErrInvalidFallback
+		}
+	}
+	return &Settings{
+		defaultLocale: defaultLocale,
+		locales:       lo.Uniq(locales),
+		fallbacks:     maps.Clone(fallbacks),
+	}, nil
+}
+
+func (s *Settings) Default() Locale {
+	if s == nil {
+		return ""
+	}
+	return s.defaultLocale
+}
+
+func (s *Settings) Locales() List {
+	if s == nil {
+		return nil
+	}
+	return slices.Clone(s.locales)
+}
+
+func (s *Settings) Fallbacks() map[Locale]Locale {
+	if s == nil {
+		return nil
+	}
+	return maps.Clone(s.fallbacks)
+}
+
+func (s *Settings) Has(l Locale) bool {
+	return s != nil && s.locales.Has(l)
+}
+
+// IsDefault returns true when values of the locale are stored as the base values.
+func (s *Settings) IsDefault(l Locale) bool {
+	return s == nil || l == "" || l == s.defaultLocale
+}
+
+// Chain returns the locales to look up in order to resolve a value of the locale.
+// It starts with the locale, follows the fallbacks and ends with the default locale.
+func (s *Settings) Chain(l Locale) List {
+	if s == nil {
+		return nil
+	}
+
+	var res List
+	for cur := l; cur != "" && s.locales.Has(cur) && !res.Has(cur); cur = s.fallbacks[cur] {
+		res = append(res, cur)
+	}
+	if !res.Has(s.defaultLocale) {
+		res = append(res, s.defaultLocale)
+	}
+	return res
+}
+
+func (s *Settings) Clone() *Settings {
+	if s == nil {
+		return nil
+	}
+	return &Settings{
+		defaultLocale: s.defaultLocale,
+		locales:       slices.Clone(s.locales),
+		fallbacks:     maps.Clone(s.fallbacks),
+	}
+}
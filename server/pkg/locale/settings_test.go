@@ -0,0 +1,52 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSettings(t *testing.T) {
+	s, err := NewSettings("en", List{"ja", "fr"}, map[Locale]Locale{"fr": "ja"})
+	assert.NoError(t, err)
+	assert.Equal(t, Locale("en"), s.Default())
+	assert.Equal(t, List{"en", "ja", "fr"}, s.Locales())
+	assert.Equal(t, map[Locale]Locale{"fr": "ja"}, s.Fallbacks())
+
+	_, err = NewSettings("", nil, nil)
+	assert.Equal(t, ErrInvalidLocale, err)
+
+	_, err = NewSettings("en", List{"ja"}, map[Locale]Locale{"fr": "ja"})
+	assert.Equal(t, ErrInvalidFallback, err)
+
+	_, err = NewSettings("en", List{"ja"}, map[Locale]Locale{"ja": "ja"})
+	assert.Equal(t, ErrInvalidFallback, err)
+}
+
+func TestSettings_Chain(t *testing.T) {
+	s, _ := NewSettings("en", List{"ja", "fr", "de"}, map[Locale]Locale{"fr": "de", "de": "fr", "ja": "en"})
+
+	assert.Equal(t, List{"en"}, s.Chain("en"))
+	assert.Equal(t, List{"ja", "en"}, s.Chain("ja"))
+	assert.Equal(t, List{"fr", "de", "en"}, s.Chain("fr"))
+	assert.Equal(t, List{"en"}, s.Chain("it"))
+	assert.Nil(t, (*Settings)(nil).Chain("en"))
+}
+
+func TestSettings_IsDefault(t *testing.T) {
+	s, _ := NewSettings("en", List{"ja"}, nil)
+	assert.True(t, s.IsDefault("en"))
+	assert.True(t, s.IsDefault(""))
+	assert.False(t, s.IsDefault("ja"))
+	assert.True(t, (*Settings)(nil).IsDefault("ja"))
+	assert.True(t, s.Has("ja"))
+	assert.False(t, s.Has("fr"))
+}
+
+func TestSettings_Clone(t *testing.T) {
+	s, _ := NewSettings("en", List{"ja"}, map[Locale]Locale{"ja": "en"})
+	c := s.Clone()
+	assert.Equal(t, s, c)
+	assert.NotSame(t, s, c)
+	assert.Nil(t, (*Settings)(nil).Clone())
+}
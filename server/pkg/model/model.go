@@ -13,7 +13,7 @@ import (
 
 var (
 	ErrInvalidKey = rerror.NewE(i18n.T("invalid key"))
-	ngKeys        = []string{"assets", "schemas", "models", "items"}
+	ngKeys        = []string{"assets", "schemas", "models", "items", "graphql"}
 )
 
 type Model struct {
@@ -130,6 +130,6 @@ func (p *Model) Clone() *Model {
 }
 
 func validateModelKey(k id.Key) bool {
-	// assets is used as an API endpoint
+	// assets and graphql are used as API endpoints
 	return k.IsURLCompatible() && len(k.String()) > 2 && !slices.Contains(ngKeys, k.String())
 }
@@ -340,6 +340,15 @@ func TestModel_SetKey(t *testing.T) {
 				Err:   fmt.Errorf("%s", "assets"),
 			},
 		},
+		{
+			name: "fails graphql",
+			args: args{key: id.NewKey("graphql")},
+			want: Model{},
+			wantErr: &rerror.Error{
+				Label: ErrInvalidKey,
+				Err:   fmt.Errorf("%s", "graphql"),
+			},
+		},
 		{
 			name: "fails items",
 			args: args{key: id.NewKey("items")},
@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+)
+
+type Builder struct {
+	n *Notification
+}
+
+func New() *Builder {
+	return &Builder{n: &Notification{}}
+}
+
+func (b *Builder) Build() (*Notification, error) {
+	if b.n.id.IsNil() || b.n.user.IsNil() || b.n.workspace.IsNil() || b.n.project.IsNil() || b.n.request.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if _, ok := TypeFrom(string(b.n.ty)); !ok {
+		return nil, ErrInvalidType
+	}
+	return b.n, nil
+}
+
+func (b *Builder) MustBuild() *Notification {
+	n, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.n.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.n.id = NewID()
+	return b
+}
+
+func (b *Builder) User(u UserID) *Builder {
+	b.n.user = u
+	return b
+}
+
+func (b *Builder) Workspace(w WorkspaceID) *Builder {
+	b.n.workspace = w
+	return b
+}
+
+func (b *Builder) Project(p ProjectID) *Builder {
+	b.n.project = p
+	return b
+}
+
+func (b *Builder) Request(r RequestID) *Builder {
+	b.n.request = r
+	return b
+}
+
+func (b *Builder) Type(t Type) *Builder {
+	b.n.ty = t
+	return b
+}
+
+func (b *Builder) Actor(o operator.Operator) *Builder {
+	b.n.actor = o
+	return b
+}
+
+func (b *Builder) Comment(c *CommentID) *Builder {
+	b.n.comment = c.CloneRef()
+	return b
+}
+
+func (b *Builder) ReadAt(t *time.Time) *Builder {
+	b.n.readAt = t
+	return b
+}
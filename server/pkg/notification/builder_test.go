@@ -0,0 +1,36 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	b := func() *Builder {
+		return New().
+			NewID().
+			User(accountdomain.NewUserID()).
+			Workspace(accountdomain.NewWorkspaceID()).
+			Project(id.NewProjectID()).
+			Request(id.NewRequestID()).
+			Type(TypeReviewRequested)
+	}
+
+	n, err := b().Build()
+	assert.NoError(t, err)
+	assert.NotNil(t, n)
+
+	_, err = b().ID(ID{}).Build()
+	assert.Equal(t, ErrInvalidID, err)
+
+	_, err = b().User(UserID{}).Build()
+	assert.Equal(t, ErrInvalidID, err)
+
+	_, err = b().Type("xxx").Build()
+	assert.Equal(t, ErrInvalidType, err)
+
+	assert.Panics(t, func() { b().Type("xxx").MustBuild() })
+}
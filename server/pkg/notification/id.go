@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type ID = id.NotificationID
+type IDList = id.NotificationIDList
+type UserID = accountdomain.UserID
+type WorkspaceID = accountdomain.WorkspaceID
+type ProjectID = id.ProjectID
+type RequestID = id.RequestID
+type CommentID = id.CommentID
+
+var NewID = id.NewNotificationID
+var MustID = id.MustNotificationID
+var IDFrom = id.NotificationIDFrom
+var IDFromRef = id.NotificationIDFromRef
+
+var ErrInvalidID = id.ErrInvalidID
@@ -0,0 +1,133 @@
+package notification
+
+import (
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var ErrInvalidType = rerror.NewE(i18n.T("invalid notification type"))
+
+type Type string
+
+const (
+	TypeReviewRequested  Type = "review_requested"
+	TypeRequestApproved  Type = "request_approved"
+	TypeChangesRequested Type = "changes_requested"
+	TypeRequestClosed    Type = "request_closed"
+	TypeRequestComment   Type = "request_comment"
+)
+
+var Types = []Type{
+	TypeReviewRequested,
+	TypeRequestApproved,
+	TypeChangesRequested,
+	TypeRequestClosed,
+	TypeRequestComment,
+}
+
+func TypeFrom(s string) (Type, bool) {
+	for _, t := range Types {
+		if string(t) == s {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+func (t Type) String() string {
+	return string(t)
+}
+
+// Notification tells a user about an activity on a request which the user reviews or has created.
+type Notification struct {
+	id        ID
+	user      UserID
+	workspace WorkspaceID
+	project   ProjectID
+	request   RequestID
+	ty        Type
+	actor     operator.Operator
+	comment   *CommentID
+	readAt    *time.Time
+}
+
+func (n *Notification) ID() ID {
+	return n.id
+}
+
+// User is the recipient of the notification.
+func (n *Notification) User() UserID {
+	return n.user
+}
+
+func (n *Notification) Workspace() WorkspaceID {
+	return n.workspace
+}
+
+func (n *Notification) Project() ProjectID {
+	return n.project
+}
+
+func (n *Notification) Request() RequestID {
+	return n.request
+}
+
+func (n *Notification) Type() Type {
+	return n.ty
+}
+
+// Actor is the operator who caused the notification.
+func (n *Notification) Actor() operator.Operator {
+	return n.actor
+}
+
+func (n *Notification) Comment() *CommentID {
+	return n.comment.CloneRef()
+}
+
+func (n *Notification) CreatedAt() time.Time {
+	return n.id.Timestamp()
+}
+
+func (n *Notification) ReadAt() *time.Time {
+	if n.readAt == nil {
+		return nil
+	}
+	t := *n.readAt
+	return &t
+}
+
+func (n *Notification) IsRead() bool {
+	return n.readAt != nil
+}
+
+// MarkRead marks the notification as read. A notification which is already read keeps the first time.
+func (n *Notification) MarkRead(t time.Time) {
+	if n.readAt == nil {
+		n.readAt = &t
+	}
+}
+
+type List []*Notification
+
+func (l List) IDs() IDList {
+	ids := make(IDList, 0, len(l))
+	for _, n := range l {
+		ids = append(ids, n.ID())
+	}
+	return ids
+}
+
+// SortByNewest returns a copy of the list ordered from the newest notification.
+func (l List) SortByNewest() List {
+	res := slices.Clone(l)
+	slices.SortStableFunc(res, func(a, b *Notification) int {
+		return b.ID().Compare(a.ID())
+	})
+	return res
+}
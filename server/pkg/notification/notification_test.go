@@ -0,0 +1,69 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/operator"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeFrom(t *testing.T) {
+	res, ok := TypeFrom("request_approved")
+	assert.True(t, ok)
+	assert.Equal(t, TypeRequestApproved, res)
+
+	res, ok = TypeFrom("xxx")
+	assert.False(t, ok)
+	assert.Equal(t, Type(""), res)
+}
+
+func TestNotification(t *testing.T) {
+	nid := NewID()
+	uid := accountdomain.NewUserID()
+	actor := operator.OperatorFromUser(accountdomain.NewUserID())
+	cid := id.NewCommentID()
+	n := New().
+		ID(nid).
+		User(uid).
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).
+		Request(id.NewRequestID()).
+		Type(TypeRequestComment).
+		Actor(actor).
+		Comment(&cid).
+		MustBuild()
+
+	assert.Equal(t, nid, n.ID())
+	assert.Equal(t, uid, n.User())
+	assert.Equal(t, TypeRequestComment, n.Type())
+	assert.Equal(t, actor, n.Actor())
+	assert.Equal(t, &cid, n.Comment())
+	assert.Equal(t, nid.Timestamp(), n.CreatedAt())
+	assert.False(t, n.IsRead())
+	assert.Nil(t, n.ReadAt())
+
+	now := time.Now()
+	n.MarkRead(now)
+	assert.True(t, n.IsRead())
+	assert.Equal(t, &now, n.ReadAt())
+
+	// the first time is kept
+	n.MarkRead(now.Add(time.Hour))
+	assert.Equal(t, &now, n.ReadAt())
+
+	assert.Equal(t, IDList{nid}, List{n}.IDs())
+}
+
+func TestList_SortByNewest(t *testing.T) {
+	n1 := New().ID(MustID("01fzxycwmq7n84eq5y2tsqrm1a")).User(accountdomain.NewUserID()).Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).Request(id.NewRequestID()).Type(TypeRequestApproved).MustBuild()
+	n2 := New().ID(MustID("01g0sbvrfj26jvrk8x8tcmmnc2")).User(accountdomain.NewUserID()).Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).Request(id.NewRequestID()).Type(TypeRequestApproved).MustBuild()
+	l := List{n1, n2}
+
+	assert.Equal(t, List{n2, n1}, l.SortByNewest())
+	assert.Equal(t, List{n1, n2}, l)
+}
@@ -0,0 +1,53 @@
+package notification
+
+// Setting tells through which channels a user receives a type of notifications.
+type Setting struct {
+	Email bool
+	InApp bool
+}
+
+var DefaultSetting = Setting{Email: true, InApp: true}
+
+// Preference is the notification settings of a user. Types without a setting use DefaultSetting.
+type Preference struct {
+	user     UserID
+	settings map[Type]Setting
+}
+
+func NewPreference(u UserID) *Preference {
+	return &Preference{user: u, settings: map[Type]Setting{}}
+}
+
+func (p *Preference) User() UserID {
+	return p.user
+}
+
+func (p *Preference) Setting(t Type) Setting {
+	if p == nil {
+		return DefaultSetting
+	}
+	if s, ok := p.settings[t]; ok {
+		return s
+	}
+	return DefaultSetting
+}
+
+// Settings returns the settings of all types.
+func (p *Preference) Settings() map[Type]Setting {
+	res := make(map[Type]Setting, len(Types))
+	for _, t := range Types {
+		res[t] = p.Setting(t)
+	}
+	return res
+}
+
+func (p *Preference) SetSetting(t Type, s Setting) error {
+	if _, ok := TypeFrom(string(t)); !ok {
+		return ErrInvalidType
+	}
+	if p.settings == nil {
+		p.settings = map[Type]Setting{}
+	}
+	p.settings[t] = s
+	return nil
+}
@@ -0,0 +1,26 @@
+package notification
+
+import (
+	"testing"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreference(t *testing.T) {
+	uid := accountdomain.NewUserID()
+	p := NewPreference(uid)
+	assert.Equal(t, uid, p.User())
+	assert.Equal(t, DefaultSetting, p.Setting(TypeRequestApproved))
+
+	assert.NoError(t, p.SetSetting(TypeRequestApproved, Setting{Email: false, InApp: true}))
+	assert.Equal(t, Setting{Email: false, InApp: true}, p.Setting(TypeRequestApproved))
+	assert.Equal(t, DefaultSetting, p.Setting(TypeRequestClosed))
+	assert.Equal(t, len(Types), len(p.Settings()))
+	assert.Equal(t, Setting{Email: false, InApp: true}, p.Settings()[TypeRequestApproved])
+
+	assert.Equal(t, ErrInvalidType, p.SetSetting("xxx", DefaultSetting))
+
+	var np *Preference
+	assert.Equal(t, DefaultSetting, np.Setting(TypeRequestApproved))
+}
@@ -0,0 +1,74 @@
+package preview
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+type Builder struct {
+	t *Token
+}
+
+func New() *Builder {
+	return &Builder{t: &Token{}}
+}
+
+func (b *Builder) Build() (*Token, error) {
+	if b.t.id.IsNil() || b.t.workspace.IsNil() || b.t.project.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if b.t.expiresAt.IsZero() {
+		return nil, ErrInvalidExpiration
+	}
+	return b.t, nil
+}
+
+func (b *Builder) MustBuild() *Token {
+	return lo.Must(b.Build())
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.t.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.t.id = NewID()
+	return b
+}
+
+func (b *Builder) Workspace(workspace WorkspaceID) *Builder {
+	b.t.workspace = workspace
+	return b
+}
+
+func (b *Builder) Project(project ProjectID) *Builder {
+	b.t.project = project
+	return b
+}
+
+func (b *Builder) Request(request *RequestID) *Builder {
+	b.t.request = request.CloneRef()
+	return b
+}
+
+func (b *Builder) User(user *UserID) *Builder {
+	b.t.user = user.CloneRef()
+	return b
+}
+
+func (b *Builder) Integration(integration *IntegrationID) *Builder {
+	b.t.integration = integration.CloneRef()
+	return b
+}
+
+func (b *Builder) ExpiresAt(t time.Time) *Builder {
+	b.t.expiresAt = t
+	return b
+}
+
+func (b *Builder) RevokedAt(t *time.Time) *Builder {
+	b.t.revokedAt = t
+	return b
+}
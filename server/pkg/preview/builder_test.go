@@ -0,0 +1,59 @@
+package preview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	wid := NewWorkspaceID()
+	pid := NewProjectID()
+	exp := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		b       *Builder
+		wantErr error
+	}{
+		{
+			name: "success",
+			b:    New().NewID().Workspace(wid).Project(pid).ExpiresAt(exp),
+		},
+		{
+			name:    "no id",
+			b:       New().Workspace(wid).Project(pid).ExpiresAt(exp),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "no workspace",
+			b:       New().NewID().Project(pid).ExpiresAt(exp),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "no project",
+			b:       New().NewID().Workspace(wid).ExpiresAt(exp),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "no expiration",
+			b:       New().NewID().Workspace(wid).Project(pid),
+			wantErr: ErrInvalidExpiration,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.b.Build()
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, exp, got.ExpiresAt())
+			assert.Nil(t, got.RevokedAt())
+		})
+	}
+}
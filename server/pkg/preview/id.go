@@ -0,0 +1,25 @@
+package preview
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type ID = id.PreviewTokenID
+type IDList = id.PreviewTokenIDList
+type WorkspaceID = accountdomain.WorkspaceID
+type ProjectID = id.ProjectID
+type RequestID = id.RequestID
+type IntegrationID = id.IntegrationID
+type UserID = accountdomain.UserID
+
+var NewID = id.NewPreviewTokenID
+var NewWorkspaceID = accountdomain.NewWorkspaceID
+var NewProjectID = id.NewProjectID
+var NewRequestID = id.NewRequestID
+var NewUserID = accountdomain.NewUserID
+var MustID = id.MustPreviewTokenID
+var IDFrom = id.PreviewTokenIDFrom
+var IDFromRef = id.PreviewTokenIDFromRef
+
+var ErrInvalidID = id.ErrInvalidID
@@ -0,0 +1,3 @@
+package preview
+
+type List []*Token
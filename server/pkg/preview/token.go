@@ -0,0 +1,179 @@
+package preview
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var (
+	ErrInvalidToken      = rerror.NewE(i18n.T("invalid preview token"))
+	ErrInvalidExpiration = rerror.NewE(i18n.T("invalid expiration of the preview token"))
+	ErrExpired           = rerror.NewE(i18n.T("the preview token has expired"))
+	ErrRevoked           = rerror.NewE(i18n.T("the preview token has been revoked"))
+)
+
+const (
+	// DefaultTTL is the lifetime of a token when it is not specified.
+	DefaultTTL = time.Hour
+	// MaxTTL is the longest lifetime of a token.
+	MaxTTL = 7 * 24 * time.Hour
+
+	tokenPrefix    = "preview_"
+	tokenSeparator = "."
+)
+
+// Token is a short-lived token to preview the draft content of a project, or the items of a request, with the public API.
+// The token string is signed with a secret of the server, and the token is stored to be revoked.
+type Token struct {
+	id          ID
+	workspace   WorkspaceID
+	project     ProjectID
+	request     *RequestID
+	user        *UserID
+	integration *IntegrationID
+	expiresAt   time.Time
+	revokedAt   *time.Time
+}
+
+func (t *Token) ID() ID {
+	return t.id
+}
+
+func (t *Token) Workspace() WorkspaceID {
+	return t.workspace
+}
+
+func (t *Token) Project() ProjectID {
+	return t.project
+}
+
+// Request returns the request which the token is scoped to. The token previews the whole project when it is nil.
+func (t *Token) Request() *RequestID {
+	return t.request.CloneRef()
+}
+
+func (t *Token) User() *UserID {
+	return t.user.CloneRef()
+}
+
+func (t *Token) Integration() *IntegrationID {
+	return t.integration.CloneRef()
+}
+
+func (t *Token) CreatedAt() time.Time {
+	return t.id.Timestamp()
+}
+
+func (t *Token) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *Token) RevokedAt() *time.Time {
+	return t.revokedAt
+}
+
+// Ref returns the ref which items are read from: the ref of the request, or the latest ref.
+func (t *Token) Ref() version.Ref {
+	if t.request != nil {
+		return request.Ref(*t.request)
+	}
+	return version.Latest
+}
+
+func (t *Token) IsExpired(now time.Time) bool {
+	return !now.Before(t.expiresAt)
+}
+
+func (t *Token) IsRevoked() bool {
+	return t.revokedAt != nil
+}
+
+func (t *Token) Revoke(now time.Time) {
+	if t.revokedAt == nil {
+		t.revokedAt = &now
+	}
+}
+
+// Sign returns the token string signed with the secret, which is sent as a bearer token to the public API.
+func (t *Token) Sign(secret []byte) string {
+	return tokenPrefix + t.id.String() + tokenSeparator + t.signature(secret)
+}
+
+// Verify returns an error when the token string is not the one signed for the token with the secret, or the token is no longer valid.
+func (t *Token) Verify(token string, secret []byte, now time.Time) error {
+	_, sig, ok := parse(token)
+	if !ok || len(secret) == 0 || !hmac.Equal([]byte(sig), []byte(t.signature(secret))) {
+		return ErrInvalidToken
+	}
+	if t.IsRevoked() {
+		return ErrRevoked
+	}
+	if t.IsExpired(now) {
+		return ErrExpired
+	}
+	return nil
+}
+
+func (t *Token) signature(secret []byte) string {
+	var rid string
+	if t.request != nil {
+		rid = t.request.String()
+	}
+	payload := strings.Join([]string{
+		t.id.String(),
+		t.project.String(),
+		rid,
+		strconv.FormatInt(t.expiresAt.Unix(), 10),
+	}, tokenSeparator)
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// IsToken returns true when the string looks like a preview token string.
+func IsToken(token string) bool {
+	return strings.HasPrefix(token, tokenPrefix)
+}
+
+// ParseID returns the ID of the token from the token string to find the token.
+func ParseID(token string) (ID, error) {
+	tid, _, ok := parse(token)
+	if !ok {
+		return ID{}, ErrInvalidToken
+	}
+	res, err := IDFrom(tid)
+	if err != nil {
+		return ID{}, ErrInvalidToken
+	}
+	return res, nil
+}
+
+func parse(token string) (string, string, bool) {
+	s, ok := strings.CutPrefix(token, tokenPrefix)
+	if !ok {
+		return "", "", false
+	}
+	tid, sig, ok := strings.Cut(s, tokenSeparator)
+	return tid, sig, ok && tid != "" && sig != ""
+}
+
+// ExpiresAt returns the expiration of a token issued at the time with the TTL, which defaults to DefaultTTL.
+func ExpiresAt(now time.Time, ttl *time.Duration) (time.Time, error) {
+	if ttl == nil {
+		return now.Add(DefaultTTL), nil
+	}
+	if *ttl <= 0 || *ttl > MaxTTL {
+		return time.Time{}, ErrInvalidExpiration
+	}
+	return now.Add(*ttl), nil
+}
@@ -0,0 +1,88 @@
+package preview
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/request"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+var secret = []byte("secret")
+
+func TestToken_Ref(t *testing.T) {
+	rid := NewRequestID()
+	tk := New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).ExpiresAt(time.Now()).MustBuild()
+	assert.Equal(t, version.Latest, tk.Ref())
+
+	tk = New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Request(&rid).ExpiresAt(time.Now()).MustBuild()
+	assert.Equal(t, request.Ref(rid), tk.Ref())
+	assert.Equal(t, &rid, tk.Request())
+}
+
+func TestToken_Revoke(t *testing.T) {
+	now := time.Now()
+	tk := New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).ExpiresAt(now.Add(time.Hour)).MustBuild()
+	assert.False(t, tk.IsRevoked())
+
+	tk.Revoke(now)
+	assert.True(t, tk.IsRevoked())
+	assert.Equal(t, &now, tk.RevokedAt())
+
+	// the first revocation is kept
+	tk.Revoke(now.Add(time.Minute))
+	assert.Equal(t, &now, tk.RevokedAt())
+}
+
+func TestToken_Verify(t *testing.T) {
+	now := time.Now()
+	rid := NewRequestID()
+	tk := New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Request(&rid).ExpiresAt(now.Add(time.Hour)).MustBuild()
+	s := tk.Sign(secret)
+
+	assert.True(t, IsToken(s))
+	assert.False(t, IsToken("secret_xxx"))
+	assert.Equal(t, lo.Must(ParseID(s)), tk.ID())
+
+	assert.NoError(t, tk.Verify(s, secret, now))
+	assert.Equal(t, ErrInvalidToken, tk.Verify(s, []byte("other"), now))
+	assert.Equal(t, ErrInvalidToken, tk.Verify(s, nil, now))
+	assert.Equal(t, ErrInvalidToken, tk.Verify(s+"x", secret, now))
+	assert.Equal(t, ErrInvalidToken, tk.Verify("preview_"+tk.ID().String(), secret, now))
+	assert.Equal(t, ErrExpired, tk.Verify(s, secret, now.Add(time.Hour)))
+
+	// the signature is bound to the scope and the expiration of the token
+	other := New().ID(tk.ID()).Workspace(tk.Workspace()).Project(tk.Project()).ExpiresAt(tk.ExpiresAt()).MustBuild()
+	assert.Equal(t, ErrInvalidToken, other.Verify(s, secret, now))
+	extended := New().ID(tk.ID()).Workspace(tk.Workspace()).Project(tk.Project()).Request(&rid).ExpiresAt(now.Add(2 * time.Hour)).MustBuild()
+	assert.Equal(t, ErrInvalidToken, extended.Verify(s, secret, now))
+
+	tk.Revoke(now)
+	assert.Equal(t, ErrRevoked, tk.Verify(s, secret, now))
+}
+
+func TestParseID(t *testing.T) {
+	tid := NewID()
+	got, err := ParseID("preview_" + tid.String() + ".sig")
+	assert.NoError(t, err)
+	assert.Equal(t, tid, got)
+
+	for _, s := range []string{"", "preview_", "preview_" + tid.String(), "secret_" + tid.String() + ".sig", "preview_xxx.sig"} {
+		_, err := ParseID(s)
+		assert.Equal(t, ErrInvalidToken, err, s)
+	}
+}
+
+func TestExpiresAt(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, now.Add(DefaultTTL), lo.Must(ExpiresAt(now, nil)))
+	assert.Equal(t, now.Add(time.Minute), lo.Must(ExpiresAt(now, lo.ToPtr(time.Minute))))
+	assert.Equal(t, now.Add(MaxTTL), lo.Must(ExpiresAt(now, lo.ToPtr(MaxTTL))))
+
+	for _, ttl := range []time.Duration{0, -time.Minute, MaxTTL + time.Second} {
+		_, err := ExpiresAt(now, &ttl)
+		assert.Equal(t, ErrInvalidExpiration, err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"golang.org/x/exp/slices"
@@ -90,7 +92,17 @@ func (b *Builder) Publication(publication *Publication) *Builder {
 	return b
 }
 
+func (b *Builder) Locales(l *locale.Settings) *Builder {
+	b.p.locales = l.Clone()
+	return b
+}
+
 func (b *Builder) RequestRoles(requestRoles []workspace.Role) *Builder {
 	b.p.requestRoles = slices.Clone(requestRoles)
 	return b
 }
+
+func (b *Builder) ApprovalPolicy(ap *request.ApprovalPolicy) *Builder {
+	b.p.approvalPolicy = ap.Clone()
+	return b
+}
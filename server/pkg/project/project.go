@@ -5,6 +5,8 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
 	"github.com/reearth/reearthx/i18n"
@@ -19,15 +21,17 @@ var (
 )
 
 type Project struct {
-	id           ID
-	workspaceID  accountdomain.WorkspaceID
-	name         string
-	description  string
-	alias        string
-	imageURL     *url.URL
-	updatedAt    time.Time
-	publication  *Publication
-	requestRoles []workspace.Role
+	id             ID
+	workspaceID    accountdomain.WorkspaceID
+	name           string
+	description    string
+	alias          string
+	imageURL       *url.URL
+	updatedAt      time.Time
+	publication    *Publication
+	requestRoles   []workspace.Role
+	locales        *locale.Settings
+	approvalPolicy *request.ApprovalPolicy
 }
 
 func (p *Project) ID() ID {
@@ -75,6 +79,16 @@ func (p *Project) RequestRoles() []workspace.Role {
 	return p.requestRoles
 }
 
+// Locales returns the locales of the localized field values, or nil when localization is disabled.
+func (p *Project) Locales() *locale.Settings {
+	return p.locales
+}
+
+// ApprovalPolicy returns the policy to approve requests, or nil when one approval of any reviewer is enough.
+func (p *Project) ApprovalPolicy() *request.ApprovalPolicy {
+	return p.approvalPolicy
+}
+
 func (p *Project) SetUpdatedAt(updatedAt time.Time) {
 	p.updatedAt = updatedAt
 }
@@ -105,6 +119,14 @@ func (p *Project) SetRequestRoles(sr []workspace.Role) {
 	p.requestRoles = slices.Clone(sr)
 }
 
+func (p *Project) SetLocales(l *locale.Settings) {
+	p.locales = l.Clone()
+}
+
+func (p *Project) SetApprovalPolicy(ap *request.ApprovalPolicy) {
+	p.approvalPolicy = ap.Clone()
+}
+
 func (p *Project) UpdateAlias(alias string) error {
 	if CheckAliasPattern(alias) {
 		p.alias = alias
@@ -124,15 +146,17 @@ func (p *Project) Clone() *Project {
 	}
 
 	return &Project{
-		id:           p.id.Clone(),
-		workspaceID:  p.workspaceID.Clone(),
-		name:         p.name,
-		description:  p.description,
-		alias:        p.alias,
-		imageURL:     util.CopyURL(p.imageURL),
-		updatedAt:    p.updatedAt,
-		publication:  p.publication.Clone(),
-		requestRoles: p.requestRoles,
+		id:             p.id.Clone(),
+		workspaceID:    p.workspaceID.Clone(),
+		name:           p.name,
+		description:    p.description,
+		alias:          p.alias,
+		imageURL:       util.CopyURL(p.imageURL),
+		updatedAt:      p.updatedAt,
+		publication:    p.publication.Clone(),
+		requestRoles:   p.requestRoles,
+		locales:        p.locales.Clone(),
+		approvalPolicy: p.approvalPolicy.Clone(),
 	}
 }
 
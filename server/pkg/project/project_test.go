@@ -5,8 +5,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/locale"
+	"github.com/reearth/reearth-cms/server/pkg/request"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -112,6 +115,16 @@ func TestProject_SetRequestRoles(t *testing.T) {
 	assert.Equal(t, p.RequestRoles(), r)
 }
 
+func TestProject_SetLocales(t *testing.T) {
+	p := &Project{}
+	l := lo.Must(locale.NewSettings("en", locale.List{"ja"}, nil))
+	p.SetLocales(l)
+	assert.Equal(t, l, p.Locales())
+	assert.NotSame(t, l, p.Locales())
+	p.SetLocales(nil)
+	assert.Nil(t, p.Locales())
+}
+
 func TestProject_UpdateAlias(t *testing.T) {
 	tests := []struct {
 		name, a  string
@@ -147,14 +160,28 @@ func TestProject_UpdateAlias(t *testing.T) {
 	}
 }
 
+func TestProject_SetApprovalPolicy(t *testing.T) {
+	p := &Project{}
+	ap := lo.Must(request.NewApprovalPolicy(2, nil, nil, false))
+	p.SetApprovalPolicy(ap)
+	assert.Equal(t, ap, p.ApprovalPolicy())
+	assert.NotSame(t, ap, p.ApprovalPolicy())
+	p.SetApprovalPolicy(nil)
+	assert.Nil(t, p.ApprovalPolicy())
+}
+
 func TestProject_Clone(t *testing.T) {
 	pub := &Publication{}
 	r := []workspace.Role{workspace.RoleOwner, workspace.RoleMaintainer}
-	p := New().NewID().Name("a").Publication(pub).RequestRoles(r).MustBuild()
+	l := lo.Must(locale.NewSettings("en", locale.List{"ja"}, nil))
+	ap := lo.Must(request.NewApprovalPolicy(2, nil, nil, true))
+	p := New().NewID().Name("a").Publication(pub).RequestRoles(r).Locales(l).ApprovalPolicy(ap).MustBuild()
 
 	got := p.Clone()
 	assert.Equal(t, p, got)
 	assert.NotSame(t, p, got)
 	assert.NotSame(t, p, got.publication)
+	assert.NotSame(t, p.locales, got.locales)
+	assert.NotSame(t, p.approvalPolicy, got.approvalPolicy)
 	assert.Nil(t, (*Project)(nil).Clone())
 }
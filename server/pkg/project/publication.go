@@ -18,6 +18,8 @@ type Publication struct {
 	scope       PublicationScope
 	assetPublic bool
 	token       string
+	// cacheMaxAge is the max age in seconds of the public api responses that can be cached by clients and CDNs
+	cacheMaxAge int
 }
 
 func NewPublication(scope PublicationScope, assetPublic bool) *Publication {
@@ -47,6 +49,10 @@ func (p *Publication) AssetPublic() bool {
 	return p.assetPublic
 }
 
+func (p *Publication) CacheMaxAge() int {
+	return p.cacheMaxAge
+}
+
 func (p *Publication) Token() string {
 	return p.token
 }
@@ -70,6 +76,13 @@ func (p *Publication) SetAssetPublic(assetPublic bool) {
 	p.assetPublic = assetPublic
 }
 
+func (p *Publication) SetCacheMaxAge(maxAge int) {
+	if maxAge < 0 {
+		maxAge = 0
+	}
+	p.cacheMaxAge = maxAge
+}
+
 func (p *Publication) Clone() *Publication {
 	if p == nil {
 		return nil
@@ -79,5 +92,6 @@ func (p *Publication) Clone() *Publication {
 		scope:       p.scope,
 		assetPublic: p.assetPublic,
 		token:       p.token,
+		cacheMaxAge: p.cacheMaxAge,
 	}
 }
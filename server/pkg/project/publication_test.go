@@ -117,3 +117,12 @@ func TestNewPublicationWithToken(t *testing.T) {
 		assetPublic: true,
 	}, NewPublicationWithToken("", true, ""))
 }
+
+func TestPublication_SetCacheMaxAge(t *testing.T) {
+	p := &Publication{}
+	p.SetCacheMaxAge(60)
+	assert.Equal(t, 60, p.CacheMaxAge())
+
+	p.SetCacheMaxAge(-1)
+	assert.Equal(t, 0, p.CacheMaxAge())
+}
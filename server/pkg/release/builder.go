@@ -0,0 +1,82 @@
+package release
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+type Builder struct {
+	r *Release
+}
+
+func New() *Builder {
+	return &Builder{r: &Release{state: StateDraft}}
+}
+
+func (b *Builder) Build() (*Release, error) {
+	if b.r.id.IsNil() || b.r.workspace.IsNil() || b.r.project.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if err := b.r.SetName(b.r.name); err != nil {
+		return nil, err
+	}
+	if _, ok := StateFrom(string(b.r.state)); !ok {
+		b.r.state = StateDraft
+	}
+	return b.r, nil
+}
+
+func (b *Builder) MustBuild() *Release {
+	return lo.Must(b.Build())
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.r.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.r.id = NewID()
+	return b
+}
+
+func (b *Builder) Workspace(workspace WorkspaceID) *Builder {
+	b.r.workspace = workspace
+	return b
+}
+
+func (b *Builder) Project(project ProjectID) *Builder {
+	b.r.project = project
+	return b
+}
+
+func (b *Builder) Name(name string) *Builder {
+	b.r.name = name
+	return b
+}
+
+func (b *Builder) Description(description string) *Builder {
+	b.r.description = description
+	return b
+}
+
+func (b *Builder) State(state State) *Builder {
+	b.r.state = state
+	return b
+}
+
+func (b *Builder) Items(items ItemList) *Builder {
+	b.r.items = items
+	return b
+}
+
+func (b *Builder) PublishedAt(t *time.Time) *Builder {
+	b.r.publishedAt = t
+	return b
+}
+
+func (b *Builder) UpdatedAt(t time.Time) *Builder {
+	b.r.updatedAt = t
+	return b
+}
@@ -0,0 +1,57 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	wid := NewWorkspaceID()
+	pid := NewProjectID()
+
+	tests := []struct {
+		name    string
+		b       *Builder
+		wantErr error
+	}{
+		{
+			name: "success",
+			b:    New().NewID().Workspace(wid).Project(pid).Name("2026-spring-campaign"),
+		},
+		{
+			name:    "no id",
+			b:       New().Workspace(wid).Project(pid).Name("spring"),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "no workspace",
+			b:       New().NewID().Project(pid).Name("spring"),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "no project",
+			b:       New().NewID().Workspace(wid).Name("spring"),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "invalid name",
+			b:       New().NewID().Workspace(wid).Project(pid).Name("spring campaign"),
+			wantErr: ErrInvalidName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.b.Build()
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, StateDraft, got.State())
+			assert.Equal(t, got.CreatedAt(), got.UpdatedAt())
+		})
+	}
+}
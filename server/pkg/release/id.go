@@ -0,0 +1,23 @@
+package release
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type ID = id.ReleaseID
+type IDList = id.ReleaseIDList
+type ProjectID = id.ProjectID
+type ItemID = id.ItemID
+type WorkspaceID = accountdomain.WorkspaceID
+
+var NewID = id.NewReleaseID
+var NewProjectID = id.NewProjectID
+var NewItemID = id.NewItemID
+var NewWorkspaceID = accountdomain.NewWorkspaceID
+
+var MustID = id.MustReleaseID
+var IDFrom = id.ReleaseIDFrom
+var IDFromRef = id.ReleaseIDFromRef
+
+var ErrInvalidID = id.ErrInvalidID
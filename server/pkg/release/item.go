@@ -0,0 +1,61 @@
+package release
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+type ItemList []*Item
+
+// Item is an item version which is staged in a release.
+type Item struct {
+	item     ItemID
+	version  version.Version
+	previous *version.Version
+}
+
+func NewItem(i ItemID, v version.Version) (*Item, error) {
+	if i.IsNil() {
+		return nil, ErrInvalidID
+	}
+	return &Item{
+		item:    i,
+		version: v,
+	}, nil
+}
+
+// NewItemWithPrevious is used to restore items of published releases.
+func NewItemWithPrevious(i ItemID, v version.Version, previous *version.Version) (*Item, error) {
+	it, err := NewItem(i, v)
+	if err != nil {
+		return nil, err
+	}
+	it.previous = util.CloneRef(previous)
+	return it, nil
+}
+
+func (i *Item) Item() ItemID {
+	return i.item
+}
+
+// Version returns the version of the item which is published by the release.
+func (i *Item) Version() version.Version {
+	return i.version
+}
+
+// Previous returns the version which was public before the release was published.
+// It is nil when the release has not been published or the item was not public.
+func (i *Item) Previous() *version.Version {
+	return util.CloneRef(i.previous)
+}
+
+func (l ItemList) IDs() id.ItemIDList {
+	return lo.Map(l, func(i *Item, _ int) ItemID { return i.Item() })
+}
+
+func (l ItemList) Find(i ItemID) *Item {
+	res, _ := lo.Find(l, func(it *Item) bool { return it.Item() == i })
+	return res
+}
@@ -0,0 +1,37 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewItem(t *testing.T) {
+	iid := NewItemID()
+	v := version.New()
+
+	got, err := NewItem(iid, v)
+	assert.NoError(t, err)
+	assert.Equal(t, iid, got.Item())
+	assert.Equal(t, v, got.Version())
+	assert.Nil(t, got.Previous())
+
+	_, err = NewItem(ItemID{}, v)
+	assert.Equal(t, ErrInvalidID, err)
+
+	prev := version.New()
+	got, err = NewItemWithPrevious(iid, v, &prev)
+	assert.NoError(t, err)
+	assert.Equal(t, &prev, got.Previous())
+}
+
+func TestItemList(t *testing.T) {
+	iid1, iid2 := NewItemID(), NewItemID()
+	l := ItemList{lo.Must(NewItem(iid1, version.New())), lo.Must(NewItem(iid2, version.New()))}
+	assert.Equal(t, id.ItemIDList{iid1, iid2}, l.IDs())
+	assert.Equal(t, l[1], l.Find(iid2))
+	assert.Nil(t, l.Find(NewItemID()))
+}
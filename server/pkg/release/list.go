@@ -0,0 +1,16 @@
+package release
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+type List []*Release
+
+// SortByCreatedAt sorts the releases from the most recently created.
+func (l List) SortByCreatedAt() List {
+	m := slices.Clone(l)
+	slices.SortStableFunc(m, func(a, b *Release) int {
+		return b.ID().Compare(a.ID())
+	})
+	return m
+}
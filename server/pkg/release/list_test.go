@@ -0,0 +1,16 @@
+package release
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_SortByCreatedAt(t *testing.T) {
+	wid, pid := NewWorkspaceID(), NewProjectID()
+	r1 := New().NewID().Workspace(wid).Project(pid).Name("r1").MustBuild()
+	r2 := New().NewID().Workspace(wid).Project(pid).Name("r2").MustBuild()
+	l := List{r1, r2}
+	assert.Equal(t, List{r2, r1}, l.SortByCreatedAt())
+	assert.Equal(t, List{r1, r2}, l)
+}
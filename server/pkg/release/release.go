@@ -0,0 +1,156 @@
+package release
+
+import (
+	"strings"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrInvalidName      = rerror.NewE(i18n.T("invalid release name"))
+	ErrAlreadyPublished = rerror.NewE(i18n.T("the release has already been published"))
+	ErrNotPublished     = rerror.NewE(i18n.T("the release has not been published"))
+	ErrEmpty            = rerror.NewE(i18n.T("the release has no items"))
+)
+
+const refKind = "release"
+
+// Ref returns the ref of mongogit which the item versions staged in the release are pointed by.
+func Ref(rid ID) version.Ref {
+	return version.StagingRef(refKind, rid.String())
+}
+
+// IsRef returns true when the ref is a ref of a release.
+func IsRef(r version.Ref) bool {
+	return strings.HasPrefix(r.String(), version.StagingRef(refKind, "").String())
+}
+
+// Release is a named set of item versions across models which are published together.
+type Release struct {
+	id          ID
+	workspace   WorkspaceID
+	project     ProjectID
+	name        string
+	description string
+	state       State
+	items       ItemList
+	publishedAt *time.Time
+	updatedAt   time.Time
+}
+
+func (r *Release) ID() ID {
+	return r.id
+}
+
+func (r *Release) Workspace() WorkspaceID {
+	return r.workspace
+}
+
+func (r *Release) Project() ProjectID {
+	return r.project
+}
+
+// Name returns the unique name of the release in the project which is used to preview the release with the public API.
+func (r *Release) Name() string {
+	return r.name
+}
+
+func (r *Release) Description() string {
+	return r.description
+}
+
+func (r *Release) State() State {
+	return r.state
+}
+
+func (r *Release) Items() ItemList {
+	return r.items
+}
+
+func (r *Release) PublishedAt() *time.Time {
+	return r.publishedAt
+}
+
+func (r *Release) CreatedAt() time.Time {
+	return r.id.Timestamp()
+}
+
+func (r *Release) UpdatedAt() time.Time {
+	if r.updatedAt.IsZero() {
+		return r.id.Timestamp()
+	}
+	return r.updatedAt
+}
+
+func (r *Release) Ref() version.Ref {
+	return Ref(r.id)
+}
+
+func (r *Release) SetName(name string) error {
+	if !id.NewKey(name).IsURLCompatible() {
+		return ErrInvalidName
+	}
+	r.name = name
+	return nil
+}
+
+func (r *Release) SetDescription(description string) {
+	r.description = description
+}
+
+func (r *Release) SetUpdatedAt(t time.Time) {
+	r.updatedAt = t
+}
+
+// AddItem stages the version of the item. The version staged before is replaced.
+func (r *Release) AddItem(it *Item) error {
+	if r.state == StatePublished {
+		return ErrAlreadyPublished
+	}
+	if it == nil {
+		return nil
+	}
+	r.items = append(lo.Reject(r.items, func(i *Item, _ int) bool { return i.Item() == it.Item() }), it)
+	return nil
+}
+
+func (r *Release) RemoveItem(i ItemID) error {
+	if r.state == StatePublished {
+		return ErrAlreadyPublished
+	}
+	r.items = lo.Reject(r.items, func(it *Item, _ int) bool { return it.Item() == i })
+	return nil
+}
+
+// Publish marks the release as published and records the public versions of the items before the release so that it can be rolled back.
+func (r *Release) Publish(previous map[ItemID]*version.Version, t time.Time) error {
+	if r.state == StatePublished {
+		return ErrAlreadyPublished
+	}
+	if len(r.items) == 0 {
+		return ErrEmpty
+	}
+	for _, it := range r.items {
+		it.previous = util.CloneRef(previous[it.Item()])
+	}
+	r.state = StatePublished
+	r.publishedAt = lo.ToPtr(t)
+	r.updatedAt = t
+	return nil
+}
+
+// Rollback marks the published release as rolled back. The items of the release can be changed and published again.
+func (r *Release) Rollback(t time.Time) error {
+	if r.state != StatePublished {
+		return ErrNotPublished
+	}
+	r.state = StateRolledBack
+	r.updatedAt = t
+	return nil
+}
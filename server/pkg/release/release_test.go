@@ -0,0 +1,71 @@
+package release
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/version"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRef(t *testing.T) {
+	rid := NewID()
+	assert.Equal(t, version.Ref("release:"+rid.String()), Ref(rid))
+	assert.True(t, IsRef(Ref(rid)))
+	assert.False(t, IsRef(version.Public))
+	assert.False(t, IsRef(version.Latest))
+}
+
+func TestRelease_SetName(t *testing.T) {
+	r := New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Name("spring").MustBuild()
+	assert.NoError(t, r.SetName("autumn"))
+	assert.Equal(t, "autumn", r.Name())
+	assert.Equal(t, ErrInvalidName, r.SetName(""))
+	assert.Equal(t, ErrInvalidName, r.SetName("a/b"))
+	assert.Equal(t, "autumn", r.Name())
+}
+
+func TestRelease_Items(t *testing.T) {
+	r := New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Name("spring").MustBuild()
+	iid1, iid2 := NewItemID(), NewItemID()
+	v1, v2, v3 := version.New(), version.New(), version.New()
+
+	assert.NoError(t, r.AddItem(lo.Must(NewItem(iid1, v1))))
+	assert.NoError(t, r.AddItem(lo.Must(NewItem(iid2, v2))))
+	// the staged version is replaced
+	assert.NoError(t, r.AddItem(lo.Must(NewItem(iid1, v3))))
+	assert.Equal(t, ItemList{lo.Must(NewItem(iid2, v2)), lo.Must(NewItem(iid1, v3))}, r.Items())
+
+	assert.NoError(t, r.RemoveItem(iid2))
+	assert.Equal(t, ItemList{lo.Must(NewItem(iid1, v3))}, r.Items())
+}
+
+func TestRelease_PublishAndRollback(t *testing.T) {
+	r := New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Name("spring").MustBuild()
+	now := time.Now().Truncate(time.Millisecond)
+	iid1, iid2 := NewItemID(), NewItemID()
+	v1, v2, prev := version.New(), version.New(), version.New()
+
+	assert.Equal(t, ErrNotPublished, r.Rollback(now))
+	assert.Equal(t, ErrEmpty, r.Publish(nil, now))
+
+	assert.NoError(t, r.AddItem(lo.Must(NewItem(iid1, v1))))
+	assert.NoError(t, r.AddItem(lo.Must(NewItem(iid2, v2))))
+	assert.NoError(t, r.Publish(map[ItemID]*version.Version{iid1: &prev}, now))
+	assert.Equal(t, StatePublished, r.State())
+	assert.Equal(t, &now, r.PublishedAt())
+	assert.Equal(t, now, r.UpdatedAt())
+	assert.Equal(t, &prev, r.Items().Find(iid1).Previous())
+	assert.Nil(t, r.Items().Find(iid2).Previous())
+
+	// published releases can not be changed
+	assert.Equal(t, ErrAlreadyPublished, r.Publish(nil, now))
+	assert.Equal(t, ErrAlreadyPublished, r.AddItem(lo.Must(NewItem(NewItemID(), version.New()))))
+	assert.Equal(t, ErrAlreadyPublished, r.RemoveItem(iid1))
+
+	assert.NoError(t, r.Rollback(now.Add(time.Second)))
+	assert.Equal(t, StateRolledBack, r.State())
+	assert.Equal(t, now.Add(time.Second), r.UpdatedAt())
+	assert.NoError(t, r.RemoveItem(iid1))
+}
@@ -0,0 +1,17 @@
+package release
+
+type State string
+
+const (
+	StateDraft      State = "draft"
+	StatePublished  State = "published"
+	StateRolledBack State = "rolled_back"
+)
+
+func StateFrom(s string) (State, bool) {
+	switch t := State(s); t {
+	case StateDraft, StatePublished, StateRolledBack:
+		return t, true
+	}
+	return "", false
+}
@@ -0,0 +1,153 @@
+package request
+
+import (
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	ErrInvalidMinApprovals = rerror.NewE(i18n.T("minimum approvals must be at least 1"))
+	ErrInvalidRequiredRole = rerror.NewE(i18n.T("invalid required role"))
+)
+
+// ApprovalPolicy decides when a request of a project is approved and its items are published.
+// Without a policy, a request is approved by one approval of any reviewer.
+type ApprovalPolicy struct {
+	minApprovals      int
+	requiredReviewers UserIDList
+	requiredRoles     []workspace.Role
+	resetOnChange     bool
+}
+
+func NewApprovalPolicy(minApprovals int, requiredReviewers UserIDList, requiredRoles []workspace.Role, resetOnChange bool) (*ApprovalPolicy, error) {
+	if minApprovals < 1 {
+		return nil, ErrInvalidMinApprovals
+	}
+	for _, r := range requiredRoles {
+		if !r.Valid() {
+			return nil, ErrInvalidRequiredRole
+		}
+	}
+	return &ApprovalPolicy{
+		minApprovals:      minApprovals,
+		requiredReviewers: slices.Clone(requiredReviewers),
+		requiredRoles:     slices.Clone(requiredRoles),
+		resetOnChange:     resetOnChange,
+	}, nil
+}
+
+// MinApprovals is the number of reviewers who have to approve a request.
+func (p *ApprovalPolicy) MinApprovals() int {
+	if p == nil {
+		return 1
+	}
+	return p.minApprovals
+}
+
+// RequiredReviewers are the users who have to approve every request in addition to MinApprovals.
+func (p *ApprovalPolicy) RequiredReviewers() UserIDList {
+	if p == nil {
+		return nil
+	}
+	return slices.Clone(p.requiredReviewers)
+}
+
+// RequiredRoles are the roles in the workspace which every request needs an approval from in addition to MinApprovals.
+// Each role needs its own reviewer who has the role or a higher one, so a role listed twice needs two reviewers.
+func (p *ApprovalPolicy) RequiredRoles() []workspace.Role {
+	if p == nil {
+		return nil
+	}
+	return slices.Clone(p.requiredRoles)
+}
+
+// ResetOnChange tells whether the reviews of a request are cleared when its items are changed.
+func (p *ApprovalPolicy) ResetOnChange() bool {
+	return p != nil && p.resetOnChange
+}
+
+// IsSatisfied returns true when the request has enough approvals from its reviewers and no reviewer requests changes.
+// roleOf returns the role of a reviewer in the workspace, and is used only when the policy has required roles.
+func (p *ApprovalPolicy) IsSatisfied(r *Request, roleOf func(UserID) workspace.Role) bool {
+	if r == nil {
+		return false
+	}
+
+	approvals := UserIDList{}
+	for _, rv := range r.Reviews() {
+		if !r.Reviewers().Has(rv.Reviewer()) {
+			continue
+		}
+		if rv.Decision() == DecisionChangesRequested {
+			return false
+		}
+		if rv.Decision() == DecisionApproved {
+			approvals = append(approvals, rv.Reviewer())
+		}
+	}
+
+	for _, u := range p.RequiredReviewers() {
+		if !approvals.Has(u) {
+			return false
+		}
+	}
+	if !p.hasRequiredRoles(approvals, roleOf) {
+		return false
+	}
+	return len(approvals) >= p.MinApprovals()
+}
+
+// hasRequiredRoles assigns a distinct approver to each required role.
+// Higher roles are assigned first and take the approver with the lowest role which includes them, so no assignment blocks a later one.
+func (p *ApprovalPolicy) hasRequiredRoles(approvals UserIDList, roleOf func(UserID) workspace.Role) bool {
+	required := p.RequiredRoles()
+	if len(required) == 0 {
+		return true
+	}
+	if roleOf == nil {
+		return false
+	}
+
+	slices.SortStableFunc(required, func(a, b workspace.Role) int { return roleRank(a) - roleRank(b) })
+	used := make([]bool, len(approvals))
+	for _, role := range required {
+		best := -1
+		for k, u := range approvals {
+			if used[k] || !roleOf(u).Includes(role) {
+				continue
+			}
+			if best < 0 || roleRank(roleOf(u)) > roleRank(roleOf(approvals[best])) {
+				best = k
+			}
+		}
+		if best < 0 {
+			return false
+		}
+		used[best] = true
+	}
+	return true
+}
+
+// roleRank orders the roles from the highest.
+func roleRank(r workspace.Role) int {
+	for k, r2 := range []workspace.Role{workspace.RoleOwner, workspace.RoleMaintainer, workspace.RoleWriter, workspace.RoleReader} {
+		if r == r2 {
+			return k
+		}
+	}
+	return -1
+}
+
+func (p *ApprovalPolicy) Clone() *ApprovalPolicy {
+	if p == nil {
+		return nil
+	}
+	return &ApprovalPolicy{
+		minApprovals:      p.minApprovals,
+		requiredReviewers: slices.Clone(p.requiredReviewers),
+		requiredRoles:     slices.Clone(p.requiredRoles),
+		resetOnChange:     p.resetOnChange,
+	}
+}
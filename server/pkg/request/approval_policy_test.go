@@ -0,0 +1,130 @@
+package request
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/account/accountdomain/workspace"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewApprovalPolicy(t *testing.T) {
+	u := accountdomain.NewUserID()
+	required := UserIDList{u}
+	p, err := NewApprovalPolicy(2, required, []workspace.Role{workspace.RoleOwner}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, p.MinApprovals())
+	assert.Equal(t, required, p.RequiredReviewers())
+	assert.Equal(t, []workspace.Role{workspace.RoleOwner}, p.RequiredRoles())
+	assert.True(t, p.ResetOnChange())
+
+	p, err = NewApprovalPolicy(1, nil, []workspace.Role{"admin"}, false)
+	assert.Equal(t, ErrInvalidRequiredRole, err)
+	assert.Nil(t, p)
+
+	p, err = NewApprovalPolicy(0, nil, nil, false)
+	assert.Equal(t, ErrInvalidMinApprovals, err)
+	assert.Nil(t, p)
+
+	// nil policy is one approval of any reviewer
+	assert.Equal(t, 1, p.MinApprovals())
+	assert.Nil(t, p.RequiredReviewers())
+	assert.Nil(t, p.RequiredRoles())
+	assert.False(t, p.ResetOnChange())
+}
+
+func TestApprovalPolicy_IsSatisfied(t *testing.T) {
+	u1, u2, u3 := accountdomain.NewUserID(), accountdomain.NewUserID(), accountdomain.NewUserID()
+	roles := map[UserID]workspace.Role{u1: workspace.RoleMaintainer, u2: workspace.RoleOwner, u3: workspace.RoleMaintainer}
+	now := time.Now()
+	approve := func(u UserID) *Review { return NewReview(u, DecisionApproved, now) }
+	reject := func(u UserID) *Review { return NewReview(u, DecisionChangesRequested, now) }
+
+	tests := []struct {
+		name    string
+		policy  *ApprovalPolicy
+		reviews []*Review
+		want    bool
+	}{
+		{name: "nil policy without reviews", want: false},
+		{name: "nil policy", reviews: []*Review{approve(u1)}, want: true},
+		{name: "changes requested", reviews: []*Review{approve(u1), reject(u2)}, want: false},
+		{name: "not a reviewer", reviews: []*Review{approve(accountdomain.NewUserID())}, want: false},
+		{
+			name:    "not enough approvals",
+			policy:  &ApprovalPolicy{minApprovals: 2},
+			reviews: []*Review{approve(u1)},
+			want:    false,
+		},
+		{
+			name:    "enough approvals",
+			policy:  &ApprovalPolicy{minApprovals: 2},
+			reviews: []*Review{approve(u1), approve(u3)},
+			want:    true,
+		},
+		{
+			name:    "required reviewer missing",
+			policy:  &ApprovalPolicy{minApprovals: 1, requiredReviewers: UserIDList{u2}},
+			reviews: []*Review{approve(u1), approve(u3)},
+			want:    false,
+		},
+		{
+			name:    "required reviewer approved",
+			policy:  &ApprovalPolicy{minApprovals: 2, requiredReviewers: UserIDList{u2}},
+			reviews: []*Review{approve(u1), approve(u2)},
+			want:    true,
+		},
+		{
+			name:    "required role missing",
+			policy:  &ApprovalPolicy{minApprovals: 1, requiredRoles: []workspace.Role{workspace.RoleOwner}},
+			reviews: []*Review{approve(u1), approve(u3)},
+			want:    false,
+		},
+		{
+			name:    "required role approved",
+			policy:  &ApprovalPolicy{minApprovals: 1, requiredRoles: []workspace.Role{workspace.RoleOwner}},
+			reviews: []*Review{approve(u2)},
+			want:    true,
+		},
+		{
+			name:    "higher role includes the required role",
+			policy:  &ApprovalPolicy{minApprovals: 1, requiredRoles: []workspace.Role{workspace.RoleMaintainer}},
+			reviews: []*Review{approve(u2)},
+			want:    true,
+		},
+		{
+			name:    "each required role needs its own reviewer",
+			policy:  &ApprovalPolicy{minApprovals: 1, requiredRoles: []workspace.Role{workspace.RoleMaintainer, workspace.RoleOwner}},
+			reviews: []*Review{approve(u2)},
+			want:    false,
+		},
+		{
+			name:    "required roles assigned to reviewers",
+			policy:  &ApprovalPolicy{minApprovals: 1, requiredRoles: []workspace.Role{workspace.RoleMaintainer, workspace.RoleOwner}},
+			reviews: []*Review{approve(u2), approve(u1)},
+			want:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := &Request{reviewers: UserIDList{u1, u2, u3}, reviews: tt.reviews}
+			assert.Equal(t, tt.want, tt.policy.IsSatisfied(r, func(u UserID) workspace.Role { return roles[u] }))
+		})
+	}
+
+	assert.False(t, (&ApprovalPolicy{minApprovals: 1}).IsSatisfied(nil, nil))
+	// roles are unknown
+	r := &Request{reviewers: UserIDList{u2}, reviews: []*Review{approve(u2)}}
+	assert.False(t, (&ApprovalPolicy{minApprovals: 1, requiredRoles: []workspace.Role{workspace.RoleOwner}}).IsSatisfied(r, nil))
+}
+
+func TestApprovalPolicy_Clone(t *testing.T) {
+	p := &ApprovalPolicy{minApprovals: 2, requiredReviewers: UserIDList{accountdomain.NewUserID()}, requiredRoles: []workspace.Role{workspace.RoleOwner}, resetOnChange: true}
+	got := p.Clone()
+	assert.Equal(t, p, got)
+	assert.NotSame(t, p, got)
+	assert.Nil(t, (*ApprovalPolicy)(nil).Clone())
+}
@@ -100,6 +100,11 @@ func (b *Builder) Reviewers(r UserIDList) *Builder {
 	return b
 }
 
+func (b *Builder) Reviews(r []*Review) *Builder {
+	b.r.reviews = r
+	return b
+}
+
 func (b *Builder) Thread(t *ThreadID) *Builder {
 	b.r.thread = t
 	return b
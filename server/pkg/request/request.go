@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/thread"
+	"github.com/reearth/reearth-cms/server/pkg/version"
 	"github.com/reearth/reearthx/account/accountdomain"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
@@ -18,6 +20,13 @@ var (
 	ErrDuplicatedItem = rerror.NewE(i18n.T("duplicated item"))
 )
 
+const refKind = "request"
+
+// Ref returns the ref of mongogit which the item versions of the request are pointed by to preview them.
+func Ref(rid ID) version.Ref {
+	return version.StagingRef(refKind, rid.String())
+}
+
 type Request struct {
 	id          ID
 	workspace   accountdomain.WorkspaceID
@@ -27,6 +36,7 @@ type Request struct {
 	description string
 	createdBy   UserID
 	reviewers   UserIDList
+	reviews     []*Review
 	state       State
 	updatedAt   time.Time
 	approvedAt  *time.Time
@@ -38,6 +48,10 @@ func (r *Request) ID() ID {
 	return r.id
 }
 
+func (r *Request) Ref() version.Ref {
+	return Ref(r.id)
+}
+
 func (r *Request) Workspace() accountdomain.WorkspaceID {
 	return r.workspace
 }
@@ -66,6 +80,20 @@ func (r *Request) Reviewers() UserIDList {
 	return r.reviewers
 }
 
+// Reviews returns the latest decision of each reviewer.
+func (r *Request) Reviews() []*Review {
+	return slices.Clone(r.reviews)
+}
+
+func (r *Request) Review(u UserID) *Review {
+	for _, rv := range r.reviews {
+		if rv.Reviewer() == u {
+			return rv
+		}
+	}
+	return nil
+}
+
 func (r *Request) State() State {
 	return r.state
 }
@@ -106,6 +134,20 @@ func (r *Request) SetReviewers(reviewers []UserID) {
 	r.reviewers = reviewers
 }
 
+// SetReview records the decision of a reviewer, replacing the previous one of the same reviewer.
+func (r *Request) SetReview(rv *Review) {
+	if rv == nil {
+		return
+	}
+	r.reviews = append(slices.DeleteFunc(r.reviews, func(o *Review) bool {
+		return o.Reviewer() == rv.Reviewer()
+	}), rv)
+}
+
+func (r *Request) ResetReviews() {
+	r.reviews = nil
+}
+
 func (r *Request) SetItems(items ItemList) error {
 	if items.HasDuplication() {
 		return ErrDuplicatedItem
@@ -131,3 +173,9 @@ func (r *Request) SetUpdatedAt(d time.Time) {
 func (r *Request) SetThread(thid id.ThreadID) {
 	r.thread = &thid
 }
+
+// RequestComment is a comment on the thread of a request. It is sent to webhooks on request.comment events.
+type RequestComment struct {
+	Request *Request
+	Comment *thread.Comment
+}
@@ -38,6 +38,28 @@ func TestRequest_SetReviewers(t *testing.T) {
 	assert.Equal(t, reviewers, req.Reviewers())
 }
 
+func TestRequest_SetReview(t *testing.T) {
+	req := &Request{}
+	u1, u2 := accountdomain.NewUserID(), accountdomain.NewUserID()
+	now := time.Now()
+	rv1 := NewReview(u1, DecisionChangesRequested, now)
+	rv2 := NewReview(u2, DecisionApproved, now)
+	rv3 := NewReview(u1, DecisionApproved, now.Add(time.Second))
+
+	req.SetReview(nil)
+	assert.Empty(t, req.Reviews())
+	req.SetReview(rv1)
+	req.SetReview(rv2)
+	assert.Equal(t, []*Review{rv1, rv2}, req.Reviews())
+	req.SetReview(rv3)
+	assert.Equal(t, []*Review{rv2, rv3}, req.Reviews())
+	assert.Same(t, rv3, req.Review(u1))
+	assert.Nil(t, req.Review(accountdomain.NewUserID()))
+
+	req.ResetReviews()
+	assert.Empty(t, req.Reviews())
+}
+
 func TestRequest_SetState(t *testing.T) {
 	req := &Request{
 		description: "xxx",
@@ -109,3 +131,14 @@ func TestRequest_UpdatedAt(t *testing.T) {
 
 	assert.Equal(t, time, r.UpdatedAt())
 }
+
+func TestRequest_Ref(t *testing.T) {
+	rId := id.NewRequestID()
+	r := &Request{
+		id: rId,
+	}
+
+	assert.Equal(t, version.Ref("request:"+rId.String()), r.Ref())
+	assert.Equal(t, r.Ref(), Ref(rId))
+	assert.True(t, r.Ref().IsStaging())
+}
@@ -0,0 +1,53 @@
+package request
+
+import (
+	"strings"
+	"time"
+)
+
+type Decision string
+
+var DecisionApproved Decision = "approved"
+var DecisionChangesRequested Decision = "changes_requested"
+
+func (d Decision) String() string {
+	return string(d)
+}
+
+func DecisionFrom(s string) Decision {
+	switch Decision(strings.ToLower(s)) {
+	case DecisionApproved:
+		return DecisionApproved
+	case DecisionChangesRequested:
+		return DecisionChangesRequested
+	default:
+		return Decision("")
+	}
+}
+
+// Review is the latest decision of a reviewer on a request.
+type Review struct {
+	reviewer  UserID
+	decision  Decision
+	createdAt time.Time
+}
+
+func NewReview(reviewer UserID, decision Decision, createdAt time.Time) *Review {
+	return &Review{
+		reviewer:  reviewer,
+		decision:  decision,
+		createdAt: createdAt,
+	}
+}
+
+func (r *Review) Reviewer() UserID {
+	return r.reviewer
+}
+
+func (r *Review) Decision() Decision {
+	return r.decision
+}
+
+func (r *Review) CreatedAt() time.Time {
+	return r.createdAt
+}
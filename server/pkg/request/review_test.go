@@ -0,0 +1,25 @@
+package request
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecisionFrom(t *testing.T) {
+	assert.Equal(t, DecisionApproved, DecisionFrom("approved"))
+	assert.Equal(t, DecisionChangesRequested, DecisionFrom("CHANGES_REQUESTED"))
+	assert.Equal(t, Decision(""), DecisionFrom("xxx"))
+	assert.Equal(t, "approved", DecisionApproved.String())
+}
+
+func TestNewReview(t *testing.T) {
+	u := accountdomain.NewUserID()
+	now := time.Now()
+	rv := NewReview(u, DecisionApproved, now)
+	assert.Equal(t, u, rv.Reviewer())
+	assert.Equal(t, DecisionApproved, rv.Decision())
+	assert.Equal(t, now, rv.CreatedAt())
+}
@@ -0,0 +1,84 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+type Builder struct {
+	s *Schedule
+}
+
+func New() *Builder {
+	return &Builder{s: &Schedule{}}
+}
+
+func (b *Builder) Build() (*Schedule, error) {
+	if b.s.id.IsNil() || b.s.workspace.IsNil() || b.s.project.IsNil() || b.s.model.IsNil() || b.s.item.IsNil() {
+		return nil, ErrInvalidID
+	}
+	if err := validateTimes(b.s.publishAt, b.s.unpublishAt); err != nil {
+		return nil, err
+	}
+	return b.s, nil
+}
+
+func (b *Builder) MustBuild() *Schedule {
+	return lo.Must(b.Build())
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.s.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.s.id = NewID()
+	return b
+}
+
+func (b *Builder) Workspace(workspace WorkspaceID) *Builder {
+	b.s.workspace = workspace
+	return b
+}
+
+func (b *Builder) Project(project ProjectID) *Builder {
+	b.s.project = project
+	return b
+}
+
+func (b *Builder) Model(model ModelID) *Builder {
+	b.s.model = model
+	return b
+}
+
+func (b *Builder) Item(item ItemID) *Builder {
+	b.s.item = item
+	return b
+}
+
+func (b *Builder) PublishAt(t *time.Time) *Builder {
+	b.s.publishAt = normalizeTime(t)
+	return b
+}
+
+func (b *Builder) UnpublishAt(t *time.Time) *Builder {
+	b.s.unpublishAt = normalizeTime(t)
+	return b
+}
+
+func (b *Builder) User(user *UserID) *Builder {
+	b.s.user = user.CloneRef()
+	return b
+}
+
+func (b *Builder) Integration(integration *IntegrationID) *Builder {
+	b.s.integration = integration.CloneRef()
+	return b
+}
+
+func (b *Builder) UpdatedAt(t time.Time) *Builder {
+	b.s.updatedAt = t
+	return b
+}
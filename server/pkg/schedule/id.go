@@ -0,0 +1,23 @@
+package schedule
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type ID = id.ScheduleID
+type IDList = id.ScheduleIDList
+type ProjectID = id.ProjectID
+type ModelID = id.ModelID
+type ItemID = id.ItemID
+type ItemIDList = id.ItemIDList
+type IntegrationID = id.IntegrationID
+type UserID = accountdomain.UserID
+type WorkspaceID = accountdomain.WorkspaceID
+
+var NewID = id.NewScheduleID
+var MustID = id.MustScheduleID
+var IDFrom = id.ScheduleIDFrom
+var IDFromRef = id.ScheduleIDFromRef
+
+var ErrInvalidID = id.ErrInvalidID
@@ -0,0 +1,44 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/reearth/reearthx/util"
+	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
+)
+
+type List []*Schedule
+
+func (l List) Items() ItemIDList {
+	return util.Map(l, func(s *Schedule) ItemID {
+		return s.Item()
+	})
+}
+
+func (l List) FindByItem(i ItemID) *Schedule {
+	for _, s := range l {
+		if s.Item() == i {
+			return s
+		}
+	}
+	return nil
+}
+
+// SortByNextAt sorts the schedules in the order they run.
+func (l List) SortByNextAt() List {
+	m := slices.Clone(l)
+	slices.SortStableFunc(m, func(a, b *Schedule) int {
+		if c := lo.FromPtr(a.NextAt()).Compare(lo.FromPtr(b.NextAt())); c != 0 {
+			return c
+		}
+		return a.ID().Compare(b.ID())
+	})
+	return m
+}
+
+func (l List) Due(now time.Time) List {
+	return util.Filter(l, func(s *Schedule) bool {
+		return s.IsPublishDue(now) || s.IsUnpublishDue(now)
+	})
+}
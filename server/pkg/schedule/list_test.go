@@ -0,0 +1,28 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := func() *Builder {
+		return New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Model(id.NewModelID()).Item(id.NewItemID())
+	}
+	s1 := b().PublishAt(lo.ToPtr(now.Add(time.Hour))).MustBuild()
+	s2 := b().UnpublishAt(lo.ToPtr(now)).MustBuild()
+	s3 := b().PublishAt(lo.ToPtr(now.Add(-time.Hour))).UnpublishAt(lo.ToPtr(now.Add(2 * time.Hour))).MustBuild()
+	l := List{s1, s2, s3}
+
+	assert.Equal(t, ItemIDList{s1.Item(), s2.Item(), s3.Item()}, l.Items())
+	assert.Equal(t, s2, l.FindByItem(s2.Item()))
+	assert.Nil(t, l.FindByItem(id.NewItemID()))
+	assert.Equal(t, List{s3, s2, s1}, l.SortByNextAt())
+	assert.Equal(t, List{s2, s3}, l.Due(now))
+}
@@ -0,0 +1,201 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrNoSchedule         = rerror.NewE(i18n.T("either publish time or unpublish time is required"))
+	ErrInvalidUnpublishAt = rerror.NewE(i18n.T("unpublish time must differ from publish time"))
+)
+
+// Action is a change of the publication of an item which is scheduled.
+type Action string
+
+const (
+	ActionPublish   Action = "publish"
+	ActionUnpublish Action = "unpublish"
+)
+
+// Schedule holds a pending publish and/or unpublish of an item.
+type Schedule struct {
+	id          ID
+	workspace   WorkspaceID
+	project     ProjectID
+	model       ModelID
+	item        ItemID
+	publishAt   *time.Time
+	unpublishAt *time.Time
+	user        *UserID
+	integration *IntegrationID
+	updatedAt   time.Time
+}
+
+func (s *Schedule) ID() ID {
+	return s.id
+}
+
+func (s *Schedule) Workspace() WorkspaceID {
+	return s.workspace
+}
+
+func (s *Schedule) Project() ProjectID {
+	return s.project
+}
+
+func (s *Schedule) Model() ModelID {
+	return s.model
+}
+
+func (s *Schedule) Item() ItemID {
+	return s.item
+}
+
+func (s *Schedule) PublishAt() *time.Time {
+	return s.publishAt
+}
+
+func (s *Schedule) UnpublishAt() *time.Time {
+	return s.unpublishAt
+}
+
+func (s *Schedule) User() *UserID {
+	return s.user
+}
+
+func (s *Schedule) Integration() *IntegrationID {
+	return s.integration
+}
+
+func (s *Schedule) CreatedAt() time.Time {
+	return s.id.Timestamp()
+}
+
+func (s *Schedule) UpdatedAt() time.Time {
+	if s.updatedAt.IsZero() {
+		return s.id.Timestamp()
+	}
+	return s.updatedAt
+}
+
+// NextAt returns the earliest pending time of the schedule.
+func (s *Schedule) NextAt() *time.Time {
+	if s.publishAt == nil {
+		return s.unpublishAt
+	}
+	if s.unpublishAt == nil || s.publishAt.Before(*s.unpublishAt) {
+		return s.publishAt
+	}
+	return s.unpublishAt
+}
+
+// IsEmpty returns true when nothing is left to run.
+func (s *Schedule) IsEmpty() bool {
+	return s.publishAt == nil && s.unpublishAt == nil
+}
+
+func (s *Schedule) IsPublishDue(now time.Time) bool {
+	return s.publishAt != nil && !s.publishAt.After(now)
+}
+
+func (s *Schedule) IsUnpublishDue(now time.Time) bool {
+	return s.unpublishAt != nil && !s.unpublishAt.After(now)
+}
+
+// DueActions returns the actions which are due at the time in chronological order.
+func (s *Schedule) DueActions(now time.Time) []Action {
+	var res []Action
+	if s.IsPublishDue(now) {
+		res = append(res, ActionPublish)
+	}
+	if s.IsUnpublishDue(now) {
+		if len(res) > 0 && s.unpublishAt.Before(*s.publishAt) {
+			res = append([]Action{ActionUnpublish}, res...)
+		} else {
+			res = append(res, ActionUnpublish)
+		}
+	}
+	return res
+}
+
+// Clear marks the action as done.
+func (s *Schedule) Clear(a Action) {
+	switch a {
+	case ActionPublish:
+		s.ClearPublishAt()
+	case ActionUnpublish:
+		s.ClearUnpublishAt()
+	}
+}
+
+func (s *Schedule) SetTimes(publishAt, unpublishAt *time.Time) error {
+	if err := validateTimes(publishAt, unpublishAt); err != nil {
+		return err
+	}
+	s.publishAt = normalizeTime(publishAt)
+	s.unpublishAt = normalizeTime(unpublishAt)
+	return nil
+}
+
+// ClearPublishAt marks the scheduled publish as done.
+func (s *Schedule) ClearPublishAt() {
+	s.publishAt = nil
+}
+
+// ClearUnpublishAt marks the scheduled unpublish as done.
+func (s *Schedule) ClearUnpublishAt() {
+	s.unpublishAt = nil
+}
+
+func (s *Schedule) SetUser(u UserID) {
+	s.user = u.Clone().Ref()
+	s.integration = nil
+}
+
+func (s *Schedule) SetIntegration(i IntegrationID) {
+	s.integration = i.Clone().Ref()
+	s.user = nil
+}
+
+func (s *Schedule) SetUpdatedAt(t time.Time) {
+	s.updatedAt = t
+}
+
+func (s *Schedule) Clone() *Schedule {
+	if s == nil {
+		return nil
+	}
+	return &Schedule{
+		id:          s.id.Clone(),
+		workspace:   s.workspace.Clone(),
+		project:     s.project.Clone(),
+		model:       s.model.Clone(),
+		item:        s.item.Clone(),
+		publishAt:   normalizeTime(s.publishAt),
+		unpublishAt: normalizeTime(s.unpublishAt),
+		user:        s.user.CloneRef(),
+		integration: s.integration.CloneRef(),
+		updatedAt:   s.updatedAt,
+	}
+}
+
+func validateTimes(publishAt, unpublishAt *time.Time) error {
+	if publishAt == nil && unpublishAt == nil {
+		return ErrNoSchedule
+	}
+	if publishAt != nil && unpublishAt != nil && unpublishAt.Equal(*publishAt) {
+		return ErrInvalidUnpublishAt
+	}
+	return nil
+}
+
+func normalizeTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return lo.ToPtr(t.UTC().Truncate(time.Millisecond))
+}
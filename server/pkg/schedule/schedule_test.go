@@ -0,0 +1,85 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := func() *Builder {
+		return New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Model(id.NewModelID()).Item(id.NewItemID())
+	}
+
+	s, err := b().PublishAt(lo.ToPtr(now)).Build()
+	assert.NoError(t, err)
+	assert.Equal(t, &now, s.PublishAt())
+	assert.Nil(t, s.UnpublishAt())
+
+	_, err = b().Build()
+	assert.Equal(t, ErrNoSchedule, err)
+
+	_, err = b().PublishAt(lo.ToPtr(now)).UnpublishAt(lo.ToPtr(now)).Build()
+	assert.Equal(t, ErrInvalidUnpublishAt, err)
+
+	// an item can be unpublished and published again later
+	_, err = b().PublishAt(lo.ToPtr(now.Add(time.Hour))).UnpublishAt(lo.ToPtr(now)).Build()
+	assert.NoError(t, err)
+
+	_, err = New().NewID().PublishAt(lo.ToPtr(now)).Build()
+	assert.Equal(t, ErrInvalidID, err)
+}
+
+func TestSchedule_Due(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Model(id.NewModelID()).Item(id.NewItemID()).
+		PublishAt(lo.ToPtr(now)).UnpublishAt(lo.ToPtr(now.Add(time.Hour))).MustBuild()
+
+	assert.Equal(t, &now, s.NextAt())
+	assert.False(t, s.IsPublishDue(now.Add(-time.Second)))
+	assert.True(t, s.IsPublishDue(now))
+	assert.False(t, s.IsUnpublishDue(now))
+	assert.True(t, s.IsUnpublishDue(now.Add(time.Hour)))
+
+	s.ClearPublishAt()
+	assert.Equal(t, lo.ToPtr(now.Add(time.Hour)), s.NextAt())
+	assert.False(t, s.IsEmpty())
+	s.ClearUnpublishAt()
+	assert.Nil(t, s.NextAt())
+	assert.True(t, s.IsEmpty())
+
+	assert.NoError(t, s.SetTimes(lo.ToPtr(now), lo.ToPtr(now.Add(time.Hour))))
+	assert.Equal(t, []Action{ActionPublish}, s.DueActions(now))
+	assert.Equal(t, []Action{ActionPublish, ActionUnpublish}, s.DueActions(now.Add(time.Hour)))
+	assert.NoError(t, s.SetTimes(lo.ToPtr(now.Add(time.Hour)), lo.ToPtr(now)))
+	assert.Equal(t, []Action{ActionUnpublish, ActionPublish}, s.DueActions(now.Add(time.Hour)))
+	assert.Empty(t, s.DueActions(now.Add(-time.Second)))
+
+	s.Clear(ActionPublish)
+	assert.Nil(t, s.PublishAt())
+	s.Clear(ActionUnpublish)
+	assert.True(t, s.IsEmpty())
+
+	assert.Equal(t, ErrNoSchedule, s.SetTimes(nil, nil))
+	assert.NoError(t, s.SetTimes(nil, lo.ToPtr(now)))
+	assert.Equal(t, &now, s.UnpublishAt())
+}
+
+func TestSchedule_SetOperator(t *testing.T) {
+	s := &Schedule{}
+	u := accountdomain.NewUserID()
+	i := id.NewIntegrationID()
+
+	s.SetUser(u)
+	assert.Equal(t, &u, s.User())
+	assert.Nil(t, s.Integration())
+
+	s.SetIntegration(i)
+	assert.Nil(t, s.User())
+	assert.Equal(t, &i, s.Integration())
+}
@@ -3,7 +3,8 @@ package schema
 import "github.com/reearth/reearthx/account/accountdomain"
 
 type Builder struct {
-	s *Schema
+	s     *Schema
+	rules []*ValidationRule
 }
 
 func New() *Builder {
@@ -26,6 +27,9 @@ func (b *Builder) Build() (*Schema, error) {
 	if b.s.titleField != nil && (len(b.s.fields) == 0 || b.s.fields == nil) {
 		return nil, ErrInvalidTitleField
 	}
+	if err := b.s.SetValidationRules(b.rules); err != nil {
+		return nil, err
+	}
 	return b.s, nil
 }
 
@@ -66,3 +70,8 @@ func (b *Builder) TitleField(fid *FieldID) *Builder {
 	b.s.titleField = fid.CloneRef()
 	return b
 }
+
+func (b *Builder) ValidationRules(rules []*ValidationRule) *Builder {
+	b.rules = rules
+	return b
+}
@@ -0,0 +1,169 @@
+package schema
+
+import (
+	"fmt"
+
+	"github.com/reearth/reearth-cms/server/pkg/expression"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"golang.org/x/exp/maps"
+)
+
+func (f *Field) Expression() *expression.Expr {
+	return f.expression
+}
+
+// IsComputed returns true when the value of the field is computed from the other fields with the expression.
+func (f *Field) IsComputed() bool {
+	return f != nil && f.expression != nil
+}
+
+// SetExpression makes the field computed with the expression, or makes the field writable again when the expression is nil.
+// The fields which the expression refers to are checked by Schema.ValidateExpressions as they are not known to the field.
+func (f *Field) SetExpression(e *expression.Expr) error {
+	if e != nil {
+		if !supportsComputation(f.Type()) {
+			return errComputationNotSupported(f.Type())
+		}
+		if f.localized {
+			return ErrLocalizationNotSupported
+		}
+		if lo.Contains(e.Fields(), f.key.String()) {
+			return &rerror.Error{Label: expression.ErrInvalidExpression, Err: fmt.Errorf("%s refers to itself", f.key)}
+		}
+	}
+	f.expression = e
+	return nil
+}
+
+func supportsComputation(t value.Type) bool {
+	switch t {
+	case value.TypeText, value.TypeTextArea, value.TypeRichText, value.TypeMarkdown, value.TypeSelect, value.TypeURL,
+		value.TypeInteger, value.TypeNumber, value.TypeBool, value.TypeCheckbox, value.TypeDateTime:
+		return true
+	}
+	return false
+}
+
+func errComputationNotSupported(t value.Type) error {
+	return &rerror.Error{Label: expression.ErrInvalidExpression, Err: fmt.Errorf("%s fields can not be computed", t)}
+}
+
+// ValidateExpressions checks that the expressions of the computed fields refer only to the fields of the schema and do not depend on each other circularly.
+func (s *Schema) ValidateExpressions() error {
+	_, err := s.ComputedFields()
+	return err
+}
+
+// ComputedFields returns the computed fields ordered so that each field comes after the computed fields which its expression refers to.
+func (s *Schema) ComputedFields() (FieldList, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	fields := s.Fields()
+	byKey := lo.SliceToMap(fields, func(f *Field) (string, *Field) { return f.Key().String(), f })
+	var res FieldList
+	visiting := map[FieldID]bool{}
+	done := map[FieldID]bool{}
+
+	var visit func(f *Field) error
+	visit = func(f *Field) error {
+		if done[f.ID()] {
+			return nil
+		}
+		if visiting[f.ID()] {
+			return &rerror.Error{Label: expression.ErrInvalidExpression, Err: fmt.Errorf("%s refers to itself through other computed fields", f.Key())}
+		}
+		visiting[f.ID()] = true
+		for _, k := range f.Expression().Fields() {
+			g, ok := byKey[k]
+			if !ok {
+				return &rerror.Error{Label: expression.ErrInvalidExpression, Err: fmt.Errorf("%s refers to the unknown field %q", f.Key(), k)}
+			}
+			if g.IsComputed() {
+				if err := visit(g); err != nil {
+					return err
+				}
+			}
+		}
+		done[f.ID()] = true
+		res = append(res, f)
+		return nil
+	}
+
+	for _, f := range fields {
+		if f.IsComputed() {
+			if err := visit(f); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return res, nil
+}
+
+// Compute evaluates the expressions of the computed fields with the values of the other fields and returns the values of the computed fields.
+// An empty value is returned for a computed field whose expression results in null.
+func (s *Schema) Compute(values map[FieldID]*value.Multiple) (map[FieldID]*value.Multiple, error) {
+	computed, err := s.ComputedFields()
+	if err != nil || len(computed) == 0 {
+		return nil, err
+	}
+
+	values = maps.Clone(values)
+	env := make(map[string]any, len(s.fields))
+	for _, f := range s.fields {
+		env[f.Key().String()] = expressionValue(f, values[f.ID()])
+	}
+
+	res := make(map[FieldID]*value.Multiple, len(computed))
+	for _, f := range computed {
+		v, err := f.Expression().Eval(env)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.Key(), err)
+		}
+		m, err := f.computedValue(v)
+		if err != nil {
+			return nil, &rerror.Error{Label: expression.ErrEvaluationFailed, Err: fmt.Errorf("%s: %w", f.Key(), err)}
+		}
+		res[f.ID()] = m
+		env[f.Key().String()] = expressionValue(f, m)
+	}
+	return res, nil
+}
+
+func expressionValue(f *Field, m *value.Multiple) any {
+	if m.IsEmpty() {
+		return nil
+	}
+	if f.Multiple() {
+		return m.Interface()
+	}
+	return m.First().Interface()
+}
+
+func (f *Field) computedValue(v any) (*value.Multiple, error) {
+	if v == nil {
+		return value.NewMultiple(f.Type(), nil), nil
+	}
+
+	var vs []any
+	if l, ok := v.([]any); ok {
+		if !f.Multiple() {
+			return nil, fmt.Errorf("a list can not be the value of a single value field")
+		}
+		vs = lo.Filter(l, func(v any, _ int) bool { return v != nil })
+	} else {
+		vs = []any{v}
+	}
+
+	m := value.NewMultiple(f.Type(), vs)
+	if m.Len() != len(vs) {
+		return nil, fmt.Errorf("the result can not be converted to %s", f.Type())
+	}
+	if err := f.ValidateValue(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
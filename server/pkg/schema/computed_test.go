@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/expression"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestField_SetExpression(t *testing.T) {
+	f := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("slug")).MustBuild()
+	assert.False(t, f.IsComputed())
+
+	e := expression.MustParse("slug(title)")
+	assert.NoError(t, f.SetExpression(e))
+	assert.True(t, f.IsComputed())
+	assert.Same(t, e, f.Expression())
+	assert.Same(t, e, f.Clone().Expression())
+
+	assert.True(t, rerror.Is(f.SetExpression(expression.MustParse("slug + 'x'")), expression.ErrInvalidExpression))
+	assert.Equal(t, ErrLocalizationNotSupported, f.SetLocalized(true))
+
+	// the type of a computed field can not be changed to a type whose values can not be computed
+	assert.True(t, rerror.Is(f.SetTypeProperty(NewAsset().TypeProperty()), expression.ErrInvalidExpression))
+	assert.NoError(t, f.SetTypeProperty(NewTextArea(nil).TypeProperty()))
+
+	assert.NoError(t, f.SetExpression(nil))
+	assert.False(t, f.IsComputed())
+
+	asset := NewField(NewAsset().TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	assert.True(t, rerror.Is(asset.SetExpression(e), expression.ErrInvalidExpression))
+
+	localized := NewField(NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).Localized(true).MustBuild()
+	assert.Equal(t, ErrLocalizationNotSupported, localized.SetExpression(e))
+
+	_, err := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("slug")).Expression(expression.MustParse("slug")).Build()
+	assert.True(t, rerror.Is(err, expression.ErrInvalidExpression))
+}
+
+func TestSchema_ComputedFields(t *testing.T) {
+	first := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("first")).MustBuild()
+	full := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("full")).Expression(expression.MustParse(`first + " " + field("last-name")`)).MustBuild()
+	initials := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("initials")).Expression(expression.MustParse(`upper(full)`)).MustBuild()
+	last := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("last-name")).MustBuild()
+	s := New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(FieldList{first, initials, full, last}).MustBuild()
+
+	got, err := s.ComputedFields()
+	assert.NoError(t, err)
+	assert.Equal(t, FieldList{full, initials}, got)
+	assert.NoError(t, s.ValidateExpressions())
+
+	// a field which is referred to by a computed field can not be removed
+	s2 := s.Clone()
+	s2.RemoveField(last.ID())
+	assert.True(t, rerror.Is(s2.ValidateExpressions(), expression.ErrInvalidExpression))
+
+	// circular references
+	cyclic := full.Clone()
+	assert.NoError(t, cyclic.SetExpression(expression.MustParse("initials")))
+	s3 := New().NewID().Workspace(s.Workspace()).Project(s.Project()).Fields(FieldList{first, initials, cyclic, last}).MustBuild()
+	_, err = s3.ComputedFields()
+	assert.True(t, rerror.Is(err, expression.ErrInvalidExpression))
+
+	got, err = (*Schema)(nil).ComputedFields()
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestSchema_Compute(t *testing.T) {
+	title := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("title")).MustBuild()
+	tags := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("tags")).Multiple(true).MustBuild()
+	slug := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("slug")).Expression(expression.MustParse("slug(title)")).MustBuild()
+	count := NewField(lo.Must(NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("count")).Expression(expression.MustParse("len(tags)")).MustBuild()
+	tagList := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("tag-list")).Multiple(true).Expression(expression.MustParse("if(len(tags) > 0, tags, null)")).MustBuild()
+	s := New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(FieldList{title, tags, slug, count, tagList}).MustBuild()
+
+	values := map[FieldID]*value.Multiple{
+		title.ID(): value.TypeText.Value("Hello World").AsMultiple(),
+		tags.ID():  value.NewMultiple(value.TypeText, []any{"a", "b"}),
+		slug.ID():  value.TypeText.Value("old").AsMultiple(),
+	}
+	got, err := s.Compute(values)
+	assert.NoError(t, err)
+	assert.Equal(t, map[FieldID]*value.Multiple{
+		slug.ID():    value.NewMultiple(value.TypeText, []any{"hello-world"}),
+		count.ID():   value.NewMultiple(value.TypeInteger, []any{int64(2)}),
+		tagList.ID(): value.NewMultiple(value.TypeText, []any{"a", "b"}),
+	}, got)
+	// the given values are not changed
+	assert.Equal(t, "old", values[slug.ID()].First().Interface())
+
+	got, err = s.Compute(nil)
+	assert.NoError(t, err)
+	assert.True(t, got[slug.ID()].IsEmpty())
+	assert.Equal(t, value.NewMultiple(value.TypeInteger, []any{int64(0)}), got[count.ID()])
+	assert.True(t, got[tagList.ID()].IsEmpty())
+
+	// a list can not be the value of a single value field
+	list := NewField(NewText(nil).TypeProperty()).NewID().Key(id.NewKey("list")).Expression(expression.MustParse("tags")).MustBuild()
+	s2 := New().NewID().Workspace(s.Workspace()).Project(s.Project()).Fields(FieldList{tags, list}).MustBuild()
+	_, err = s2.Compute(values)
+	assert.True(t, rerror.Is(err, expression.ErrEvaluationFailed))
+
+	// the result must be a valid value of the field
+	n := NewField(lo.Must(NewInteger(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("n")).Expression(expression.MustParse("title")).MustBuild()
+	s3 := New().NewID().Workspace(s.Workspace()).Project(s.Project()).Fields(FieldList{title, n}).MustBuild()
+	_, err = s3.Compute(values)
+	assert.True(t, rerror.Is(err, expression.ErrEvaluationFailed))
+
+	got, err = New().NewID().Workspace(s.Workspace()).Project(s.Project()).Fields(FieldList{title}).MustBuild().Compute(values)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
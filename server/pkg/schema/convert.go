@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+// ConversionResult counts the values processed when the type of a field is converted.
+type ConversionResult struct {
+	// Converted is the number of the values which are cast to the new type.
+	Converted int
+	// Failed is the number of the values which can not be cast to the new type or are invalid for the field.
+	Failed int
+	// Lost is the number of the values dropped because the field does not accept multiple values.
+	Lost int
+}
+
+func (r ConversionResult) Add(s ConversionResult) ConversionResult {
+	return ConversionResult{
+		Converted: r.Converted + s.Converted,
+		Failed:    r.Failed + s.Failed,
+		Lost:      r.Lost + s.Lost,
+	}
+}
+
+// IsConvertible reports whether the values of a field of the type can be converted to the other type.
+// References and groups are excluded as their values depend on other models and items.
+func IsConvertible(from, to value.Type) bool {
+	nonConvertible := []value.Type{value.TypeUnknown, value.TypeReference, value.TypeGroup}
+	return !lo.Contains(nonConvertible, from) && !lo.Contains(nonConvertible, to)
+}
+
+// ConvertValue casts the values to the type of the field. The values which can not be cast or are invalid for the field are dropped.
+func (f *Field) ConvertValue(m *value.Multiple) (*value.Multiple, ConversionResult) {
+	var res ConversionResult
+	if f == nil || m.IsEmpty() {
+		return nil, res
+	}
+
+	values := make([]*value.Value, 0, m.Len())
+	for _, v := range m.Values() {
+		if v.IsEmpty() {
+			continue
+		}
+		c := v.Cast(f.Type())
+		if c.IsEmpty() || f.typeProperty.Validate(c) != nil {
+			res.Failed++
+			continue
+		}
+		values = append(values, c)
+	}
+
+	if !f.multiple && len(values) > 1 {
+		res.Lost = len(values) - 1
+		values = values[:1]
+	}
+	res.Converted = len(values)
+
+	if len(values) == 0 {
+		return nil, res
+	}
+	return value.MultipleFrom(f.Type(), values), res
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsConvertible(t *testing.T) {
+	assert.True(t, IsConvertible(value.TypeText, value.TypeSelect))
+	assert.True(t, IsConvertible(value.TypeInteger, value.TypeNumber))
+	assert.False(t, IsConvertible(value.TypeText, value.TypeReference))
+	assert.False(t, IsConvertible(value.TypeGroup, value.TypeText))
+}
+
+func TestField_ConvertValue(t *testing.T) {
+	sel := NewField(NewSelect([]string{"a", "b"}).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	texts := value.NewMultiple(value.TypeText, []any{"a", "c", "b", ""})
+
+	got, res := sel.ConvertValue(texts)
+	assert.Equal(t, value.NewMultiple(value.TypeSelect, []any{"a"}), got)
+	assert.Equal(t, ConversionResult{Converted: 1, Failed: 1, Lost: 1}, res)
+
+	sel.SetMultiple(true)
+	got, res = sel.ConvertValue(texts)
+	assert.Equal(t, value.NewMultiple(value.TypeSelect, []any{"a", "b"}), got)
+	assert.Equal(t, ConversionResult{Converted: 2, Failed: 1}, res)
+
+	got, res = sel.ConvertValue(value.NewMultiple(value.TypeText, []any{"c"}))
+	assert.Nil(t, got)
+	assert.Equal(t, ConversionResult{Failed: 1}, res)
+
+	got, res = sel.ConvertValue(nil)
+	assert.Nil(t, got)
+	assert.Equal(t, ConversionResult{}, res)
+
+	num := NewField(lo.Must(NewNumber(nil, nil)).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	got, res = num.ConvertValue(value.TypeInteger.Value(int64(10)).AsMultiple())
+	assert.Equal(t, value.TypeNumber.Value(float64(10)).AsMultiple(), got)
+	assert.Equal(t, ConversionResult{Converted: 1}, res)
+}
+
+func TestConversionResult_Add(t *testing.T) {
+	assert.Equal(t, ConversionResult{Converted: 3, Failed: 2, Lost: 1}, ConversionResult{Converted: 1, Failed: 2}.Add(ConversionResult{Converted: 2, Lost: 1}))
+}
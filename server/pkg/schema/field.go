@@ -4,13 +4,18 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/expression"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
 )
 
-var ErrValueRequired = rerror.NewE(i18n.T("value is required"))
+var (
+	ErrValueRequired            = rerror.NewE(i18n.T("value is required"))
+	ErrLocalizationNotSupported = rerror.NewE(i18n.T("the field type does not support localization"))
+)
 
 type Field struct {
 	id           FieldID
@@ -20,10 +25,15 @@ type Field struct {
 	unique       bool
 	multiple     bool
 	required     bool
+	localized    bool
 	updatedAt    time.Time
 	defaultValue *value.Multiple
 	typeProperty *TypeProperty
 	order        int
+	// validationRules are checked against the values of items in addition to the type property.
+	validationRules []*ValidationRule
+	// expression computes the value of the field from the other fields of the item. Computed fields are read-only.
+	expression *expression.Expr
 }
 
 func (f *Field) ID() FieldID {
@@ -105,6 +115,28 @@ func (f *Field) SetRequired(req bool) {
 	f.required = req
 }
 
+// Localized returns true when the field can hold a value for each locale of the project.
+func (f *Field) Localized() bool {
+	return f.localized
+}
+
+func (f *Field) SetLocalized(localized bool) error {
+	if localized && (!f.SupportsLocalization() || f.IsComputed()) {
+		return ErrLocalizationNotSupported
+	}
+	f.localized = localized
+	return nil
+}
+
+// SupportsLocalization returns false for the types whose values are not language dependent.
+func (f *Field) SupportsLocalization() bool {
+	return supportsLocalization(f.Type())
+}
+
+func supportsLocalization(t value.Type) bool {
+	return t != value.TypeGroup && t != value.TypeReference
+}
+
 func (f *Field) SetUnique(unique bool) {
 	f.unique = unique
 }
@@ -136,6 +168,12 @@ func (f *Field) SetTypeProperty(tp *TypeProperty) error {
 	if tp == nil {
 		return ErrInvalidType
 	}
+	if f.localized && !supportsLocalization(tp.Type()) {
+		return ErrLocalizationNotSupported
+	}
+	if f.IsComputed() && !supportsComputation(tp.Type()) {
+		return errComputationNotSupported(tp.Type())
+	}
 	if !f.defaultValue.IsEmpty() {
 		for _, v := range f.defaultValue.Values() {
 			if err := tp.Validate(v); err != nil {
@@ -144,6 +182,26 @@ func (f *Field) SetTypeProperty(tp *TypeProperty) error {
 		}
 	}
 	f.typeProperty = tp
+	// the rules which no longer apply to the new type are dropped
+	if len(f.validationRules) > 0 {
+		f.validationRules = lo.Filter(f.validationRules, func(r *ValidationRule, _ int) bool {
+			return r.validateField(f) == nil
+		})
+	}
+	return nil
+}
+
+func (f *Field) ValidationRules() []*ValidationRule {
+	return cloneValidationRules(f.validationRules)
+}
+
+func (f *Field) SetValidationRules(rules []*ValidationRule) error {
+	for _, r := range rules {
+		if err := r.validateField(f); err != nil {
+			return err
+		}
+	}
+	f.validationRules = cloneValidationRules(rules)
 	return nil
 }
 
@@ -161,9 +219,13 @@ func (f *Field) Clone() *Field {
 		unique:       f.unique,
 		multiple:     f.multiple,
 		required:     f.required,
+		localized:    f.localized,
 		updatedAt:    f.updatedAt,
 		typeProperty: f.typeProperty.Clone(),
 		defaultValue: f.defaultValue.Clone(),
+
+		validationRules: cloneValidationRules(f.validationRules),
+		expression:      f.expression,
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/reearth/reearth-cms/server/pkg/expression"
 	"github.com/reearth/reearth-cms/server/pkg/id"
 	"github.com/reearth/reearth-cms/server/pkg/value"
 	"github.com/reearth/reearthx/i18n"
@@ -15,9 +16,11 @@ var ErrInvalidKey = rerror.NewE(i18n.T("invalid key"))
 var ErrInvalidType = rerror.NewE(i18n.T("invalid type"))
 
 type FieldBuilder struct {
-	f   *Field
-	dv  *value.Multiple
-	err error
+	f          *Field
+	dv         *value.Multiple
+	rules      []*ValidationRule
+	expression *expression.Expr
+	err        error
 }
 
 func NewField(tp *TypeProperty) *FieldBuilder {
@@ -84,6 +87,15 @@ func (b *FieldBuilder) Build() (*Field, error) {
 	if err := b.f.SetDefaultValue(b.dv); err != nil {
 		return nil, err
 	}
+	if b.f.localized && !b.f.SupportsLocalization() {
+		return nil, ErrLocalizationNotSupported
+	}
+	if err := b.f.SetValidationRules(b.rules); err != nil {
+		return nil, err
+	}
+	if err := b.f.SetExpression(b.expression); err != nil {
+		return nil, err
+	}
 	return b.f, nil
 }
 
@@ -125,6 +137,21 @@ func (b *FieldBuilder) Required(required bool) *FieldBuilder {
 	return b
 }
 
+func (b *FieldBuilder) Localized(localized bool) *FieldBuilder {
+	b.f.localized = localized
+	return b
+}
+
+func (b *FieldBuilder) ValidationRules(rules []*ValidationRule) *FieldBuilder {
+	b.rules = rules
+	return b
+}
+
+func (b *FieldBuilder) Expression(e *expression.Expr) *FieldBuilder {
+	b.expression = e
+	return b
+}
+
 func (b *FieldBuilder) Order(o int) *FieldBuilder {
 	b.f.order = o
 	return b
@@ -73,6 +73,7 @@ func TestField_Clone(t *testing.T) {
 		unique:       true,
 		multiple:     true,
 		required:     true,
+		localized:    true,
 		typeProperty: NewText(nil).TypeProperty(),
 		defaultValue: value.TypeText.Value("aa").AsMultiple(),
 		updatedAt:    time.Now(),
@@ -93,6 +94,17 @@ func TestField_SetRequired(t *testing.T) {
 	assert.Equal(t, true, f.Required())
 }
 
+func TestField_SetLocalized(t *testing.T) {
+	f := &Field{typeProperty: NewText(nil).TypeProperty()}
+	assert.NoError(t, f.SetLocalized(true))
+	assert.True(t, f.Localized())
+
+	f = &Field{typeProperty: NewReference(id.NewModelID(), id.NewSchemaID(), nil, nil).TypeProperty()}
+	assert.Equal(t, ErrLocalizationNotSupported, f.SetLocalized(true))
+	assert.False(t, f.Localized())
+	assert.NoError(t, f.SetLocalized(false))
+}
+
 func TestField_SetUnique(t *testing.T) {
 	f := &Field{unique: false}
 	f.SetUnique(true)
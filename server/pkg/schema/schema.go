@@ -18,6 +18,8 @@ type Schema struct {
 	workspace  accountdomain.WorkspaceID
 	fields     []*Field
 	titleField *FieldID
+	// validationRules are the rules across the fields of the schema.
+	validationRules []*ValidationRule
 }
 
 func (s *Schema) ID() ID {
@@ -114,6 +116,11 @@ func (s *Schema) RemoveField(fid FieldID) {
 			if lo.FromPtr(s.titleField) == fid {
 				s.titleField = nil
 			}
+			if len(s.validationRules) > 0 {
+				s.validationRules = lo.Reject(s.validationRules, func(r *ValidationRule, _ int) bool {
+					return r.Field() == fid || r.OtherField() == fid
+				})
+			}
 			return
 		}
 	}
@@ -151,9 +158,25 @@ func (s *Schema) Clone() *Schema {
 		workspace:  s.Workspace().Clone(),
 		fields:     slices.Clone(s.fields),
 		titleField: s.TitleField().CloneRef(),
+
+		validationRules: cloneValidationRules(s.validationRules),
 	}
 }
 
+func (s *Schema) ValidationRules() []*ValidationRule {
+	return cloneValidationRules(s.validationRules)
+}
+
+func (s *Schema) SetValidationRules(rules []*ValidationRule) error {
+	for _, r := range rules {
+		if err := r.validateSchema(s); err != nil {
+			return err
+		}
+	}
+	s.validationRules = cloneValidationRules(rules)
+	return nil
+}
+
 func (s *Schema) HasGeometryFields() bool {
 	if s == nil {
 		return false
@@ -179,4 +202,5 @@ func (s *Schema) CopyFrom(s2 *Schema) {
 	}
 	s.fields = slices.Clone(s2.fields)
 	s.titleField = s2.TitleField().CloneRef()
+	s.validationRules = cloneValidationRules(s2.validationRules)
 }
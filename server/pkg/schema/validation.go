@@ -0,0 +1,179 @@
+package schema
+
+import (
+	"cmp"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var (
+	ErrPatternMismatch  = rerror.NewE(i18n.T("value does not match the pattern"))
+	ErrTooFewValues     = rerror.NewE(i18n.T("too few values"))
+	ErrTooManyValues    = rerror.NewE(i18n.T("too many values"))
+	ErrComparisonFailed = rerror.NewE(i18n.T("values do not satisfy the comparison"))
+)
+
+// ValidationError is a violation of a validation rule by the value of a field.
+type ValidationError struct {
+	Field FieldID
+	Key   string
+	// Locale is the locale of the violating value. It is empty for the value of the default locale.
+	Locale string
+	Rule   ValidationRuleType
+	// Message is the custom message of the rule.
+	Message string
+	Err     error
+}
+
+func newValidationError(f *Field, r *ValidationRule, err error) *ValidationError {
+	return &ValidationError{
+		Field:   f.ID(),
+		Key:     f.Key().String(),
+		Rule:    r.Type(),
+		Message: r.Message(),
+		Err:     err,
+	}
+}
+
+// Reason returns the custom message of the rule, or the default message when the rule has no custom message.
+func (e *ValidationError) Reason() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Error() string {
+	if e.Locale != "" {
+		return e.Key + " (" + e.Locale + "): " + e.Reason()
+	}
+	return e.Key + ": " + e.Reason()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ValidationError) LocalizeError(l *i18n.Localizer) error {
+	e2 := *e
+	if le, ok := e.Err.(rerror.Localizable); ok {
+		e2.Err = le.LocalizeError(l)
+	}
+	return &e2
+}
+
+// ValidationErrors is the list of all violations of the validation rules by an item.
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	return strings.Join(lo.Map(e, func(e *ValidationError, _ int) string { return e.Error() }), "; ")
+}
+
+func (e ValidationErrors) Unwrap() []error {
+	return lo.Map(e, func(e *ValidationError, _ int) error { return e })
+}
+
+func (e ValidationErrors) LocalizeError(l *i18n.Localizer) error {
+	return ValidationErrors(lo.Map(e, func(e *ValidationError, _ int) *ValidationError {
+		return e.LocalizeError(l).(*ValidationError)
+	}))
+}
+
+// ValidateRules checks the value against the validation rules of the field. Empty values are not checked as they are covered by the required constraint.
+func (f *Field) ValidateRules(m *value.Multiple) ValidationErrors {
+	if f == nil || m.IsEmpty() {
+		return nil
+	}
+
+	values := lo.Filter(m.Values(), func(v *value.Value, _ int) bool { return !v.IsEmpty() })
+	var res ValidationErrors
+	for _, r := range f.validationRules {
+		var err error
+		switch r.Type() {
+		case ValidationRuleTypePattern:
+			if lo.SomeBy(values, func(v *value.Value) bool {
+				s, ok := v.Interface().(string)
+				return ok && !r.pattern.MatchString(s)
+			}) {
+				err = ErrPatternMismatch
+			}
+		case ValidationRuleTypeMinCount:
+			if len(values) < r.Count() {
+				err = ErrTooFewValues
+			}
+		case ValidationRuleTypeMaxCount:
+			if len(values) > r.Count() {
+				err = ErrTooManyValues
+			}
+		}
+		if err != nil {
+			res = append(res, newValidationError(f, r, err))
+		}
+	}
+	return res
+}
+
+// ValidateRules checks the values of the fields against the validation rules of the schema.
+// The violations are reported on the first field of the compare rules, and the comparisons are skipped when either value is empty
+// or the types of the fields have been changed so that they can no longer be compared.
+func (s *Schema) ValidateRules(values map[FieldID]*value.Multiple) ValidationErrors {
+	if s == nil {
+		return nil
+	}
+
+	var res ValidationErrors
+	for _, r := range s.validationRules {
+		f := s.Field(r.Field())
+		a, b := values[r.Field()].First(), values[r.OtherField()].First()
+		if f == nil || a.IsEmpty() || b.IsEmpty() {
+			continue
+		}
+		if c, ok := compareValues(a, b); ok && !r.Operator().match(c) {
+			res = append(res, newValidationError(f, r, ErrComparisonFailed))
+		}
+	}
+	return res
+}
+
+func (o CompareOperator) match(c int) bool {
+	switch o {
+	case CompareOperatorLessThan:
+		return c < 0
+	case CompareOperatorLessThanOrEqual:
+		return c <= 0
+	case CompareOperatorGreaterThan:
+		return c > 0
+	case CompareOperatorGreaterThanOrEqual:
+		return c >= 0
+	case CompareOperatorEqual:
+		return c == 0
+	case CompareOperatorNotEqual:
+		return c != 0
+	}
+	return false
+}
+
+func compareValues(a, b *value.Value) (int, bool) {
+	if a.Type() != b.Type() {
+		return 0, false
+	}
+	switch a.Type() {
+	case value.TypeInteger:
+		x, _ := a.ValueInteger()
+		y, _ := b.ValueInteger()
+		return cmp.Compare(x, y), true
+	case value.TypeNumber:
+		x, _ := a.ValueNumber()
+		y, _ := b.ValueNumber()
+		return cmp.Compare(x, y), true
+	case value.TypeDateTime:
+		x, _ := a.ValueDateTime()
+		y, _ := b.ValueDateTime()
+		return x.Compare(y), true
+	}
+	return 0, false
+}
@@ -0,0 +1,174 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+)
+
+var ErrInvalidValidationRule = rerror.NewE(i18n.T("invalid validation rule"))
+
+type ValidationRuleType string
+
+const (
+	// ValidationRuleTypePattern requires the values of a field to match a regular expression.
+	ValidationRuleTypePattern ValidationRuleType = "pattern"
+	// ValidationRuleTypeMinCount requires a field to have at least the number of values.
+	ValidationRuleTypeMinCount ValidationRuleType = "minCount"
+	// ValidationRuleTypeMaxCount requires a field to have at most the number of values.
+	ValidationRuleTypeMaxCount ValidationRuleType = "maxCount"
+	// ValidationRuleTypeCompare compares the values of two fields of a schema, e.g. an end date should be after a start date.
+	ValidationRuleTypeCompare ValidationRuleType = "compare"
+)
+
+type CompareOperator string
+
+const (
+	CompareOperatorLessThan           CompareOperator = "lt"
+	CompareOperatorLessThanOrEqual    CompareOperator = "lte"
+	CompareOperatorGreaterThan        CompareOperator = "gt"
+	CompareOperatorGreaterThanOrEqual CompareOperator = "gte"
+	CompareOperatorEqual              CompareOperator = "eq"
+	CompareOperatorNotEqual           CompareOperator = "ne"
+)
+
+var (
+	patternTypes     = []value.Type{value.TypeText, value.TypeTextArea, value.TypeRichText, value.TypeMarkdown, value.TypeURL}
+	comparableTypes  = []value.Type{value.TypeInteger, value.TypeNumber, value.TypeDateTime}
+	compareOperators = []CompareOperator{
+		CompareOperatorLessThan,
+		CompareOperatorLessThanOrEqual,
+		CompareOperatorGreaterThan,
+		CompareOperatorGreaterThanOrEqual,
+		CompareOperatorEqual,
+		CompareOperatorNotEqual,
+	}
+)
+
+// ValidationRule is a constraint on the values of items in addition to the constraints of the field types.
+// Pattern and count rules belong to fields, and compare rules belong to schemas.
+type ValidationRule struct {
+	t        ValidationRuleType
+	pattern  *regexp.Regexp
+	count    int
+	field    FieldID
+	operator CompareOperator
+	other    FieldID
+	// message replaces the default error message when it is not empty.
+	message string
+}
+
+func NewPatternRule(pattern, message string) (*ValidationRule, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, &rerror.Error{Label: ErrInvalidValidationRule, Err: err}
+	}
+	return &ValidationRule{t: ValidationRuleTypePattern, pattern: re, message: message}, nil
+}
+
+func NewCountRule(t ValidationRuleType, count int, message string) (*ValidationRule, error) {
+	if t != ValidationRuleTypeMinCount && t != ValidationRuleTypeMaxCount || count < 0 {
+		return nil, ErrInvalidValidationRule
+	}
+	return &ValidationRule{t: t, count: count, message: message}, nil
+}
+
+func NewCompareRule(field FieldID, operator CompareOperator, other FieldID, message string) (*ValidationRule, error) {
+	if !lo.Contains(compareOperators, operator) || field == other {
+		return nil, ErrInvalidValidationRule
+	}
+	return &ValidationRule{t: ValidationRuleTypeCompare, field: field, operator: operator, other: other, message: message}, nil
+}
+
+// NewValidationRule creates a rule of the type from the attributes used by the type, and the others are ignored.
+func NewValidationRule(t ValidationRuleType, pattern string, count int, field FieldID, operator CompareOperator, other FieldID, message string) (*ValidationRule, error) {
+	switch t {
+	case ValidationRuleTypePattern:
+		return NewPatternRule(pattern, message)
+	case ValidationRuleTypeMinCount, ValidationRuleTypeMaxCount:
+		return NewCountRule(t, count, message)
+	case ValidationRuleTypeCompare:
+		return NewCompareRule(field, operator, other, message)
+	}
+	return nil, ErrInvalidValidationRule
+}
+
+func (r *ValidationRule) Type() ValidationRuleType {
+	return r.t
+}
+
+func (r *ValidationRule) Pattern() string {
+	if r.pattern == nil {
+		return ""
+	}
+	return r.pattern.String()
+}
+
+func (r *ValidationRule) Count() int {
+	return r.count
+}
+
+func (r *ValidationRule) Field() FieldID {
+	return r.field
+}
+
+func (r *ValidationRule) Operator() CompareOperator {
+	return r.operator
+}
+
+func (r *ValidationRule) OtherField() FieldID {
+	return r.other
+}
+
+func (r *ValidationRule) Message() string {
+	return r.message
+}
+
+func (r *ValidationRule) Clone() *ValidationRule {
+	if r == nil {
+		return nil
+	}
+	c := *r
+	return &c
+}
+
+func (r *ValidationRule) isFieldRule() bool {
+	return r.t != ValidationRuleTypeCompare
+}
+
+// validateField checks if the rule can be set to the field.
+func (r *ValidationRule) validateField(f *Field) error {
+	if !r.isFieldRule() {
+		return &rerror.Error{Label: ErrInvalidValidationRule, Err: fmt.Errorf("%s rule can not be set to a field", r.t)}
+	}
+	if r.t == ValidationRuleTypePattern && !lo.Contains(patternTypes, f.Type()) {
+		return &rerror.Error{Label: ErrInvalidValidationRule, Err: fmt.Errorf("pattern rule is not supported by %s field", f.Type())}
+	}
+	return nil
+}
+
+// validateSchema checks if the rule can be set to the schema.
+func (r *ValidationRule) validateSchema(s *Schema) error {
+	if r.isFieldRule() {
+		return &rerror.Error{Label: ErrInvalidValidationRule, Err: fmt.Errorf("%s rule can not be set to a schema", r.t)}
+	}
+	f, o := s.Field(r.field), s.Field(r.other)
+	if f == nil || o == nil {
+		return &rerror.Error{Label: ErrInvalidValidationRule, Err: fmt.Errorf("field is not found")}
+	}
+	if f.Type() != o.Type() || !lo.Contains(comparableTypes, f.Type()) {
+		return &rerror.Error{Label: ErrInvalidValidationRule, Err: fmt.Errorf("%s field and %s field can not be compared", f.Type(), o.Type())}
+	}
+	return nil
+}
+
+func cloneValidationRules(rules []*ValidationRule) []*ValidationRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	return lo.Map(rules, func(r *ValidationRule, _ int) *ValidationRule { return r.Clone() })
+}
@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewValidationRule(t *testing.T) {
+	f1, f2 := id.NewFieldID(), id.NewFieldID()
+
+	r, err := NewValidationRule(ValidationRuleTypePattern, "^[a-z]+$", 3, f1, CompareOperatorLessThan, f2, "lower case only")
+	assert.NoError(t, err)
+	assert.Equal(t, ValidationRuleTypePattern, r.Type())
+	assert.Equal(t, "^[a-z]+$", r.Pattern())
+	assert.Equal(t, 0, r.Count())
+	assert.True(t, r.Field().IsEmpty())
+	assert.Equal(t, "lower case only", r.Message())
+
+	r, err = NewValidationRule(ValidationRuleTypeMaxCount, "", 3, f1, "", f2, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, r.Count())
+	assert.Equal(t, "", r.Pattern())
+
+	r, err = NewValidationRule(ValidationRuleTypeCompare, "", 0, f1, CompareOperatorGreaterThan, f2, "")
+	assert.NoError(t, err)
+	assert.Equal(t, f1, r.Field())
+	assert.Equal(t, CompareOperatorGreaterThan, r.Operator())
+	assert.Equal(t, f2, r.OtherField())
+
+	_, err = NewValidationRule(ValidationRuleTypePattern, "[", 0, f1, "", f2, "")
+	assert.True(t, rerror.Is(err, ErrInvalidValidationRule))
+	_, err = NewValidationRule(ValidationRuleTypeMinCount, "", -1, f1, "", f2, "")
+	assert.Equal(t, ErrInvalidValidationRule, err)
+	_, err = NewValidationRule(ValidationRuleTypeCompare, "", 0, f1, "x", f2, "")
+	assert.Equal(t, ErrInvalidValidationRule, err)
+	_, err = NewValidationRule(ValidationRuleTypeCompare, "", 0, f1, CompareOperatorEqual, f1, "")
+	assert.Equal(t, ErrInvalidValidationRule, err)
+	_, err = NewValidationRule("x", "", 0, f1, "", f2, "")
+	assert.Equal(t, ErrInvalidValidationRule, err)
+}
+
+func TestField_SetValidationRules(t *testing.T) {
+	text := NewField(NewText(nil).TypeProperty()).NewID().Key(id.RandomKey()).MustBuild()
+	pattern := lo.Must(NewPatternRule("^a", ""))
+	count := lo.Must(NewCountRule(ValidationRuleTypeMaxCount, 2, ""))
+	compare := lo.Must(NewCompareRule(id.NewFieldID(), CompareOperatorLessThan, id.NewFieldID(), ""))
+
+	assert.NoError(t, text.SetValidationRules([]*ValidationRule{pattern, count}))
+	assert.Equal(t, []*ValidationRule{pattern, count}, text.ValidationRules())
+	assert.True(t, rerror.Is(text.SetValidationRules([]*ValidationRule{compare}), ErrInvalidValidationRule))
+	assert.Equal(t, 2, len(text.Clone().ValidationRules()))
+
+	// the pattern rule is dropped as integers can not be matched with patterns
+	assert.NoError(t, text.SetTypeProperty(lo.Must(NewInteger(nil, nil)).TypeProperty()))
+	assert.Equal(t, []*ValidationRule{count}, text.ValidationRules())
+	assert.True(t, rerror.Is(text.SetValidationRules([]*ValidationRule{pattern}), ErrInvalidValidationRule))
+
+	assert.NoError(t, text.SetValidationRules(nil))
+	assert.Nil(t, text.ValidationRules())
+}
+
+func TestSchema_SetValidationRules(t *testing.T) {
+	start := NewField(NewDateTime().TypeProperty()).NewID().Key(id.NewKey("start")).MustBuild()
+	end := NewField(NewDateTime().TypeProperty()).NewID().Key(id.NewKey("end")).MustBuild()
+	num := NewField(lo.Must(NewNumber(nil, nil)).TypeProperty()).NewID().Key(id.NewKey("num")).MustBuild()
+	s := New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(FieldList{start, end, num}).MustBuild()
+
+	rule := lo.Must(NewCompareRule(end.ID(), CompareOperatorGreaterThan, start.ID(), ""))
+	assert.NoError(t, s.SetValidationRules([]*ValidationRule{rule}))
+	assert.Equal(t, []*ValidationRule{rule}, s.ValidationRules())
+	assert.Equal(t, []*ValidationRule{rule}, s.Clone().ValidationRules())
+
+	assert.True(t, rerror.Is(s.SetValidationRules([]*ValidationRule{lo.Must(NewCompareRule(end.ID(), CompareOperatorGreaterThan, num.ID(), ""))}), ErrInvalidValidationRule))
+	assert.True(t, rerror.Is(s.SetValidationRules([]*ValidationRule{lo.Must(NewCompareRule(end.ID(), CompareOperatorGreaterThan, id.NewFieldID(), ""))}), ErrInvalidValidationRule))
+	assert.True(t, rerror.Is(s.SetValidationRules([]*ValidationRule{lo.Must(NewCountRule(ValidationRuleTypeMinCount, 1, ""))}), ErrInvalidValidationRule))
+
+	_, err := New().NewID().Workspace(accountdomain.NewWorkspaceID()).Project(id.NewProjectID()).Fields(FieldList{start}).ValidationRules([]*ValidationRule{rule}).Build()
+	assert.True(t, rerror.Is(err, ErrInvalidValidationRule))
+
+	// the rules on a removed field are removed with it
+	s.RemoveField(start.ID())
+	assert.Nil(t, s.ValidationRules())
+}
@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/account/accountdomain"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestField_ValidateRules(t *testing.T) {
+	f := NewField(NewText(nil).TypeProperty()).
+		NewID().
+		Key(id.NewKey("code")).
+		Multiple(true).
+		ValidationRules([]*ValidationRule{
+			lo.Must(NewPatternRule("^[A-Z]{3}$", "use three capital letters")),
+			lo.Must(NewCountRule(ValidationRuleTypeMinCount, 2, "")),
+			lo.Must(NewCountRule(ValidationRuleTypeMaxCount, 3, "")),
+		}).
+		MustBuild()
+
+	assert.Nil(t, f.ValidateRules(nil))
+	assert.Nil(t, f.ValidateRules(value.NewMultiple(value.TypeText, []any{"ABC", "DEF"})))
+
+	errs := f.ValidateRules(value.NewMultiple(value.TypeText, []any{"abc", ""}))
+	assert.Equal(t, ValidationErrors{
+		{Field: f.ID(), Key: "code", Rule: ValidationRuleTypePattern, Message: "use three capital letters", Err: ErrPatternMismatch},
+		{Field: f.ID(), Key: "code", Rule: ValidationRuleTypeMinCount, Err: ErrTooFewValues},
+	}, errs)
+	assert.Equal(t, "code: use three capital letters; code: too few values", errs.Error())
+	assert.True(t, errors.Is(errs, ErrTooFewValues))
+
+	errs = f.ValidateRules(value.NewMultiple(value.TypeText, []any{"ABC", "DEF", "GHI", "JKL"}))
+	assert.Equal(t, ValidationErrors{
+		{Field: f.ID(), Key: "code", Rule: ValidationRuleTypeMaxCount, Err: ErrTooManyValues},
+	}, errs)
+}
+
+func TestSchema_ValidateRules(t *testing.T) {
+	start := NewField(NewDateTime().TypeProperty()).NewID().Key(id.NewKey("start")).MustBuild()
+	end := NewField(NewDateTime().TypeProperty()).NewID().Key(id.NewKey("end")).MustBuild()
+	s := New().
+		NewID().
+		Workspace(accountdomain.NewWorkspaceID()).
+		Project(id.NewProjectID()).
+		Fields(FieldList{start, end}).
+		ValidationRules([]*ValidationRule{lo.Must(NewCompareRule(end.ID(), CompareOperatorGreaterThan, start.ID(), ""))}).
+		MustBuild()
+
+	now := time.Now()
+	values := func(s, e any) map[FieldID]*value.Multiple {
+		return map[FieldID]*value.Multiple{
+			start.ID(): value.TypeDateTime.Value(s).AsMultiple(),
+			end.ID():   value.TypeDateTime.Value(e).AsMultiple(),
+		}
+	}
+
+	assert.Nil(t, s.ValidateRules(values(now, now.Add(time.Hour))))
+	assert.Equal(t, ValidationErrors{
+		{Field: end.ID(), Key: "end", Rule: ValidationRuleTypeCompare, Err: ErrComparisonFailed},
+	}, s.ValidateRules(values(now, now)))
+	assert.Nil(t, s.ValidateRules(values(now, nil)))
+	assert.Nil(t, s.ValidateRules(nil))
+}
+
+func TestCompareOperator_match(t *testing.T) {
+	assert.True(t, CompareOperatorLessThan.match(-1))
+	assert.False(t, CompareOperatorLessThan.match(0))
+	assert.True(t, CompareOperatorLessThanOrEqual.match(0))
+	assert.True(t, CompareOperatorGreaterThan.match(1))
+	assert.False(t, CompareOperatorGreaterThanOrEqual.match(-1))
+	assert.True(t, CompareOperatorEqual.match(0))
+	assert.True(t, CompareOperatorNotEqual.match(1))
+	assert.False(t, CompareOperator("x").match(0))
+}
+
+func TestValidationErrors_LocalizeError(t *testing.T) {
+	errs := ValidationErrors{
+		{Key: "a", Rule: ValidationRuleTypePattern, Message: "custom", Err: ErrPatternMismatch},
+		{Key: "b", Rule: ValidationRuleTypeMaxCount, Err: ErrTooManyValues},
+	}
+	b := i18n.NewBundle(language.English)
+	b.MustAddMessages(language.Japanese, &i18n.Message{ID: "too many values", Other: "値の数が多すぎます。"})
+
+	var res ValidationErrors
+	assert.True(t, errors.As(errs.LocalizeError(i18n.NewLocalizer(b, "ja")), &res))
+	assert.Equal(t, "a: custom; b: 値の数が多すぎます。", res.Error())
+	assert.Equal(t, "a: custom; b: too many values", errs.Error())
+}
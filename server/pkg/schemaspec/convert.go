@@ -0,0 +1,296 @@
+package schemaspec
+
+import (
+	"math"
+
+	"github.com/reearth/reearth-cms/server/pkg/group"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/model"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/samber/lo"
+)
+
+// FromProject describes the current models and groups of a project. The schemas should contain the schemas of the models and the groups.
+func FromProject(models model.List, groups group.List, schemas schema.List) *Spec {
+	mkeys := lo.SliceToMap(models, func(m *model.Model) (id.ModelID, string) { return m.ID(), m.Key().String() })
+	gkeys := lo.SliceToMap(groups, func(g *group.Group) (id.GroupID, string) { return g.ID(), g.Key().String() })
+	fieldKey := func(sid id.SchemaID, fid *id.FieldID) string {
+		if fid == nil {
+			return ""
+		}
+		if f := schemas.Schema(&sid).FieldByIDOrKey(fid, nil); f != nil {
+			return f.Key().String()
+		}
+		return ""
+	}
+
+	fields := func(s *schema.Schema) []Field {
+		if s == nil {
+			return nil
+		}
+		return lo.Map(s.Fields().Ordered(), func(f *schema.Field, _ int) Field {
+			return fromField(f, mkeys, gkeys, fieldKey)
+		})
+	}
+	rules := func(s *schema.Schema) []ValidationRule {
+		if s == nil {
+			return nil
+		}
+		return lo.Map(s.ValidationRules(), func(r *schema.ValidationRule, _ int) ValidationRule {
+			return fromValidationRule(r, func(fid id.FieldID) string { return fieldKey(s.ID(), &fid) })
+		})
+	}
+
+	res := &Spec{}
+	for _, g := range groups.Ordered() {
+		s := schemas.Schema(lo.ToPtr(g.Schema()))
+		res.Groups = append(res.Groups, Group{
+			Key:             g.Key().String(),
+			Name:            g.Name(),
+			Description:     g.Description(),
+			Fields:          fields(s),
+			ValidationRules: rules(s),
+		})
+	}
+	for _, m := range models.Ordered() {
+		s := schemas.Schema(lo.ToPtr(m.Schema()))
+		ms := Model{
+			Key:             m.Key().String(),
+			Name:            m.Name(),
+			Description:     m.Description(),
+			Fields:          fields(s),
+			ValidationRules: rules(s),
+		}
+		if s != nil {
+			ms.TitleField = fieldKey(s.ID(), s.TitleField())
+		}
+		res.Models = append(res.Models, ms)
+	}
+	return res
+}
+
+func fromField(f *schema.Field, mkeys map[id.ModelID]string, gkeys map[id.GroupID]string, fieldKey func(id.SchemaID, *id.FieldID) string) Field {
+	res := Field{
+		Key:         f.Key().String(),
+		Type:        f.Type(),
+		Name:        f.Name(),
+		Description: f.Description(),
+		Multiple:    f.Multiple(),
+		Required:    f.Required(),
+		Unique:      f.Unique(),
+		Localized:   f.Localized(),
+		Expression:  f.Expression().String(),
+		ValidationRules: lo.Map(f.ValidationRules(), func(r *schema.ValidationRule, _ int) ValidationRule {
+			return fromValidationRule(r, nil)
+		}),
+	}
+
+	f.TypeProperty().Match(schema.TypePropertyMatch{
+		Text:     func(p *schema.FieldText) { res.MaxLength = p.MaxLength() },
+		TextArea: func(p *schema.FieldTextArea) { res.MaxLength = p.MaxLength() },
+		RichText: func(p *schema.FieldRichText) { res.MaxLength = p.MaxLength() },
+		Markdown: func(p *schema.FieldMarkdown) { res.MaxLength = p.MaxLength() },
+		Integer: func(p *schema.FieldInteger) {
+			res.Min = intToFloat(p.Min())
+			res.Max = intToFloat(p.Max())
+		},
+		Number: func(p *schema.FieldNumber) {
+			res.Min = p.Min()
+			res.Max = p.Max()
+		},
+		Select: func(p *schema.FieldSelect) { res.Options = p.Values() },
+		Tag: func(p *schema.FieldTag) {
+			res.Tags = lo.Map(p.Tags(), func(t *schema.Tag, _ int) Tag {
+				return Tag{Name: t.Name(), Color: t.Color().String()}
+			})
+		},
+		Reference: func(p *schema.FieldReference) {
+			res.Reference = &Reference{
+				Model:              mkeys[p.Model()],
+				CorrespondingField: fieldKey(p.Schema(), p.CorrespondingFieldID()),
+			}
+		},
+		Group: func(p *schema.FieldGroup) { res.Group = gkeys[p.Group()] },
+		GeometryObject: func(p *schema.FieldGeometryObject) {
+			res.GeometryTypes = lo.Map(p.SupportedTypes(), func(t schema.GeometryObjectSupportedType, _ int) string { return t.String() })
+		},
+		GeometryEditor: func(p *schema.FieldGeometryEditor) {
+			res.GeometryTypes = lo.Map(p.SupportedTypes(), func(t schema.GeometryEditorSupportedType, _ int) string { return t.String() })
+		},
+	})
+	return res
+}
+
+func fromValidationRule(r *schema.ValidationRule, fieldKey func(id.FieldID) string) ValidationRule {
+	res := ValidationRule{Type: r.Type(), Pattern: r.Pattern(), Count: r.Count(), Message: r.Message()}
+	if r.Type() == schema.ValidationRuleTypeCompare && fieldKey != nil {
+		res.Field = fieldKey(r.Field())
+		res.Operator = r.Operator()
+		res.OtherField = fieldKey(r.OtherField())
+	}
+	return res
+}
+
+// normalize clears the attributes which are not used by the type of the rule.
+func (r ValidationRule) normalize() ValidationRule {
+	res := ValidationRule{Type: r.Type, Message: r.Message}
+	switch r.Type {
+	case schema.ValidationRuleTypePattern:
+		res.Pattern = r.Pattern
+	case schema.ValidationRuleTypeMinCount, schema.ValidationRuleTypeMaxCount:
+		res.Count = r.Count
+	case schema.ValidationRuleTypeCompare:
+		res.Field, res.Operator, res.OtherField = r.Field, r.Operator, r.OtherField
+	}
+	return res
+}
+
+// SchemaValidationRules builds the pattern and count rules of the field.
+func (f Field) SchemaValidationRules() ([]*schema.ValidationRule, error) {
+	res := make([]*schema.ValidationRule, 0, len(f.ValidationRules))
+	for _, r := range f.ValidationRules {
+		sr, err := schema.NewValidationRule(r.Type, r.Pattern, r.Count, id.FieldID{}, "", id.FieldID{}, r.Message)
+		if err != nil {
+			return nil, invalid("field %s: %v", f.Key, err)
+		}
+		res = append(res, sr)
+	}
+	return res, nil
+}
+
+// CompareRules builds the compare rules of a schema. fields is the IDs of the fields of the schema by their keys.
+func CompareRules(rules []ValidationRule, fields map[string]id.FieldID) ([]*schema.ValidationRule, error) {
+	res := make([]*schema.ValidationRule, 0, len(rules))
+	for _, r := range rules {
+		f, ok1 := fields[r.Field]
+		o, ok2 := fields[r.OtherField]
+		if !ok1 || !ok2 {
+			return nil, invalid("field of the validation rule is not found")
+		}
+		sr, err := schema.NewCompareRule(f, r.Operator, o, r.Message)
+		if err != nil {
+			return nil, invalid("validation rule %s %s %s: %v", r.Field, r.Operator, r.OtherField, err)
+		}
+		res = append(res, sr)
+	}
+	return res, nil
+}
+
+// Resolver resolves the keys of models, groups and fields in a spec to the entities of a project.
+type Resolver struct {
+	Models map[string]*model.Model
+	Groups map[string]*group.Group
+	// ModelFields is the IDs of the fields of each model by their keys.
+	ModelFields map[string]map[string]id.FieldID
+}
+
+// TypeProperty builds the type property of the field. The tags of the current field are kept as long as their names are not changed.
+func (f Field) TypeProperty(r *Resolver, current *schema.Field) (*schema.TypeProperty, error) {
+	switch f.Type {
+	case value.TypeText:
+		return schema.NewText(f.MaxLength).TypeProperty(), nil
+	case value.TypeTextArea:
+		return schema.NewTextArea(f.MaxLength).TypeProperty(), nil
+	case value.TypeRichText:
+		return schema.NewRichText(f.MaxLength).TypeProperty(), nil
+	case value.TypeMarkdown:
+		return schema.NewMarkdown(f.MaxLength).TypeProperty(), nil
+	case value.TypeAsset:
+		return schema.NewAsset().TypeProperty(), nil
+	case value.TypeDateTime:
+		return schema.NewDateTime().TypeProperty(), nil
+	case value.TypeBool:
+		return schema.NewBool().TypeProperty(), nil
+	case value.TypeCheckbox:
+		return schema.NewCheckbox().TypeProperty(), nil
+	case value.TypeURL:
+		return schema.NewURL().TypeProperty(), nil
+	case value.TypeSelect:
+		return schema.NewSelect(f.Options).TypeProperty(), nil
+	case value.TypeTag:
+		var tags schema.TagList
+		if current != nil {
+			current.TypeProperty().Match(schema.TypePropertyMatch{
+				Tag: func(p *schema.FieldTag) { tags = p.Tags() },
+			})
+		}
+		res, err := schema.NewFieldTag(lo.Map(f.Tags, func(t Tag, _ int) *schema.Tag {
+			if ct := tags.FindByName(t.Name); ct != nil {
+				return lo.Must(schema.NewTagWithID(ct.ID(), t.Name, schema.TagColorFrom(t.Color)))
+			}
+			return schema.NewTag(t.Name, schema.TagColorFrom(t.Color))
+		}))
+		if err != nil {
+			return nil, invalid("field %s: %v", f.Key, err)
+		}
+		return res.TypeProperty(), nil
+	case value.TypeInteger:
+		min, max := floatToInt(f.Min), floatToInt(f.Max)
+		if f.Min != nil && min == nil || f.Max != nil && max == nil {
+			return nil, invalid("field %s: min and max should be integers", f.Key)
+		}
+		res, err := schema.NewInteger(min, max)
+		if err != nil {
+			return nil, err
+		}
+		return res.TypeProperty(), nil
+	case value.TypeNumber:
+		res, err := schema.NewNumber(f.Min, f.Max)
+		if err != nil {
+			return nil, err
+		}
+		return res.TypeProperty(), nil
+	case value.TypeReference:
+		m := r.Models[f.Reference.Model]
+		if m == nil {
+			return nil, invalid("field %s: model %s is not found", f.Key, f.Reference.Model)
+		}
+		var cf *id.FieldID
+		if f.Reference.CorrespondingField != "" {
+			fid, ok := r.ModelFields[f.Reference.Model][f.Reference.CorrespondingField]
+			if !ok {
+				return nil, invalid("field %s: field %s is not found", f.Key, f.Reference.CorrespondingField)
+			}
+			cf = fid.Ref()
+		}
+		return schema.NewReference(m.ID(), m.Schema(), cf, nil).TypeProperty(), nil
+	case value.TypeGroup:
+		g := r.Groups[f.Group]
+		if g == nil {
+			return nil, invalid("field %s: group %s is not found", f.Key, f.Group)
+		}
+		return schema.NewGroup(g.ID()).TypeProperty(), nil
+	case value.TypeGeometryObject:
+		types := lo.Map(f.GeometryTypes, func(t string, _ int) schema.GeometryObjectSupportedType {
+			return schema.GeometryObjectSupportedTypeFrom(t)
+		})
+		if lo.Contains(types, "") {
+			return nil, invalid("field %s: geometry types are invalid", f.Key)
+		}
+		return schema.NewGeometryObject(types).TypeProperty(), nil
+	case value.TypeGeometryEditor:
+		types := lo.Map(f.GeometryTypes, func(t string, _ int) schema.GeometryEditorSupportedType {
+			return schema.GeometryEditorSupportedTypeFrom(t)
+		})
+		if lo.Contains(types, "") {
+			return nil, invalid("field %s: geometry types are invalid", f.Key)
+		}
+		return schema.NewGeometryEditor(types).TypeProperty(), nil
+	}
+	return nil, invalid("field %s: type %s is not supported", f.Key, f.Type)
+}
+
+func intToFloat(i *int64) *float64 {
+	if i == nil {
+		return nil
+	}
+	return lo.ToPtr(float64(*i))
+}
+
+func floatToInt(f *float64) *int64 {
+	if f == nil || *f != math.Trunc(*f) {
+		return nil
+	}
+	return lo.ToPtr(int64(*f))
+}
@@ -0,0 +1,226 @@
+package schemaspec
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/samber/lo"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeCreate ChangeType = "create"
+	ChangeTypeUpdate ChangeType = "update"
+	// ChangeTypeReplace means that the field is deleted and created again, as the type and the target of a field can not be changed.
+	ChangeTypeReplace ChangeType = "replace"
+	ChangeTypeDelete  ChangeType = "delete"
+)
+
+// Change is a change to a model, a group or a field. A change is destructive when it can lose the contents of the items.
+type Change struct {
+	Type        ChangeType `json:"type"`
+	Model       string     `json:"model,omitempty"`
+	Group       string     `json:"group,omitempty"`
+	Field       string     `json:"field,omitempty"`
+	Attributes  []string   `json:"attributes,omitempty"`
+	Destructive bool       `json:"destructive,omitempty"`
+}
+
+func (c Change) String() string {
+	var b strings.Builder
+	b.WriteString(string(c.Type))
+	if c.Model != "" {
+		b.WriteString(" model " + c.Model)
+	} else {
+		b.WriteString(" group " + c.Group)
+	}
+	if c.Field != "" {
+		b.WriteString(" field " + c.Field)
+	}
+	if len(c.Attributes) > 0 {
+		b.WriteString(" (" + strings.Join(c.Attributes, ", ") + ")")
+	}
+	if c.Destructive {
+		b.WriteString(" [destructive]")
+	}
+	return b.String()
+}
+
+type Plan []Change
+
+func (p Plan) Destructive() Plan {
+	return lo.Filter(p, func(c Change, _ int) bool { return c.Destructive })
+}
+
+func (p Plan) HasDestructiveChanges() bool {
+	return lo.SomeBy(p, func(c Change) bool { return c.Destructive })
+}
+
+// Diff computes the changes to make the current spec the desired one. Groups are listed before models, and deletions come last.
+func Diff(current, desired *Spec) Plan {
+	var res, deleted Plan
+
+	for i, g := range desired.Groups {
+		c := Change{Group: g.Key}
+		cg := current.Group(g.Key)
+		if cg == nil {
+			res = append(res, with(c, ChangeTypeCreate))
+			res = append(res, diffFields(c, nil, g.Fields)...)
+			continue
+		}
+		var attrs []string
+		attrs = appendIf(attrs, "name", cg.Name != g.NameOrKey())
+		attrs = appendIf(attrs, "description", cg.Description != g.Description)
+		attrs = appendIf(attrs, "order", slices.IndexFunc(current.Groups, func(g2 Group) bool { return g2.Key == g.Key }) != i)
+		attrs = appendIf(attrs, "validationRules", !equalRules(cg.ValidationRules, g.ValidationRules))
+		if len(attrs) > 0 {
+			c2 := with(c, ChangeTypeUpdate)
+			c2.Attributes = attrs
+			res = append(res, c2)
+		}
+		res = append(res, diffFields(c, cg.Fields, g.Fields)...)
+	}
+
+	for i, m := range desired.Models {
+		c := Change{Model: m.Key}
+		cm := current.Model(m.Key)
+		if cm == nil {
+			res = append(res, with(c, ChangeTypeCreate))
+			res = append(res, diffFields(c, nil, m.Fields)...)
+			continue
+		}
+		var attrs []string
+		attrs = appendIf(attrs, "name", cm.Name != m.NameOrKey())
+		attrs = appendIf(attrs, "description", cm.Description != m.Description)
+		attrs = appendIf(attrs, "titleField", cm.TitleField != m.TitleField)
+		attrs = appendIf(attrs, "order", slices.IndexFunc(current.Models, func(m2 Model) bool { return m2.Key == m.Key }) != i)
+		attrs = appendIf(attrs, "validationRules", !equalRules(cm.ValidationRules, m.ValidationRules))
+		if len(attrs) > 0 {
+			c2 := with(c, ChangeTypeUpdate)
+			c2.Attributes = attrs
+			res = append(res, c2)
+		}
+		res = append(res, diffFields(c, cm.Fields, m.Fields)...)
+	}
+
+	for _, m := range current.Models {
+		if desired.Model(m.Key) == nil {
+			deleted = append(deleted, Change{Type: ChangeTypeDelete, Model: m.Key, Destructive: true})
+		}
+	}
+	for _, g := range current.Groups {
+		if desired.Group(g.Key) == nil {
+			deleted = append(deleted, Change{Type: ChangeTypeDelete, Group: g.Key, Destructive: true})
+		}
+	}
+
+	return append(res, deleted...)
+}
+
+func diffFields(c Change, current, desired []Field) Plan {
+	var res Plan
+	for i, f := range desired {
+		fc := c
+		fc.Field = f.Key
+		cf, ok := lo.Find(current, func(cf Field) bool { return cf.Key == f.Key })
+		if !ok {
+			res = append(res, with(fc, ChangeTypeCreate))
+			continue
+		}
+		if f.RequiresReplacement(cf) {
+			fc.Type = ChangeTypeReplace
+			fc.Destructive = true
+			res = append(res, fc)
+			continue
+		}
+		order := slices.IndexFunc(current, func(f2 Field) bool { return f2.Key == f.Key }) != i
+		if attrs, destructive := f.diff(cf, order); len(attrs) > 0 {
+			fc.Type = ChangeTypeUpdate
+			fc.Attributes = attrs
+			fc.Destructive = destructive
+			res = append(res, fc)
+		}
+	}
+	for _, cf := range current {
+		if lo.NoneBy(desired, func(f Field) bool { return f.Key == cf.Key }) {
+			fc := c
+			fc.Field = cf.Key
+			fc.Type = ChangeTypeDelete
+			fc.Destructive = true
+			res = append(res, fc)
+		}
+	}
+	return res
+}
+
+// RequiresReplacement returns true when the field can not be updated from the current field, as the type or the target of the field is changed.
+func (f Field) RequiresReplacement(current Field) bool {
+	return f.Type != current.Type ||
+		f.Group != current.Group ||
+		lo.FromPtr(f.Reference) != lo.FromPtr(current.Reference)
+}
+
+func (f Field) diff(current Field, order bool) (attrs []string, destructive bool) {
+	add := func(name string, changed, d bool) {
+		if changed {
+			attrs = append(attrs, name)
+			destructive = destructive || d
+		}
+	}
+
+	add("name", current.Name != f.NameOrKey(), false)
+	add("description", current.Description != f.Description, false)
+	add("order", order, false)
+	// values except the first one are lost when a field is changed to single
+	add("multiple", current.Multiple != f.Multiple, current.Multiple)
+	add("required", current.Required != f.Required, false)
+	add("unique", current.Unique != f.Unique, false)
+	// values except the default locale are lost when a field is not localized anymore
+	add("localized", current.Localized != f.Localized, current.Localized)
+	add("maxLength", !equalPtr(current.MaxLength, f.MaxLength), false)
+	add("min", !equalPtr(current.Min, f.Min), false)
+	add("max", !equalPtr(current.Max, f.Max), false)
+
+	// the values of the removed options, tags and geometry types become invalid
+	options := schema.NewSelect(f.Options).Values()
+	add("options", !slices.Equal(current.Options, options), len(lo.Without(current.Options, options...)) > 0)
+
+	tags := lo.Map(f.Tags, func(t Tag, _ int) Tag {
+		return Tag{Name: strings.TrimSpace(t.Name), Color: schema.TagColorFrom(t.Color).String()}
+	})
+	tagNames, currentTagNames := lo.Map(tags, func(t Tag, _ int) string { return t.Name }), lo.Map(current.Tags, func(t Tag, _ int) string { return t.Name })
+	add("tags", !slices.Equal(current.Tags, tags), len(lo.Without(currentTagNames, tagNames...)) > 0)
+
+	geometryTypes := lo.Map(f.GeometryTypes, func(t string, _ int) string { return strings.ToUpper(t) })
+	add("geometryTypes", !slices.Equal(current.GeometryTypes, geometryTypes), len(lo.Without(current.GeometryTypes, geometryTypes...)) > 0)
+
+	add("validationRules", !equalRules(current.ValidationRules, f.ValidationRules), false)
+	// the values of a field are overwritten by the computed ones once the field is computed
+	add("expression", current.Expression != f.Expression, current.Expression == "")
+	return
+}
+
+func equalRules(a, b []ValidationRule) bool {
+	return slices.Equal(
+		lo.Map(a, func(r ValidationRule, _ int) ValidationRule { return r.normalize() }),
+		lo.Map(b, func(r ValidationRule, _ int) ValidationRule { return r.normalize() }),
+	)
+}
+
+func with(c Change, t ChangeType) Change {
+	c.Type = t
+	return c
+}
+
+func appendIf(l []string, s string, ok bool) []string {
+	if ok {
+		return append(l, s)
+	}
+	return l
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	return lo.FromPtr(a) == lo.FromPtr(b) && (a == nil) == (b == nil)
+}
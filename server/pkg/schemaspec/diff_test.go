@@ -0,0 +1,88 @@
+package schemaspec
+
+import (
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	current := &Spec{
+		Groups: []Group{{Key: "address", Name: "address"}},
+		Models: []Model{
+			{Key: "shop", Name: "Shop", Fields: []Field{
+				{Key: "name", Name: "name", Type: value.TypeText},
+				{Key: "category", Name: "category", Type: value.TypeSelect, Options: []string{"food", "goods"}},
+				{Key: "price", Name: "price", Type: value.TypeText},
+				{Key: "memo", Name: "memo", Type: value.TypeText},
+			}},
+			{Key: "staff", Name: "staff"},
+		},
+	}
+	desired := &Spec{
+		Models: []Model{
+			{Key: "shop", Name: "Shop", Fields: []Field{
+				{Key: "name", Type: value.TypeText, Required: true},
+				{Key: "category", Type: value.TypeSelect, Options: []string{"food"}},
+				{Key: "price", Type: value.TypeInteger},
+				{Key: "url", Type: value.TypeURL},
+			}},
+			{Key: "item"},
+		},
+	}
+
+	plan := Diff(current, desired)
+	assert.Equal(t, Plan{
+		{Type: ChangeTypeUpdate, Model: "shop", Field: "name", Attributes: []string{"required"}},
+		{Type: ChangeTypeUpdate, Model: "shop", Field: "category", Attributes: []string{"options"}, Destructive: true},
+		{Type: ChangeTypeReplace, Model: "shop", Field: "price", Destructive: true},
+		{Type: ChangeTypeCreate, Model: "shop", Field: "url"},
+		{Type: ChangeTypeDelete, Model: "shop", Field: "memo", Destructive: true},
+		{Type: ChangeTypeCreate, Model: "item"},
+		{Type: ChangeTypeDelete, Model: "staff", Destructive: true},
+		{Type: ChangeTypeDelete, Group: "address", Destructive: true},
+	}, plan)
+	assert.True(t, plan.HasDestructiveChanges())
+	assert.Equal(t, 5, len(plan.Destructive()))
+	assert.Equal(t, "update model shop field category (options) [destructive]", plan[1].String())
+
+	assert.Empty(t, Diff(current, current))
+}
+
+func TestField_RequiresReplacement(t *testing.T) {
+	ref := Field{Key: "ref", Type: value.TypeReference, Reference: &Reference{Model: "a"}}
+	assert.False(t, ref.RequiresReplacement(Field{Key: "ref", Type: value.TypeReference, Reference: &Reference{Model: "a"}}))
+	assert.True(t, ref.RequiresReplacement(Field{Key: "ref", Type: value.TypeReference, Reference: &Reference{Model: "b"}}))
+	assert.True(t, ref.RequiresReplacement(Field{Key: "ref", Type: value.TypeReference, Reference: &Reference{Model: "a", CorrespondingField: "x"}}))
+	assert.True(t, ref.RequiresReplacement(Field{Key: "ref", Type: value.TypeText}))
+}
+
+func TestField_diff(t *testing.T) {
+	tags := Field{Key: "tag", Name: "tag", Type: value.TypeTag, Tags: []Tag{{Name: "a", Color: "red"}, {Name: "b", Color: "blue"}}}
+
+	attrs, destructive := Field{Key: "tag", Type: value.TypeTag, Tags: []Tag{{Name: "a", Color: "RED"}, {Name: "b", Color: "blue"}, {Name: "c"}}}.diff(tags, false)
+	assert.Equal(t, []string{"tags"}, attrs)
+	assert.False(t, destructive)
+
+	attrs, destructive = Field{Key: "tag", Type: value.TypeTag, Tags: []Tag{{Name: "a", Color: "red"}}}.diff(tags, true)
+	assert.Equal(t, []string{"order", "tags"}, attrs)
+	assert.True(t, destructive)
+
+	attrs, destructive = Field{Key: "tag", Type: value.TypeTag, Multiple: true, Tags: tags.Tags}.diff(tags, false)
+	assert.Equal(t, []string{"multiple"}, attrs)
+	assert.False(t, destructive)
+
+	attrs, destructive = tags.diff(Field{Key: "tag", Name: "tag", Type: value.TypeTag, Multiple: true, Tags: tags.Tags}, false)
+	assert.Equal(t, []string{"multiple"}, attrs)
+	assert.True(t, destructive)
+
+	// the attributes which are not used by the type of a rule are ignored
+	rules := Field{Key: "code", Name: "code", Type: value.TypeText, ValidationRules: []ValidationRule{{Type: schema.ValidationRuleTypePattern, Pattern: "^a"}}}
+	attrs, _ = Field{Key: "code", Type: value.TypeText, ValidationRules: []ValidationRule{{Type: schema.ValidationRuleTypePattern, Pattern: "^a", Count: 1}}}.diff(rules, false)
+	assert.Empty(t, attrs)
+	attrs, destructive = Field{Key: "code", Type: value.TypeText, Expression: "upper(name)"}.diff(rules, false)
+	assert.Equal(t, []string{"validationRules", "expression"}, attrs)
+	assert.True(t, destructive)
+}
@@ -0,0 +1,304 @@
+package schemaspec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/reearth/reearth-cms/server/pkg/expression"
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidSpec = rerror.NewE(i18n.T("invalid schema spec"))
+
+// Spec describes the models and the groups of a project with their fields, so that the schemas can be managed as code.
+// Models, groups and fields are identified by their keys.
+type Spec struct {
+	Models []Model `json:"models,omitempty" yaml:"models,omitempty"`
+	Groups []Group `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+type Model struct {
+	Key         string  `json:"key" yaml:"key"`
+	Name        string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Description string  `json:"description,omitempty" yaml:"description,omitempty"`
+	TitleField  string  `json:"titleField,omitempty" yaml:"titleField,omitempty"`
+	Fields      []Field `json:"fields,omitempty" yaml:"fields,omitempty"`
+	// ValidationRules are the compare rules across the fields.
+	ValidationRules []ValidationRule `json:"validationRules,omitempty" yaml:"validationRules,omitempty"`
+}
+
+type Group struct {
+	Key         string  `json:"key" yaml:"key"`
+	Name        string  `json:"name,omitempty" yaml:"name,omitempty"`
+	Description string  `json:"description,omitempty" yaml:"description,omitempty"`
+	Fields      []Field `json:"fields,omitempty" yaml:"fields,omitempty"`
+	// ValidationRules are the compare rules across the fields.
+	ValidationRules []ValidationRule `json:"validationRules,omitempty" yaml:"validationRules,omitempty"`
+}
+
+type Field struct {
+	Key         string     `json:"key" yaml:"key"`
+	Type        value.Type `json:"type" yaml:"type"`
+	Name        string     `json:"name,omitempty" yaml:"name,omitempty"`
+	Description string     `json:"description,omitempty" yaml:"description,omitempty"`
+	Multiple    bool       `json:"multiple,omitempty" yaml:"multiple,omitempty"`
+	Required    bool       `json:"required,omitempty" yaml:"required,omitempty"`
+	Unique      bool       `json:"unique,omitempty" yaml:"unique,omitempty"`
+	Localized   bool       `json:"localized,omitempty" yaml:"localized,omitempty"`
+	// MaxLength is for text, textArea, richText and markdown fields.
+	MaxLength *int `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	// Min and Max are for integer and number fields.
+	Min *float64 `json:"min,omitempty" yaml:"min,omitempty"`
+	Max *float64 `json:"max,omitempty" yaml:"max,omitempty"`
+	// Options is for select fields.
+	Options []string `json:"options,omitempty" yaml:"options,omitempty"`
+	// Tags is for tag fields.
+	Tags []Tag `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// Reference is for reference fields.
+	Reference *Reference `json:"reference,omitempty" yaml:"reference,omitempty"`
+	// Group is the key of the group of group fields.
+	Group string `json:"group,omitempty" yaml:"group,omitempty"`
+	// GeometryTypes is for geometryObject and geometryEditor fields.
+	GeometryTypes []string `json:"geometryTypes,omitempty" yaml:"geometryTypes,omitempty"`
+	// ValidationRules are the pattern and count rules of the field.
+	ValidationRules []ValidationRule `json:"validationRules,omitempty" yaml:"validationRules,omitempty"`
+	// Expression makes the field computed from the other fields, which are referred to by their keys.
+	Expression string `json:"expression,omitempty" yaml:"expression,omitempty"`
+}
+
+// ValidationRule is a validation rule of a field or a schema. Only the attributes of the type are used.
+type ValidationRule struct {
+	Type schema.ValidationRuleType `json:"type" yaml:"type"`
+	// Pattern is for pattern rules.
+	Pattern string `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	// Count is for minCount and maxCount rules.
+	Count int `json:"count,omitempty" yaml:"count,omitempty"`
+	// Field, Operator and OtherField are for compare rules. The fields are referred to by their keys.
+	Field      string                 `json:"field,omitempty" yaml:"field,omitempty"`
+	Operator   schema.CompareOperator `json:"operator,omitempty" yaml:"operator,omitempty"`
+	OtherField string                 `json:"otherField,omitempty" yaml:"otherField,omitempty"`
+	Message    string                 `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+type Reference struct {
+	// Model is the key of the referenced model.
+	Model string `json:"model" yaml:"model"`
+	// CorrespondingField is the key of the field of the referenced model that refers back to this field.
+	// It is empty for one-way references.
+	CorrespondingField string `json:"correspondingField,omitempty" yaml:"correspondingField,omitempty"`
+}
+
+type Tag struct {
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color,omitempty" yaml:"color,omitempty"`
+}
+
+var types = []value.Type{
+	value.TypeText,
+	value.TypeTextArea,
+	value.TypeRichText,
+	value.TypeMarkdown,
+	value.TypeAsset,
+	value.TypeDateTime,
+	value.TypeBool,
+	value.TypeCheckbox,
+	value.TypeSelect,
+	value.TypeTag,
+	value.TypeInteger,
+	value.TypeNumber,
+	value.TypeReference,
+	value.TypeURL,
+	value.TypeGroup,
+	value.TypeGeometryObject,
+	value.TypeGeometryEditor,
+}
+
+// Parse reads a spec written in YAML or JSON.
+func Parse(r io.Reader) (*Spec, error) {
+	d := yaml.NewDecoder(r)
+	d.KnownFields(true)
+	s := &Spec{}
+	if err := d.Decode(s); err != nil && !errors.Is(err, io.EOF) {
+		return nil, &rerror.Error{Label: ErrInvalidSpec, Err: err}
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ParseBytes is the same as Parse but reads a byte slice.
+func ParseBytes(b []byte) (*Spec, error) {
+	return Parse(bytes.NewReader(b))
+}
+
+func (s *Spec) Model(key string) *Model {
+	if s == nil {
+		return nil
+	}
+	m, ok := lo.Find(s.Models, func(m Model) bool { return m.Key == key })
+	if !ok {
+		return nil
+	}
+	return &m
+}
+
+func (s *Spec) Group(key string) *Group {
+	if s == nil {
+		return nil
+	}
+	g, ok := lo.Find(s.Groups, func(g Group) bool { return g.Key == key })
+	if !ok {
+		return nil
+	}
+	return &g
+}
+
+func (m Model) NameOrKey() string {
+	return lo.CoalesceOrEmpty(m.Name, m.Key)
+}
+
+func (g Group) NameOrKey() string {
+	return lo.CoalesceOrEmpty(g.Name, g.Key)
+}
+
+// NameOrKey returns the name of the field. The key is used as the name when the name is omitted.
+func (f Field) NameOrKey() string {
+	return lo.CoalesceOrEmpty(f.Name, f.Key)
+}
+
+// Validate checks that the keys are valid and unique and that every reference and group field points to a model or a group in the spec.
+func (s *Spec) Validate() error {
+	if s == nil {
+		return invalid("spec is empty")
+	}
+	if k, ok := duplicatedKey(lo.Map(s.Models, func(m Model, _ int) string { return m.Key })); ok {
+		return invalid("model %s is duplicated", k)
+	}
+	if k, ok := duplicatedKey(lo.Map(s.Groups, func(g Group, _ int) string { return g.Key })); ok {
+		return invalid("group %s is duplicated", k)
+	}
+
+	for _, g := range s.Groups {
+		if !validModelKey(g.Key) {
+			return invalid("group key %s is invalid", g.Key)
+		}
+		if err := s.validateFields("group "+g.Key, g.Fields); err != nil {
+			return err
+		}
+		if err := validateCompareRules("group "+g.Key, g.Fields, g.ValidationRules); err != nil {
+			return err
+		}
+	}
+	for _, m := range s.Models {
+		if !validModelKey(m.Key) {
+			return invalid("model key %s is invalid", m.Key)
+		}
+		if err := s.validateFields("model "+m.Key, m.Fields); err != nil {
+			return err
+		}
+		if err := validateCompareRules("model "+m.Key, m.Fields, m.ValidationRules); err != nil {
+			return err
+		}
+		if m.TitleField != "" && lo.NoneBy(m.Fields, func(f Field) bool { return f.Key == m.TitleField }) {
+			return invalid("model %s: title field %s is not found", m.Key, m.TitleField)
+		}
+
+		for _, f := range m.Fields {
+			if f.Reference == nil || f.Reference.CorrespondingField == "" {
+				continue
+			}
+			// both sides of a two-way reference should be described
+			rf, ok := lo.Find(s.Model(f.Reference.Model).Fields, func(rf Field) bool { return rf.Key == f.Reference.CorrespondingField })
+			if !ok || rf.Reference == nil || rf.Reference.Model != m.Key || rf.Reference.CorrespondingField != f.Key {
+				return invalid("model %s: field %s: corresponding field %s should refer back to it", m.Key, f.Key, f.Reference.CorrespondingField)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Spec) validateFields(name string, fields []Field) error {
+	if k, ok := duplicatedKey(lo.Map(fields, func(f Field, _ int) string { return f.Key })); ok {
+		return invalid("%s: field %s is duplicated", name, k)
+	}
+
+	for _, f := range fields {
+		if !id.NewKey(f.Key).IsValid() {
+			return invalid("%s: field key %s is invalid", name, f.Key)
+		}
+		if !lo.Contains(types, f.Type) {
+			return invalid("%s: field %s: type %s is not supported", name, f.Key, f.Type)
+		}
+		if (f.Type == value.TypeReference) != (f.Reference != nil) {
+			return invalid("%s: field %s: reference should be specified only for reference fields", name, f.Key)
+		}
+		if f.Reference != nil && s.Model(f.Reference.Model) == nil {
+			return invalid("%s: field %s: model %s is not found", name, f.Key, f.Reference.Model)
+		}
+		if (f.Type == value.TypeGroup) != (f.Group != "") {
+			return invalid("%s: field %s: group should be specified only for group fields", name, f.Key)
+		}
+		if f.Group != "" && s.Group(f.Group) == nil {
+			return invalid("%s: field %s: group %s is not found", name, f.Key, f.Group)
+		}
+		if f.Type.IsGeometryFieldType() && len(f.GeometryTypes) == 0 {
+			return invalid("%s: field %s: geometry types are required", name, f.Key)
+		}
+		if lo.SomeBy(f.ValidationRules, func(r ValidationRule) bool { return r.Type == schema.ValidationRuleTypeCompare }) {
+			return invalid("%s: field %s: compare rules should be specified for the model or the group", name, f.Key)
+		}
+		if f.Expression != "" {
+			e, err := expression.Parse(f.Expression)
+			if err != nil {
+				return invalid("%s: field %s: %v", name, f.Key, err)
+			}
+			if k, ok := lo.Find(e.Fields(), func(k string) bool { return lo.NoneBy(fields, func(f Field) bool { return f.Key == k }) }); ok {
+				return invalid("%s: field %s: field %s in the expression is not found", name, f.Key, k)
+			}
+		}
+	}
+	return nil
+}
+
+func validateCompareRules(name string, fields []Field, rules []ValidationRule) error {
+	for _, r := range rules {
+		if r.Type != schema.ValidationRuleTypeCompare {
+			return invalid("%s: %s rules should be specified for fields", name, r.Type)
+		}
+		for _, k := range []string{r.Field, r.OtherField} {
+			if lo.NoneBy(fields, func(f Field) bool { return f.Key == k }) {
+				return invalid("%s: field %s in the validation rule is not found", name, k)
+			}
+		}
+	}
+	return nil
+}
+
+// validModelKey reports whether k can be used as a key of a model or a group, as both are used in API endpoints.
+func validModelKey(k string) bool {
+	return id.NewKey(k).IsURLCompatible() && len(k) > 2
+}
+
+func duplicatedKey(keys []string) (string, bool) {
+	d := lo.FindDuplicates(keys)
+	if len(d) == 0 {
+		return "", false
+	}
+	return d[0], true
+}
+
+func invalid(format string, a ...any) error {
+	return &rerror.Error{
+		Label: ErrInvalidSpec,
+		Err:   fmt.Errorf(format, a...),
+	}
+}
@@ -0,0 +1,178 @@
+package schemaspec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/reearth/reearth-cms/server/pkg/schema"
+	"github.com/reearth/reearth-cms/server/pkg/value"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	yml := `
+groups:
+  - key: address
+    fields:
+      - key: city
+        type: text
+models:
+  - key: shop
+    name: Shop
+    titleField: name
+    fields:
+      - key: name
+        type: text
+        maxLength: 100
+        required: true
+      - key: category
+        type: select
+        options: [food, goods]
+      - key: address
+        type: group
+        group: address
+      - key: location
+        type: geometryObject
+        geometryTypes: [POINT]
+      - key: staff
+        type: reference
+        multiple: true
+        reference:
+          model: staff
+          correspondingField: shop
+  - key: staff
+    fields:
+      - key: shop
+        type: reference
+        reference:
+          model: shop
+          correspondingField: staff
+`
+	s, err := Parse(strings.NewReader(yml))
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(s.Groups))
+	assert.Equal(t, 2, len(s.Models))
+	assert.Equal(t, Field{Key: "name", Type: value.TypeText, MaxLength: lo.ToPtr(100), Required: true}, s.Models[0].Fields[0])
+	assert.Equal(t, []string{"food", "goods"}, s.Models[0].Fields[1].Options)
+	assert.Equal(t, &Reference{Model: "staff", CorrespondingField: "shop"}, s.Models[0].Fields[4].Reference)
+
+	// JSON is also accepted
+	s, err = ParseBytes([]byte(`{"models":[{"key":"shop","fields":[{"key":"name","type":"text"}]}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "name", s.Models[0].Fields[0].Key)
+
+	_, err = ParseBytes([]byte(`{"models":[{"key":"shop","unknown":true}]}`))
+	assert.True(t, rerror.Is(err, ErrInvalidSpec))
+}
+
+func TestSpec_Validate(t *testing.T) {
+	text := Field{Key: "name", Type: value.TypeText}
+
+	tests := []struct {
+		name    string
+		spec    *Spec
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			spec: &Spec{Models: []Model{{Key: "shop", TitleField: "name", Fields: []Field{text}}}},
+		},
+		{
+			name:    "duplicated model",
+			spec:    &Spec{Models: []Model{{Key: "shop"}, {Key: "shop"}}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicated field",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{text, text}}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid model key",
+			spec:    &Spec{Models: []Model{{Key: "a b"}}},
+			wantErr: true,
+		},
+		{
+			name:    "empty field key",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{{Type: value.TypeText}}}}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{{Key: "name", Type: "foo"}}}}},
+			wantErr: true,
+		},
+		{
+			name:    "title field not found",
+			spec:    &Spec{Models: []Model{{Key: "shop", TitleField: "title", Fields: []Field{text}}}},
+			wantErr: true,
+		},
+		{
+			name:    "referenced model not found",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{{Key: "ref", Type: value.TypeReference, Reference: &Reference{Model: "staff"}}}}}},
+			wantErr: true,
+		},
+		{
+			name: "corresponding field does not refer back",
+			spec: &Spec{Models: []Model{
+				{Key: "shop", Fields: []Field{{Key: "ref", Type: value.TypeReference, Reference: &Reference{Model: "staff", CorrespondingField: "shop"}}}},
+				{Key: "staff", Fields: []Field{{Key: "shop", Type: value.TypeReference, Reference: &Reference{Model: "shop"}}}},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "group not found",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{{Key: "address", Type: value.TypeGroup, Group: "address"}}}}},
+			wantErr: true,
+		},
+		{
+			name:    "geometry types are missing",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{{Key: "location", Type: value.TypeGeometryObject}}}}},
+			wantErr: true,
+		},
+		{
+			name: "rules and expression",
+			spec: &Spec{Models: []Model{{Key: "shop", Fields: []Field{
+				{Key: "code", Type: value.TypeText, ValidationRules: []ValidationRule{{Type: schema.ValidationRuleTypePattern, Pattern: "^[A-Z]+$"}}},
+				{Key: "label", Type: value.TypeText, Expression: `upper(code)`},
+				{Key: "from", Type: value.TypeInteger},
+				{Key: "to", Type: value.TypeInteger},
+			}, ValidationRules: []ValidationRule{{Type: schema.ValidationRuleTypeCompare, Field: "from", Operator: schema.CompareOperatorLessThan, OtherField: "to"}}}}},
+		},
+		{
+			name:    "compare rule on a field",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{{Key: "name", Type: value.TypeText, ValidationRules: []ValidationRule{{Type: schema.ValidationRuleTypeCompare}}}}}}},
+			wantErr: true,
+		},
+		{
+			name:    "field of compare rule not found",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{text}, ValidationRules: []ValidationRule{{Type: schema.ValidationRuleTypeCompare, Field: "name", Operator: schema.CompareOperatorLessThan, OtherField: "x"}}}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid expression",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{text, {Key: "label", Type: value.TypeText, Expression: "name +"}}}}},
+			wantErr: true,
+		},
+		{
+			name:    "field in expression not found",
+			spec:    &Spec{Models: []Model{{Key: "shop", Fields: []Field{text, {Key: "label", Type: value.TypeText, Expression: "upper(title)"}}}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.spec.Validate()
+			if tt.wantErr {
+				assert.True(t, rerror.Is(err, ErrInvalidSpec), err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
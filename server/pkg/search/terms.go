@@ -0,0 +1,48 @@
+package search
+
+import (
+	"strings"
+)
+
+// Terms is the frequency of each term of a document, multiplied by the weight of the text the term came from.
+type Terms map[string]int
+
+func NewTerms() Terms {
+	return Terms{}
+}
+
+// Add tokenizes the text and adds its terms with the weight.
+func (t Terms) Add(text string, weight int) {
+	if weight <= 0 {
+		return
+	}
+	for _, tok := range Tokenize(text) {
+		t[tok] += weight
+	}
+}
+
+// Score returns the relevance of the document for the query tokens.
+// A query token matches the terms which start with it, and exact matches count double.
+// Every query token has to match, so zero is returned when one of them does not.
+func (t Terms) Score(tokens []string) float64 {
+	if len(tokens) == 0 || len(t) == 0 {
+		return 0
+	}
+
+	score := 0
+	for _, tok := range tokens {
+		s := 0
+		for term, f := range t {
+			if term == tok {
+				s += f * 2
+			} else if strings.HasPrefix(term, tok) {
+				s += f
+			}
+		}
+		if s == 0 {
+			return 0
+		}
+		score += s
+	}
+	return float64(score) / float64(len(tokens))
+}
@@ -0,0 +1,29 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerms(t *testing.T) {
+	terms := NewTerms()
+	terms.Add("東京都の天気", 2)
+	terms.Add("Tokyo weather", 1)
+	terms.Add("ignored", 0)
+
+	assert.Equal(t, Terms{
+		"東京": 2, "京都": 2, "都の": 2, "の天": 2, "天気": 2, "気": 2,
+		"tokyo": 1, "weather": 1,
+	}, terms)
+
+	assert.Equal(t, 0.0, terms.Score(nil))
+	assert.Equal(t, 0.0, terms.Score(Tokenize("ignored")))
+	assert.Equal(t, 0.0, terms.Score(Tokenize("tokyo rain")))
+	assert.Equal(t, 2.0, terms.Score(Tokenize("tokyo")))
+	assert.Equal(t, 1.0, terms.Score(Tokenize("tok")))
+	assert.Equal(t, 4.0, terms.Score(Tokenize("東京")[:1]))
+	// "京" matches "京都" as a prefix
+	assert.Equal(t, 2.0, terms.Score([]string{"京"}))
+	assert.Equal(t, 4.0, terms.Score(Tokenize("天気")))
+}
@@ -0,0 +1,56 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Tokenize splits the text into the terms of the search index.
+// The text is normalized with NFKC and lower-cased so full-width and half-width characters match each other.
+// Runs of letters and digits become one term each. Japanese and Chinese text has no spaces between words,
+// so runs of kanji, hiragana and katakana are split into overlapping bigrams followed by the last character.
+func Tokenize(text string) []string {
+	var res []string
+	var word, cjk []rune
+
+	flush := func() {
+		if len(word) > 0 {
+			res = append(res, string(word))
+			word = word[:0]
+		}
+		if len(cjk) > 0 {
+			for i := 0; i+1 < len(cjk); i++ {
+				res = append(res, string(cjk[i:i+2]))
+			}
+			res = append(res, string(cjk[len(cjk)-1]))
+			cjk = cjk[:0]
+		}
+	}
+
+	for _, r := range strings.ToLower(norm.NFKC.String(text)) {
+		switch {
+		case isCJK(r):
+			if len(word) > 0 {
+				flush()
+			}
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r):
+			if len(cjk) > 0 {
+				flush()
+			}
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+
+	return res
+}
+
+func isCJK(r rune) bool {
+	// U+30FC (prolonged sound mark) and U+3005 (iteration mark) are not in the scripts below but are used in words
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー' || r == '々'
+}
@@ -0,0 +1,31 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "empty", text: "", want: nil},
+		{name: "words", text: "Hello, World! 2024", want: []string{"hello", "world", "2024"}},
+		{name: "full-width", text: "ＡＢＣ１２３", want: []string{"abc123"}},
+		{name: "half-width katakana", text: "ｶﾀｶﾅ", want: []string{"カタ", "タカ", "カナ", "ナ"}},
+		{name: "japanese", text: "東京都の天気", want: []string{"東京", "京都", "都の", "の天", "天気", "気"}},
+		{name: "single kanji", text: "雨", want: []string{"雨"}},
+		{name: "prolonged sound mark", text: "データ", want: []string{"デー", "ータ", "タ"}},
+		{name: "mixed", text: "reearthのCMS", want: []string{"reearth", "の", "cms"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, Tokenize(tt.text))
+		})
+	}
+}
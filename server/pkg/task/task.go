@@ -8,11 +8,12 @@ import (
 )
 
 type Payload struct {
-	DecompressAsset *DecompressAssetPayload
-	CompressAsset   *CompressAssetPayload
-	Webhook         *WebhookPayload
-	Copy            *CopyPayload
-	Import          *ImportPayload
+	DecompressAsset      *DecompressAssetPayload
+	CompressAsset        *CompressAssetPayload
+	Webhook              *WebhookPayload
+	Copy                 *CopyPayload
+	Import               *ImportPayload
+	ExtractAssetMetadata *ExtractAssetMetadataPayload
 }
 
 type DecompressAssetPayload struct {
@@ -26,6 +27,16 @@ func (t *DecompressAssetPayload) Payload() Payload {
 	}
 }
 
+type ExtractAssetMetadataPayload struct {
+	AssetID string
+}
+
+func (t *ExtractAssetMetadataPayload) Payload() Payload {
+	return Payload{
+		ExtractAssetMetadata: t,
+	}
+}
+
 type CompressAssetPayload struct {
 	AssetID string
 }
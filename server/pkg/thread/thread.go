@@ -92,3 +92,9 @@ func (th *Thread) Clone() *Thread {
 		comments:  comments,
 	}
 }
+
+// ThreadComment is a comment with the thread it belongs to. It is sent to webhooks on comment events.
+type ThreadComment struct {
+	Thread  *Thread
+	Comment *Comment
+}
@@ -0,0 +1,102 @@
+package trash
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+type Builder struct {
+	e *Entry
+}
+
+func New() *Builder {
+	return &Builder{e: &Entry{}}
+}
+
+func (b *Builder) Build() (*Entry, error) {
+	if b.e.id.IsNil() || b.e.workspace.IsNil() || b.e.project.IsNil() {
+		return nil, ErrInvalidID
+	}
+	switch b.e.typ {
+	case TypeItem:
+		if b.e.item == nil || b.e.model == nil {
+			return nil, ErrInvalidID
+		}
+	case TypeAsset:
+		if b.e.asset == nil {
+			return nil, ErrInvalidID
+		}
+	case TypeModel:
+		if b.e.model == nil {
+			return nil, ErrInvalidID
+		}
+	default:
+		return nil, ErrInvalidType
+	}
+	return b.e, nil
+}
+
+func (b *Builder) MustBuild() *Entry {
+	return lo.Must(b.Build())
+}
+
+func (b *Builder) ID(id ID) *Builder {
+	b.e.id = id
+	return b
+}
+
+func (b *Builder) NewID() *Builder {
+	b.e.id = NewID()
+	return b
+}
+
+func (b *Builder) Workspace(workspace WorkspaceID) *Builder {
+	b.e.workspace = workspace
+	return b
+}
+
+func (b *Builder) Project(project ProjectID) *Builder {
+	b.e.project = project
+	return b
+}
+
+// Item sets the trashed item and its model.
+func (b *Builder) Item(item ItemID, model ModelID) *Builder {
+	b.e.typ = TypeItem
+	b.e.item = item.Ref()
+	b.e.model = model.Ref()
+	return b
+}
+
+func (b *Builder) Asset(asset AssetID) *Builder {
+	b.e.typ = TypeAsset
+	b.e.asset = asset.Ref()
+	return b
+}
+
+func (b *Builder) Model(model ModelID) *Builder {
+	b.e.typ = TypeModel
+	b.e.model = model.Ref()
+	return b
+}
+
+func (b *Builder) Name(name string) *Builder {
+	b.e.name = name
+	return b
+}
+
+func (b *Builder) User(user *UserID) *Builder {
+	b.e.user = user.CloneRef()
+	return b
+}
+
+func (b *Builder) Integration(integration *IntegrationID) *Builder {
+	b.e.integration = integration.CloneRef()
+	return b
+}
+
+func (b *Builder) DeletedAt(t time.Time) *Builder {
+	b.e.deletedAt = t
+	return b
+}
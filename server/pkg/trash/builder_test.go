@@ -0,0 +1,66 @@
+package trash
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_Build(t *testing.T) {
+	wid := NewWorkspaceID()
+	pid := NewProjectID()
+
+	tests := []struct {
+		name    string
+		b       *Builder
+		wantErr error
+	}{
+		{
+			name: "item",
+			b:    New().NewID().Workspace(wid).Project(pid).Item(NewItemID(), NewModelID()),
+		},
+		{
+			name: "asset",
+			b:    New().NewID().Workspace(wid).Project(pid).Asset(NewAssetID()),
+		},
+		{
+			name: "model",
+			b:    New().NewID().Workspace(wid).Project(pid).Model(NewModelID()),
+		},
+		{
+			name:    "no id",
+			b:       New().Workspace(wid).Project(pid).Model(NewModelID()),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "no workspace",
+			b:       New().NewID().Project(pid).Model(NewModelID()),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "no project",
+			b:       New().NewID().Workspace(wid).Model(NewModelID()),
+			wantErr: ErrInvalidID,
+		},
+		{
+			name:    "no type",
+			b:       New().NewID().Workspace(wid).Project(pid),
+			wantErr: ErrInvalidType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.b.Build()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+
+	assert.Panics(t, func() { New().MustBuild() })
+}
@@ -0,0 +1,31 @@
+package trash
+
+import (
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearthx/account/accountdomain"
+)
+
+type ID = id.TrashID
+type IDList = id.TrashIDList
+type ProjectID = id.ProjectID
+type ModelID = id.ModelID
+type ItemID = id.ItemID
+type AssetID = id.AssetID
+type IntegrationID = id.IntegrationID
+type UserID = accountdomain.UserID
+type WorkspaceID = accountdomain.WorkspaceID
+
+var NewID = id.NewTrashID
+var NewProjectID = id.NewProjectID
+var NewModelID = id.NewModelID
+var NewItemID = id.NewItemID
+var NewAssetID = id.NewAssetID
+var NewIntegrationID = id.NewIntegrationID
+var NewUserID = accountdomain.NewUserID
+var NewWorkspaceID = accountdomain.NewWorkspaceID
+
+var MustID = id.MustTrashID
+var IDFrom = id.TrashIDFrom
+var IDFromRef = id.TrashIDFromRef
+
+var ErrInvalidID = id.ErrInvalidID
@@ -0,0 +1,19 @@
+package trash
+
+import (
+	"golang.org/x/exp/slices"
+)
+
+type List []*Entry
+
+// SortByDeletedAt sorts the entries from the most recently deleted.
+func (l List) SortByDeletedAt() List {
+	m := slices.Clone(l)
+	slices.SortStableFunc(m, func(a, b *Entry) int {
+		if c := b.DeletedAt().Compare(a.DeletedAt()); c != 0 {
+			return c
+		}
+		return b.ID().Compare(a.ID())
+	})
+	return m
+}
@@ -0,0 +1,22 @@
+package trash
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestList_SortByDeletedAt(t *testing.T) {
+	now := time.Now()
+	b := func(t time.Time) *Entry {
+		return New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Asset(NewAssetID()).DeletedAt(t).MustBuild()
+	}
+	e1 := b(now.Add(-time.Hour))
+	e2 := b(now)
+	e3 := b(now.Add(-time.Minute))
+
+	l := List{e1, e2, e3}
+	assert.Equal(t, List{e2, e3, e1}, l.SortByDeletedAt())
+	assert.Equal(t, List{e1, e2, e3}, l)
+}
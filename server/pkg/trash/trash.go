@@ -0,0 +1,106 @@
+package trash
+
+import (
+	"time"
+
+	"github.com/reearth/reearthx/i18n"
+	"github.com/reearth/reearthx/rerror"
+)
+
+var (
+	ErrInvalidType = rerror.NewE(i18n.T("invalid trash type"))
+)
+
+type Type string
+
+const (
+	TypeItem  Type = "item"
+	TypeAsset Type = "asset"
+	TypeModel Type = "model"
+)
+
+func TypeFrom(s string) (Type, bool) {
+	switch t := Type(s); t {
+	case TypeItem, TypeAsset, TypeModel:
+		return t, true
+	}
+	return "", false
+}
+
+// Entry records an item, an asset or a model moved to the trash of a project.
+// The entity is hidden until it is restored, or until the entry expires and the entity is purged permanently.
+type Entry struct {
+	id          ID
+	workspace   WorkspaceID
+	project     ProjectID
+	typ         Type
+	item        *ItemID
+	asset       *AssetID
+	model       *ModelID
+	name        string
+	user        *UserID
+	integration *IntegrationID
+	deletedAt   time.Time
+}
+
+func (e *Entry) ID() ID {
+	return e.id
+}
+
+func (e *Entry) Workspace() WorkspaceID {
+	return e.workspace
+}
+
+func (e *Entry) Project() ProjectID {
+	return e.project
+}
+
+func (e *Entry) Type() Type {
+	return e.typ
+}
+
+// Item returns the trashed item. It is set only for entries of items.
+func (e *Entry) Item() *ItemID {
+	return e.item.CloneRef()
+}
+
+// Asset returns the trashed asset. It is set only for entries of assets.
+func (e *Entry) Asset() *AssetID {
+	return e.asset.CloneRef()
+}
+
+// Model returns the trashed model, or the model of the trashed item.
+func (e *Entry) Model() *ModelID {
+	return e.model.CloneRef()
+}
+
+// Name returns a display name of the trashed entity such as the file name of the asset or the name of the model.
+func (e *Entry) Name() string {
+	return e.name
+}
+
+func (e *Entry) User() *UserID {
+	return e.user.CloneRef()
+}
+
+func (e *Entry) Integration() *IntegrationID {
+	return e.integration.CloneRef()
+}
+
+func (e *Entry) DeletedAt() time.Time {
+	if e.deletedAt.IsZero() {
+		return e.id.Timestamp()
+	}
+	return e.deletedAt
+}
+
+// IsDeletedBy returns true when the entity was moved to the trash by the user or the integration.
+func (e *Entry) IsDeletedBy(u *UserID, i *IntegrationID) bool {
+	if u != nil && e.user != nil {
+		return *u == *e.user
+	}
+	if i != nil && e.integration != nil {
+		return *i == *e.integration
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package trash
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeFrom(t *testing.T) {
+	got, ok := TypeFrom("item")
+	assert.True(t, ok)
+	assert.Equal(t, TypeItem, got)
+
+	got, ok = TypeFrom("asset")
+	assert.True(t, ok)
+	assert.Equal(t, TypeAsset, got)
+
+	got, ok = TypeFrom("model")
+	assert.True(t, ok)
+	assert.Equal(t, TypeModel, got)
+
+	got, ok = TypeFrom("project")
+	assert.False(t, ok)
+	assert.Equal(t, Type(""), got)
+}
+
+func TestEntry(t *testing.T) {
+	tid := NewID()
+	wid := NewWorkspaceID()
+	pid := NewProjectID()
+	iid := NewItemID()
+	mid := NewModelID()
+	uid := NewUserID()
+	now := time.Now()
+
+	e := New().ID(tid).Workspace(wid).Project(pid).Item(iid, mid).Name("item").User(&uid).DeletedAt(now).MustBuild()
+	assert.Equal(t, tid, e.ID())
+	assert.Equal(t, wid, e.Workspace())
+	assert.Equal(t, pid, e.Project())
+	assert.Equal(t, TypeItem, e.Type())
+	assert.Equal(t, &iid, e.Item())
+	assert.Equal(t, &mid, e.Model())
+	assert.Nil(t, e.Asset())
+	assert.Equal(t, "item", e.Name())
+	assert.Equal(t, &uid, e.User())
+	assert.Nil(t, e.Integration())
+	assert.Equal(t, now, e.DeletedAt())
+
+	e2 := New().ID(tid).Workspace(wid).Project(pid).Model(mid).MustBuild()
+	assert.Equal(t, tid.Timestamp(), e2.DeletedAt())
+}
+
+func TestEntry_IsDeletedBy(t *testing.T) {
+	uid := NewUserID()
+	iid := NewIntegrationID()
+	b := func() *Builder {
+		return New().NewID().Workspace(NewWorkspaceID()).Project(NewProjectID()).Asset(NewAssetID())
+	}
+
+	e := b().User(&uid).MustBuild()
+	assert.True(t, e.IsDeletedBy(&uid, nil))
+	assert.False(t, e.IsDeletedBy(NewUserID().Ref(), nil))
+	assert.False(t, e.IsDeletedBy(nil, &iid))
+
+	e = b().Integration(&iid).MustBuild()
+	assert.True(t, e.IsDeletedBy(nil, &iid))
+	assert.False(t, e.IsDeletedBy(&uid, nil))
+	assert.False(t, e.IsDeletedBy(nil, nil))
+}
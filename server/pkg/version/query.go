@@ -1,12 +1,26 @@
 package version
 
 import (
+	"time"
+
 	"github.com/samber/lo"
 )
 
 type Query struct {
-	all bool
-	eq  *VersionOrRef
+	all     bool
+	eq      *VersionOrRef
+	overlay *overlay
+	at      *at
+}
+
+type overlay struct {
+	ref  Ref
+	base Ref
+}
+
+type at struct {
+	ref  Ref
+	time time.Time
 }
 
 func All() Query {
@@ -17,9 +31,22 @@ func Eq(vr VersionOrRef) Query {
 	return Query{eq: lo.ToPtr(vr)}
 }
 
+// Overlay returns a query which matches the versions pointed by the ref.
+// For objects which the ref does not point any version of, the versions pointed by the base ref are matched instead.
+func Overlay(ref, base Ref) Query {
+	return Query{overlay: &overlay{ref: ref, base: base}}
+}
+
+// At returns a query which matches the versions pointed by the recorded ref at the time.
+func At(ref Ref, t time.Time) Query {
+	return Query{at: &at{ref: ref, time: t}}
+}
+
 type QueryMatch struct {
-	All func()
-	Eq  func(VersionOrRef)
+	All     func()
+	Eq      func(VersionOrRef)
+	Overlay func(Ref, Ref)
+	At      func(Ref, time.Time)
 }
 
 func (q Query) Match(m QueryMatch) {
@@ -31,4 +58,12 @@ func (q Query) Match(m QueryMatch) {
 		m.Eq(*q.eq)
 		return
 	}
+	if q.overlay != nil && m.Overlay != nil {
+		m.Overlay(q.overlay.ref, q.overlay.base)
+		return
+	}
+	if q.at != nil && m.At != nil {
+		m.At(q.at.ref, q.at.time)
+		return
+	}
 }
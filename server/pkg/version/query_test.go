@@ -2,6 +2,7 @@ package version
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -15,6 +16,15 @@ func TestEq(t *testing.T) {
 	assert.Equal(t, Query{eq: v.OrRef().Ref()}, Eq(v.OrRef()))
 }
 
+func TestOverlay(t *testing.T) {
+	assert.Equal(t, Query{overlay: &overlay{ref: Ref("a"), base: Public}}, Overlay(Ref("a"), Public))
+}
+
+func TestAt(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, Query{at: &at{ref: Public, time: now}}, At(Public, now))
+}
+
 func TestQuery_Match(t *testing.T) {
 	res := ""
 	qm := QueryMatch{
@@ -24,6 +34,12 @@ func TestQuery_Match(t *testing.T) {
 		Eq: func(ref VersionOrRef) {
 			res = "eq"
 		},
+		Overlay: func(ref, base Ref) {
+			res = "overlay:" + ref.String() + ":" + base.String()
+		},
+		At: func(ref Ref, _ time.Time) {
+			res = "at:" + ref.String()
+		},
 	}
 	q := All()
 	q.Match(qm)
@@ -32,4 +48,12 @@ func TestQuery_Match(t *testing.T) {
 	q = Eq(New().OrRef())
 	q.Match(qm)
 	assert.Equal(t, "eq", res)
+
+	q = Overlay(Ref("a"), Public)
+	q.Match(qm)
+	assert.Equal(t, "overlay:a:public", res)
+
+	q = At(Public, time.Now())
+	q.Match(qm)
+	assert.Equal(t, "at:public", res)
 }
@@ -1,6 +1,10 @@
 package version
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/chrispappas/golang-generics-set/set"
 	"github.com/samber/lo"
 )
@@ -8,8 +12,18 @@ import (
 const Latest = Ref("latest")
 const Public = Ref("public")
 
+const (
+	stagingSeparator  = ":"
+	snapshotSeparator = "@"
+)
+
 type Ref string
 
+// StagingRef returns the ref which stages versions to preview them over the public versions, such as the ref of a release.
+func StagingRef(kind, id string) Ref {
+	return Ref(kind + stagingSeparator + id)
+}
+
 func (r Ref) Ref() *Ref {
 	return &r
 }
@@ -29,6 +43,39 @@ func (r *Ref) OrLatest() *Ref {
 	return r
 }
 
+// IsStaging returns true when the ref is a staging ref. Items which are not staged are read from the public ref instead.
+func (r Ref) IsStaging() bool {
+	return strings.Contains(string(r), stagingSeparator)
+}
+
+// At returns the ref which reads the versions which the ref pointed at the time. Only the moves of recorded refs can be read.
+func (r Ref) At(t time.Time) Ref {
+	return Ref(string(r) + snapshotSeparator + strconv.FormatInt(t.UnixNano(), 10))
+}
+
+// Snapshot returns the ref and the time of the ref returned by At.
+func (r Ref) Snapshot() (Ref, time.Time, bool) {
+	base, at, ok := strings.Cut(string(r), snapshotSeparator)
+	if !ok || base == "" {
+		return "", time.Time{}, false
+	}
+	nsec, err := strconv.ParseInt(at, 10, 64)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return Ref(base), time.Unix(0, nsec), true
+}
+
+func (r Ref) IsSnapshot() bool {
+	_, _, ok := r.Snapshot()
+	return ok
+}
+
+// IsRecorded returns true when the moves of the ref are recorded to read the versions at a point in time.
+func (r Ref) IsRecorded() bool {
+	return r == Public
+}
+
 func (r Ref) IsSpecial() bool {
 	return r == Ref("") || r == Latest
 }
@@ -2,6 +2,7 @@ package version
 
 import (
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -38,3 +39,33 @@ func TestRefsFrom(t *testing.T) {
 	assert.True(t, s.Has("y"))
 	assert.False(t, s.Has("z"))
 }
+
+func TestStagingRef(t *testing.T) {
+	r := StagingRef("release", "x")
+	assert.Equal(t, Ref("release:x"), r)
+	assert.True(t, r.IsStaging())
+	assert.False(t, Latest.IsStaging())
+	assert.False(t, Public.IsStaging())
+}
+
+func TestRef_At(t *testing.T) {
+	now := time.Date(2024, 4, 1, 12, 30, 0, 123456789, time.UTC)
+	r := Public.At(now)
+	assert.True(t, r.IsSnapshot())
+	assert.False(t, r.IsStaging())
+	ref, at, ok := r.Snapshot()
+	assert.True(t, ok)
+	assert.Equal(t, Public, ref)
+	assert.True(t, now.Equal(at))
+
+	_, _, ok = Public.Snapshot()
+	assert.False(t, ok)
+	assert.False(t, Ref("public@x").IsSnapshot())
+	assert.False(t, Ref("@1").IsSnapshot())
+}
+
+func TestRef_IsRecorded(t *testing.T) {
+	assert.True(t, Public.IsRecorded())
+	assert.False(t, Latest.IsRecorded())
+	assert.False(t, StagingRef("release", "x").IsRecorded())
+}
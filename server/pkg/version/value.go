@@ -1,6 +1,9 @@
 package version
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 type Value[T any] struct {
 	version Version
@@ -8,6 +11,15 @@ type Value[T any] struct {
 	refs    Refs
 	time    time.Time
 	value   T
+	// periods are kept only in Values, so values got from Values do not have them
+	periods []period
+}
+
+// period is a period in which a recorded ref pointed the version.
+type period struct {
+	ref  Ref
+	from time.Time
+	to   *time.Time
 }
 
 func NewValue[T any](version Version, parents Versions, refs Refs, t time.Time, value T) *Value[T] {
@@ -75,6 +87,14 @@ func (v *Value[T]) Clone() *Value[T] {
 	return NewValue(v.version, v.parents, v.refs, v.time, v.value)
 }
 
+func (v *Value[T]) cloneWithPeriods() *Value[T] {
+	res := v.Clone()
+	if res != nil {
+		res.periods = slices.Clone(v.periods)
+	}
+	return res
+}
+
 func (v *Value[T]) AddRefs(refs ...Ref) {
 	if v.refs == nil {
 		v.refs = Refs{}
@@ -92,6 +112,39 @@ func (v *Value[T]) DeleteRefs(refs ...Ref) {
 	}
 }
 
+// startPeriod records that the ref has pointed the version since the time.
+func (v *Value[T]) startPeriod(r Ref, t time.Time) {
+	v.periods = append(v.periods, period{ref: r, from: t})
+}
+
+// endPeriod records that the ref stopped pointing the version at the time.
+func (v *Value[T]) endPeriod(r Ref, t time.Time) {
+	for i, p := range v.periods {
+		if p.ref == r && p.to == nil {
+			v.periods[i].to = &t
+			return
+		}
+	}
+	// the ref has pointed the version since before its moves were recorded
+	v.periods = append(v.periods, period{ref: r, from: v.time, to: &t})
+}
+
+// pointedAt returns true when the ref pointed the version at the time.
+// Versions which the ref has pointed since before its moves were recorded are regarded as pointed since they were created.
+func (v *Value[T]) pointedAt(r Ref, t time.Time) bool {
+	recorded := false
+	for _, p := range v.periods {
+		if p.ref != r {
+			continue
+		}
+		if !p.from.After(t) && (p.to == nil || p.to.After(t)) {
+			return true
+		}
+		recorded = true
+	}
+	return !recorded && v.refs.Has(r) && !v.time.After(t)
+}
+
 func (v *Value[T]) validate() bool {
 	return !v.version.IsZero() && !v.Parents().Has(v.version)
 }
@@ -1,6 +1,8 @@
 package version
 
 import (
+	"time"
+
 	"github.com/chrispappas/golang-generics-set/set"
 	"github.com/reearth/reearthx/i18n"
 	"github.com/reearth/reearthx/rerror"
@@ -86,7 +88,7 @@ func (v *Values[V]) Clone() *Values[V] {
 		return nil
 	}
 	return &Values[V]{
-		inner:    v.All(),
+		inner:    util.Map(v.inner, func(v *Value[V]) *Value[V] { return v.cloneWithPeriods() }),
 		archived: v.archived,
 	}
 }
@@ -124,17 +126,53 @@ func (v *Values[V]) UpdateRef(r Ref, vr *VersionOrRef) {
 	}
 
 	// delete ref
-	if v := v.get(r.OrVersion()); v != nil {
-		v.DeleteRefs(r)
-	}
-	if vr == nil {
-		return
+	prev := v.get(r.OrVersion())
+	if prev != nil {
+		prev.DeleteRefs(r)
 	}
 
 	// set ref to specified version
-	if v2 := v.get(*vr); v2 != nil {
-		v2.AddRefs(r)
+	var next *Value[V]
+	if vr != nil {
+		next = v.get(*vr)
+	}
+	if next != nil {
+		next.AddRefs(r)
+	}
+
+	if r.IsRecorded() && prev != next {
+		now := util.Now()
+		if prev != nil {
+			prev.endPeriod(r, now)
+		}
+		if next != nil {
+			next.startPeriod(r, now)
+		}
+	}
+}
+
+// GetAt returns the version which the recorded ref pointed at the time.
+func (v *Values[V]) GetAt(r Ref, t time.Time) *Value[V] {
+	if v == nil || !r.IsRecorded() {
+		return nil
 	}
+	w, _ := lo.Find(v.inner, func(w *Value[V]) bool {
+		return w.pointedAt(r, t)
+	})
+	return w.Clone()
+}
+
+// Replace overwrites the value of the version without creating a new version. It returns false when the version is not found.
+func (v *Values[V]) Replace(ver Version, value V) bool {
+	if v == nil || v.IsArchived() {
+		return false
+	}
+	w := v.get(ver.OrRef())
+	if w == nil {
+		return false
+	}
+	w.value = value
+	return true
 }
 
 func cloneValues[V any](values []*Value[V]) []*Value[V] {
@@ -183,6 +183,25 @@ func TestValues_Add(t *testing.T) {
 	assert.Nil(t, v.inner[0].parents)
 }
 
+func TestValues_Replace(t *testing.T) {
+	vx, vy := New(), New()
+	v := &Values[string]{
+		inner: []*Value[string]{
+			NewValue(vx, NewVersions(vy), NewRefs(Latest), time.Time{}, "1"),
+			NewValue(vy, nil, NewRefs("a"), time.Time{}, "2"),
+		},
+	}
+
+	assert.True(t, v.Replace(vy, "3"))
+	assert.Equal(t, NewValue(vy, nil, NewRefs("a"), time.Time{}, "3"), v.Get(vy.OrRef()))
+	assert.Equal(t, NewValue(vx, NewVersions(vy), NewRefs(Latest), time.Time{}, "1"), v.Get(vx.OrRef()))
+	assert.False(t, v.Replace(New(), "4"))
+
+	v.archived = true
+	assert.False(t, v.Replace(vx, "4"))
+	assert.Equal(t, "1", v.Get(vx.OrRef()).Value())
+}
+
 func TestValues_UpdateRef(t *testing.T) {
 	vx, vy := New(), New()
 
@@ -325,6 +344,41 @@ func TestValues_UpdateRef(t *testing.T) {
 	}
 }
 
+func TestValues_GetAt(t *testing.T) {
+	vx, vy, vz := New(), New(), New()
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1, t2, t3 := t0.Add(time.Hour), t0.Add(2*time.Hour), t0.Add(3*time.Hour)
+
+	// x has been public since before the moves were recorded
+	v := &Values[string]{
+		inner: []*Value[string]{
+			NewValue(vx, nil, NewRefs(Public), t0, "x"),
+			NewValue(vy, NewVersions(vx), nil, t1, "y"),
+			NewValue(vz, NewVersions(vy), NewRefs(Latest), t2, "z"),
+		},
+	}
+	assert.Nil(t, v.GetAt(Public, t0.Add(-time.Second)))
+	assert.Equal(t, vx, v.GetAt(Public, t0).Version())
+
+	// publish y at t2, unpublish at t3
+	defer util.MockNow(t2)()
+	v.UpdateRef(Public, vy.OrRef().Ref())
+	defer util.MockNow(t3)()
+	v.UpdateRef(Public, nil)
+
+	assert.Nil(t, v.GetAt(Public, t0.Add(-time.Second)))
+	assert.Equal(t, vx, v.GetAt(Public, t0).Version())
+	assert.Equal(t, vx, v.GetAt(Public, t2.Add(-time.Second)).Version())
+	assert.Equal(t, vy, v.GetAt(Public, t2).Version())
+	assert.Nil(t, v.GetAt(Public, t3))
+	assert.Nil(t, v.Get(Public.OrVersion()))
+
+	// moves of unrecorded refs are not recorded
+	v.UpdateRef("a", vz.OrRef().Ref())
+	assert.Nil(t, v.GetAt("a", t3))
+	assert.Nil(t, (*Values[string])(nil).GetAt(Public, t0))
+}
+
 func TestUnwrapValues(t *testing.T) {
 	assert.Equal(t, []int{1, 2, 3}, UnwrapValues([]*Value[int]{{value: 1}, {value: 2}, {value: 3}}))
 	assert.Nil(t, UnwrapValues[int](nil))
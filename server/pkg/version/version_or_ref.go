@@ -5,6 +5,14 @@ type VersionOrRef struct {
 	ref     Ref
 }
 
+// ParseVersionOrRef parses s as a version, and treats s as a ref when it is not a version.
+func ParseVersionOrRef(s string) VersionOrRef {
+	if v := ParseVersion(&s); v != nil {
+		return v.OrRef()
+	}
+	return Ref(s).OrVersion()
+}
+
 func (vr VersionOrRef) Ref() *VersionOrRef {
 	return &vr
 }
@@ -6,6 +6,13 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestParseVersionOrRef(t *testing.T) {
+	v := New()
+	assert.Equal(t, v.OrRef(), ParseVersionOrRef(v.String()))
+	assert.Equal(t, Public.OrVersion(), ParseVersionOrRef("public"))
+	assert.True(t, ParseVersionOrRef("").IsZero())
+}
+
 func TestVersionOrRef_IsZero(t *testing.T) {
 	assert.False(t, New().OrRef().IsZero())
 	assert.False(t, Ref("x").OrVersion().IsZero())
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/reearth/reearth-cms/worker/internal/adapter/http"
+	"github.com/reearth/reearth-cms/worker/internal/infrastructure/aws"
+	"github.com/reearth/reearth-cms/worker/internal/infrastructure/gcp"
+	rmongo "github.com/reearth/reearth-cms/worker/internal/infrastructure/mongo"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/interactor"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/repo"
+	"github.com/reearth/reearthx/log"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+)
+
+const defaultRetention = 30 * 24 * time.Hour
+
+func main() {
+	log.Infof("reearth-cms/worker: purger has started")
+	ctx := context.Background()
+
+	if err := godotenv.Load(".env"); err != nil && !os.IsNotExist(err) {
+		log.Fatal("config: unable to load .env")
+	} else if err == nil {
+		log.Info("config: .env loaded")
+	}
+
+	dbURI := mustGetEnv("REEARTH_CMS_DB")
+	retention := defaultRetention
+	if v := os.Getenv("REEARTH_CMS_PURGER_RETENTION"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("invalid retention %s: %v", v, err)
+		}
+		retention = d
+	}
+	var batchSize int64
+	if v := os.Getenv("REEARTH_CMS_PURGER_BATCH_SIZE"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			log.Fatalf("invalid batch size %s", v)
+		}
+		batchSize = n
+	}
+
+	repos, err := initRepos(ctx, dbURI)
+	if err != nil {
+		log.Fatalf("failed to initialize repositories with DB URI %s: %v", dbURI, err)
+	}
+	gateways, err := initGateways(ctx)
+	if err != nil {
+		log.Fatalf("failed to initialize gateways: %v", err)
+	}
+
+	uc := interactor.NewUsecase(gateways, repos)
+	ctrl := http.NewPurgeController(uc)
+	if err := ctrl.Purge(ctx, http.PurgeInput{Retention: retention, BatchSize: batchSize}); err != nil {
+		log.Fatalf("purge operation failed: %v", err)
+	}
+}
+
+func mustGetEnv(key string) string {
+	value := os.Getenv(key)
+	if value == "" {
+		log.Fatalf("environment variable %s is required", key)
+	}
+	return value
+}
+
+func initRepos(ctx context.Context, dbURI string) (*repo.Container, error) {
+	client, err := mongo.Connect(
+		ctx,
+		options.Client().
+			ApplyURI(dbURI).
+			SetConnectTimeout(10*time.Second).
+			SetMonitor(otelmongo.NewMonitor()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	db := client.Database("reearth_cms")
+	return rmongo.New(ctx, nil, nil, rmongo.NewTrash(db))
+}
+
+// initGateways initializes the file storage which the files of purged assets are deleted from.
+// The files are kept when no bucket is configured.
+func initGateways(ctx context.Context) (*gateway.Container, error) {
+	gateways := &gateway.Container{}
+	if b := os.Getenv("REEARTH_CMS_PURGER_GCS_BUCKET_NAME"); b != "" {
+		log.Infof("file: GCS storage is used: %s\n", b)
+		f, err := gcp.NewFile(b, "")
+		if err != nil {
+			return nil, err
+		}
+		gateways.File = f
+	} else if b := os.Getenv("REEARTH_CMS_PURGER_S3_BUCKET_NAME"); b != "" {
+		log.Infof("file: S3 storage is used: %s\n", b)
+		f, err := aws.NewFile(ctx, b, "")
+		if err != nil {
+			return nil, err
+		}
+		gateways.File = f
+	}
+	return gateways, nil
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/worker/internal/usecase/interactor"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/util"
+)
+
+type PurgeController struct {
+	usecase *interactor.Usecase
+}
+
+func NewPurgeController(u *interactor.Usecase) *PurgeController {
+	return &PurgeController{
+		usecase: u,
+	}
+}
+
+type PurgeInput struct {
+	Retention time.Duration `json:"retention"`
+	BatchSize int64         `json:"batchSize"`
+}
+
+func (c *PurgeController) Purge(ctx context.Context, input PurgeInput) error {
+	n, err := c.usecase.Purge(ctx, util.Now().Add(-input.Retention), input.BatchSize)
+	if err != nil {
+		return err
+	}
+	log.Infof("reearth-cms/worker: %d trash entries have been purged", n)
+	return nil
+}
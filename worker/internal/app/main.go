@@ -45,7 +45,7 @@ func Start(debug bool, version string) {
 	}
 	mongoWebhook := rmongo.NewWebhook(client.Database("reearth_cms"))
 	lo.Must0(mongoWebhook.InitIndex(ctx))
-	repos, err := rmongo.New(ctx, mongoWebhook, nil)
+	repos, err := rmongo.New(ctx, mongoWebhook, nil, nil)
 	if err != nil {
 		log.Fatalf("repo initialization error: %+v\n", err)
 	}
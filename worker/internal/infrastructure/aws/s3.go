@@ -13,13 +13,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
 )
 
 const (
-	s3AssetBasePath string = "assets"
+	s3AssetBasePath      string = "assets"
+	s3DerivativeBasePath string = "derivatives"
 )
 
 type fileRepo struct {
@@ -144,6 +146,47 @@ func (f *fileRepo) WriteProceeded(ctx context.Context, filePath string, proceede
 	return nil
 }
 
+func (f *fileRepo) DeleteAll(ctx context.Context, prefix string) error {
+	return f.deleteAll(ctx, s3AssetBasePath, prefix)
+}
+
+func (f *fileRepo) DeleteDerivatives(ctx context.Context, prefix string) error {
+	return f.deleteAll(ctx, s3DerivativeBasePath, prefix)
+}
+
+func (f *fileRepo) deleteAll(ctx context.Context, base, prefix string) error {
+	if prefix == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	p := s3.NewListObjectsV2Paginator(f.s3Client, &s3.ListObjectsV2Input{
+		Bucket: &f.bucketName,
+		Prefix: aws.String(path.Join(base, prefix) + "/"),
+	})
+	for p.HasMorePages() {
+		page, err := p.NextPage(ctx)
+		if err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		if len(page.Contents) == 0 {
+			continue
+		}
+
+		objects := make([]types.ObjectIdentifier, 0, len(page.Contents))
+		for _, o := range page.Contents {
+			objects = append(objects, types.ObjectIdentifier{Key: o.Key})
+		}
+		if _, err := f.s3Client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: &f.bucketName,
+			Delete: &types.Delete{Objects: objects},
+		}); err != nil {
+			log.Errorf("s3: delete err: %+v\n", err)
+			return gateway.ErrFailedToRemoveFile
+		}
+	}
+	return nil
+}
+
 func getS3ObjectKeyFromURL(assetBasePath, assetPath string) string {
 	if assetPath == "" {
 		return ""
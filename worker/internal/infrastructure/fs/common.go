@@ -2,6 +2,11 @@ package fs
 
 import "errors"
 
+const (
+	fsAssetBasePath      = "assets"
+	fsDerivativeBasePath = "derivatives"
+)
+
 var (
 	errInvalidBaseURL = errors.New("invalid base URL")
 )
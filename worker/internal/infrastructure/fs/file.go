@@ -76,3 +76,24 @@ func (f *fileRepo) Upload(ctx context.Context, name string) (io.WriteCloser, err
 func (f *fileRepo) WriteProceeded(ctx context.Context, path string, proceeded int64) error {
 	return nil
 }
+
+// DeleteAll implements gateway.File. The prefix is relative to the asset directory as in the other storages.
+func (f *fileRepo) DeleteAll(ctx context.Context, prefix string) error {
+	return f.deleteAll(fsAssetBasePath, prefix)
+}
+
+// DeleteDerivatives implements gateway.File
+func (f *fileRepo) DeleteDerivatives(ctx context.Context, prefix string) error {
+	return f.deleteAll(fsDerivativeBasePath, prefix)
+}
+
+func (f *fileRepo) deleteAll(base, prefix string) error {
+	if prefix == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	if err := f.fs.RemoveAll(path.Join(base, sanitize.Path(prefix))); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
@@ -3,8 +3,10 @@ package fs
 import (
 	"context"
 	"io"
+	"os"
 	"testing"
 
+	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 )
@@ -58,3 +60,22 @@ func Test_fileRepo_Upload(t *testing.T) {
 	c, _ := io.ReadAll(f2)
 	assert.Equal(t, string(byte), string(c))
 }
+
+func Test_fileRepo_DeleteAll(t *testing.T) {
+	fs := mockFs()
+	f, _ := NewFile(fs, "")
+
+	assert.ErrorIs(t, f.DeleteAll(context.Background(), ""), gateway.ErrInvalidFile)
+	_ = afero.WriteFile(fs, "assets/51/30c89f/xxx.txt", []byte("xxx"), 0644)
+	assert.NoError(t, f.DeleteAll(context.Background(), "51/30c89f"))
+
+	_, err := fs.Stat("assets/51/30c89f/xxx.txt")
+	assert.True(t, os.IsNotExist(err))
+	_, err = fs.Stat("assets/aaa.txt")
+	assert.NoError(t, err)
+
+	_ = afero.WriteFile(fs, "derivatives/51/30c89f/xxx.png/100x0_contain.png", []byte("xxx"), 0644)
+	assert.NoError(t, f.DeleteDerivatives(context.Background(), "51/30c89f"))
+	_, err = fs.Stat("derivatives/51/30c89f/xxx.png/100x0_contain.png")
+	assert.True(t, os.IsNotExist(err))
+}
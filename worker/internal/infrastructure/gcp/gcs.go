@@ -13,10 +13,12 @@ import (
 	"github.com/reearth/reearth-cms/worker/internal/usecase/gateway"
 	"github.com/reearth/reearthx/log"
 	"github.com/reearth/reearthx/rerror"
+	"google.golang.org/api/iterator"
 )
 
 const (
-	gcsAssetBasePath string = "assets"
+	gcsAssetBasePath      string = "assets"
+	gcsDerivativeBasePath string = "derivatives"
 )
 
 type fileRepo struct {
@@ -81,6 +83,42 @@ func (f *fileRepo) WriteProceeded(ctx context.Context, path string, proceeded in
 	return nil
 }
 
+func (f *fileRepo) DeleteAll(ctx context.Context, prefix string) error {
+	return f.deleteAll(ctx, gcsAssetBasePath, prefix)
+}
+
+func (f *fileRepo) DeleteDerivatives(ctx context.Context, prefix string) error {
+	return f.deleteAll(ctx, gcsDerivativeBasePath, prefix)
+}
+
+func (f *fileRepo) deleteAll(ctx context.Context, base, prefix string) error {
+	if prefix == "" {
+		return gateway.ErrInvalidFile
+	}
+
+	bucket, err := f.getBucket(ctx)
+	if err != nil {
+		log.Errorf("gcs: delete bucket err: %+v\n", err)
+		return rerror.ErrInternalBy(err)
+	}
+
+	it := bucket.Objects(ctx, &storage.Query{Prefix: path.Join(base, prefix) + "/"})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return rerror.ErrInternalBy(err)
+		}
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			log.Errorf("gcs: delete err: %+v\n", err)
+			return gateway.ErrFailedToRemoveFile
+		}
+	}
+	return nil
+}
+
 func (f *fileRepo) readAll(ctx context.Context, objectName string) (gateway.ReadAtCloser, int64, int64, error) {
 	if objectName == "" {
 		return nil, 0, 0, rerror.ErrNotFound
@@ -7,10 +7,17 @@ import (
 	"github.com/reearth/reearth-cms/worker/internal/usecase/repo"
 )
 
-func New(ctx context.Context, webhook *Webhook, copier *Copier) (*repo.Container, error) {
-	r := &repo.Container{
-		Webhook: webhook,
-		Copier:  copier,
+func New(ctx context.Context, webhook *Webhook, copier *Copier, trash *Trash) (*repo.Container, error) {
+	r := &repo.Container{}
+	// assign only non-nil pointers to avoid non-nil interfaces holding nil values
+	if webhook != nil {
+		r.Webhook = webhook
+	}
+	if copier != nil {
+		r.Copier = copier
+	}
+	if trash != nil {
+		r.Trash = trash
 	}
 
 	// init
@@ -26,7 +33,7 @@ func Init(r *repo.Container) error {
 		return nil
 	}
 
-	if r.Webhook == nil && r.Copier == nil {
+	if r.Webhook == nil && r.Copier == nil && r.Trash == nil {
 		return errors.New("invalid repository container")
 	}
 
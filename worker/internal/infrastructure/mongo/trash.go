@@ -0,0 +1,203 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/repo"
+	"github.com/reearth/reearthx/rerror"
+	"github.com/samber/lo"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	trashCol        = "trash"
+	itemCol         = "item"
+	assetCol        = "asset"
+	assetFileCol    = "asset_files"
+	modelCol        = "model"
+	schemaCol       = "schema"
+	viewCol         = "view"
+	itemTemplateCol = "item_template"
+	threadCol       = "thread"
+)
+
+var _ repo.Trash = (*Trash)(nil)
+
+type Trash struct {
+	db *mongo.Database
+}
+
+type trashDocument struct {
+	ID      string  `bson:"id"`
+	Type    string  `bson:"type"`
+	Item    *string `bson:"item"`
+	Asset   *string `bson:"asset"`
+	ModelID *string `bson:"modelid"`
+}
+
+func NewTrash(db *mongo.Database) *Trash {
+	return &Trash{db: db}
+}
+
+func (r *Trash) FindExpired(ctx context.Context, before time.Time, limit int64) ([]*repo.TrashEntry, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "deletedat", Value: 1}}).SetLimit(limit)
+	cursor, err := r.db.Collection(trashCol).Find(ctx, bson.M{"deletedat": bson.M{"$lt": before}}, opts)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	var docs []trashDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+
+	return lo.Map(docs, func(d trashDocument, _ int) *repo.TrashEntry {
+		return &repo.TrashEntry{
+			ID:    d.ID,
+			Type:  trash.Type(d.Type),
+			Item:  d.Item,
+			Asset: d.Asset,
+			Model: d.ModelID,
+		}
+	}), nil
+}
+
+func (r *Trash) AssetUUID(ctx context.Context, assetID string) (string, error) {
+	var doc struct {
+		UUID string `bson:"uuid"`
+	}
+	if err := r.db.Collection(assetCol).FindOne(ctx, bson.M{"id": assetID}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", rerror.ErrNotFound
+		}
+		return "", rerror.ErrInternalBy(err)
+	}
+	return doc.UUID, nil
+}
+
+func (r *Trash) Purge(ctx context.Context, e *repo.TrashEntry) error {
+	var err error
+	switch e.Type {
+	case trash.TypeItem:
+		if e.Item != nil {
+			err = r.purgeItem(ctx, *e.Item)
+		}
+	case trash.TypeAsset:
+		if e.Asset != nil {
+			err = r.purgeAsset(ctx, *e.Asset)
+		}
+	case trash.TypeModel:
+		if e.Model != nil {
+			err = r.purgeModel(ctx, *e.Model)
+		}
+	default:
+		err = trash.ErrInvalidType
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.deleteMany(ctx, trashCol, bson.M{"id": e.ID})
+}
+
+func (r *Trash) purgeItem(ctx context.Context, itemID string) error {
+	ids := []string{itemID}
+	threads, err := r.distinct(ctx, itemCol, "thread", bson.M{"id": itemID})
+	if err != nil {
+		return err
+	}
+	metadataItems, err := r.distinct(ctx, itemCol, "metadataitem", bson.M{"id": itemID})
+	if err != nil {
+		return err
+	}
+	ids = append(ids, metadataItems...)
+
+	if err := r.deleteMany(ctx, itemCol, bson.M{"id": bson.M{"$in": ids}}); err != nil {
+		return err
+	}
+	return r.deleteThreads(ctx, threads)
+}
+
+func (r *Trash) purgeAsset(ctx context.Context, assetID string) error {
+	threads, err := r.distinct(ctx, assetCol, "thread", bson.M{"id": assetID})
+	if err != nil {
+		return err
+	}
+
+	if err := r.deleteMany(ctx, assetFileCol, bson.M{"assetid": assetID}); err != nil {
+		return err
+	}
+	if err := r.deleteMany(ctx, assetCol, bson.M{"id": assetID}); err != nil {
+		return err
+	}
+	return r.deleteThreads(ctx, threads)
+}
+
+func (r *Trash) purgeModel(ctx context.Context, modelID string) error {
+	var m struct {
+		Schema   string  `bson:"schema"`
+		Metadata *string `bson:"metadata"`
+	}
+	if err := r.db.Collection(modelCol).FindOne(ctx, bson.M{"id": modelID}).Decode(&m); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return rerror.ErrInternalBy(err)
+	}
+
+	threads, err := r.distinct(ctx, itemCol, "thread", bson.M{"modelid": modelID})
+	if err != nil {
+		return err
+	}
+	if err := r.deleteMany(ctx, itemCol, bson.M{"modelid": modelID}); err != nil {
+		return err
+	}
+	if err := r.deleteThreads(ctx, threads); err != nil {
+		return err
+	}
+
+	schemas := lo.Compact([]string{m.Schema, lo.FromPtr(m.Metadata)})
+	if len(schemas) > 0 {
+		if err := r.deleteMany(ctx, schemaCol, bson.M{"id": bson.M{"$in": schemas}}); err != nil {
+			return err
+		}
+	}
+	if err := r.deleteMany(ctx, viewCol, bson.M{"modelid": modelID}); err != nil {
+		return err
+	}
+	if err := r.deleteMany(ctx, itemTemplateCol, bson.M{"modelid": modelID}); err != nil {
+		return err
+	}
+	// the items of the model which were trashed before the model are purged together
+	if err := r.deleteMany(ctx, trashCol, bson.M{"type": string(trash.TypeItem), "modelid": modelID}); err != nil {
+		return err
+	}
+	return r.deleteMany(ctx, modelCol, bson.M{"id": modelID})
+}
+
+func (r *Trash) deleteThreads(ctx context.Context, threads []string) error {
+	if len(threads) == 0 {
+		return nil
+	}
+	return r.deleteMany(ctx, threadCol, bson.M{"id": bson.M{"$in": threads}})
+}
+
+func (r *Trash) distinct(ctx context.Context, col, field string, filter bson.M) ([]string, error) {
+	res, err := r.db.Collection(col).Distinct(ctx, field, filter)
+	if err != nil {
+		return nil, rerror.ErrInternalBy(err)
+	}
+	return lo.FilterMap(res, func(v any, _ int) (string, bool) {
+		s, ok := v.(string)
+		return s, ok && s != ""
+	}), nil
+}
+
+func (r *Trash) deleteMany(ctx context.Context, col string, filter bson.M) error {
+	if _, err := r.db.Collection(col).DeleteMany(ctx, filter); err != nil {
+		return rerror.ErrInternalBy(err)
+	}
+	return nil
+}
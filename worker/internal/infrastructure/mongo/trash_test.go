@@ -0,0 +1,83 @@
+package mongo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/id"
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/repo"
+	"github.com/reearth/reearthx/mongox/mongotest"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestTrash_FindExpired(t *testing.T) {
+	ctx := context.Background()
+	db := mongotest.Connect(t)(t)
+	r := NewTrash(db)
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	iid := id.NewItemID().String()
+	tid1, tid2 := id.NewTrashID().String(), id.NewTrashID().String()
+	_, err := db.Collection(trashCol).InsertMany(ctx, []any{
+		bson.M{"id": tid1, "type": "item", "item": iid, "deletedat": now.Add(-48 * time.Hour)},
+		bson.M{"id": tid2, "type": "item", "item": id.NewItemID().String(), "deletedat": now},
+	})
+	assert.NoError(t, err)
+
+	got, err := r.FindExpired(ctx, now.Add(-24*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []*repo.TrashEntry{{ID: tid1, Type: trash.TypeItem, Item: &iid}}, got)
+}
+
+func TestTrash_Purge(t *testing.T) {
+	ctx := context.Background()
+	db := mongotest.Connect(t)(t)
+	r := NewTrash(db)
+
+	mid, sid := id.NewModelID().String(), id.NewSchemaID().String()
+	iid1, iid2 := id.NewItemID().String(), id.NewItemID().String()
+	thid1, thid2 := id.NewThreadID().String(), id.NewThreadID().String()
+	tid1, tid2 := id.NewTrashID().String(), id.NewTrashID().String()
+
+	_, err := db.Collection(modelCol).InsertOne(ctx, bson.M{"id": mid, "schema": sid})
+	assert.NoError(t, err)
+	_, err = db.Collection(schemaCol).InsertOne(ctx, bson.M{"id": sid})
+	assert.NoError(t, err)
+	_, err = db.Collection(itemCol).InsertMany(ctx, []any{
+		bson.M{"id": iid1, "modelid": mid, "thread": thid1},
+		bson.M{"id": iid2, "modelid": mid, "thread": thid2},
+	})
+	assert.NoError(t, err)
+	_, err = db.Collection(threadCol).InsertMany(ctx, []any{bson.M{"id": thid1}, bson.M{"id": thid2}})
+	assert.NoError(t, err)
+	_, err = db.Collection(trashCol).InsertMany(ctx, []any{
+		bson.M{"id": tid1, "type": "item", "item": iid1, "modelid": mid},
+		bson.M{"id": tid2, "type": "model", "modelid": mid},
+	})
+	assert.NoError(t, err)
+
+	// item
+	assert.NoError(t, r.Purge(ctx, &repo.TrashEntry{ID: tid1, Type: trash.TypeItem, Item: &iid1, Model: &mid}))
+	assert.Equal(t, int64(0), count(t, db.Collection(itemCol), bson.M{"id": iid1}))
+	assert.Equal(t, int64(1), count(t, db.Collection(itemCol), bson.M{"id": iid2}))
+	assert.Equal(t, int64(1), count(t, db.Collection(threadCol), bson.M{}))
+	assert.Equal(t, int64(1), count(t, db.Collection(trashCol), bson.M{}))
+
+	// model
+	assert.NoError(t, r.Purge(ctx, &repo.TrashEntry{ID: tid2, Type: trash.TypeModel, Model: lo.ToPtr(mid)}))
+	for _, c := range []string{modelCol, schemaCol, itemCol, threadCol, trashCol} {
+		assert.Equal(t, int64(0), count(t, db.Collection(c), bson.M{}))
+	}
+}
+
+func count(t *testing.T, c *mongo.Collection, filter bson.M) int64 {
+	t.Helper()
+	n, err := c.CountDocuments(context.Background(), filter)
+	assert.NoError(t, err)
+	return n
+}
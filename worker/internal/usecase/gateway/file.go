@@ -23,4 +23,8 @@ type File interface {
 	Read(ctx context.Context, path string) (ReadAtCloser, int64, int64, error)
 	WriteProceeded(ctx context.Context, path string, proceeded int64) error
 	Upload(ctx context.Context, name string) (io.WriteCloser, error)
+	// DeleteAll deletes the asset files under the prefix.
+	DeleteAll(ctx context.Context, prefix string) error
+	// DeleteDerivatives deletes the images transformed from the asset files under the prefix.
+	DeleteDerivatives(ctx context.Context, prefix string) error
 }
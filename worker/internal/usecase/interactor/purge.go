@@ -0,0 +1,67 @@
+package interactor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+	"github.com/reearth/reearth-cms/worker/internal/usecase/repo"
+	"github.com/reearth/reearthx/log"
+	"github.com/reearth/reearthx/rerror"
+)
+
+const defaultPurgeBatchSize int64 = 100
+
+// Purge permanently deletes the entities which have been in the trash since before the given time and returns the number of purged entries.
+// The entries are loaded batchSize at a time, and the default batch size is used when it is not positive.
+func (u *Usecase) Purge(ctx context.Context, before time.Time, batchSize int64) (int, error) {
+	if batchSize <= 0 {
+		batchSize = defaultPurgeBatchSize
+	}
+
+	count := 0
+	for {
+		entries, err := u.repos.Trash.FindExpired(ctx, before, batchSize)
+		if err != nil {
+			return count, err
+		}
+		if len(entries) == 0 {
+			return count, nil
+		}
+
+		for _, e := range entries {
+			if err := u.purgeFiles(ctx, e); err != nil {
+				return count, err
+			}
+			if err := u.repos.Trash.Purge(ctx, e); err != nil {
+				return count, err
+			}
+			count++
+			log.Infof("purge usecase: purged %s %s", e.Type, e.ID)
+		}
+	}
+}
+
+// purgeFiles deletes the uploaded files of the asset, and the images transformed from them, before its documents are deleted so that they can be retried on failure.
+func (u *Usecase) purgeFiles(ctx context.Context, e *repo.TrashEntry) error {
+	if e.Type != trash.TypeAsset || e.Asset == nil || u.gateways == nil || u.gateways.File == nil {
+		return nil
+	}
+
+	uuid, err := u.repos.Trash.AssetUUID(ctx, *e.Asset)
+	if err != nil {
+		if errors.Is(err, rerror.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if len(uuid) < 3 {
+		return nil
+	}
+	prefix := uuid[:2] + "/" + uuid[2:]
+	if err := u.gateways.File.DeleteDerivatives(ctx, prefix); err != nil {
+		return err
+	}
+	return u.gateways.File.DeleteAll(ctx, prefix)
+}
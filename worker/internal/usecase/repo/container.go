@@ -3,4 +3,5 @@ package repo
 type Container struct {
 	Webhook Webhook
 	Copier  Copier
+	Trash   Trash
 }
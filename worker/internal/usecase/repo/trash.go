@@ -0,0 +1,22 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/reearth/reearth-cms/server/pkg/trash"
+)
+
+type TrashEntry struct {
+	ID    string
+	Type  trash.Type
+	Item  *string
+	Asset *string
+	Model *string
+}
+
+type Trash interface {
+	FindExpired(context.Context, time.Time, int64) ([]*TrashEntry, error)
+	AssetUUID(context.Context, string) (string, error)
+	Purge(context.Context, *TrashEntry) error
+}